@@ -85,6 +85,18 @@ var ErrGetBlock = errors.New("getting block failed")
 // ErrGetValidatorsInfo signals an error happening when trying to fetch validators info
 var ErrGetValidatorsInfo = errors.New("validators info failed")
 
+// ErrGetValidatorRewardsHistory signals an error happening when trying to fetch the rewards history of a validator
+var ErrGetValidatorRewardsHistory = errors.New("getting validator rewards history failed")
+
+// ErrGetNodesShufflingPreview signals an error happening when trying to preview the nodes shuffling of the next epoch
+var ErrGetNodesShufflingPreview = errors.New("getting nodes shuffling preview failed")
+
+// ErrGetValidatorRatingsHistory signals an error happening when trying to fetch the ratings history of a validator
+var ErrGetValidatorRatingsHistory = errors.New("getting validator ratings history failed")
+
+// ErrAuctionSimulation signals an error happening when trying to simulate the auction selection
+var ErrAuctionSimulation = errors.New("auction simulation failed")
+
 // ErrGetAlteredAccountsForBlock signals an error happening when trying to fetch the altered accounts for a block
 var ErrGetAlteredAccountsForBlock = errors.New("getting altered accounts for block failed")
 
@@ -109,6 +121,9 @@ var ErrValidationEmptyAddress = errors.New("address is empty")
 // ErrValidationEmptyKey signals that an empty key was provided
 var ErrValidationEmptyKey = errors.New("key is empty")
 
+// ErrValidationEmptyBlsKey signals that an empty BLS key was provided
+var ErrValidationEmptyBlsKey = errors.New("BLS key is empty")
+
 // ErrGetProof signals an error happening when trying to compute a Merkle proof
 var ErrGetProof = errors.New("getting proof failed")
 
@@ -139,6 +154,27 @@ var ErrBadUrlParams = errors.New("bad url parameter(s)")
 // ErrGetGasConfigs signals that an error occurred while trying to fetch gas configs
 var ErrGetGasConfigs = errors.New("getting gas configs failed")
 
+// ErrGetTrieStatistics signals that an error occurred while trying to get the trie statistics
+var ErrGetTrieStatistics = errors.New("getting trie statistics failed")
+
+// ErrGetDelegationContract signals that an error occurred while trying to get the delegation contract state
+var ErrGetDelegationContract = errors.New("getting delegation contract failed")
+
+// ErrGetDelegationPositions signals that an error occurred while trying to get the delegation positions of an address
+var ErrGetDelegationPositions = errors.New("getting delegation positions failed")
+
+// ErrGetGovernanceProposals signals that an error occurred while trying to get the governance proposals
+var ErrGetGovernanceProposals = errors.New("getting governance proposals failed")
+
+// ErrGetGovernanceVotes signals that an error occurred while trying to get the governance votes
+var ErrGetGovernanceVotes = errors.New("getting governance votes failed")
+
+// ErrInvalidProposalNonce signals that an invalid governance proposal nonce was provided
+var ErrInvalidProposalNonce = errors.New("invalid proposal nonce")
+
+// ErrGetGasPriceSuggestion signals that an error occurred while trying to compute the gas price suggestion
+var ErrGetGasPriceSuggestion = errors.New("getting gas price suggestion failed")
+
 // ErrEmptySenderToGetLatestNonce signals that an error happened when trying to fetch latest nonce
 var ErrEmptySenderToGetLatestNonce = errors.New("empty sender to get latest nonce")
 
@@ -174,3 +210,12 @@ var ErrGetWaitingManagedKeys = errors.New("error getting the waiting managed key
 
 // ErrGetWaitingEpochsLeftForPublicKey signals that an error occurred while getting the waiting epochs left for public key
 var ErrGetWaitingEpochsLeftForPublicKey = errors.New("error getting the waiting epochs left for public key")
+
+// ErrStartOutportReindexing signals that an error occurred while starting the outport re-indexing
+var ErrStartOutportReindexing = errors.New("error starting the outport re-indexing")
+
+// ErrGetConsensusRoundsTimeline signals that an error occurred while getting the consensus rounds timeline
+var ErrGetConsensusRoundsTimeline = errors.New("error getting the consensus rounds timeline")
+
+// ErrGetOutportReindexingStatus signals that an error occurred while getting the outport re-indexing status
+var ErrGetOutportReindexingStatus = errors.New("error getting the outport re-indexing status")
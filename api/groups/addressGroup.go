@@ -14,6 +14,7 @@ import (
 	"github.com/multiversx/mx-chain-core-go/data/esdt"
 	"github.com/multiversx/mx-chain-go/api/errors"
 	"github.com/multiversx/mx-chain-go/api/shared"
+	"github.com/multiversx/mx-chain-go/common"
 )
 
 const (
@@ -39,6 +40,7 @@ const (
 	urlParamBlockRootHash          = "blockRootHash"
 	urlParamHintEpoch              = "hintEpoch"
 	urlParamWithKeys               = "withKeys"
+	urlParamWithDataTrieStatistics = "withDataTrieStatistics"
 )
 
 // addressFacadeHandler defines the methods to be implemented by a facade for handling address requests
@@ -57,6 +59,7 @@ type addressFacadeHandler interface {
 	GetKeyValuePairs(address string, options api.AccountQueryOptions) (map[string]string, api.BlockInfo, error)
 	GetGuardianData(address string, options api.AccountQueryOptions) (api.GuardianData, api.BlockInfo, error)
 	IsDataTrieMigrated(address string, options api.AccountQueryOptions) (bool, error)
+	GetDataTrieStatistics(address string, options api.AccountQueryOptions) (*common.DataTrieStatisticsAPIResponse, api.BlockInfo, error)
 	IsInterfaceNil() bool
 }
 
@@ -194,6 +197,12 @@ func (ag *addressGroup) getAccount(c *gin.Context) {
 
 	options.WithKeys = withKeys
 
+	withDataTrieStatistics, err := parseBoolUrlParam(c, urlParamWithDataTrieStatistics)
+	if err != nil {
+		shared.RespondWithValidationError(c, errors.ErrCouldNotGetAccount, err)
+		return
+	}
+
 	accountResponse, blockInfo, err := ag.getFacade().GetAccount(addr, options)
 	if err != nil {
 		shared.RespondWithInternalError(c, errors.ErrCouldNotGetAccount, err)
@@ -201,7 +210,18 @@ func (ag *addressGroup) getAccount(c *gin.Context) {
 	}
 
 	accountResponse.Address = addr
-	shared.RespondWithSuccess(c, gin.H{"account": accountResponse, "blockInfo": blockInfo})
+	response := gin.H{"account": accountResponse, "blockInfo": blockInfo}
+	if withDataTrieStatistics {
+		dataTrieStatistics, _, errGet := ag.getFacade().GetDataTrieStatistics(addr, options)
+		if errGet != nil {
+			shared.RespondWithInternalError(c, errors.ErrCouldNotGetAccount, errGet)
+			return
+		}
+
+		response["dataTrieStatistics"] = dataTrieStatistics
+	}
+
+	shared.RespondWithSuccess(c, response)
 }
 
 // getAccounts returns the state of the provided addresses on the specified block
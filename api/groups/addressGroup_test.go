@@ -19,6 +19,7 @@ import (
 	"github.com/multiversx/mx-chain-go/api/groups"
 	"github.com/multiversx/mx-chain-go/api/mock"
 	"github.com/multiversx/mx-chain-go/api/shared"
+	"github.com/multiversx/mx-chain-go/common"
 	"github.com/multiversx/mx-chain-go/config"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -34,6 +35,7 @@ type accountResponse struct {
 		RootHash        []byte `json:"rootHash"`
 		DeveloperReward string `json:"developerReward"`
 	} `json:"account"`
+	DataTrieStatistics *common.DataTrieStatisticsAPIResponse `json:"dataTrieStatistics"`
 }
 
 type valueForKeyResponseData struct {
@@ -238,6 +240,58 @@ func TestAddressGroup_getAccount(t *testing.T) {
 		assert.Equal(t, uint64(1), accResp.Account.Nonce)
 		assert.Equal(t, "100", accResp.Account.Balance)
 		assert.Equal(t, "120", accResp.Account.DeveloperReward)
+		assert.Nil(t, accResp.DataTrieStatistics)
+		assert.Empty(t, response.Error)
+	})
+	t.Run("data trie statistics facade error should error", func(t *testing.T) {
+		t.Parallel()
+
+		facade := &mock.FacadeStub{
+			GetDataTrieStatisticsCalled: func(address string, options api.AccountQueryOptions) (*common.DataTrieStatisticsAPIResponse, api.BlockInfo, error) {
+				return nil, api.BlockInfo{}, expectedErr
+			},
+		}
+
+		testAddressGroup(
+			t,
+			facade,
+			"/address/addr?withDataTrieStatistics=true",
+			"GET",
+			nil,
+			http.StatusInternalServerError,
+			formatExpectedErr(apiErrors.ErrCouldNotGetAccount, expectedErr),
+		)
+	})
+	t.Run("with data trie statistics should work", func(t *testing.T) {
+		t.Parallel()
+
+		expectedStatistics := &common.DataTrieStatisticsAPIResponse{
+			Address:      "addr",
+			RootHash:     "aabb",
+			TotalSize:    1024,
+			NumNodes:     10,
+			NumLeaves:    7,
+			MaxTrieDepth: 3,
+		}
+		facade := &mock.FacadeStub{
+			GetAccountCalled: func(address string, options api.AccountQueryOptions) (api.AccountResponse, api.BlockInfo, error) {
+				return api.AccountResponse{Address: "addr", Nonce: 1}, api.BlockInfo{}, nil
+			},
+			GetDataTrieStatisticsCalled: func(address string, options api.AccountQueryOptions) (*common.DataTrieStatisticsAPIResponse, api.BlockInfo, error) {
+				assert.Equal(t, "addr", address)
+				return expectedStatistics, api.BlockInfo{}, nil
+			},
+		}
+
+		response := &shared.GenericAPIResponse{}
+		loadAddressGroupResponse(t, facade, "/address/addr?withDataTrieStatistics=true", "GET", nil, response)
+
+		accResp := accountResponse{}
+		mapResponseBytes, _ := json.Marshal(response.Data)
+		_ = json.Unmarshal(mapResponseBytes, &accResp)
+
+		assert.Equal(t, uint64(1), accResp.Account.Nonce)
+		assert.Equal(t, expectedStatistics, accResp.DataTrieStatistics)
 		assert.Empty(t, response.Error)
 	})
 }
@@ -3,6 +3,7 @@ package groups
 import (
 	"fmt"
 	"net/http"
+	"strconv"
 	"sync"
 	"time"
 
@@ -18,21 +19,31 @@ import (
 )
 
 const (
-	getConfigPath          = "/config"
-	getStatusPath          = "/status"
-	economicsPath          = "/economics"
-	enableEpochsPath       = "/enable-epochs"
-	getESDTsPath           = "/esdts"
-	getFFTsPath            = "/esdt/fungible-tokens"
-	getSFTsPath            = "/esdt/semi-fungible-tokens"
-	getNFTsPath            = "/esdt/non-fungible-tokens"
-	getESDTSupplyPath      = "/esdt/supply/:token"
-	directStakedInfoPath   = "/direct-staked-info"
-	delegatedInfoPath      = "/delegated-info"
-	ratingsPath            = "/ratings"
-	genesisNodesConfigPath = "/genesis-nodes"
-	genesisBalances        = "/genesis-balances"
-	gasConfigPath          = "/gas-configs"
+	getConfigPath           = "/config"
+	getStatusPath           = "/status"
+	economicsPath           = "/economics"
+	enableEpochsPath        = "/enable-epochs"
+	getESDTsPath            = "/esdts"
+	getFFTsPath             = "/esdt/fungible-tokens"
+	getSFTsPath             = "/esdt/semi-fungible-tokens"
+	getNFTsPath             = "/esdt/non-fungible-tokens"
+	getESDTSupplyPath       = "/esdt/supply/:token"
+	directStakedInfoPath    = "/direct-staked-info"
+	delegatedInfoPath       = "/delegated-info"
+	ratingsPath             = "/ratings"
+	genesisNodesConfigPath  = "/genesis-nodes"
+	genesisBalances         = "/genesis-balances"
+	gasConfigPath           = "/gas-configs"
+	gasPriceSuggestionPath  = "/gas-price-suggestion"
+	trieStatisticsPath      = "/trie-statistics"
+	delegationContractPath  = "/delegation/contract/:address"
+	delegationPositionsPath = "/delegation/positions/:address"
+	governanceListPath      = "/governance/proposals"
+	governanceProposalPath  = "/governance/proposal/:nonce"
+	governanceVotesPath     = "/governance/votes/:address"
+	urlParamRootHash        = "rootHash"
+	urlParamPage            = "page"
+	urlParamPageSize        = "pageSize"
 )
 
 // networkFacadeHandler defines the methods to be implemented by a facade for handling network requests
@@ -46,6 +57,13 @@ type networkFacadeHandler interface {
 	GetGenesisNodesPubKeys() (map[uint32][]string, map[uint32][]string, error)
 	GetGenesisBalances() ([]*common.InitialAccountAPI, error)
 	GetGasConfigs() (map[string]map[string]uint64, error)
+	GetGasPriceSuggestion() (*common.GasPriceSuggestionAPIResponse, error)
+	GetTrieStatistics(rootHash string) (*common.TrieStatisticsAPIResponse, error)
+	GetDelegationContract(address string, page uint32, pageSize uint32) (*common.DelegationContractAPIResponse, error)
+	GetDelegationPositions(address string) (*common.DelegationPositionsAPIResponse, error)
+	GetGovernanceProposals() (*common.GovernanceProposalsAPIResponse, error)
+	GetGovernanceProposal(nonce uint64) (*common.GovernanceProposalAPIResponse, error)
+	GetGovernanceVotes(address string) (*common.GovernanceVotesAPIResponse, error)
 	IsInterfaceNil() bool
 }
 
@@ -154,6 +172,41 @@ func NewNetworkGroup(facade networkFacadeHandler) (*networkGroup, error) {
 			Method:  http.MethodGet,
 			Handler: ng.getGasConfig,
 		},
+		{
+			Path:    gasPriceSuggestionPath,
+			Method:  http.MethodGet,
+			Handler: ng.getGasPriceSuggestion,
+		},
+		{
+			Path:    trieStatisticsPath,
+			Method:  http.MethodGet,
+			Handler: ng.getTrieStatistics,
+		},
+		{
+			Path:    delegationContractPath,
+			Method:  http.MethodGet,
+			Handler: ng.getDelegationContract,
+		},
+		{
+			Path:    delegationPositionsPath,
+			Method:  http.MethodGet,
+			Handler: ng.getDelegationPositions,
+		},
+		{
+			Path:    governanceListPath,
+			Method:  http.MethodGet,
+			Handler: ng.getGovernanceProposals,
+		},
+		{
+			Path:    governanceProposalPath,
+			Method:  http.MethodGet,
+			Handler: ng.getGovernanceProposal,
+		},
+		{
+			Path:    governanceVotesPath,
+			Method:  http.MethodGet,
+			Handler: ng.getGovernanceVotes,
+		},
 	}
 	ng.endpoints = endpoints
 
@@ -352,6 +405,53 @@ func (ng *networkGroup) delegatedInfo(c *gin.Context) {
 	)
 }
 
+// getDelegationContract returns the configuration, the stake totals and the nodes of a delegation contract, along
+// with a page of its delegators
+func (ng *networkGroup) getDelegationContract(c *gin.Context) {
+	address := c.Param("address")
+	if address == "" {
+		shared.RespondWithValidationError(c, errors.ErrValidation, errors.ErrBadUrlParams)
+		return
+	}
+
+	page, err := parseUint32UrlParam(c, urlParamPage)
+	if err != nil {
+		shared.RespondWithValidationError(c, errors.ErrValidation, errors.ErrBadUrlParams)
+		return
+	}
+
+	pageSize, err := parseUint32UrlParam(c, urlParamPageSize)
+	if err != nil {
+		shared.RespondWithValidationError(c, errors.ErrValidation, errors.ErrBadUrlParams)
+		return
+	}
+
+	contract, err := ng.getFacade().GetDelegationContract(address, page.Value, pageSize.Value)
+	if err != nil {
+		shared.RespondWithInternalError(c, errors.ErrGetDelegationContract, err)
+		return
+	}
+
+	shared.RespondWith(c, http.StatusOK, gin.H{"contract": contract}, "", shared.ReturnCodeSuccess)
+}
+
+// getDelegationPositions returns the positions of an address in every delegation contract it delegated to
+func (ng *networkGroup) getDelegationPositions(c *gin.Context) {
+	address := c.Param("address")
+	if address == "" {
+		shared.RespondWithValidationError(c, errors.ErrValidation, errors.ErrBadUrlParams)
+		return
+	}
+
+	positions, err := ng.getFacade().GetDelegationPositions(address)
+	if err != nil {
+		shared.RespondWithInternalError(c, errors.ErrGetDelegationPositions, err)
+		return
+	}
+
+	shared.RespondWith(c, http.StatusOK, gin.H{"delegation": positions}, "", shared.ReturnCodeSuccess)
+}
+
 func (ng *networkGroup) getESDTTokenSupply(c *gin.Context) {
 	token := c.Param("token")
 	if token == "" {
@@ -477,6 +577,102 @@ func (ng *networkGroup) getGasConfig(c *gin.Context) {
 	shared.RespondWith(c, http.StatusOK, gin.H{"gasConfigs": gc}, "", shared.ReturnCodeSuccess)
 }
 
+// getGasPriceSuggestion returns the slow, normal and fast gas price suggestions along with their expected inclusion delays
+func (ng *networkGroup) getGasPriceSuggestion(c *gin.Context) {
+	gasPriceSuggestion, err := ng.getFacade().GetGasPriceSuggestion()
+	if err != nil {
+		c.JSON(
+			http.StatusInternalServerError,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: fmt.Sprintf("%s: %s", errors.ErrGetGasPriceSuggestion.Error(), err.Error()),
+				Code:  shared.ReturnCodeInternalError,
+			},
+		)
+		return
+	}
+
+	shared.RespondWith(c, http.StatusOK, gin.H{"suggestion": gasPriceSuggestion}, "", shared.ReturnCodeSuccess)
+}
+
+// getTrieStatistics returns the statistics of the accounts trie and of all the data tries for the provided root hash
+// or for the current one. The statistics are computed in background, so the in progress status is returned until they
+// are ready
+func (ng *networkGroup) getTrieStatistics(c *gin.Context) {
+	rootHash := c.Request.URL.Query().Get(urlParamRootHash)
+	trieStatistics, err := ng.getFacade().GetTrieStatistics(rootHash)
+	if err != nil {
+		c.JSON(
+			http.StatusInternalServerError,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: fmt.Sprintf("%s: %s", errors.ErrGetTrieStatistics.Error(), err.Error()),
+				Code:  shared.ReturnCodeInternalError,
+			},
+		)
+		return
+	}
+
+	shared.RespondWith(c, http.StatusOK, gin.H{"statistics": trieStatistics}, "", shared.ReturnCodeSuccess)
+}
+
+// getGovernanceProposals returns all the governance proposals with their current vote tallies and quorum progress
+func (ng *networkGroup) getGovernanceProposals(c *gin.Context) {
+	proposals, err := ng.getFacade().GetGovernanceProposals()
+	if err != nil {
+		respondWithGovernanceError(c, errors.ErrGetGovernanceProposals, err)
+		return
+	}
+
+	shared.RespondWith(c, http.StatusOK, gin.H{"governance": proposals}, "", shared.ReturnCodeSuccess)
+}
+
+// getGovernanceProposal returns the governance proposal with the provided nonce
+func (ng *networkGroup) getGovernanceProposal(c *gin.Context) {
+	nonce, err := strconv.ParseUint(c.Param("nonce"), 10, 64)
+	if err != nil {
+		shared.RespondWithValidationError(c, errors.ErrValidation, errors.ErrInvalidProposalNonce)
+		return
+	}
+
+	proposal, err := ng.getFacade().GetGovernanceProposal(nonce)
+	if err != nil {
+		respondWithGovernanceError(c, errors.ErrGetGovernanceProposals, err)
+		return
+	}
+
+	shared.RespondWith(c, http.StatusOK, gin.H{"proposal": proposal}, "", shared.ReturnCodeSuccess)
+}
+
+// getGovernanceVotes returns the proposals an address voted on, its voting power and, for delegation contracts,
+// the voting power used on behalf of the delegators
+func (ng *networkGroup) getGovernanceVotes(c *gin.Context) {
+	address := c.Param("address")
+	if address == "" {
+		shared.RespondWithValidationError(c, errors.ErrValidation, errors.ErrBadUrlParams)
+		return
+	}
+
+	votes, err := ng.getFacade().GetGovernanceVotes(address)
+	if err != nil {
+		respondWithGovernanceError(c, errors.ErrGetGovernanceVotes, err)
+		return
+	}
+
+	shared.RespondWith(c, http.StatusOK, gin.H{"votes": votes}, "", shared.ReturnCodeSuccess)
+}
+
+func respondWithGovernanceError(c *gin.Context, baseErr error, err error) {
+	c.JSON(
+		http.StatusInternalServerError,
+		shared.GenericAPIResponse{
+			Data:  nil,
+			Error: fmt.Sprintf("%s: %s", baseErr.Error(), err.Error()),
+			Code:  shared.ReturnCodeInternalError,
+		},
+	)
+}
+
 func (ng *networkGroup) getFacade() networkFacadeHandler {
 	ng.mutFacade.RLock()
 	defer ng.mutFacade.RUnlock()
@@ -90,6 +90,76 @@ type gasConfigsData struct {
 	Configs groups.GasConfig `json:"gasConfigs"`
 }
 
+type gasPriceSuggestionResponse struct {
+	Data  gasPriceSuggestionData `json:"data"`
+	Error string                 `json:"error"`
+	Code  string                 `json:"code"`
+}
+
+type gasPriceSuggestionData struct {
+	Suggestion *common.GasPriceSuggestionAPIResponse `json:"suggestion"`
+}
+
+type trieStatisticsResponse struct {
+	Data  trieStatisticsData `json:"data"`
+	Error string             `json:"error"`
+	Code  string             `json:"code"`
+}
+
+type trieStatisticsData struct {
+	Statistics *common.TrieStatisticsAPIResponse `json:"statistics"`
+}
+
+type delegationContractResponse struct {
+	Data  delegationContractData `json:"data"`
+	Error string                 `json:"error"`
+	Code  string                 `json:"code"`
+}
+
+type delegationContractData struct {
+	Contract *common.DelegationContractAPIResponse `json:"contract"`
+}
+
+type delegationPositionsResponse struct {
+	Data  delegationPositionsData `json:"data"`
+	Error string                  `json:"error"`
+	Code  string                  `json:"code"`
+}
+
+type delegationPositionsData struct {
+	Delegation *common.DelegationPositionsAPIResponse `json:"delegation"`
+}
+
+type governanceProposalsResponse struct {
+	Data  governanceProposalsData `json:"data"`
+	Error string                  `json:"error"`
+	Code  string                  `json:"code"`
+}
+
+type governanceProposalsData struct {
+	Governance *common.GovernanceProposalsAPIResponse `json:"governance"`
+}
+
+type governanceProposalResponse struct {
+	Data  governanceProposalData `json:"data"`
+	Error string                 `json:"error"`
+	Code  string                 `json:"code"`
+}
+
+type governanceProposalData struct {
+	Proposal *common.GovernanceProposalAPIResponse `json:"proposal"`
+}
+
+type governanceVotesResponse struct {
+	Data  governanceVotesData `json:"data"`
+	Error string              `json:"error"`
+	Code  string              `json:"code"`
+}
+
+type governanceVotesData struct {
+	Votes *common.GovernanceVotesAPIResponse `json:"votes"`
+}
+
 func TestNetworkConfigMetrics_ShouldWork(t *testing.T) {
 	t.Parallel()
 
@@ -903,6 +973,553 @@ func TestGetGasConfigs(t *testing.T) {
 	})
 }
 
+func TestGetGasPriceSuggestion(t *testing.T) {
+	t.Parallel()
+
+	t.Run("facade error, should fail", func(t *testing.T) {
+		t.Parallel()
+
+		facade := mock.FacadeStub{
+			GetGasPriceSuggestionCalled: func() (*common.GasPriceSuggestionAPIResponse, error) {
+				return nil, expectedErr
+			},
+		}
+
+		networkGroup, err := groups.NewNetworkGroup(&facade)
+		require.NoError(t, err)
+
+		ws := startWebServer(networkGroup, "network", getNetworkRoutesConfig())
+
+		req, _ := http.NewRequest("GET", "/network/gas-price-suggestion", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := gasPriceSuggestionResponse{}
+		loadResponse(resp.Body, &response)
+
+		assert.Equal(t, http.StatusInternalServerError, resp.Code)
+		assert.True(t, strings.Contains(response.Error, apiErrors.ErrGetGasPriceSuggestion.Error()))
+		assert.True(t, strings.Contains(response.Error, expectedErr.Error()))
+	})
+
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		expectedSuggestion := &common.GasPriceSuggestionAPIResponse{
+			ShardID:     1,
+			MinGasPrice: 1000000000,
+			Slow: &common.GasPriceSuggestion{
+				GasPrice:                 1000000000,
+				ExpectedInclusionRounds:  3,
+				ExpectedInclusionDelayMs: 18000,
+			},
+			Normal: &common.GasPriceSuggestion{
+				GasPrice:                 1200000000,
+				ExpectedInclusionRounds:  2,
+				ExpectedInclusionDelayMs: 12000,
+			},
+			Fast: &common.GasPriceSuggestion{
+				GasPrice:                 1500000000,
+				ExpectedInclusionRounds:  1,
+				ExpectedInclusionDelayMs: 6000,
+			},
+			BlocksAnalyzed:        10,
+			AverageBlockFullness:  0.75,
+			PoolOccupancy:         0.5,
+			PendingTransactions:   1000,
+			TotalPoolTransactions: 1500,
+			LastNonce:             37,
+		}
+		facade := &mock.FacadeStub{
+			GetGasPriceSuggestionCalled: func() (*common.GasPriceSuggestionAPIResponse, error) {
+				return expectedSuggestion, nil
+			},
+		}
+
+		response := &gasPriceSuggestionResponse{}
+		loadNetworkGroupResponse(
+			t,
+			facade,
+			"/network/gas-price-suggestion",
+			"GET",
+			nil,
+			response,
+		)
+		assert.Equal(t, expectedSuggestion, response.Data.Suggestion)
+	})
+}
+
+func TestGetTrieStatistics(t *testing.T) {
+	t.Parallel()
+
+	t.Run("facade error, should fail", func(t *testing.T) {
+		t.Parallel()
+
+		facade := mock.FacadeStub{
+			GetTrieStatisticsCalled: func(rootHash string) (*common.TrieStatisticsAPIResponse, error) {
+				return nil, expectedErr
+			},
+		}
+
+		networkGroup, err := groups.NewNetworkGroup(&facade)
+		require.NoError(t, err)
+
+		ws := startWebServer(networkGroup, "network", getNetworkRoutesConfig())
+
+		req, _ := http.NewRequest("GET", "/network/trie-statistics", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := trieStatisticsResponse{}
+		loadResponse(resp.Body, &response)
+
+		assert.Equal(t, http.StatusInternalServerError, resp.Code)
+		assert.True(t, strings.Contains(response.Error, apiErrors.ErrGetTrieStatistics.Error()))
+		assert.True(t, strings.Contains(response.Error, expectedErr.Error()))
+	})
+
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		expectedStatistics := &common.TrieStatisticsAPIResponse{
+			RootHash:       "aabb",
+			Status:         "ready",
+			NumAccounts:    10,
+			NumDataTries:   2,
+			NumBranchNodes: 5,
+			NumLeafNodes:   30,
+			TotalSize:      1000,
+			MainTrieNodes: []*common.TrieNodesPerDepth{
+				{Depth: 0, NumBranchNodes: 1},
+			},
+			LargestDataTries: []*common.DataTrieStatisticsAPIResponse{
+				{Address: "erd1alice", TotalSize: 300},
+			},
+		}
+		facade := &mock.FacadeStub{
+			GetTrieStatisticsCalled: func(rootHash string) (*common.TrieStatisticsAPIResponse, error) {
+				assert.Equal(t, "aabb", rootHash)
+				return expectedStatistics, nil
+			},
+		}
+
+		response := &trieStatisticsResponse{}
+		loadNetworkGroupResponse(
+			t,
+			facade,
+			"/network/trie-statistics?rootHash=aabb",
+			"GET",
+			nil,
+			response,
+		)
+		assert.Equal(t, expectedStatistics, response.Data.Statistics)
+	})
+}
+
+func TestGetGovernanceProposals(t *testing.T) {
+	t.Parallel()
+
+	t.Run("facade error, should fail", func(t *testing.T) {
+		t.Parallel()
+
+		facade := mock.FacadeStub{
+			GetGovernanceProposalsCalled: func() (*common.GovernanceProposalsAPIResponse, error) {
+				return nil, expectedErr
+			},
+		}
+
+		networkGroup, err := groups.NewNetworkGroup(&facade)
+		require.NoError(t, err)
+
+		ws := startWebServer(networkGroup, "network", getNetworkRoutesConfig())
+
+		req, _ := http.NewRequest("GET", "/network/governance/proposals", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := governanceProposalsResponse{}
+		loadResponse(resp.Body, &response)
+
+		assert.Equal(t, http.StatusInternalServerError, resp.Code)
+		assert.True(t, strings.Contains(response.Error, apiErrors.ErrGetGovernanceProposals.Error()))
+		assert.True(t, strings.Contains(response.Error, expectedErr.Error()))
+	})
+
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		expectedProposals := &common.GovernanceProposalsAPIResponse{
+			CurrentEpoch: 10,
+			TotalStake:   "1000",
+			Config: &common.GovernanceConfigAPIResponse{
+				MinQuorum:         0.5,
+				ProposalFee:       "100",
+				LostProposalFee:   "10",
+				LastProposalNonce: 1,
+			},
+			Proposals: []*common.GovernanceProposalAPIResponse{
+				{
+					Nonce:          1,
+					CommitHash:     "hash",
+					Status:         "active",
+					Yes:            "300",
+					No:             "0",
+					Veto:           "0",
+					Abstain:        "0",
+					TotalVotes:     "300",
+					RequiredQuorum: "500",
+					QuorumProgress: 60,
+				},
+			},
+		}
+		facade := &mock.FacadeStub{
+			GetGovernanceProposalsCalled: func() (*common.GovernanceProposalsAPIResponse, error) {
+				return expectedProposals, nil
+			},
+		}
+
+		response := &governanceProposalsResponse{}
+		loadNetworkGroupResponse(
+			t,
+			facade,
+			"/network/governance/proposals",
+			"GET",
+			nil,
+			response,
+		)
+		assert.Equal(t, expectedProposals, response.Data.Governance)
+	})
+}
+
+func TestGetDelegationContract(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid page, should fail", func(t *testing.T) {
+		t.Parallel()
+
+		networkGroup, err := groups.NewNetworkGroup(&mock.FacadeStub{})
+		require.NoError(t, err)
+
+		ws := startWebServer(networkGroup, "network", getNetworkRoutesConfig())
+
+		req, _ := http.NewRequest("GET", "/network/delegation/contract/erd1contract?page=abc", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := delegationContractResponse{}
+		loadResponse(resp.Body, &response)
+
+		assert.Equal(t, http.StatusBadRequest, resp.Code)
+		assert.True(t, strings.Contains(response.Error, apiErrors.ErrBadUrlParams.Error()))
+	})
+
+	t.Run("invalid page size, should fail", func(t *testing.T) {
+		t.Parallel()
+
+		networkGroup, err := groups.NewNetworkGroup(&mock.FacadeStub{})
+		require.NoError(t, err)
+
+		ws := startWebServer(networkGroup, "network", getNetworkRoutesConfig())
+
+		req, _ := http.NewRequest("GET", "/network/delegation/contract/erd1contract?pageSize=-1", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := delegationContractResponse{}
+		loadResponse(resp.Body, &response)
+
+		assert.Equal(t, http.StatusBadRequest, resp.Code)
+		assert.True(t, strings.Contains(response.Error, apiErrors.ErrBadUrlParams.Error()))
+	})
+
+	t.Run("facade error, should fail", func(t *testing.T) {
+		t.Parallel()
+
+		facade := mock.FacadeStub{
+			GetDelegationContractCalled: func(address string, page uint32, pageSize uint32) (*common.DelegationContractAPIResponse, error) {
+				return nil, expectedErr
+			},
+		}
+
+		networkGroup, err := groups.NewNetworkGroup(&facade)
+		require.NoError(t, err)
+
+		ws := startWebServer(networkGroup, "network", getNetworkRoutesConfig())
+
+		req, _ := http.NewRequest("GET", "/network/delegation/contract/erd1contract", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := delegationContractResponse{}
+		loadResponse(resp.Body, &response)
+
+		assert.Equal(t, http.StatusInternalServerError, resp.Code)
+		assert.True(t, strings.Contains(response.Error, apiErrors.ErrGetDelegationContract.Error()))
+		assert.True(t, strings.Contains(response.Error, expectedErr.Error()))
+	})
+
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		expectedContract := &common.DelegationContractAPIResponse{
+			Address: "erd1contract",
+			Config: &common.DelegationContractConfigAPIResponse{
+				Owner:      "erd1owner",
+				ServiceFee: 1000,
+			},
+			TotalActiveStake: "5000",
+			TotalUnStaked:    "100",
+			Nodes: &common.DelegationNodesAPIResponse{
+				Staked:    []string{"bls1"},
+				NotStaked: []string{},
+				UnStaked:  []string{},
+			},
+			NumDelegators: 3,
+			Page:          1,
+			PageSize:      2,
+			Delegators: []*common.DelegationPositionAPIResponse{
+				{
+					Delegator:        "erd1alice",
+					ActiveStake:      "1000",
+					UnStakedFunds:    []*common.DelegationUnStakedFundAPIResponse{{Value: "100", RemainingEpochs: 4}},
+					ClaimableRewards: "7",
+				},
+			},
+		}
+		facade := &mock.FacadeStub{
+			GetDelegationContractCalled: func(address string, page uint32, pageSize uint32) (*common.DelegationContractAPIResponse, error) {
+				assert.Equal(t, "erd1contract", address)
+				assert.Equal(t, uint32(1), page)
+				assert.Equal(t, uint32(2), pageSize)
+				return expectedContract, nil
+			},
+		}
+
+		response := &delegationContractResponse{}
+		loadNetworkGroupResponse(
+			t,
+			facade,
+			"/network/delegation/contract/erd1contract?page=1&pageSize=2",
+			"GET",
+			nil,
+			response,
+		)
+		assert.Equal(t, expectedContract, response.Data.Contract)
+	})
+}
+
+func TestGetDelegationPositions(t *testing.T) {
+	t.Parallel()
+
+	t.Run("facade error, should fail", func(t *testing.T) {
+		t.Parallel()
+
+		facade := mock.FacadeStub{
+			GetDelegationPositionsCalled: func(address string) (*common.DelegationPositionsAPIResponse, error) {
+				return nil, expectedErr
+			},
+		}
+
+		networkGroup, err := groups.NewNetworkGroup(&facade)
+		require.NoError(t, err)
+
+		ws := startWebServer(networkGroup, "network", getNetworkRoutesConfig())
+
+		req, _ := http.NewRequest("GET", "/network/delegation/positions/erd1alice", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := delegationPositionsResponse{}
+		loadResponse(resp.Body, &response)
+
+		assert.Equal(t, http.StatusInternalServerError, resp.Code)
+		assert.True(t, strings.Contains(response.Error, apiErrors.ErrGetDelegationPositions.Error()))
+		assert.True(t, strings.Contains(response.Error, expectedErr.Error()))
+	})
+
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		expectedPositions := &common.DelegationPositionsAPIResponse{
+			Address:               "erd1alice",
+			TotalActiveStake:      "1500",
+			TotalClaimableRewards: "9",
+			Positions: []*common.DelegationPositionAPIResponse{
+				{
+					Contract:         "erd1contract1",
+					ActiveStake:      "1000",
+					UnStakedFunds:    []*common.DelegationUnStakedFundAPIResponse{},
+					ClaimableRewards: "7",
+				},
+				{
+					Contract:         "erd1contract2",
+					ActiveStake:      "500",
+					UnStakedFunds:    []*common.DelegationUnStakedFundAPIResponse{{Value: "10", RemainingEpochs: 0}},
+					ClaimableRewards: "2",
+				},
+			},
+		}
+		facade := &mock.FacadeStub{
+			GetDelegationPositionsCalled: func(address string) (*common.DelegationPositionsAPIResponse, error) {
+				assert.Equal(t, "erd1alice", address)
+				return expectedPositions, nil
+			},
+		}
+
+		response := &delegationPositionsResponse{}
+		loadNetworkGroupResponse(
+			t,
+			facade,
+			"/network/delegation/positions/erd1alice",
+			"GET",
+			nil,
+			response,
+		)
+		assert.Equal(t, expectedPositions, response.Data.Delegation)
+	})
+}
+
+func TestGetGovernanceProposal(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid nonce, should fail", func(t *testing.T) {
+		t.Parallel()
+
+		networkGroup, err := groups.NewNetworkGroup(&mock.FacadeStub{})
+		require.NoError(t, err)
+
+		ws := startWebServer(networkGroup, "network", getNetworkRoutesConfig())
+
+		req, _ := http.NewRequest("GET", "/network/governance/proposal/abc", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := governanceProposalResponse{}
+		loadResponse(resp.Body, &response)
+
+		assert.Equal(t, http.StatusBadRequest, resp.Code)
+		assert.True(t, strings.Contains(response.Error, apiErrors.ErrInvalidProposalNonce.Error()))
+	})
+
+	t.Run("facade error, should fail", func(t *testing.T) {
+		t.Parallel()
+
+		facade := mock.FacadeStub{
+			GetGovernanceProposalCalled: func(nonce uint64) (*common.GovernanceProposalAPIResponse, error) {
+				return nil, expectedErr
+			},
+		}
+
+		networkGroup, err := groups.NewNetworkGroup(&facade)
+		require.NoError(t, err)
+
+		ws := startWebServer(networkGroup, "network", getNetworkRoutesConfig())
+
+		req, _ := http.NewRequest("GET", "/network/governance/proposal/1", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := governanceProposalResponse{}
+		loadResponse(resp.Body, &response)
+
+		assert.Equal(t, http.StatusInternalServerError, resp.Code)
+		assert.True(t, strings.Contains(response.Error, apiErrors.ErrGetGovernanceProposals.Error()))
+		assert.True(t, strings.Contains(response.Error, expectedErr.Error()))
+	})
+
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		expectedProposal := &common.GovernanceProposalAPIResponse{
+			Nonce:      7,
+			CommitHash: "hash",
+			Status:     "passed",
+			Closed:     true,
+			Passed:     true,
+		}
+		facade := &mock.FacadeStub{
+			GetGovernanceProposalCalled: func(nonce uint64) (*common.GovernanceProposalAPIResponse, error) {
+				assert.Equal(t, uint64(7), nonce)
+				return expectedProposal, nil
+			},
+		}
+
+		response := &governanceProposalResponse{}
+		loadNetworkGroupResponse(
+			t,
+			facade,
+			"/network/governance/proposal/7",
+			"GET",
+			nil,
+			response,
+		)
+		assert.Equal(t, expectedProposal, response.Data.Proposal)
+	})
+}
+
+func TestGetGovernanceVotes(t *testing.T) {
+	t.Parallel()
+
+	t.Run("facade error, should fail", func(t *testing.T) {
+		t.Parallel()
+
+		facade := mock.FacadeStub{
+			GetGovernanceVotesCalled: func(address string) (*common.GovernanceVotesAPIResponse, error) {
+				return nil, expectedErr
+			},
+		}
+
+		networkGroup, err := groups.NewNetworkGroup(&facade)
+		require.NoError(t, err)
+
+		ws := startWebServer(networkGroup, "network", getNetworkRoutesConfig())
+
+		req, _ := http.NewRequest("GET", "/network/governance/votes/erd1alice", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := governanceVotesResponse{}
+		loadResponse(resp.Body, &response)
+
+		assert.Equal(t, http.StatusInternalServerError, resp.Code)
+		assert.True(t, strings.Contains(response.Error, apiErrors.ErrGetGovernanceVotes.Error()))
+		assert.True(t, strings.Contains(response.Error, expectedErr.Error()))
+	})
+
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		expectedVotes := &common.GovernanceVotesAPIResponse{
+			Address:     "erd1alice",
+			VotingPower: "100",
+			DirectVotes: []*common.GovernanceVoteAPIResponse{
+				{ProposalNonce: 1, CommitHash: "hash", Status: "active"},
+			},
+			DelegatedVotes: []*common.GovernanceVoteAPIResponse{},
+			DelegatedVotingPower: []*common.GovernanceDelegatedVotingPowerAPIResponse{
+				{ProposalNonce: 1, TotalPower: "50", UsedPower: "10", TotalStake: "50", UsedStake: "10"},
+			},
+		}
+		facade := &mock.FacadeStub{
+			GetGovernanceVotesCalled: func(address string) (*common.GovernanceVotesAPIResponse, error) {
+				assert.Equal(t, "erd1alice", address)
+				return expectedVotes, nil
+			},
+		}
+
+		response := &governanceVotesResponse{}
+		loadNetworkGroupResponse(
+			t,
+			facade,
+			"/network/governance/votes/erd1alice",
+			"GET",
+			nil,
+			response,
+		)
+		assert.Equal(t, expectedVotes, response.Data.Votes)
+	})
+}
+
 func TestNetworkGroup_UpdateFacade(t *testing.T) {
 	t.Parallel()
 
@@ -1020,6 +1637,13 @@ func getNetworkRoutesConfig() config.ApiRoutesConfig {
 					{Name: "/genesis-balances", Open: true},
 					{Name: "/ratings", Open: true},
 					{Name: "/gas-configs", Open: true},
+					{Name: "/gas-price-suggestion", Open: true},
+					{Name: "/trie-statistics", Open: true},
+					{Name: "/delegation/contract/:address", Open: true},
+					{Name: "/delegation/positions/:address", Open: true},
+					{Name: "/governance/proposals", Open: true},
+					{Name: "/governance/proposal/:nonce", Open: true},
+					{Name: "/governance/votes/:address", Open: true},
 				},
 			},
 		},
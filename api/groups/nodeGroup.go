@@ -2,6 +2,7 @@ package groups
 
 import (
 	"fmt"
+	"math"
 	"net/http"
 	"sync"
 
@@ -33,6 +34,10 @@ const (
 	eligibleManagedKeys       = "/managed-keys/eligible"
 	waitingManagedKeys        = "/managed-keys/waiting"
 	epochsLeftInWaiting       = "/waiting-epochs-left/:key"
+	outportReindexPath        = "/outport-reindex"
+	outportReindexStatusPath  = "/outport-reindex/status"
+	consensusRoundsPath       = "/consensus/rounds"
+	urlParamLast              = "last"
 )
 
 // nodeFacadeHandler defines the methods to be implemented by a facade for node requests
@@ -49,6 +54,9 @@ type nodeFacadeHandler interface {
 	GetEligibleManagedKeys() ([]string, error)
 	GetWaitingManagedKeys() ([]string, error)
 	GetWaitingEpochsLeftForPublicKey(publicKey string) (uint32, error)
+	StartOutportReindexing(startNonce uint64, endNonce uint64) error
+	GetOutportReindexingStatus() (common.OutportReindexStatus, error)
+	GetConsensusRoundsTimeline(numRounds uint32) ([]*common.ConsensusRoundTimeline, error)
 	IsInterfaceNil() bool
 }
 
@@ -58,6 +66,12 @@ type QueryDebugRequest struct {
 	Search string `form:"search" json:"search"`
 }
 
+// OutportReindexRequest represents the structure on which user input for starting an outport re-indexing will validate against
+type OutportReindexRequest struct {
+	StartNonce uint64 `form:"startNonce" json:"startNonce"`
+	EndNonce   uint64 `form:"endNonce" json:"endNonce"`
+}
+
 type nodeGroup struct {
 	*baseGroup
 	facade    nodeFacadeHandler
@@ -151,6 +165,21 @@ func NewNodeGroup(facade nodeFacadeHandler) (*nodeGroup, error) {
 			Method:  http.MethodGet,
 			Handler: ng.waitingEpochsLeft,
 		},
+		{
+			Path:    outportReindexPath,
+			Method:  http.MethodPost,
+			Handler: ng.startOutportReindexing,
+		},
+		{
+			Path:    outportReindexStatusPath,
+			Method:  http.MethodGet,
+			Handler: ng.outportReindexingStatus,
+		},
+		{
+			Path:    consensusRoundsPath,
+			Method:  http.MethodGet,
+			Handler: ng.consensusRounds,
+		},
 	}
 	ng.endpoints = endpoints
 
@@ -479,6 +508,65 @@ func (ng *nodeGroup) waitingEpochsLeft(c *gin.Context) {
 	shared.RespondWithSuccess(c, gin.H{"epochsLeft": epochsLeft})
 }
 
+// startOutportReindexing starts pushing the blocks in the requested nonce range to the outport drivers
+func (ng *nodeGroup) startOutportReindexing(c *gin.Context) {
+	var request = OutportReindexRequest{}
+	err := c.ShouldBindJSON(&request)
+	if err != nil {
+		c.JSON(
+			http.StatusBadRequest,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: fmt.Sprintf("%s: %s", errors.ErrValidation.Error(), err.Error()),
+				Code:  shared.ReturnCodeRequestError,
+			},
+		)
+		return
+	}
+
+	err = ng.getFacade().StartOutportReindexing(request.StartNonce, request.EndNonce)
+	if err != nil {
+		shared.RespondWithInternalError(c, errors.ErrStartOutportReindexing, err)
+		return
+	}
+
+	shared.RespondWithSuccess(c, gin.H{"status": "started"})
+}
+
+// outportReindexingStatus returns the progress of the last outport re-indexing job
+func (ng *nodeGroup) outportReindexingStatus(c *gin.Context) {
+	status, err := ng.getFacade().GetOutportReindexingStatus()
+	if err != nil {
+		shared.RespondWithInternalError(c, errors.ErrGetOutportReindexingStatus, err)
+		return
+	}
+
+	shared.RespondWithSuccess(c, gin.H{"status": status})
+}
+
+// consensusRounds returns the consensus timelines of the last rounds recorded by the node. All the recorded rounds
+// are returned if the number of rounds is not provided
+func (ng *nodeGroup) consensusRounds(c *gin.Context) {
+	last, err := parseUint32UrlParam(c, urlParamLast)
+	if err != nil {
+		shared.RespondWithValidationError(c, errors.ErrBadUrlParams, err)
+		return
+	}
+
+	numRounds := uint32(math.MaxUint32)
+	if last.HasValue {
+		numRounds = last.Value
+	}
+
+	timelines, err := ng.getFacade().GetConsensusRoundsTimeline(numRounds)
+	if err != nil {
+		shared.RespondWithInternalError(c, errors.ErrGetConsensusRoundsTimeline, err)
+		return
+	}
+
+	shared.RespondWithSuccess(c, gin.H{"rounds": timelines})
+}
+
 func (ng *nodeGroup) getFacade() nodeFacadeHandler {
 	ng.mutFacade.RLock()
 	defer ng.mutFacade.RUnlock()
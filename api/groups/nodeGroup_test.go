@@ -6,6 +6,7 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"math"
 	"net/http"
 	"net/http/httptest"
 	"strings"
@@ -109,6 +110,20 @@ type waitingEpochsLeftResponse struct {
 	generalResponse
 }
 
+type outportReindexStatusResponse struct {
+	Data struct {
+		Status common.OutportReindexStatus `json:"status"`
+	} `json:"data"`
+	generalResponse
+}
+
+type consensusRoundsResponse struct {
+	Data struct {
+		Rounds []*common.ConsensusRoundTimeline `json:"rounds"`
+	} `json:"data"`
+	generalResponse
+}
+
 func init() {
 	gin.SetMode(gin.TestMode)
 }
@@ -974,6 +989,261 @@ func TestNodeGroup_WaitingEpochsLeft(t *testing.T) {
 	})
 }
 
+func TestNodeGroup_StartOutportReindexing(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid request should error", func(t *testing.T) {
+		t.Parallel()
+
+		nodeGroup, err := groups.NewNodeGroup(&mock.FacadeStub{})
+		require.NoError(t, err)
+
+		ws := startWebServer(nodeGroup, "node", getNodeRoutesConfig())
+
+		req, _ := http.NewRequest("POST", "/node/outport-reindex", bytes.NewBuffer([]byte("invalid json")))
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := &shared.GenericAPIResponse{}
+		loadResponse(resp.Body, response)
+
+		assert.Equal(t, http.StatusBadRequest, resp.Code)
+		assert.True(t, strings.Contains(response.Error, apiErrors.ErrValidation.Error()))
+	})
+	t.Run("facade error should error", func(t *testing.T) {
+		t.Parallel()
+
+		facade := mock.FacadeStub{
+			StartOutportReindexingCalled: func(startNonce uint64, endNonce uint64) error {
+				return expectedErr
+			},
+		}
+
+		nodeGroup, err := groups.NewNodeGroup(&facade)
+		require.NoError(t, err)
+
+		ws := startWebServer(nodeGroup, "node", getNodeRoutesConfig())
+
+		request := groups.OutportReindexRequest{StartNonce: 1, EndNonce: 10}
+		requestBytes, _ := json.Marshal(request)
+		req, _ := http.NewRequest("POST", "/node/outport-reindex", bytes.NewBuffer(requestBytes))
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := &shared.GenericAPIResponse{}
+		loadResponse(resp.Body, response)
+
+		assert.Equal(t, http.StatusInternalServerError, resp.Code)
+		assert.True(t, strings.Contains(response.Error, expectedErr.Error()))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		wasCalled := false
+		facade := mock.FacadeStub{
+			StartOutportReindexingCalled: func(startNonce uint64, endNonce uint64) error {
+				assert.Equal(t, uint64(1), startNonce)
+				assert.Equal(t, uint64(10), endNonce)
+				wasCalled = true
+				return nil
+			},
+		}
+
+		nodeGroup, err := groups.NewNodeGroup(&facade)
+		require.NoError(t, err)
+
+		ws := startWebServer(nodeGroup, "node", getNodeRoutesConfig())
+
+		request := groups.OutportReindexRequest{StartNonce: 1, EndNonce: 10}
+		requestBytes, _ := json.Marshal(request)
+		req, _ := http.NewRequest("POST", "/node/outport-reindex", bytes.NewBuffer(requestBytes))
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := &shared.GenericAPIResponse{}
+		loadResponse(resp.Body, response)
+
+		assert.Equal(t, http.StatusOK, resp.Code)
+		assert.Equal(t, "", response.Error)
+		assert.True(t, wasCalled)
+	})
+}
+
+func TestNodeGroup_OutportReindexingStatus(t *testing.T) {
+	t.Parallel()
+
+	t.Run("facade error should error", func(t *testing.T) {
+		t.Parallel()
+
+		facade := mock.FacadeStub{
+			GetOutportReindexingStatusCalled: func() (common.OutportReindexStatus, error) {
+				return common.OutportReindexStatus{}, expectedErr
+			},
+		}
+
+		nodeGroup, err := groups.NewNodeGroup(&facade)
+		require.NoError(t, err)
+
+		ws := startWebServer(nodeGroup, "node", getNodeRoutesConfig())
+
+		req, _ := http.NewRequest("GET", "/node/outport-reindex/status", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := &shared.GenericAPIResponse{}
+		loadResponse(resp.Body, response)
+
+		assert.Equal(t, http.StatusInternalServerError, resp.Code)
+		assert.True(t, strings.Contains(response.Error, expectedErr.Error()))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		providedStatus := common.OutportReindexStatus{
+			Status:           "in progress",
+			StartNonce:       1,
+			EndNonce:         10,
+			LastIndexedNonce: 3,
+			NumIndexedBlocks: 3,
+		}
+		facade := mock.FacadeStub{
+			GetOutportReindexingStatusCalled: func() (common.OutportReindexStatus, error) {
+				return providedStatus, nil
+			},
+		}
+
+		nodeGroup, err := groups.NewNodeGroup(&facade)
+		require.NoError(t, err)
+
+		ws := startWebServer(nodeGroup, "node", getNodeRoutesConfig())
+
+		req, _ := http.NewRequest("GET", "/node/outport-reindex/status", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := &outportReindexStatusResponse{}
+		loadResponse(resp.Body, response)
+
+		assert.Equal(t, http.StatusOK, resp.Code)
+		assert.Equal(t, "", response.Error)
+		assert.Equal(t, providedStatus, response.Data.Status)
+	})
+}
+
+func TestNodeGroup_ConsensusRounds(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid last param should error", func(t *testing.T) {
+		t.Parallel()
+
+		facade := mock.FacadeStub{
+			GetConsensusRoundsTimelineCalled: func(numRounds uint32) ([]*common.ConsensusRoundTimeline, error) {
+				assert.Fail(t, "should have not been called")
+				return nil, nil
+			},
+		}
+
+		nodeGroup, err := groups.NewNodeGroup(&facade)
+		require.NoError(t, err)
+
+		ws := startWebServer(nodeGroup, "node", getNodeRoutesConfig())
+
+		req, _ := http.NewRequest("GET", "/node/consensus/rounds?last=not-a-number", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := &shared.GenericAPIResponse{}
+		loadResponse(resp.Body, response)
+
+		assert.Equal(t, http.StatusBadRequest, resp.Code)
+		assert.True(t, strings.Contains(response.Error, apiErrors.ErrBadUrlParams.Error()))
+	})
+	t.Run("facade error should error", func(t *testing.T) {
+		t.Parallel()
+
+		facade := mock.FacadeStub{
+			GetConsensusRoundsTimelineCalled: func(numRounds uint32) ([]*common.ConsensusRoundTimeline, error) {
+				return nil, expectedErr
+			},
+		}
+
+		nodeGroup, err := groups.NewNodeGroup(&facade)
+		require.NoError(t, err)
+
+		ws := startWebServer(nodeGroup, "node", getNodeRoutesConfig())
+
+		req, _ := http.NewRequest("GET", "/node/consensus/rounds?last=5", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := &shared.GenericAPIResponse{}
+		loadResponse(resp.Body, response)
+
+		assert.Equal(t, http.StatusInternalServerError, resp.Code)
+		assert.True(t, strings.Contains(response.Error, expectedErr.Error()))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		providedTimelines := []*common.ConsensusRoundTimeline{
+			{
+				Round:                 10,
+				RoundStartTimestampMs: 1000,
+				Subrounds: []*common.ConsensusSubroundTiming{
+					{Name: "(BLOCK)", StartOffsetMs: 5, EndOffsetMs: 300, Finished: true},
+				},
+				Header:               &common.ConsensusMessageArrival{From: "aa", OffsetMs: 120, Hash: "bb"},
+				Signatures:           []*common.ConsensusMessageArrival{{From: "cc", OffsetMs: 400}},
+				ProcessingDurationMs: 150,
+				Outcome:              "completed",
+			},
+		}
+		facade := mock.FacadeStub{
+			GetConsensusRoundsTimelineCalled: func(numRounds uint32) ([]*common.ConsensusRoundTimeline, error) {
+				assert.Equal(t, uint32(5), numRounds)
+				return providedTimelines, nil
+			},
+		}
+
+		nodeGroup, err := groups.NewNodeGroup(&facade)
+		require.NoError(t, err)
+
+		ws := startWebServer(nodeGroup, "node", getNodeRoutesConfig())
+
+		req, _ := http.NewRequest("GET", "/node/consensus/rounds?last=5", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := &consensusRoundsResponse{}
+		loadResponse(resp.Body, response)
+
+		assert.Equal(t, http.StatusOK, resp.Code)
+		assert.Equal(t, "", response.Error)
+		assert.Equal(t, providedTimelines, response.Data.Rounds)
+	})
+	t.Run("missing last param should return all the recorded rounds", func(t *testing.T) {
+		t.Parallel()
+
+		facade := mock.FacadeStub{
+			GetConsensusRoundsTimelineCalled: func(numRounds uint32) ([]*common.ConsensusRoundTimeline, error) {
+				assert.Equal(t, uint32(math.MaxUint32), numRounds)
+				return make([]*common.ConsensusRoundTimeline, 0), nil
+			},
+		}
+
+		nodeGroup, err := groups.NewNodeGroup(&facade)
+		require.NoError(t, err)
+
+		ws := startWebServer(nodeGroup, "node", getNodeRoutesConfig())
+
+		req, _ := http.NewRequest("GET", "/node/consensus/rounds", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		assert.Equal(t, http.StatusOK, resp.Code)
+	})
+}
+
 func TestNodeGroup_UpdateFacade(t *testing.T) {
 	t.Parallel()
 
@@ -1087,6 +1357,9 @@ func getNodeRoutesConfig() config.ApiRoutesConfig {
 					{Name: "/managed-keys/eligible", Open: true},
 					{Name: "/managed-keys/waiting", Open: true},
 					{Name: "/waiting-epochs-left/:key", Open: true},
+					{Name: "/outport-reindex", Open: true},
+					{Name: "/outport-reindex/status", Open: true},
+					{Name: "/consensus/rounds", Open: true},
 				},
 			},
 		},
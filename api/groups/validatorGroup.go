@@ -6,6 +6,7 @@ import (
 	"sync"
 
 	"github.com/gin-gonic/gin"
+	"github.com/multiversx/mx-chain-core-go/core"
 	"github.com/multiversx/mx-chain-core-go/core/check"
 	"github.com/multiversx/mx-chain-core-go/data/validator"
 	"github.com/multiversx/mx-chain-go/api/errors"
@@ -14,17 +15,32 @@ import (
 )
 
 const (
-	statisticsPath = "/statistics"
-	auctionPath    = "/auction"
+	statisticsPath       = "/statistics"
+	auctionPath          = "/auction"
+	auctionSimulatePath  = "/auction/simulate"
+	rewardsHistoryPath   = "/rewards/:blsKey"
+	ratingsHistoryPath   = "/:blsKey/history"
+	shufflingPreviewPath = "/shuffling/preview"
+	urlParamFromEpoch    = "fromEpoch"
+	urlParamToEpoch      = "toEpoch"
 )
 
 // validatorFacadeHandler defines the methods to be implemented by a facade for validator requests
 type validatorFacadeHandler interface {
 	ValidatorStatisticsApi() (map[string]*validator.ValidatorStatistics, error)
 	AuctionListApi() ([]*common.AuctionListValidatorAPIResponse, error)
+	SimulateAuctionApi(changes []*common.AuctionSimulationOwnerChange) (*common.AuctionSimulationAPIResponse, error)
+	GetNodesShufflingPreview() (*common.NodesShufflingPreviewAPIResponse, error)
+	GetValidatorRewardsHistory(blsKey string, fromEpoch core.OptionalUint32, toEpoch core.OptionalUint32) ([]*common.ValidatorRewardsBreakdown, error)
+	GetValidatorRatingsHistory(blsKey string, fromEpoch core.OptionalUint32, toEpoch core.OptionalUint32) ([]*common.ValidatorRatingHistory, error)
 	IsInterfaceNil() bool
 }
 
+// AuctionSimulationRequest represents the structure of the auction simulation request
+type AuctionSimulationRequest struct {
+	Changes []*common.AuctionSimulationOwnerChange `json:"changes"`
+}
+
 type validatorGroup struct {
 	*baseGroup
 	facade    validatorFacadeHandler
@@ -53,6 +69,26 @@ func NewValidatorGroup(facade validatorFacadeHandler) (*validatorGroup, error) {
 			Method:  http.MethodGet,
 			Handler: ng.auction,
 		},
+		{
+			Path:    auctionSimulatePath,
+			Method:  http.MethodPost,
+			Handler: ng.simulateAuction,
+		},
+		{
+			Path:    rewardsHistoryPath,
+			Method:  http.MethodGet,
+			Handler: ng.rewardsHistory,
+		},
+		{
+			Path:    shufflingPreviewPath,
+			Method:  http.MethodGet,
+			Handler: ng.shufflingPreview,
+		},
+		{
+			Path:    ratingsHistoryPath,
+			Method:  http.MethodGet,
+			Handler: ng.ratingsHistory,
+		},
 	}
 	ng.endpoints = endpoints
 
@@ -109,6 +145,120 @@ func (vg *validatorGroup) auction(c *gin.Context) {
 	)
 }
 
+// simulateAuction will return the outcome of the auction selection after applying the requested owners changes
+func (vg *validatorGroup) simulateAuction(c *gin.Context) {
+	request := AuctionSimulationRequest{}
+	err := c.ShouldBindJSON(&request)
+	if err != nil {
+		c.JSON(
+			http.StatusBadRequest,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: fmt.Sprintf("%s: %s", errors.ErrValidation.Error(), err.Error()),
+				Code:  shared.ReturnCodeRequestError,
+			},
+		)
+		return
+	}
+
+	simulation, err := vg.getFacade().SimulateAuctionApi(request.Changes)
+	if err != nil {
+		c.JSON(
+			http.StatusBadRequest,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: fmt.Sprintf("%s: %s", errors.ErrAuctionSimulation.Error(), err.Error()),
+				Code:  shared.ReturnCodeRequestError,
+			},
+		)
+		return
+	}
+
+	c.JSON(
+		http.StatusOK,
+		shared.GenericAPIResponse{
+			Data:  gin.H{"simulation": simulation},
+			Error: "",
+			Code:  shared.ReturnCodeSuccess,
+		},
+	)
+}
+
+// rewardsHistory will return the rewards breakdown of a validator for each epoch in the requested range
+func (vg *validatorGroup) rewardsHistory(c *gin.Context) {
+	blsKey, fromEpoch, toEpoch, ok := getValidatorHistoryParams(c, errors.ErrGetValidatorRewardsHistory)
+	if !ok {
+		return
+	}
+
+	history, err := vg.getFacade().GetValidatorRewardsHistory(blsKey, fromEpoch, toEpoch)
+	if err != nil {
+		shared.RespondWithValidationError(c, errors.ErrGetValidatorRewardsHistory, err)
+		return
+	}
+
+	c.JSON(
+		http.StatusOK,
+		shared.GenericAPIResponse{
+			Data:  gin.H{"rewards": history},
+			Error: "",
+			Code:  shared.ReturnCodeSuccess,
+		},
+	)
+}
+
+// shufflingPreview will return the nodes lists of each shard projected for the next epoch
+func (vg *validatorGroup) shufflingPreview(c *gin.Context) {
+	preview, err := vg.getFacade().GetNodesShufflingPreview()
+	if err != nil {
+		shared.RespondWithInternalError(c, errors.ErrGetNodesShufflingPreview, err)
+		return
+	}
+
+	shared.RespondWith(c, http.StatusOK, gin.H{"preview": preview}, "", shared.ReturnCodeSuccess)
+}
+
+// ratingsHistory will return the start and end ratings of a validator for each epoch in the requested range, along
+// with the counters and the jail events that changed them
+func (vg *validatorGroup) ratingsHistory(c *gin.Context) {
+	blsKey, fromEpoch, toEpoch, ok := getValidatorHistoryParams(c, errors.ErrGetValidatorRatingsHistory)
+	if !ok {
+		return
+	}
+
+	history, err := vg.getFacade().GetValidatorRatingsHistory(blsKey, fromEpoch, toEpoch)
+	if err != nil {
+		shared.RespondWithValidationError(c, errors.ErrGetValidatorRatingsHistory, err)
+		return
+	}
+
+	shared.RespondWith(c, http.StatusOK, gin.H{"history": history}, "", shared.ReturnCodeSuccess)
+}
+
+// getValidatorHistoryParams returns the BLS key and the epochs range of a validator history request, responding with
+// a validation error if any of them is invalid
+func getValidatorHistoryParams(c *gin.Context, baseErr error) (string, core.OptionalUint32, core.OptionalUint32, bool) {
+	blsKey := c.Param("blsKey")
+	if blsKey == "" {
+		shared.RespondWithValidationError(c, baseErr, errors.ErrValidationEmptyBlsKey)
+		return "", core.OptionalUint32{}, core.OptionalUint32{}, false
+	}
+
+	fromEpoch, err := parseUint32UrlParam(c, urlParamFromEpoch)
+	if err != nil {
+		shared.RespondWithValidationError(c, baseErr, fmt.Errorf("%w for %s", errors.ErrBadUrlParams, urlParamFromEpoch))
+		return "", core.OptionalUint32{}, core.OptionalUint32{}, false
+	}
+
+	toEpoch, err := parseUint32UrlParam(c, urlParamToEpoch)
+	if err != nil {
+		shared.RespondWithValidationError(c, baseErr, fmt.Errorf("%w for %s", errors.ErrBadUrlParams, urlParamToEpoch))
+		return "", core.OptionalUint32{}, core.OptionalUint32{}, false
+	}
+
+	return blsKey, fromEpoch, toEpoch, true
+}
+
 func (vg *validatorGroup) getFacade() validatorFacadeHandler {
 	vg.mutFacade.RLock()
 	defer vg.mutFacade.RUnlock()
@@ -1,12 +1,15 @@
 package groups_test
 
 import (
+	"bytes"
 	"encoding/json"
 	"errors"
 	"net/http"
 	"net/http/httptest"
+	"strings"
 	"testing"
 
+	"github.com/multiversx/mx-chain-core-go/core"
 	"github.com/multiversx/mx-chain-core-go/data/validator"
 	apiErrors "github.com/multiversx/mx-chain-go/api/errors"
 	"github.com/multiversx/mx-chain-go/api/groups"
@@ -47,6 +50,34 @@ type auctionListResponse struct {
 	Error string
 }
 
+type auctionSimulationResponse struct {
+	Data struct {
+		Result *common.AuctionSimulationAPIResponse `json:"simulation"`
+	} `json:"data"`
+	Error string
+}
+
+type rewardsHistoryResponse struct {
+	Data struct {
+		Result []*common.ValidatorRewardsBreakdown `json:"rewards"`
+	} `json:"data"`
+	Error string
+}
+
+type shufflingPreviewResponse struct {
+	Data struct {
+		Result *common.NodesShufflingPreviewAPIResponse `json:"preview"`
+	} `json:"data"`
+	Error string
+}
+
+type ratingsHistoryResponse struct {
+	Data struct {
+		Result []*common.ValidatorRatingHistory `json:"history"`
+	} `json:"data"`
+	Error string
+}
+
 func TestValidatorStatistics_ErrorWhenFacadeFails(t *testing.T) {
 	t.Parallel()
 
@@ -256,6 +287,326 @@ func TestAuctionList_ReturnsSuccessfully(t *testing.T) {
 	assert.Equal(t, response.Data.Result, auctionListToReturn)
 }
 
+func TestAuctionSimulation_InvalidBodyShouldErr(t *testing.T) {
+	t.Parallel()
+
+	validatorGroup, err := groups.NewValidatorGroup(&mock.FacadeStub{})
+	require.NoError(t, err)
+
+	ws := startWebServer(validatorGroup, "validator", getValidatorRoutesConfig())
+	req, _ := http.NewRequest("POST", "/validator/auction/simulate", bytes.NewBufferString("invalid"))
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := auctionSimulationResponse{}
+	loadResponse(resp.Body, &response)
+
+	assert.Equal(t, http.StatusBadRequest, resp.Code)
+	assert.True(t, strings.Contains(response.Error, apiErrors.ErrValidation.Error()))
+}
+
+func TestAuctionSimulation_ErrorWhenFacadeFails(t *testing.T) {
+	t.Parallel()
+
+	errStr := "error in facade"
+	facade := mock.FacadeStub{
+		SimulateAuctionHandler: func(changes []*common.AuctionSimulationOwnerChange) (*common.AuctionSimulationAPIResponse, error) {
+			return nil, errors.New(errStr)
+		},
+	}
+
+	validatorGroup, err := groups.NewValidatorGroup(&facade)
+	require.NoError(t, err)
+
+	ws := startWebServer(validatorGroup, "validator", getValidatorRoutesConfig())
+	req, _ := http.NewRequest("POST", "/validator/auction/simulate", bytes.NewBufferString(`{"changes":[]}`))
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := auctionSimulationResponse{}
+	loadResponse(resp.Body, &response)
+
+	assert.Equal(t, http.StatusBadRequest, resp.Code)
+	assert.Contains(t, response.Error, apiErrors.ErrAuctionSimulation.Error())
+	assert.Contains(t, response.Error, errStr)
+}
+
+func TestAuctionSimulation_ReturnsSuccessfully(t *testing.T) {
+	t.Parallel()
+
+	expectedChanges := []*common.AuctionSimulationOwnerChange{
+		{
+			Owner:      "owner",
+			AddedStake: "-2500",
+			AddedNodes: -1,
+		},
+	}
+	simulationToReturn := &common.AuctionSimulationAPIResponse{
+		AvailableSlots:    2,
+		MinQualifiedTopUp: "100",
+		SelectedNodes:     []string{"node1", "node2"},
+		UnselectedNodes:   []string{"node3"},
+		AuctionList: []*common.AuctionListValidatorAPIResponse{
+			{
+				Owner:          "owner",
+				NumStakedNodes: 3,
+				TotalTopUp:     "300",
+				TopUpPerNode:   "100",
+				QualifiedTopUp: "150",
+			},
+		},
+	}
+	facade := mock.FacadeStub{
+		SimulateAuctionHandler: func(changes []*common.AuctionSimulationOwnerChange) (*common.AuctionSimulationAPIResponse, error) {
+			require.Equal(t, expectedChanges, changes)
+			return simulationToReturn, nil
+		},
+	}
+
+	validatorGroup, err := groups.NewValidatorGroup(&facade)
+	require.NoError(t, err)
+
+	body, _ := json.Marshal(&groups.AuctionSimulationRequest{Changes: expectedChanges})
+	ws := startWebServer(validatorGroup, "validator", getValidatorRoutesConfig())
+	req, _ := http.NewRequest("POST", "/validator/auction/simulate", bytes.NewBuffer(body))
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := auctionSimulationResponse{}
+	loadResponse(resp.Body, &response)
+
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, simulationToReturn, response.Data.Result)
+}
+
+func TestRewardsHistory_InvalidEpochShouldErr(t *testing.T) {
+	t.Parallel()
+
+	validatorGroup, err := groups.NewValidatorGroup(&mock.FacadeStub{})
+	require.NoError(t, err)
+
+	ws := startWebServer(validatorGroup, "validator", getValidatorRoutesConfig())
+	req, _ := http.NewRequest("GET", "/validator/rewards/abcd?fromEpoch=x", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := rewardsHistoryResponse{}
+	loadResponse(resp.Body, &response)
+
+	assert.Equal(t, http.StatusBadRequest, resp.Code)
+	assert.Contains(t, response.Error, apiErrors.ErrBadUrlParams.Error())
+}
+
+func TestRewardsHistory_ErrorWhenFacadeFails(t *testing.T) {
+	t.Parallel()
+
+	errStr := "error in facade"
+	facade := mock.FacadeStub{
+		GetValidatorRewardsHistoryHandler: func(blsKey string, fromEpoch core.OptionalUint32, toEpoch core.OptionalUint32) ([]*common.ValidatorRewardsBreakdown, error) {
+			return nil, errors.New(errStr)
+		},
+	}
+
+	validatorGroup, err := groups.NewValidatorGroup(&facade)
+	require.NoError(t, err)
+
+	ws := startWebServer(validatorGroup, "validator", getValidatorRoutesConfig())
+	req, _ := http.NewRequest("GET", "/validator/rewards/abcd", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := rewardsHistoryResponse{}
+	loadResponse(resp.Body, &response)
+
+	assert.Equal(t, http.StatusBadRequest, resp.Code)
+	assert.Contains(t, response.Error, apiErrors.ErrGetValidatorRewardsHistory.Error())
+	assert.Contains(t, response.Error, errStr)
+}
+
+func TestRewardsHistory_ReturnsSuccessfully(t *testing.T) {
+	t.Parallel()
+
+	historyToReturn := []*common.ValidatorRewardsBreakdown{
+		{
+			Epoch:        4,
+			BlsKey:       "abcd",
+			BaseReward:   "100",
+			TopUpReward:  "10",
+			LeaderFees:   "5",
+			TotalRewards: "115",
+		},
+	}
+	facade := mock.FacadeStub{
+		GetValidatorRewardsHistoryHandler: func(blsKey string, fromEpoch core.OptionalUint32, toEpoch core.OptionalUint32) ([]*common.ValidatorRewardsBreakdown, error) {
+			assert.Equal(t, "abcd", blsKey)
+			assert.Equal(t, core.OptionalUint32{Value: 3, HasValue: true}, fromEpoch)
+			assert.Equal(t, core.OptionalUint32{}, toEpoch)
+			return historyToReturn, nil
+		},
+	}
+
+	validatorGroup, err := groups.NewValidatorGroup(&facade)
+	require.NoError(t, err)
+
+	ws := startWebServer(validatorGroup, "validator", getValidatorRoutesConfig())
+	req, _ := http.NewRequest("GET", "/validator/rewards/abcd?fromEpoch=3", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := rewardsHistoryResponse{}
+	loadResponse(resp.Body, &response)
+
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, historyToReturn, response.Data.Result)
+}
+
+func TestShufflingPreview_ErrorWhenFacadeFails(t *testing.T) {
+	t.Parallel()
+
+	errStr := "error in facade"
+	facade := mock.FacadeStub{
+		GetNodesShufflingPreviewHandler: func() (*common.NodesShufflingPreviewAPIResponse, error) {
+			return nil, errors.New(errStr)
+		},
+	}
+
+	validatorGroup, err := groups.NewValidatorGroup(&facade)
+	require.NoError(t, err)
+
+	ws := startWebServer(validatorGroup, "validator", getValidatorRoutesConfig())
+	req, _ := http.NewRequest("GET", "/validator/shuffling/preview", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := shufflingPreviewResponse{}
+	loadResponse(resp.Body, &response)
+
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	assert.Contains(t, response.Error, apiErrors.ErrGetNodesShufflingPreview.Error())
+	assert.Contains(t, response.Error, errStr)
+}
+
+func TestShufflingPreview_ReturnsSuccessfully(t *testing.T) {
+	t.Parallel()
+
+	previewToReturn := &common.NodesShufflingPreviewAPIResponse{
+		IsProjection:    true,
+		RandomnessKnown: true,
+		Epoch:           5,
+		Shards: map[uint32]*common.NodesShufflingPreviewShardAPIResponse{
+			0: {
+				Eligible:    []string{"pk1"},
+				Waiting:     []string{"pk2"},
+				Leaving:     []string{},
+				ShuffledOut: []string{"pk3"},
+			},
+		},
+	}
+	facade := mock.FacadeStub{
+		GetNodesShufflingPreviewHandler: func() (*common.NodesShufflingPreviewAPIResponse, error) {
+			return previewToReturn, nil
+		},
+	}
+
+	validatorGroup, err := groups.NewValidatorGroup(&facade)
+	require.NoError(t, err)
+
+	ws := startWebServer(validatorGroup, "validator", getValidatorRoutesConfig())
+	req, _ := http.NewRequest("GET", "/validator/shuffling/preview", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := shufflingPreviewResponse{}
+	loadResponse(resp.Body, &response)
+
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, previewToReturn, response.Data.Result)
+}
+
+func TestRatingsHistory_InvalidEpochShouldErr(t *testing.T) {
+	t.Parallel()
+
+	validatorGroup, err := groups.NewValidatorGroup(&mock.FacadeStub{})
+	require.NoError(t, err)
+
+	ws := startWebServer(validatorGroup, "validator", getValidatorRoutesConfig())
+	req, _ := http.NewRequest("GET", "/validator/abcd/history?toEpoch=x", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := ratingsHistoryResponse{}
+	loadResponse(resp.Body, &response)
+
+	assert.Equal(t, http.StatusBadRequest, resp.Code)
+	assert.Contains(t, response.Error, apiErrors.ErrGetValidatorRatingsHistory.Error())
+	assert.Contains(t, response.Error, apiErrors.ErrBadUrlParams.Error())
+}
+
+func TestRatingsHistory_ErrorWhenFacadeFails(t *testing.T) {
+	t.Parallel()
+
+	errStr := "error in facade"
+	facade := mock.FacadeStub{
+		GetValidatorRatingsHistoryHandler: func(blsKey string, fromEpoch core.OptionalUint32, toEpoch core.OptionalUint32) ([]*common.ValidatorRatingHistory, error) {
+			return nil, errors.New(errStr)
+		},
+	}
+
+	validatorGroup, err := groups.NewValidatorGroup(&facade)
+	require.NoError(t, err)
+
+	ws := startWebServer(validatorGroup, "validator", getValidatorRoutesConfig())
+	req, _ := http.NewRequest("GET", "/validator/abcd/history", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := ratingsHistoryResponse{}
+	loadResponse(resp.Body, &response)
+
+	assert.Equal(t, http.StatusBadRequest, resp.Code)
+	assert.Contains(t, response.Error, apiErrors.ErrGetValidatorRatingsHistory.Error())
+	assert.Contains(t, response.Error, errStr)
+}
+
+func TestRatingsHistory_ReturnsSuccessfully(t *testing.T) {
+	t.Parallel()
+
+	historyToReturn := []*common.ValidatorRatingHistory{
+		{
+			Epoch:            4,
+			ShardID:          1,
+			BlsKey:           "abcd",
+			List:             "eligible",
+			StartRating:      50,
+			EndRating:        50.5,
+			LeaderSuccess:    2,
+			ValidatorSuccess: 50,
+		},
+	}
+	facade := mock.FacadeStub{
+		GetValidatorRatingsHistoryHandler: func(blsKey string, fromEpoch core.OptionalUint32, toEpoch core.OptionalUint32) ([]*common.ValidatorRatingHistory, error) {
+			assert.Equal(t, "abcd", blsKey)
+			assert.Equal(t, core.OptionalUint32{Value: 2, HasValue: true}, fromEpoch)
+			assert.Equal(t, core.OptionalUint32{Value: 5, HasValue: true}, toEpoch)
+			return historyToReturn, nil
+		},
+	}
+
+	validatorGroup, err := groups.NewValidatorGroup(&facade)
+	require.NoError(t, err)
+
+	ws := startWebServer(validatorGroup, "validator", getValidatorRoutesConfig())
+	req, _ := http.NewRequest("GET", "/validator/abcd/history?fromEpoch=2&toEpoch=5", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := ratingsHistoryResponse{}
+	loadResponse(resp.Body, &response)
+
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, historyToReturn, response.Data.Result)
+}
+
 func getValidatorRoutesConfig() config.ApiRoutesConfig {
 	return config.ApiRoutesConfig{
 		APIPackages: map[string]config.APIPackageConfig{
@@ -263,6 +614,10 @@ func getValidatorRoutesConfig() config.ApiRoutesConfig {
 				Routes: []config.RouteConfig{
 					{Name: "/statistics", Open: true},
 					{Name: "/auction", Open: true},
+					{Name: "/auction/simulate", Open: true},
+					{Name: "/rewards/:blsKey", Open: true},
+					{Name: "/:blsKey/history", Open: true},
+					{Name: "/shuffling/preview", Open: true},
 				},
 			},
 		},
@@ -72,6 +72,11 @@ type FacadeStub struct {
 	GetAllIssuedESDTsCalled                     func(tokenType string) ([]string, error)
 	GetDirectStakedListHandler                  func() ([]*api.DirectStakedValue, error)
 	GetDelegatorsListHandler                    func() ([]*api.Delegator, error)
+	GetDelegationContractCalled                 func(address string, page uint32, pageSize uint32) (*common.DelegationContractAPIResponse, error)
+	GetDelegationPositionsCalled                func(address string) (*common.DelegationPositionsAPIResponse, error)
+	GetGovernanceProposalsCalled                func() (*common.GovernanceProposalsAPIResponse, error)
+	GetGovernanceProposalCalled                 func(nonce uint64) (*common.GovernanceProposalAPIResponse, error)
+	GetGovernanceVotesCalled                    func(address string) (*common.GovernanceVotesAPIResponse, error)
 	GetProofCalled                              func(string, string) (*common.GetProofResponse, error)
 	GetProofCurrentRootHashCalled               func(string) (*common.GetProofResponse, error)
 	GetProofDataTrieCalled                      func(string, string, string) (*common.GetProofResponse, *common.GetProofResponse, error)
@@ -84,6 +89,12 @@ type FacadeStub struct {
 	GetLastPoolNonceForSenderCalled             func(sender string) (uint64, error)
 	GetTransactionsPoolNonceGapsForSenderCalled func(sender string) (*common.TransactionsPoolNonceGapsForSenderApiResponse, error)
 	GetGasConfigsCalled                         func() (map[string]map[string]uint64, error)
+	GetGasPriceSuggestionCalled                 func() (*common.GasPriceSuggestionAPIResponse, error)
+	GetTrieStatisticsCalled                     func(rootHash string) (*common.TrieStatisticsAPIResponse, error)
+	StartOutportReindexingCalled                func(startNonce uint64, endNonce uint64) error
+	GetOutportReindexingStatusCalled            func() (common.OutportReindexStatus, error)
+	GetConsensusRoundsTimelineCalled            func(numRounds uint32) ([]*common.ConsensusRoundTimeline, error)
+	GetDataTrieStatisticsCalled                 func(address string, options api.AccountQueryOptions) (*common.DataTrieStatisticsAPIResponse, api.BlockInfo, error)
 	RestApiInterfaceCalled                      func() string
 	RestAPIServerDebugModeCalled                func() bool
 	PprofEnabledCalled                          func() bool
@@ -97,6 +108,10 @@ type FacadeStub struct {
 	GetWaitingEpochsLeftForPublicKeyCalled      func(publicKey string) (uint32, error)
 	P2PPrometheusMetricsEnabledCalled           func() bool
 	AuctionListHandler                          func() ([]*common.AuctionListValidatorAPIResponse, error)
+	SimulateAuctionHandler                      func(changes []*common.AuctionSimulationOwnerChange) (*common.AuctionSimulationAPIResponse, error)
+	GetNodesShufflingPreviewHandler             func() (*common.NodesShufflingPreviewAPIResponse, error)
+	GetValidatorRewardsHistoryHandler           func(blsKey string, fromEpoch core.OptionalUint32, toEpoch core.OptionalUint32) ([]*common.ValidatorRewardsBreakdown, error)
+	GetValidatorRatingsHistoryHandler           func(blsKey string, fromEpoch core.OptionalUint32, toEpoch core.OptionalUint32) ([]*common.ValidatorRatingHistory, error)
 }
 
 // GetTokenSupply -
@@ -383,6 +398,42 @@ func (f *FacadeStub) AuctionListApi() ([]*common.AuctionListValidatorAPIResponse
 	return nil, nil
 }
 
+// SimulateAuctionApi is the mock implementation of a handler's SimulateAuctionApi method
+func (f *FacadeStub) SimulateAuctionApi(changes []*common.AuctionSimulationOwnerChange) (*common.AuctionSimulationAPIResponse, error) {
+	if f.SimulateAuctionHandler != nil {
+		return f.SimulateAuctionHandler(changes)
+	}
+
+	return nil, nil
+}
+
+// GetNodesShufflingPreview is the mock implementation of a handler's GetNodesShufflingPreview method
+func (f *FacadeStub) GetNodesShufflingPreview() (*common.NodesShufflingPreviewAPIResponse, error) {
+	if f.GetNodesShufflingPreviewHandler != nil {
+		return f.GetNodesShufflingPreviewHandler()
+	}
+
+	return nil, nil
+}
+
+// GetValidatorRewardsHistory is the mock implementation of a handler's GetValidatorRewardsHistory method
+func (f *FacadeStub) GetValidatorRewardsHistory(blsKey string, fromEpoch core.OptionalUint32, toEpoch core.OptionalUint32) ([]*common.ValidatorRewardsBreakdown, error) {
+	if f.GetValidatorRewardsHistoryHandler != nil {
+		return f.GetValidatorRewardsHistoryHandler(blsKey, fromEpoch, toEpoch)
+	}
+
+	return nil, nil
+}
+
+// GetValidatorRatingsHistory is the mock implementation of a handler's GetValidatorRatingsHistory method
+func (f *FacadeStub) GetValidatorRatingsHistory(blsKey string, fromEpoch core.OptionalUint32, toEpoch core.OptionalUint32) ([]*common.ValidatorRatingHistory, error) {
+	if f.GetValidatorRatingsHistoryHandler != nil {
+		return f.GetValidatorRatingsHistoryHandler(blsKey, fromEpoch, toEpoch)
+	}
+
+	return nil, nil
+}
+
 // ExecuteSCQuery is a mock implementation.
 func (f *FacadeStub) ExecuteSCQuery(query *process.SCQuery) (*vm.VMOutputApi, api.BlockInfo, error) {
 	if f.ExecuteSCQueryHandler != nil {
@@ -419,6 +470,33 @@ func (f *FacadeStub) GetDirectStakedList() ([]*api.DirectStakedValue, error) {
 	return nil, nil
 }
 
+// GetGovernanceProposals -
+func (f *FacadeStub) GetGovernanceProposals() (*common.GovernanceProposalsAPIResponse, error) {
+	if f.GetGovernanceProposalsCalled != nil {
+		return f.GetGovernanceProposalsCalled()
+	}
+
+	return nil, nil
+}
+
+// GetGovernanceProposal -
+func (f *FacadeStub) GetGovernanceProposal(nonce uint64) (*common.GovernanceProposalAPIResponse, error) {
+	if f.GetGovernanceProposalCalled != nil {
+		return f.GetGovernanceProposalCalled(nonce)
+	}
+
+	return nil, nil
+}
+
+// GetGovernanceVotes -
+func (f *FacadeStub) GetGovernanceVotes(address string) (*common.GovernanceVotesAPIResponse, error) {
+	if f.GetGovernanceVotesCalled != nil {
+		return f.GetGovernanceVotesCalled(address)
+	}
+
+	return nil, nil
+}
+
 // GetDelegatorsList -
 func (f *FacadeStub) GetDelegatorsList() ([]*api.Delegator, error) {
 	if f.GetDelegatorsListHandler != nil {
@@ -428,6 +506,24 @@ func (f *FacadeStub) GetDelegatorsList() ([]*api.Delegator, error) {
 	return nil, nil
 }
 
+// GetDelegationContract -
+func (f *FacadeStub) GetDelegationContract(address string, page uint32, pageSize uint32) (*common.DelegationContractAPIResponse, error) {
+	if f.GetDelegationContractCalled != nil {
+		return f.GetDelegationContractCalled(address, page, pageSize)
+	}
+
+	return nil, nil
+}
+
+// GetDelegationPositions -
+func (f *FacadeStub) GetDelegationPositions(address string) (*common.DelegationPositionsAPIResponse, error) {
+	if f.GetDelegationPositionsCalled != nil {
+		return f.GetDelegationPositionsCalled(address)
+	}
+
+	return nil, nil
+}
+
 // ComputeTransactionGasLimit -
 func (f *FacadeStub) ComputeTransactionGasLimit(tx *transaction.Transaction) (*transaction.CostResponse, error) {
 	if f.ComputeTransactionGasLimitHandler != nil {
@@ -651,6 +747,60 @@ func (f *FacadeStub) GetGasConfigs() (map[string]map[string]uint64, error) {
 	return nil, nil
 }
 
+// GetGasPriceSuggestion -
+func (f *FacadeStub) GetGasPriceSuggestion() (*common.GasPriceSuggestionAPIResponse, error) {
+	if f.GetGasPriceSuggestionCalled != nil {
+		return f.GetGasPriceSuggestionCalled()
+	}
+
+	return nil, nil
+}
+
+// GetTrieStatistics -
+func (f *FacadeStub) GetTrieStatistics(rootHash string) (*common.TrieStatisticsAPIResponse, error) {
+	if f.GetTrieStatisticsCalled != nil {
+		return f.GetTrieStatisticsCalled(rootHash)
+	}
+
+	return nil, nil
+}
+
+// StartOutportReindexing -
+func (f *FacadeStub) StartOutportReindexing(startNonce uint64, endNonce uint64) error {
+	if f.StartOutportReindexingCalled != nil {
+		return f.StartOutportReindexingCalled(startNonce, endNonce)
+	}
+
+	return nil
+}
+
+// GetConsensusRoundsTimeline -
+func (f *FacadeStub) GetConsensusRoundsTimeline(numRounds uint32) ([]*common.ConsensusRoundTimeline, error) {
+	if f.GetConsensusRoundsTimelineCalled != nil {
+		return f.GetConsensusRoundsTimelineCalled(numRounds)
+	}
+
+	return nil, nil
+}
+
+// GetOutportReindexingStatus -
+func (f *FacadeStub) GetOutportReindexingStatus() (common.OutportReindexStatus, error) {
+	if f.GetOutportReindexingStatusCalled != nil {
+		return f.GetOutportReindexingStatusCalled()
+	}
+
+	return common.OutportReindexStatus{}, nil
+}
+
+// GetDataTrieStatistics -
+func (f *FacadeStub) GetDataTrieStatistics(address string, options api.AccountQueryOptions) (*common.DataTrieStatisticsAPIResponse, api.BlockInfo, error) {
+	if f.GetDataTrieStatisticsCalled != nil {
+		return f.GetDataTrieStatisticsCalled(address, options)
+	}
+
+	return nil, api.BlockInfo{}, nil
+}
+
 // GetInternalStartOfEpochValidatorsInfo -
 func (f *FacadeStub) GetInternalStartOfEpochValidatorsInfo(epoch uint32) ([]*state.ShardValidatorInfo, error) {
 	if f.GetInternalStartOfEpochValidatorsInfoCalled != nil {
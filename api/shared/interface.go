@@ -93,6 +93,11 @@ type FacadeHandler interface {
 	GetTotalStakedValue() (*api.StakeValues, error)
 	GetDirectStakedList() ([]*api.DirectStakedValue, error)
 	GetDelegatorsList() ([]*api.Delegator, error)
+	GetDelegationContract(address string, page uint32, pageSize uint32) (*common.DelegationContractAPIResponse, error)
+	GetDelegationPositions(address string) (*common.DelegationPositionsAPIResponse, error)
+	GetGovernanceProposals() (*common.GovernanceProposalsAPIResponse, error)
+	GetGovernanceProposal(nonce uint64) (*common.GovernanceProposalAPIResponse, error)
+	GetGovernanceVotes(address string) (*common.GovernanceVotesAPIResponse, error)
 	StatusMetrics() external.StatusMetricsHandler
 	GetTokenSupply(token string) (*api.ESDTSupply, error)
 	GetAllIssuedESDTs(tokenType string) ([]string, error)
@@ -116,6 +121,10 @@ type FacadeHandler interface {
 	EncodeAddressPubkey(pk []byte) (string, error)
 	ValidatorStatisticsApi() (map[string]*validator.ValidatorStatistics, error)
 	AuctionListApi() ([]*common.AuctionListValidatorAPIResponse, error)
+	SimulateAuctionApi(changes []*common.AuctionSimulationOwnerChange) (*common.AuctionSimulationAPIResponse, error)
+	GetNodesShufflingPreview() (*common.NodesShufflingPreviewAPIResponse, error)
+	GetValidatorRewardsHistory(blsKey string, fromEpoch core.OptionalUint32, toEpoch core.OptionalUint32) ([]*common.ValidatorRewardsBreakdown, error)
+	GetValidatorRatingsHistory(blsKey string, fromEpoch core.OptionalUint32, toEpoch core.OptionalUint32) ([]*common.ValidatorRatingHistory, error)
 	ExecuteSCQuery(*process.SCQuery) (*vm.VMOutputApi, api.BlockInfo, error)
 	DecodeAddressPubkey(pk string) ([]byte, error)
 	RestApiInterface() string
@@ -124,11 +133,17 @@ type FacadeHandler interface {
 	GetGenesisNodesPubKeys() (map[uint32][]string, map[uint32][]string, error)
 	GetGenesisBalances() ([]*common.InitialAccountAPI, error)
 	GetGasConfigs() (map[string]map[string]uint64, error)
+	GetGasPriceSuggestion() (*common.GasPriceSuggestionAPIResponse, error)
+	GetTrieStatistics(rootHash string) (*common.TrieStatisticsAPIResponse, error)
+	StartOutportReindexing(startNonce uint64, endNonce uint64) error
+	GetOutportReindexingStatus() (common.OutportReindexStatus, error)
+	GetConsensusRoundsTimeline(numRounds uint32) ([]*common.ConsensusRoundTimeline, error)
 	GetTransactionsPool(fields string) (*common.TransactionsPoolAPIResponse, error)
 	GetTransactionsPoolForSender(sender, fields string) (*common.TransactionsPoolForSenderApiResponse, error)
 	GetLastPoolNonceForSender(sender string) (uint64, error)
 	GetTransactionsPoolNonceGapsForSender(sender string) (*common.TransactionsPoolNonceGapsForSenderApiResponse, error)
 	IsDataTrieMigrated(address string, options api.AccountQueryOptions) (bool, error)
+	GetDataTrieStatistics(address string, options api.AccountQueryOptions) (*common.DataTrieStatisticsAPIResponse, api.BlockInfo, error)
 	GetManagedKeysCount() int
 	GetManagedKeys() []string
 	GetLoadedKeys() []string
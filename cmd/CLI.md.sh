@@ -2,10 +2,14 @@
 
 generate() {
     generateForAssessmentTool
+    generateForDBChecker
+    generateForDBMigrator
     generateForKeyGenerator
     generateForLogViewer
     generateForNode
     generateForSeedNode
+    generateForStateExport
+    generateForStateSnapshot
     generateForTermUi
 }
 
@@ -23,6 +27,34 @@ $(code)
     echo "$HELP" > ./assessment/CLI.md
 }
 
+generateForDBChecker() {
+    HELP="
+# DB Checker CLI
+
+The **DB Checker Tool** exposes the following Command Line Interface:
+$(code)
+\$ dbchecker --help
+
+$(./dbchecker/dbchecker --help | head -n -3)
+$(code)
+"
+    echo "$HELP" > ./dbchecker/CLI.md
+}
+
+generateForDBMigrator() {
+    HELP="
+# DB Migrator CLI
+
+The **DB Migrator Tool** exposes the following Command Line Interface:
+$(code)
+\$ dbmigrator --help
+
+$(./dbmigrator/dbmigrator --help | head -n -3)
+$(code)
+"
+    echo "$HELP" > ./dbmigrator/CLI.md
+}
+
 generateForKeyGenerator() {
     HELP="
 # Keygenerator CLI
@@ -79,6 +111,34 @@ $(code)
     echo "$HELP" > ./seednode/CLI.md
 }
 
+generateForStateExport() {
+    HELP="
+# State Export CLI
+
+The **State Export Tool** exposes the following Command Line Interface:
+$(code)
+\$ stateexport --help
+
+$(./stateexport/stateexport --help | head -n -3)
+$(code)
+"
+    echo "$HELP" > ./stateexport/CLI.md
+}
+
+generateForStateSnapshot() {
+    HELP="
+# State Snapshot CLI
+
+The **State Snapshot Tool** exposes the following Command Line Interface:
+$(code)
+\$ statesnapshot --help
+
+$(./statesnapshot/statesnapshot --help | head -n -3)
+$(code)
+"
+    echo "$HELP" > ./statesnapshot/CLI.md
+}
+
 generateForTermUi() {
     HELP="
 # MultiversX TermUI CLI
@@ -0,0 +1,205 @@
+package benchmarks
+
+import (
+	"encoding/hex"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	"github.com/multiversx/mx-chain-go/config"
+	"github.com/multiversx/mx-chain-go/integrationTests"
+	"github.com/multiversx/mx-chain-go/integrationTests/vm"
+	"github.com/multiversx/mx-chain-go/integrationTests/vm/wasm"
+	"github.com/multiversx/mx-chain-go/process/factory"
+	vmcommon "github.com/multiversx/mx-chain-vm-common-go"
+)
+
+const (
+	blockBenchmarkGasPrice      = uint64(1)
+	blockBenchmarkMoveGasLimit  = uint64(100_000)
+	blockBenchmarkNumTxKinds    = 3
+	blockBenchmarkTransferValue = 5
+)
+
+// ArgBlockBenchmark is the block processing type benchmark argument used in constructor
+type ArgBlockBenchmark struct {
+	Name           string
+	ScFilename     string
+	Function       string
+	NumRuns        int
+	NumBlocks      int
+	NumTxsPerBlock int
+}
+
+type blockBenchmark struct {
+	name           string
+	scFilename     string
+	function       string
+	numRuns        int
+	numBlocks      int
+	numTxsPerBlock int
+}
+
+type blockBenchmarkAccounts struct {
+	owner      []byte
+	ownerNonce uint64
+	alice      []byte
+	aliceNonce uint64
+	bob        []byte
+	scAddress  []byte
+}
+
+// NewBlockBenchmark creates a new benchmark that processes blocks of a synthetic transaction mix (move balance,
+// move balance with data and ERC20 SC calls) and commits the accounts state after each block
+func NewBlockBenchmark(arg ArgBlockBenchmark) *blockBenchmark {
+	return &blockBenchmark{
+		name:           arg.Name,
+		scFilename:     arg.ScFilename,
+		function:       arg.Function,
+		numRuns:        arg.NumRuns,
+		numBlocks:      arg.NumBlocks,
+		numTxsPerBlock: arg.NumTxsPerBlock,
+	}
+}
+
+// Run returns the time needed for the benchmark to be run
+func (bb *blockBenchmark) Run() (time.Duration, error) {
+	if !core.FileExists(bb.scFilename) {
+		return 0, fmt.Errorf("%w, file %s", ErrFileDoesNotExist, bb.scFilename)
+	}
+
+	results := make([]time.Duration, 0, bb.numRuns)
+	for i := 0; i < bb.numRuns; i++ {
+		elapsed, err := bb.runOnce()
+		if err != nil {
+			return 0, err
+		}
+
+		results = append(results, elapsed)
+	}
+
+	return getMinimumTimeDuration(results), nil
+}
+
+func (bb *blockBenchmark) runOnce() (time.Duration, error) {
+	accounts := &blockBenchmarkAccounts{
+		owner:      []byte("12345678901234567890123456789011"),
+		ownerNonce: 11,
+		alice:      []byte("12345678901234567890123456789111"),
+		bob:        []byte("12345678901234567890123456789222"),
+	}
+	largeNumber := big.NewInt(1000000000000000)
+
+	testContext, err := vm.CreateTxProcessorWasmVMWithGasSchedule(
+		accounts.ownerNonce,
+		accounts.owner,
+		big.NewInt(0).Mul(largeNumber, largeNumber),
+		createTestGasMap(),
+		config.EnableEpochs{
+			MaxBlockchainHookCountersEnableEpoch: integrationTests.UnreachableEpoch,
+		},
+	)
+	if err != nil {
+		return 0, err
+	}
+	defer testContext.Close()
+
+	_, err = vm.CreateAccount(testContext.Accounts, accounts.alice, accounts.aliceNonce, big.NewInt(0).Mul(largeNumber, largeNumber))
+	if err != nil {
+		return 0, err
+	}
+
+	accounts.scAddress, err = testContext.BlockchainHook.NewAddress(accounts.owner, accounts.ownerNonce, factory.WasmVirtualMachine)
+	if err != nil {
+		return 0, err
+	}
+
+	initialSupply := "00" + hex.EncodeToString(largeNumber.Bytes())
+	deployTx := vm.CreateDeployTx(
+		accounts.owner,
+		accounts.ownerNonce,
+		big.NewInt(0),
+		blockBenchmarkGasPrice,
+		300_000_000,
+		wasm.CreateDeployTxData(wasm.GetSCCode(bb.scFilename))+"@"+initialSupply,
+	)
+	err = processBenchmarkTransaction(testContext, deployTx)
+	if err != nil {
+		return 0, err
+	}
+	accounts.ownerNonce++
+
+	_, err = testContext.Accounts.Commit()
+	if err != nil {
+		return 0, err
+	}
+
+	startTime := time.Now()
+	for i := 0; i < bb.numBlocks; i++ {
+		err = bb.processBlock(testContext, accounts)
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	return time.Since(startTime), nil
+}
+
+func (bb *blockBenchmark) processBlock(testContext *vm.VMTestContext, accounts *blockBenchmarkAccounts) error {
+	testContext.CreateBlockStarted()
+
+	for i := 0; i < bb.numTxsPerBlock; i++ {
+		err := processBenchmarkTransaction(testContext, bb.createTransaction(i, accounts))
+		if err != nil {
+			return err
+		}
+	}
+
+	_, err := testContext.Accounts.Commit()
+
+	return err
+}
+
+func (bb *blockBenchmark) createTransaction(index int, accounts *blockBenchmarkAccounts) *transaction.Transaction {
+	value := big.NewInt(blockBenchmarkTransferValue)
+
+	switch index % blockBenchmarkNumTxKinds {
+	case 0:
+		tx := vm.CreateTransaction(accounts.aliceNonce, value, accounts.alice, accounts.bob, blockBenchmarkGasPrice, blockBenchmarkMoveGasLimit, nil)
+		accounts.aliceNonce++
+		return tx
+	case 1:
+		data := []byte(fmt.Sprintf("move balance with data %d", index))
+		tx := vm.CreateTransaction(accounts.aliceNonce, value, accounts.alice, accounts.bob, blockBenchmarkGasPrice, blockBenchmarkMoveGasLimit, data)
+		accounts.aliceNonce++
+		return tx
+	default:
+		tx := vm.CreateTransferTokenTx(accounts.ownerNonce, bb.function, value, accounts.scAddress, accounts.owner, accounts.bob)
+		accounts.ownerNonce++
+		return tx
+	}
+}
+
+func processBenchmarkTransaction(testContext *vm.VMTestContext, tx *transaction.Transaction) error {
+	returnCode, err := testContext.TxProcessor.ProcessTransaction(tx)
+	if err != nil {
+		return err
+	}
+	if returnCode != vmcommon.Ok {
+		return fmt.Errorf("transaction with nonce %d returned %s", tx.Nonce, returnCode.String())
+	}
+
+	return nil
+}
+
+// Name returns the benchmark's name
+func (bb *blockBenchmark) Name() string {
+	return fmt.Sprintf("%s, %d blocks of %d transactions, minimum duration", bb.name, bb.numBlocks, bb.numTxsPerBlock)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (bb *blockBenchmark) IsInterfaceNil() bool {
+	return bb == nil
+}
@@ -0,0 +1,52 @@
+package benchmarks
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBlockBenchmark_MissingFileShouldErr(t *testing.T) {
+	t.Parallel()
+
+	bb := NewBlockBenchmark(
+		ArgBlockBenchmark{
+			Name:       "block",
+			ScFilename: "../testdata/missing.wasm",
+			NumRuns:    1,
+		},
+	)
+
+	testDuration, err := bb.Run()
+	assert.True(t, errors.Is(err, ErrFileDoesNotExist))
+	assert.Zero(t, testDuration)
+}
+
+func TestBlockBenchmark_ShouldWork(t *testing.T) {
+	if testing.Short() {
+		t.Skip("this is not a short test")
+	}
+
+	testName := "block"
+	bb := NewBlockBenchmark(
+		ArgBlockBenchmark{
+			Name:           testName,
+			ScFilename:     "../testdata/erc20_c.wasm",
+			Function:       "transferToken",
+			NumRuns:        1,
+			NumBlocks:      2,
+			NumTxsPerBlock: 30,
+		},
+	)
+
+	assert.False(t, check.IfNil(bb))
+
+	testDuration, err := bb.Run()
+	assert.Nil(t, err)
+	assert.True(t, testDuration > 0)
+	assert.True(t, strings.Contains(bb.Name(), testName))
+	assert.True(t, strings.Contains(bb.Name(), "2 blocks of 30 transactions"))
+}
@@ -0,0 +1,139 @@
+package benchmarks
+
+import (
+	"fmt"
+	"time"
+
+	crypto "github.com/multiversx/mx-chain-crypto-go"
+	"github.com/multiversx/mx-chain-crypto-go/signing"
+	"github.com/multiversx/mx-chain-crypto-go/signing/mcl"
+	mclMultiSig "github.com/multiversx/mx-chain-crypto-go/signing/mcl/multisig"
+	mclSig "github.com/multiversx/mx-chain-crypto-go/signing/mcl/singlesig"
+	"github.com/multiversx/mx-chain-crypto-go/signing/multisig"
+)
+
+// ArgBLSBenchmark is the BLS type benchmark argument used in constructor
+type ArgBLSBenchmark struct {
+	Name          string
+	NumRuns       int
+	NumSignatures int
+	NumSigners    int
+}
+
+type blsBenchmark struct {
+	name          string
+	numRuns       int
+	numSignatures int
+	numSigners    int
+}
+
+// NewBLSBenchmark creates a new benchmark that signs and verifies messages with BLS single signatures and then
+// aggregates and verifies the signature shares of a consensus group
+func NewBLSBenchmark(arg ArgBLSBenchmark) *blsBenchmark {
+	return &blsBenchmark{
+		name:          arg.Name,
+		numRuns:       arg.NumRuns,
+		numSignatures: arg.NumSignatures,
+		numSigners:    arg.NumSigners,
+	}
+}
+
+// Run returns the time needed for the benchmark to be run
+func (bb *blsBenchmark) Run() (time.Duration, error) {
+	keyGen := signing.NewKeyGenerator(mcl.NewSuiteBLS12())
+	multiSigner, err := multisig.NewBLSMultisig(&mclMultiSig.BlsMultiSignerKOSK{}, keyGen)
+	if err != nil {
+		return 0, err
+	}
+
+	privateKeys := make([]crypto.PrivateKey, 0, bb.numSigners)
+	publicKeys := make([][]byte, 0, bb.numSigners)
+	for i := 0; i < bb.numSigners; i++ {
+		sk, pk := keyGen.GeneratePair()
+		pkBytes, errBytes := pk.ToByteArray()
+		if errBytes != nil {
+			return 0, errBytes
+		}
+
+		privateKeys = append(privateKeys, sk)
+		publicKeys = append(publicKeys, pkBytes)
+	}
+
+	results := make([]time.Duration, 0, bb.numRuns)
+	for i := 0; i < bb.numRuns; i++ {
+		elapsed, errRun := bb.runOnce(multiSigner, privateKeys, publicKeys)
+		if errRun != nil {
+			return 0, errRun
+		}
+
+		results = append(results, elapsed)
+	}
+
+	return getMinimumTimeDuration(results), nil
+}
+
+func (bb *blsBenchmark) runOnce(
+	multiSigner crypto.MultiSigner,
+	privateKeys []crypto.PrivateKey,
+	publicKeys [][]byte,
+) (time.Duration, error) {
+	singleSigner := &mclSig.BlsSingleSigner{}
+
+	startTime := time.Now()
+	for i := 0; i < bb.numSignatures && len(privateKeys) > 0; i++ {
+		message := []byte(fmt.Sprintf("message %d", i))
+		sk := privateKeys[i%len(privateKeys)]
+		sig, err := singleSigner.Sign(sk, message)
+		if err != nil {
+			return 0, err
+		}
+
+		err = singleSigner.Verify(sk.GeneratePublic(), message, sig)
+		if err != nil {
+			return 0, fmt.Errorf("%w: %s", ErrInvalidSignature, err.Error())
+		}
+	}
+
+	message := []byte("block header hash")
+	shares := make([][]byte, 0, len(privateKeys))
+	for i, sk := range privateKeys {
+		skBytes, err := sk.ToByteArray()
+		if err != nil {
+			return 0, err
+		}
+
+		share, err := multiSigner.CreateSignatureShare(skBytes, message)
+		if err != nil {
+			return 0, err
+		}
+
+		err = multiSigner.VerifySignatureShare(publicKeys[i], message, share)
+		if err != nil {
+			return 0, fmt.Errorf("%w: %s", ErrInvalidSignature, err.Error())
+		}
+
+		shares = append(shares, share)
+	}
+
+	aggregatedSig, err := multiSigner.AggregateSigs(publicKeys, shares)
+	if err != nil {
+		return 0, err
+	}
+
+	err = multiSigner.VerifyAggregatedSig(publicKeys, message, aggregatedSig)
+	if err != nil {
+		return 0, fmt.Errorf("%w: %s", ErrInvalidSignature, err.Error())
+	}
+
+	return time.Since(startTime), nil
+}
+
+// Name returns the benchmark's name
+func (bb *blsBenchmark) Name() string {
+	return fmt.Sprintf("%s, %d sign/verify, %d signers aggregation, minimum duration", bb.name, bb.numSignatures, bb.numSigners)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (bb *blsBenchmark) IsInterfaceNil() bool {
+	return bb == nil
+}
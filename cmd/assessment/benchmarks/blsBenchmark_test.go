@@ -0,0 +1,31 @@
+package benchmarks
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBLSBenchmark_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	testName := "bls"
+	bb := NewBLSBenchmark(
+		ArgBLSBenchmark{
+			Name:          testName,
+			NumRuns:       1,
+			NumSignatures: 5,
+			NumSigners:    7,
+		},
+	)
+
+	assert.False(t, check.IfNil(bb))
+
+	testDuration, err := bb.Run()
+	assert.Nil(t, err)
+	assert.True(t, testDuration > 0)
+	assert.True(t, strings.Contains(bb.Name(), testName))
+	assert.True(t, strings.Contains(bb.Name(), "7 signers aggregation"))
+}
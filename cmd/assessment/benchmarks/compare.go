@@ -0,0 +1,158 @@
+package benchmarks
+
+import (
+	"fmt"
+
+	"github.com/multiversx/mx-chain-core-go/display"
+)
+
+// The statuses of a compared benchmark
+const (
+	StatusOk          = "ok"
+	StatusRegression  = "regression"
+	StatusImprovement = "improvement"
+	StatusError       = "error"
+	StatusMissing     = "missing"
+	StatusNew         = "new"
+)
+
+// ComparisonRow holds the comparison of one benchmark between the baseline and the current report
+type ComparisonRow struct {
+	Name            string
+	BaselineSeconds float64
+	CurrentSeconds  float64
+	DeltaPercent    float64
+	Status          string
+}
+
+// ComparisonResult holds the comparison between two benchmarks reports
+type ComparisonResult struct {
+	TolerancePercent float64
+	Rows             []ComparisonRow
+}
+
+// CompareReports compares the current report against the baseline report. A benchmark that takes longer than the
+// baseline with more than tolerancePercent is a regression, one that is faster with more than tolerancePercent is an
+// improvement. The benchmarks are matched by name, as the name contains the benchmark's parameters
+func CompareReports(baseline *JSONReport, current *JSONReport, tolerancePercent float64) (*ComparisonResult, error) {
+	if baseline == nil || current == nil {
+		return nil, ErrNilReport
+	}
+	if tolerancePercent < 0 {
+		return nil, fmt.Errorf("%w: %f", ErrInvalidTolerance, tolerancePercent)
+	}
+
+	currentResults := make(map[string]JSONResult, len(current.Results))
+	for _, result := range current.Results {
+		currentResults[result.Name] = result
+	}
+
+	comparison := &ComparisonResult{
+		TolerancePercent: tolerancePercent,
+		Rows:             make([]ComparisonRow, 0, len(baseline.Results)+1),
+	}
+	baselineNames := make(map[string]struct{}, len(baseline.Results))
+	for _, baselineResult := range baseline.Results {
+		baselineNames[baselineResult.Name] = struct{}{}
+
+		currentResult, found := currentResults[baselineResult.Name]
+		if !found {
+			comparison.Rows = append(comparison.Rows, ComparisonRow{
+				Name:            baselineResult.Name,
+				BaselineSeconds: baselineResult.Seconds,
+				Status:          StatusMissing,
+			})
+			continue
+		}
+
+		comparison.Rows = append(comparison.Rows, compareResults(baselineResult, currentResult, tolerancePercent))
+	}
+
+	for _, currentResult := range current.Results {
+		_, found := baselineNames[currentResult.Name]
+		if found {
+			continue
+		}
+
+		comparison.Rows = append(comparison.Rows, ComparisonRow{
+			Name:           currentResult.Name,
+			CurrentSeconds: currentResult.Seconds,
+			Status:         StatusNew,
+		})
+	}
+
+	if baseline.ComputingPowerEvaluated && current.ComputingPowerEvaluated {
+		comparison.Rows = append(comparison.Rows, compareResults(
+			JSONResult{Name: totalMarker, Seconds: baseline.TotalSeconds},
+			JSONResult{Name: totalMarker, Seconds: current.TotalSeconds},
+			tolerancePercent,
+		))
+	}
+
+	return comparison, nil
+}
+
+func compareResults(baseline JSONResult, current JSONResult, tolerancePercent float64) ComparisonRow {
+	row := ComparisonRow{
+		Name:            baseline.Name,
+		BaselineSeconds: baseline.Seconds,
+		CurrentSeconds:  current.Seconds,
+		Status:          StatusOk,
+	}
+	if len(baseline.Error) > 0 || len(current.Error) > 0 {
+		row.Status = StatusError
+		return row
+	}
+	if baseline.Seconds <= 0 {
+		return row
+	}
+
+	row.DeltaPercent = (current.Seconds - baseline.Seconds) * 100 / baseline.Seconds
+	switch {
+	case row.DeltaPercent > tolerancePercent:
+		row.Status = StatusRegression
+	case row.DeltaPercent < -tolerancePercent:
+		row.Status = StatusImprovement
+	}
+
+	return row
+}
+
+// NumFailures returns the number of benchmarks that regressed, errored or are missing from the current report
+func (cr *ComparisonResult) NumFailures() int {
+	numFailures := 0
+	for _, row := range cr.Rows {
+		switch row.Status {
+		case StatusRegression, StatusError, StatusMissing:
+			numFailures++
+		}
+	}
+
+	return numFailures
+}
+
+// ToDisplayTable will output the comparison as an ASCII table
+func (cr *ComparisonResult) ToDisplayTable() string {
+	hdr := []string{"Benchmark", "Baseline seconds", "Current seconds", "Delta", "Status"}
+	hasTotal := len(cr.Rows) > 0 && cr.Rows[len(cr.Rows)-1].Name == totalMarker
+	lines := make([]*display.LineData, 0, len(cr.Rows))
+	for i, row := range cr.Rows {
+		lines = append(lines, display.NewLineData(
+			hasTotal && i == len(cr.Rows)-2,
+			[]string{
+				row.Name,
+				fmt.Sprintf("%0.3f", row.BaselineSeconds),
+				fmt.Sprintf("%0.3f", row.CurrentSeconds),
+				fmt.Sprintf("%+0.2f%%", row.DeltaPercent),
+				row.Status,
+			},
+		))
+	}
+
+	tbl, err := display.CreateTableString(hdr, lines)
+	if err != nil {
+		return fmt.Sprintf("[ERR:%s]", err)
+	}
+
+	return tbl
+}
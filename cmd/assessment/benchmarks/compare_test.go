@@ -0,0 +1,93 @@
+package benchmarks
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCompareReports(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil reports should error", func(t *testing.T) {
+		t.Parallel()
+
+		comparison, err := CompareReports(nil, &JSONReport{}, 10)
+		assert.Equal(t, ErrNilReport, err)
+		assert.Nil(t, comparison)
+
+		comparison, err = CompareReports(&JSONReport{}, nil, 10)
+		assert.Equal(t, ErrNilReport, err)
+		assert.Nil(t, comparison)
+	})
+	t.Run("negative tolerance should error", func(t *testing.T) {
+		t.Parallel()
+
+		comparison, err := CompareReports(&JSONReport{}, &JSONReport{}, -1)
+		assert.True(t, errors.Is(err, ErrInvalidTolerance))
+		assert.Nil(t, comparison)
+	})
+	t.Run("should compare all the results", func(t *testing.T) {
+		t.Parallel()
+
+		baseline := &JSONReport{
+			Results: []JSONResult{
+				{Name: "same", Seconds: 10},
+				{Name: "slower", Seconds: 10},
+				{Name: "faster", Seconds: 10},
+				{Name: "errored", Seconds: 10},
+				{Name: "missing", Seconds: 10},
+			},
+			TotalSeconds:            50,
+			ComputingPowerEvaluated: true,
+		}
+		current := &JSONReport{
+			Results: []JSONResult{
+				{Name: "same", Seconds: 10.5},
+				{Name: "slower", Seconds: 12},
+				{Name: "faster", Seconds: 8},
+				{Name: "errored", Seconds: 0, Error: "error"},
+				{Name: "new", Seconds: 1},
+			},
+			TotalSeconds:            41.5,
+			ComputingPowerEvaluated: true,
+		}
+
+		comparison, err := CompareReports(baseline, current, 10)
+		require.Nil(t, err)
+		require.Equal(t, 7, len(comparison.Rows))
+
+		expectedStatuses := []string{StatusOk, StatusRegression, StatusImprovement, StatusError, StatusMissing, StatusNew, StatusImprovement}
+		for i, row := range comparison.Rows {
+			assert.Equal(t, expectedStatuses[i], row.Status, "row %s", row.Name)
+		}
+		assert.Equal(t, float64(20), comparison.Rows[1].DeltaPercent)
+		assert.Equal(t, totalMarker, comparison.Rows[6].Name)
+		assert.Equal(t, 3, comparison.NumFailures())
+
+		tbl := comparison.ToDisplayTable()
+		for _, str := range []string{"slower", "+20.00%", "-20.00%", StatusRegression, StatusMissing, totalMarker} {
+			assert.True(t, strings.Contains(tbl, str), "string %s not contained", str)
+		}
+	})
+	t.Run("total is not compared if the computing power was not evaluated", func(t *testing.T) {
+		t.Parallel()
+
+		baseline := &JSONReport{
+			Results:                 []JSONResult{{Name: "test", Seconds: 10}},
+			ComputingPowerEvaluated: true,
+		}
+		current := &JSONReport{
+			Results: []JSONResult{{Name: "test", Seconds: 10}},
+		}
+
+		comparison, err := CompareReports(baseline, current, 0)
+		require.Nil(t, err)
+		require.Equal(t, 1, len(comparison.Rows))
+		assert.Equal(t, StatusOk, comparison.Rows[0].Status)
+		assert.Zero(t, comparison.NumFailures())
+	})
+}
@@ -14,7 +14,7 @@ import (
 func TestNewCoordinator_NilSliceShouldErr(t *testing.T) {
 	t.Parallel()
 
-	c, err := NewCoordinator(nil)
+	c, err := NewCoordinator(nil, nil)
 
 	assert.True(t, check.IfNil(c))
 	assert.True(t, errors.Is(err, ErrEmptyBenchmarksSlice))
@@ -27,7 +27,7 @@ func TestNewCoordinator_NilBenchmarkShouldErr(t *testing.T) {
 		&mock.BenchmarkStub{},
 		nil,
 		&mock.BenchmarkStub{},
-	})
+	}, nil)
 
 	assert.True(t, check.IfNil(c))
 	assert.True(t, errors.Is(err, ErrNilBenchmark))
@@ -39,7 +39,7 @@ func TestNewCoordinator_ShouldWork(t *testing.T) {
 	c, err := NewCoordinator([]BenchmarkRunner{
 		&mock.BenchmarkStub{},
 		&mock.BenchmarkStub{},
-	})
+	}, nil)
 
 	assert.False(t, check.IfNil(c))
 	assert.Nil(t, err)
@@ -60,7 +60,7 @@ func TestCoordinator_RunAllOneErrorsShouldRetErr(t *testing.T) {
 				return 3, expectedErr
 			},
 		},
-	})
+	}, nil)
 
 	result := c.RunAllTests()
 	require.NotNil(t, result)
@@ -86,7 +86,7 @@ func TestCoordinator_RunAllShouldWork(t *testing.T) {
 				return 3, nil
 			},
 		},
-	})
+	}, nil)
 
 	result := c.RunAllTests()
 	require.NotNil(t, result)
@@ -97,3 +97,46 @@ func TestCoordinator_RunAllShouldWork(t *testing.T) {
 	assert.Equal(t, time.Duration(3), result.Results[1].Duration)
 	assert.True(t, result.EnoughComputingPower)
 }
+
+func TestCoordinator_RunAllWithExtraBenchmarksShouldNotAccumulateThem(t *testing.T) {
+	t.Parallel()
+
+	c, _ := NewCoordinator(
+		[]BenchmarkRunner{
+			&mock.BenchmarkStub{
+				RunCalled: func() (time.Duration, error) {
+					return 2, nil
+				},
+			},
+		},
+		[]BenchmarkRunner{
+			&mock.BenchmarkStub{
+				RunCalled: func() (time.Duration, error) {
+					return ThresholdEnoughComputingPower, nil
+				},
+			},
+		},
+	)
+
+	result := c.RunAllTests()
+	require.NotNil(t, result)
+	assert.Nil(t, result.Error)
+	assert.Equal(t, time.Duration(2), result.TotalDuration)
+	require.Equal(t, 2, len(result.Results))
+	assert.Equal(t, ThresholdEnoughComputingPower, result.Results[1].Duration)
+	assert.True(t, result.ComputingPowerEvaluated)
+	assert.True(t, result.EnoughComputingPower)
+}
+
+func TestCoordinator_RunAllOnlyExtraBenchmarksShouldNotEvaluateComputingPower(t *testing.T) {
+	t.Parallel()
+
+	c, err := NewCoordinator(nil, []BenchmarkRunner{&mock.BenchmarkStub{}})
+	require.Nil(t, err)
+
+	result := c.RunAllTests()
+	require.NotNil(t, result)
+	assert.Equal(t, 1, len(result.Results))
+	assert.False(t, result.ComputingPowerEvaluated)
+	assert.False(t, result.EnoughComputingPower)
+}
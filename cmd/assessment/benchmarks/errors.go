@@ -10,3 +10,21 @@ var ErrNilBenchmark = errors.New("nil benchmark")
 
 // ErrFileDoesNotExist signals that the required file does not exist
 var ErrFileDoesNotExist = errors.New("file does not exist")
+
+// ErrInvalidProof signals that a generated trie proof could not be verified
+var ErrInvalidProof = errors.New("invalid proof")
+
+// ErrInvalidSignature signals that a generated signature could not be verified
+var ErrInvalidSignature = errors.New("invalid signature")
+
+// ErrValueMismatch signals that the value read from the storage differs from the one written
+var ErrValueMismatch = errors.New("value mismatch")
+
+// ErrNilReport signals that a nil benchmarks report was provided
+var ErrNilReport = errors.New("nil benchmarks report")
+
+// ErrInvalidTolerance signals that an invalid tolerance was provided
+var ErrInvalidTolerance = errors.New("invalid tolerance")
+
+// ErrUnknownBenchmarksGroup signals that an unknown benchmarks group was requested
+var ErrUnknownBenchmarksGroup = errors.New("unknown benchmarks group")
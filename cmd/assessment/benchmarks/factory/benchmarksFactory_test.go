@@ -1,8 +1,10 @@
 package factory
 
 import (
+	"errors"
 	"testing"
 
+	"github.com/multiversx/mx-chain-go/cmd/assessment/benchmarks"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -11,3 +13,24 @@ func TestCreateBenchmarksList(t *testing.T) {
 
 	assert.Equal(t, 15, len(list))
 }
+
+func TestCreateExtraBenchmarksList(t *testing.T) {
+	t.Run("unknown group should error", func(t *testing.T) {
+		list, err := CreateExtraBenchmarksList("../testdata", []string{GroupTrie, "unknown"})
+
+		assert.True(t, errors.Is(err, benchmarks.ErrUnknownBenchmarksGroup))
+		assert.Nil(t, list)
+	})
+	t.Run("all groups should work", func(t *testing.T) {
+		list, err := CreateExtraBenchmarksList("../testdata", AllGroups)
+
+		assert.Nil(t, err)
+		assert.Equal(t, 7, len(list))
+	})
+	t.Run("vm group only should create an empty list", func(t *testing.T) {
+		list, err := CreateExtraBenchmarksList("../testdata", []string{GroupVM})
+
+		assert.Nil(t, err)
+		assert.Empty(t, list)
+	})
+}
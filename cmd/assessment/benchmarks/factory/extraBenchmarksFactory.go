@@ -0,0 +1,98 @@
+package factory
+
+import (
+	"fmt"
+	"path/filepath"
+
+	"github.com/multiversx/mx-chain-go/cmd/assessment/benchmarks"
+	"github.com/multiversx/mx-chain-go/storage/storageunit"
+)
+
+// The benchmarks groups that can be selected
+const (
+	GroupVM      = "vm"
+	GroupTrie    = "trie"
+	GroupBLS     = "bls"
+	GroupStorage = "storage"
+	GroupBlock   = "block"
+)
+
+// AllGroups contains all the benchmarks groups, in the order they are run
+var AllGroups = []string{GroupVM, GroupTrie, GroupBLS, GroupStorage, GroupBlock}
+
+// CreateExtraBenchmarksList creates the list of the benchmarks from the provided groups, except the VM group that
+// is created by CreateBenchmarksList
+func CreateExtraBenchmarksList(testDataDirectory string, groups []string) ([]benchmarks.BenchmarkRunner, error) {
+	list := make([]benchmarks.BenchmarkRunner, 0)
+	for _, group := range groups {
+		switch group {
+		case GroupVM:
+		case GroupTrie:
+			list = append(list, createTrieBenchmark())
+		case GroupBLS:
+			list = append(list, createBLSBenchmark())
+		case GroupStorage:
+			list = append(list, createStorageBenchmarks()...)
+		case GroupBlock:
+			list = append(list, createBlockBenchmark(testDataDirectory))
+		default:
+			return nil, fmt.Errorf("%w: %s", benchmarks.ErrUnknownBenchmarksGroup, group)
+		}
+	}
+
+	return list, nil
+}
+
+func createTrieBenchmark() benchmarks.BenchmarkRunner {
+	arg := benchmarks.ArgTrieBenchmark{
+		Name:      "Trie insert, commit and proofs",
+		NumRuns:   3,
+		NumKeys:   100000,
+		NumProofs: 10000,
+	}
+
+	return benchmarks.NewTrieBenchmark(arg)
+}
+
+func createBLSBenchmark() benchmarks.BenchmarkRunner {
+	arg := benchmarks.ArgBLSBenchmark{
+		Name:          "BLS sign, verify and aggregation",
+		NumRuns:       3,
+		NumSignatures: 200,
+		NumSigners:    400,
+	}
+
+	return benchmarks.NewBLSBenchmark(arg)
+}
+
+func createStorageBenchmarks() []benchmarks.BenchmarkRunner {
+	dbTypes := []storageunit.DBType{storageunit.LvlDB, storageunit.LvlDBSerial, storageunit.PebbleDB, storageunit.MemoryDB}
+
+	list := make([]benchmarks.BenchmarkRunner, 0, len(dbTypes))
+	for _, dbType := range dbTypes {
+		arg := benchmarks.ArgStorageBenchmark{
+			Name:      "Storage write and read",
+			DBType:    string(dbType),
+			NumRuns:   3,
+			NumKeys:   50000,
+			ValueSize: 256,
+		}
+
+		list = append(list, benchmarks.NewStorageBenchmark(arg))
+	}
+
+	return list
+}
+
+func createBlockBenchmark(testDataDirectory string) benchmarks.BenchmarkRunner {
+	arg := benchmarks.ArgBlockBenchmark{
+		Name:           "Block processing of a transactions mix",
+		ScFilename:     filepath.Join(testDataDirectory, "erc20_c.wasm"),
+		Function:       "transferToken",
+		NumRuns:        2,
+		NumBlocks:      10,
+		NumTxsPerBlock: 300,
+	}
+
+	return benchmarks.NewBlockBenchmark(arg)
+}
@@ -8,14 +8,21 @@ type runner struct {
 	coordinator benchmarkCoordinator
 }
 
-// NewRunner is a wrapper over the coordinator implementation that will assemble all the defined benchmarks
-func NewRunner(testDataDirectory string) (*runner, error) {
+// NewRunner is a wrapper over the coordinator implementation that will assemble all the benchmarks of the provided groups
+func NewRunner(testDataDirectory string, groups []string) (*runner, error) {
 	r := &runner{}
 
-	list := CreateBenchmarksList(testDataDirectory)
+	list := make([]benchmarks.BenchmarkRunner, 0)
+	if containsGroup(groups, GroupVM) {
+		list = CreateBenchmarksList(testDataDirectory)
+	}
+
+	extraList, err := CreateExtraBenchmarksList(testDataDirectory, groups)
+	if err != nil {
+		return nil, err
+	}
 
-	var err error
-	r.coordinator, err = benchmarks.NewCoordinator(list)
+	r.coordinator, err = benchmarks.NewCoordinator(list, extraList)
 	if err != nil {
 		return nil, err
 	}
@@ -23,6 +30,16 @@ func NewRunner(testDataDirectory string) (*runner, error) {
 	return r, nil
 }
 
+func containsGroup(groups []string, group string) bool {
+	for _, g := range groups {
+		if g == group {
+			return true
+		}
+	}
+
+	return false
+}
+
 // RunAllTests will call the inner coordinator's RunAllTests function
 func (r *runner) RunAllTests() *benchmarks.TestResults {
 	return r.coordinator.RunAllTests()
@@ -0,0 +1,79 @@
+package benchmarks
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-go/common/hostParameters"
+)
+
+// JSONResult is the JSON representation of a SingleResult
+type JSONResult struct {
+	Name    string  `json:"name"`
+	Seconds float64 `json:"seconds"`
+	Error   string  `json:"error,omitempty"`
+}
+
+// JSONReport is the JSON representation of the benchmarks results, along with the host's anonymized info
+type JSONReport struct {
+	Host                    *hostParameters.HostInfo `json:"host,omitempty"`
+	Timestamp               int64                    `json:"timestamp"`
+	Results                 []JSONResult             `json:"results"`
+	TotalSeconds            float64                  `json:"totalSeconds"`
+	ComputingPowerEvaluated bool                     `json:"computingPowerEvaluated"`
+	EnoughComputingPower    bool                     `json:"enoughComputingPower"`
+}
+
+// ToJSONReport will convert the contained data in a report that can be saved as JSON
+func (tr *TestResults) ToJSONReport(host *hostParameters.HostInfo, timestamp int64) *JSONReport {
+	report := &JSONReport{
+		Host:                    host,
+		Timestamp:               timestamp,
+		Results:                 make([]JSONResult, 0, len(tr.Results)),
+		TotalSeconds:            tr.TotalDuration.Seconds(),
+		ComputingPowerEvaluated: tr.ComputingPowerEvaluated,
+		EnoughComputingPower:    tr.EnoughComputingPower,
+	}
+
+	for _, sr := range tr.Results {
+		report.Results = append(report.Results, JSONResult{
+			Name:    sr.Name,
+			Seconds: sr.Seconds(),
+			Error:   tr.errToString(sr.Error),
+		})
+	}
+
+	return report
+}
+
+// SaveJSONReport writes the report in the provided file
+func SaveJSONReport(report *JSONReport, filename string) error {
+	if report == nil {
+		return ErrNilReport
+	}
+
+	buff, err := json.MarshalIndent(report, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(filename, buff, core.FileModeReadWrite)
+}
+
+// LoadJSONReport reads a report previously written with SaveJSONReport
+func LoadJSONReport(filename string) (*JSONReport, error) {
+	buff, err := os.ReadFile(filepath.Clean(filename))
+	if err != nil {
+		return nil, err
+	}
+
+	report := &JSONReport{}
+	err = json.Unmarshal(buff, report)
+	if err != nil {
+		return nil, err
+	}
+
+	return report, nil
+}
@@ -0,0 +1,89 @@
+package benchmarks
+
+import (
+	"errors"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/multiversx/mx-chain-go/common/hostParameters"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTestResults_ToJSONReport(t *testing.T) {
+	t.Parallel()
+
+	errFound := errors.New("error found")
+	tr := &TestResults{
+		TotalDuration: time.Second * 5,
+		Results: []SingleResult{
+			{
+				Duration: time.Second * 2,
+				Name:     "test 1",
+			},
+			{
+				Duration: time.Second * 3,
+				Name:     "test 2",
+				Error:    errFound,
+			},
+		},
+		ComputingPowerEvaluated: true,
+		EnoughComputingPower:    true,
+	}
+	host := &hostParameters.HostInfo{
+		AppVersion: "v1",
+		CPUModel:   "cpu",
+	}
+
+	report := tr.ToJSONReport(host, 1234)
+	assert.Equal(t, host, report.Host)
+	assert.Equal(t, int64(1234), report.Timestamp)
+	assert.Equal(t, float64(5), report.TotalSeconds)
+	assert.True(t, report.ComputingPowerEvaluated)
+	assert.True(t, report.EnoughComputingPower)
+	assert.Equal(t, []JSONResult{
+		{Name: "test 1", Seconds: 2},
+		{Name: "test 2", Seconds: 3, Error: errFound.Error()},
+	}, report.Results)
+}
+
+func TestSaveJSONReport(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil report should error", func(t *testing.T) {
+		t.Parallel()
+
+		err := SaveJSONReport(nil, filepath.Join(t.TempDir(), "report.json"))
+		assert.Equal(t, ErrNilReport, err)
+	})
+	t.Run("save and load should work", func(t *testing.T) {
+		t.Parallel()
+
+		report := &JSONReport{
+			Host: &hostParameters.HostInfo{
+				AppVersion: "v1",
+				CPUFlags:   []string{"sse", "avx"},
+			},
+			Timestamp:               1234,
+			Results:                 []JSONResult{{Name: "test", Seconds: 1.5}},
+			TotalSeconds:            1.5,
+			ComputingPowerEvaluated: true,
+		}
+		filename := filepath.Join(t.TempDir(), "report.json")
+
+		err := SaveJSONReport(report, filename)
+		require.Nil(t, err)
+
+		loaded, err := LoadJSONReport(filename)
+		require.Nil(t, err)
+		assert.Equal(t, report, loaded)
+	})
+	t.Run("load missing file should error", func(t *testing.T) {
+		t.Parallel()
+
+		loaded, err := LoadJSONReport(filepath.Join(t.TempDir(), "missing.json"))
+		assert.NotNil(t, err)
+		assert.Nil(t, loaded)
+	})
+}
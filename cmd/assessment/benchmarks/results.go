@@ -18,10 +18,11 @@ type SingleResult struct {
 
 // TestResults represents the output structure containing the test results data
 type TestResults struct {
-	TotalDuration        time.Duration
-	Error                error
-	Results              []SingleResult
-	EnoughComputingPower bool
+	TotalDuration           time.Duration
+	Error                   error
+	Results                 []SingleResult
+	ComputingPowerEvaluated bool
+	EnoughComputingPower    bool
 }
 
 // ToDisplayTable will output the contained data as an ASCII table
@@ -0,0 +1,148 @@
+package benchmarks
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/multiversx/mx-chain-go/config"
+	"github.com/multiversx/mx-chain-go/storage"
+	storageFactory "github.com/multiversx/mx-chain-go/storage/factory"
+	"github.com/multiversx/mx-chain-go/storage/storageunit"
+)
+
+const storageBenchmarkDirPattern = "assessment-storage-"
+
+// ArgStorageBenchmark is the storage type benchmark argument used in constructor
+type ArgStorageBenchmark struct {
+	Name      string
+	DBType    string
+	NumRuns   int
+	NumKeys   int
+	ValueSize int
+}
+
+type storageBenchmark struct {
+	name      string
+	dbType    string
+	numRuns   int
+	numKeys   int
+	valueSize int
+}
+
+// NewStorageBenchmark creates a new benchmark that writes values in a database of the provided type, reopens the
+// database and then reads all the values back
+func NewStorageBenchmark(arg ArgStorageBenchmark) *storageBenchmark {
+	return &storageBenchmark{
+		name:      arg.Name,
+		dbType:    arg.DBType,
+		numRuns:   arg.NumRuns,
+		numKeys:   arg.NumKeys,
+		valueSize: arg.ValueSize,
+	}
+}
+
+// Run returns the time needed for the benchmark to be run
+func (sb *storageBenchmark) Run() (time.Duration, error) {
+	results := make([]time.Duration, 0, sb.numRuns)
+	for i := 0; i < sb.numRuns; i++ {
+		elapsed, err := sb.runInTempDirectory()
+		if err != nil {
+			return 0, err
+		}
+
+		results = append(results, elapsed)
+	}
+
+	return getMinimumTimeDuration(results), nil
+}
+
+func (sb *storageBenchmark) runInTempDirectory() (time.Duration, error) {
+	directory, err := os.MkdirTemp("", storageBenchmarkDirPattern)
+	if err != nil {
+		return 0, err
+	}
+	defer func() {
+		_ = os.RemoveAll(directory)
+	}()
+
+	persisterFactory, err := storageFactory.NewPersisterFactory(config.DBConfig{
+		Type:              sb.dbType,
+		BatchDelaySeconds: 2,
+		MaxBatchSize:      100,
+		MaxOpenFiles:      10,
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return sb.runOnce(persisterFactory, filepath.Join(directory, "db"))
+}
+
+func (sb *storageBenchmark) runOnce(persisterFactory storage.PersisterFactoryHandler, path string) (time.Duration, error) {
+	startTime := time.Now()
+	persister, err := persisterFactory.Create(path)
+	if err != nil {
+		return 0, err
+	}
+
+	defer func() {
+		_ = persister.Close()
+	}()
+
+	for i := 0; i < sb.numKeys; i++ {
+		err = persister.Put(sb.createKey(i), sb.createValue(i))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	// the in-memory database can not be reopened, the other types are reopened so the values are read from the disk
+	if sb.dbType != string(storageunit.MemoryDB) {
+		err = persister.Close()
+		if err != nil {
+			return 0, err
+		}
+
+		reopened, errCreate := persisterFactory.Create(path)
+		if errCreate != nil {
+			return 0, errCreate
+		}
+		persister = reopened
+	}
+
+	for i := 0; i < sb.numKeys; i++ {
+		value, errGet := persister.Get(sb.createKey(i))
+		if errGet != nil {
+			return 0, errGet
+		}
+		if !bytes.Equal(value, sb.createValue(i)) {
+			return 0, fmt.Errorf("%w for key index %d", ErrValueMismatch, i)
+		}
+	}
+
+	return time.Since(startTime), nil
+}
+
+func (sb *storageBenchmark) createKey(index int) []byte {
+	return []byte(fmt.Sprintf("key%010d", index))
+}
+
+func (sb *storageBenchmark) createValue(index int) []byte {
+	value := bytes.Repeat([]byte{byte(index)}, sb.valueSize)
+	copy(value, fmt.Sprintf("%d", index))
+
+	return value
+}
+
+// Name returns the benchmark's name
+func (sb *storageBenchmark) Name() string {
+	return fmt.Sprintf("%s, %s, %d writes and reads of %d bytes, minimum duration", sb.name, sb.dbType, sb.numKeys, sb.valueSize)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (sb *storageBenchmark) IsInterfaceNil() bool {
+	return sb == nil
+}
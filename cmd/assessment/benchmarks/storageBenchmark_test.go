@@ -0,0 +1,57 @@
+package benchmarks
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-go/storage/storageunit"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStorageBenchmark_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	dbTypes := []storageunit.DBType{storageunit.LvlDB, storageunit.LvlDBSerial, storageunit.PebbleDB, storageunit.MemoryDB}
+	for _, dbType := range dbTypes {
+		dbTypeString := string(dbType)
+		t.Run(dbTypeString, func(t *testing.T) {
+			t.Parallel()
+
+			sb := NewStorageBenchmark(
+				ArgStorageBenchmark{
+					Name:      "storage",
+					DBType:    dbTypeString,
+					NumRuns:   1,
+					NumKeys:   500,
+					ValueSize: 32,
+				},
+			)
+
+			assert.False(t, check.IfNil(sb))
+
+			testDuration, err := sb.Run()
+			assert.Nil(t, err)
+			assert.True(t, testDuration > 0)
+			assert.True(t, strings.Contains(sb.Name(), dbTypeString))
+		})
+	}
+}
+
+func TestStorageBenchmark_UnknownDBTypeShouldErr(t *testing.T) {
+	t.Parallel()
+
+	sb := NewStorageBenchmark(
+		ArgStorageBenchmark{
+			Name:      "storage",
+			DBType:    "unknown",
+			NumRuns:   1,
+			NumKeys:   1,
+			ValueSize: 32,
+		},
+	)
+
+	testDuration, err := sb.Run()
+	assert.NotNil(t, err)
+	assert.Zero(t, testDuration)
+}
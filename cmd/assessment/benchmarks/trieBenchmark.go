@@ -0,0 +1,102 @@
+package benchmarks
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/multiversx/mx-chain-go/integrationTests"
+)
+
+// ArgTrieBenchmark is the trie type benchmark argument used in constructor
+type ArgTrieBenchmark struct {
+	Name      string
+	NumRuns   int
+	NumKeys   int
+	NumProofs int
+}
+
+type trieBenchmark struct {
+	name      string
+	numRuns   int
+	numKeys   int
+	numProofs int
+}
+
+// NewTrieBenchmark creates a new benchmark that inserts keys in an in-memory trie, commits it and then generates
+// and verifies proofs for some of the inserted keys
+func NewTrieBenchmark(arg ArgTrieBenchmark) *trieBenchmark {
+	return &trieBenchmark{
+		name:      arg.Name,
+		numRuns:   arg.NumRuns,
+		numKeys:   arg.NumKeys,
+		numProofs: arg.NumProofs,
+	}
+}
+
+// Run returns the time needed for the benchmark to be run
+func (tb *trieBenchmark) Run() (time.Duration, error) {
+	results := make([]time.Duration, 0, tb.numRuns)
+	for i := 0; i < tb.numRuns; i++ {
+		elapsed, err := tb.runOnce()
+		if err != nil {
+			return 0, err
+		}
+
+		results = append(results, elapsed)
+	}
+
+	return getMinimumTimeDuration(results), nil
+}
+
+func (tb *trieBenchmark) runOnce() (time.Duration, error) {
+	tr := integrationTests.CreateNewDefaultTrie()
+	keys := make([][]byte, 0, tb.numKeys)
+	for i := 0; i < tb.numKeys; i++ {
+		keys = append(keys, integrationTests.TestHasher.Compute(fmt.Sprintf("key%d", i)))
+	}
+
+	startTime := time.Now()
+	for i, key := range keys {
+		err := tr.Update(key, []byte(fmt.Sprintf("value%d", i)))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	err := tr.Commit()
+	if err != nil {
+		return 0, err
+	}
+
+	rootHash, err := tr.RootHash()
+	if err != nil {
+		return 0, err
+	}
+
+	for i := 0; i < tb.numProofs && i < len(keys); i++ {
+		proof, _, errProof := tr.GetProof(keys[i])
+		if errProof != nil {
+			return 0, errProof
+		}
+
+		ok, errProof := tr.VerifyProof(rootHash, keys[i], proof)
+		if errProof != nil {
+			return 0, errProof
+		}
+		if !ok {
+			return 0, fmt.Errorf("%w for key %x", ErrInvalidProof, keys[i])
+		}
+	}
+
+	return time.Since(startTime), nil
+}
+
+// Name returns the benchmark's name
+func (tb *trieBenchmark) Name() string {
+	return fmt.Sprintf("%s, %d inserts, %d proofs, minimum duration", tb.name, tb.numKeys, tb.numProofs)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (tb *trieBenchmark) IsInterfaceNil() bool {
+	return tb == nil
+}
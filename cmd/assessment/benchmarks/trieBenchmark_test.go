@@ -0,0 +1,31 @@
+package benchmarks
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTrieBenchmark_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	testName := "trie"
+	tb := NewTrieBenchmark(
+		ArgTrieBenchmark{
+			Name:      testName,
+			NumRuns:   2,
+			NumKeys:   1000,
+			NumProofs: 100,
+		},
+	)
+
+	assert.False(t, check.IfNil(tb))
+
+	testDuration, err := tb.Run()
+	assert.Nil(t, err)
+	assert.True(t, testDuration > 0)
+	assert.True(t, strings.Contains(tb.Name(), testName))
+	assert.True(t, strings.Contains(tb.Name(), "100 proofs"))
+}
@@ -44,6 +44,44 @@ VERSION:
 		Value: "./output-" + hostPlaceholder + "-" + timestampPlaceholder + ".csv",
 	}
 
+	// jsonOutputFile defines a flag for the benchmarks JSON output file, the one that can be later compared
+	jsonOutputFile = cli.StringFlag{
+		Name:  "json-output-file",
+		Usage: "The output file format where benchmarks will be written in JSON format. Set it empty to skip the JSON output.",
+		Value: "./output-" + hostPlaceholder + "-" + timestampPlaceholder + ".json",
+	}
+
+	// benchmarksGroups defines a flag for selecting the benchmarks to be run
+	benchmarksGroups = cli.StringFlag{
+		Name: "benchmarks",
+		Usage: "Comma separated list of the benchmarks groups to be run. Available groups: " +
+			strings.Join(factory.AllGroups, ", ") + ". Only the " + factory.GroupVM + " group decides if the host has " +
+			"enough computing power.",
+		Value: factory.GroupVM,
+	}
+
+	// compareBaseline defines a flag for the baseline JSON results file used in compare mode
+	compareBaseline = cli.StringFlag{
+		Name: "compare-baseline",
+		Usage: "The baseline JSON results file. If set, the tool will not run the benchmarks but will compare the " +
+			"results from the compare-current file against this baseline.",
+		Value: "",
+	}
+
+	// compareCurrent defines a flag for the current JSON results file used in compare mode
+	compareCurrent = cli.StringFlag{
+		Name:  "compare-current",
+		Usage: "The JSON results file compared against the baseline.",
+		Value: "",
+	}
+
+	// tolerance defines a flag for the tolerance used in compare mode
+	tolerance = cli.Float64Flag{
+		Name:  "tolerance",
+		Usage: "The percentage a benchmark is allowed to differ from the baseline before being reported as a regression or an improvement.",
+		Value: 10,
+	}
+
 	log = logger.GetOrCreate("main")
 )
 
@@ -60,6 +98,11 @@ func main() {
 		"produces anonymized host parameters along with a list of benchmarks results. More details can be found in the README.md file."
 	app.Flags = []cli.Flag{
 		outputFile,
+		jsonOutputFile,
+		benchmarksGroups,
+		compareBaseline,
+		compareCurrent,
+		tolerance,
 	}
 	app.Authors = []cli.Author{
 		{
@@ -69,6 +112,10 @@ func main() {
 	}
 
 	app.Action = func(c *cli.Context) error {
+		if len(c.GlobalString(compareBaseline.Name)) > 0 {
+			return compareResults(c)
+		}
+
 		return startAssessment(c, app.Version, machineID)
 	}
 
@@ -80,11 +127,12 @@ func main() {
 }
 
 func startAssessment(c *cli.Context, version string, machineID string) error {
-	outputFileName := c.GlobalString(outputFile.Name)
-	outputFileName = strings.Replace(outputFileName, hostPlaceholder, machineID, 1)
-	outputFileName = strings.Replace(outputFileName, timestampPlaceholder, fmt.Sprintf("%d", time.Now().Unix()), 1)
+	timestamp := time.Now().Unix()
+	outputFileName := applyPlaceholders(c.GlobalString(outputFile.Name), machineID, timestamp)
+	jsonOutputFileName := applyPlaceholders(c.GlobalString(jsonOutputFile.Name), machineID, timestamp)
+	groups := parseGroups(c.GlobalString(benchmarksGroups.Name))
 
-	log.Info("Saving benchmarks result", "file", outputFileName)
+	log.Info("Saving benchmarks result", "file", outputFileName, "JSON file", jsonOutputFileName)
 	log.Info("Starting host assessment process...")
 	sw := core.NewStopWatch()
 	sw.Start("whole process")
@@ -94,7 +142,7 @@ func startAssessment(c *cli.Context, version string, machineID string) error {
 	}()
 	log.Info("Benchmark in progress. Please wait!")
 
-	run, err := factory.NewRunner("./testdata")
+	run, err := factory.NewRunner("./testdata", groups)
 	if err != nil {
 		return err
 	}
@@ -109,8 +157,65 @@ func startAssessment(c *cli.Context, version string, machineID string) error {
 	printFinalResult(benchmarkResult)
 
 	err = saveToFile(hostInfo, benchmarkResult, outputFileName)
+	if err != nil {
+		return err
+	}
+	if len(jsonOutputFileName) == 0 {
+		return nil
+	}
+
+	return benchmarks.SaveJSONReport(benchmarkResult.ToJSONReport(hostInfo, timestamp), jsonOutputFileName)
+}
+
+func applyPlaceholders(fileName string, machineID string, timestamp int64) string {
+	fileName = strings.Replace(fileName, hostPlaceholder, machineID, 1)
 
-	return err
+	return strings.Replace(fileName, timestampPlaceholder, fmt.Sprintf("%d", timestamp), 1)
+}
+
+func parseGroups(groups string) []string {
+	result := make([]string, 0)
+	for _, group := range strings.Split(groups, ",") {
+		group = strings.TrimSpace(group)
+		if len(group) > 0 {
+			result = append(result, group)
+		}
+	}
+
+	return result
+}
+
+func compareResults(c *cli.Context) error {
+	baselineFileName := c.GlobalString(compareBaseline.Name)
+	currentFileName := c.GlobalString(compareCurrent.Name)
+	if len(currentFileName) == 0 {
+		return fmt.Errorf("the --%s flag is required in compare mode", compareCurrent.Name)
+	}
+
+	baseline, err := benchmarks.LoadJSONReport(baselineFileName)
+	if err != nil {
+		return fmt.Errorf("%w while loading the baseline file %s", err, baselineFileName)
+	}
+	current, err := benchmarks.LoadJSONReport(currentFileName)
+	if err != nil {
+		return fmt.Errorf("%w while loading the current file %s", err, currentFileName)
+	}
+
+	comparison, err := benchmarks.CompareReports(baseline, current, c.GlobalFloat64(tolerance.Name))
+	if err != nil {
+		return err
+	}
+
+	log.Info("Benchmarks comparison:\n"+comparison.ToDisplayTable(),
+		"baseline", baselineFileName, "current", currentFileName, "tolerance", fmt.Sprintf("%0.2f%%", comparison.TolerancePercent))
+
+	numFailures := comparison.NumFailures()
+	if numFailures > 0 {
+		return fmt.Errorf("%d benchmarks regressed, errored or are missing", numFailures)
+	}
+
+	log.Info("No benchmark regressed")
+	return nil
 }
 
 func printFinalResult(results *benchmarks.TestResults) {
@@ -119,6 +224,11 @@ func printFinalResult(results *benchmarks.TestResults) {
 		return
 	}
 
+	if !results.ComputingPowerEvaluated {
+		log.Info("The Node Under Test (NUT) computing power was not evaluated, the " + factory.GroupVM + " benchmarks group was not run")
+		return
+	}
+
 	if results.EnoughComputingPower {
 		log.Info("The Node Under Test (NUT) has enough computing power")
 		return
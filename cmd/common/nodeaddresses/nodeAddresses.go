@@ -0,0 +1,16 @@
+package nodeaddresses
+
+import "strings"
+
+// Parse splits the provided comma separated list of node addresses, ignoring the blanks and the empty entries
+func Parse(addressesList string) []string {
+	nodeAddresses := make([]string, 0)
+	for _, nodeAddress := range strings.Split(addressesList, ",") {
+		nodeAddress = strings.TrimSpace(nodeAddress)
+		if len(nodeAddress) > 0 {
+			nodeAddresses = append(nodeAddresses, nodeAddress)
+		}
+	}
+
+	return nodeAddresses
+}
@@ -0,0 +1,24 @@
+package nodeaddresses
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParse(t *testing.T) {
+	t.Parallel()
+
+	t.Run("empty list should return no addresses", func(t *testing.T) {
+		t.Parallel()
+
+		assert.Empty(t, Parse(""))
+		assert.Empty(t, Parse(" , ,"))
+	})
+	t.Run("should trim and skip the empty entries", func(t *testing.T) {
+		t.Parallel()
+
+		nodeAddresses := Parse(" 127.0.0.1:8080,,localhost:8081 , ")
+		assert.Equal(t, []string{"127.0.0.1:8080", "localhost:8081"}, nodeAddresses)
+	})
+}
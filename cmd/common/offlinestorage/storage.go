@@ -0,0 +1,242 @@
+// Package offlinestorage opens the storers of a stopped node's working directory, for the tools inspecting or exporting
+// its data
+package offlinestorage
+
+import (
+	"errors"
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/nodetype"
+	"github.com/multiversx/mx-chain-core-go/marshal"
+	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/common/statistics/disabled"
+	"github.com/multiversx/mx-chain-go/config"
+	"github.com/multiversx/mx-chain-go/dataRetriever"
+	disabledBootstrap "github.com/multiversx/mx-chain-go/epochStart/bootstrap/disabled"
+	"github.com/multiversx/mx-chain-go/process/block/bootstrapStorage"
+	"github.com/multiversx/mx-chain-go/sharding"
+	"github.com/multiversx/mx-chain-go/storage"
+	"github.com/multiversx/mx-chain-go/storage/directoryhandler"
+	storageFactory "github.com/multiversx/mx-chain-go/storage/factory"
+	"github.com/multiversx/mx-chain-go/storage/latestData"
+)
+
+// ErrNoEpochDirectories signals that no epoch directory was found for the shard
+var ErrNoEpochDirectories = errors.New("no epoch directories found for the shard")
+
+// ArgsOpenStorage holds the arguments needed for opening the storers of a node's working directory
+type ArgsOpenStorage struct {
+	GeneralConfig config.Config
+	WorkingDir    string
+	NumOfShards   uint32
+	Marshaller    marshal.Marshalizer
+	StorageType   storageFactory.StorageServiceType
+}
+
+// OpenedStorage holds the storers opened from a node's working directory and the shard and epochs they belong to
+type OpenedStorage struct {
+	StorageService dataRetriever.StorageService
+	ShardID        uint32
+	FirstEpoch     uint32
+	LastEpoch      uint32
+}
+
+// OpenStorage opens all the storers of a node's working directory, using the same storage service factory as the node.
+// The persisters of all the epochs found on disk are opened in the mode of the provided storage type and no data
+// cleaning is done
+func OpenStorage(args ArgsOpenStorage) (*OpenedStorage, error) {
+	parentDir := filepath.Join(args.WorkingDir, storage.DefaultDBPath, args.GeneralConfig.GeneralSettings.ChainID)
+	shardID, err := getShardID(args, parentDir)
+	if err != nil {
+		return nil, err
+	}
+
+	firstEpoch, lastEpoch, err := getEpochsOnDisk(parentDir, core.GetShardIDString(shardID))
+	if err != nil {
+		return nil, err
+	}
+
+	pathManager, err := storageFactory.CreatePathManager(storageFactory.ArgCreatePathManager{
+		WorkingDir: args.WorkingDir,
+		ChainID:    args.GeneralConfig.GeneralSettings.ChainID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	shardCoordinator, err := sharding.NewMultiShardCoordinator(args.NumOfShards, shardID)
+	if err != nil {
+		return nil, err
+	}
+
+	additionalStorageServiceCreator, err := storageFactory.NewShardAdditionalStorageServiceFactory()
+	if err != nil {
+		return nil, err
+	}
+
+	storageServiceFactory, err := storageFactory.NewStorageServiceFactory(storageFactory.StorageServiceFactoryArgs{
+		Config:                          prepareConfig(args.GeneralConfig, firstEpoch, lastEpoch),
+		PrefsConfig:                     config.PreferencesConfig{},
+		ShardCoordinator:                shardCoordinator,
+		PathManager:                     pathManager,
+		EpochStartNotifier:              disabledBootstrap.NewEpochStartNotifier(),
+		NodeTypeProvider:                nodetype.NewNodeTypeProvider(core.NodeTypeObserver),
+		StorageType:                     args.StorageType,
+		ManagedPeersHolder:              &disabledManagedPeersHolder{},
+		CurrentEpoch:                    lastEpoch,
+		CreateTrieEpochRootHashStorer:   false,
+		NodeProcessingMode:              common.Normal,
+		RepopulateTokensSupplies:        false,
+		StateStatsHandler:               disabled.NewStateStatistics(),
+		AdditionalStorageServiceCreator: additionalStorageServiceCreator,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var storageService dataRetriever.StorageService
+	if shardID == core.MetachainShardId {
+		storageService, err = storageServiceFactory.CreateForMeta()
+	} else {
+		storageService, err = storageServiceFactory.CreateForShard()
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return &OpenedStorage{
+		StorageService: storageService,
+		ShardID:        shardID,
+		FirstEpoch:     firstEpoch,
+		LastEpoch:      lastEpoch,
+	}, nil
+}
+
+func getShardID(args ArgsOpenStorage, parentDir string) (uint32, error) {
+	bootstrapDataProvider, err := storageFactory.NewBootstrapDataProvider(args.Marshaller)
+	if err != nil {
+		return 0, err
+	}
+
+	latestDataProvider, err := latestData.NewLatestDataProvider(latestData.ArgsLatestDataProvider{
+		GeneralConfig:         args.GeneralConfig,
+		BootstrapDataProvider: bootstrapDataProvider,
+		DirectoryReader:       directoryhandler.NewDirectoryReader(),
+		ParentDir:             parentDir,
+		DefaultEpochString:    storage.DefaultEpochString,
+		DefaultShardString:    storage.DefaultShardString,
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	latest, err := latestDataProvider.Get()
+	if err != nil {
+		return 0, fmt.Errorf("%w while reading the latest data from %s", err, parentDir)
+	}
+
+	return latest.ShardID, nil
+}
+
+// getEpochsOnDisk returns the oldest and the newest epochs holding a directory for the provided shard
+func getEpochsOnDisk(parentDir string, shardIDStr string) (uint32, uint32, error) {
+	directoryReader := directoryhandler.NewDirectoryReader()
+	epochDirs, err := directoryReader.ListDirectoriesAsString(parentDir)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	shardDir := fmt.Sprintf("%s_%s", storage.DefaultShardString, shardIDStr)
+	found := false
+	firstEpoch, lastEpoch := uint32(0), uint32(0)
+	for _, epochDir := range epochDirs {
+		if !strings.HasPrefix(epochDir, storage.DefaultEpochString+"_") {
+			continue
+		}
+
+		var epoch uint32
+		_, err = fmt.Sscanf(epochDir, storage.DefaultEpochString+"_%d", &epoch)
+		if err != nil {
+			continue
+		}
+
+		shardDirs, errList := directoryReader.ListDirectoriesAsString(filepath.Join(parentDir, epochDir))
+		if errList != nil || !containsString(shardDirs, shardDir) {
+			continue
+		}
+
+		if !found || epoch < firstEpoch {
+			firstEpoch = epoch
+		}
+		if !found || epoch > lastEpoch {
+			lastEpoch = epoch
+		}
+		found = true
+	}
+
+	if !found {
+		return 0, 0, fmt.Errorf("%w: %s in %s", ErrNoEpochDirectories, shardDir, parentDir)
+	}
+
+	return firstEpoch, lastEpoch, nil
+}
+
+func containsString(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+
+	return false
+}
+
+// prepareConfig makes the pruning storers open the persisters of all the epochs found on disk and disables any
+// cleaning of the old data
+func prepareConfig(generalConfig config.Config, firstEpoch uint32, lastEpoch uint32) config.Config {
+	numEpochs := uint64(lastEpoch-firstEpoch) + 1
+
+	generalConfig.StoragePruning.NumEpochsToKeep = numEpochs
+	generalConfig.StoragePruning.NumActivePersisters = numEpochs
+	generalConfig.StoragePruning.ValidatorCleanOldEpochsData = false
+	generalConfig.StoragePruning.ObserverCleanOldEpochsData = false
+	generalConfig.StoragePruning.AccountsTrieCleanOldEpochsData = false
+
+	return generalConfig
+}
+
+// GetLastNonce returns the nonce of the last block committed by the node, read from its bootstrap storer
+func GetLastNonce(storageService dataRetriever.StorageService, marshaller marshal.Marshalizer) (uint64, error) {
+	bootstrapUnit, err := storageService.GetStorer(dataRetriever.BootstrapUnit)
+	if err != nil {
+		return 0, err
+	}
+
+	bootstrapStorer, err := bootstrapStorage.NewBootstrapStorer(marshaller, bootstrapUnit)
+	if err != nil {
+		return 0, err
+	}
+
+	bootstrapData, err := bootstrapStorer.Get(bootstrapStorer.GetHighestRound())
+	if err != nil {
+		return 0, fmt.Errorf("%w while reading the last bootstrap data", err)
+	}
+
+	return bootstrapData.LastHeader.Nonce, nil
+}
+
+type disabledManagedPeersHolder struct {
+}
+
+// IsMultiKeyMode returns false
+func (holder *disabledManagedPeersHolder) IsMultiKeyMode() bool {
+	return false
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (holder *disabledManagedPeersHolder) IsInterfaceNil() bool {
+	return holder == nil
+}
@@ -0,0 +1,64 @@
+package offlinestorage
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-go/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPrepareConfig(t *testing.T) {
+	t.Parallel()
+
+	generalConfig := config.Config{}
+	generalConfig.StoragePruning.NumEpochsToKeep = 2
+	generalConfig.StoragePruning.NumActivePersisters = 2
+	generalConfig.StoragePruning.ObserverCleanOldEpochsData = true
+	generalConfig.StoragePruning.ValidatorCleanOldEpochsData = true
+	generalConfig.StoragePruning.AccountsTrieCleanOldEpochsData = true
+
+	prepared := prepareConfig(generalConfig, 3, 7)
+	assert.Equal(t, uint64(5), prepared.StoragePruning.NumEpochsToKeep)
+	assert.Equal(t, uint64(5), prepared.StoragePruning.NumActivePersisters)
+	assert.False(t, prepared.StoragePruning.ObserverCleanOldEpochsData)
+	assert.False(t, prepared.StoragePruning.ValidatorCleanOldEpochsData)
+	assert.False(t, prepared.StoragePruning.AccountsTrieCleanOldEpochsData)
+}
+
+func TestGetEpochsOnDisk(t *testing.T) {
+	t.Parallel()
+
+	t.Run("no epoch directories should error", func(t *testing.T) {
+		t.Parallel()
+
+		parentDir := t.TempDir()
+		require.Nil(t, os.MkdirAll(filepath.Join(parentDir, "Epoch_1", "Shard_1"), os.ModePerm))
+
+		_, _, err := getEpochsOnDisk(parentDir, "0")
+		assert.True(t, errors.Is(err, ErrNoEpochDirectories))
+	})
+	t.Run("should return the oldest and newest epochs of the shard", func(t *testing.T) {
+		t.Parallel()
+
+		parentDir := t.TempDir()
+		dirs := []string{"Epoch_2/Shard_0", "Epoch_5/Shard_0", "Epoch_6/Shard_1", "Epoch_1/Shard_metachain", "Static/Shard_0"}
+		for _, dir := range dirs {
+			require.Nil(t, os.MkdirAll(filepath.Join(parentDir, dir), os.ModePerm))
+		}
+
+		firstEpoch, lastEpoch, err := getEpochsOnDisk(parentDir, "0")
+		assert.Nil(t, err)
+		assert.Equal(t, uint32(2), firstEpoch)
+		assert.Equal(t, uint32(5), lastEpoch)
+
+		firstEpoch, lastEpoch, err = getEpochsOnDisk(parentDir, core.GetShardIDString(core.MetachainShardId))
+		assert.Nil(t, err)
+		assert.Equal(t, uint32(1), firstEpoch)
+		assert.Equal(t, uint32(1), lastEpoch)
+	})
+}
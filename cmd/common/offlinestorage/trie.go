@@ -0,0 +1,54 @@
+package offlinestorage
+
+import (
+	"github.com/multiversx/mx-chain-core-go/hashing"
+	"github.com/multiversx/mx-chain-core-go/marshal"
+	"github.com/multiversx/mx-chain-go/common"
+	commonDisabled "github.com/multiversx/mx-chain-go/common/disabled"
+	"github.com/multiversx/mx-chain-go/common/statistics/disabled"
+	"github.com/multiversx/mx-chain-go/config"
+	"github.com/multiversx/mx-chain-go/dataRetriever"
+	"github.com/multiversx/mx-chain-go/trie"
+)
+
+// CreateTrieStorage creates a trie storage manager on top of the provided unit of the opened storage
+func CreateTrieStorage(
+	storageService dataRetriever.StorageService,
+	unit dataRetriever.UnitType,
+	generalConfig config.Config,
+	marshaller marshal.Marshalizer,
+	hasher hashing.Hasher,
+) (common.StorageManager, error) {
+	storer, err := storageService.GetStorer(unit)
+	if err != nil {
+		return nil, err
+	}
+
+	return trie.NewTrieStorageManager(trie.NewTrieStorageManagerArgs{
+		MainStorer:     storer,
+		Marshalizer:    marshaller,
+		Hasher:         hasher,
+		GeneralConfig:  generalConfig.TrieStorageManagerConfig,
+		IdleProvider:   commonDisabled.NewProcessStatusHandler(),
+		Identifier:     unit.String(),
+		StatsCollector: disabled.NewStateStatistics(),
+	})
+}
+
+// CreateTrie creates a trie on top of the provided unit of the opened storage
+func CreateTrie(
+	storageService dataRetriever.StorageService,
+	unit dataRetriever.UnitType,
+	generalConfig config.Config,
+	maxTrieLevelInMemory uint,
+	marshaller marshal.Marshalizer,
+	hasher hashing.Hasher,
+	enableEpochsHandler common.EnableEpochsHandler,
+) (common.Trie, error) {
+	trieStorage, err := CreateTrieStorage(storageService, unit, generalConfig, marshaller, hasher)
+	if err != nil {
+		return nil, err
+	}
+
+	return trie.NewTrie(trieStorage, marshaller, hasher, enableEpochsHandler, maxTrieLevelInMemory)
+}
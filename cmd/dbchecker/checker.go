@@ -0,0 +1,428 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-core-go/data"
+	"github.com/multiversx/mx-chain-core-go/data/batch"
+	"github.com/multiversx/mx-chain-core-go/data/block"
+	"github.com/multiversx/mx-chain-core-go/data/typeConverters"
+	"github.com/multiversx/mx-chain-core-go/hashing"
+	"github.com/multiversx/mx-chain-core-go/marshal"
+	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/common/errChan"
+	"github.com/multiversx/mx-chain-go/dataRetriever"
+	processCommon "github.com/multiversx/mx-chain-go/process"
+	"github.com/multiversx/mx-chain-go/state/accounts"
+	"github.com/multiversx/mx-chain-go/state/parsers"
+	"github.com/multiversx/mx-chain-go/trie/keyBuilder"
+)
+
+const leavesChannelSize = 100
+
+var errNilStorageService = errors.New("nil storage service")
+var errNilMarshaller = errors.New("nil marshaller")
+var errNilHasher = errors.New("nil hasher")
+var errNilUint64Converter = errors.New("nil uint64 converter")
+var errNilStateTrie = errors.New("nil state trie")
+var errNilPeerTrie = errors.New("nil peer trie")
+var errHashMismatch = errors.New("hash mismatch")
+var errInvalidTrie = errors.New("invalid trie")
+
+type argsDBChecker struct {
+	storageService    dataRetriever.StorageService
+	marshaller        marshal.Marshalizer
+	hasher            hashing.Hasher
+	uint64Converter   typeConverters.Uint64ByteSliceConverter
+	stateTrie         common.Trie
+	peerTrie          common.Trie
+	selfShardID       uint32
+	firstEpoch        uint32
+	lastEpoch         uint32
+	lastNonce         uint64
+	numBlocksForTries uint64
+}
+
+type dbChecker struct {
+	storageService    dataRetriever.StorageService
+	marshaller        marshal.Marshalizer
+	hasher            hashing.Hasher
+	uint64Converter   typeConverters.Uint64ByteSliceConverter
+	stateTrie         common.Trie
+	peerTrie          common.Trie
+	selfShardID       uint32
+	firstEpoch        uint32
+	lastNonce         uint64
+	numBlocksForTries uint64
+	emptyReceiptsHash []byte
+	checkedDataTries  map[string]struct{}
+	report            *report
+}
+
+// newDBChecker creates a checker that walks the stored blocks from the last nonce backwards, until it reaches the
+// first block of the oldest epoch found on disk, and verifies all the items referenced by them
+func newDBChecker(args argsDBChecker) (*dbChecker, error) {
+	if check.IfNil(args.storageService) {
+		return nil, errNilStorageService
+	}
+	if check.IfNil(args.marshaller) {
+		return nil, errNilMarshaller
+	}
+	if check.IfNil(args.hasher) {
+		return nil, errNilHasher
+	}
+	if check.IfNil(args.uint64Converter) {
+		return nil, errNilUint64Converter
+	}
+	if check.IfNil(args.stateTrie) {
+		return nil, errNilStateTrie
+	}
+	if args.selfShardID == core.MetachainShardId && check.IfNil(args.peerTrie) {
+		return nil, errNilPeerTrie
+	}
+
+	emptyReceiptsHash, err := core.CalculateHash(args.marshaller, args.hasher, &batch.Batch{Data: make([][]byte, 0)})
+	if err != nil {
+		return nil, err
+	}
+
+	return &dbChecker{
+		storageService:    args.storageService,
+		marshaller:        args.marshaller,
+		hasher:            args.hasher,
+		uint64Converter:   args.uint64Converter,
+		stateTrie:         args.stateTrie,
+		peerTrie:          args.peerTrie,
+		selfShardID:       args.selfShardID,
+		firstEpoch:        args.firstEpoch,
+		lastNonce:         args.lastNonce,
+		numBlocksForTries: args.numBlocksForTries,
+		emptyReceiptsHash: emptyReceiptsHash,
+		checkedDataTries:  make(map[string]struct{}),
+		report:            newReport(args.selfShardID, args.firstEpoch, args.lastEpoch, args.lastNonce),
+	}, nil
+}
+
+// check runs all the verifications and returns the resulting report
+func (checker *dbChecker) check() *report {
+	headersForTries := checker.checkBlocks()
+	checker.checkTries(headersForTries)
+	checker.report.finalize()
+
+	return checker.report
+}
+
+func (checker *dbChecker) checkBlocks() []data.HeaderHandler {
+	headersForTries := make([]data.HeaderHandler, 0, checker.numBlocksForTries)
+	currentEpoch := checker.report.LastEpoch
+	var higherHeader data.HeaderHandler
+
+	for nonce := checker.lastNonce; nonce > 0; nonce-- {
+		header := checker.checkHeader(nonce, currentEpoch, higherHeader)
+		higherHeader = header
+		if check.IfNil(header) {
+			continue
+		}
+
+		currentEpoch = header.GetEpoch()
+		if uint64(len(headersForTries)) < checker.numBlocksForTries {
+			headersForTries = append(headersForTries, header)
+		}
+		if nonce%progressLogInterval == 0 {
+			log.Info("checking blocks", "nonce", nonce, "epoch", currentEpoch, "issues", len(checker.report.Issues))
+		}
+
+		isFirstBlockOnDisk := header.IsStartOfEpochBlock() && currentEpoch <= checker.firstEpoch
+		if isFirstBlockOnDisk {
+			break
+		}
+	}
+
+	return headersForTries
+}
+
+func (checker *dbChecker) checkHeader(nonce uint64, epoch uint32, higherHeader data.HeaderHandler) data.HeaderHandler {
+	nonceHashUnit := checker.nonceHashUnit()
+	headerHash, err := checker.storageService.Get(nonceHashUnit, checker.uint64Converter.ToByteSlice(nonce))
+	if err != nil {
+		checker.report.addIssue(issueMissing, nonceHashUnit.String(), checker.uint64Converter.ToByteSlice(nonce), epoch, nonce, err.Error())
+		return nil
+	}
+
+	headerUnit := checker.headerUnit()
+	headerBytes, ok := checker.getAndVerify(headerUnit, headerHash, epoch, nonce)
+	if !ok {
+		return nil
+	}
+
+	header, err := processCommon.UnmarshalHeader(checker.selfShardID, checker.marshaller, headerBytes)
+	if err != nil {
+		checker.report.addIssue(issueCorrupt, headerUnit.String(), headerHash, epoch, nonce, err.Error())
+		return nil
+	}
+	if header.GetNonce() != nonce {
+		details := fmt.Sprintf("header nonce %d does not match the nonce %d it is stored for", header.GetNonce(), nonce)
+		checker.report.addIssue(issueCorrupt, nonceHashUnit.String(), checker.uint64Converter.ToByteSlice(nonce), header.GetEpoch(), nonce, details)
+		return nil
+	}
+	if !check.IfNil(higherHeader) && !bytes.Equal(higherHeader.GetPrevHash(), headerHash) {
+		details := fmt.Sprintf("the previous hash of the block with nonce %d does not match", higherHeader.GetNonce())
+		checker.report.addIssue(issueCorrupt, nonceHashUnit.String(), checker.uint64Converter.ToByteSlice(nonce), header.GetEpoch(), nonce, details)
+	}
+
+	checker.report.addHeader(header.GetEpoch(), nonce)
+	checker.checkMiniBlocks(header)
+	checker.checkReceipts(header)
+
+	return header
+}
+
+func (checker *dbChecker) checkMiniBlocks(header data.HeaderHandler) {
+	er := checker.report.getEpochReport(header.GetEpoch())
+	for _, mbHeader := range header.GetMiniBlockHeaderHandlers() {
+		miniBlockBytes, ok := checker.getAndVerify(dataRetriever.MiniBlockUnit, mbHeader.GetHash(), header.GetEpoch(), header.GetNonce())
+		if !ok {
+			continue
+		}
+		er.NumMiniBlocks++
+
+		miniBlock := &block.MiniBlock{}
+		err := checker.marshaller.Unmarshal(miniBlock, miniBlockBytes)
+		if err != nil {
+			checker.report.addIssue(issueCorrupt, dataRetriever.MiniBlockUnit.String(), mbHeader.GetHash(), header.GetEpoch(), header.GetNonce(), err.Error())
+			continue
+		}
+
+		checker.checkTransactions(miniBlock, mbHeader, header)
+	}
+}
+
+func (checker *dbChecker) checkTransactions(miniBlock *block.MiniBlock, mbHeader data.MiniBlockHeaderHandler, header data.HeaderHandler) {
+	unit, ok := getTransactionsUnit(miniBlock.Type)
+	if !ok {
+		return
+	}
+
+	firstIndex := int(mbHeader.GetIndexOfFirstTxProcessed())
+	if firstIndex < 0 {
+		firstIndex = 0
+	}
+	lastIndex := int(mbHeader.GetIndexOfLastTxProcessed())
+	if lastIndex >= len(miniBlock.TxHashes) {
+		lastIndex = len(miniBlock.TxHashes) - 1
+	}
+
+	er := checker.report.getEpochReport(header.GetEpoch())
+	for index := firstIndex; index <= lastIndex; index++ {
+		_, ok = checker.getAndVerify(unit, miniBlock.TxHashes[index], header.GetEpoch(), header.GetNonce())
+		if ok {
+			er.NumTransactions++
+		}
+	}
+}
+
+func getTransactionsUnit(miniBlockType block.Type) (dataRetriever.UnitType, bool) {
+	switch miniBlockType {
+	case block.TxBlock, block.InvalidBlock:
+		return dataRetriever.TransactionUnit, true
+	case block.SmartContractResultBlock, block.ReceiptBlock:
+		return dataRetriever.UnsignedTransactionUnit, true
+	case block.RewardsBlock:
+		return dataRetriever.RewardTransactionUnit, true
+	default:
+		return 0, false
+	}
+}
+
+// checkReceipts verifies the receipts saved for a block, if any. The receipts hash from the header is computed
+// over the hashes of the saved miniblocks, see transactionCoordinator.CreateReceiptsHash
+func (checker *dbChecker) checkReceipts(header data.HeaderHandler) {
+	receiptsHash := header.GetReceiptsHash()
+	if len(receiptsHash) == 0 || bytes.Equal(receiptsHash, checker.emptyReceiptsHash) {
+		return
+	}
+
+	receiptsBytes, err := checker.storageService.Get(dataRetriever.ReceiptsUnit, receiptsHash)
+	if err != nil {
+		checker.report.addIssue(issueMissing, dataRetriever.ReceiptsUnit.String(), receiptsHash, header.GetEpoch(), header.GetNonce(), err.Error())
+		return
+	}
+
+	receiptsBatch := &batch.Batch{}
+	err = checker.marshaller.Unmarshal(receiptsBatch, receiptsBytes)
+	if err != nil {
+		checker.report.addIssue(issueCorrupt, dataRetriever.ReceiptsUnit.String(), receiptsHash, header.GetEpoch(), header.GetNonce(), err.Error())
+		return
+	}
+
+	miniBlocksHashes := make([][]byte, 0, len(receiptsBatch.Data))
+	for _, miniBlockBytes := range receiptsBatch.Data {
+		miniBlocksHashes = append(miniBlocksHashes, checker.hasher.Compute(string(miniBlockBytes)))
+	}
+	computedHash, err := core.CalculateHash(checker.marshaller, checker.hasher, &batch.Batch{Data: miniBlocksHashes})
+	if err != nil || !bytes.Equal(computedHash, receiptsHash) {
+		checker.report.addIssue(issueCorrupt, dataRetriever.ReceiptsUnit.String(), receiptsHash, header.GetEpoch(), header.GetNonce(), errHashMismatch.Error())
+		return
+	}
+
+	checker.report.getEpochReport(header.GetEpoch()).NumReceipts++
+}
+
+// getAndVerify fetches the value stored for the provided hash and checks that the value hashes to the key
+func (checker *dbChecker) getAndVerify(unit dataRetriever.UnitType, hash []byte, epoch uint32, headerNonce uint64) ([]byte, bool) {
+	value, err := checker.storageService.Get(unit, hash)
+	if err != nil {
+		checker.report.addIssue(issueMissing, unit.String(), hash, epoch, headerNonce, err.Error())
+		return nil, false
+	}
+
+	if !bytes.Equal(checker.hasher.Compute(string(value)), hash) {
+		checker.report.addIssue(issueCorrupt, unit.String(), hash, epoch, headerNonce, errHashMismatch.Error())
+		return nil, false
+	}
+
+	return value, true
+}
+
+func (checker *dbChecker) checkTries(headers []data.HeaderHandler) {
+	checkedRootHashes := make(map[string]struct{})
+	for _, header := range headers {
+		checker.checkTrie(checker.stateTrie, dataRetriever.UserAccountsUnit, header.GetRootHash(), header, checkedRootHashes)
+
+		metaHeader, isMetaHeader := header.(data.MetaHeaderHandler)
+		if isMetaHeader {
+			checker.checkTrie(checker.peerTrie, dataRetriever.PeerAccountsUnit, metaHeader.GetValidatorStatsRootHash(), header, checkedRootHashes)
+		}
+	}
+}
+
+func (checker *dbChecker) checkTrie(
+	tr common.Trie,
+	unit dataRetriever.UnitType,
+	rootHash []byte,
+	header data.HeaderHandler,
+	checkedRootHashes map[string]struct{},
+) {
+	_, alreadyChecked := checkedRootHashes[string(rootHash)]
+	if alreadyChecked {
+		return
+	}
+	checkedRootHashes[string(rootHash)] = struct{}{}
+
+	tReport := &trieReport{
+		Unit:        unit.String(),
+		HeaderNonce: header.GetNonce(),
+		RootHash:    fmt.Sprintf("%x", rootHash),
+	}
+	checker.report.Tries = append(checker.report.Tries, tReport)
+	log.Info("checking trie", "unit", tReport.Unit, "root hash", rootHash, "header nonce", tReport.HeaderNonce)
+
+	numNodes, err := checker.getNumTrieNodes(tr, "", rootHash)
+	if err != nil {
+		checker.report.addIssue(issueMissing, unit.String(), rootHash, header.GetEpoch(), header.GetNonce(), err.Error())
+		return
+	}
+	tReport.NumNodes = numNodes
+	tReport.Complete = true
+
+	if unit != dataRetriever.UserAccountsUnit {
+		return
+	}
+
+	numIssues := len(checker.report.Issues)
+	checker.checkDataTries(tr, unit, rootHash, header, tReport)
+	tReport.Complete = numIssues == len(checker.report.Issues)
+}
+
+func (checker *dbChecker) checkDataTries(
+	tr common.Trie,
+	unit dataRetriever.UnitType,
+	rootHash []byte,
+	header data.HeaderHandler,
+	tReport *trieReport,
+) {
+	iteratorChannels := &common.TrieIteratorChannels{
+		LeavesChan: make(chan core.KeyValueHolder, leavesChannelSize),
+		ErrChan:    errChan.NewErrChanWrapper(),
+	}
+	err := tr.GetAllLeavesOnChannel(
+		iteratorChannels,
+		context.Background(),
+		rootHash,
+		keyBuilder.NewKeyBuilder(),
+		parsers.NewMainTrieLeafParser(),
+	)
+	if err != nil {
+		checker.report.addIssue(issueMissing, unit.String(), rootHash, header.GetEpoch(), header.GetNonce(), err.Error())
+		return
+	}
+
+	for leaf := range iteratorChannels.LeavesChan {
+		account := &accounts.UserAccountData{}
+		errUnmarshal := checker.marshaller.Unmarshal(account, leaf.Value())
+		if errUnmarshal != nil {
+			// leaves holding code are skipped
+			continue
+		}
+		if common.IsEmptyTrie(account.RootHash) {
+			continue
+		}
+
+		tReport.NumDataTries++
+		_, alreadyChecked := checker.checkedDataTries[string(account.RootHash)]
+		if alreadyChecked {
+			continue
+		}
+		checker.checkedDataTries[string(account.RootHash)] = struct{}{}
+
+		address := fmt.Sprintf("%x", leaf.Key())
+		numNodes, errStats := checker.getNumTrieNodes(tr, address, account.RootHash)
+		if errStats != nil {
+			details := fmt.Sprintf("data trie of account %s: %s", address, errStats.Error())
+			checker.report.addIssue(issueMissing, unit.String(), account.RootHash, header.GetEpoch(), header.GetNonce(), details)
+			continue
+		}
+		tReport.NumNodes += numNodes
+	}
+
+	err = iteratorChannels.ErrChan.ReadFromChanNonBlocking()
+	if err != nil {
+		checker.report.addIssue(issueMissing, unit.String(), rootHash, header.GetEpoch(), header.GetNonce(), err.Error())
+	}
+}
+
+// getNumTrieNodes traverses the whole trie, failing on the first node that cannot be loaded
+func (checker *dbChecker) getNumTrieNodes(tr common.Trie, address string, rootHash []byte) (uint64, error) {
+	trieStats, ok := tr.(common.TrieStats)
+	if !ok {
+		return 0, fmt.Errorf("%w, type is %T", errInvalidTrie, tr)
+	}
+
+	stats, err := trieStats.GetTrieStats(address, rootHash)
+	if err != nil {
+		return 0, err
+	}
+
+	return stats.GetTotalNumNodes(), nil
+}
+
+func (checker *dbChecker) nonceHashUnit() dataRetriever.UnitType {
+	if checker.selfShardID == core.MetachainShardId {
+		return dataRetriever.MetaHdrNonceHashDataUnit
+	}
+
+	return dataRetriever.ShardHdrNonceHashDataUnit + dataRetriever.UnitType(checker.selfShardID)
+}
+
+func (checker *dbChecker) headerUnit() dataRetriever.UnitType {
+	if checker.selfShardID == core.MetachainShardId {
+		return dataRetriever.MetaBlockUnit
+	}
+
+	return dataRetriever.BlockHeaderUnit
+}
@@ -0,0 +1,396 @@
+package main
+
+import (
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/data/batch"
+	"github.com/multiversx/mx-chain-core-go/data/block"
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	"github.com/multiversx/mx-chain-core-go/data/typeConverters/uint64ByteSlice"
+	"github.com/multiversx/mx-chain-core-go/hashing/blake2b"
+	"github.com/multiversx/mx-chain-core-go/marshal"
+	"github.com/multiversx/mx-chain-go/cmd/common/offlinestorage"
+	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/config"
+	"github.com/multiversx/mx-chain-go/dataRetriever"
+	"github.com/multiversx/mx-chain-go/state/accounts"
+	"github.com/multiversx/mx-chain-go/testscommon"
+	"github.com/multiversx/mx-chain-go/testscommon/enableEpochsHandlerMock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testNumBlocks = 6
+
+type testChain struct {
+	args             argsDBChecker
+	headerHashes     map[uint64][]byte
+	miniBlockHashes  map[uint64][]byte
+	txHashes         map[uint64][][]byte
+	receiptsHash     []byte
+	rootHash         []byte
+	dataTrieRootHash []byte
+}
+
+// createTestChain creates the blocks with nonces 1..testNumBlocks, two blocks per epoch, the first block of each
+// epoch, besides epoch 0, being a start of epoch block. Each block has a miniblock with two transactions and the
+// last block also has receipts
+func createTestChain(t *testing.T) *testChain {
+	marshaller := &marshal.GogoProtoMarshalizer{}
+	hasher := blake2b.NewBlake2b()
+	converter := uint64ByteSlice.NewBigEndianConverter()
+
+	storageService := dataRetriever.NewChainStorer()
+	units := []dataRetriever.UnitType{
+		dataRetriever.ShardHdrNonceHashDataUnit,
+		dataRetriever.BlockHeaderUnit,
+		dataRetriever.MiniBlockUnit,
+		dataRetriever.TransactionUnit,
+		dataRetriever.UnsignedTransactionUnit,
+		dataRetriever.RewardTransactionUnit,
+		dataRetriever.ReceiptsUnit,
+		dataRetriever.UserAccountsUnit,
+	}
+	for _, unit := range units {
+		storageService.AddStorer(unit, testscommon.CreateMemUnit())
+	}
+
+	stateTrie, rootHash, dataTrieRootHash := createTestState(t, storageService, marshaller)
+
+	chain := &testChain{
+		headerHashes:     make(map[uint64][]byte),
+		miniBlockHashes:  make(map[uint64][]byte),
+		txHashes:         make(map[uint64][][]byte),
+		rootHash:         rootHash,
+		dataTrieRootHash: dataTrieRootHash,
+	}
+
+	putMarshalled := func(unit dataRetriever.UnitType, obj interface{}) []byte {
+		buff, err := marshaller.Marshal(obj)
+		require.Nil(t, err)
+		hash := hasher.Compute(string(buff))
+		require.Nil(t, storageService.Put(unit, hash, buff))
+
+		return hash
+	}
+
+	prevHash := []byte("genesis hash")
+	for nonce := uint64(1); nonce <= testNumBlocks; nonce++ {
+		miniBlock := &block.MiniBlock{
+			Type: block.TxBlock,
+		}
+		for i := uint64(0); i < 2; i++ {
+			txHash := putMarshalled(dataRetriever.TransactionUnit, &transaction.Transaction{Nonce: nonce*10 + i})
+			miniBlock.TxHashes = append(miniBlock.TxHashes, txHash)
+		}
+		chain.txHashes[nonce] = miniBlock.TxHashes
+		chain.miniBlockHashes[nonce] = putMarshalled(dataRetriever.MiniBlockUnit, miniBlock)
+
+		header := &block.Header{
+			Nonce:    nonce,
+			Epoch:    uint32((nonce - 1) / 2),
+			PrevHash: prevHash,
+			RootHash: rootHash,
+			MiniBlockHeaders: []block.MiniBlockHeader{
+				{
+					Hash:    chain.miniBlockHashes[nonce],
+					TxCount: 2,
+				},
+			},
+		}
+		if header.Epoch > 0 && nonce%2 == 1 {
+			header.EpochStartMetaHash = []byte("epoch start meta hash")
+		}
+		if nonce == testNumBlocks {
+			receiptsMiniBlock := &block.MiniBlock{Type: block.ReceiptBlock}
+			receiptsMiniBlockBytes, err := marshaller.Marshal(receiptsMiniBlock)
+			require.Nil(t, err)
+			header.ReceiptsHash, err = core.CalculateHash(marshaller, hasher, &batch.Batch{Data: [][]byte{hasher.Compute(string(receiptsMiniBlockBytes))}})
+			require.Nil(t, err)
+
+			receiptsBytes, err := marshaller.Marshal(&batch.Batch{Data: [][]byte{receiptsMiniBlockBytes}})
+			require.Nil(t, err)
+			require.Nil(t, storageService.Put(dataRetriever.ReceiptsUnit, header.ReceiptsHash, receiptsBytes))
+			chain.receiptsHash = header.ReceiptsHash
+		}
+
+		prevHash = putMarshalled(dataRetriever.BlockHeaderUnit, header)
+		chain.headerHashes[nonce] = prevHash
+		require.Nil(t, storageService.Put(dataRetriever.ShardHdrNonceHashDataUnit, converter.ToByteSlice(nonce), prevHash))
+	}
+
+	chain.args = argsDBChecker{
+		storageService:    storageService,
+		marshaller:        marshaller,
+		hasher:            hasher,
+		uint64Converter:   converter,
+		stateTrie:         stateTrie,
+		selfShardID:       0,
+		firstEpoch:        0,
+		lastEpoch:         uint32((testNumBlocks - 1) / 2),
+		lastNonce:         testNumBlocks,
+		numBlocksForTries: 2,
+	}
+
+	return chain
+}
+
+func createTestState(t *testing.T, storageService dataRetriever.StorageService, marshaller marshal.Marshalizer) (common.Trie, []byte, []byte) {
+	generalConfig := config.Config{
+		TrieStorageManagerConfig: config.TrieStorageManagerConfig{
+			PruningBufferLen:      1000,
+			SnapshotsBufferLen:    10,
+			SnapshotsGoroutineNum: 2,
+		},
+	}
+	enableEpochsHandler := enableEpochsHandlerMock.NewEnableEpochsHandlerStub()
+	hasher := blake2b.NewBlake2b()
+
+	dataTrie, err := offlinestorage.CreateTrie(storageService, dataRetriever.UserAccountsUnit, generalConfig, 5, marshaller, hasher, enableEpochsHandler)
+	require.Nil(t, err)
+	require.Nil(t, dataTrie.Update([]byte("key1"), []byte("value1")))
+	require.Nil(t, dataTrie.Update([]byte("key2"), []byte("value2")))
+	require.Nil(t, dataTrie.Commit())
+	dataTrieRootHash, err := dataTrie.RootHash()
+	require.Nil(t, err)
+
+	stateTrie, err := offlinestorage.CreateTrie(storageService, dataRetriever.UserAccountsUnit, generalConfig, 5, marshaller, hasher, enableEpochsHandler)
+	require.Nil(t, err)
+	accountsData := []*accounts.UserAccountData{
+		{Address: []byte("address1"), Nonce: 1},
+		{Address: []byte("address2"), Nonce: 2, RootHash: dataTrieRootHash},
+	}
+	for _, account := range accountsData {
+		accountBytes, errMarshal := marshaller.Marshal(account)
+		require.Nil(t, errMarshal)
+		require.Nil(t, stateTrie.Update(account.Address, accountBytes))
+	}
+	require.Nil(t, stateTrie.Commit())
+	rootHash, err := stateTrie.RootHash()
+	require.Nil(t, err)
+
+	return stateTrie, rootHash, dataTrieRootHash
+}
+
+func requireIssue(t *testing.T, checkReport *report, kind string, unit dataRetriever.UnitType, headerNonce uint64) {
+	for _, i := range checkReport.Issues {
+		if i.Kind == kind && i.Unit == unit.String() && i.HeaderNonce == headerNonce {
+			return
+		}
+	}
+
+	require.Fail(t, "issue not found", "kind %s, unit %s, header nonce %d, issues %v", kind, unit.String(), headerNonce, checkReport.Issues)
+}
+
+func TestNewDBChecker(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil storage service should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createTestChain(t).args
+		args.storageService = nil
+		checker, err := newDBChecker(args)
+		assert.Nil(t, checker)
+		assert.Equal(t, errNilStorageService, err)
+	})
+	t.Run("nil marshaller should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createTestChain(t).args
+		args.marshaller = nil
+		checker, err := newDBChecker(args)
+		assert.Nil(t, checker)
+		assert.Equal(t, errNilMarshaller, err)
+	})
+	t.Run("nil hasher should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createTestChain(t).args
+		args.hasher = nil
+		checker, err := newDBChecker(args)
+		assert.Nil(t, checker)
+		assert.Equal(t, errNilHasher, err)
+	})
+	t.Run("nil uint64 converter should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createTestChain(t).args
+		args.uint64Converter = nil
+		checker, err := newDBChecker(args)
+		assert.Nil(t, checker)
+		assert.Equal(t, errNilUint64Converter, err)
+	})
+	t.Run("nil state trie should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createTestChain(t).args
+		args.stateTrie = nil
+		checker, err := newDBChecker(args)
+		assert.Nil(t, checker)
+		assert.Equal(t, errNilStateTrie, err)
+	})
+	t.Run("nil peer trie on metachain should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createTestChain(t).args
+		args.selfShardID = core.MetachainShardId
+		checker, err := newDBChecker(args)
+		assert.Nil(t, checker)
+		assert.Equal(t, errNilPeerTrie, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		checker, err := newDBChecker(createTestChain(t).args)
+		assert.Nil(t, err)
+		assert.NotNil(t, checker)
+	})
+}
+
+func TestDBChecker_Check(t *testing.T) {
+	t.Parallel()
+
+	t.Run("intact database should not report issues", func(t *testing.T) {
+		t.Parallel()
+
+		checker, _ := newDBChecker(createTestChain(t).args)
+		checkReport := checker.check()
+
+		assert.Equal(t, 0, checkReport.NumIssues)
+		require.Equal(t, 3, len(checkReport.Epochs))
+		for _, er := range checkReport.Epochs {
+			assert.Equal(t, uint64(2), er.NumHeaders)
+			assert.Equal(t, uint64(2), er.NumMiniBlocks)
+			assert.Equal(t, uint64(4), er.NumTransactions)
+		}
+		assert.Equal(t, uint64(1), checkReport.Epochs[2].NumReceipts)
+		assert.Equal(t, uint64(5), checkReport.Epochs[2].FirstNonce)
+		assert.Equal(t, uint64(6), checkReport.Epochs[2].LastNonce)
+
+		// all the blocks have the same root hash so the trie is checked only once
+		require.Equal(t, 1, len(checkReport.Tries))
+		assert.True(t, checkReport.Tries[0].Complete)
+		assert.Equal(t, uint64(1), checkReport.Tries[0].NumDataTries)
+		assert.Equal(t, dataRetriever.UserAccountsUnit.String(), checkReport.Tries[0].Unit)
+	})
+	t.Run("should stop at the first block of the oldest epoch on disk", func(t *testing.T) {
+		t.Parallel()
+
+		args := createTestChain(t).args
+		args.firstEpoch = 1
+		checker, _ := newDBChecker(args)
+		checkReport := checker.check()
+
+		assert.Equal(t, 0, checkReport.NumIssues)
+		require.Equal(t, 2, len(checkReport.Epochs))
+		assert.Equal(t, uint32(1), checkReport.Epochs[0].Epoch)
+		assert.Equal(t, uint64(3), checkReport.Epochs[0].FirstNonce)
+	})
+	t.Run("missing nonce to hash entry should be reported", func(t *testing.T) {
+		t.Parallel()
+
+		chain := createTestChain(t)
+		storer, _ := chain.args.storageService.GetStorer(dataRetriever.ShardHdrNonceHashDataUnit)
+		_ = storer.Remove(chain.args.uint64Converter.ToByteSlice(4))
+
+		checker, _ := newDBChecker(chain.args)
+		checkReport := checker.check()
+
+		assert.Equal(t, 1, checkReport.NumIssues)
+		requireIssue(t, checkReport, issueMissing, dataRetriever.ShardHdrNonceHashDataUnit, 4)
+		assert.Equal(t, uint64(1), checkReport.Epochs[1].NumHeaders)
+	})
+	t.Run("corrupt header should be reported", func(t *testing.T) {
+		t.Parallel()
+
+		chain := createTestChain(t)
+		_ = chain.args.storageService.Put(dataRetriever.BlockHeaderUnit, chain.headerHashes[2], []byte("corrupt"))
+
+		checker, _ := newDBChecker(chain.args)
+		checkReport := checker.check()
+
+		assert.Equal(t, 1, checkReport.NumIssues)
+		requireIssue(t, checkReport, issueCorrupt, dataRetriever.BlockHeaderUnit, 2)
+	})
+	t.Run("broken chain should be reported", func(t *testing.T) {
+		t.Parallel()
+
+		chain := createTestChain(t)
+		_ = chain.args.storageService.Put(dataRetriever.ShardHdrNonceHashDataUnit, chain.args.uint64Converter.ToByteSlice(3), chain.headerHashes[1])
+
+		checker, _ := newDBChecker(chain.args)
+		checkReport := checker.check()
+
+		requireIssue(t, checkReport, issueCorrupt, dataRetriever.ShardHdrNonceHashDataUnit, 3)
+	})
+	t.Run("missing and corrupt block items should be reported", func(t *testing.T) {
+		t.Parallel()
+
+		chain := createTestChain(t)
+		txStorer, _ := chain.args.storageService.GetStorer(dataRetriever.TransactionUnit)
+		_ = txStorer.Remove(chain.txHashes[3][1])
+		_ = chain.args.storageService.Put(dataRetriever.MiniBlockUnit, chain.miniBlockHashes[5], []byte("corrupt"))
+		receiptsStorer, _ := chain.args.storageService.GetStorer(dataRetriever.ReceiptsUnit)
+		_ = receiptsStorer.Remove(chain.receiptsHash)
+
+		checker, _ := newDBChecker(chain.args)
+		checkReport := checker.check()
+
+		assert.Equal(t, 3, checkReport.NumIssues)
+		requireIssue(t, checkReport, issueMissing, dataRetriever.TransactionUnit, 3)
+		requireIssue(t, checkReport, issueCorrupt, dataRetriever.MiniBlockUnit, 5)
+		requireIssue(t, checkReport, issueMissing, dataRetriever.ReceiptsUnit, 6)
+		assert.Equal(t, uint64(1), checkReport.Epochs[1].NumIssues)
+		assert.Equal(t, uint64(2), checkReport.Epochs[2].NumIssues)
+	})
+	t.Run("missing data trie should be reported", func(t *testing.T) {
+		t.Parallel()
+
+		chain := createTestChain(t)
+		trieStorer, _ := chain.args.storageService.GetStorer(dataRetriever.UserAccountsUnit)
+		_ = trieStorer.Remove(chain.dataTrieRootHash)
+
+		checker, _ := newDBChecker(chain.args)
+		checkReport := checker.check()
+
+		assert.Equal(t, 1, checkReport.NumIssues)
+		requireIssue(t, checkReport, issueMissing, dataRetriever.UserAccountsUnit, testNumBlocks)
+		require.Equal(t, 1, len(checkReport.Tries))
+		assert.False(t, checkReport.Tries[0].Complete)
+	})
+	t.Run("missing state trie root should be reported", func(t *testing.T) {
+		t.Parallel()
+
+		chain := createTestChain(t)
+		trieStorer, _ := chain.args.storageService.GetStorer(dataRetriever.UserAccountsUnit)
+		_ = trieStorer.Remove(chain.rootHash)
+
+		checker, _ := newDBChecker(chain.args)
+		checkReport := checker.check()
+
+		requireIssue(t, checkReport, issueMissing, dataRetriever.UserAccountsUnit, testNumBlocks)
+		assert.False(t, checkReport.Tries[0].Complete)
+		assert.Equal(t, uint64(0), checkReport.Tries[0].NumNodes)
+	})
+}
+
+func TestGetTransactionsUnit(t *testing.T) {
+	t.Parallel()
+
+	unit, ok := getTransactionsUnit(block.TxBlock)
+	assert.True(t, ok)
+	assert.Equal(t, dataRetriever.TransactionUnit, unit)
+
+	unit, ok = getTransactionsUnit(block.SmartContractResultBlock)
+	assert.True(t, ok)
+	assert.Equal(t, dataRetriever.UnsignedTransactionUnit, unit)
+
+	unit, ok = getTransactionsUnit(block.RewardsBlock)
+	assert.True(t, ok)
+	assert.Equal(t, dataRetriever.RewardTransactionUnit, unit)
+
+	_, ok = getTransactionsUnit(block.PeerBlock)
+	assert.False(t, ok)
+}
@@ -0,0 +1,276 @@
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"runtime"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/data/typeConverters/uint64ByteSlice"
+	"github.com/multiversx/mx-chain-core-go/hashing"
+	hasherFactory "github.com/multiversx/mx-chain-core-go/hashing/factory"
+	"github.com/multiversx/mx-chain-core-go/marshal"
+	marshallerFactory "github.com/multiversx/mx-chain-core-go/marshal/factory"
+	"github.com/multiversx/mx-chain-go/cmd/common/offlinestorage"
+	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/common/enablers"
+	"github.com/multiversx/mx-chain-go/common/forking"
+	"github.com/multiversx/mx-chain-go/config"
+	"github.com/multiversx/mx-chain-go/dataRetriever"
+	storageFactory "github.com/multiversx/mx-chain-go/storage/factory"
+	logger "github.com/multiversx/mx-chain-logger-go"
+	"github.com/urfave/cli"
+)
+
+const (
+	progressLogInterval = 10000
+	reportFilePerm      = 0644
+)
+
+type cfg struct {
+	workingDir        string
+	configFile        string
+	epochConfigFile   string
+	numOfShards       uint
+	numBlocksForTries uint64
+	reportFile        string
+	logLevel          string
+}
+
+var (
+	helpTemplate = `NAME:
+   {{.Name}} - {{.Usage}}
+USAGE:
+   {{.HelpName}} {{if .VisibleFlags}}[global options]{{end}}
+   {{if len .Authors}}
+AUTHOR:
+   {{range .Authors}}{{ . }}{{end}}
+   {{end}}{{if .Commands}}
+GLOBAL OPTIONS:
+   {{range .VisibleFlags}}{{.}}
+   {{end}}
+VERSION:
+   {{.Version}}
+   {{end}}
+`
+	// workingDirectory defines a flag for the node's working directory
+	workingDirectory = cli.StringFlag{
+		Name:        "working-directory",
+		Usage:       "The node's working `directory`, the one holding the db directory.",
+		Value:       ".",
+		Destination: &argsConfig.workingDir,
+	}
+	// configurationFile defines a flag for the path to the node's main toml configuration file
+	configurationFile = cli.StringFlag{
+		Name:        "config",
+		Usage:       "The `filepath` for the node's main configuration file. The storers are opened using this configuration.",
+		Value:       "./config/config.toml",
+		Destination: &argsConfig.configFile,
+	}
+	// epochConfigurationFile defines a flag for the path to the node's enable epochs toml configuration file
+	epochConfigurationFile = cli.StringFlag{
+		Name:        "epoch-config",
+		Usage:       "The `filepath` for the node's enable epochs configuration file.",
+		Value:       "./config/enableEpochs.toml",
+		Destination: &argsConfig.epochConfigFile,
+	}
+	// numOfShards defines a flag for the number of shards of the network
+	numOfShards = cli.UintFlag{
+		Name:        "num-of-shards",
+		Usage:       "The `number` of shards of the network, metachain excluded.",
+		Value:       3,
+		Destination: &argsConfig.numOfShards,
+	}
+	// numBlocksForTries defines a flag for the number of most recent blocks for which the tries are checked
+	numBlocksForTries = cli.Uint64Flag{
+		Name: "num-blocks-tries",
+		Usage: "The `number` of most recent blocks for which the state and peer tries should be fully present. " +
+			"When the state pruning is enabled, only the tries of the last blocks are kept.",
+		Value:       1,
+		Destination: &argsConfig.numBlocksForTries,
+	}
+	// reportFile defines a flag for the path of the generated report
+	reportFile = cli.StringFlag{
+		Name:        "report",
+		Usage:       "The `filepath` of the generated JSON report.",
+		Value:       "./dbchecker-report.json",
+		Destination: &argsConfig.reportFile,
+	}
+	// logLevel defines the logger level
+	logLevel = cli.StringFlag{
+		Name: "log-level",
+		Usage: "This flag specifies the logger `level(s)`. It can contain multiple comma-separated value. For example" +
+			", if set to *:INFO the logs for all packages will have the INFO level. However, if set to *:INFO,api:DEBUG" +
+			" the logs for all packages will have the INFO level, excepting the api package which will receive a DEBUG" +
+			" log level.",
+		Value:       "*:" + logger.LogInfo.String(),
+		Destination: &argsConfig.logLevel,
+	}
+
+	argsConfig = &cfg{}
+
+	log = logger.GetOrCreate("dbchecker")
+
+	errIssuesFound = errors.New("the database check found issues")
+)
+
+func main() {
+	app := cli.NewApp()
+	cli.AppHelpTemplate = helpTemplate
+	app.Name = "MultiversX DB checker"
+	app.Version = fmt.Sprintf("%s/%s/%s-%s", "1.0.0", runtime.Version(), runtime.GOOS, runtime.GOARCH)
+	app.Usage = "DB checker application used to verify the integrity of a stopped node's databases"
+	app.Flags = []cli.Flag{
+		workingDirectory,
+		configurationFile,
+		epochConfigurationFile,
+		numOfShards,
+		numBlocksForTries,
+		reportFile,
+		logLevel,
+	}
+	app.Authors = []cli.Author{
+		{
+			Name:  "The MultiversX Team",
+			Email: "contact@multiversx.com",
+		},
+	}
+	app.Action = func(_ *cli.Context) error {
+		return process()
+	}
+
+	err := app.Run(os.Args)
+	if err != nil {
+		log.Error(err.Error())
+		os.Exit(1)
+	}
+}
+
+func process() error {
+	err := logger.SetLogLevel(argsConfig.logLevel)
+	if err != nil {
+		return err
+	}
+
+	generalConfig, err := common.LoadMainConfig(argsConfig.configFile)
+	if err != nil {
+		return err
+	}
+	epochConfig, err := common.LoadEpochConfig(argsConfig.epochConfigFile)
+	if err != nil {
+		return err
+	}
+
+	marshaller, err := marshallerFactory.NewMarshalizer(generalConfig.Marshalizer.Type)
+	if err != nil {
+		return err
+	}
+	hasher, err := hasherFactory.NewHasher(generalConfig.Hasher.Type)
+	if err != nil {
+		return err
+	}
+
+	opened, err := offlinestorage.OpenStorage(offlinestorage.ArgsOpenStorage{
+		GeneralConfig: *generalConfig,
+		WorkingDir:    argsConfig.workingDir,
+		NumOfShards:   uint32(argsConfig.numOfShards),
+		Marshaller:    marshaller,
+		StorageType:   storageFactory.DBCheckerStorageService,
+	})
+	if err != nil {
+		return err
+	}
+	defer func() {
+		errClose := opened.StorageService.CloseAll()
+		if errClose != nil {
+			log.Warn("error closing the storers", "error", errClose)
+		}
+	}()
+
+	lastNonce, err := offlinestorage.GetLastNonce(opened.StorageService, marshaller)
+	if err != nil {
+		return err
+	}
+
+	log.Info("starting the database check",
+		"shard", opened.ShardID,
+		"first epoch", opened.FirstEpoch,
+		"last epoch", opened.LastEpoch,
+		"last nonce", lastNonce,
+	)
+
+	checker, err := createDBChecker(*generalConfig, *epochConfig, opened, lastNonce, marshaller, hasher)
+	if err != nil {
+		return err
+	}
+
+	checkReport := checker.check()
+	err = saveReport(checkReport, argsConfig.reportFile)
+	if err != nil {
+		return err
+	}
+
+	log.Info("database check finished",
+		"checked epochs", len(checkReport.Epochs),
+		"checked tries", len(checkReport.Tries),
+		"issues", checkReport.NumIssues,
+		"report", argsConfig.reportFile,
+	)
+	if checkReport.NumIssues > 0 {
+		return fmt.Errorf("%w: %d issue(s), see %s", errIssuesFound, checkReport.NumIssues, argsConfig.reportFile)
+	}
+
+	return nil
+}
+
+func createDBChecker(
+	generalConfig config.Config,
+	epochConfig config.EpochConfig,
+	opened *offlinestorage.OpenedStorage,
+	lastNonce uint64,
+	marshaller marshal.Marshalizer,
+	hasher hashing.Hasher,
+) (*dbChecker, error) {
+	enableEpochsHandler, err := enablers.NewEnableEpochsHandler(epochConfig.EnableEpochs, forking.NewGenericEpochNotifier())
+	if err != nil {
+		return nil, err
+	}
+
+	stateTrie, err := offlinestorage.CreateTrie(opened.StorageService, dataRetriever.UserAccountsUnit, generalConfig, generalConfig.StateTriesConfig.MaxStateTrieLevelInMemory, marshaller, hasher, enableEpochsHandler)
+	if err != nil {
+		return nil, err
+	}
+
+	var peerTrie common.Trie
+	if opened.ShardID == core.MetachainShardId {
+		peerTrie, err = offlinestorage.CreateTrie(opened.StorageService, dataRetriever.PeerAccountsUnit, generalConfig, generalConfig.StateTriesConfig.MaxPeerTrieLevelInMemory, marshaller, hasher, enableEpochsHandler)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return newDBChecker(argsDBChecker{
+		storageService:    opened.StorageService,
+		marshaller:        marshaller,
+		hasher:            hasher,
+		uint64Converter:   uint64ByteSlice.NewBigEndianConverter(),
+		stateTrie:         stateTrie,
+		peerTrie:          peerTrie,
+		selfShardID:       opened.ShardID,
+		firstEpoch:        opened.FirstEpoch,
+		lastEpoch:         opened.LastEpoch,
+		lastNonce:         lastNonce,
+		numBlocksForTries: argsConfig.numBlocksForTries,
+	})
+}
+
+func saveReport(checkReport *report, path string) error {
+	reportBytes, err := json.MarshalIndent(checkReport, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(path, reportBytes, reportFilePerm)
+}
@@ -0,0 +1,126 @@
+package main
+
+import (
+	"encoding/hex"
+	"sort"
+)
+
+const (
+	issueMissing = "missing"
+	issueCorrupt = "corrupt"
+)
+
+// issue describes a missing or corrupt item found in the database
+type issue struct {
+	Kind        string `json:"kind"`
+	Unit        string `json:"unit"`
+	Key         string `json:"key"`
+	Epoch       uint32 `json:"epoch"`
+	HeaderNonce uint64 `json:"headerNonce"`
+	Details     string `json:"details,omitempty"`
+}
+
+// epochReport holds the number of items checked for the blocks of an epoch
+type epochReport struct {
+	Epoch           uint32 `json:"epoch"`
+	FirstNonce      uint64 `json:"firstNonce"`
+	LastNonce       uint64 `json:"lastNonce"`
+	NumHeaders      uint64 `json:"numHeaders"`
+	NumMiniBlocks   uint64 `json:"numMiniBlocks"`
+	NumTransactions uint64 `json:"numTransactions"`
+	NumReceipts     uint64 `json:"numReceipts"`
+	NumIssues       uint64 `json:"numIssues"`
+}
+
+// trieReport holds the result of a trie check
+type trieReport struct {
+	Unit         string `json:"unit"`
+	HeaderNonce  uint64 `json:"headerNonce"`
+	RootHash     string `json:"rootHash"`
+	NumNodes     uint64 `json:"numNodes"`
+	NumDataTries uint64 `json:"numDataTries"`
+	Complete     bool   `json:"complete"`
+}
+
+// report is the machine-readable output of the database checker
+type report struct {
+	ShardID    uint32         `json:"shardID"`
+	FirstEpoch uint32         `json:"firstEpoch"`
+	LastEpoch  uint32         `json:"lastEpoch"`
+	LastNonce  uint64         `json:"lastNonce"`
+	Epochs     []*epochReport `json:"epochs"`
+	Tries      []*trieReport  `json:"tries"`
+	Issues     []*issue       `json:"issues"`
+	NumIssues  int            `json:"numIssues"`
+
+	epochsMap map[uint32]*epochReport
+}
+
+func newReport(shardID uint32, firstEpoch uint32, lastEpoch uint32, lastNonce uint64) *report {
+	return &report{
+		ShardID:    shardID,
+		FirstEpoch: firstEpoch,
+		LastEpoch:  lastEpoch,
+		LastNonce:  lastNonce,
+		Epochs:     make([]*epochReport, 0),
+		Tries:      make([]*trieReport, 0),
+		Issues:     make([]*issue, 0),
+		epochsMap:  make(map[uint32]*epochReport),
+	}
+}
+
+func (r *report) getEpochReport(epoch uint32) *epochReport {
+	er, found := r.epochsMap[epoch]
+	if !found {
+		er = &epochReport{
+			Epoch: epoch,
+		}
+		r.epochsMap[epoch] = er
+	}
+
+	return er
+}
+
+func (r *report) addHeader(epoch uint32, nonce uint64) {
+	er := r.getEpochReport(epoch)
+	er.NumHeaders++
+	if er.FirstNonce == 0 || nonce < er.FirstNonce {
+		er.FirstNonce = nonce
+	}
+	if nonce > er.LastNonce {
+		er.LastNonce = nonce
+	}
+}
+
+func (r *report) addIssue(kind string, unit string, key []byte, epoch uint32, headerNonce uint64, details string) {
+	log.Debug("database issue found",
+		"kind", kind,
+		"unit", unit,
+		"key", key,
+		"epoch", epoch,
+		"header nonce", headerNonce,
+		"details", details,
+	)
+
+	r.Issues = append(r.Issues, &issue{
+		Kind:        kind,
+		Unit:        unit,
+		Key:         hex.EncodeToString(key),
+		Epoch:       epoch,
+		HeaderNonce: headerNonce,
+		Details:     details,
+	})
+	r.getEpochReport(epoch).NumIssues++
+}
+
+func (r *report) finalize() {
+	r.Epochs = make([]*epochReport, 0, len(r.epochsMap))
+	for _, er := range r.epochsMap {
+		r.Epochs = append(r.Epochs, er)
+	}
+	sort.Slice(r.Epochs, func(i, j int) bool {
+		return r.Epochs[i].Epoch < r.Epochs[j].Epoch
+	})
+
+	r.NumIssues = len(r.Issues)
+}
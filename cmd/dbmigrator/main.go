@@ -0,0 +1,136 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"runtime"
+
+	"github.com/multiversx/mx-chain-go/storage/storageunit"
+	logger "github.com/multiversx/mx-chain-logger-go"
+	"github.com/urfave/cli"
+)
+
+type cfg struct {
+	sourcePath      string
+	destinationPath string
+	destinationType string
+	logLevel        string
+}
+
+var (
+	helpTemplate = `NAME:
+   {{.Name}} - {{.Usage}}
+USAGE:
+   {{.HelpName}} {{if .VisibleFlags}}[global options]{{end}}
+   {{if len .Authors}}
+AUTHOR:
+   {{range .Authors}}{{ . }}{{end}}
+   {{end}}{{if .Commands}}
+GLOBAL OPTIONS:
+   {{range .VisibleFlags}}{{.}}
+   {{end}}
+VERSION:
+   {{.Version}}
+   {{end}}
+`
+	// source defines a flag for the path of the database to be migrated
+	source = cli.StringFlag{
+		Name:        "source",
+		Usage:       "The `path` of the database directory to be migrated. It is opened using the config file it contains.",
+		Destination: &argsConfig.sourcePath,
+	}
+	// destination defines a flag for the path of the newly created database
+	destination = cli.StringFlag{
+		Name:        "destination",
+		Usage:       "The `path` of the newly created database directory. It should be missing or empty.",
+		Destination: &argsConfig.destinationPath,
+	}
+	// destinationType defines a flag for the type of the newly created database
+	destinationType = cli.StringFlag{
+		Name:        "destination-type",
+		Usage:       "The `type` of the newly created database. Can be one of LvlDB, LvlDBSerial or PebbleDB.",
+		Value:       string(storageunit.PebbleDB),
+		Destination: &argsConfig.destinationType,
+	}
+	// logLevel defines the logger level
+	logLevel = cli.StringFlag{
+		Name: "log-level",
+		Usage: "This flag specifies the logger `level(s)`. It can contain multiple comma-separated value. For example" +
+			", if set to *:INFO the logs for all packages will have the INFO level. However, if set to *:INFO,api:DEBUG" +
+			" the logs for all packages will have the INFO level, excepting the api package which will receive a DEBUG" +
+			" log level.",
+		Value:       "*:" + logger.LogInfo.String(),
+		Destination: &argsConfig.logLevel,
+	}
+
+	argsConfig = &cfg{}
+
+	log = logger.GetOrCreate("dbmigrator")
+)
+
+func main() {
+	app := cli.NewApp()
+	cli.AppHelpTemplate = helpTemplate
+	app.Name = "MultiversX DB migrator"
+	app.Version = fmt.Sprintf("%s/%s/%s-%s", "1.0.0", runtime.Version(), runtime.GOOS, runtime.GOARCH)
+	app.Usage = "DB migrator application used to convert a node's database directory to another storage engine"
+	app.Flags = []cli.Flag{
+		source,
+		destination,
+		destinationType,
+		logLevel,
+	}
+	app.Authors = []cli.Author{
+		{
+			Name:  "The MultiversX Team",
+			Email: "contact@multiversx.com",
+		},
+	}
+	app.Action = func(_ *cli.Context) error {
+		return process()
+	}
+
+	err := app.Run(os.Args)
+	if err != nil {
+		log.Error(err.Error())
+		os.Exit(1)
+	}
+}
+
+func process() error {
+	err := logger.SetLogLevel(argsConfig.logLevel)
+	if err != nil {
+		return err
+	}
+
+	if len(argsConfig.sourcePath) == 0 || len(argsConfig.destinationPath) == 0 {
+		return fmt.Errorf("both the --%s and the --%s flags should be provided", source.Name, destination.Name)
+	}
+
+	log.Info("starting the database migration",
+		"source", argsConfig.sourcePath,
+		"destination", argsConfig.destinationPath,
+		"destination type", argsConfig.destinationType,
+	)
+
+	result, err := migrateDB(migrationArgs{
+		sourcePath:      argsConfig.sourcePath,
+		destinationPath: argsConfig.destinationPath,
+		destinationType: argsConfig.destinationType,
+	})
+	if err != nil {
+		return err
+	}
+
+	log.Info("database migration finished",
+		"source type", result.sourceType,
+		"num shards", result.numShards,
+		"migrated keys", result.numMigrated,
+		"failed keys", result.numPutErrors,
+	)
+	if result.numPutErrors > 0 {
+		return fmt.Errorf("%d keys could not be migrated", result.numPutErrors)
+	}
+
+	return nil
+}
@@ -0,0 +1,118 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/multiversx/mx-chain-go/config"
+	"github.com/multiversx/mx-chain-go/storage"
+	"github.com/multiversx/mx-chain-go/storage/factory"
+)
+
+const progressLogInterval = 100000
+
+// fallbackDBConfig is used for the source databases that do not hold their own config file
+var fallbackDBConfig = config.DBConfig{
+	BatchDelaySeconds: 2,
+	MaxBatchSize:      100,
+	MaxOpenFiles:      10,
+}
+
+var errEmptySourceDB = errors.New("the source database directory is empty or missing")
+var errDestinationNotEmpty = errors.New("the destination database directory is not empty")
+var errSameDBType = errors.New("the source database already has the requested type")
+
+type migrationArgs struct {
+	sourcePath      string
+	destinationPath string
+	destinationType string
+}
+
+type migrationResult struct {
+	sourceType   string
+	numShards    int32
+	numMigrated  uint64
+	numPutErrors uint64
+}
+
+// migrateDB copies every (key, value) pair of the source database into a newly created database having the
+// requested type. The source database configuration (sharding, batching) is preserved, and the destination
+// directory receives its own config file, so a node will open it with the new engine
+func migrateDB(args migrationArgs) (*migrationResult, error) {
+	if isDirEmpty(args.sourcePath) {
+		return nil, fmt.Errorf("%w: %s", errEmptySourceDB, args.sourcePath)
+	}
+	if !isDirEmpty(args.destinationPath) {
+		return nil, fmt.Errorf("%w: %s", errDestinationNotEmpty, args.destinationPath)
+	}
+
+	sourceConfig, err := factory.NewDBConfigHandler(fallbackDBConfig).GetDBConfig(args.sourcePath)
+	if err != nil {
+		return nil, err
+	}
+	if sourceConfig.Type == args.destinationType {
+		return nil, fmt.Errorf("%w: %s", errSameDBType, sourceConfig.Type)
+	}
+
+	source, err := createPersister(*sourceConfig, args.sourcePath)
+	if err != nil {
+		return nil, fmt.Errorf("%w while opening the source database", err)
+	}
+	defer func() {
+		log.LogIfError(source.Close())
+	}()
+
+	destinationConfig := *sourceConfig
+	destinationConfig.Type = args.destinationType
+	destinationConfig.UseTmpAsFilePath = false
+	destination, err := createPersister(destinationConfig, args.destinationPath)
+	if err != nil {
+		return nil, fmt.Errorf("%w while creating the destination database", err)
+	}
+
+	result := &migrationResult{
+		sourceType: sourceConfig.Type,
+		numShards:  sourceConfig.NumShards,
+	}
+	source.RangeKeys(func(key []byte, value []byte) bool {
+		errPut := destination.Put(key, value)
+		if errPut != nil {
+			log.Error("cannot write in the destination database", "key", key, "error", errPut)
+			result.numPutErrors++
+			return true
+		}
+
+		result.numMigrated++
+		if result.numMigrated%progressLogInterval == 0 {
+			log.Info("migration in progress", "migrated keys", result.numMigrated)
+		}
+
+		return true
+	})
+
+	err = destination.Close()
+	if err != nil {
+		return nil, fmt.Errorf("%w while closing the destination database", err)
+	}
+
+	return result, nil
+}
+
+func createPersister(dbConfig config.DBConfig, path string) (storage.Persister, error) {
+	persisterFactory, err := factory.NewPersisterFactory(dbConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	return persisterFactory.Create(path)
+}
+
+func isDirEmpty(path string) bool {
+	entries, err := os.ReadDir(path)
+	if err != nil {
+		return true
+	}
+
+	return len(entries) == 0
+}
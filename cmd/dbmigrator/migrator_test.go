@@ -0,0 +1,105 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"path/filepath"
+	"testing"
+
+	"github.com/multiversx/mx-chain-go/config"
+	"github.com/multiversx/mx-chain-go/storage/factory"
+	"github.com/multiversx/mx-chain-go/storage/storageunit"
+	"github.com/stretchr/testify/require"
+)
+
+func createSourceDB(t *testing.T, numShards int32, numKeys int) string {
+	sourcePath := filepath.Join(t.TempDir(), "source")
+	dbConfig := config.DBConfig{
+		Type:                string(storageunit.LvlDBSerial),
+		BatchDelaySeconds:   2,
+		MaxBatchSize:        100,
+		MaxOpenFiles:        10,
+		ShardIDProviderType: string(storageunit.BinarySplit),
+		NumShards:           numShards,
+	}
+	persister, err := createPersister(dbConfig, sourcePath)
+	require.Nil(t, err)
+
+	for i := 0; i < numKeys; i++ {
+		err = persister.Put([]byte(fmt.Sprintf("key%d", i)), []byte(fmt.Sprintf("value%d", i)))
+		require.Nil(t, err)
+	}
+	require.Nil(t, persister.Close())
+
+	return sourcePath
+}
+
+func TestMigrateDB(t *testing.T) {
+	t.Parallel()
+
+	t.Run("missing source should error", func(t *testing.T) {
+		t.Parallel()
+
+		result, err := migrateDB(migrationArgs{
+			sourcePath:      filepath.Join(t.TempDir(), "missing"),
+			destinationPath: filepath.Join(t.TempDir(), "destination"),
+			destinationType: string(storageunit.PebbleDB),
+		})
+		require.Nil(t, result)
+		require.True(t, errors.Is(err, errEmptySourceDB))
+	})
+	t.Run("not empty destination should error", func(t *testing.T) {
+		t.Parallel()
+
+		sourcePath := createSourceDB(t, 1, 1)
+		result, err := migrateDB(migrationArgs{
+			sourcePath:      sourcePath,
+			destinationPath: sourcePath,
+			destinationType: string(storageunit.PebbleDB),
+		})
+		require.Nil(t, result)
+		require.True(t, errors.Is(err, errDestinationNotEmpty))
+	})
+	t.Run("same type should error", func(t *testing.T) {
+		t.Parallel()
+
+		result, err := migrateDB(migrationArgs{
+			sourcePath:      createSourceDB(t, 1, 1),
+			destinationPath: filepath.Join(t.TempDir(), "destination"),
+			destinationType: string(storageunit.LvlDBSerial),
+		})
+		require.Nil(t, result)
+		require.True(t, errors.Is(err, errSameDBType))
+	})
+	t.Run("should migrate a sharded database", func(t *testing.T) {
+		t.Parallel()
+
+		numKeys := 50
+		destinationPath := filepath.Join(t.TempDir(), "destination")
+		result, err := migrateDB(migrationArgs{
+			sourcePath:      createSourceDB(t, 4, numKeys),
+			destinationPath: destinationPath,
+			destinationType: string(storageunit.PebbleDB),
+		})
+		require.Nil(t, err)
+		require.Equal(t, uint64(numKeys), result.numMigrated)
+		require.Equal(t, uint64(0), result.numPutErrors)
+		require.Equal(t, int32(4), result.numShards)
+
+		destinationConfig, err := factory.NewDBConfigHandler(fallbackDBConfig).GetDBConfig(destinationPath)
+		require.Nil(t, err)
+		require.Equal(t, string(storageunit.PebbleDB), destinationConfig.Type)
+		require.Equal(t, int32(4), destinationConfig.NumShards)
+
+		destination, err := createPersister(fallbackDBConfig, destinationPath)
+		require.Nil(t, err)
+		defer func() {
+			_ = destination.Close()
+		}()
+		for i := 0; i < numKeys; i++ {
+			value, errGet := destination.Get([]byte(fmt.Sprintf("key%d", i)))
+			require.Nil(t, errGet)
+			require.Equal(t, []byte(fmt.Sprintf("value%d", i)), value)
+		}
+	})
+}
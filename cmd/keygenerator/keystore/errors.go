@@ -0,0 +1,15 @@
+package keystore
+
+import "errors"
+
+// ErrUnsupportedVersion signals that the keystore was written with an unsupported version
+var ErrUnsupportedVersion = errors.New("unsupported keystore version")
+
+// ErrUnsupportedCipher signals that the keystore uses an unsupported cipher or key derivation function
+var ErrUnsupportedCipher = errors.New("unsupported keystore cipher")
+
+// ErrInvalidKeystore signals that the keystore is malformed
+var ErrInvalidKeystore = errors.New("invalid keystore")
+
+// ErrWrongPassword signals that the keystore could not be decrypted with the provided password
+var ErrWrongPassword = errors.New("wrong keystore password")
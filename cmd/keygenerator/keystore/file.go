@@ -0,0 +1,47 @@
+package keystore
+
+import (
+	"encoding/json"
+	"os"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+)
+
+// LoadFromFile reads the keystore found in the provided file
+func LoadFromFile(filePath string) (*Keystore, error) {
+	buff, err := os.ReadFile(filePath)
+	if err != nil {
+		return nil, err
+	}
+
+	return Unmarshal(buff)
+}
+
+// Unmarshal decodes the keystore from its JSON representation
+func Unmarshal(buff []byte) (*Keystore, error) {
+	ks := &Keystore{}
+	err := json.Unmarshal(buff, ks)
+	if err != nil {
+		return nil, err
+	}
+	if ks.Crypto == nil {
+		return nil, ErrInvalidKeystore
+	}
+
+	return ks, nil
+}
+
+// Marshal encodes the keystore as indented JSON
+func (ks *Keystore) Marshal() ([]byte, error) {
+	return json.MarshalIndent(ks, "", "    ")
+}
+
+// SaveToFile writes the keystore as indented JSON in the provided file
+func (ks *Keystore) SaveToFile(filePath string) error {
+	buff, err := ks.Marshal()
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(filePath, buff, core.FileModeUserReadWrite)
+}
@@ -0,0 +1,198 @@
+package keystore
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+
+	"golang.org/x/crypto/scrypt"
+)
+
+const (
+	// KindSecretKey is the kind of the keystores holding a secret key
+	KindSecretKey = "secretKey"
+	// KindMnemonic is the kind of the keystores holding a mnemonic
+	KindMnemonic = "mnemonic"
+
+	keystoreVersion = 4
+	cipherName      = "aes-128-ctr"
+	kdfName         = "scrypt"
+	scryptN         = 4096
+	scryptR         = 8
+	scryptP         = 1
+	derivedKeyLen   = 32
+	saltLen         = 32
+	uuidLen         = 16
+)
+
+// Keystore is a password-encrypted JSON keystore, in the format used by the MultiversX wallets
+type Keystore struct {
+	Version int     `json:"version"`
+	ID      string  `json:"id"`
+	Kind    string  `json:"kind"`
+	Address string  `json:"address,omitempty"`
+	Bech32  string  `json:"bech32,omitempty"`
+	Crypto  *Crypto `json:"crypto"`
+}
+
+// Crypto holds the encrypted data and the parameters needed to decrypt it
+type Crypto struct {
+	Ciphertext   string        `json:"ciphertext"`
+	CipherParams *CipherParams `json:"cipherparams"`
+	Cipher       string        `json:"cipher"`
+	KDF          string        `json:"kdf"`
+	KDFParams    *KDFParams    `json:"kdfparams"`
+	MAC          string        `json:"mac"`
+}
+
+// CipherParams holds the parameters of the cipher
+type CipherParams struct {
+	IV string `json:"iv"`
+}
+
+// KDFParams holds the parameters of the scrypt key derivation function
+type KDFParams struct {
+	DKLen int    `json:"dklen"`
+	Salt  string `json:"salt"`
+	N     int    `json:"n"`
+	R     int    `json:"r"`
+	P     int    `json:"p"`
+}
+
+// Encrypt creates a keystore of the provided kind holding the data encrypted with the password. The address fields
+// are left for the caller to fill
+func Encrypt(kind string, data []byte, password []byte) (*Keystore, error) {
+	salt, err := randomBytes(saltLen)
+	if err != nil {
+		return nil, err
+	}
+	iv, err := randomBytes(aes.BlockSize)
+	if err != nil {
+		return nil, err
+	}
+	id, err := newUUID()
+	if err != nil {
+		return nil, err
+	}
+
+	kdfParams := &KDFParams{
+		DKLen: derivedKeyLen,
+		Salt:  hex.EncodeToString(salt),
+		N:     scryptN,
+		R:     scryptR,
+		P:     scryptP,
+	}
+	derivedKey, err := deriveKey(password, salt, kdfParams)
+	if err != nil {
+		return nil, err
+	}
+
+	ciphertext, err := applyCipher(derivedKey[:16], iv, data)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Keystore{
+		Version: keystoreVersion,
+		ID:      id,
+		Kind:    kind,
+		Crypto: &Crypto{
+			Ciphertext:   hex.EncodeToString(ciphertext),
+			CipherParams: &CipherParams{IV: hex.EncodeToString(iv)},
+			Cipher:       cipherName,
+			KDF:          kdfName,
+			KDFParams:    kdfParams,
+			MAC:          hex.EncodeToString(computeMAC(derivedKey[16:], ciphertext)),
+		},
+	}, nil
+}
+
+// Decrypt returns the data of the keystore. A wrong password is detected by the MAC check
+func (ks *Keystore) Decrypt(password []byte) ([]byte, error) {
+	if ks.Version != keystoreVersion {
+		return nil, fmt.Errorf("%w: %d", ErrUnsupportedVersion, ks.Version)
+	}
+	if ks.Crypto == nil || ks.Crypto.CipherParams == nil || ks.Crypto.KDFParams == nil {
+		return nil, ErrInvalidKeystore
+	}
+	if ks.Crypto.Cipher != cipherName || ks.Crypto.KDF != kdfName || ks.Crypto.KDFParams.DKLen != derivedKeyLen {
+		return nil, fmt.Errorf("%w: cipher %s, kdf %s", ErrUnsupportedCipher, ks.Crypto.Cipher, ks.Crypto.KDF)
+	}
+
+	salt, err := hex.DecodeString(ks.Crypto.KDFParams.Salt)
+	if err != nil {
+		return nil, err
+	}
+	iv, err := hex.DecodeString(ks.Crypto.CipherParams.IV)
+	if err != nil {
+		return nil, err
+	}
+	ciphertext, err := hex.DecodeString(ks.Crypto.Ciphertext)
+	if err != nil {
+		return nil, err
+	}
+	mac, err := hex.DecodeString(ks.Crypto.MAC)
+	if err != nil {
+		return nil, err
+	}
+
+	derivedKey, err := deriveKey(password, salt, ks.Crypto.KDFParams)
+	if err != nil {
+		return nil, err
+	}
+	if !hmac.Equal(mac, computeMAC(derivedKey[16:], ciphertext)) {
+		return nil, ErrWrongPassword
+	}
+
+	return applyCipher(derivedKey[:16], iv, ciphertext)
+}
+
+func deriveKey(password []byte, salt []byte, params *KDFParams) ([]byte, error) {
+	return scrypt.Key(password, salt, params.N, params.R, params.P, params.DKLen)
+}
+
+// applyCipher encrypts or decrypts the data, as the CTR mode is symmetric
+func applyCipher(key []byte, iv []byte, data []byte) ([]byte, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	if len(iv) != aes.BlockSize {
+		return nil, ErrInvalidKeystore
+	}
+
+	result := make([]byte, len(data))
+	cipher.NewCTR(block, iv).XORKeyStream(result, data)
+
+	return result, nil
+}
+
+func computeMAC(key []byte, ciphertext []byte) []byte {
+	mac := hmac.New(sha256.New, key)
+	_, _ = mac.Write(ciphertext)
+
+	return mac.Sum(nil)
+}
+
+func randomBytes(length int) ([]byte, error) {
+	buff := make([]byte, length)
+	_, err := rand.Read(buff)
+
+	return buff, err
+}
+
+// newUUID returns a random version 4 UUID
+func newUUID() (string, error) {
+	id, err := randomBytes(uuidLen)
+	if err != nil {
+		return "", err
+	}
+	id[6] = (id[6] & 0x0f) | 0x40
+	id[8] = (id[8] & 0x3f) | 0x80
+
+	return fmt.Sprintf("%x-%x-%x-%x-%x", id[0:4], id[4:6], id[6:8], id[8:10], id[10:]), nil
+}
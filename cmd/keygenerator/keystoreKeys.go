@@ -0,0 +1,286 @@
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/hex"
+	"encoding/pem"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	crypto "github.com/multiversx/mx-chain-crypto-go"
+	"github.com/multiversx/mx-chain-crypto-go/signing"
+	"github.com/multiversx/mx-chain-crypto-go/signing/ed25519"
+	"github.com/multiversx/mx-chain-crypto-go/signing/mcl"
+	"github.com/multiversx/mx-chain-go/cmd/keygenerator/keystore"
+)
+
+const pemExtension = ".pem"
+const keystoreExtension = ".json"
+const pemTypePrefix = "PRIVATE KEY for "
+const walletSkLen = 64
+const blsSkLen = 32
+
+var password []byte
+
+func createKeyFormat(outputFormat string) (keyFormat, error) {
+	if outputFormat == pemFormat {
+		return keyFormat{
+			extension: pemExtension,
+			encode:    writeKeyToStream,
+		}, nil
+	}
+
+	pass, err := getPassword()
+	if err != nil {
+		return keyFormat{}, err
+	}
+
+	return keyFormat{
+		extension: keystoreExtension,
+		encode:    createKeystoreEncoder(pass),
+	}, nil
+}
+
+// getPassword returns the keystores password, read only once from the password file or from the standard input
+func getPassword() ([]byte, error) {
+	if len(password) > 0 {
+		return password, nil
+	}
+
+	var err error
+	password, err = readPassword(argsConfig.passwordFile)
+	if err != nil {
+		return nil, err
+	}
+	if len(password) == 0 {
+		return nil, fmt.Errorf("empty keystore password")
+	}
+
+	return password, nil
+}
+
+func readPassword(passwordFile string) ([]byte, error) {
+	if len(passwordFile) > 0 {
+		buff, err := os.ReadFile(passwordFile)
+		if err != nil {
+			return nil, err
+		}
+
+		return bytes.TrimRight(buff, "\r\n"), nil
+	}
+
+	_, _ = fmt.Fprint(os.Stderr, "Keystore password: ")
+	line, err := bufio.NewReader(os.Stdin).ReadString('\n')
+	if err != nil && err != io.EOF {
+		return nil, err
+	}
+
+	return []byte(strings.TrimRight(line, "\r\n")), nil
+}
+
+func createKeystoreEncoder(pass []byte) keyEncoder {
+	return func(writer io.Writer, key key, converter pubKeyConverter) error {
+		if check.IfNilReflect(writer) {
+			return fmt.Errorf("nil writer")
+		}
+
+		ks, err := createKeystore(key, pass)
+		if err != nil {
+			return err
+		}
+
+		buff, err := ks.Marshal()
+		if err != nil {
+			return err
+		}
+
+		_, err = writer.Write(append(buff, '\n'))
+		return err
+	}
+}
+
+func createKeystore(key key, pass []byte) (*keystore.Keystore, error) {
+	ks, err := keystore.Encrypt(keystore.KindSecretKey, key.skBytes, pass)
+	if err != nil {
+		return nil, err
+	}
+
+	ks.Address = hex.EncodeToString(key.pkBytes)
+	if len(key.pkBytes) == txSignPubkeyLen {
+		ks.Bech32, err = walletPubKeyConverter.Encode(key.pkBytes)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return ks, nil
+}
+
+// convertKeyFile converts a wallet or a validator key file from keystore to PEM or from PEM to keystore. A PEM file
+// containing more keys is converted in a keystore file for each key
+func convertKeyFile(filePath string) error {
+	buff, err := os.ReadFile(filePath)
+	if err != nil {
+		return err
+	}
+
+	basePath := strings.TrimSuffix(filePath, filepath.Ext(filePath))
+	ks, errUnmarshal := keystore.Unmarshal(buff)
+	if errUnmarshal == nil {
+		return convertKeystoreToPem(ks, basePath)
+	}
+
+	return convertPemToKeystore(buff, basePath)
+}
+
+func convertKeystoreToPem(ks *keystore.Keystore, basePath string) error {
+	if ks.Kind != keystore.KindSecretKey {
+		return fmt.Errorf("can not convert a keystore of kind %s to PEM", ks.Kind)
+	}
+
+	pass, err := getPassword()
+	if err != nil {
+		return err
+	}
+	skBytes, err := ks.Decrypt(pass)
+	if err != nil {
+		return err
+	}
+
+	k, converter, err := createKeyFromSecretKey(skBytes)
+	if err != nil {
+		return err
+	}
+
+	file, err := createNewFile(basePath + pemExtension)
+	if err != nil {
+		return err
+	}
+
+	err = writeKeyToStream(file, k, converter)
+	if err != nil {
+		_ = file.Close()
+		return err
+	}
+
+	return file.Close()
+}
+
+func convertPemToKeystore(buff []byte, basePath string) error {
+	keys := make([]key, 0)
+	for {
+		var blk *pem.Block
+		blk, buff = pem.Decode(buff)
+		if blk == nil {
+			break
+		}
+
+		k, err := createKeyFromPemBlock(blk)
+		if err != nil {
+			return err
+		}
+		keys = append(keys, k)
+	}
+	if len(keys) == 0 {
+		return fmt.Errorf("no keystore or PEM keys found")
+	}
+
+	pass, err := getPassword()
+	if err != nil {
+		return err
+	}
+
+	for i, k := range keys {
+		filePath := basePath + keystoreExtension
+		if len(keys) > 1 {
+			filePath = fmt.Sprintf("%s_%d%s", basePath, i, keystoreExtension)
+		}
+
+		ks, err := createKeystore(k, pass)
+		if err != nil {
+			return err
+		}
+
+		_, err = os.Stat(filePath)
+		if err == nil {
+			return fmt.Errorf("file %s already exists", filePath)
+		}
+		err = ks.SaveToFile(filePath)
+		if err != nil {
+			return err
+		}
+
+		log.Info("converted key", "address", ks.Address, "file", filePath)
+	}
+
+	return nil
+}
+
+func createKeyFromPemBlock(blk *pem.Block) (key, error) {
+	if !strings.HasPrefix(blk.Type, pemTypePrefix) {
+		return key{}, fmt.Errorf("unknown PEM block type %s", blk.Type)
+	}
+
+	skBytes, err := hex.DecodeString(string(blk.Bytes))
+	if err != nil {
+		return key{}, err
+	}
+
+	k, converter, err := createKeyFromSecretKey(skBytes)
+	if err != nil {
+		return key{}, err
+	}
+
+	pkString, err := converter.Encode(k.pkBytes)
+	if err != nil {
+		return key{}, err
+	}
+	expectedPkString := strings.TrimPrefix(blk.Type, pemTypePrefix)
+	if pkString != expectedPkString {
+		return key{}, fmt.Errorf("the private key does not match the public key %s", expectedPkString)
+	}
+
+	return k, nil
+}
+
+// createKeyFromSecretKey rebuilds the key pair, telling the wallet and the validator keys apart by the secret key length
+func createKeyFromSecretKey(skBytes []byte) (key, pubKeyConverter, error) {
+	var keyGen crypto.KeyGenerator
+	var converter pubKeyConverter
+	switch len(skBytes) {
+	case walletSkLen:
+		keyGen = signing.NewKeyGenerator(ed25519.NewEd25519())
+		converter = walletPubKeyConverter
+	case blsSkLen:
+		keyGen = signing.NewKeyGenerator(mcl.NewSuiteBLS12())
+		converter = validatorPubKeyConverter
+	default:
+		return key{}, nil, fmt.Errorf("unknown private key of %d bytes", len(skBytes))
+	}
+
+	sk, err := keyGen.PrivateKeyFromByteArray(skBytes)
+	if err != nil {
+		return key{}, nil, err
+	}
+	pkBytes, err := sk.GeneratePublic().ToByteArray()
+	if err != nil {
+		return key{}, nil, err
+	}
+
+	return key{
+		skBytes: skBytes,
+		pkBytes: pkBytes,
+	}, converter, nil
+}
+
+func createNewFile(filePath string) (*os.File, error) {
+	log.Info("writing", "file", filePath)
+
+	return os.OpenFile(filePath, os.O_CREATE|os.O_EXCL|os.O_WRONLY, core.FileModeUserReadWrite)
+}
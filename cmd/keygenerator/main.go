@@ -31,6 +31,12 @@ type cfg struct {
 	noSplit       bool
 	prefixPattern string
 	shardIDByte   int
+	mnemonicFile  string
+	accountIndex  uint
+	startIndex    uint
+	outputFormat  string
+	passwordFile  string
+	convertFile   string
 }
 
 const validatorType = "validator"
@@ -38,6 +44,9 @@ const walletType = "wallet"
 const p2pType = "p2p"
 const bothType = "both"
 const minedWalletPrefixKeys = "mined-wallet"
+const mnemonicType = "mnemonic"
+const pemFormat = "pem"
+const keystoreFormat = "keystore"
 const nopattern = "nopattern"
 const desiredpattern = "[0-f]+"
 const noshard = -1
@@ -48,6 +57,14 @@ type key struct {
 	pkBytes []byte
 }
 
+// keyEncoder writes a key in a specific format
+type keyEncoder func(writer io.Writer, key key, converter pubKeyConverter) error
+
+type keyFormat struct {
+	extension string
+	encode    keyEncoder
+}
+
 type pubKeyConverter interface {
 	Decode(humanReadable string) ([]byte, error)
 	Encode(pkBytes []byte) (string, error)
@@ -86,12 +103,13 @@ VERSION:
 	keyType = cli.StringFlag{
 		Name: "key-type",
 		Usage: fmt.Sprintf(
-			"What kind of keys should generate. Available options: %s, %s, %s, %s, %s",
+			"What kind of keys should generate. Available options: %s, %s, %s, %s, %s, %s",
 			validatorType,
 			walletType,
 			p2pType,
 			bothType,
-			minedWalletPrefixKeys),
+			minedWalletPrefixKeys,
+			mnemonicType),
 		Value:       "validator",
 		Destination: &argsConfig.keyType,
 	}
@@ -123,11 +141,55 @@ VERSION:
 		Value:       -1,
 		Destination: &argsConfig.shardIDByte,
 	}
+	// mnemonicFile defines a flag for the file holding the mnemonic the wallet keys are derived from
+	mnemonicFile = cli.StringFlag{
+		Name: "mnemonic-file",
+		Usage: fmt.Sprintf("The file holding the mnemonic the %s keys are derived from, either as plain text or as an "+
+			"encrypted keystore. If not provided, the keys are randomly generated", walletType),
+		Destination: &argsConfig.mnemonicFile,
+	}
+	// accountIndex defines a flag for the account index of the derivation path m/44'/508'/account'/0'/address'
+	accountIndex = cli.UintFlag{
+		Name:        "account-index",
+		Usage:       "The account index of the derivation path m/44'/508'/account'/0'/address' used with the mnemonic",
+		Value:       0,
+		Destination: &argsConfig.accountIndex,
+	}
+	// startIndex defines a flag for the first address index of the derivation path
+	startIndex = cli.UintFlag{
+		Name: "start-index",
+		Usage: "The first address index of the derivation path m/44'/508'/account'/0'/address' used with the mnemonic. " +
+			"The keys are derived on consecutive indexes, skipping the ones not matching the --shard option",
+		Value:       0,
+		Destination: &argsConfig.startIndex,
+	}
+	// outputFormat defines a flag for the format of the written keys
+	outputFormat = cli.StringFlag{
+		Name: "output-format",
+		Usage: fmt.Sprintf("The format of the written keys. Available options: %s, %s (password-encrypted JSON, "+
+			"not available for %s keys and with --no-split)", pemFormat, keystoreFormat, p2pType),
+		Value:       pemFormat,
+		Destination: &argsConfig.outputFormat,
+	}
+	// passwordFile defines a flag for the file holding the keystores password
+	passwordFile = cli.StringFlag{
+		Name:        "password-file",
+		Usage:       "The file holding the password of the keystores. If not provided, the password is read from the standard input",
+		Destination: &argsConfig.passwordFile,
+	}
+	// convertFile defines a flag for the file to be converted between the PEM and the keystore formats
+	convertFile = cli.StringFlag{
+		Name: "convert",
+		Usage: fmt.Sprintf("The %s or %s key file to be converted from PEM to keystore or from keystore to PEM. "+
+			"The converted files are written next to the provided one", walletType, validatorType),
+		Destination: &argsConfig.convertFile,
+	}
 	argsConfig = &cfg{}
 
-	walletKeyFilenameTemplate    = "walletKey%s.pem"
-	validatorKeyFilenameTemplate = "validatorKey%s.pem"
-	p2pKeyFilenameTemplate       = "p2pKey%s.pem"
+	walletKeyFilenameTemplate    = "walletKey%s"
+	validatorKeyFilenameTemplate = "validatorKey%s"
+	p2pKeyFilenameTemplate       = "p2pKey%s"
+	mnemonicFilenameTemplate     = "mnemonic%s"
 
 	log = logger.GetOrCreate("keygenerator")
 
@@ -155,6 +217,12 @@ func main() {
 		noSplit,
 		shardIDByte,
 		keyPrefix,
+		mnemonicFile,
+		accountIndex,
+		startIndex,
+		outputFormat,
+		passwordFile,
+		convertFile,
 	}
 
 	app.Action = func(_ *cli.Context) error {
@@ -170,12 +238,52 @@ func main() {
 }
 
 func process() error {
-	validatorKeys, walletKeys, p2pKeys, err := generateKeys(argsConfig.keyType, argsConfig.numKeys, argsConfig.prefixPattern, argsConfig.shardIDByte)
+	err := checkArgs(argsConfig)
 	if err != nil {
 		return err
 	}
 
-	return outputKeys(validatorKeys, walletKeys, p2pKeys, argsConfig.consoleOut, argsConfig.noSplit)
+	if len(argsConfig.convertFile) > 0 {
+		return convertKeyFile(argsConfig.convertFile)
+	}
+
+	format, err := createKeyFormat(argsConfig.outputFormat)
+	if err != nil {
+		return err
+	}
+
+	if argsConfig.keyType == mnemonicType {
+		return generateMnemonic(format, argsConfig.consoleOut)
+	}
+
+	var validatorKeys, walletKeys, p2pKeys []key
+	if len(argsConfig.mnemonicFile) > 0 {
+		walletKeys, err = deriveWalletKeys(argsConfig.mnemonicFile, uint32(argsConfig.accountIndex), uint32(argsConfig.startIndex), argsConfig.numKeys, argsConfig.shardIDByte)
+	} else {
+		validatorKeys, walletKeys, p2pKeys, err = generateKeys(argsConfig.keyType, argsConfig.numKeys, argsConfig.prefixPattern, argsConfig.shardIDByte)
+	}
+	if err != nil {
+		return err
+	}
+
+	return outputKeys(validatorKeys, walletKeys, p2pKeys, argsConfig.consoleOut, argsConfig.noSplit, format)
+}
+
+func checkArgs(args *cfg) error {
+	if args.outputFormat != pemFormat && args.outputFormat != keystoreFormat {
+		return fmt.Errorf("unknown output format %s", args.outputFormat)
+	}
+	if args.outputFormat == keystoreFormat && args.noSplit {
+		return fmt.Errorf("the %s output format can not be used with the no-split option", keystoreFormat)
+	}
+	if args.outputFormat == keystoreFormat && args.keyType == p2pType {
+		return fmt.Errorf("the %s output format is not available for %s keys", keystoreFormat, p2pType)
+	}
+	if len(args.mnemonicFile) > 0 && args.keyType != walletType {
+		return fmt.Errorf("the mnemonic file can only be used with the %s key type", walletType)
+	}
+
+	return nil
 }
 
 func generateKeys(typeKey string, numKeys int, prefix string, shardID int) ([]key, []key, []key, error) {
@@ -308,34 +416,35 @@ func outputKeys(
 	p2pKeys []key,
 	consoleOut bool,
 	noSplit bool,
+	format keyFormat,
 ) error {
 	if consoleOut {
-		return printKeys(validatorKeys, walletKeys, p2pKeys)
+		return printKeys(validatorKeys, walletKeys, p2pKeys, format)
 	}
 
-	return saveKeys(validatorKeys, walletKeys, p2pKeys, noSplit)
+	return saveKeys(validatorKeys, walletKeys, p2pKeys, noSplit, format)
 }
 
-func printKeys(validatorKeys, walletKeys, p2pKeys []key) error {
+func printKeys(validatorKeys, walletKeys, p2pKeys []key, format keyFormat) error {
 	if len(validatorKeys)+len(walletKeys)+len(p2pKeys) == 0 {
 		return fmt.Errorf("internal error: no keys to print")
 	}
 
 	var errFound error
 	if len(validatorKeys) > 0 {
-		err := printSliceKeys("Validator keys:", validatorKeys, validatorPubKeyConverter)
+		err := printSliceKeys("Validator keys:", validatorKeys, validatorPubKeyConverter, format)
 		if err != nil {
 			errFound = err
 		}
 	}
 	if len(walletKeys) > 0 {
-		err := printSliceKeys("Wallet keys:", walletKeys, walletPubKeyConverter)
+		err := printSliceKeys("Wallet keys:", walletKeys, walletPubKeyConverter, format)
 		if err != nil {
 			errFound = err
 		}
 	}
 	if len(p2pKeys) > 0 {
-		err := printSliceKeys("P2p keys:", p2pKeys, pidPubKeyConverter)
+		err := printSliceKeys("P2p keys:", p2pKeys, pidPubKeyConverter, format)
 		if err != nil {
 			errFound = err
 		}
@@ -344,12 +453,12 @@ func printKeys(validatorKeys, walletKeys, p2pKeys []key) error {
 	return errFound
 }
 
-func printSliceKeys(message string, sliceKeys []key, converter pubKeyConverter) error {
+func printSliceKeys(message string, sliceKeys []key, converter pubKeyConverter, format keyFormat) error {
 	data := []string{message + "\n"}
 
 	for _, k := range sliceKeys {
 		buf := bytes.NewBuffer(make([]byte, 0))
-		err := writeKeyToStream(buf, k, converter)
+		err := format.encode(buf, k, converter)
 		if err != nil {
 			return err
 		}
@@ -379,26 +488,26 @@ func writeKeyToStream(writer io.Writer, key key, converter pubKeyConverter) erro
 	return pem.Encode(writer, &blk)
 }
 
-func saveKeys(validatorKeys, walletKeys, p2pKeys []key, noSplit bool) error {
+func saveKeys(validatorKeys, walletKeys, p2pKeys []key, noSplit bool, format keyFormat) error {
 	if len(validatorKeys)+len(walletKeys)+len(p2pKeys) == 0 {
 		return fmt.Errorf("internal error: no keys to save")
 	}
 
 	var errFound error
 	if len(validatorKeys) > 0 {
-		err := saveSliceKeys(validatorKeyFilenameTemplate, validatorKeys, validatorPubKeyConverter, noSplit)
+		err := saveSliceKeys(validatorKeyFilenameTemplate, validatorKeys, validatorPubKeyConverter, noSplit, format)
 		if err != nil {
 			errFound = err
 		}
 	}
 	if len(walletKeys) > 0 {
-		err := saveSliceKeys(walletKeyFilenameTemplate, walletKeys, walletPubKeyConverter, noSplit)
+		err := saveSliceKeys(walletKeyFilenameTemplate, walletKeys, walletPubKeyConverter, noSplit, format)
 		if err != nil {
 			errFound = err
 		}
 	}
 	if len(p2pKeys) > 0 {
-		err := saveSliceKeys(p2pKeyFilenameTemplate, p2pKeys, pidPubKeyConverter, noSplit)
+		err := saveSliceKeys(p2pKeyFilenameTemplate, p2pKeys, pidPubKeyConverter, noSplit, format)
 		if err != nil {
 			errFound = err
 		}
@@ -407,19 +516,19 @@ func saveKeys(validatorKeys, walletKeys, p2pKeys []key, noSplit bool) error {
 	return errFound
 }
 
-func saveSliceKeys(baseFilenameTemplate string, keys []key, converter pubKeyConverter, noSplit bool) error {
+func saveSliceKeys(baseFilenameTemplate string, keys []key, converter pubKeyConverter, noSplit bool, format keyFormat) error {
 	var file *os.File
 	var err error
 	for i, k := range keys {
 		shouldCreateFile := !noSplit || i == 0
 		if shouldCreateFile {
-			file, err = generateFile(i, len(keys), noSplit, baseFilenameTemplate)
+			file, err = generateFile(i, len(keys), noSplit, baseFilenameTemplate+format.extension)
 			if err != nil {
 				return err
 			}
 		}
 
-		err = writeKeyToStream(file, k, converter)
+		err = format.encode(file, k, converter)
 		if err != nil {
 			return err
 		}
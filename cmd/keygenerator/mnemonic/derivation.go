@@ -0,0 +1,65 @@
+package mnemonic
+
+import (
+	"crypto/ed25519"
+	"crypto/hmac"
+	"crypto/sha512"
+	"encoding/binary"
+)
+
+const (
+	hardenedOffset  = 0x80000000
+	ed25519SeedKey  = "ed25519 seed"
+	purposeIndex    = 44
+	coinTypeIndex   = 508
+	changeIndex     = 0
+	keyMaterialSize = 32
+)
+
+// WalletKey is a wallet key derived from a mnemonic
+type WalletKey struct {
+	AccountIndex uint32
+	AddressIndex uint32
+	PrivateKey   ed25519.PrivateKey
+	PublicKey    ed25519.PublicKey
+}
+
+// DeriveWalletKey derives the wallet key found on the MultiversX path m/44'/508'/account'/0'/address' of the
+// mnemonic. All the path levels are hardened, as required by the SLIP-0010 ed25519 derivation
+func (m Mnemonic) DeriveWalletKey(accountIndex uint32, addressIndex uint32) *WalletKey {
+	path := []uint32{purposeIndex, coinTypeIndex, accountIndex, changeIndex, addressIndex}
+	keyMaterial := deriveKeyMaterial(m.Seed(), path)
+	privateKey := ed25519.NewKeyFromSeed(keyMaterial)
+
+	return &WalletKey{
+		AccountIndex: accountIndex,
+		AddressIndex: addressIndex,
+		PrivateKey:   privateKey,
+		PublicKey:    privateKey.Public().(ed25519.PublicKey),
+	}
+}
+
+// deriveKeyMaterial applies the SLIP-0010 ed25519 master key generation and hardened child key derivation
+func deriveKeyMaterial(seed []byte, path []uint32) []byte {
+	digest := computeHMAC([]byte(ed25519SeedKey), seed)
+	key, chainCode := digest[:keyMaterialSize], digest[keyMaterialSize:]
+
+	for _, index := range path {
+		data := make([]byte, 0, 1+keyMaterialSize+4)
+		data = append(data, 0)
+		data = append(data, key...)
+		data = binary.BigEndian.AppendUint32(data, index|hardenedOffset)
+
+		digest = computeHMAC(chainCode, data)
+		key, chainCode = digest[:keyMaterialSize], digest[keyMaterialSize:]
+	}
+
+	return key
+}
+
+func computeHMAC(key []byte, data []byte) []byte {
+	mac := hmac.New(sha512.New, key)
+	_, _ = mac.Write(data)
+
+	return mac.Sum(nil)
+}
@@ -0,0 +1,12 @@
+package mnemonic
+
+import "errors"
+
+// ErrInvalidNumberOfWords signals that the mnemonic does not have a valid number of words
+var ErrInvalidNumberOfWords = errors.New("invalid number of mnemonic words")
+
+// ErrUnknownWord signals that the mnemonic holds a word that is not part of the BIP39 english wordlist
+var ErrUnknownWord = errors.New("unknown mnemonic word")
+
+// ErrInvalidChecksum signals that the checksum of the mnemonic does not match its words
+var ErrInvalidChecksum = errors.New("invalid mnemonic checksum")
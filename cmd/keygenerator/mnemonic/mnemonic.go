@@ -0,0 +1,118 @@
+package mnemonic
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/sha512"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"golang.org/x/crypto/pbkdf2"
+)
+
+const (
+	entropyBits        = 256
+	bitsPerWord        = 11
+	seedIterations     = 2048
+	seedLen            = 64
+	seedSaltPrefix     = "mnemonic"
+	wordListSize       = 2048
+	minNumWords        = 12
+	maxNumWords        = 24
+	numWordsMultiplier = 3
+)
+
+var (
+	wordList    = strings.Split(englishWords, "\n")
+	wordIndexes = createWordIndexes(wordList)
+)
+
+func createWordIndexes(words []string) map[string]int {
+	indexes := make(map[string]int, len(words))
+	for i, word := range words {
+		indexes[word] = i
+	}
+
+	return indexes
+}
+
+// Mnemonic is a validated BIP39 mnemonic, its words being separated by single spaces
+type Mnemonic string
+
+// NewMnemonic generates a new 24 words mnemonic from 256 bits of random entropy
+func NewMnemonic() (Mnemonic, error) {
+	entropy := make([]byte, entropyBits/8)
+	_, err := rand.Read(entropy)
+	if err != nil {
+		return "", err
+	}
+
+	return newMnemonicFromEntropy(entropy)
+}
+
+func newMnemonicFromEntropy(entropy []byte) (Mnemonic, error) {
+	numEntropyBits := len(entropy) * 8
+	numChecksumBits := numEntropyBits / 32
+	numWords := (numEntropyBits + numChecksumBits) / bitsPerWord
+
+	checksum := sha256.Sum256(entropy)
+	value := new(big.Int).SetBytes(entropy)
+	value.Lsh(value, uint(numChecksumBits))
+	value.Or(value, big.NewInt(int64(checksum[0]>>(8-numChecksumBits))))
+
+	words := make([]string, numWords)
+	mask := big.NewInt(wordListSize - 1)
+	for i := numWords - 1; i >= 0; i-- {
+		wordIndex := new(big.Int).And(value, mask)
+		words[i] = wordList[wordIndex.Int64()]
+		value.Rsh(value, bitsPerWord)
+	}
+
+	return Mnemonic(strings.Join(words, " ")), nil
+}
+
+// Parse validates the words and the checksum of the provided mnemonic. The words can be separated by any whitespace
+// and are case-insensitive
+func Parse(text string) (Mnemonic, error) {
+	words := strings.Fields(strings.ToLower(text))
+	numWords := len(words)
+	if numWords < minNumWords || numWords > maxNumWords || numWords%numWordsMultiplier != 0 {
+		return "", fmt.Errorf("%w: %d words", ErrInvalidNumberOfWords, numWords)
+	}
+
+	value := big.NewInt(0)
+	for _, word := range words {
+		index, ok := wordIndexes[word]
+		if !ok {
+			return "", fmt.Errorf("%w: %s", ErrUnknownWord, word)
+		}
+
+		value.Lsh(value, bitsPerWord)
+		value.Or(value, big.NewInt(int64(index)))
+	}
+
+	numChecksumBits := numWords * bitsPerWord / 33
+	numEntropyBytes := numChecksumBits * 4
+	checksumMask := big.NewInt(1<<numChecksumBits - 1)
+	providedChecksum := new(big.Int).And(value, checksumMask).Int64()
+
+	entropy := value.Rsh(value, uint(numChecksumBits)).FillBytes(make([]byte, numEntropyBytes))
+	checksum := sha256.Sum256(entropy)
+	expectedChecksum := int64(checksum[0] >> (8 - numChecksumBits))
+	if providedChecksum != expectedChecksum {
+		return "", ErrInvalidChecksum
+	}
+
+	return Mnemonic(strings.Join(words, " ")), nil
+}
+
+// Seed returns the BIP39 seed of the mnemonic, computed with an empty passphrase as the MultiversX wallets do
+func (m Mnemonic) Seed() []byte {
+	return pbkdf2.Key([]byte(m), []byte(seedSaltPrefix), seedIterations, seedLen, sha512.New)
+}
+
+// String returns the words of the mnemonic
+func (m Mnemonic) String() string {
+	return string(m)
+}
@@ -0,0 +1,132 @@
+package mnemonic
+
+import (
+	"encoding/hex"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/core/pubkeyConverter"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// testMnemonic is the mnemonic of the MultiversX test wallets
+const testMnemonic = "moral volcano peasant pass circle pen over picture flat shop clap goat never lyrics gather prepare " +
+	"woman film husband gravity behind test tiger improve"
+
+func TestWordList(t *testing.T) {
+	t.Parallel()
+
+	require.Equal(t, wordListSize, len(wordList))
+	require.Equal(t, wordListSize, len(wordIndexes))
+	assert.Equal(t, "abandon", wordList[0])
+	assert.Equal(t, "zoo", wordList[wordListSize-1])
+}
+
+func TestNewMnemonicFromEntropy(t *testing.T) {
+	t.Parallel()
+
+	// BIP39 test vectors
+	testVectors := map[string]string{
+		"00000000000000000000000000000000": "abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon about",
+		"7f7f7f7f7f7f7f7f7f7f7f7f7f7f7f7f": "legal winner thank year wave sausage worth useful legal winner thank yellow",
+		"80808080808080808080808080808080": "letter advice cage absurd amount doctor acoustic avoid letter advice cage above",
+		"ffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff": "zoo zoo zoo zoo zoo zoo zoo zoo zoo zoo zoo zoo zoo " +
+			"zoo zoo zoo zoo zoo zoo zoo zoo zoo zoo vote",
+	}
+
+	for entropyHex, expectedMnemonic := range testVectors {
+		entropy, err := hex.DecodeString(entropyHex)
+		require.Nil(t, err)
+
+		m, err := newMnemonicFromEntropy(entropy)
+		require.Nil(t, err)
+		assert.Equal(t, expectedMnemonic, m.String())
+
+		parsed, err := Parse(expectedMnemonic)
+		require.Nil(t, err)
+		assert.Equal(t, m, parsed)
+	}
+}
+
+func TestNewMnemonic(t *testing.T) {
+	t.Parallel()
+
+	m, err := NewMnemonic()
+	require.Nil(t, err)
+	assert.Equal(t, maxNumWords, len(strings.Fields(m.String())))
+
+	parsed, err := Parse(m.String())
+	require.Nil(t, err)
+	assert.Equal(t, m, parsed)
+}
+
+func TestParse(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid number of words should error", func(t *testing.T) {
+		t.Parallel()
+
+		m, err := Parse("abandon abandon about")
+		assert.True(t, errors.Is(err, ErrInvalidNumberOfWords))
+		assert.Empty(t, m)
+	})
+	t.Run("unknown word should error", func(t *testing.T) {
+		t.Parallel()
+
+		m, err := Parse("abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon multiversx")
+		assert.True(t, errors.Is(err, ErrUnknownWord))
+		assert.Empty(t, m)
+	})
+	t.Run("invalid checksum should error", func(t *testing.T) {
+		t.Parallel()
+
+		m, err := Parse("abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon")
+		assert.Equal(t, ErrInvalidChecksum, err)
+		assert.Empty(t, m)
+	})
+	t.Run("should normalize the words", func(t *testing.T) {
+		t.Parallel()
+
+		m, err := Parse(" Abandon abandon abandon abandon\nabandon abandon abandon abandon abandon abandon abandon  ABOUT \n")
+		require.Nil(t, err)
+		assert.Equal(t, "abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon about", m.String())
+	})
+}
+
+func TestMnemonic_Seed(t *testing.T) {
+	t.Parallel()
+
+	m, err := Parse("abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon about")
+	require.Nil(t, err)
+
+	expectedSeed := "5eb00bbddcf069084889a8ab9155568165f5c453ccb85e70811aaed6f6da5fc19a5ac40b389cd370d086206dec8aa6c43daea6690f20ad3d8d48b2d2ce9e38e4"
+	assert.Equal(t, expectedSeed, hex.EncodeToString(m.Seed()))
+}
+
+func TestDeriveKeyMaterial(t *testing.T) {
+	t.Parallel()
+
+	// SLIP-0010 ed25519 test vector 1
+	seed, _ := hex.DecodeString("000102030405060708090a0b0c0d0e0f")
+	assert.Equal(t, "2b4be7f19ee27bbf30c667b642d5f4aa69fd169872f8fc3059c08ebae2eb19e7", hex.EncodeToString(deriveKeyMaterial(seed, nil)))
+	assert.Equal(t, "68e0fe46dfb67e368c75379acec591dad19df3cde26e63b93a8e704f1dade7a3", hex.EncodeToString(deriveKeyMaterial(seed, []uint32{0})))
+}
+
+func TestMnemonic_DeriveWalletKey(t *testing.T) {
+	t.Parallel()
+
+	converter, _ := pubkeyConverter.NewBech32PubkeyConverter(32, "erd")
+	m, err := Parse(testMnemonic)
+	require.Nil(t, err)
+
+	alice := m.DeriveWalletKey(0, 0)
+	assert.Equal(t, uint32(0), alice.AddressIndex)
+	address, _ := converter.Encode(alice.PublicKey)
+	assert.Equal(t, "erd1qyu5wthldzr8wx5c9ucg8kjagg0jfs53s8nr3zpz3hypefsdd8ssycr6th", address)
+
+	bob := m.DeriveWalletKey(0, 1)
+	address, _ = converter.Encode(bob.PublicKey)
+	assert.Equal(t, "erd1spyavw0956vq68xj8y4tenjpq2wd5a9p2c6j8gsz7ztyrnpxrruqzu66jx", address)
+}
@@ -0,0 +1,143 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"math"
+	"os"
+	"path/filepath"
+
+	"github.com/multiversx/mx-chain-go/cmd/keygenerator/keystore"
+	"github.com/multiversx/mx-chain-go/cmd/keygenerator/mnemonic"
+)
+
+const mnemonicTextExtension = ".txt"
+
+// generateMnemonic generates a new mnemonic, written as plain text for the PEM format or encrypted for the
+// keystore format
+func generateMnemonic(format keyFormat, consoleOut bool) error {
+	m, err := mnemonic.NewMnemonic()
+	if err != nil {
+		return err
+	}
+
+	if consoleOut {
+		log.Info("Mnemonic:\n" + m.String())
+		return nil
+	}
+
+	workingDir, err := os.Getwd()
+	if err != nil {
+		return err
+	}
+
+	filenameTemplate := filepath.Join(workingDir, mnemonicFilenameTemplate)
+	if format.extension == pemExtension {
+		filenameTemplate += mnemonicTextExtension
+	} else {
+		filenameTemplate += format.extension
+	}
+	backupFileIfExists(filenameTemplate)
+	filename := fmt.Sprintf(filenameTemplate, "")
+
+	buff := []byte(m.String() + "\n")
+	if format.extension == keystoreExtension {
+		buff, err = encryptMnemonic(m)
+		if err != nil {
+			return err
+		}
+	}
+
+	file, err := createNewFile(filename)
+	if err != nil {
+		return err
+	}
+
+	_, err = file.Write(buff)
+	if err != nil {
+		_ = file.Close()
+		return err
+	}
+
+	return file.Close()
+}
+
+func encryptMnemonic(m mnemonic.Mnemonic) ([]byte, error) {
+	pass, err := getPassword()
+	if err != nil {
+		return nil, err
+	}
+
+	ks, err := keystore.Encrypt(keystore.KindMnemonic, []byte(m.String()), pass)
+	if err != nil {
+		return nil, err
+	}
+
+	return ks.Marshal()
+}
+
+// loadMnemonic reads the mnemonic from a plain text file or from a keystore of the mnemonic kind
+func loadMnemonic(filePath string) (mnemonic.Mnemonic, error) {
+	buff, err := os.ReadFile(filePath)
+	if err != nil {
+		return "", err
+	}
+
+	ks, errUnmarshal := keystore.Unmarshal(buff)
+	if errUnmarshal != nil {
+		return mnemonic.Parse(string(buff))
+	}
+	if ks.Kind != keystore.KindMnemonic {
+		return "", fmt.Errorf("the keystore %s is not of kind %s", filePath, keystore.KindMnemonic)
+	}
+
+	pass, err := getPassword()
+	if err != nil {
+		return "", err
+	}
+	buff, err = ks.Decrypt(pass)
+	if err != nil {
+		return "", err
+	}
+
+	return mnemonic.Parse(string(buff))
+}
+
+// deriveWalletKeys derives numKeys wallet keys from the mnemonic, on consecutive address indexes starting with the
+// provided one. When a shard is provided, the indexes of the keys not allocated to that shard are skipped
+func deriveWalletKeys(mnemonicFile string, accountIndex uint32, startIndex uint32, numKeys int, shardID int) ([]key, error) {
+	if numKeys < 1 {
+		return nil, fmt.Errorf("number of keys should be a number greater or equal to 1")
+	}
+
+	m, err := loadMnemonic(mnemonicFile)
+	if err != nil {
+		return nil, err
+	}
+
+	withPreferredShard := shardID != noshard && shardID >= 0 && shardID <= 255
+	walletKeys := make([]key, 0, numKeys)
+	for addressIndex := uint64(startIndex); len(walletKeys) < numKeys; addressIndex++ {
+		if addressIndex > math.MaxUint32 {
+			return nil, fmt.Errorf("address indexes exhausted after deriving %d keys", len(walletKeys))
+		}
+
+		walletKey := m.DeriveWalletKey(accountIndex, uint32(addressIndex))
+		if withPreferredShard && !keyInShard(walletKey.PublicKey, byte(shardID)) {
+			continue
+		}
+
+		address, errEncode := walletPubKeyConverter.Encode(walletKey.PublicKey)
+		if errEncode != nil {
+			return nil, errEncode
+		}
+		log.Info("derived wallet key", "address", address, "account index", accountIndex, "address index", addressIndex)
+
+		walletKeys = append(walletKeys, key{
+			skBytes: bytes.Clone(walletKey.PrivateKey),
+			pkBytes: bytes.Clone(walletKey.PublicKey),
+		})
+	}
+
+	return walletKeys, nil
+}
@@ -0,0 +1,15 @@
+package logs
+
+import "errors"
+
+// ErrInvalidRoundRange signals that the minimum round is greater than the maximum round
+var ErrInvalidRoundRange = errors.New("invalid round range")
+
+// ErrNilWriter signals that a nil writer has been provided
+var ErrNilWriter = errors.New("nil writer")
+
+// ErrNilReader signals that a nil reader has been provided
+var ErrNilReader = errors.New("nil reader")
+
+// ErrNilRecordHandler signals that a nil record handler has been provided
+var ErrNilRecordHandler = errors.New("nil record handler")
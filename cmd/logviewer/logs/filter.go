@@ -0,0 +1,126 @@
+package logs
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	logger "github.com/multiversx/mx-chain-logger-go"
+)
+
+// NoRound marks an unbounded end of the rounds range
+const NoRound = int64(-1)
+
+// ArgsFilter holds the arguments needed to create a Filter. The empty values disable the corresponding criteria
+type ArgsFilter struct {
+	LoggerNames  []string
+	MinLevel     string
+	MinRound     int64
+	MaxRound     int64
+	MessageRegex string
+	FieldsRegex  string
+}
+
+// Filter selects the records matching all the configured criteria
+type Filter struct {
+	loggerNames  []string
+	minLevel     logger.LogLevel
+	minRound     int64
+	maxRound     int64
+	messageRegex *regexp.Regexp
+	fieldsRegex  *regexp.Regexp
+}
+
+// NewFilter creates a new Filter
+func NewFilter(args ArgsFilter) (*Filter, error) {
+	if args.MinRound != NoRound && args.MaxRound != NoRound && args.MinRound > args.MaxRound {
+		return nil, fmt.Errorf("%w: %d > %d", ErrInvalidRoundRange, args.MinRound, args.MaxRound)
+	}
+
+	f := &Filter{
+		loggerNames: make([]string, 0, len(args.LoggerNames)),
+		minLevel:    logger.LogTrace,
+		minRound:    args.MinRound,
+		maxRound:    args.MaxRound,
+	}
+	for _, name := range args.LoggerNames {
+		name = strings.TrimSpace(name)
+		if len(name) > 0 {
+			f.loggerNames = append(f.loggerNames, name)
+		}
+	}
+
+	var err error
+	if len(args.MinLevel) > 0 {
+		f.minLevel, err = logger.GetLogLevel(args.MinLevel)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if len(args.MessageRegex) > 0 {
+		f.messageRegex, err = regexp.Compile(args.MessageRegex)
+		if err != nil {
+			return nil, fmt.Errorf("%w for the message regex", err)
+		}
+	}
+	if len(args.FieldsRegex) > 0 {
+		f.fieldsRegex, err = regexp.Compile(args.FieldsRegex)
+		if err != nil {
+			return nil, fmt.Errorf("%w for the fields regex", err)
+		}
+	}
+
+	return f, nil
+}
+
+// Matches returns true if the record matches all the criteria
+func (f *Filter) Matches(record *Record) bool {
+	if record.LogLevel() < f.minLevel {
+		return false
+	}
+	if !f.matchesLoggerName(record.LoggerName) {
+		return false
+	}
+	if f.minRound != NoRound && record.Round < f.minRound {
+		return false
+	}
+	if f.maxRound != NoRound && record.Round > f.maxRound {
+		return false
+	}
+	if f.messageRegex != nil && !f.messageRegex.MatchString(record.Message) {
+		return false
+	}
+
+	return f.matchesFields(record.Args)
+}
+
+// matchesLoggerName accepts the logger names equal to one of the configured names or being a sub-logger of it,
+// as "process" matches "process/block" as well
+func (f *Filter) matchesLoggerName(loggerName string) bool {
+	if len(f.loggerNames) == 0 {
+		return true
+	}
+
+	for _, name := range f.loggerNames {
+		if loggerName == name || strings.HasPrefix(loggerName, name+"/") {
+			return true
+		}
+	}
+
+	return false
+}
+
+// matchesFields checks the fields regex against each argument, formatted as "key = value"
+func (f *Filter) matchesFields(args []Arg) bool {
+	if f.fieldsRegex == nil {
+		return true
+	}
+
+	for _, arg := range args {
+		if f.fieldsRegex.MatchString(arg.Key + " = " + arg.Value) {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,105 @@
+package logs
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createArgsFilter() ArgsFilter {
+	return ArgsFilter{
+		MinRound: NoRound,
+		MaxRound: NoRound,
+	}
+}
+
+func createRecord() *Record {
+	return &Record{
+		Level:      "DEBUG",
+		LoggerName: "process/block",
+		Message:    "block committed",
+		Round:      300,
+		Args:       []Arg{{Key: "nonce", Value: "10"}, {Key: "hash", Value: "aabb"}},
+	}
+}
+
+func TestNewFilter(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid round range should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createArgsFilter()
+		args.MinRound = 10
+		args.MaxRound = 9
+		f, err := NewFilter(args)
+		assert.True(t, errors.Is(err, ErrInvalidRoundRange))
+		assert.Nil(t, f)
+	})
+	t.Run("invalid level should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createArgsFilter()
+		args.MinLevel = "LOUD"
+		f, err := NewFilter(args)
+		assert.NotNil(t, err)
+		assert.Nil(t, f)
+	})
+	t.Run("invalid message regex should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createArgsFilter()
+		args.MessageRegex = "block("
+		f, err := NewFilter(args)
+		assert.NotNil(t, err)
+		assert.Nil(t, f)
+	})
+	t.Run("invalid fields regex should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createArgsFilter()
+		args.FieldsRegex = "[nonce"
+		f, err := NewFilter(args)
+		assert.NotNil(t, err)
+		assert.Nil(t, f)
+	})
+}
+
+func TestFilter_Matches(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name     string
+		setArgs  func(args *ArgsFilter)
+		expected bool
+	}{
+		{name: "no criteria", setArgs: func(args *ArgsFilter) {}, expected: true},
+		{name: "same logger name", setArgs: func(args *ArgsFilter) { args.LoggerNames = []string{"api", "process/block"} }, expected: true},
+		{name: "parent logger name", setArgs: func(args *ArgsFilter) { args.LoggerNames = []string{" process"} }, expected: true},
+		{name: "other logger name", setArgs: func(args *ArgsFilter) { args.LoggerNames = []string{"process/bl"} }, expected: false},
+		{name: "lower min level", setArgs: func(args *ArgsFilter) { args.MinLevel = "TRACE" }, expected: true},
+		{name: "higher min level", setArgs: func(args *ArgsFilter) { args.MinLevel = "info" }, expected: false},
+		{name: "round in range", setArgs: func(args *ArgsFilter) { args.MinRound, args.MaxRound = 300, 300 }, expected: true},
+		{name: "round before range", setArgs: func(args *ArgsFilter) { args.MinRound = 301 }, expected: false},
+		{name: "round after range", setArgs: func(args *ArgsFilter) { args.MaxRound = 299 }, expected: false},
+		{name: "matching message", setArgs: func(args *ArgsFilter) { args.MessageRegex = "^block" }, expected: true},
+		{name: "not matching message", setArgs: func(args *ArgsFilter) { args.MessageRegex = "^committed" }, expected: false},
+		{name: "matching field", setArgs: func(args *ArgsFilter) { args.FieldsRegex = "^hash = aa" }, expected: true},
+		{name: "not matching field", setArgs: func(args *ArgsFilter) { args.FieldsRegex = "nonce = 11" }, expected: false},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			args := createArgsFilter()
+			tc.setArgs(&args)
+			f, err := NewFilter(args)
+			require.Nil(t, err)
+			assert.Equal(t, tc.expected, f.Matches(createRecord()))
+		})
+	}
+}
@@ -0,0 +1,100 @@
+package logs
+
+import (
+	"strings"
+	"time"
+
+	logger "github.com/multiversx/mx-chain-logger-go"
+	"github.com/multiversx/mx-chain-logger-go/proto"
+)
+
+// Arg is a key-value argument of a log line
+type Arg struct {
+	Key   string `json:"key"`
+	Value string `json:"value"`
+}
+
+// Record is the structured form of a log line, written as a JSON line when recording
+type Record struct {
+	Node       string    `json:"node"`
+	Timestamp  time.Time `json:"timestamp"`
+	Level      string    `json:"level"`
+	LoggerName string    `json:"loggerName"`
+	Message    string    `json:"message"`
+	Shard      string    `json:"shard,omitempty"`
+	Epoch      uint32    `json:"epoch"`
+	Round      int64     `json:"round"`
+	SubRound   string    `json:"subRound,omitempty"`
+	Args       []Arg     `json:"args,omitempty"`
+}
+
+// NewRecord creates a record from a log line received from the provided node
+func NewRecord(node string, line *logger.LogLineWrapper) *Record {
+	return &Record{
+		Node:       node,
+		Timestamp:  time.Unix(0, line.Timestamp).UTC(),
+		Level:      strings.TrimSpace(logger.LogLevel(line.LogLevel).String()),
+		LoggerName: line.LoggerName,
+		Message:    line.Message,
+		Shard:      line.Correlation.Shard,
+		Epoch:      line.Correlation.Epoch,
+		Round:      line.Correlation.Round,
+		SubRound:   line.Correlation.SubRound,
+		Args:       createArgs(line.Args),
+	}
+}
+
+// createArgs pairs the arguments, which are provided as "name1", "val1", "name2", "val2" ...
+func createArgs(args []string) []Arg {
+	if len(args) < 2 {
+		return nil
+	}
+
+	result := make([]Arg, 0, len(args)/2)
+	for i := 1; i < len(args); i += 2 {
+		result = append(result, Arg{
+			Key:   args[i-1],
+			Value: args[i],
+		})
+	}
+
+	return result
+}
+
+// LogLevel returns the level of the record, logger.LogNone if the level is unknown
+func (r *Record) LogLevel() logger.LogLevel {
+	level, err := logger.GetLogLevel(r.Level)
+	if err != nil {
+		return logger.LogNone
+	}
+
+	return level
+}
+
+// ToLogLine converts the record back to a log line, ready to be displayed. The message is prefixed with the node
+// when required, so the lines received from more nodes can be told apart
+func (r *Record) ToLogLine(withNode bool) *logger.LogLine {
+	message := r.Message
+	if withNode {
+		message = "[" + r.Node + "] " + message
+	}
+
+	args := make([]interface{}, 0, len(r.Args)*2)
+	for _, arg := range r.Args {
+		args = append(args, arg.Key, arg.Value)
+	}
+
+	return &logger.LogLine{
+		LoggerName: r.LoggerName,
+		Correlation: proto.LogCorrelationMessage{
+			Shard:    r.Shard,
+			Epoch:    r.Epoch,
+			Round:    r.Round,
+			SubRound: r.SubRound,
+		},
+		Message:   message,
+		LogLevel:  r.LogLevel(),
+		Args:      args,
+		Timestamp: r.Timestamp.Local(),
+	}
+}
@@ -0,0 +1,46 @@
+package logs
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"io"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+)
+
+const maxLineSize = 10 * 1024 * 1024
+
+// ReadRecords reads the JSON lines records in order, calling the handler for each of them. Empty lines are skipped
+func ReadRecords(reader io.Reader, handler func(record *Record) error) error {
+	if check.IfNilReflect(reader) {
+		return ErrNilReader
+	}
+	if handler == nil {
+		return ErrNilRecordHandler
+	}
+
+	scanner := bufio.NewScanner(reader)
+	scanner.Buffer(make([]byte, 0, 64*1024), maxLineSize)
+	lineNumber := 0
+	for scanner.Scan() {
+		lineNumber++
+		line := scanner.Bytes()
+		if len(line) == 0 {
+			continue
+		}
+
+		record := &Record{}
+		err := json.Unmarshal(line, record)
+		if err != nil {
+			return fmt.Errorf("%w on line %d", err, lineNumber)
+		}
+
+		err = handler(record)
+		if err != nil {
+			return err
+		}
+	}
+
+	return scanner.Err()
+}
@@ -0,0 +1,79 @@
+package logs
+
+import (
+	"bytes"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewRecordWriter(t *testing.T) {
+	t.Parallel()
+
+	rw, err := NewRecordWriter(nil)
+	assert.Equal(t, ErrNilWriter, err)
+	assert.Nil(t, rw)
+}
+
+func TestReadRecords(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil reader should error", func(t *testing.T) {
+		t.Parallel()
+
+		err := ReadRecords(nil, func(record *Record) error { return nil })
+		assert.Equal(t, ErrNilReader, err)
+	})
+	t.Run("nil handler should error", func(t *testing.T) {
+		t.Parallel()
+
+		err := ReadRecords(strings.NewReader(""), nil)
+		assert.Equal(t, ErrNilRecordHandler, err)
+	})
+	t.Run("invalid line should error", func(t *testing.T) {
+		t.Parallel()
+
+		err := ReadRecords(strings.NewReader("{}\nINFO [2024-01-01] plain line\n"), func(record *Record) error { return nil })
+		assert.ErrorContains(t, err, "on line 2")
+	})
+	t.Run("handler error should stop", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		numCalls := 0
+		err := ReadRecords(strings.NewReader("{}\n{}\n"), func(record *Record) error {
+			numCalls++
+			return expectedErr
+		})
+		assert.Equal(t, expectedErr, err)
+		assert.Equal(t, 1, numCalls)
+	})
+	t.Run("should read the written records", func(t *testing.T) {
+		t.Parallel()
+
+		buff := &bytes.Buffer{}
+		rw, err := NewRecordWriter(buff)
+		require.Nil(t, err)
+
+		records := []*Record{
+			NewRecord("node-0", createLogLineWrapper()),
+			NewRecord("node-1", createLogLineWrapper()),
+		}
+		for _, record := range records {
+			require.Nil(t, rw.Write(record))
+		}
+		buff.WriteString("\n")
+		assert.Equal(t, 3, strings.Count(buff.String(), "\n"))
+
+		readRecords := make([]*Record, 0)
+		err = ReadRecords(buff, func(record *Record) error {
+			readRecords = append(readRecords, record)
+			return nil
+		})
+		require.Nil(t, err)
+		assert.Equal(t, records, readRecords)
+	})
+}
@@ -0,0 +1,34 @@
+package logs
+
+import (
+	"encoding/json"
+	"io"
+	"sync"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+)
+
+// RecordWriter writes the records as JSON lines. It is safe to be used by more nodes at once
+type RecordWriter struct {
+	mut     sync.Mutex
+	encoder *json.Encoder
+}
+
+// NewRecordWriter creates a new RecordWriter
+func NewRecordWriter(writer io.Writer) (*RecordWriter, error) {
+	if check.IfNilReflect(writer) {
+		return nil, ErrNilWriter
+	}
+
+	return &RecordWriter{
+		encoder: json.NewEncoder(writer),
+	}, nil
+}
+
+// Write writes the record on a single line
+func (rw *RecordWriter) Write(record *Record) error {
+	rw.mut.Lock()
+	defer rw.mut.Unlock()
+
+	return rw.encoder.Encode(record)
+}
@@ -0,0 +1,71 @@
+package logs
+
+import (
+	"testing"
+	"time"
+
+	logger "github.com/multiversx/mx-chain-logger-go"
+	"github.com/multiversx/mx-chain-logger-go/proto"
+	"github.com/stretchr/testify/assert"
+)
+
+func createLogLineWrapper() *logger.LogLineWrapper {
+	return &logger.LogLineWrapper{
+		LogLineMessage: proto.LogLineMessage{
+			Message:    "block committed",
+			LogLevel:   int32(logger.LogDebug),
+			Args:       []string{"nonce", "10", "hash", "aabb", "odd"},
+			Timestamp:  time.Unix(1700000000, 5).UnixNano(),
+			LoggerName: "process/block",
+			Correlation: proto.LogCorrelationMessage{
+				Shard:    "1",
+				Epoch:    2,
+				Round:    300,
+				SubRound: "(END_ROUND)",
+			},
+		},
+	}
+}
+
+func TestNewRecord(t *testing.T) {
+	t.Parallel()
+
+	record := NewRecord("127.0.0.1:8080", createLogLineWrapper())
+	assert.Equal(t, &Record{
+		Node:       "127.0.0.1:8080",
+		Timestamp:  time.Unix(1700000000, 5).UTC(),
+		Level:      "DEBUG",
+		LoggerName: "process/block",
+		Message:    "block committed",
+		Shard:      "1",
+		Epoch:      2,
+		Round:      300,
+		SubRound:   "(END_ROUND)",
+		Args:       []Arg{{Key: "nonce", Value: "10"}, {Key: "hash", Value: "aabb"}},
+	}, record)
+	assert.Equal(t, logger.LogDebug, record.LogLevel())
+}
+
+func TestRecord_LogLevel(t *testing.T) {
+	t.Parallel()
+
+	record := &Record{Level: "unknown"}
+	assert.Equal(t, logger.LogNone, record.LogLevel())
+}
+
+func TestRecord_ToLogLine(t *testing.T) {
+	t.Parallel()
+
+	record := NewRecord("127.0.0.1:8080", createLogLineWrapper())
+
+	line := record.ToLogLine(false)
+	assert.Equal(t, "block committed", line.Message)
+	assert.Equal(t, logger.LogDebug, line.LogLevel)
+	assert.Equal(t, "process/block", line.LoggerName)
+	assert.Equal(t, int64(300), line.Correlation.Round)
+	assert.Equal(t, []interface{}{"nonce", "10", "hash", "aabb"}, line.Args)
+	assert.True(t, record.Timestamp.Equal(line.Timestamp))
+
+	line = record.ToLogLine(true)
+	assert.Equal(t, "[127.0.0.1:8080] block committed", line.Message)
+}
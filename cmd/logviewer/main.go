@@ -8,12 +8,16 @@ import (
 	"os/signal"
 	"path/filepath"
 	"runtime"
+	"strings"
+	"sync"
 	"syscall"
 	"time"
 
 	"github.com/gorilla/websocket"
 	"github.com/multiversx/mx-chain-core-go/core"
 	"github.com/multiversx/mx-chain-core-go/marshal"
+	"github.com/multiversx/mx-chain-go/cmd/common/nodeaddresses"
+	"github.com/multiversx/mx-chain-go/cmd/logviewer/logs"
 	"github.com/multiversx/mx-chain-go/common"
 	"github.com/multiversx/mx-chain-logger-go"
 	"github.com/urfave/cli"
@@ -37,6 +41,15 @@ type config struct {
 	logWithCorrelation bool
 	logWithLoggerName  bool
 	withTLS            bool
+	addresses          string
+	recordFile         string
+	replayFile         string
+	filterLoggers      string
+	filterLevel        string
+	filterMessage      string
+	filterFields       string
+	minRound           int64
+	maxRound           int64
 }
 
 var (
@@ -120,15 +133,85 @@ VERSION:
 		Value:       "private_key.pem",
 		Destination: &argsConfig.certPkFile,
 	}
+	// addresses defines a flag for setting more nodes to connect to at once
+	addresses = cli.StringFlag{
+		Name: "addresses",
+		Usage: "Comma-separated addresses and port numbers of the mx-chain-go nodes. If provided, the application will " +
+			"connect to all the nodes at once instead of the one set with the --address flag and will interleave their " +
+			"log lines, each one prefixed with the node address",
+		Value:       "",
+		Destination: &argsConfig.addresses,
+	}
+	// recordFile defines a flag for the file in which the received log lines are recorded as structured JSON lines
+	recordFile = cli.StringFlag{
+		Name: "record",
+		Usage: "The file in which all the received log lines are appended as JSON lines, keeping the logger name, " +
+			"the correlation fields and the arguments. The filters only apply to the displayed lines",
+		Value:       "",
+		Destination: &argsConfig.recordFile,
+	}
+	// replayFile defines a flag for a recorded file to be displayed offline
+	replayFile = cli.StringFlag{
+		Name:        "replay",
+		Usage:       "The recorded JSON lines file to be displayed through the filters, without connecting to any node",
+		Value:       "",
+		Destination: &argsConfig.replayFile,
+	}
+	// filterLoggers defines a flag for displaying only the lines of some loggers
+	filterLoggers = cli.StringFlag{
+		Name:        "filter-logger",
+		Usage:       "Comma-separated logger names. Only the lines of these loggers and of their sub-loggers are displayed",
+		Value:       "",
+		Destination: &argsConfig.filterLoggers,
+	}
+	// filterLevel defines a flag for displaying only the lines with at least a log level
+	filterLevel = cli.StringFlag{
+		Name:        "filter-level",
+		Usage:       "The minimum log level of the displayed lines. Useful when replaying, as the live lines are already filtered by the node",
+		Value:       "",
+		Destination: &argsConfig.filterLevel,
+	}
+	// minRound defines a flag for the first round of the displayed lines
+	minRound = cli.Int64Flag{
+		Name:        "min-round",
+		Usage:       "The first round of the displayed lines. -1 means no limit",
+		Value:       logs.NoRound,
+		Destination: &argsConfig.minRound,
+	}
+	// maxRound defines a flag for the last round of the displayed lines
+	maxRound = cli.Int64Flag{
+		Name:        "max-round",
+		Usage:       "The last round of the displayed lines. -1 means no limit",
+		Value:       logs.NoRound,
+		Destination: &argsConfig.maxRound,
+	}
+	// filterMessage defines a flag for a regex the displayed messages should match
+	filterMessage = cli.StringFlag{
+		Name:        "filter-message",
+		Usage:       "Regular expression the message of the displayed lines should match",
+		Value:       "",
+		Destination: &argsConfig.filterMessage,
+	}
+	// filterFields defines a flag for a regex one of the displayed arguments should match
+	filterFields = cli.StringFlag{
+		Name:        "filter-fields",
+		Usage:       "Regular expression one of the arguments of the displayed lines should match, each argument being formatted as \"key = value\"",
+		Value:       "",
+		Destination: &argsConfig.filterFields,
+	}
 
 	argsConfig = &config{}
 
-	log           = logger.GetOrCreate("logviewer")
-	cliApp        *cli.App
-	webSocket     *websocket.Conn
-	fileForLogs   *os.File
-	marshalizer   marshal.Marshalizer
-	retryDuration = time.Second * 10
+	log            = logger.GetOrCreate("logviewer")
+	cliApp         *cli.App
+	mutWebSockets  sync.Mutex
+	webSockets     = make(map[string]*websocket.Conn)
+	fileForLogs    *os.File
+	marshalizer    marshal.Marshalizer
+	retryDuration  = time.Second * 10
+	logFilter      *logs.Filter
+	recordWriter   *logs.RecordWriter
+	withNodePrefix bool
 )
 
 func main() {
@@ -163,6 +246,15 @@ func initCliFlags() {
 		withTLS,
 		certFile,
 		certPkFile,
+		addresses,
+		recordFile,
+		replayFile,
+		filterLoggers,
+		filterLevel,
+		minRound,
+		maxRound,
+		filterMessage,
+		filterFields,
 	}
 	cliApp.Authors = []cli.Author{
 		{
@@ -200,6 +292,37 @@ func startLogViewer(ctx *cli.Context) error {
 		}()
 	}
 
+	logFilter, err = logs.NewFilter(logs.ArgsFilter{
+		LoggerNames:  strings.Split(argsConfig.filterLoggers, ","),
+		MinLevel:     argsConfig.filterLevel,
+		MinRound:     argsConfig.minRound,
+		MaxRound:     argsConfig.maxRound,
+		MessageRegex: argsConfig.filterMessage,
+		FieldsRegex:  argsConfig.filterFields,
+	})
+	if err != nil {
+		return err
+	}
+
+	if len(argsConfig.replayFile) > 0 {
+		return replayRecordedFile(argsConfig.replayFile)
+	}
+
+	if len(argsConfig.recordFile) > 0 {
+		fileForRecords, errOpen := os.OpenFile(argsConfig.recordFile, os.O_CREATE|os.O_APPEND|os.O_WRONLY, core.FileModeReadWrite)
+		if errOpen != nil {
+			return errOpen
+		}
+		defer func() {
+			_ = fileForRecords.Close()
+		}()
+
+		recordWriter, err = logs.NewRecordWriter(fileForRecords)
+		if err != nil {
+			return err
+		}
+	}
+
 	profile := &logger.Profile{
 		LogLevelPatterns: argsConfig.logLevel,
 		WithCorrelation:  argsConfig.logWithCorrelation,
@@ -211,32 +334,75 @@ func startLogViewer(ctx *cli.Context) error {
 		log.LogIfError(err)
 	}
 
-	go func() {
-		for {
-			webSocket, err = openWebSocket(argsConfig.address)
-			if err != nil {
-				log.Error(fmt.Sprintf("logviewer websocket error, retrying in %v...", retryDuration), "error", err.Error())
-				time.Sleep(retryDuration)
-				continue
-			}
-
-			if customLogProfile {
-				err = sendProfile(webSocket, profile)
-			} else {
-				err = sendDefaultProfileIdentifier(webSocket)
-			}
-			log.LogIfError(err)
+	nodeAddresses := []string{argsConfig.address}
+	if len(argsConfig.addresses) > 0 {
+		nodeAddresses = nodeaddresses.Parse(argsConfig.addresses)
+	}
+	withNodePrefix = len(nodeAddresses) > 1
+	for _, nodeAddress := range nodeAddresses {
+		go listenToNode(nodeAddress, profile, customLogProfile)
+	}
+
+	// set this log's level to the lowest desired log level that matches received logs from mx-chain-go
+	lowestLogLevel := getLowestLogLevel(logLevels)
+	log.SetLevel(lowestLogLevel)
 
-			listeningOnWebSocket()
+	waitForUserToTerminateApp()
+
+	return nil
+}
+
+func listenToNode(nodeAddress string, profile *logger.Profile, customLogProfile bool) {
+	for {
+		conn, err := openWebSocket(nodeAddress)
+		if err != nil {
+			log.Error(fmt.Sprintf("logviewer websocket error, retrying in %v...", retryDuration), "address", nodeAddress, "error", err.Error())
 			time.Sleep(retryDuration)
+			continue
 		}
+
+		if customLogProfile {
+			err = sendProfile(conn, profile)
+		} else {
+			err = sendDefaultProfileIdentifier(conn)
+		}
+		log.LogIfError(err)
+
+		mutWebSockets.Lock()
+		webSockets[nodeAddress] = conn
+		mutWebSockets.Unlock()
+
+		listeningOnWebSocket(nodeAddress, conn)
+		time.Sleep(retryDuration)
+	}
+}
+
+func replayRecordedFile(filePath string) error {
+	file, err := os.Open(filePath)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = file.Close()
 	}()
 
-	// set this log's level to the lowest desired log level that matches received logs from mx-chain-go
-	lowestLogLevel := getLowestLogLevel(logLevels)
-	log.SetLevel(lowestLogLevel)
+	withNodePrefix = true
+	numRecords := 0
+	numDisplayed := 0
+	err = logs.ReadRecords(file, func(record *logs.Record) error {
+		numRecords++
+		if logFilter.Matches(record) {
+			numDisplayed++
+			log.LogLine(record.ToLogLine(withNodePrefix))
+		}
 
-	waitForUserToTerminateApp(webSocket)
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	log.Info("logviewer replay finished", "records", numRecords, "displayed", numDisplayed)
 
 	return nil
 }
@@ -313,14 +479,14 @@ func sendDefaultProfileIdentifier(conn *websocket.Conn) error {
 	return conn.WriteMessage(websocket.TextMessage, []byte(common.DefaultLogProfileIdentifier))
 }
 
-func listeningOnWebSocket() {
+func listeningOnWebSocket(nodeAddress string, conn *websocket.Conn) {
 	for {
-		msgType, message, err := webSocket.ReadMessage()
+		msgType, message, err := conn.ReadMessage()
 		if msgType == websocket.CloseMessage {
 			return
 		}
 		if err == nil {
-			outputMessage(message)
+			outputMessage(nodeAddress, message)
 			continue
 		}
 
@@ -334,23 +500,28 @@ func listeningOnWebSocket() {
 	}
 }
 
-func waitForUserToTerminateApp(conn *websocket.Conn) {
+func waitForUserToTerminateApp() {
 	sigs := make(chan os.Signal, 1)
 	signal.Notify(sigs, syscall.SIGINT, syscall.SIGTERM)
 
 	<-sigs
 
 	log.Info("terminating logviewer app at user's signal...")
-	if conn != nil {
+	mutWebSockets.Lock()
+	for _, conn := range webSockets {
 		err := conn.WriteMessage(websocket.CloseMessage, websocket.FormatCloseMessage(websocket.CloseNormalClosure, ""))
 		log.LogIfError(err)
+	}
+	numWebSockets := len(webSockets)
+	mutWebSockets.Unlock()
+	if numWebSockets > 0 {
 		time.Sleep(time.Second)
 	}
 
 	log.Info("logviewer application stopped")
 }
 
-func outputMessage(message []byte) {
+func outputMessage(nodeAddress string, message []byte) {
 	logLine := &logger.LogLineWrapper{}
 
 	err := marshalizer.Unmarshal(logLine, message)
@@ -359,17 +530,15 @@ func outputMessage(message []byte) {
 		return
 	}
 
-	recoveredLogLine := &logger.LogLine{
-		LoggerName:  logLine.LoggerName,
-		Correlation: logLine.Correlation,
-		Message:     logLine.Message,
-		LogLevel:    logger.LogLevel(logLine.LogLevel),
-		Args:        make([]interface{}, len(logLine.Args)),
-		Timestamp:   time.Unix(0, logLine.Timestamp),
-	}
-	for i, str := range logLine.Args {
-		recoveredLogLine.Args[i] = str
+	record := logs.NewRecord(nodeAddress, logLine)
+	if recordWriter != nil {
+		err = recordWriter.Write(record)
+		if err != nil {
+			log.Error("can not record log line", "error", err.Error())
+		}
 	}
 
-	log.LogLine(recoveredLogLine)
+	if logFilter.Matches(record) {
+		log.LogLine(record.ToLogLine(withNodePrefix))
+	}
 }
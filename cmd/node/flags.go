@@ -408,6 +408,15 @@ var (
 		Name:  "p2p-prometheus-metrics",
 		Usage: "Boolean option for enabling the /debug/metrics/prometheus route for p2p prometheus metrics",
 	}
+
+	// stateSnapshotFile defines a flag for the path of a state snapshot file used when starting from the network
+	stateSnapshotFile = cli.StringFlag{
+		Name: "state-snapshot-file",
+		Usage: "The `filepath` of a state snapshot file, created with the statesnapshot tool. When the node starts " +
+			"from the network, the accounts tries are imported from this file, after being checked against the " +
+			"epoch start root hashes, instead of being synced from the peers",
+		Value: "",
+	}
 )
 
 func getFlags() []cli.Flag {
@@ -470,6 +479,7 @@ func getFlags() []cli.Flag {
 		operationMode,
 		repopulateTokensSupplies,
 		p2pPrometheusMetrics,
+		stateSnapshotFile,
 	}
 }
 
@@ -499,6 +509,7 @@ func getFlagsConfig(ctx *cli.Context, log logger.Logger) *config.ContextFlagsCon
 	flagsConfig.OperationMode = ctx.GlobalString(operationMode.Name)
 	flagsConfig.RepopulateTokensSupplies = ctx.GlobalBool(repopulateTokensSupplies.Name)
 	flagsConfig.P2PPrometheusMetricsEnabled = ctx.GlobalBool(p2pPrometheusMetrics.Name)
+	flagsConfig.StateSnapshotFile = ctx.GlobalString(stateSnapshotFile.Name)
 
 	if ctx.GlobalBool(noKey.Name) {
 		log.Warn("the provided -no-key option is deprecated and will soon be removed. To start a node without " +
@@ -0,0 +1,46 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/data"
+	"github.com/multiversx/mx-chain-core-go/data/typeConverters"
+	"github.com/multiversx/mx-chain-core-go/marshal"
+	"github.com/multiversx/mx-chain-go/dataRetriever"
+	chainProcess "github.com/multiversx/mx-chain-go/process"
+	"github.com/multiversx/mx-chain-go/state/stateExport"
+)
+
+// createExportHeader reads the header with the provided nonce of the shard and returns the root hashes of the state
+// after the block was processed. The validators are exported only from the metachain
+func createExportHeader(
+	storageService dataRetriever.StorageService,
+	uint64Converter typeConverters.Uint64ByteSliceConverter,
+	marshaller marshal.Marshalizer,
+	shardID uint32,
+	nonce uint64,
+) (*stateExport.ExportHeader, data.HeaderHandler, error) {
+	header, headerHash, err := chainProcess.GetHeaderFromStorageWithNonce(nonce, shardID, storageService, uint64Converter, marshaller)
+	if err != nil {
+		return nil, nil, fmt.Errorf("%w while reading the header with nonce %d", err, nonce)
+	}
+
+	exportHeader := &stateExport.ExportHeader{
+		ShardID:    shardID,
+		Epoch:      header.GetEpoch(),
+		Round:      header.GetRound(),
+		Nonce:      header.GetNonce(),
+		HeaderHash: headerHash,
+		RootHash:   header.GetRootHash(),
+	}
+	if shardID == core.MetachainShardId {
+		metaHeader, ok := header.(data.MetaHeaderHandler)
+		if !ok {
+			return nil, nil, chainProcess.ErrWrongTypeAssertion
+		}
+		exportHeader.ValidatorStatsRootHash = metaHeader.GetValidatorStatsRootHash()
+	}
+
+	return exportHeader, header, nil
+}
@@ -0,0 +1,94 @@
+package main
+
+import (
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/data"
+	"github.com/multiversx/mx-chain-core-go/data/block"
+	"github.com/multiversx/mx-chain-core-go/data/typeConverters/uint64ByteSlice"
+	"github.com/multiversx/mx-chain-core-go/marshal"
+	"github.com/multiversx/mx-chain-go/dataRetriever"
+	"github.com/multiversx/mx-chain-go/testscommon"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createStorageService(t *testing.T, marshaller marshal.Marshalizer, shardID uint32, header data.HeaderHandler) dataRetriever.StorageService {
+	converter := uint64ByteSlice.NewBigEndianConverter()
+	headerUnit := dataRetriever.BlockHeaderUnit
+	nonceUnit := dataRetriever.ShardHdrNonceHashDataUnit + dataRetriever.UnitType(shardID)
+	if shardID == core.MetachainShardId {
+		headerUnit = dataRetriever.MetaBlockUnit
+		nonceUnit = dataRetriever.MetaHdrNonceHashDataUnit
+	}
+
+	storageService := dataRetriever.NewChainStorer()
+	storageService.AddStorer(headerUnit, testscommon.CreateMemUnit())
+	storageService.AddStorer(nonceUnit, testscommon.CreateMemUnit())
+
+	headerBytes, err := marshaller.Marshal(header)
+	require.Nil(t, err)
+	require.Nil(t, storageService.Put(headerUnit, []byte("header hash"), headerBytes))
+	require.Nil(t, storageService.Put(nonceUnit, converter.ToByteSlice(header.GetNonce()), []byte("header hash")))
+
+	return storageService
+}
+
+func TestCreateExportHeader(t *testing.T) {
+	t.Parallel()
+
+	marshaller := &marshal.GogoProtoMarshalizer{}
+	converter := uint64ByteSlice.NewBigEndianConverter()
+
+	t.Run("missing header should error", func(t *testing.T) {
+		t.Parallel()
+
+		storageService := createStorageService(t, marshaller, 1, &block.HeaderV2{Header: &block.Header{ShardID: 1, Nonce: 10}})
+		exportHeader, header, err := createExportHeader(storageService, converter, marshaller, 1, 11)
+		assert.NotNil(t, err)
+		assert.Nil(t, exportHeader)
+		assert.Nil(t, header)
+	})
+	t.Run("shard should export the accounts trie", func(t *testing.T) {
+		t.Parallel()
+
+		shardHeader := &block.HeaderV2{
+			Header: &block.Header{
+				ShardID:  1,
+				Nonce:    10,
+				Round:    12,
+				Epoch:    2,
+				RootHash: []byte("shard root hash"),
+			},
+		}
+		storageService := createStorageService(t, marshaller, 1, shardHeader)
+		exportHeader, header, err := createExportHeader(storageService, converter, marshaller, 1, 10)
+		require.Nil(t, err)
+		assert.Equal(t, uint64(10), header.GetNonce())
+		assert.Equal(t, uint32(1), exportHeader.ShardID)
+		assert.Equal(t, uint32(2), exportHeader.Epoch)
+		assert.Equal(t, uint64(12), exportHeader.Round)
+		assert.Equal(t, uint64(10), exportHeader.Nonce)
+		assert.Equal(t, []byte("header hash"), exportHeader.HeaderHash)
+		assert.Equal(t, []byte("shard root hash"), exportHeader.RootHash)
+		assert.Empty(t, exportHeader.ValidatorStatsRootHash)
+	})
+	t.Run("metachain should export the accounts and the peer accounts tries", func(t *testing.T) {
+		t.Parallel()
+
+		metaBlock := &block.MetaBlock{
+			Nonce:                  20,
+			Epoch:                  3,
+			RootHash:               []byte("meta root hash"),
+			ValidatorStatsRootHash: []byte("validator stats root hash"),
+		}
+		storageService := createStorageService(t, marshaller, core.MetachainShardId, metaBlock)
+		exportHeader, _, err := createExportHeader(storageService, converter, marshaller, core.MetachainShardId, 20)
+		require.Nil(t, err)
+		assert.Equal(t, core.MetachainShardId, exportHeader.ShardID)
+		assert.Equal(t, uint32(3), exportHeader.Epoch)
+		assert.Equal(t, []byte("meta root hash"), exportHeader.RootHash)
+		assert.Equal(t, []byte("validator stats root hash"), exportHeader.ValidatorStatsRootHash)
+	})
+}
@@ -0,0 +1,378 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"runtime"
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/data/typeConverters/uint64ByteSlice"
+	"github.com/multiversx/mx-chain-core-go/hashing"
+	hasherFactory "github.com/multiversx/mx-chain-core-go/hashing/factory"
+	"github.com/multiversx/mx-chain-core-go/marshal"
+	marshallerFactory "github.com/multiversx/mx-chain-core-go/marshal/factory"
+	"github.com/multiversx/mx-chain-go/cmd/common/offlinestorage"
+	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/common/enablers"
+	commonFactory "github.com/multiversx/mx-chain-go/common/factory"
+	"github.com/multiversx/mx-chain-go/common/forking"
+	"github.com/multiversx/mx-chain-go/config"
+	"github.com/multiversx/mx-chain-go/dataRetriever"
+	"github.com/multiversx/mx-chain-go/state/stateExport"
+	storageFactory "github.com/multiversx/mx-chain-go/storage/factory"
+	logger "github.com/multiversx/mx-chain-logger-go"
+	"github.com/urfave/cli"
+)
+
+const (
+	lastNonce      = -1
+	exportFilePerm = 0644
+	tmpFileSuffix  = ".tmp"
+)
+
+type cfg struct {
+	workingDir        string
+	configFile        string
+	epochConfigFile   string
+	numOfShards       uint
+	nonce             int64
+	outputFile        string
+	genesisOutputFile string
+	logLevel          string
+}
+
+var (
+	helpTemplate = `NAME:
+   {{.Name}} - {{.Usage}}
+USAGE:
+   {{.HelpName}} {{if .VisibleFlags}}[global options]{{end}}
+   {{if len .Authors}}
+AUTHOR:
+   {{range .Authors}}{{ . }}{{end}}
+   {{end}}{{if .Commands}}
+GLOBAL OPTIONS:
+   {{range .VisibleFlags}}{{.}}
+   {{end}}
+VERSION:
+   {{.Version}}
+   {{end}}
+`
+	// workingDirectory defines a flag for the node's working directory
+	workingDirectory = cli.StringFlag{
+		Name:        "working-directory",
+		Usage:       "The node's working `directory`, the one holding the db directory.",
+		Value:       ".",
+		Destination: &argsConfig.workingDir,
+	}
+	// configurationFile defines a flag for the path to the node's main toml configuration file
+	configurationFile = cli.StringFlag{
+		Name:        "config",
+		Usage:       "The `filepath` for the node's main configuration file. The storers are opened using this configuration.",
+		Value:       "./config/config.toml",
+		Destination: &argsConfig.configFile,
+	}
+	// epochConfigurationFile defines a flag for the path to the node's enable epochs toml configuration file
+	epochConfigurationFile = cli.StringFlag{
+		Name:        "epoch-config",
+		Usage:       "The `filepath` for the node's enable epochs configuration file.",
+		Value:       "./config/enableEpochs.toml",
+		Destination: &argsConfig.epochConfigFile,
+	}
+	// numOfShards defines a flag for the number of shards of the network
+	numOfShards = cli.UintFlag{
+		Name:        "num-of-shards",
+		Usage:       "The `number` of shards of the network, metachain excluded.",
+		Value:       3,
+		Destination: &argsConfig.numOfShards,
+	}
+	// nonce defines a flag for the nonce of the block whose state is exported
+	nonce = cli.Int64Flag{
+		Name: "nonce",
+		Usage: "The `nonce` of the block whose state is exported. The state tries of the block must be present in the " +
+			"database, so older blocks can only be exported from nodes running without state pruning. If not set, the " +
+			"last block found on disk is used.",
+		Value:       lastNonce,
+		Destination: &argsConfig.nonce,
+	}
+	// outputFile defines a flag for the path of the generated state file
+	outputFile = cli.StringFlag{
+		Name: "output",
+		Usage: "The `filepath` of the generated JSON state file. The accounts use the chain simulator's address state " +
+			"format and the validators use the nodesSetup.json format.",
+		Value:       "./state.json",
+		Destination: &argsConfig.outputFile,
+	}
+	// genesisOutputFile defines a flag for the path of the generated genesis accounts file
+	genesisOutputFile = cli.StringFlag{
+		Name: "genesis-output",
+		Usage: "The `filepath` of an optional genesis.json file holding the funded user accounts of the export. The " +
+			"genesis total supply to be configured is printed at the end of the export. A genesis file can only hold " +
+			"the balances of user accounts: the smart contracts, the data tries keys (ESDT tokens included) and the " +
+			"validators of the export are left out and their number is printed as well.",
+		Value:       "",
+		Destination: &argsConfig.genesisOutputFile,
+	}
+	// logLevel defines the logger level
+	logLevel = cli.StringFlag{
+		Name: "log-level",
+		Usage: "This flag specifies the logger `level(s)`. It can contain multiple comma-separated value. For example" +
+			", if set to *:INFO the logs for all packages will have the INFO level. However, if set to *:INFO,api:DEBUG" +
+			" the logs for all packages will have the INFO level, excepting the api package which will receive a DEBUG" +
+			" log level.",
+		Value:       "*:" + logger.LogInfo.String(),
+		Destination: &argsConfig.logLevel,
+	}
+
+	argsConfig = &cfg{}
+
+	log = logger.GetOrCreate("stateexport")
+)
+
+func main() {
+	app := cli.NewApp()
+	cli.AppHelpTemplate = helpTemplate
+	app.Name = "MultiversX state exporter"
+	app.Version = fmt.Sprintf("%s/%s/%s-%s", "1.0.0", runtime.Version(), runtime.GOOS, runtime.GOARCH)
+	app.Usage = "State exporter application used to export the accounts and the validators of a stopped node at a " +
+		"chosen block in a human-readable JSON file, that can be loaded by the chain simulator or used as genesis"
+	app.Flags = []cli.Flag{
+		workingDirectory,
+		configurationFile,
+		epochConfigurationFile,
+		numOfShards,
+		nonce,
+		outputFile,
+		genesisOutputFile,
+		logLevel,
+	}
+	app.Authors = []cli.Author{
+		{
+			Name:  "The MultiversX Team",
+			Email: "contact@multiversx.com",
+		},
+	}
+	app.Action = func(_ *cli.Context) error {
+		return process()
+	}
+
+	err := app.Run(os.Args)
+	if err != nil {
+		log.Error(err.Error())
+		os.Exit(1)
+	}
+}
+
+func process() error {
+	err := logger.SetLogLevel(argsConfig.logLevel)
+	if err != nil {
+		return err
+	}
+
+	generalConfig, err := common.LoadMainConfig(argsConfig.configFile)
+	if err != nil {
+		return err
+	}
+	epochConfig, err := common.LoadEpochConfig(argsConfig.epochConfigFile)
+	if err != nil {
+		return err
+	}
+
+	marshaller, err := marshallerFactory.NewMarshalizer(generalConfig.Marshalizer.Type)
+	if err != nil {
+		return err
+	}
+	hasher, err := hasherFactory.NewHasher(generalConfig.Hasher.Type)
+	if err != nil {
+		return err
+	}
+	addressPubkeyConverter, err := commonFactory.NewPubkeyConverter(generalConfig.AddressPubkeyConverter)
+	if err != nil {
+		return err
+	}
+	validatorPubkeyConverter, err := commonFactory.NewPubkeyConverter(generalConfig.ValidatorPubkeyConverter)
+	if err != nil {
+		return err
+	}
+
+	opened, err := offlinestorage.OpenStorage(offlinestorage.ArgsOpenStorage{
+		GeneralConfig: *generalConfig,
+		WorkingDir:    argsConfig.workingDir,
+		NumOfShards:   uint32(argsConfig.numOfShards),
+		Marshaller:    marshaller,
+		StorageType:   storageFactory.StateExportStorageService,
+	})
+	if err != nil {
+		return err
+	}
+	defer func() {
+		errClose := opened.StorageService.CloseAll()
+		if errClose != nil {
+			log.Warn("error closing the storers", "error", errClose)
+		}
+	}()
+
+	exportedNonce, err := getExportedNonce(opened.StorageService, marshaller)
+	if err != nil {
+		return err
+	}
+
+	exportHeader, header, err := createExportHeader(opened.StorageService, uint64ByteSlice.NewBigEndianConverter(), marshaller, opened.ShardID, exportedNonce)
+	if err != nil {
+		return err
+	}
+
+	// the data tries leaves are parsed according to the flags active in the epoch of the exported block
+	epochNotifier := forking.NewGenericEpochNotifier()
+	enableEpochsHandler, err := enablers.NewEnableEpochsHandler(epochConfig.EnableEpochs, epochNotifier)
+	if err != nil {
+		return err
+	}
+	epochNotifier.CheckEpoch(header)
+
+	exporter, err := createStateExporter(*generalConfig, opened, marshaller, hasher, enableEpochsHandler, addressPubkeyConverter, validatorPubkeyConverter)
+	if err != nil {
+		return err
+	}
+
+	log.Info("starting the state export",
+		"shard", exportHeader.ShardID,
+		"epoch", exportHeader.Epoch,
+		"nonce", exportHeader.Nonce,
+		"root hash", exportHeader.RootHash,
+		"validator stats root hash", exportHeader.ValidatorStatsRootHash,
+	)
+
+	startTime := time.Now()
+	summary, err := exportState(exporter, *exportHeader)
+	if err != nil {
+		return err
+	}
+
+	log.Info("state export finished",
+		"file", argsConfig.outputFile,
+		"num accounts", summary.NumAccounts,
+		"num data tries keys", summary.NumDataTrieKeys,
+		"num validators", summary.NumValidators,
+		"total balance", summary.TotalBalance.String(),
+		"duration", time.Since(startTime),
+	)
+
+	if len(argsConfig.genesisOutputFile) == 0 {
+		return nil
+	}
+
+	return exportGenesisAccounts(addressPubkeyConverter)
+}
+
+// getExportedNonce returns the nonce provided on the command line or, if none was provided, the last committed nonce
+func getExportedNonce(storageService dataRetriever.StorageService, marshaller marshal.Marshalizer) (uint64, error) {
+	if argsConfig.nonce != lastNonce {
+		return uint64(argsConfig.nonce), nil
+	}
+
+	return offlinestorage.GetLastNonce(storageService, marshaller)
+}
+
+func createStateExporter(
+	generalConfig config.Config,
+	opened *offlinestorage.OpenedStorage,
+	marshaller marshal.Marshalizer,
+	hasher hashing.Hasher,
+	enableEpochsHandler common.EnableEpochsHandler,
+	addressPubkeyConverter core.PubkeyConverter,
+	validatorPubkeyConverter core.PubkeyConverter,
+) (stateExport.StateExporter, error) {
+	accountsTrie, err := offlinestorage.CreateTrie(opened.StorageService, dataRetriever.UserAccountsUnit, generalConfig, generalConfig.StateTriesConfig.MaxStateTrieLevelInMemory, marshaller, hasher, enableEpochsHandler)
+	if err != nil {
+		return nil, err
+	}
+
+	var peerAccountsTrie common.Trie
+	if opened.ShardID == core.MetachainShardId {
+		peerAccountsTrie, err = offlinestorage.CreateTrie(opened.StorageService, dataRetriever.PeerAccountsUnit, generalConfig, generalConfig.StateTriesConfig.MaxPeerTrieLevelInMemory, marshaller, hasher, enableEpochsHandler)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return stateExport.NewStateExporter(stateExport.ArgsStateExporter{
+		AccountsTrie:             accountsTrie,
+		PeerAccountsTrie:         peerAccountsTrie,
+		Marshaller:               marshaller,
+		EnableEpochsHandler:      enableEpochsHandler,
+		AddressPubkeyConverter:   addressPubkeyConverter,
+		ValidatorPubkeyConverter: validatorPubkeyConverter,
+	})
+}
+
+func exportState(exporter stateExport.StateExporter, header stateExport.ExportHeader) (*stateExport.ExportSummary, error) {
+	// the state is written under a temporary name so that an interrupted export does not leave a partial file
+	tmpFile := argsConfig.outputFile + tmpFileSuffix
+	file, err := os.OpenFile(tmpFile, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, exportFilePerm)
+	if err != nil {
+		return nil, err
+	}
+
+	summary, err := exporter.Export(file, header)
+	if err != nil {
+		_ = file.Close()
+		_ = os.Remove(tmpFile)
+		return nil, err
+	}
+
+	err = file.Sync()
+	if err != nil {
+		_ = file.Close()
+		return nil, err
+	}
+
+	err = file.Close()
+	if err != nil {
+		return nil, err
+	}
+
+	return summary, os.Rename(tmpFile, argsConfig.outputFile)
+}
+
+func exportGenesisAccounts(addressPubkeyConverter core.PubkeyConverter) error {
+	exportedState, err := stateExport.LoadExportedState(argsConfig.outputFile)
+	if err != nil {
+		return err
+	}
+
+	genesisAccounts, err := stateExport.CreateGenesisAccounts(exportedState.Accounts, addressPubkeyConverter)
+	if err != nil {
+		return err
+	}
+
+	genesisBytes, err := json.MarshalIndent(genesisAccounts.InitialAccounts, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	err = os.WriteFile(argsConfig.genesisOutputFile, genesisBytes, exportFilePerm)
+	if err != nil {
+		return err
+	}
+
+	log.Info("genesis accounts exported",
+		"file", argsConfig.genesisOutputFile,
+		"num accounts", len(genesisAccounts.InitialAccounts),
+		"genesis total supply", genesisAccounts.TotalSupply.String(),
+	)
+
+	numSkippedValidators := len(exportedState.Validators)
+	if genesisAccounts.NumSkippedSmartContracts+genesisAccounts.NumSkippedDataTrieKeys+uint64(numSkippedValidators) > 0 {
+		log.Warn("the genesis file only holds the user accounts balances, the following exported data was left out "+
+			"and has to be recreated after the chain start (validators must also be added in nodesSetup.json and staked)",
+			"num smart contracts", genesisAccounts.NumSkippedSmartContracts,
+			"num data tries keys", genesisAccounts.NumSkippedDataTrieKeys,
+			"num validators", numSkippedValidators,
+			"num empty accounts", genesisAccounts.NumSkippedEmptyAccounts,
+		)
+	}
+
+	return nil
+}
@@ -0,0 +1,86 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/data"
+	"github.com/multiversx/mx-chain-core-go/marshal"
+	"github.com/multiversx/mx-chain-go/dataRetriever"
+	chainProcess "github.com/multiversx/mx-chain-go/process"
+	"github.com/multiversx/mx-chain-go/state/stateSnapshot"
+)
+
+var errShardDataNotFound = errors.New("the epoch start meta block does not hold the shard's last finalized header")
+
+// createSnapshotHeader reads the epoch start meta block of the provided epoch and returns the root hashes a node
+// starting in that epoch syncs: the metachain syncs the peer and user accounts tries of the epoch start meta block,
+// while a shard syncs the user accounts trie of its header notarized in the epoch start meta block
+func createSnapshotHeader(
+	storageService dataRetriever.StorageService,
+	marshaller marshal.Marshalizer,
+	shardID uint32,
+	epoch uint32,
+) (*stateSnapshot.SnapshotHeader, error) {
+	metaBlockBytes, err := storageService.Get(dataRetriever.MetaBlockUnit, []byte(core.EpochStartIdentifier(epoch)))
+	if err != nil {
+		return nil, fmt.Errorf("%w while reading the epoch start meta block of epoch %d", err, epoch)
+	}
+
+	metaBlock, err := chainProcess.UnmarshalMetaHeader(marshaller, metaBlockBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	header := &stateSnapshot.SnapshotHeader{
+		ShardID: shardID,
+		Epoch:   epoch,
+	}
+	if shardID == core.MetachainShardId {
+		header.UserAccountsRootHash = metaBlock.GetRootHash()
+		header.PeerAccountsRootHash = metaBlock.GetValidatorStatsRootHash()
+
+		return header, nil
+	}
+
+	shardData, err := getLastFinalizedShardData(metaBlock, shardID)
+	if err != nil {
+		return nil, err
+	}
+
+	shardHeaderBytes, err := storageService.Get(dataRetriever.BlockHeaderUnit, shardData.GetHeaderHash())
+	if err != nil {
+		return nil, fmt.Errorf("%w while reading the shard header %x", err, shardData.GetHeaderHash())
+	}
+
+	shardHeader, err := chainProcess.UnmarshalShardHeader(marshaller, shardHeaderBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	header.UserAccountsRootHash = getRootHashToSync(shardHeader)
+
+	return header, nil
+}
+
+func getLastFinalizedShardData(metaBlock data.MetaHeaderHandler, shardID uint32) (data.EpochStartShardDataHandler, error) {
+	for _, shardData := range metaBlock.GetEpochStartHandler().GetLastFinalizedHeaderHandlers() {
+		if shardData.GetShardID() == shardID {
+			return shardData, nil
+		}
+	}
+
+	return nil, fmt.Errorf("%w: shard %d, epoch %d", errShardDataNotFound, shardID, metaBlock.GetEpoch())
+}
+
+// getRootHashToSync mirrors the root hash selection done by the epoch start bootstrap: when the header was processed
+// with scheduled transactions, the scheduled root hash is synced
+func getRootHashToSync(shardHeader data.ShardHeaderHandler) []byte {
+	additionalData := shardHeader.GetAdditionalData()
+	if additionalData != nil && len(additionalData.GetScheduledRootHash()) > 0 {
+		return additionalData.GetScheduledRootHash()
+	}
+
+	return shardHeader.GetRootHash()
+}
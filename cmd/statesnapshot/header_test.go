@@ -0,0 +1,108 @@
+package main
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/data/block"
+	"github.com/multiversx/mx-chain-core-go/marshal"
+	"github.com/multiversx/mx-chain-go/dataRetriever"
+	"github.com/multiversx/mx-chain-go/testscommon"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testEpoch = uint32(5)
+
+func createStorageService(t *testing.T, marshaller marshal.Marshalizer, shardHeader *block.HeaderV2) dataRetriever.StorageService {
+	storageService := dataRetriever.NewChainStorer()
+	storageService.AddStorer(dataRetriever.MetaBlockUnit, testscommon.CreateMemUnit())
+	storageService.AddStorer(dataRetriever.BlockHeaderUnit, testscommon.CreateMemUnit())
+
+	metaBlock := &block.MetaBlock{
+		Epoch:                  testEpoch,
+		RootHash:               []byte("meta root hash"),
+		ValidatorStatsRootHash: []byte("validator stats root hash"),
+		EpochStart: block.EpochStart{
+			LastFinalizedHeaders: []block.EpochStartShardData{
+				{
+					ShardID:    1,
+					HeaderHash: []byte("shard header hash"),
+					RootHash:   []byte("shard root hash"),
+				},
+			},
+		},
+	}
+	metaBlockBytes, err := marshaller.Marshal(metaBlock)
+	require.Nil(t, err)
+	require.Nil(t, storageService.Put(dataRetriever.MetaBlockUnit, []byte(core.EpochStartIdentifier(testEpoch)), metaBlockBytes))
+
+	shardHeaderBytes, err := marshaller.Marshal(shardHeader)
+	require.Nil(t, err)
+	require.Nil(t, storageService.Put(dataRetriever.BlockHeaderUnit, []byte("shard header hash"), shardHeaderBytes))
+
+	return storageService
+}
+
+func TestCreateSnapshotHeader(t *testing.T) {
+	t.Parallel()
+
+	marshaller := &marshal.GogoProtoMarshalizer{}
+	shardHeader := &block.HeaderV2{
+		Header: &block.Header{
+			ShardID:  1,
+			Epoch:    testEpoch,
+			RootHash: []byte("shard root hash"),
+		},
+	}
+
+	t.Run("missing epoch start meta block should error", func(t *testing.T) {
+		t.Parallel()
+
+		storageService := createStorageService(t, marshaller, shardHeader)
+		header, err := createSnapshotHeader(storageService, marshaller, core.MetachainShardId, testEpoch+1)
+		assert.NotNil(t, err)
+		assert.Nil(t, header)
+	})
+	t.Run("metachain should export the peer and user accounts tries", func(t *testing.T) {
+		t.Parallel()
+
+		storageService := createStorageService(t, marshaller, shardHeader)
+		header, err := createSnapshotHeader(storageService, marshaller, core.MetachainShardId, testEpoch)
+		require.Nil(t, err)
+		assert.Equal(t, core.MetachainShardId, header.ShardID)
+		assert.Equal(t, testEpoch, header.Epoch)
+		assert.Equal(t, []byte("meta root hash"), header.UserAccountsRootHash)
+		assert.Equal(t, []byte("validator stats root hash"), header.PeerAccountsRootHash)
+	})
+	t.Run("shard not notarized in the epoch start meta block should error", func(t *testing.T) {
+		t.Parallel()
+
+		storageService := createStorageService(t, marshaller, shardHeader)
+		header, err := createSnapshotHeader(storageService, marshaller, 0, testEpoch)
+		assert.True(t, errors.Is(err, errShardDataNotFound))
+		assert.Nil(t, header)
+	})
+	t.Run("shard should export the root hash of the notarized header", func(t *testing.T) {
+		t.Parallel()
+
+		storageService := createStorageService(t, marshaller, shardHeader)
+		header, err := createSnapshotHeader(storageService, marshaller, 1, testEpoch)
+		require.Nil(t, err)
+		assert.Equal(t, []byte("shard root hash"), header.UserAccountsRootHash)
+		assert.Nil(t, header.PeerAccountsRootHash)
+	})
+	t.Run("shard should export the scheduled root hash if present", func(t *testing.T) {
+		t.Parallel()
+
+		scheduledHeader := &block.HeaderV2{
+			Header:            shardHeader.Header,
+			ScheduledRootHash: []byte("scheduled root hash"),
+		}
+		storageService := createStorageService(t, marshaller, scheduledHeader)
+		header, err := createSnapshotHeader(storageService, marshaller, 1, testEpoch)
+		require.Nil(t, err)
+		assert.Equal(t, []byte("scheduled root hash"), header.UserAccountsRootHash)
+	})
+}
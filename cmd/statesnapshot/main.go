@@ -0,0 +1,270 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"runtime"
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/hashing"
+	hasherFactory "github.com/multiversx/mx-chain-core-go/hashing/factory"
+	"github.com/multiversx/mx-chain-core-go/marshal"
+	marshallerFactory "github.com/multiversx/mx-chain-core-go/marshal/factory"
+	"github.com/multiversx/mx-chain-go/cmd/common/offlinestorage"
+	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/config"
+	"github.com/multiversx/mx-chain-go/dataRetriever"
+	"github.com/multiversx/mx-chain-go/state/stateSnapshot"
+	storageFactory "github.com/multiversx/mx-chain-go/storage/factory"
+	logger "github.com/multiversx/mx-chain-logger-go"
+	"github.com/urfave/cli"
+)
+
+const (
+	latestEpoch      = -1
+	snapshotFilePerm = 0644
+	tmpFileSuffix    = ".tmp"
+)
+
+type cfg struct {
+	workingDir  string
+	configFile  string
+	numOfShards uint
+	epoch       int
+	chunkSize   uint
+	outputFile  string
+	logLevel    string
+}
+
+var (
+	helpTemplate = `NAME:
+   {{.Name}} - {{.Usage}}
+USAGE:
+   {{.HelpName}} {{if .VisibleFlags}}[global options]{{end}}
+   {{if len .Authors}}
+AUTHOR:
+   {{range .Authors}}{{ . }}{{end}}
+   {{end}}{{if .Commands}}
+GLOBAL OPTIONS:
+   {{range .VisibleFlags}}{{.}}
+   {{end}}
+VERSION:
+   {{.Version}}
+   {{end}}
+`
+	// workingDirectory defines a flag for the node's working directory
+	workingDirectory = cli.StringFlag{
+		Name:        "working-directory",
+		Usage:       "The node's working `directory`, the one holding the db directory.",
+		Value:       ".",
+		Destination: &argsConfig.workingDir,
+	}
+	// configurationFile defines a flag for the path to the node's main toml configuration file
+	configurationFile = cli.StringFlag{
+		Name:        "config",
+		Usage:       "The `filepath` for the node's main configuration file. The storers are opened using this configuration.",
+		Value:       "./config/config.toml",
+		Destination: &argsConfig.configFile,
+	}
+	// numOfShards defines a flag for the number of shards of the network
+	numOfShards = cli.UintFlag{
+		Name:        "num-of-shards",
+		Usage:       "The `number` of shards of the network, metachain excluded.",
+		Value:       3,
+		Destination: &argsConfig.numOfShards,
+	}
+	// epoch defines a flag for the epoch whose start of epoch state is exported
+	epoch = cli.IntFlag{
+		Name: "epoch",
+		Usage: "The `epoch` whose start of epoch state is exported. A node starting from the network in this epoch " +
+			"can import the snapshot. If not set, the latest epoch found on disk is used.",
+		Value:       latestEpoch,
+		Destination: &argsConfig.epoch,
+	}
+	// chunkSize defines a flag for the size of the nodes chunks
+	chunkSize = cli.UintFlag{
+		Name:        "chunk-size",
+		Usage:       "The approximate size, in `bytes`, of the trie nodes chunks. Each chunk is verified by its hash on import.",
+		Value:       4 * 1024 * 1024,
+		Destination: &argsConfig.chunkSize,
+	}
+	// outputFile defines a flag for the path of the generated snapshot file
+	outputFile = cli.StringFlag{
+		Name:        "output",
+		Usage:       "The `filepath` of the generated state snapshot file.",
+		Value:       "./state.snapshot",
+		Destination: &argsConfig.outputFile,
+	}
+	// logLevel defines the logger level
+	logLevel = cli.StringFlag{
+		Name: "log-level",
+		Usage: "This flag specifies the logger `level(s)`. It can contain multiple comma-separated value. For example" +
+			", if set to *:INFO the logs for all packages will have the INFO level. However, if set to *:INFO,api:DEBUG" +
+			" the logs for all packages will have the INFO level, excepting the api package which will receive a DEBUG" +
+			" log level.",
+		Value:       "*:" + logger.LogInfo.String(),
+		Destination: &argsConfig.logLevel,
+	}
+
+	argsConfig = &cfg{}
+
+	log = logger.GetOrCreate("statesnapshot")
+)
+
+func main() {
+	app := cli.NewApp()
+	cli.AppHelpTemplate = helpTemplate
+	app.Name = "MultiversX state snapshot exporter"
+	app.Version = fmt.Sprintf("%s/%s/%s-%s", "1.0.0", runtime.Version(), runtime.GOOS, runtime.GOARCH)
+	app.Usage = "State snapshot exporter application used to export the start of epoch accounts tries of a stopped " +
+		"node in a single file, that can be imported by a node starting from the network with the --state-snapshot-file flag"
+	app.Flags = []cli.Flag{
+		workingDirectory,
+		configurationFile,
+		numOfShards,
+		epoch,
+		chunkSize,
+		outputFile,
+		logLevel,
+	}
+	app.Authors = []cli.Author{
+		{
+			Name:  "The MultiversX Team",
+			Email: "contact@multiversx.com",
+		},
+	}
+	app.Action = func(_ *cli.Context) error {
+		return process()
+	}
+
+	err := app.Run(os.Args)
+	if err != nil {
+		log.Error(err.Error())
+		os.Exit(1)
+	}
+}
+
+func process() error {
+	err := logger.SetLogLevel(argsConfig.logLevel)
+	if err != nil {
+		return err
+	}
+
+	generalConfig, err := common.LoadMainConfig(argsConfig.configFile)
+	if err != nil {
+		return err
+	}
+
+	marshaller, err := marshallerFactory.NewMarshalizer(generalConfig.Marshalizer.Type)
+	if err != nil {
+		return err
+	}
+	hasher, err := hasherFactory.NewHasher(generalConfig.Hasher.Type)
+	if err != nil {
+		return err
+	}
+
+	opened, err := offlinestorage.OpenStorage(offlinestorage.ArgsOpenStorage{
+		GeneralConfig: *generalConfig,
+		WorkingDir:    argsConfig.workingDir,
+		NumOfShards:   uint32(argsConfig.numOfShards),
+		Marshaller:    marshaller,
+		StorageType:   storageFactory.StateSnapshotStorageService,
+	})
+	if err != nil {
+		return err
+	}
+	defer func() {
+		errClose := opened.StorageService.CloseAll()
+		if errClose != nil {
+			log.Warn("error closing the storers", "error", errClose)
+		}
+	}()
+
+	exportedEpoch := opened.LastEpoch
+	if argsConfig.epoch != latestEpoch {
+		exportedEpoch = uint32(argsConfig.epoch)
+	}
+
+	header, err := createSnapshotHeader(opened.StorageService, marshaller, opened.ShardID, exportedEpoch)
+	if err != nil {
+		return err
+	}
+
+	log.Info("starting the state snapshot export",
+		"shard", opened.ShardID,
+		"epoch", header.Epoch,
+		"user accounts root hash", header.UserAccountsRootHash,
+		"peer accounts root hash", header.PeerAccountsRootHash,
+	)
+
+	startTime := time.Now()
+	err = exportSnapshot(*generalConfig, opened, *header, marshaller, hasher)
+	if err != nil {
+		return err
+	}
+
+	log.Info("state snapshot export finished", "file", argsConfig.outputFile, "duration", time.Since(startTime))
+
+	return nil
+}
+
+func exportSnapshot(
+	generalConfig config.Config,
+	opened *offlinestorage.OpenedStorage,
+	header stateSnapshot.SnapshotHeader,
+	marshaller marshal.Marshalizer,
+	hasher hashing.Hasher,
+) error {
+	userAccountsStorage, err := offlinestorage.CreateTrieStorage(opened.StorageService, dataRetriever.UserAccountsUnit, generalConfig, marshaller, hasher)
+	if err != nil {
+		return err
+	}
+
+	var peerAccountsStorage common.StorageManager
+	if opened.ShardID == core.MetachainShardId {
+		peerAccountsStorage, err = offlinestorage.CreateTrieStorage(opened.StorageService, dataRetriever.PeerAccountsUnit, generalConfig, marshaller, hasher)
+		if err != nil {
+			return err
+		}
+	}
+
+	exporter, err := stateSnapshot.NewSnapshotExporter(stateSnapshot.ArgsSnapshotExporter{
+		UserAccountsStorage: userAccountsStorage,
+		PeerAccountsStorage: peerAccountsStorage,
+		Marshaller:          marshaller,
+		Hasher:              hasher,
+		ChunkSize:           int(argsConfig.chunkSize),
+	})
+	if err != nil {
+		return err
+	}
+
+	// the snapshot is written under a temporary name so that an interrupted export does not leave a partial file
+	tmpFile := argsConfig.outputFile + tmpFileSuffix
+	file, err := os.OpenFile(tmpFile, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, snapshotFilePerm)
+	if err != nil {
+		return err
+	}
+
+	err = exporter.Export(file, header)
+	if err != nil {
+		_ = file.Close()
+		_ = os.Remove(tmpFile)
+		return err
+	}
+
+	err = file.Sync()
+	if err != nil {
+		_ = file.Close()
+		return err
+	}
+
+	err = file.Close()
+	if err != nil {
+		return err
+	}
+
+	return os.Rename(tmpFile, argsConfig.outputFile)
+}
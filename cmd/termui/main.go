@@ -7,8 +7,10 @@ import (
 	"runtime"
 	"syscall"
 
+	"github.com/multiversx/mx-chain-go/cmd/common/nodeaddresses"
 	"github.com/multiversx/mx-chain-go/cmd/termui/presenter"
 	"github.com/multiversx/mx-chain-go/cmd/termui/provider"
+	"github.com/multiversx/mx-chain-go/cmd/termui/view"
 	"github.com/multiversx/mx-chain-go/cmd/termui/view/termuic"
 	logger "github.com/multiversx/mx-chain-logger-go"
 	"github.com/urfave/cli"
@@ -20,6 +22,8 @@ type config struct {
 	useWss             bool
 	interval           int
 	address            string
+	addresses          string
+	historySize        int
 	logLevel           string
 }
 
@@ -46,6 +50,21 @@ VERSION:
 		Value:       "127.0.0.1:8080",
 		Destination: &argsConfig.address,
 	}
+	// addresses defines a flag for setting the addresses of the nodes displayed in the multi-node dashboard
+	addresses = cli.StringFlag{
+		Name: "addresses",
+		Usage: "Comma-separated addresses and port numbers of the mx-chain-go nodes. If provided, the application " +
+			"will display a dashboard with all the nodes instead of connecting to the node set with the --address flag",
+		Value:       "",
+		Destination: &argsConfig.addresses,
+	}
+	// historySize defines a flag for setting how many samples of the key metrics are kept for each node
+	historySize = cli.IntFlag{
+		Name:        "history-size",
+		Usage:       "This flag specifies how many samples of the key metrics are kept for each node in the multi-node dashboard",
+		Value:       300,
+		Destination: &argsConfig.historySize,
+	}
 	// logLevel defines the logger level
 	logLevel = cli.StringFlag{
 		Name: "log-level",
@@ -93,6 +112,10 @@ func main() {
 	initCliFlags()
 
 	cliApp.Action = func(c *cli.Context) error {
+		if len(argsConfig.addresses) > 0 {
+			return startTermuiDashboard()
+		}
+
 		return startTermuiViewer(c)
 	}
 
@@ -155,6 +178,59 @@ func startTermuiViewer(ctx *cli.Context) error {
 	return nil
 }
 
+func startTermuiDashboard() error {
+	nodeAddresses := nodeaddresses.Parse(argsConfig.addresses)
+	nodes := make([]view.NodeView, 0, len(nodeAddresses))
+	for _, nodeAddress := range nodeAddresses {
+		node, err := createNodeView(nodeAddress, argsConfig.interval, argsConfig.historySize)
+		if err != nil {
+			return fmt.Errorf("%w for node %s", err, nodeAddress)
+		}
+
+		nodes = append(nodes, node)
+	}
+
+	termuiConsole, err := termuic.NewTermuiDashboardConsole(nodes, argsConfig.interval)
+	if err != nil {
+		return err
+	}
+
+	err = termuiConsole.Start()
+	if err != nil {
+		return err
+	}
+
+	waitForUserToTerminateApp()
+
+	return nil
+}
+
+func createNodeView(nodeAddress string, fetchInterval int, historySize int) (view.NodeView, error) {
+	presenterStatusHandler := presenter.NewPresenterStatusHandler()
+	statusMetricsProvider, err := provider.NewStatusMetricsProvider(presenterStatusHandler, nodeAddress, fetchInterval)
+	if err != nil {
+		return view.NodeView{}, err
+	}
+
+	metricsHistory, err := presenter.NewMetricsHistory(presenterStatusHandler, historySize)
+	if err != nil {
+		return view.NodeView{}, err
+	}
+
+	err = statusMetricsProvider.SetMetricsRecorder(metricsHistory)
+	if err != nil {
+		return view.NodeView{}, err
+	}
+
+	statusMetricsProvider.StartUpdatingData()
+
+	return view.NodeView{
+		Address:   nodeAddress,
+		Presenter: presenterStatusHandler,
+		History:   metricsHistory,
+	}, nil
+}
+
 func initCliFlags() {
 	cliApp = cli.NewApp()
 	cli.AppHelpTemplate = nodeHelpTemplate
@@ -163,6 +239,8 @@ func initCliFlags() {
 	cliApp.Usage = "Terminal UI application used to display metrics from the node"
 	cliApp.Flags = []cli.Flag{
 		address,
+		addresses,
+		historySize,
 		logLevel,
 		logWithCorrelation,
 		logWithLoggerName,
@@ -1,6 +1,9 @@
 package presenter
 
-import "errors"
+import (
+	"errors"
+	"time"
+)
 
 func (psh *PresenterStatusHandler) GetPresenterMetricByKey(key string) (interface{}, error) {
 	psh.mutPresenterMap.RLock()
@@ -12,3 +15,7 @@ func (psh *PresenterStatusHandler) GetPresenterMetricByKey(key string) (interfac
 	}
 	return nil, errors.New("metric does not exist")
 }
+
+func (mh *MetricsHistory) RecordAt(now time.Time) {
+	mh.record(now)
+}
@@ -0,0 +1,117 @@
+package presenter
+
+import (
+	"sync"
+	"time"
+
+	"github.com/multiversx/mx-chain-go/cmd/termui/view"
+)
+
+// MetricsHistory keeps a rolling history of the key metrics of a node, sampled from its presenter
+type MetricsHistory struct {
+	presenter     view.Presenter
+	maxSamples    int
+	mut           sync.RWMutex
+	nonceLag      []float64
+	txPerSecond   []float64
+	memUsed       []float64
+	lastSample    time.Time
+	lastNumTxs    uint64
+	hasLastSample bool
+}
+
+// NewMetricsHistory will return a new instance of MetricsHistory, keeping at most maxSamples values for each metric
+func NewMetricsHistory(presenter view.Presenter, maxSamples int) (*MetricsHistory, error) {
+	if presenter == nil || presenter.IsInterfaceNil() {
+		return nil, view.ErrNilPresenterInterface
+	}
+	if maxSamples < 1 {
+		return nil, view.ErrInvalidHistorySize
+	}
+
+	return &MetricsHistory{
+		presenter:   presenter,
+		maxSamples:  maxSamples,
+		nonceLag:    make([]float64, 0, maxSamples),
+		txPerSecond: make([]float64, 0, maxSamples),
+		memUsed:     make([]float64, 0, maxSamples),
+	}, nil
+}
+
+// Record samples the current values of the metrics
+func (mh *MetricsHistory) Record() {
+	mh.record(time.Now())
+}
+
+func (mh *MetricsHistory) record(now time.Time) {
+	nonce := mh.presenter.GetNonce()
+	probableHighestNonce := mh.presenter.GetProbableHighestNonce()
+	numTxs := mh.presenter.GetNumTxProcessed()
+	memUsed := mh.presenter.GetMemUsedByNode()
+
+	nonceLag := uint64(0)
+	if probableHighestNonce > nonce {
+		nonceLag = probableHighestNonce - nonce
+	}
+
+	mh.mut.Lock()
+	defer mh.mut.Unlock()
+
+	txPerSecond := float64(0)
+	elapsed := now.Sub(mh.lastSample).Seconds()
+	if mh.hasLastSample && elapsed > 0 && numTxs >= mh.lastNumTxs {
+		txPerSecond = float64(numTxs-mh.lastNumTxs) / elapsed
+	}
+	mh.lastSample = now
+	mh.lastNumTxs = numTxs
+	mh.hasLastSample = true
+
+	mh.nonceLag = mh.appendSample(mh.nonceLag, float64(nonceLag))
+	mh.txPerSecond = mh.appendSample(mh.txPerSecond, txPerSecond)
+	mh.memUsed = mh.appendSample(mh.memUsed, float64(memUsed))
+}
+
+func (mh *MetricsHistory) appendSample(samples []float64, value float64) []float64 {
+	samples = append(samples, value)
+	if len(samples) > mh.maxSamples {
+		samples = samples[len(samples)-mh.maxSamples:]
+	}
+
+	return samples
+}
+
+// GetNonceLagHistory returns the history of the difference between the probable highest nonce and the node's nonce
+func (mh *MetricsHistory) GetNonceLagHistory() []float64 {
+	mh.mut.RLock()
+	defer mh.mut.RUnlock()
+
+	return copySamples(mh.nonceLag)
+}
+
+// GetTxPerSecondHistory returns the history of the processed transactions per second
+func (mh *MetricsHistory) GetTxPerSecondHistory() []float64 {
+	mh.mut.RLock()
+	defer mh.mut.RUnlock()
+
+	return copySamples(mh.txPerSecond)
+}
+
+// GetMemUsedHistory returns the history of the memory used by the node, in bytes
+func (mh *MetricsHistory) GetMemUsedHistory() []float64 {
+	mh.mut.RLock()
+	defer mh.mut.RUnlock()
+
+	return copySamples(mh.memUsed)
+}
+
+func copySamples(samples []float64) []float64 {
+	result := make([]float64, len(samples))
+	copy(result, samples)
+
+	return result
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (mh *MetricsHistory) IsInterfaceNil() bool {
+	return mh == nil
+}
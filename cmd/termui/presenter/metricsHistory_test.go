@@ -0,0 +1,76 @@
+package presenter_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-go/cmd/termui/presenter"
+	"github.com/multiversx/mx-chain-go/cmd/termui/view"
+	"github.com/multiversx/mx-chain-go/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewMetricsHistory(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil presenter should error", func(t *testing.T) {
+		t.Parallel()
+
+		mh, err := presenter.NewMetricsHistory(nil, 10)
+		assert.Equal(t, view.ErrNilPresenterInterface, err)
+		assert.True(t, check.IfNil(mh))
+	})
+	t.Run("invalid history size should error", func(t *testing.T) {
+		t.Parallel()
+
+		mh, err := presenter.NewMetricsHistory(presenter.NewPresenterStatusHandler(), 0)
+		assert.Equal(t, view.ErrInvalidHistorySize, err)
+		assert.True(t, check.IfNil(mh))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		mh, err := presenter.NewMetricsHistory(presenter.NewPresenterStatusHandler(), 10)
+		assert.Nil(t, err)
+		assert.False(t, check.IfNil(mh))
+		assert.Empty(t, mh.GetNonceLagHistory())
+	})
+}
+
+func TestMetricsHistory_Record(t *testing.T) {
+	t.Parallel()
+
+	presenterStatusHandler := presenter.NewPresenterStatusHandler()
+	mh, err := presenter.NewMetricsHistory(presenterStatusHandler, 3)
+	require.Nil(t, err)
+
+	startTime := time.Unix(1000, 0)
+	presenterStatusHandler.SetUInt64Value(common.MetricNonce, 90)
+	presenterStatusHandler.SetUInt64Value(common.MetricProbableHighestNonce, 100)
+	presenterStatusHandler.SetUInt64Value(common.MetricNumProcessedTxs, 1000)
+	presenterStatusHandler.SetUInt64Value(common.MetricMemUsedGolang, 2048)
+	mh.RecordAt(startTime)
+
+	presenterStatusHandler.SetUInt64Value(common.MetricNonce, 100)
+	presenterStatusHandler.SetUInt64Value(common.MetricNumProcessedTxs, 1200)
+	mh.RecordAt(startTime.Add(2 * time.Second))
+
+	// the node restarted, the number of processed transactions is reset
+	presenterStatusHandler.SetUInt64Value(common.MetricNonce, 101)
+	presenterStatusHandler.SetUInt64Value(common.MetricNumProcessedTxs, 10)
+	presenterStatusHandler.SetUInt64Value(common.MetricMemUsedGolang, 4096)
+	mh.RecordAt(startTime.Add(4 * time.Second))
+
+	assert.Equal(t, []float64{10, 0, 0}, mh.GetNonceLagHistory())
+	assert.Equal(t, []float64{0, 100, 0}, mh.GetTxPerSecondHistory())
+	assert.Equal(t, []float64{2048, 2048, 4096}, mh.GetMemUsedHistory())
+
+	presenterStatusHandler.SetUInt64Value(common.MetricNumProcessedTxs, 20)
+	mh.RecordAt(startTime.Add(5 * time.Second))
+
+	assert.Equal(t, []float64{0, 0, 0}, mh.GetNonceLagHistory())
+	assert.Equal(t, []float64{100, 0, 10}, mh.GetTxPerSecondHistory())
+	assert.Equal(t, []float64{2048, 4096, 4096}, mh.GetMemUsedHistory())
+}
@@ -0,0 +1,13 @@
+package provider
+
+type disabledMetricsRecorder struct {
+}
+
+// Record does nothing
+func (dmr *disabledMetricsRecorder) Record() {
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (dmr *disabledMetricsRecorder) IsInterfaceNil() bool {
+	return dmr == nil
+}
@@ -19,3 +19,6 @@ var ErrNilChanNodeIsStarting = errors.New("nil node starting channel")
 
 // ErrEmptyNodeURL signals that an empty URL for the node has been provided
 var ErrEmptyNodeURL = errors.New("empty node URL")
+
+// ErrNilMetricsRecorder signals that a nil metrics recorder has been provided
+var ErrNilMetricsRecorder = errors.New("nil metrics recorder")
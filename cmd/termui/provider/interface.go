@@ -16,3 +16,9 @@ type PresenterHandler interface {
 	Write(p []byte) (n int, err error)
 	view.Presenter
 }
+
+// MetricsRecorder defines a component which samples the metrics after each fetch
+type MetricsRecorder interface {
+	Record()
+	IsInterfaceNil() bool
+}
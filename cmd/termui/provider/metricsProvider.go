@@ -8,6 +8,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/multiversx/mx-chain-core-go/core/check"
 	"github.com/multiversx/mx-chain-go/common"
 	logger "github.com/multiversx/mx-chain-logger-go"
 )
@@ -51,6 +52,7 @@ type StatusMetricsProvider struct {
 	fetchInterval   int
 	shardID         string
 	numTrieNodesSet bool
+	recorder        MetricsRecorder
 }
 
 // NewStatusMetricsProvider will return a new instance of a StatusMetricsProvider
@@ -73,14 +75,27 @@ func NewStatusMetricsProvider(
 		presenter:     presenter,
 		nodeAddress:   formatUrlAddress(nodeAddress),
 		fetchInterval: fetchInterval,
+		recorder:      &disabledMetricsRecorder{},
 	}, nil
 }
 
+// SetMetricsRecorder sets the recorder which samples the metrics after each fetch
+func (smp *StatusMetricsProvider) SetMetricsRecorder(recorder MetricsRecorder) error {
+	if check.IfNil(recorder) {
+		return ErrNilMetricsRecorder
+	}
+
+	smp.recorder = recorder
+
+	return nil
+}
+
 // StartUpdatingData will update data from the API at a given interval
 func (smp *StatusMetricsProvider) StartUpdatingData() {
 	go func() {
 		for {
 			smp.updateMetrics()
+			smp.recorder.Record()
 			time.Sleep(time.Duration(smp.fetchInterval) * time.Millisecond)
 		}
 	}()
@@ -13,3 +13,12 @@ var ErrNilGrid = errors.New("nil grid")
 
 // ErrInvalidRefreshTimeInMilliseconds signals that an invalid time in milliseconds was provided
 var ErrInvalidRefreshTimeInMilliseconds = errors.New("invalid refresh time in milliseconds")
+
+// ErrInvalidHistorySize signals that an invalid number of history samples was provided
+var ErrInvalidHistorySize = errors.New("invalid history size")
+
+// ErrNoNodes signals that no node was provided
+var ErrNoNodes = errors.New("no nodes provided")
+
+// ErrNilMetricsHistory signals that a nil metrics history has been provided
+var ErrNilMetricsHistory = errors.New("nil metrics history")
@@ -68,3 +68,18 @@ type Presenter interface {
 	InvalidateCache()
 	IsInterfaceNil() bool
 }
+
+// MetricsHistory defines the methods that return the rolling history of the key metrics of a node
+type MetricsHistory interface {
+	GetNonceLagHistory() []float64
+	GetTxPerSecondHistory() []float64
+	GetMemUsedHistory() []float64
+	IsInterfaceNil() bool
+}
+
+// NodeView holds the components needed to display a node in the multi-node dashboard
+type NodeView struct {
+	Address   string
+	Presenter Presenter
+	History   MetricsHistory
+}
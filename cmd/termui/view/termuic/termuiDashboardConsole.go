@@ -0,0 +1,121 @@
+package termuic
+
+import (
+	"os"
+	"os/signal"
+	"sync"
+	"syscall"
+	"time"
+
+	ui "github.com/gizak/termui/v3"
+	"github.com/multiversx/mx-chain-go/cmd/termui/view"
+	"github.com/multiversx/mx-chain-go/cmd/termui/view/termuic/termuiRenders"
+)
+
+// TermuiDashboardConsole displays the multi-node dashboard
+type TermuiDashboardConsole struct {
+	nodes                     []view.NodeView
+	dashboardRender           *termuiRenders.DashboardRender
+	mutRefresh                *sync.RWMutex
+	refreshTimeInMilliseconds int
+}
+
+// NewTermuiDashboardConsole method is used to return a new TermuiDashboardConsole structure
+func NewTermuiDashboardConsole(nodes []view.NodeView, refreshTimeInMilliseconds int) (*TermuiDashboardConsole, error) {
+	if len(nodes) == 0 {
+		return nil, view.ErrNoNodes
+	}
+	if refreshTimeInMilliseconds < 1 {
+		return nil, view.ErrInvalidRefreshTimeInMilliseconds
+	}
+
+	return &TermuiDashboardConsole{
+		nodes:                     nodes,
+		mutRefresh:                &sync.RWMutex{},
+		refreshTimeInMilliseconds: refreshTimeInMilliseconds,
+	}, nil
+}
+
+// Start method - will start termui dashboard console
+func (tdc *TermuiDashboardConsole) Start() error {
+	var err error
+	tdc.dashboardRender, err = termuiRenders.NewDashboardRender(tdc.nodes)
+	if err != nil {
+		return err
+	}
+
+	go func() {
+		defer func() {
+			log.Debug("closing termui ui")
+			ui.Close()
+		}()
+		_ = ui.Init()
+		tdc.eventLoop()
+	}()
+
+	return nil
+}
+
+func (tdc *TermuiDashboardConsole) eventLoop() {
+	termWidth, termHeight := ui.TerminalDimensions()
+	tdc.dashboardRender.SetRectangle(termWidth, termHeight)
+
+	uiEvents := ui.PollEvents()
+	// handles kill signal sent to gotop
+	sigTerm := make(chan os.Signal, 2)
+	signal.Notify(sigTerm, os.Interrupt, syscall.SIGTERM)
+
+	tdc.refreshWindow()
+	ticksCounter := 0
+
+	for {
+		select {
+		case <-time.After(time.Millisecond * time.Duration(tdc.refreshTimeInMilliseconds)):
+			ticksCounter++
+			if ticksCounter > numOfTicksBeforeRedrawing {
+				width, height := ui.TerminalDimensions()
+				tdc.dashboardRender.SetRectangle(width, height)
+				ticksCounter = 0
+			}
+			tdc.refreshWindow()
+		case <-sigTerm:
+			ui.Clear()
+			return
+		case e := <-uiEvents:
+			tdc.processUiEvents(e)
+		}
+	}
+}
+
+func (tdc *TermuiDashboardConsole) processUiEvents(e ui.Event) {
+	switch e.ID {
+	case "<Resize>":
+		payload := e.Payload.(ui.Resize)
+		tdc.dashboardRender.SetRectangle(payload.Width, payload.Height)
+	case "<Down>", "j":
+		tdc.dashboardRender.SelectNext()
+	case "<Up>", "k":
+		tdc.dashboardRender.SelectPrevious()
+	case "<Enter>":
+		tdc.dashboardRender.DrillDown()
+	case "<Escape>", "<Backspace>":
+		tdc.dashboardRender.Back()
+	case "<C-c>":
+		ui.Close()
+		stopApplication()
+		return
+	default:
+		return
+	}
+
+	tdc.refreshWindow()
+}
+
+func (tdc *TermuiDashboardConsole) refreshWindow() {
+	tdc.mutRefresh.Lock()
+	defer tdc.mutRefresh.Unlock()
+
+	tdc.dashboardRender.RefreshData(tdc.refreshTimeInMilliseconds)
+	ui.Clear()
+	ui.Render(tdc.dashboardRender.Drawables()...)
+}
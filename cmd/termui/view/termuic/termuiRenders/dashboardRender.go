@@ -0,0 +1,318 @@
+package termuiRenders
+
+import (
+	"fmt"
+
+	ui "github.com/gizak/termui/v3"
+	"github.com/gizak/termui/v3/widgets"
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-go/cmd/termui/view"
+)
+
+const (
+	sparklinesHeight = 12
+	statusSynced     = "yes"
+	statusNotSynced  = "syncing"
+)
+
+var summaryHeader = []string{"#", "Node", "Shard", "Nonce", "Lag", "Synced", "Peers", "Signed / accepted", "Proposed / accepted", "CPU", "Mem"}
+
+type nodeDetails struct {
+	container *DrawableContainer
+	render    *WidgetsRender
+}
+
+// DashboardRender will define the termui widgets of the multi-node dashboard: a summary grid of all the nodes, the
+// details of one node and the metric history charts of the selected node
+type DashboardRender struct {
+	nodes       []view.NodeView
+	details     []*nodeDetails
+	summary     *widgets.Table
+	sparklines  *widgets.SparklineGroup
+	nonceLag    *widgets.Sparkline
+	txPerSecond *widgets.Sparkline
+	memUsed     *widgets.Sparkline
+	selected    int
+	firstRow    int
+	drillDown   bool
+	width       int
+	height      int
+}
+
+// NewDashboardRender method will create a new DashboardRender that displays the provided nodes
+func NewDashboardRender(nodes []view.NodeView) (*DashboardRender, error) {
+	if len(nodes) == 0 {
+		return nil, view.ErrNoNodes
+	}
+
+	details := make([]*nodeDetails, 0, len(nodes))
+	for i, node := range nodes {
+		if check.IfNil(node.History) {
+			return nil, fmt.Errorf("%w for node %d", view.ErrNilMetricsHistory, i)
+		}
+
+		container := NewDrawableContainer()
+		render, err := NewWidgetsRender(node.Presenter, container)
+		if err != nil {
+			return nil, fmt.Errorf("%w for node %d", err, i)
+		}
+
+		details = append(details, &nodeDetails{
+			container: container,
+			render:    render,
+		})
+	}
+
+	dr := &DashboardRender{
+		nodes:   nodes,
+		details: details,
+	}
+	dr.initWidgets()
+
+	return dr, nil
+}
+
+func (dr *DashboardRender) initWidgets() {
+	dr.summary = widgets.NewTable()
+	dr.summary.Title = "Nodes (up/down: select, enter: details, esc: back):"
+	dr.summary.RowSeparator = false
+	dr.summary.TextAlignment = ui.AlignLeft
+	dr.summary.Rows = [][]string{summaryHeader}
+
+	dr.nonceLag = widgets.NewSparkline()
+	dr.nonceLag.LineColor = ui.ColorYellow
+	dr.txPerSecond = widgets.NewSparkline()
+	dr.txPerSecond.LineColor = ui.ColorGreen
+	dr.memUsed = widgets.NewSparkline()
+	dr.memUsed.LineColor = ui.ColorCyan
+
+	dr.sparklines = widgets.NewSparklineGroup(dr.nonceLag, dr.txPerSecond, dr.memUsed)
+}
+
+// SelectNext selects the next node
+func (dr *DashboardRender) SelectNext() {
+	if dr.selected < len(dr.nodes)-1 {
+		dr.selected++
+	}
+}
+
+// SelectPrevious selects the previous node
+func (dr *DashboardRender) SelectPrevious() {
+	if dr.selected > 0 {
+		dr.selected--
+	}
+}
+
+// DrillDown switches to the details of the selected node
+func (dr *DashboardRender) DrillDown() {
+	dr.drillDown = true
+	dr.SetRectangle(dr.width, dr.height)
+}
+
+// Back switches back to the summary of all the nodes
+func (dr *DashboardRender) Back() {
+	dr.drillDown = false
+	dr.SetRectangle(dr.width, dr.height)
+}
+
+// SetRectangle sets the dimensions of the dashboard
+func (dr *DashboardRender) SetRectangle(width int, height int) {
+	dr.width = width
+	dr.height = height
+
+	if dr.drillDown {
+		details := dr.details[dr.selected]
+		details.container.SetBottom(dr.sparklines)
+		details.container.SetRectangle(0, 0, width, height)
+		return
+	}
+
+	chartsTop := height - sparklinesHeight
+	if chartsTop < 0 {
+		chartsTop = 0
+	}
+	dr.summary.SetRect(0, 0, width, chartsTop)
+	dr.sparklines.SetRect(0, chartsTop, width, height)
+}
+
+// Drawables returns the widgets to be rendered
+func (dr *DashboardRender) Drawables() []ui.Drawable {
+	if dr.drillDown {
+		return dr.details[dr.selected].container.Items()
+	}
+
+	return []ui.Drawable{dr.summary, dr.sparklines}
+}
+
+// RefreshData method is used to prepare the data displayed on the dashboard
+func (dr *DashboardRender) RefreshData(numMillisecondsRefreshTime int) {
+	if dr.drillDown {
+		dr.details[dr.selected].render.RefreshData(numMillisecondsRefreshTime)
+	} else {
+		dr.prepareSummary()
+	}
+
+	dr.prepareSparklines()
+}
+
+func (dr *DashboardRender) prepareSummary() {
+	// one line of the table is used by the header
+	numVisibleRows := dr.summary.Inner.Dy() - 1
+	if numVisibleRows < 1 {
+		numVisibleRows = 1
+	}
+	dr.firstRow = computeFirstVisibleRow(dr.firstRow, dr.selected, numVisibleRows)
+	lastRow := dr.firstRow + numVisibleRows
+	if lastRow > len(dr.nodes) {
+		lastRow = len(dr.nodes)
+	}
+
+	rows := make([][]string, 0, lastRow-dr.firstRow+1)
+	rows = append(rows, summaryHeader)
+	dr.summary.RowStyles = map[int]ui.Style{
+		0: ui.NewStyle(ui.ColorWhite, ui.ColorClear, ui.ModifierBold),
+	}
+	for i := dr.firstRow; i < lastRow; i++ {
+		row, syncStatus := createSummaryRow(i, dr.nodes[i])
+		rowIndex := len(rows)
+		rows = append(rows, row)
+
+		switch {
+		case i == dr.selected:
+			dr.summary.RowStyles[rowIndex] = ui.NewStyle(ui.ColorBlack, ui.ColorCyan)
+		case syncStatus == statusNotApplicable:
+			dr.summary.RowStyles[rowIndex] = ui.NewStyle(ui.ColorRed)
+		case syncStatus == statusNotSynced:
+			dr.summary.RowStyles[rowIndex] = ui.NewStyle(ui.ColorYellow)
+		}
+	}
+
+	dr.summary.Rows = rows
+}
+
+// computeFirstVisibleRow scrolls the summary so that the selected node is always visible
+func computeFirstVisibleRow(firstRow int, selected int, numVisibleRows int) int {
+	if selected < firstRow {
+		return selected
+	}
+	if selected >= firstRow+numVisibleRows {
+		return selected - numVisibleRows + 1
+	}
+
+	return firstRow
+}
+
+func createSummaryRow(index int, node view.NodeView) ([]string, string) {
+	presenter := node.Presenter
+
+	shardID := presenter.GetShardId()
+	shardStr := fmt.Sprintf("%d", shardID)
+	if shardID == uint64(core.MetachainShardId) {
+		shardStr = "meta"
+	}
+
+	nonce := presenter.GetNonce()
+	nonceLag := uint64(0)
+	probableHighestNonce := presenter.GetProbableHighestNonce()
+	if probableHighestNonce > nonce {
+		nonceLag = probableHighestNonce - nonce
+	}
+
+	syncStatus := computeSyncStatus(presenter)
+
+	return []string{
+		fmt.Sprintf("%d", index),
+		computeNodeName(node),
+		shardStr,
+		fmt.Sprintf("%d", nonce),
+		fmt.Sprintf("%d", nonceLag),
+		syncStatus,
+		fmt.Sprintf("%d", presenter.GetNumConnectedPeers()),
+		fmt.Sprintf("%d / %d", presenter.GetCountConsensus(), presenter.GetCountConsensusAcceptedBlocks()),
+		fmt.Sprintf("%d / %d", presenter.GetCountLeader(), presenter.GetCountAcceptedBlocks()),
+		fmt.Sprintf("%d%%", presenter.GetCpuLoadPercent()),
+		fmt.Sprintf("%d%% (%s)", presenter.GetMemLoadPercent(), core.ConvertBytes(presenter.GetMemUsedByNode())),
+	}, syncStatus
+}
+
+// computeNodeName returns the display name of the node, or its address if the name is not yet known
+func computeNodeName(node view.NodeView) string {
+	name := node.Presenter.GetNodeName()
+	if len(name) == 0 || name == statusNotApplicable {
+		return node.Address
+	}
+
+	return name
+}
+
+func computeSyncStatus(presenter view.Presenter) string {
+	currentRound := presenter.GetCurrentRound()
+	if currentRound == 0 {
+		return statusNotApplicable
+	}
+	if presenter.GetIsSyncing() == 1 || presenter.GetSynchronizedRound() < currentRound {
+		return statusNotSynced
+	}
+
+	return statusSynced
+}
+
+func (dr *DashboardRender) prepareSparklines() {
+	node := dr.nodes[dr.selected]
+	dr.sparklines.Title = fmt.Sprintf("History of %s:", computeNodeName(node))
+
+	width := dr.sparklines.Inner.Dx()
+
+	nonceLag := lastSamples(node.History.GetNonceLagHistory(), width)
+	dr.nonceLag.Data = nonceLag
+	dr.nonceLag.MaxVal = computeMaxValue(nonceLag)
+	dr.nonceLag.Title = fmt.Sprintf("Nonce lag: %.0f", lastValue(nonceLag))
+
+	txPerSecond := lastSamples(node.History.GetTxPerSecondHistory(), width)
+	dr.txPerSecond.Data = txPerSecond
+	dr.txPerSecond.MaxVal = computeMaxValue(txPerSecond)
+	dr.txPerSecond.Title = fmt.Sprintf("Transactions per second: %.1f", lastValue(txPerSecond))
+
+	memUsed := lastSamples(node.History.GetMemUsedHistory(), width)
+	dr.memUsed.Data = memUsed
+	dr.memUsed.MaxVal = computeMaxValue(memUsed)
+	dr.memUsed.Title = fmt.Sprintf("Memory used: %s", core.ConvertBytes(uint64(lastValue(memUsed))))
+}
+
+// lastSamples returns the samples fitting the width, as the sparklines are drawn from the first sample
+func lastSamples(samples []float64, width int) []float64 {
+	if width <= 0 {
+		return []float64{}
+	}
+	if len(samples) > width {
+		return samples[len(samples)-width:]
+	}
+
+	return samples
+}
+
+func lastValue(samples []float64) float64 {
+	if len(samples) == 0 {
+		return 0
+	}
+
+	return samples[len(samples)-1]
+}
+
+// computeMaxValue returns the maximum of the samples, never 0 as the sparklines divide by it
+func computeMaxValue(samples []float64) float64 {
+	maxValue := float64(1)
+	for _, sample := range samples {
+		if sample > maxValue {
+			maxValue = sample
+		}
+	}
+
+	return maxValue
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (dr *DashboardRender) IsInterfaceNil() bool {
+	return dr == nil
+}
@@ -0,0 +1,146 @@
+package termuiRenders
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-go/cmd/termui/presenter"
+	"github.com/multiversx/mx-chain-go/cmd/termui/view"
+	"github.com/multiversx/mx-chain-go/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createNodeView(t *testing.T, address string) (view.NodeView, *presenter.PresenterStatusHandler) {
+	presenterStatusHandler := presenter.NewPresenterStatusHandler()
+	history, err := presenter.NewMetricsHistory(presenterStatusHandler, 10)
+	require.Nil(t, err)
+
+	return view.NodeView{
+		Address:   address,
+		Presenter: presenterStatusHandler,
+		History:   history,
+	}, presenterStatusHandler
+}
+
+func TestNewDashboardRender(t *testing.T) {
+	t.Parallel()
+
+	t.Run("no nodes should error", func(t *testing.T) {
+		t.Parallel()
+
+		dr, err := NewDashboardRender(nil)
+		assert.Equal(t, view.ErrNoNodes, err)
+		assert.Nil(t, dr)
+	})
+	t.Run("nil history should error", func(t *testing.T) {
+		t.Parallel()
+
+		node, _ := createNodeView(t, "127.0.0.1:8080")
+		node.History = nil
+		dr, err := NewDashboardRender([]view.NodeView{node})
+		assert.True(t, errors.Is(err, view.ErrNilMetricsHistory))
+		assert.Nil(t, dr)
+	})
+	t.Run("nil presenter should error", func(t *testing.T) {
+		t.Parallel()
+
+		node, _ := createNodeView(t, "127.0.0.1:8080")
+		node.Presenter = nil
+		dr, err := NewDashboardRender([]view.NodeView{node})
+		assert.True(t, errors.Is(err, view.ErrNilPresenterInterface))
+		assert.Nil(t, dr)
+	})
+}
+
+func TestDashboardRender_SelectionAndDrillDown(t *testing.T) {
+	t.Parallel()
+
+	node0, _ := createNodeView(t, "127.0.0.1:8080")
+	node1, _ := createNodeView(t, "127.0.0.1:8081")
+	dr, err := NewDashboardRender([]view.NodeView{node0, node1})
+	require.Nil(t, err)
+	dr.SetRectangle(200, 50)
+
+	dr.SelectPrevious()
+	assert.Equal(t, 0, dr.selected)
+	dr.SelectNext()
+	dr.SelectNext()
+	assert.Equal(t, 1, dr.selected)
+
+	dr.RefreshData(1000)
+	assert.Equal(t, 3, len(dr.summary.Rows))
+	assert.Equal(t, 2, len(dr.Drawables()))
+	assert.Equal(t, "History of 127.0.0.1:8081:", dr.sparklines.Title)
+
+	dr.DrillDown()
+	dr.RefreshData(1000)
+	drawables := dr.Drawables()
+	assert.Equal(t, 3, len(drawables))
+	assert.Equal(t, dr.sparklines, drawables[2])
+
+	dr.Back()
+	assert.Equal(t, 2, len(dr.Drawables()))
+}
+
+func TestCreateSummaryRow(t *testing.T) {
+	t.Parallel()
+
+	t.Run("unreachable node", func(t *testing.T) {
+		t.Parallel()
+
+		node, _ := createNodeView(t, "127.0.0.1:8080")
+		row, syncStatus := createSummaryRow(3, node)
+		assert.Equal(t, statusNotApplicable, syncStatus)
+		assert.Equal(t, "3", row[0])
+		assert.Equal(t, "127.0.0.1:8080", row[1])
+	})
+	t.Run("syncing metachain node", func(t *testing.T) {
+		t.Parallel()
+
+		node, presenterStatusHandler := createNodeView(t, "127.0.0.1:8080")
+		presenterStatusHandler.SetStringValue(common.MetricNodeDisplayName, "meta-0")
+		presenterStatusHandler.SetUInt64Value(common.MetricShardId, uint64(core.MetachainShardId))
+		presenterStatusHandler.SetUInt64Value(common.MetricNonce, 90)
+		presenterStatusHandler.SetUInt64Value(common.MetricProbableHighestNonce, 100)
+		presenterStatusHandler.SetUInt64Value(common.MetricCurrentRound, 110)
+		presenterStatusHandler.SetUInt64Value(common.MetricSynchronizedRound, 100)
+		presenterStatusHandler.SetUInt64Value(common.MetricCountConsensus, 7)
+		presenterStatusHandler.SetUInt64Value(common.MetricCountConsensusAcceptedBlocks, 6)
+
+		row, syncStatus := createSummaryRow(0, node)
+		assert.Equal(t, statusNotSynced, syncStatus)
+		assert.Equal(t, []string{"0", "meta-0", "meta", "90", "10", statusNotSynced}, row[:6])
+		assert.Equal(t, "7 / 6", row[7])
+	})
+	t.Run("synced node", func(t *testing.T) {
+		t.Parallel()
+
+		node, presenterStatusHandler := createNodeView(t, "127.0.0.1:8080")
+		presenterStatusHandler.SetUInt64Value(common.MetricCurrentRound, 110)
+		presenterStatusHandler.SetUInt64Value(common.MetricSynchronizedRound, 110)
+
+		_, syncStatus := createSummaryRow(0, node)
+		assert.Equal(t, statusSynced, syncStatus)
+	})
+}
+
+func TestComputeFirstVisibleRow(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, 0, computeFirstVisibleRow(0, 5, 10))
+	assert.Equal(t, 6, computeFirstVisibleRow(0, 15, 10))
+	assert.Equal(t, 3, computeFirstVisibleRow(6, 3, 10))
+}
+
+func TestLastSamples(t *testing.T) {
+	t.Parallel()
+
+	samples := []float64{1, 2, 3, 4}
+	assert.Empty(t, lastSamples(samples, 0))
+	assert.Equal(t, []float64{3, 4}, lastSamples(samples, 2))
+	assert.Equal(t, samples, lastSamples(samples, 10))
+	assert.Equal(t, float64(4), computeMaxValue(samples))
+	assert.Equal(t, float64(1), computeMaxValue([]float64{0, 0}))
+}
@@ -82,6 +82,9 @@ const ConsensusTopic = "consensus"
 // GenesisTxSignatureString is the string used to generate genesis transaction signature as 128 hex characters
 const GenesisTxSignatureString = "GENESISGENESISGENESISGENESISGENESISGENESISGENESISGENESISGENESISG"
 
+// TopicSaveConsensusRoundTimeline is the outport topic used when streaming the timeline of a consensus round
+const TopicSaveConsensusRoundTimeline = "SaveConsensusRoundTimeline"
+
 // HeartbeatV2Topic is the topic used for heartbeatV2 signaling
 const HeartbeatV2Topic = "heartbeatV2"
 
@@ -1,6 +1,7 @@
 package common
 
 import (
+	"encoding/binary"
 	"fmt"
 	"strconv"
 	"strings"
@@ -65,3 +66,13 @@ func ByteSliceToUint64(bytes []byte) (uint64, error) {
 
 	return result, nil
 }
+
+// ValidatorEpochKey returns the storage key of the per epoch data of the provided validator, such as its rewards
+// breakdown or its ratings history
+func ValidatorEpochKey(blsKey []byte, epoch uint32) []byte {
+	key := make([]byte, len(blsKey)+4)
+	copy(key, blsKey)
+	binary.BigEndian.PutUint32(key[len(blsKey):], epoch)
+
+	return key
+}
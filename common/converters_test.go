@@ -410,3 +410,11 @@ func TestByteSliceToUint64(t *testing.T) {
 		require.Equal(t, expectedValue, convertedValue)
 	})
 }
+
+func TestValidatorEpochKey(t *testing.T) {
+	t.Parallel()
+
+	key := common.ValidatorEpochKey([]byte("bls"), 258)
+	require.Equal(t, append([]byte("bls"), 0, 0, 1, 2), key)
+	require.NotEqual(t, key, common.ValidatorEpochKey([]byte("bls"), 259))
+}
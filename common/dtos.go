@@ -1,3 +1,4 @@
+//go:generate protoc -I=. -I=$GOPATH/src -I=$GOPATH/src/github.com/multiversx/protobuf/protobuf  --gogoslick_out=. validatorRewardsBreakdown.proto
 package common
 
 import (
@@ -80,6 +81,7 @@ type AlteredAccountsForBlockAPIResponse struct {
 type AuctionNode struct {
 	BlsKey    string `json:"blsKey"`
 	Qualified bool   `json:"qualified"`
+	Simulated bool   `json:"simulated,omitempty"`
 }
 
 // AuctionListValidatorAPIResponse holds the data needed for an auction node validator for responding to API calls
@@ -91,3 +93,292 @@ type AuctionListValidatorAPIResponse struct {
 	QualifiedTopUp string         `json:"qualifiedTopUp"`
 	Nodes          []*AuctionNode `json:"nodes"`
 }
+
+// ValidatorRatingHistory holds the rating of a validator at the start and at the end of an epoch, along with the
+// counters that changed it during that epoch. The ratings are percentages of the maximum rating, as in the validator
+// statistics, the end rating including the penalty applied at the end of the epoch to the validators that signed below
+// the threshold. The list is the one the validator was in at the end of the epoch, jailed validators being moved to
+// the jailed list
+type ValidatorRatingHistory struct {
+	Epoch                      uint32  `json:"epoch"`
+	ShardID                    uint32  `json:"shardID"`
+	BlsKey                     string  `json:"blsKey,omitempty"`
+	List                       string  `json:"list"`
+	PreviousList               string  `json:"previousList,omitempty"`
+	Jailed                     bool    `json:"jailed"`
+	StartRating                float32 `json:"startRating"`
+	EndRating                  float32 `json:"endRating"`
+	LeaderSuccess              uint32  `json:"leaderSuccess"`
+	LeaderFailure              uint32  `json:"leaderFailure"`
+	ValidatorSuccess           uint32  `json:"validatorSuccess"`
+	ValidatorFailure           uint32  `json:"validatorFailure"`
+	ValidatorIgnoredSignatures uint32  `json:"validatorIgnoredSignatures"`
+}
+
+// AuctionSimulationOwnerChange holds a hypothetical change of an owner's staked value and number of nodes in auction.
+// Both values can be negative. Added nodes are paid from the added stake first, the remainder being top up, while
+// removed nodes release their stake as top up, the same way the validator system smart contract handles them
+type AuctionSimulationOwnerChange struct {
+	Owner      string `json:"owner"`
+	AddedStake string `json:"addedStake"`
+	AddedNodes int64  `json:"addedNodes"`
+}
+
+// AuctionSimulationAPIResponse holds the outcome of an auction selection simulation for responding to API calls
+type AuctionSimulationAPIResponse struct {
+	AvailableSlots    uint32                             `json:"availableSlots"`
+	MinQualifiedTopUp string                             `json:"minQualifiedTopUp"`
+	SelectedNodes     []string                           `json:"selectedNodes"`
+	UnselectedNodes   []string                           `json:"unselectedNodes"`
+	AuctionList       []*AuctionListValidatorAPIResponse `json:"auctionList"`
+}
+
+// NodesShufflingPreviewAPIResponse holds the nodes lists of each shard projected for the next epoch, as resulted from
+// running the auction selection and the nodes shuffler on the current validators info. It is only a projection, the
+// real lists being affected by the changes done until the end of the epoch, by the epoch start steps listed as not
+// simulated and, if not yet known, by the randomness of the epoch start block
+type NodesShufflingPreviewAPIResponse struct {
+	IsProjection    bool                                              `json:"isProjection"`
+	NotSimulated    []string                                          `json:"notSimulated"`
+	RandomnessKnown bool                                              `json:"randomnessKnown"`
+	Epoch           uint32                                            `json:"epoch"`
+	Shards          map[uint32]*NodesShufflingPreviewShardAPIResponse `json:"shards"`
+}
+
+// NodesShufflingPreviewShardAPIResponse holds the projected nodes lists of a shard for responding to API calls
+type NodesShufflingPreviewShardAPIResponse struct {
+	Eligible    []string `json:"eligible"`
+	Waiting     []string `json:"waiting"`
+	Leaving     []string `json:"leaving"`
+	ShuffledOut []string `json:"shuffledOut"`
+}
+
+// GasPriceSuggestion holds a suggested gas price along with the expected inclusion delay of a transaction using it
+type GasPriceSuggestion struct {
+	GasPrice                 uint64 `json:"gasPrice"`
+	ExpectedInclusionRounds  uint64 `json:"expectedInclusionRounds"`
+	ExpectedInclusionDelayMs uint64 `json:"expectedInclusionDelayMs"`
+}
+
+// GasPriceSuggestionAPIResponse holds the gas price suggestions to be returned on API calls
+type GasPriceSuggestionAPIResponse struct {
+	ShardID               uint32              `json:"shardID"`
+	MinGasPrice           uint64              `json:"minGasPrice"`
+	Slow                  *GasPriceSuggestion `json:"slow"`
+	Normal                *GasPriceSuggestion `json:"normal"`
+	Fast                  *GasPriceSuggestion `json:"fast"`
+	BlocksAnalyzed        int                 `json:"blocksAnalyzed"`
+	AverageBlockFullness  float64             `json:"averageBlockFullness"`
+	PoolOccupancy         float64             `json:"poolOccupancy"`
+	PoolOccupancyPerCache map[string]float64  `json:"poolOccupancyPerCache"`
+	PendingTransactions   int                 `json:"pendingTransactions"`
+	TotalPoolTransactions int64               `json:"totalPoolTransactions"`
+	LastNonce             uint64              `json:"lastNonce"`
+}
+
+// TrieNodesPerDepth holds the number of trie nodes of each type found at a certain depth
+type TrieNodesPerDepth struct {
+	Depth             uint32 `json:"depth"`
+	NumBranchNodes    uint64 `json:"numBranchNodes"`
+	NumExtensionNodes uint64 `json:"numExtensionNodes"`
+	NumLeafNodes      uint64 `json:"numLeafNodes"`
+}
+
+// DataTrieStatisticsAPIResponse holds the size statistics of an account data trie to be returned on API calls
+type DataTrieStatisticsAPIResponse struct {
+	Address      string `json:"address"`
+	RootHash     string `json:"rootHash"`
+	TotalSize    uint64 `json:"totalSize"`
+	NumNodes     uint64 `json:"numNodes"`
+	NumLeaves    uint64 `json:"numLeaves"`
+	MaxTrieDepth uint32 `json:"maxTrieDepth"`
+}
+
+// TrieStatisticsAPIResponse holds the statistics of the accounts trie, together with all the data tries, for a root
+// hash to be returned on API calls
+type TrieStatisticsAPIResponse struct {
+	RootHash          string                           `json:"rootHash"`
+	Status            string                           `json:"status"`
+	Error             string                           `json:"error,omitempty"`
+	DurationInMs      int64                            `json:"durationInMs,omitempty"`
+	NumAccounts       uint64                           `json:"numAccounts"`
+	NumDataTries      uint64                           `json:"numDataTries"`
+	NumBranchNodes    uint64                           `json:"numBranchNodes"`
+	NumExtensionNodes uint64                           `json:"numExtensionNodes"`
+	NumLeafNodes      uint64                           `json:"numLeafNodes"`
+	TotalSize         uint64                           `json:"totalSize"`
+	MainTrieSize      uint64                           `json:"mainTrieSize"`
+	DataTriesSize     uint64                           `json:"dataTriesSize"`
+	MaxMainTrieDepth  uint32                           `json:"maxMainTrieDepth"`
+	MaxDataTrieDepth  uint32                           `json:"maxDataTrieDepth"`
+	MainTrieNodes     []*TrieNodesPerDepth             `json:"mainTrieNodesPerDepth"`
+	DataTriesNodes    []*TrieNodesPerDepth             `json:"dataTriesNodesPerDepth"`
+	LargestDataTries  []*DataTrieStatisticsAPIResponse `json:"largestDataTries"`
+}
+
+// OutportReindexStatus holds the progress of an outport re-indexing job
+type OutportReindexStatus struct {
+	Status           string `json:"status"`
+	StartNonce       uint64 `json:"startNonce"`
+	EndNonce         uint64 `json:"endNonce"`
+	LastIndexedNonce uint64 `json:"lastIndexedNonce"`
+	NumIndexedBlocks uint64 `json:"numIndexedBlocks"`
+	Error            string `json:"error,omitempty"`
+}
+
+// GovernanceConfigAPIResponse holds the active configuration of the governance system smart contract
+type GovernanceConfigAPIResponse struct {
+	MinQuorum         float32 `json:"minQuorum"`
+	MinPassThreshold  float32 `json:"minPassThreshold"`
+	MinVetoThreshold  float32 `json:"minVetoThreshold"`
+	ProposalFee       string  `json:"proposalFee"`
+	LostProposalFee   string  `json:"lostProposalFee"`
+	LastProposalNonce uint64  `json:"lastProposalNonce"`
+}
+
+// GovernanceProposalAPIResponse holds a governance proposal along with its vote tallies and quorum progress. The
+// required quorum is computed against the current total stake
+type GovernanceProposalAPIResponse struct {
+	Nonce          uint64  `json:"nonce"`
+	CommitHash     string  `json:"commitHash"`
+	Issuer         string  `json:"issuer"`
+	Status         string  `json:"status"`
+	StartVoteEpoch uint64  `json:"startVoteEpoch"`
+	EndVoteEpoch   uint64  `json:"endVoteEpoch"`
+	Closed         bool    `json:"closed"`
+	Passed         bool    `json:"passed"`
+	ProposalCost   string  `json:"proposalCost"`
+	Yes            string  `json:"yes"`
+	No             string  `json:"no"`
+	Veto           string  `json:"veto"`
+	Abstain        string  `json:"abstain"`
+	TotalVotes     string  `json:"totalVotes"`
+	QuorumStake    string  `json:"quorumStake"`
+	RequiredQuorum string  `json:"requiredQuorum"`
+	QuorumProgress float64 `json:"quorumProgress"`
+}
+
+// GovernanceProposalsAPIResponse holds all the governance proposals to be returned on API calls
+type GovernanceProposalsAPIResponse struct {
+	CurrentEpoch uint32                           `json:"currentEpoch"`
+	TotalStake   string                           `json:"totalStake"`
+	Config       *GovernanceConfigAPIResponse     `json:"config"`
+	Proposals    []*GovernanceProposalAPIResponse `json:"proposals"`
+}
+
+// GovernanceVoteAPIResponse holds a proposal an address voted on. The governance contract does not store the vote
+// option, only the proposal
+type GovernanceVoteAPIResponse struct {
+	ProposalNonce uint64 `json:"proposalNonce"`
+	CommitHash    string `json:"commitHash"`
+	Status        string `json:"status"`
+}
+
+// GovernanceDelegatedVotingPowerAPIResponse holds the voting power a delegation contract has, and has already used,
+// on behalf of its delegators for a proposal
+type GovernanceDelegatedVotingPowerAPIResponse struct {
+	ProposalNonce uint64 `json:"proposalNonce"`
+	TotalPower    string `json:"totalPower"`
+	UsedPower     string `json:"usedPower"`
+	TotalStake    string `json:"totalStake"`
+	UsedStake     string `json:"usedStake"`
+}
+
+// GovernanceVotesAPIResponse holds the governance vote history and the voting power of an address
+type GovernanceVotesAPIResponse struct {
+	Address              string                                       `json:"address"`
+	VotingPower          string                                       `json:"votingPower"`
+	DirectVotes          []*GovernanceVoteAPIResponse                 `json:"directVotes"`
+	DelegatedVotes       []*GovernanceVoteAPIResponse                 `json:"delegatedVotes"`
+	DelegatedVotingPower []*GovernanceDelegatedVotingPowerAPIResponse `json:"delegatedVotingPower"`
+}
+
+// DelegationContractConfigAPIResponse holds the configuration of a delegation contract. The service fee is expressed
+// in hundredths of a percent
+type DelegationContractConfigAPIResponse struct {
+	Owner                       string `json:"owner"`
+	ServiceFee                  uint64 `json:"serviceFee"`
+	MaxDelegationCap            string `json:"maxDelegationCap"`
+	InitialOwnerFunds           string `json:"initialOwnerFunds"`
+	AutomaticActivation         bool   `json:"automaticActivation"`
+	WithDelegationCap           bool   `json:"withDelegationCap"`
+	ChangeableServiceFee        bool   `json:"changeableServiceFee"`
+	CheckCapOnReDelegateRewards bool   `json:"checkCapOnReDelegateRewards"`
+	CreatedNonce                uint64 `json:"createdNonce"`
+	UnBondPeriodInEpochs        uint32 `json:"unBondPeriodInEpochs"`
+}
+
+// DelegationNodesAPIResponse holds the BLS keys of the nodes of a delegation contract, grouped by their state
+type DelegationNodesAPIResponse struct {
+	Staked    []string `json:"staked"`
+	NotStaked []string `json:"notStaked"`
+	UnStaked  []string `json:"unStaked"`
+}
+
+// DelegationUnStakedFundAPIResponse holds an undelegated fund along with the number of epochs remaining until it
+// can be withdrawn. A value of 0 means the fund can already be withdrawn
+type DelegationUnStakedFundAPIResponse struct {
+	Value           string `json:"value"`
+	RemainingEpochs uint32 `json:"remainingEpochs"`
+}
+
+// DelegationPositionAPIResponse holds the position of a delegator in a delegation contract. Only one of the
+// delegator and the contract addresses is set, depending on the point of view of the request
+type DelegationPositionAPIResponse struct {
+	Delegator        string                               `json:"delegator,omitempty"`
+	Contract         string                               `json:"contract,omitempty"`
+	ActiveStake      string                               `json:"activeStake"`
+	UnStakedFunds    []*DelegationUnStakedFundAPIResponse `json:"unStakedFunds"`
+	ClaimableRewards string                               `json:"claimableRewards"`
+}
+
+// DelegationContractAPIResponse holds the state of a delegation contract along with a page of its delegators
+type DelegationContractAPIResponse struct {
+	Address          string                               `json:"address"`
+	Config           *DelegationContractConfigAPIResponse `json:"config"`
+	TotalActiveStake string                               `json:"totalActiveStake"`
+	TotalUnStaked    string                               `json:"totalUnStaked"`
+	Nodes            *DelegationNodesAPIResponse          `json:"nodes"`
+	NumDelegators    uint32                               `json:"numDelegators"`
+	Page             uint32                               `json:"page"`
+	PageSize         uint32                               `json:"pageSize"`
+	Delegators       []*DelegationPositionAPIResponse     `json:"delegators"`
+}
+
+// DelegationPositionsAPIResponse holds all the delegation positions of an address, across every delegation contract
+type DelegationPositionsAPIResponse struct {
+	Address               string                           `json:"address"`
+	TotalActiveStake      string                           `json:"totalActiveStake"`
+	TotalClaimableRewards string                           `json:"totalClaimableRewards"`
+	Positions             []*DelegationPositionAPIResponse `json:"positions"`
+}
+
+// ConsensusSubroundTiming holds the moments, relative to the round start, when a consensus subround started and ended
+type ConsensusSubroundTiming struct {
+	Name          string `json:"name"`
+	StartOffsetMs int64  `json:"startOffsetMs"`
+	EndOffsetMs   int64  `json:"endOffsetMs"`
+	Finished      bool   `json:"finished"`
+}
+
+// ConsensusMessageArrival holds the moment, relative to the round start, when a consensus message was received
+// along with its sender
+type ConsensusMessageArrival struct {
+	From     string `json:"from"`
+	OffsetMs int64  `json:"offsetMs"`
+	Hash     string `json:"hash,omitempty"`
+}
+
+// ConsensusRoundTimeline holds the timeline of a consensus round, as seen by the current node
+type ConsensusRoundTimeline struct {
+	Round                 int64                      `json:"round"`
+	ShardID               uint32                     `json:"shardID"`
+	RoundStartTimestampMs int64                      `json:"roundStartTimestampMs"`
+	Subrounds             []*ConsensusSubroundTiming `json:"subrounds"`
+	Header                *ConsensusMessageArrival   `json:"header,omitempty"`
+	Body                  *ConsensusMessageArrival   `json:"body,omitempty"`
+	Signatures            []*ConsensusMessageArrival `json:"signatures"`
+	ProcessingDurationMs  int64                      `json:"processingDurationMs"`
+	Outcome               string                     `json:"outcome"`
+	FailedSubround        string                     `json:"failedSubround,omitempty"`
+}
@@ -194,6 +194,8 @@ type TrieStatisticsHandler interface {
 	AddLeafNode(level int, size uint64, version core.TrieNodeVersion)
 	AddAccountInfo(address string, rootHash []byte)
 
+	GetAddress() string
+	GetRootHash() []byte
 	GetTotalNodesSize() uint64
 	GetTotalNumNodes() uint64
 	GetMaxTrieDepth() uint32
@@ -204,6 +206,7 @@ type TrieStatisticsHandler interface {
 	GetLeafNodesSize() uint64
 	GetNumLeafNodes() uint64
 	GetLeavesMigrationStats() map[core.TrieNodeVersion]uint64
+	GetNodesPerDepth() []*TrieNodesPerDepth
 
 	MergeTriesStatistics(statsToBeMerged TrieStatisticsHandler)
 	ToString() []string
@@ -213,8 +216,13 @@ type TrieStatisticsHandler interface {
 // TriesStatisticsCollector is used to merge the statistics for multiple tries
 type TriesStatisticsCollector interface {
 	Add(trieStats TrieStatisticsHandler, trieType TrieType)
+	IncrementNumAccounts()
 	Print()
 	GetNumNodes() uint64
+	GetNumAccounts() uint64
+	GetNumTries(trieType TrieType) uint64
+	GetTrieStatistics(trieType TrieType) TrieStatisticsHandler
+	GetLargestDataTries() []TrieStatisticsHandler
 }
 
 // StateStatisticsHandler defines the behaviour of a storage statistics handler
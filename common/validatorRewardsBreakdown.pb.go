@@ -0,0 +1,912 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: validatorRewardsBreakdown.proto
+
+package common
+
+import (
+	fmt "fmt"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	reflect "reflect"
+	strings "strings"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// ValidatorRewardsBreakdown holds the rewards of a validator computed in an epoch start block, split by their source.
+// The base and top-up rewards depend on the number of blocks the validator was selected in, which is driven by its
+// rating, while the leader fees are the fees accumulated by the validator as a leader. The total rewards are the ones
+// sent to the reward address. The rewards of the validators without any success in the epoch and the ones of the
+// unsupported metachain reward addresses go to protocol sustainability instead
+type ValidatorRewardsBreakdown struct {
+	Epoch                      uint32 `protobuf:"varint,1,opt,name=Epoch,proto3" json:"epoch"`
+	ShardID                    uint32 `protobuf:"varint,2,opt,name=ShardID,proto3" json:"shardID"`
+	BlsKey                     string `protobuf:"bytes,3,opt,name=BlsKey,proto3" json:"blsKey,omitempty"`
+	RewardAddress              string `protobuf:"bytes,4,opt,name=RewardAddress,proto3" json:"rewardAddress"`
+	NumSelectedInSuccessBlocks uint32 `protobuf:"varint,5,opt,name=NumSelectedInSuccessBlocks,proto3" json:"numSelectedInSuccessBlocks"`
+	TopUpStake                 string `protobuf:"bytes,6,opt,name=TopUpStake,proto3" json:"topUpStake"`
+	BaseReward                 string `protobuf:"bytes,7,opt,name=BaseReward,proto3" json:"baseReward"`
+	TopUpReward                string `protobuf:"bytes,8,opt,name=TopUpReward,proto3" json:"topUpReward"`
+	LeaderFees                 string `protobuf:"bytes,9,opt,name=LeaderFees,proto3" json:"leaderFees"`
+	ProtocolSustainability     string `protobuf:"bytes,10,opt,name=ProtocolSustainability,proto3" json:"protocolSustainability"`
+	TotalRewards               string `protobuf:"bytes,11,opt,name=TotalRewards,proto3" json:"totalRewards"`
+}
+
+func (m *ValidatorRewardsBreakdown) Reset()      { *m = ValidatorRewardsBreakdown{} }
+func (*ValidatorRewardsBreakdown) ProtoMessage() {}
+func (*ValidatorRewardsBreakdown) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f0511dacaeddbd53, []int{0}
+}
+func (m *ValidatorRewardsBreakdown) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValidatorRewardsBreakdown) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *ValidatorRewardsBreakdown) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorRewardsBreakdown.Merge(m, src)
+}
+func (m *ValidatorRewardsBreakdown) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidatorRewardsBreakdown) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorRewardsBreakdown.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidatorRewardsBreakdown proto.InternalMessageInfo
+
+func (m *ValidatorRewardsBreakdown) GetEpoch() uint32 {
+	if m != nil {
+		return m.Epoch
+	}
+	return 0
+}
+
+func (m *ValidatorRewardsBreakdown) GetShardID() uint32 {
+	if m != nil {
+		return m.ShardID
+	}
+	return 0
+}
+
+func (m *ValidatorRewardsBreakdown) GetBlsKey() string {
+	if m != nil {
+		return m.BlsKey
+	}
+	return ""
+}
+
+func (m *ValidatorRewardsBreakdown) GetRewardAddress() string {
+	if m != nil {
+		return m.RewardAddress
+	}
+	return ""
+}
+
+func (m *ValidatorRewardsBreakdown) GetNumSelectedInSuccessBlocks() uint32 {
+	if m != nil {
+		return m.NumSelectedInSuccessBlocks
+	}
+	return 0
+}
+
+func (m *ValidatorRewardsBreakdown) GetTopUpStake() string {
+	if m != nil {
+		return m.TopUpStake
+	}
+	return ""
+}
+
+func (m *ValidatorRewardsBreakdown) GetBaseReward() string {
+	if m != nil {
+		return m.BaseReward
+	}
+	return ""
+}
+
+func (m *ValidatorRewardsBreakdown) GetTopUpReward() string {
+	if m != nil {
+		return m.TopUpReward
+	}
+	return ""
+}
+
+func (m *ValidatorRewardsBreakdown) GetLeaderFees() string {
+	if m != nil {
+		return m.LeaderFees
+	}
+	return ""
+}
+
+func (m *ValidatorRewardsBreakdown) GetProtocolSustainability() string {
+	if m != nil {
+		return m.ProtocolSustainability
+	}
+	return ""
+}
+
+func (m *ValidatorRewardsBreakdown) GetTotalRewards() string {
+	if m != nil {
+		return m.TotalRewards
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterType((*ValidatorRewardsBreakdown)(nil), "proto.ValidatorRewardsBreakdown")
+}
+
+func init() { proto.RegisterFile("validatorRewardsBreakdown.proto", fileDescriptor_f0511dacaeddbd53) }
+
+var fileDescriptor_f0511dacaeddbd53 = []byte{
+	// 466 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x92, 0x41, 0x8b, 0xd3, 0x40,
+	0x18, 0x86, 0x33, 0xba, 0x6d, 0xed, 0x74, 0xab, 0xeb, 0x20, 0xcb, 0xd8, 0xc3, 0xcc, 0x22, 0x08,
+	0x7b, 0x58, 0xbb, 0x88, 0x82, 0x57, 0x0d, 0x2a, 0x2c, 0x8a, 0xc8, 0x64, 0xf5, 0xe0, 0x41, 0x98,
+	0x24, 0x63, 0x1b, 0x9a, 0x64, 0x42, 0x66, 0xe2, 0xd2, 0x9b, 0x3f, 0xc1, 0x9f, 0xe1, 0x4f, 0xf1,
+	0xd8, 0x63, 0x4f, 0xc1, 0xa6, 0x17, 0x99, 0xd3, 0xde, 0xbc, 0x4a, 0x27, 0xb5, 0xcd, 0x82, 0xdd,
+	0x53, 0x32, 0xcf, 0x3c, 0xef, 0xf7, 0xbd, 0x90, 0x40, 0xfa, 0x95, 0xc7, 0x51, 0xc8, 0xb5, 0xcc,
+	0x99, 0xb8, 0xe0, 0x79, 0xa8, 0xdc, 0x5c, 0xf0, 0x49, 0x28, 0x2f, 0xd2, 0x61, 0x96, 0x4b, 0x2d,
+	0x51, 0xcb, 0x3e, 0x06, 0x8f, 0x46, 0x91, 0x1e, 0x17, 0xfe, 0x30, 0x90, 0xc9, 0xe9, 0x48, 0x8e,
+	0xe4, 0xa9, 0xc5, 0x7e, 0xf1, 0xc5, 0x9e, 0xec, 0xc1, 0xbe, 0xd5, 0xa9, 0x07, 0x7f, 0xf6, 0xe0,
+	0xfd, 0x8f, 0xbb, 0x26, 0x23, 0x0a, 0x5b, 0xaf, 0x32, 0x19, 0x8c, 0x31, 0x38, 0x02, 0xc7, 0x7d,
+	0xb7, 0x6b, 0x4a, 0xda, 0x12, 0x2b, 0xc0, 0x6a, 0x8e, 0x1e, 0xc2, 0x8e, 0x37, 0xe6, 0x79, 0x78,
+	0xf6, 0x12, 0xdf, 0xb0, 0x4a, 0xcf, 0x94, 0xb4, 0xa3, 0x6a, 0xc4, 0xfe, 0xdd, 0xa1, 0x13, 0xd8,
+	0x76, 0x63, 0xf5, 0x46, 0x4c, 0xf1, 0xcd, 0x23, 0x70, 0xdc, 0x75, 0xef, 0x99, 0x92, 0x1e, 0xf8,
+	0x96, 0x9c, 0xc8, 0x24, 0xd2, 0x22, 0xc9, 0xf4, 0x94, 0xad, 0x1d, 0xf4, 0x0c, 0xf6, 0xeb, 0x26,
+	0x2f, 0xc2, 0x30, 0x17, 0x4a, 0xe1, 0x3d, 0x1b, 0xba, 0x6b, 0x4a, 0xda, 0xcf, 0x9b, 0x17, 0xec,
+	0xaa, 0x87, 0x3e, 0xc3, 0xc1, 0xbb, 0x22, 0xf1, 0x44, 0x2c, 0x02, 0x2d, 0xc2, 0xb3, 0xd4, 0x2b,
+	0x82, 0x40, 0x28, 0xe5, 0xc6, 0x32, 0x98, 0x28, 0xdc, 0xb2, 0x05, 0x89, 0x29, 0xe9, 0x20, 0xdd,
+	0x69, 0xb1, 0x6b, 0x26, 0xa0, 0x21, 0x84, 0xe7, 0x32, 0xfb, 0x90, 0x79, 0x9a, 0x4f, 0x04, 0x6e,
+	0xdb, 0x56, 0xb7, 0x4d, 0x49, 0xa1, 0xde, 0x50, 0xd6, 0x30, 0x56, 0xbe, 0xcb, 0x95, 0xa8, 0x4b,
+	0xe2, 0xce, 0xd6, 0xf7, 0x37, 0x94, 0x35, 0x0c, 0xf4, 0x18, 0xf6, 0x6c, 0x7a, 0x1d, 0xb8, 0x65,
+	0x03, 0x77, 0x4c, 0x49, 0x7b, 0x7a, 0x8b, 0x59, 0xd3, 0x59, 0xad, 0x78, 0x2b, 0x78, 0x28, 0xf2,
+	0xd7, 0x42, 0x28, 0xdc, 0xdd, 0xae, 0x88, 0x37, 0x94, 0x35, 0x0c, 0xc4, 0xe0, 0xe1, 0xfb, 0xd5,
+	0x87, 0x0f, 0x64, 0xec, 0x15, 0x4a, 0xf3, 0x28, 0xe5, 0x7e, 0x14, 0x47, 0x7a, 0x8a, 0xa1, 0xcd,
+	0x0e, 0x4c, 0x49, 0x0f, 0xb3, 0xff, 0x1a, 0x6c, 0x47, 0x12, 0x3d, 0x85, 0xfb, 0xe7, 0x52, 0xf3,
+	0x78, 0xfd, 0xfb, 0xe0, 0x9e, 0x9d, 0x74, 0x60, 0x4a, 0xba, 0xaf, 0x1b, 0x9c, 0x5d, 0xb1, 0xdc,
+	0xe7, 0xb3, 0x05, 0x71, 0xe6, 0x0b, 0xe2, 0x5c, 0x2e, 0x08, 0xf8, 0x56, 0x11, 0xf0, 0xa3, 0x22,
+	0xe0, 0x67, 0x45, 0xc0, 0xac, 0x22, 0x60, 0x5e, 0x11, 0xf0, 0xab, 0x22, 0xe0, 0x77, 0x45, 0x9c,
+	0xcb, 0x8a, 0x80, 0xef, 0x4b, 0xe2, 0xcc, 0x96, 0xc4, 0x99, 0x2f, 0x89, 0xf3, 0xa9, 0x1d, 0xc8,
+	0x24, 0x91, 0xa9, 0xdf, 0xb6, 0x3d, 0x9f, 0xfc, 0x1d, 0x00, 0xf6, 0xbc, 0x5b, 0x56, 0x1b, 0x03,
+	0x00, 0x00,
+}
+
+func (this *ValidatorRewardsBreakdown) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ValidatorRewardsBreakdown)
+	if !ok {
+		that2, ok := that.(ValidatorRewardsBreakdown)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Epoch != that1.Epoch {
+		return false
+	}
+	if this.ShardID != that1.ShardID {
+		return false
+	}
+	if this.BlsKey != that1.BlsKey {
+		return false
+	}
+	if this.RewardAddress != that1.RewardAddress {
+		return false
+	}
+	if this.NumSelectedInSuccessBlocks != that1.NumSelectedInSuccessBlocks {
+		return false
+	}
+	if this.TopUpStake != that1.TopUpStake {
+		return false
+	}
+	if this.BaseReward != that1.BaseReward {
+		return false
+	}
+	if this.TopUpReward != that1.TopUpReward {
+		return false
+	}
+	if this.LeaderFees != that1.LeaderFees {
+		return false
+	}
+	if this.ProtocolSustainability != that1.ProtocolSustainability {
+		return false
+	}
+	if this.TotalRewards != that1.TotalRewards {
+		return false
+	}
+	return true
+}
+func (this *ValidatorRewardsBreakdown) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 15)
+	s = append(s, "&common.ValidatorRewardsBreakdown{")
+	s = append(s, "Epoch: "+fmt.Sprintf("%#v", this.Epoch)+",\n")
+	s = append(s, "ShardID: "+fmt.Sprintf("%#v", this.ShardID)+",\n")
+	s = append(s, "BlsKey: "+fmt.Sprintf("%#v", this.BlsKey)+",\n")
+	s = append(s, "RewardAddress: "+fmt.Sprintf("%#v", this.RewardAddress)+",\n")
+	s = append(s, "NumSelectedInSuccessBlocks: "+fmt.Sprintf("%#v", this.NumSelectedInSuccessBlocks)+",\n")
+	s = append(s, "TopUpStake: "+fmt.Sprintf("%#v", this.TopUpStake)+",\n")
+	s = append(s, "BaseReward: "+fmt.Sprintf("%#v", this.BaseReward)+",\n")
+	s = append(s, "TopUpReward: "+fmt.Sprintf("%#v", this.TopUpReward)+",\n")
+	s = append(s, "LeaderFees: "+fmt.Sprintf("%#v", this.LeaderFees)+",\n")
+	s = append(s, "ProtocolSustainability: "+fmt.Sprintf("%#v", this.ProtocolSustainability)+",\n")
+	s = append(s, "TotalRewards: "+fmt.Sprintf("%#v", this.TotalRewards)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func valueToGoStringValidatorRewardsBreakdown(v interface{}, typ string) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("func(v %v) *%v { return &v } ( %#v )", typ, typ, pv)
+}
+func (m *ValidatorRewardsBreakdown) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorRewardsBreakdown) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorRewardsBreakdown) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TotalRewards) > 0 {
+		i -= len(m.TotalRewards)
+		copy(dAtA[i:], m.TotalRewards)
+		i = encodeVarintValidatorRewardsBreakdown(dAtA, i, uint64(len(m.TotalRewards)))
+		i--
+		dAtA[i] = 0x5a
+	}
+	if len(m.ProtocolSustainability) > 0 {
+		i -= len(m.ProtocolSustainability)
+		copy(dAtA[i:], m.ProtocolSustainability)
+		i = encodeVarintValidatorRewardsBreakdown(dAtA, i, uint64(len(m.ProtocolSustainability)))
+		i--
+		dAtA[i] = 0x52
+	}
+	if len(m.LeaderFees) > 0 {
+		i -= len(m.LeaderFees)
+		copy(dAtA[i:], m.LeaderFees)
+		i = encodeVarintValidatorRewardsBreakdown(dAtA, i, uint64(len(m.LeaderFees)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if len(m.TopUpReward) > 0 {
+		i -= len(m.TopUpReward)
+		copy(dAtA[i:], m.TopUpReward)
+		i = encodeVarintValidatorRewardsBreakdown(dAtA, i, uint64(len(m.TopUpReward)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.BaseReward) > 0 {
+		i -= len(m.BaseReward)
+		copy(dAtA[i:], m.BaseReward)
+		i = encodeVarintValidatorRewardsBreakdown(dAtA, i, uint64(len(m.BaseReward)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.TopUpStake) > 0 {
+		i -= len(m.TopUpStake)
+		copy(dAtA[i:], m.TopUpStake)
+		i = encodeVarintValidatorRewardsBreakdown(dAtA, i, uint64(len(m.TopUpStake)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.NumSelectedInSuccessBlocks != 0 {
+		i = encodeVarintValidatorRewardsBreakdown(dAtA, i, uint64(m.NumSelectedInSuccessBlocks))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.RewardAddress) > 0 {
+		i -= len(m.RewardAddress)
+		copy(dAtA[i:], m.RewardAddress)
+		i = encodeVarintValidatorRewardsBreakdown(dAtA, i, uint64(len(m.RewardAddress)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.BlsKey) > 0 {
+		i -= len(m.BlsKey)
+		copy(dAtA[i:], m.BlsKey)
+		i = encodeVarintValidatorRewardsBreakdown(dAtA, i, uint64(len(m.BlsKey)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.ShardID != 0 {
+		i = encodeVarintValidatorRewardsBreakdown(dAtA, i, uint64(m.ShardID))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Epoch != 0 {
+		i = encodeVarintValidatorRewardsBreakdown(dAtA, i, uint64(m.Epoch))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintValidatorRewardsBreakdown(dAtA []byte, offset int, v uint64) int {
+	offset -= sovValidatorRewardsBreakdown(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *ValidatorRewardsBreakdown) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Epoch != 0 {
+		n += 1 + sovValidatorRewardsBreakdown(uint64(m.Epoch))
+	}
+	if m.ShardID != 0 {
+		n += 1 + sovValidatorRewardsBreakdown(uint64(m.ShardID))
+	}
+	l = len(m.BlsKey)
+	if l > 0 {
+		n += 1 + l + sovValidatorRewardsBreakdown(uint64(l))
+	}
+	l = len(m.RewardAddress)
+	if l > 0 {
+		n += 1 + l + sovValidatorRewardsBreakdown(uint64(l))
+	}
+	if m.NumSelectedInSuccessBlocks != 0 {
+		n += 1 + sovValidatorRewardsBreakdown(uint64(m.NumSelectedInSuccessBlocks))
+	}
+	l = len(m.TopUpStake)
+	if l > 0 {
+		n += 1 + l + sovValidatorRewardsBreakdown(uint64(l))
+	}
+	l = len(m.BaseReward)
+	if l > 0 {
+		n += 1 + l + sovValidatorRewardsBreakdown(uint64(l))
+	}
+	l = len(m.TopUpReward)
+	if l > 0 {
+		n += 1 + l + sovValidatorRewardsBreakdown(uint64(l))
+	}
+	l = len(m.LeaderFees)
+	if l > 0 {
+		n += 1 + l + sovValidatorRewardsBreakdown(uint64(l))
+	}
+	l = len(m.ProtocolSustainability)
+	if l > 0 {
+		n += 1 + l + sovValidatorRewardsBreakdown(uint64(l))
+	}
+	l = len(m.TotalRewards)
+	if l > 0 {
+		n += 1 + l + sovValidatorRewardsBreakdown(uint64(l))
+	}
+	return n
+}
+
+func sovValidatorRewardsBreakdown(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozValidatorRewardsBreakdown(x uint64) (n int) {
+	return sovValidatorRewardsBreakdown(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (this *ValidatorRewardsBreakdown) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ValidatorRewardsBreakdown{`,
+		`Epoch:` + fmt.Sprintf("%v", this.Epoch) + `,`,
+		`ShardID:` + fmt.Sprintf("%v", this.ShardID) + `,`,
+		`BlsKey:` + fmt.Sprintf("%v", this.BlsKey) + `,`,
+		`RewardAddress:` + fmt.Sprintf("%v", this.RewardAddress) + `,`,
+		`NumSelectedInSuccessBlocks:` + fmt.Sprintf("%v", this.NumSelectedInSuccessBlocks) + `,`,
+		`TopUpStake:` + fmt.Sprintf("%v", this.TopUpStake) + `,`,
+		`BaseReward:` + fmt.Sprintf("%v", this.BaseReward) + `,`,
+		`TopUpReward:` + fmt.Sprintf("%v", this.TopUpReward) + `,`,
+		`LeaderFees:` + fmt.Sprintf("%v", this.LeaderFees) + `,`,
+		`ProtocolSustainability:` + fmt.Sprintf("%v", this.ProtocolSustainability) + `,`,
+		`TotalRewards:` + fmt.Sprintf("%v", this.TotalRewards) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func valueToStringValidatorRewardsBreakdown(v interface{}) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("*%v", pv)
+}
+func (m *ValidatorRewardsBreakdown) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowValidatorRewardsBreakdown
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValidatorRewardsBreakdown: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValidatorRewardsBreakdown: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+			}
+			m.Epoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowValidatorRewardsBreakdown
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Epoch |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ShardID", wireType)
+			}
+			m.ShardID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowValidatorRewardsBreakdown
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ShardID |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlsKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowValidatorRewardsBreakdown
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthValidatorRewardsBreakdown
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthValidatorRewardsBreakdown
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BlsKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowValidatorRewardsBreakdown
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthValidatorRewardsBreakdown
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthValidatorRewardsBreakdown
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RewardAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NumSelectedInSuccessBlocks", wireType)
+			}
+			m.NumSelectedInSuccessBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowValidatorRewardsBreakdown
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NumSelectedInSuccessBlocks |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TopUpStake", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowValidatorRewardsBreakdown
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthValidatorRewardsBreakdown
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthValidatorRewardsBreakdown
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TopUpStake = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseReward", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowValidatorRewardsBreakdown
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthValidatorRewardsBreakdown
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthValidatorRewardsBreakdown
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BaseReward = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TopUpReward", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowValidatorRewardsBreakdown
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthValidatorRewardsBreakdown
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthValidatorRewardsBreakdown
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TopUpReward = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LeaderFees", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowValidatorRewardsBreakdown
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthValidatorRewardsBreakdown
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthValidatorRewardsBreakdown
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LeaderFees = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProtocolSustainability", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowValidatorRewardsBreakdown
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthValidatorRewardsBreakdown
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthValidatorRewardsBreakdown
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProtocolSustainability = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalRewards", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowValidatorRewardsBreakdown
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthValidatorRewardsBreakdown
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthValidatorRewardsBreakdown
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TotalRewards = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipValidatorRewardsBreakdown(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthValidatorRewardsBreakdown
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthValidatorRewardsBreakdown
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipValidatorRewardsBreakdown(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowValidatorRewardsBreakdown
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowValidatorRewardsBreakdown
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowValidatorRewardsBreakdown
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthValidatorRewardsBreakdown
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupValidatorRewardsBreakdown
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthValidatorRewardsBreakdown
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthValidatorRewardsBreakdown        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowValidatorRewardsBreakdown          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupValidatorRewardsBreakdown = fmt.Errorf("proto: unexpected end of group")
+)
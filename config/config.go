@@ -45,6 +45,22 @@ type TrieSyncStorageConfig struct {
 	DB          DBConfig
 }
 
+// TxPoolJournalConfig will map the transactions pool journal configuration
+type TxPoolJournalConfig struct {
+	Enabled                     bool
+	MaxAgeInSeconds             uint64
+	FlushIntervalInMilliseconds uint64
+	PruneIntervalInSeconds      uint64
+	DB                          DBConfig
+}
+
+// TxPoolReplacementConfig will map the replace-by-fee configuration of the transactions pool
+type TxPoolReplacementConfig struct {
+	Enabled                 bool
+	GasPriceBumpPercentage  uint32
+	MaxReplacementsPerNonce uint32
+}
+
 // PubkeyConfig will map the public key configuration
 type PubkeyConfig struct {
 	Length          int
@@ -67,7 +83,23 @@ type MarshalizerConfig struct {
 
 // ConsensusConfig holds the consensus configuration parameters
 type ConsensusConfig struct {
-	Type string
+	Type                    string
+	NumRoundTimelinesToKeep uint32
+	MessagesRecorder        ConsensusMessagesRecorderConfig
+	SignaturesForwarding    ConsensusSignaturesForwardingConfig
+}
+
+// ConsensusMessagesRecorderConfig holds the configuration for the received consensus messages recorder
+type ConsensusMessagesRecorderConfig struct {
+	Enabled         bool
+	Directory       string
+	MaxFileSizeInMB uint64
+}
+
+// ConsensusSignaturesForwardingConfig holds the configuration for sending the signature shares to the leader
+type ConsensusSignaturesForwardingConfig struct {
+	LeaderOnly         bool
+	IncludeBackupPeers bool
 }
 
 // NTPConfig will hold the configuration for NTP queries
@@ -115,6 +147,17 @@ type GatewayMetricsConfig struct {
 	URL string
 }
 
+// TrieStatisticsConfig will hold the configuration for the trie statistics API
+type TrieStatisticsConfig struct {
+	NumCachedRootHashes uint32
+	NumLargestDataTries uint32
+}
+
+// GasPriceSuggestionConfig will hold the configuration for the gas price suggestion API
+type GasPriceSuggestionConfig struct {
+	NumRecentBlocks uint32
+}
+
 // HeartbeatV2Config will hold the configuration for heartbeat v2
 type HeartbeatV2Config struct {
 	PeerAuthenticationTimeBetweenSendsInSec          int64
@@ -179,6 +222,8 @@ type Config struct {
 	SmartContractDataPool       CacheConfig
 	ValidatorInfoPool           CacheConfig
 	TrieSyncStorage             TrieSyncStorageConfig
+	TxPoolJournal               TxPoolJournalConfig
+	TxPoolReplacement           TxPoolReplacementConfig
 	EpochStartConfig            EpochStartConfig
 	AddressPubkeyConverter      PubkeyConfig
 	ValidatorPubkeyConverter    PubkeyConfig
@@ -218,6 +263,8 @@ type Config struct {
 
 	SoftwareVersionConfig SoftwareVersionConfig
 	GatewayMetricsConfig  GatewayMetricsConfig
+	GasPriceSuggestion    GasPriceSuggestionConfig
+	TrieStatistics        TrieStatisticsConfig
 	DbLookupExtensions    DbLookupExtensionsConfig
 	Versions              VersionsConfig
 	Logs                  LogsConfig
@@ -256,6 +303,16 @@ type StoragePruningConfig struct {
 	NumEpochsToKeep                      uint64
 	NumActivePersisters                  uint64
 	FullArchiveNumActivePersisters       uint32
+	ColdArchive                          ColdArchiveConfig
+}
+
+// ColdArchiveConfig will hold settings related to the archiving of old epochs databases on full archive nodes
+type ColdArchiveConfig struct {
+	Enabled                   bool
+	Mode                      string
+	ArchivePath               string
+	NumEpochsToKeepUnarchived uint32
+	NumOpenedArchives         uint32
 }
 
 // ResourceStatsConfig will hold all resource stats settings
@@ -482,6 +539,8 @@ type DbLookupExtensionsConfig struct {
 	ResultsHashesByTxHashStorageConfig StorageConfig
 	ESDTSuppliesStorageConfig          StorageConfig
 	RoundHashStorageConfig             StorageConfig
+	ValidatorRewardsStorageConfig      StorageConfig
+	ValidatorRatingsStorageConfig      StorageConfig
 }
 
 // DebugConfig will hold debugging configuration
@@ -5,6 +5,9 @@ type ExternalConfig struct {
 	ElasticSearchConnector ElasticSearchConfig
 	EventNotifierConnector EventNotifierConfig
 	HostDriversConfig      []HostDriversConfig
+	FileDriverConfig       FileDriverConfig
+	OutportReindexConfig   OutportReindexConfig
+	OutportQueueConfig     OutportQueueConfig
 }
 
 // ElasticSearchConfig will hold the configuration for the elastic search
@@ -51,3 +54,26 @@ type HostDriversConfig struct {
 	AcknowledgeTimeoutInSec    int
 	Version                    uint32
 }
+
+// FileDriverConfig will hold the configuration for the file outport driver
+type FileDriverConfig struct {
+	Enabled             bool
+	Path                string
+	MaxSegmentSizeInMB  uint64
+	RotateOnEpochChange bool
+}
+
+// OutportReindexConfig will hold the configuration for re-indexing historical blocks through the outport drivers
+type OutportReindexConfig struct {
+	Enabled            bool
+	MaxBlocksPerSecond uint32
+	ResumeFilePath     string
+}
+
+// OutportQueueConfig will hold the configuration for the disk-backed queues placed between the outport and its drivers
+type OutportQueueConfig struct {
+	Enabled            bool
+	Path               string
+	MaxQueueSizeInMB   uint64
+	MaxSegmentSizeInMB uint64
+}
@@ -12,6 +12,7 @@ import (
 	"github.com/multiversx/mx-chain-core-go/marshal"
 	crypto "github.com/multiversx/mx-chain-crypto-go"
 	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/config"
 	"github.com/multiversx/mx-chain-go/consensus"
 	"github.com/multiversx/mx-chain-go/consensus/spos"
 	"github.com/multiversx/mx-chain-go/process"
@@ -43,6 +44,8 @@ type commonMessenger struct {
 	peerSignatureHandler    crypto.PeerSignatureHandler
 	delayedBlockBroadcaster delayedBroadcaster
 	keysHandler             consensus.KeysHandler
+	peerIDsProvider         consensus.PeerIDsProvider
+	signaturesForwarding    config.ConsensusSignaturesForwardingConfig
 }
 
 // CommonMessengerArgs holds the arguments for creating commonMessenger instance
@@ -58,6 +61,8 @@ type CommonMessengerArgs struct {
 	MaxValidatorDelayCacheSize uint32
 	AlarmScheduler             core.TimersScheduler
 	KeysHandler                consensus.KeysHandler
+	PeerIDsProvider            consensus.PeerIDsProvider
+	SignaturesForwarding       config.ConsensusSignaturesForwardingConfig
 }
 
 func checkCommonMessengerNilParameters(
@@ -93,33 +98,94 @@ func checkCommonMessengerNilParameters(
 	if check.IfNil(args.KeysHandler) {
 		return ErrNilKeysHandler
 	}
+	if check.IfNil(args.PeerIDsProvider) {
+		return ErrNilPeerIDsProvider
+	}
 
 	return nil
 }
 
 // BroadcastConsensusMessage will send on consensus topic the consensus message
 func (cm *commonMessenger) BroadcastConsensusMessage(message *consensus.Message) error {
-	privateKey := cm.keysHandler.GetHandledPrivateKey(message.PubKey)
-	signature, err := cm.peerSignatureHandler.GetPeerSignature(privateKey, message.OriginatorPid)
+	buff, err := cm.signAndMarshalConsensusMessage(message)
 	if err != nil {
 		return err
 	}
 
-	message.Signature = signature
+	cm.broadcast(cm.consensusTopic(), buff, message.PubKey)
 
-	buff, err := cm.marshalizer.Marshal(message)
+	return nil
+}
+
+// SendConsensusMessageToLeader will send the consensus message directly to the peers of the provided leader, if the
+// signatures forwarding is enabled. Otherwise, or if the leader can not be reached, the message is broadcast on the consensus topic
+func (cm *commonMessenger) SendConsensusMessageToLeader(message *consensus.Message, leaderPubKey []byte) error {
+	buff, err := cm.signAndMarshalConsensusMessage(message)
 	if err != nil {
 		return err
 	}
 
-	consensusTopic := common.ConsensusTopic +
-		cm.shardCoordinator.CommunicationIdentifier(cm.shardCoordinator.SelfId())
+	consensusTopic := cm.consensusTopic()
+	if cm.sendToLeader(consensusTopic, buff, message.PubKey, leaderPubKey) {
+		return nil
+	}
 
 	cm.broadcast(consensusTopic, buff, message.PubKey)
 
 	return nil
 }
 
+func (cm *commonMessenger) signAndMarshalConsensusMessage(message *consensus.Message) ([]byte, error) {
+	privateKey := cm.keysHandler.GetHandledPrivateKey(message.PubKey)
+	signature, err := cm.peerSignatureHandler.GetPeerSignature(privateKey, message.OriginatorPid)
+	if err != nil {
+		return nil, err
+	}
+
+	message.Signature = signature
+
+	return cm.marshalizer.Marshal(message)
+}
+
+func (cm *commonMessenger) consensusTopic() string {
+	return common.ConsensusTopic + cm.shardCoordinator.CommunicationIdentifier(cm.shardCoordinator.SelfId())
+}
+
+// sendToLeader returns true if the message was sent to at least one of the leader's peers
+func (cm *commonMessenger) sendToLeader(topic string, buff []byte, pkBytes []byte, leaderPubKey []byte) bool {
+	if !cm.signaturesForwarding.LeaderOnly {
+		return false
+	}
+	// the messages created with a managed key should originate from the peer ID associated with that key,
+	// so they can only be broadcast
+	if !cm.keysHandler.IsOriginalPublicKeyOfTheNode(pkBytes) {
+		return false
+	}
+
+	pids := cm.peerIDsProvider.GetPeerIDs(leaderPubKey)
+	if len(pids) == 0 {
+		log.Debug("commonMessenger.sendToLeader: no known peer for the leader, will broadcast", "leader", leaderPubKey)
+		return false
+	}
+	if !cm.signaturesForwarding.IncludeBackupPeers {
+		pids = pids[:1]
+	}
+
+	sent := false
+	for _, pid := range pids {
+		err := cm.messenger.SendToConnectedPeer(topic, buff, pid)
+		if err != nil {
+			log.Debug("commonMessenger.sendToLeader: can not send to leader's peer",
+				"leader", leaderPubKey, "pid", pid.Pretty(), "error", err)
+			continue
+		}
+
+		sent = true
+	}
+
+	return sent
+}
+
 // BroadcastMiniBlocks will send on miniblocks topic the cross-shard miniblocks
 func (cm *commonMessenger) BroadcastMiniBlocks(miniBlocks map[uint32][]byte, pkBytes []byte) error {
 	for k, v := range miniBlocks {
@@ -9,6 +9,7 @@ import (
 	"github.com/multiversx/mx-chain-core-go/core"
 	"github.com/multiversx/mx-chain-core-go/data/block"
 	"github.com/multiversx/mx-chain-crypto-go"
+	"github.com/multiversx/mx-chain-go/config"
 	"github.com/multiversx/mx-chain-go/consensus"
 	"github.com/multiversx/mx-chain-go/consensus/broadcast"
 	"github.com/multiversx/mx-chain-go/consensus/mock"
@@ -89,6 +90,196 @@ func TestCommonMessenger_BroadcastConsensusMessageShouldWork(t *testing.T) {
 	assert.Nil(t, err)
 }
 
+func TestCommonMessenger_SendConsensusMessageToLeader(t *testing.T) {
+	t.Parallel()
+
+	leaderPkBytes := []byte("leader pk")
+	leaderPid := core.PeerID("leader pid")
+	leaderBackupPid := core.PeerID("leader backup pid")
+	peerIDsProvider := &p2pmocks.NetworkShardingCollectorStub{
+		GetPeerIDsCalled: func(pk []byte) []core.PeerID {
+			if bytes.Equal(pk, leaderPkBytes) {
+				return []core.PeerID{leaderPid, leaderBackupPid}
+			}
+
+			return nil
+		},
+	}
+	peerSigHandler := &mock.PeerSignatureHandler{
+		Signer: &mock.SingleSignerMock{
+			SignStub: func(private crypto.PrivateKey, msg []byte) ([]byte, error) {
+				return []byte(""), nil
+			},
+		},
+	}
+	keysHandler := &testscommon.KeysHandlerStub{
+		IsOriginalPublicKeyOfTheNodeCalled: func(pkBytes []byte) bool {
+			return bytes.Equal(nodePkBytes, pkBytes)
+		},
+	}
+
+	createMessenger := func(
+		messenger consensus.P2PMessenger,
+		signaturesForwarding config.ConsensusSignaturesForwardingConfig,
+	) interface {
+		SendConsensusMessageToLeader(message *consensus.Message, leaderPubKey []byte) error
+	} {
+		cm, _ := broadcast.NewCommonMessenger(
+			&mock.MarshalizerMock{},
+			messenger,
+			&mock.ShardCoordinatorMock{},
+			peerSigHandler,
+			keysHandler,
+		)
+		cm.SetSignaturesForwarding(peerIDsProvider, signaturesForwarding)
+
+		return cm
+	}
+
+	t.Run("signing error should error", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		cm, _ := broadcast.NewCommonMessenger(
+			&mock.MarshalizerMock{},
+			&p2pmocks.MessengerStub{},
+			&mock.ShardCoordinatorMock{},
+			&mock.PeerSignatureHandler{
+				Signer: &mock.SingleSignerMock{
+					SignStub: func(private crypto.PrivateKey, msg []byte) ([]byte, error) {
+						return nil, expectedErr
+					},
+				},
+			},
+			keysHandler,
+		)
+
+		err := cm.SendConsensusMessageToLeader(&consensus.Message{}, leaderPkBytes)
+		assert.Equal(t, expectedErr, err)
+	})
+	t.Run("forwarding disabled should broadcast", func(t *testing.T) {
+		t.Parallel()
+
+		numBroadcast := 0
+		messenger := &p2pmocks.MessengerStub{
+			BroadcastCalled: func(topic string, buff []byte) {
+				numBroadcast++
+			},
+			SendToConnectedPeerCalled: func(topic string, buff []byte, peerID core.PeerID) error {
+				assert.Fail(t, "should have not called SendToConnectedPeer")
+				return nil
+			},
+		}
+		cm := createMessenger(messenger, config.ConsensusSignaturesForwardingConfig{})
+
+		err := cm.SendConsensusMessageToLeader(&consensus.Message{PubKey: nodePkBytes}, leaderPkBytes)
+		assert.Nil(t, err)
+		assert.Equal(t, 1, numBroadcast)
+	})
+	t.Run("managed key should broadcast", func(t *testing.T) {
+		t.Parallel()
+
+		numBroadcast := 0
+		messenger := &p2pmocks.MessengerStub{
+			BroadcastUsingPrivateKeyCalled: func(topic string, buff []byte, pid core.PeerID, skBytes []byte) {
+				numBroadcast++
+			},
+			SendToConnectedPeerCalled: func(topic string, buff []byte, peerID core.PeerID) error {
+				assert.Fail(t, "should have not called SendToConnectedPeer")
+				return nil
+			},
+		}
+		cm := createMessenger(messenger, config.ConsensusSignaturesForwardingConfig{LeaderOnly: true})
+
+		err := cm.SendConsensusMessageToLeader(&consensus.Message{PubKey: []byte("managed pk")}, leaderPkBytes)
+		assert.Nil(t, err)
+		assert.Equal(t, 1, numBroadcast)
+	})
+	t.Run("unknown leader peer should broadcast", func(t *testing.T) {
+		t.Parallel()
+
+		numBroadcast := 0
+		messenger := &p2pmocks.MessengerStub{
+			BroadcastCalled: func(topic string, buff []byte) {
+				numBroadcast++
+			},
+			SendToConnectedPeerCalled: func(topic string, buff []byte, peerID core.PeerID) error {
+				assert.Fail(t, "should have not called SendToConnectedPeer")
+				return nil
+			},
+		}
+		cm := createMessenger(messenger, config.ConsensusSignaturesForwardingConfig{LeaderOnly: true})
+
+		err := cm.SendConsensusMessageToLeader(&consensus.Message{PubKey: nodePkBytes}, []byte("unknown leader"))
+		assert.Nil(t, err)
+		assert.Equal(t, 1, numBroadcast)
+	})
+	t.Run("unreachable leader should broadcast", func(t *testing.T) {
+		t.Parallel()
+
+		numBroadcast := 0
+		numSends := 0
+		messenger := &p2pmocks.MessengerStub{
+			BroadcastCalled: func(topic string, buff []byte) {
+				numBroadcast++
+			},
+			SendToConnectedPeerCalled: func(topic string, buff []byte, peerID core.PeerID) error {
+				numSends++
+				return errors.New("peer not connected")
+			},
+		}
+		cm := createMessenger(messenger, config.ConsensusSignaturesForwardingConfig{LeaderOnly: true, IncludeBackupPeers: true})
+
+		err := cm.SendConsensusMessageToLeader(&consensus.Message{PubKey: nodePkBytes}, leaderPkBytes)
+		assert.Nil(t, err)
+		assert.Equal(t, 2, numSends)
+		assert.Equal(t, 1, numBroadcast)
+	})
+	t.Run("should send only to the last known leader peer", func(t *testing.T) {
+		t.Parallel()
+
+		sentTo := make([]core.PeerID, 0)
+		messenger := &p2pmocks.MessengerStub{
+			BroadcastCalled: func(topic string, buff []byte) {
+				assert.Fail(t, "should have not called Broadcast")
+			},
+			SendToConnectedPeerCalled: func(topic string, buff []byte, peerID core.PeerID) error {
+				assert.Equal(t, "consensus_0", topic)
+				sentTo = append(sentTo, peerID)
+				return nil
+			},
+		}
+		cm := createMessenger(messenger, config.ConsensusSignaturesForwardingConfig{LeaderOnly: true})
+
+		err := cm.SendConsensusMessageToLeader(&consensus.Message{PubKey: nodePkBytes}, leaderPkBytes)
+		assert.Nil(t, err)
+		assert.Equal(t, []core.PeerID{leaderPid}, sentTo)
+	})
+	t.Run("should send to the leader backup peers if one send works", func(t *testing.T) {
+		t.Parallel()
+
+		sentTo := make([]core.PeerID, 0)
+		messenger := &p2pmocks.MessengerStub{
+			BroadcastCalled: func(topic string, buff []byte) {
+				assert.Fail(t, "should have not called Broadcast")
+			},
+			SendToConnectedPeerCalled: func(topic string, buff []byte, peerID core.PeerID) error {
+				if peerID == leaderPid {
+					return errors.New("peer not connected")
+				}
+
+				sentTo = append(sentTo, peerID)
+				return nil
+			},
+		}
+		cm := createMessenger(messenger, config.ConsensusSignaturesForwardingConfig{LeaderOnly: true, IncludeBackupPeers: true})
+
+		err := cm.SendConsensusMessageToLeader(&consensus.Message{PubKey: nodePkBytes}, leaderPkBytes)
+		assert.Nil(t, err)
+		assert.Equal(t, []core.PeerID{leaderBackupPid}, sentTo)
+	})
+}
+
 func TestSubroundEndRound_ExtractMiniBlocksAndTransactionsShouldWork(t *testing.T) {
 	t.Parallel()
 
@@ -4,3 +4,6 @@ import "errors"
 
 // ErrNilKeysHandler signals that a nil keys handler was provided
 var ErrNilKeysHandler = errors.New("nil keys handler")
+
+// ErrNilPeerIDsProvider signals that a nil peer IDs provider was provided
+var ErrNilPeerIDsProvider = errors.New("nil peer IDs provider")
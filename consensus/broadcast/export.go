@@ -6,6 +6,7 @@ import (
 	"github.com/multiversx/mx-chain-core-go/data"
 	"github.com/multiversx/mx-chain-core-go/marshal"
 	crypto "github.com/multiversx/mx-chain-crypto-go"
+	"github.com/multiversx/mx-chain-go/config"
 	"github.com/multiversx/mx-chain-go/consensus"
 	"github.com/multiversx/mx-chain-go/sharding"
 )
@@ -184,6 +185,12 @@ func NewCommonMessenger(
 	}, nil
 }
 
+// SetSignaturesForwarding -
+func (cm *commonMessenger) SetSignaturesForwarding(peerIDsProvider consensus.PeerIDsProvider, signaturesForwarding config.ConsensusSignaturesForwardingConfig) {
+	cm.peerIDsProvider = peerIDsProvider
+	cm.signaturesForwarding = signaturesForwarding
+}
+
 // Broadcast -
 func (cm *commonMessenger) Broadcast(topic string, data []byte, pkBytes []byte) {
 	cm.broadcast(topic, data, pkBytes)
@@ -54,6 +54,8 @@ func NewMetaChainMessenger(
 		peerSignatureHandler:    args.PeerSignatureHandler,
 		delayedBlockBroadcaster: dbb,
 		keysHandler:             args.KeysHandler,
+		peerIDsProvider:         args.PeerIDsProvider,
+		signaturesForwarding:    args.SignaturesForwarding,
 	}
 
 	mcm := &metaChainMessenger{
@@ -45,6 +45,7 @@ func createDefaultMetaChainArgs() broadcast.MetaChainMessengerArgs {
 			MaxDelayCacheSize:          2,
 			AlarmScheduler:             alarmScheduler,
 			KeysHandler:                &testscommon.KeysHandlerStub{},
+			PeerIDsProvider:            &p2pmocks.NetworkShardingCollectorStub{},
 		},
 	}
 }
@@ -94,6 +95,15 @@ func TestMetaChainMessenger_NilKeysHandlerShouldError(t *testing.T) {
 	assert.Equal(t, broadcast.ErrNilKeysHandler, err)
 }
 
+func TestMetaChainMessenger_NilPeerIDsProviderShouldError(t *testing.T) {
+	args := createDefaultMetaChainArgs()
+	args.PeerIDsProvider = nil
+	mcm, err := broadcast.NewMetaChainMessenger(args)
+
+	assert.Nil(t, mcm)
+	assert.Equal(t, broadcast.ErrNilPeerIDsProvider, err)
+}
+
 func TestMetaChainMessenger_NewMetaChainMessengerShouldWork(t *testing.T) {
 	args := createDefaultMetaChainArgs()
 	mcm, err := broadcast.NewMetaChainMessenger(args)
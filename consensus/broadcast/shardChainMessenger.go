@@ -43,6 +43,8 @@ func NewShardChainMessenger(
 		shardCoordinator:     args.ShardCoordinator,
 		peerSignatureHandler: args.PeerSignatureHandler,
 		keysHandler:          args.KeysHandler,
+		peerIDsProvider:      args.PeerIDsProvider,
+		signaturesForwarding: args.SignaturesForwarding,
 	}
 
 	dbbArgs := &ArgsDelayedBlockBroadcaster{
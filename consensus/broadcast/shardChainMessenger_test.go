@@ -78,6 +78,7 @@ func createDefaultShardChainArgs() broadcast.ShardChainMessengerArgs {
 			MaxValidatorDelayCacheSize: 1,
 			AlarmScheduler:             alarmScheduler,
 			KeysHandler:                &testscommon.KeysHandlerStub{},
+			PeerIDsProvider:            &p2pmocks.NetworkShardingCollectorStub{},
 		},
 	}
 }
@@ -145,6 +146,15 @@ func TestShardChainMessenger_NilKeysHandlerShouldError(t *testing.T) {
 	assert.Equal(t, broadcast.ErrNilKeysHandler, err)
 }
 
+func TestShardChainMessenger_NilPeerIDsProviderShouldError(t *testing.T) {
+	args := createDefaultShardChainArgs()
+	args.PeerIDsProvider = nil
+	scm, err := broadcast.NewShardChainMessenger(args)
+
+	assert.Nil(t, scm)
+	assert.Equal(t, broadcast.ErrNilPeerIDsProvider, err)
+}
+
 func TestShardChainMessenger_NewShardChainMessengerShouldWork(t *testing.T) {
 	args := createDefaultShardChainArgs()
 	scm, err := broadcast.NewShardChainMessenger(args)
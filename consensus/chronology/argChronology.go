@@ -10,9 +10,10 @@ import (
 
 // ArgChronology holds all dependencies required by the chronology component
 type ArgChronology struct {
-	GenesisTime      time.Time
-	RoundHandler     consensus.RoundHandler
-	SyncTimer        ntp.SyncTimer
-	Watchdog         core.WatchdogTimer
-	AppStatusHandler core.AppStatusHandler
+	GenesisTime           time.Time
+	RoundHandler          consensus.RoundHandler
+	SyncTimer             ntp.SyncTimer
+	Watchdog              core.WatchdogTimer
+	AppStatusHandler      core.AppStatusHandler
+	RoundTimelineRecorder consensus.RoundTimelineRecorder
 }
@@ -42,6 +42,8 @@ type chronology struct {
 	appStatusHandler core.AppStatusHandler
 	cancelFunc       func()
 
+	roundTimelineRecorder consensus.RoundTimelineRecorder
+
 	watchdog core.WatchdogTimer
 }
 
@@ -54,11 +56,12 @@ func NewChronology(arg ArgChronology) (*chronology, error) {
 	}
 
 	chr := chronology{
-		genesisTime:      arg.GenesisTime,
-		roundHandler:     arg.RoundHandler,
-		syncTimer:        arg.SyncTimer,
-		appStatusHandler: arg.AppStatusHandler,
-		watchdog:         arg.Watchdog,
+		genesisTime:           arg.GenesisTime,
+		roundHandler:          arg.RoundHandler,
+		syncTimer:             arg.SyncTimer,
+		appStatusHandler:      arg.AppStatusHandler,
+		watchdog:              arg.Watchdog,
+		roundTimelineRecorder: arg.RoundTimelineRecorder,
 	}
 
 	chr.subroundId = srBeforeStartRound
@@ -83,6 +86,9 @@ func checkNewChronologyParams(arg ArgChronology) error {
 	if check.IfNil(arg.AppStatusHandler) {
 		return ErrNilAppStatusHandler
 	}
+	if check.IfNil(arg.RoundTimelineRecorder) {
+		return ErrNilRoundTimelineRecorder
+	}
 
 	return nil
 }
@@ -149,12 +155,20 @@ func (chr *chronology) startRound(ctx context.Context) {
 	log.Debug(display.Headline(msg, chr.syncTimer.FormattedCurrentTime(), "."))
 	logger.SetCorrelationSubround(sr.Name())
 
+	roundIndex := chr.roundHandler.Index()
+	chr.roundTimelineRecorder.SubroundStarted(roundIndex, sr.Name())
 	if !sr.DoWork(ctx, chr.roundHandler) {
+		chr.roundTimelineRecorder.SubroundEnded(roundIndex, sr.Name(), false)
+		chr.roundTimelineRecorder.RoundEnded(roundIndex, false, sr.Name())
 		chr.subroundId = srBeforeStartRound
 		return
 	}
 
+	chr.roundTimelineRecorder.SubroundEnded(roundIndex, sr.Name(), true)
 	chr.subroundId = sr.Next()
+	if chr.loadSubroundHandler(chr.subroundId) == nil {
+		chr.roundTimelineRecorder.RoundEnded(roundIndex, true, "")
+	}
 }
 
 // updateRound updates rounds and subrounds depending on the current time and the finished tasks
@@ -167,6 +181,7 @@ func (chr *chronology) updateRound() {
 		msg := fmt.Sprintf("ROUND %d BEGINS (%d)", chr.roundHandler.Index(), chr.roundHandler.TimeStamp().Unix())
 		log.Debug(display.Headline(msg, chr.syncTimer.FormattedCurrentTime(), "#"))
 		logger.SetCorrelationRound(chr.roundHandler.Index())
+		chr.roundTimelineRecorder.RoundStarted(chr.roundHandler.Index(), chr.roundHandler.TimeStamp())
 
 		chr.initRound()
 	}
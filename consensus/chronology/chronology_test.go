@@ -8,6 +8,7 @@ import (
 	"github.com/multiversx/mx-chain-go/consensus"
 	"github.com/multiversx/mx-chain-go/consensus/chronology"
 	"github.com/multiversx/mx-chain-go/consensus/mock"
+	consensusMocks "github.com/multiversx/mx-chain-go/testscommon/consensus"
 	statusHandlerMock "github.com/multiversx/mx-chain-go/testscommon/statusHandler"
 	"github.com/stretchr/testify/assert"
 )
@@ -73,6 +74,17 @@ func TestChronology_NewChronologyNilAppStatusHandlerShouldFail(t *testing.T) {
 	assert.Equal(t, err, chronology.ErrNilAppStatusHandler)
 }
 
+func TestChronology_NewChronologyNilRoundTimelineRecorderShouldFail(t *testing.T) {
+	t.Parallel()
+
+	arg := getDefaultChronologyArg()
+	arg.RoundTimelineRecorder = nil
+	chr, err := chronology.NewChronology(arg)
+
+	assert.Nil(t, chr)
+	assert.Equal(t, err, chronology.ErrNilRoundTimelineRecorder)
+}
+
 func TestChronology_NewChronologyShouldWork(t *testing.T) {
 	t.Parallel()
 
@@ -182,6 +194,109 @@ func TestChronology_StartRoundShouldWork(t *testing.T) {
 	assert.Equal(t, srm.Next(), chr.SubroundId())
 }
 
+func TestChronology_StartRoundShouldRecordTheRoundTimeline(t *testing.T) {
+	t.Parallel()
+
+	t.Run("subround fails should record a failed round", func(t *testing.T) {
+		t.Parallel()
+
+		arg := getDefaultChronologyArg()
+		roundHandlerMock := &mock.RoundHandlerMock{}
+		roundHandlerMock.UpdateRound(roundHandlerMock.TimeStamp(), roundHandlerMock.TimeStamp().Add(roundHandlerMock.TimeDuration()))
+		arg.RoundHandler = roundHandlerMock
+		subroundEnded := false
+		roundEnded := false
+		arg.RoundTimelineRecorder = &consensusMocks.RoundTimelineRecorderStub{
+			SubroundEndedCalled: func(round int64, subroundName string, finished bool) {
+				assert.Equal(t, roundHandlerMock.Index(), round)
+				assert.Equal(t, "(TEST)", subroundName)
+				assert.False(t, finished)
+				subroundEnded = true
+			},
+			RoundEndedCalled: func(round int64, completed bool, failedSubround string) {
+				assert.Equal(t, roundHandlerMock.Index(), round)
+				assert.False(t, completed)
+				assert.Equal(t, "(TEST)", failedSubround)
+				roundEnded = true
+			},
+		}
+		chr, _ := chronology.NewChronology(arg)
+
+		srm := initSubroundHandlerMock()
+		chr.AddSubround(srm)
+		chr.SetSubroundId(0)
+		chr.StartRound()
+
+		assert.True(t, subroundEnded)
+		assert.True(t, roundEnded)
+	})
+	t.Run("last subround finishes should record a completed round", func(t *testing.T) {
+		t.Parallel()
+
+		arg := getDefaultChronologyArg()
+		roundHandlerMock := &mock.RoundHandlerMock{}
+		roundHandlerMock.UpdateRound(roundHandlerMock.TimeStamp(), roundHandlerMock.TimeStamp().Add(roundHandlerMock.TimeDuration()))
+		arg.RoundHandler = roundHandlerMock
+		subroundStarted := false
+		roundEnded := false
+		arg.RoundTimelineRecorder = &consensusMocks.RoundTimelineRecorderStub{
+			SubroundStartedCalled: func(round int64, subroundName string) {
+				assert.Equal(t, "(TEST)", subroundName)
+				subroundStarted = true
+			},
+			RoundEndedCalled: func(round int64, completed bool, failedSubround string) {
+				assert.True(t, completed)
+				assert.Empty(t, failedSubround)
+				roundEnded = true
+			},
+		}
+		chr, _ := chronology.NewChronology(arg)
+
+		srm := initSubroundHandlerMock()
+		srm.DoWorkCalled = func(roundHandler consensus.RoundHandler) bool {
+			return true
+		}
+		srm.NextCalled = func() int {
+			return -1
+		}
+		chr.AddSubround(srm)
+		chr.SetSubroundId(0)
+		chr.StartRound()
+
+		assert.True(t, subroundStarted)
+		assert.True(t, roundEnded)
+	})
+	t.Run("intermediate subround finishes should not end the round", func(t *testing.T) {
+		t.Parallel()
+
+		arg := getDefaultChronologyArg()
+		roundHandlerMock := &mock.RoundHandlerMock{}
+		roundHandlerMock.UpdateRound(roundHandlerMock.TimeStamp(), roundHandlerMock.TimeStamp().Add(roundHandlerMock.TimeDuration()))
+		arg.RoundHandler = roundHandlerMock
+		arg.RoundTimelineRecorder = &consensusMocks.RoundTimelineRecorderStub{
+			RoundEndedCalled: func(round int64, completed bool, failedSubround string) {
+				assert.Fail(t, "should have not been called")
+			},
+		}
+		chr, _ := chronology.NewChronology(arg)
+
+		srm := initSubroundHandlerMock()
+		srm.DoWorkCalled = func(roundHandler consensus.RoundHandler) bool {
+			return true
+		}
+		nextSrm := initSubroundHandlerMock()
+		nextSrm.CurrentCalled = func() int {
+			return 1
+		}
+		chr.AddSubround(srm)
+		chr.AddSubround(nextSrm)
+		chr.SetSubroundId(0)
+		chr.StartRound()
+
+		assert.Equal(t, 1, chr.SubroundId())
+	})
+}
+
 func TestChronology_UpdateRoundShouldInitRound(t *testing.T) {
 	t.Parallel()
 
@@ -314,10 +429,11 @@ func TestChronology_CheckIfStatusHandlerWorks(t *testing.T) {
 
 func getDefaultChronologyArg() chronology.ArgChronology {
 	return chronology.ArgChronology{
-		GenesisTime:      time.Now(),
-		RoundHandler:     &mock.RoundHandlerMock{},
-		SyncTimer:        &mock.SyncTimerMock{},
-		AppStatusHandler: statusHandlerMock.NewAppStatusHandlerMock(),
-		Watchdog:         &mock.WatchdogMock{},
+		GenesisTime:           time.Now(),
+		RoundHandler:          &mock.RoundHandlerMock{},
+		SyncTimer:             &mock.SyncTimerMock{},
+		AppStatusHandler:      statusHandlerMock.NewAppStatusHandlerMock(),
+		Watchdog:              &mock.WatchdogMock{},
+		RoundTimelineRecorder: &consensusMocks.RoundTimelineRecorderStub{},
 	}
 }
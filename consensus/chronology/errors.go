@@ -13,5 +13,8 @@ var ErrNilSyncTimer = errors.New("sync timer is nil")
 // ErrNilAppStatusHandler is raised when the AppStatusHandler is nil when setting it
 var ErrNilAppStatusHandler = errors.New("nil AppStatusHandler")
 
+// ErrNilRoundTimelineRecorder signals that a nil round timeline recorder has been provided
+var ErrNilRoundTimelineRecorder = errors.New("nil round timeline recorder")
+
 // ErrNilWatchdog signals that a nil watchdog has been provided
 var ErrNilWatchdog = errors.New("nil watchdog")
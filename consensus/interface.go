@@ -7,6 +7,7 @@ import (
 	"github.com/multiversx/mx-chain-core-go/core"
 	"github.com/multiversx/mx-chain-core-go/data"
 	crypto "github.com/multiversx/mx-chain-crypto-go"
+	"github.com/multiversx/mx-chain-go/common"
 	"github.com/multiversx/mx-chain-go/p2p"
 )
 
@@ -85,6 +86,28 @@ type ChronologyHandler interface {
 	IsInterfaceNil() bool
 }
 
+// RoundTimelineRecorder defines the behaviour of a component able to record the timeline of the consensus rounds
+type RoundTimelineRecorder interface {
+	RoundStarted(round int64, roundTimeStamp time.Time)
+	SubroundStarted(round int64, subroundName string)
+	SubroundEnded(round int64, subroundName string, finished bool)
+	HeaderReceived(round int64, pubKey []byte, headerHash []byte)
+	BodyReceived(round int64, pubKey []byte)
+	SignatureReceived(round int64, pubKey []byte)
+	BlockProcessed(round int64, duration time.Duration)
+	RoundEnded(round int64, completed bool, failedSubround string)
+	GetLastRounds(numRounds uint32) []*common.ConsensusRoundTimeline
+	Close() error
+	IsInterfaceNil() bool
+}
+
+// ReceivedMessagesRecorder defines the behaviour of a component able to persist the received consensus messages
+type ReceivedMessagesRecorder interface {
+	RecordReceivedMessage(message p2p.MessageP2P, fromConnectedPeer core.PeerID, localRound int64, receivedTime time.Time)
+	Close() error
+	IsInterfaceNil() bool
+}
+
 // BroadcastMessenger defines the behaviour of the broadcast messages by the consensus group
 type BroadcastMessenger interface {
 	BroadcastBlock(data.BodyHandler, data.HeaderHandler) error
@@ -92,6 +115,7 @@ type BroadcastMessenger interface {
 	BroadcastMiniBlocks(map[uint32][]byte, []byte) error
 	BroadcastTransactions(map[string][][]byte, []byte) error
 	BroadcastConsensusMessage(*Message) error
+	SendConsensusMessageToLeader(message *Message, leaderPubKey []byte) error
 	BroadcastBlockDataLeader(header data.HeaderHandler, miniBlocks map[uint32][]byte, transactions map[string][][]byte, pkBytes []byte) error
 	PrepareBroadcastHeaderValidator(header data.HeaderHandler, miniBlocks map[uint32][]byte, transactions map[string][][]byte, idx int, pkBytes []byte)
 	PrepareBroadcastBlockDataValidator(header data.HeaderHandler, miniBlocks map[uint32][]byte, transactions map[string][][]byte, idx int, pkBytes []byte)
@@ -102,6 +126,13 @@ type BroadcastMessenger interface {
 type P2PMessenger interface {
 	Broadcast(topic string, buff []byte)
 	BroadcastUsingPrivateKey(topic string, buff []byte, pid core.PeerID, skBytes []byte)
+	SendToConnectedPeer(topic string, buff []byte, peerID core.PeerID) error
+	IsInterfaceNil() bool
+}
+
+// PeerIDsProvider is able to provide the peer IDs known for a public key
+type PeerIDsProvider interface {
+	GetPeerIDs(pk []byte) []core.PeerID
 	IsInterfaceNil() bool
 }
 
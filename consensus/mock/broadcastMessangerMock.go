@@ -14,6 +14,7 @@ type BroadcastMessengerMock struct {
 	BroadcastMiniBlocksCalled                func(map[uint32][]byte, []byte) error
 	BroadcastTransactionsCalled              func(map[string][][]byte, []byte) error
 	BroadcastConsensusMessageCalled          func(*consensus.Message) error
+	SendConsensusMessageToLeaderCalled       func(message *consensus.Message, leaderPubKey []byte) error
 	BroadcastBlockDataLeaderCalled           func(h data.HeaderHandler, mbs map[uint32][]byte, txs map[string][][]byte, pkBytes []byte) error
 }
 
@@ -106,6 +107,14 @@ func (bmm *BroadcastMessengerMock) BroadcastConsensusMessage(message *consensus.
 	return nil
 }
 
+// SendConsensusMessageToLeader -
+func (bmm *BroadcastMessengerMock) SendConsensusMessageToLeader(message *consensus.Message, leaderPubKey []byte) error {
+	if bmm.SendConsensusMessageToLeaderCalled != nil {
+		return bmm.SendConsensusMessageToLeaderCalled(message, leaderPubKey)
+	}
+	return nil
+}
+
 // BroadcastHeader -
 func (bmm *BroadcastMessengerMock) BroadcastHeader(headerhandler data.HeaderHandler, pkBytes []byte) error {
 	if bmm.BroadcastHeaderCalled != nil {
@@ -38,6 +38,7 @@ type ConsensusCoreMock struct {
 	messageSigningHandler   consensus.P2PSigningHandler
 	peerBlacklistHandler    consensus.PeerBlacklistHandler
 	signingHandler          consensus.SigningHandler
+	roundTimelineRecorder   consensus.RoundTimelineRecorder
 }
 
 // GetAntiFloodHandler -
@@ -240,6 +241,16 @@ func (ccm *ConsensusCoreMock) SetSigningHandler(signingHandler consensus.Signing
 	ccm.signingHandler = signingHandler
 }
 
+// RoundTimelineRecorder -
+func (ccm *ConsensusCoreMock) RoundTimelineRecorder() consensus.RoundTimelineRecorder {
+	return ccm.roundTimelineRecorder
+}
+
+// SetRoundTimelineRecorder -
+func (ccm *ConsensusCoreMock) SetRoundTimelineRecorder(roundTimelineRecorder consensus.RoundTimelineRecorder) {
+	ccm.roundTimelineRecorder = roundTimelineRecorder
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (ccm *ConsensusCoreMock) IsInterfaceNil() bool {
 	return ccm == nil
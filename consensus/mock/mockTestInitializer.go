@@ -206,6 +206,7 @@ func InitConsensusCoreWithMultiSigner(multiSigner crypto.MultiSigner) *Consensus
 	peerBlacklistHandler := &PeerBlacklistHandlerStub{}
 	multiSignerContainer := cryptoMocks.NewMultiSignerContainerMock(multiSigner)
 	signingHandler := &consensusMocks.SigningHandlerStub{}
+	roundTimelineRecorder := &consensusMocks.RoundTimelineRecorderStub{}
 
 	container := &ConsensusCoreMock{
 		blockChain:              blockChain,
@@ -231,6 +232,7 @@ func InitConsensusCoreWithMultiSigner(multiSigner crypto.MultiSigner) *Consensus
 		messageSigningHandler:   messageSigningHandler,
 		peerBlacklistHandler:    peerBlacklistHandler,
 		signingHandler:          signingHandler,
+		roundTimelineRecorder:   roundTimelineRecorder,
 	}
 
 	return container
@@ -0,0 +1,30 @@
+package disabled
+
+import (
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-go/p2p"
+)
+
+type messagesRecorder struct {
+}
+
+// NewDisabledMessagesRecorder creates a new disabled received consensus messages recorder
+func NewDisabledMessagesRecorder() *messagesRecorder {
+	return &messagesRecorder{}
+}
+
+// RecordReceivedMessage does nothing
+func (mr *messagesRecorder) RecordReceivedMessage(_ p2p.MessageP2P, _ core.PeerID, _ int64, _ time.Time) {
+}
+
+// Close returns nil
+func (mr *messagesRecorder) Close() error {
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (mr *messagesRecorder) IsInterfaceNil() bool {
+	return mr == nil
+}
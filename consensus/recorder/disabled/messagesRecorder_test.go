@@ -0,0 +1,35 @@
+package disabled
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-go/testscommon/p2pmocks"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewDisabledMessagesRecorder(t *testing.T) {
+	t.Parallel()
+
+	recorder := NewDisabledMessagesRecorder()
+	assert.False(t, check.IfNil(recorder))
+}
+
+func TestMessagesRecorder_MethodsShouldNotPanic(t *testing.T) {
+	t.Parallel()
+
+	defer func() {
+		r := recover()
+		if r != nil {
+			assert.Fail(t, fmt.Sprintf("should have not panicked %v", r))
+		}
+	}()
+
+	recorder := NewDisabledMessagesRecorder()
+	recorder.RecordReceivedMessage(&p2pmocks.P2PMessageMock{}, "pid", 1, time.Now())
+	recorder.RecordReceivedMessage(nil, "", 0, time.Time{})
+
+	assert.Nil(t, recorder.Close())
+}
@@ -0,0 +1,12 @@
+package recorder
+
+import "errors"
+
+// ErrEmptyDirectory signals that an empty directory was provided
+var ErrEmptyDirectory = errors.New("empty directory")
+
+// ErrInvalidMaxFileSize signals that an invalid maximum file size was provided
+var ErrInvalidMaxFileSize = errors.New("invalid maximum file size")
+
+// ErrIncompleteRecording signals that some received messages were dropped while recording
+var ErrIncompleteRecording = errors.New("incomplete recording")
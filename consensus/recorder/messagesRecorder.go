@@ -0,0 +1,231 @@
+package recorder
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-go/consensus"
+	"github.com/multiversx/mx-chain-go/p2p"
+	logger "github.com/multiversx/mx-chain-logger-go"
+)
+
+var _ consensus.ReceivedMessagesRecorder = (*messagesRecorder)(nil)
+
+var log = logger.GetOrCreate("consensus/recorder")
+
+const (
+	filePrefix    = "consensus-messages"
+	fileExtension = "json"
+
+	// messagesToRecordBufferSize is the number of received messages waiting to be written. The messages received while
+	// the buffer is full are dropped and a gap marker is written in their place
+	messagesToRecordBufferSize = 1000
+)
+
+// ArgMessagesRecorder holds the arguments needed for creating a new received consensus messages recorder
+type ArgMessagesRecorder struct {
+	Directory          string
+	ShardID            uint32
+	NodeIdentifier     string
+	MaxFileSizeInBytes uint64
+}
+
+type queuedMessage struct {
+	gapMarker *RecordedMessage
+	record    *RecordedMessage
+}
+
+type messagesRecorder struct {
+	mut                sync.Mutex
+	file               *os.File
+	messagesToRecord   chan *queuedMessage
+	gapMarker          *RecordedMessage
+	writerDone         chan struct{}
+	fileSize           uint64
+	maxFileSizeInBytes uint64
+	isFileFull         bool
+	closed             bool
+}
+
+// NewMessagesRecorder creates a recorder that writes each received consensus message, one JSON object per line,
+// in a new file created in the provided directory. The messages are written by a background go routine and the
+// recording stops once the file reaches the maximum size
+func NewMessagesRecorder(args ArgMessagesRecorder) (*messagesRecorder, error) {
+	if len(args.Directory) == 0 {
+		return nil, ErrEmptyDirectory
+	}
+	if args.MaxFileSizeInBytes == 0 {
+		return nil, ErrInvalidMaxFileSize
+	}
+
+	file, err := core.CreateFile(core.ArgCreateFileArgument{
+		Directory:     args.Directory,
+		Prefix:        fmt.Sprintf("%s_%d_%s", filePrefix, args.ShardID, args.NodeIdentifier),
+		FileExtension: fileExtension,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	log.Info("recording the received consensus messages", "file", file.Name(), "max file size", core.ConvertBytes(args.MaxFileSizeInBytes))
+
+	mr := &messagesRecorder{
+		file:               file,
+		messagesToRecord:   make(chan *queuedMessage, messagesToRecordBufferSize),
+		writerDone:         make(chan struct{}),
+		maxFileSizeInBytes: args.MaxFileSizeInBytes,
+	}
+
+	go mr.writeMessages()
+
+	return mr, nil
+}
+
+// RecordReceivedMessage queues the provided message, along with its p2p metadata and the local round and receive time,
+// to be written on disk. The message is dropped if the writer falls behind, the dropped messages being counted in a gap
+// marker written before the next recorded message
+func (mr *messagesRecorder) RecordReceivedMessage(message p2p.MessageP2P, fromConnectedPeer core.PeerID, localRound int64, receivedTime time.Time) {
+	if check.IfNil(message) {
+		return
+	}
+
+	record := newRecordedMessage(message, fromConnectedPeer, localRound, receivedTime)
+
+	mr.mut.Lock()
+	defer mr.mut.Unlock()
+
+	if mr.closed {
+		return
+	}
+
+	select {
+	case mr.messagesToRecord <- &queuedMessage{gapMarker: mr.gapMarker, record: record}:
+		mr.gapMarker = nil
+	default:
+		mr.addDroppedMessage(record)
+	}
+}
+
+// addDroppedMessage counts the dropped message in the pending gap marker, which holds the round and the receive time of
+// the last dropped message
+func (mr *messagesRecorder) addDroppedMessage(record *RecordedMessage) {
+	if mr.gapMarker == nil {
+		mr.gapMarker = newGapMarker(0, 0, 0)
+	}
+
+	mr.gapMarker.NumDroppedMessages++
+	mr.gapMarker.LocalRound = record.LocalRound
+	mr.gapMarker.ReceivedTimestampNs = record.ReceivedTimestampNs
+}
+
+// writeMessages writes the queued messages, away from the go routine handling the received messages
+func (mr *messagesRecorder) writeMessages() {
+	defer close(mr.writerDone)
+
+	for message := range mr.messagesToRecord {
+		if message.gapMarker != nil {
+			mr.writeGapMarker(message.gapMarker)
+		}
+
+		mr.writeRecord(message.record)
+	}
+}
+
+func (mr *messagesRecorder) writeGapMarker(gapMarker *RecordedMessage) {
+	log.Warn("messagesRecorder: the writer is too slow, received consensus messages were dropped",
+		"num dropped", gapMarker.NumDroppedMessages, "last dropped local round", gapMarker.LocalRound)
+
+	mr.writeRecord(gapMarker)
+}
+
+func (mr *messagesRecorder) writeRecord(record *RecordedMessage) {
+	if mr.isFileFull {
+		return
+	}
+
+	recordBytes, err := json.Marshal(record)
+	if err != nil {
+		log.Debug("messagesRecorder.writeRecord", "error", err)
+		return
+	}
+	recordBytes = append(recordBytes, '\n')
+
+	if mr.fileSize+uint64(len(recordBytes)) > mr.maxFileSizeInBytes {
+		mr.isFileFull = true
+		log.Warn("messagesRecorder: the recording file reached its maximum size, the next received consensus messages will not be recorded",
+			"file", mr.file.Name(), "size", core.ConvertBytes(mr.fileSize))
+		return
+	}
+
+	numWritten, err := mr.file.Write(recordBytes)
+	mr.fileSize += uint64(numWritten)
+	if err != nil {
+		log.Debug("messagesRecorder.writeRecord", "error", err)
+	}
+}
+
+// Close writes the queued messages, along with the pending gap marker, and closes the recording file. Messages received afterwards are not recorded anymore
+func (mr *messagesRecorder) Close() error {
+	mr.mut.Lock()
+	if mr.closed {
+		mr.mut.Unlock()
+		return nil
+	}
+	mr.closed = true
+	close(mr.messagesToRecord)
+	mr.mut.Unlock()
+
+	<-mr.writerDone
+
+	if mr.gapMarker != nil {
+		mr.writeGapMarker(mr.gapMarker)
+		mr.gapMarker = nil
+	}
+
+	return mr.file.Close()
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (mr *messagesRecorder) IsInterfaceNil() bool {
+	return mr == nil
+}
+
+// LoadRecordedMessages reads all the messages stored in the provided recording file, in the order they were received.
+// The gap markers are not returned as messages, the dropped messages they mark being counted in the returned recording
+func LoadRecordedMessages(filePath string) (*Recording, error) {
+	file, err := os.Open(filePath)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		_ = file.Close()
+	}()
+
+	recording := &Recording{
+		Messages: make([]*RecordedMessage, 0),
+	}
+	decoder := json.NewDecoder(file)
+	for index := 0; ; index++ {
+		record := &RecordedMessage{}
+		err = decoder.Decode(record)
+		if err == io.EOF {
+			return recording, nil
+		}
+		if err != nil {
+			return nil, fmt.Errorf("%w while decoding the record with index %d", err, index)
+		}
+
+		if record.IsGapMarker() {
+			recording.NumDroppedMessages += record.NumDroppedMessages
+			continue
+		}
+
+		recording.Messages = append(recording.Messages, record)
+	}
+}
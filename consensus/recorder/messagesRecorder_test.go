@@ -0,0 +1,278 @@
+package recorder
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-go/testscommon/p2pmocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createMockArgMessagesRecorder(directory string) ArgMessagesRecorder {
+	return ArgMessagesRecorder{
+		Directory:          directory,
+		ShardID:            1,
+		NodeIdentifier:     "node",
+		MaxFileSizeInBytes: core.MegabyteSize,
+	}
+}
+
+func createMockP2PMessage(data string) *p2pmocks.P2PMessageMock {
+	return &p2pmocks.P2PMessageMock{
+		FromField:            []byte("from"),
+		DataField:            []byte(data),
+		PayloadField:         []byte("payload"),
+		SeqNoField:           []byte{1, 2, 3},
+		TopicField:           "consensus_1",
+		SignatureField:       []byte("signature"),
+		KeyField:             []byte("key"),
+		PeerField:            core.PeerID([]byte{0xff, 0x00, 0xfe}),
+		TimestampField:       1234,
+		BroadcastMethodField: "Broadcast",
+	}
+}
+
+func getRecordingFile(t *testing.T, directory string) string {
+	files, err := filepath.Glob(filepath.Join(directory, filePrefix+"_1_node-*."+fileExtension))
+	require.Nil(t, err)
+	require.Equal(t, 1, len(files))
+
+	return files[0]
+}
+
+func TestNewMessagesRecorder(t *testing.T) {
+	t.Parallel()
+
+	t.Run("empty directory should error", func(t *testing.T) {
+		t.Parallel()
+
+		mr, err := NewMessagesRecorder(createMockArgMessagesRecorder(""))
+		assert.Equal(t, ErrEmptyDirectory, err)
+		assert.True(t, check.IfNil(mr))
+	})
+	t.Run("invalid max file size should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgMessagesRecorder(t.TempDir())
+		args.MaxFileSizeInBytes = 0
+		mr, err := NewMessagesRecorder(args)
+		assert.Equal(t, ErrInvalidMaxFileSize, err)
+		assert.True(t, check.IfNil(mr))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		directory := t.TempDir()
+		mr, err := NewMessagesRecorder(createMockArgMessagesRecorder(directory))
+		assert.Nil(t, err)
+		assert.False(t, check.IfNil(mr))
+		assert.Nil(t, mr.Close())
+
+		_ = getRecordingFile(t, directory)
+	})
+}
+
+func TestMessagesRecorder_RecordReceivedMessage(t *testing.T) {
+	t.Parallel()
+
+	t.Run("recorded messages should be loaded in order", func(t *testing.T) {
+		t.Parallel()
+
+		directory := t.TempDir()
+		mr, _ := NewMessagesRecorder(createMockArgMessagesRecorder(directory))
+
+		firstReceivedTime := time.Unix(100, 5)
+		secondReceivedTime := time.Unix(106, 7)
+		firstMessage := createMockP2PMessage("first")
+		secondMessage := createMockP2PMessage("second")
+		mr.RecordReceivedMessage(firstMessage, "connected peer", 10, firstReceivedTime)
+		mr.RecordReceivedMessage(nil, "connected peer", 10, firstReceivedTime)
+		mr.RecordReceivedMessage(secondMessage, core.PeerID([]byte{0xff}), 11, secondReceivedTime)
+		require.Nil(t, mr.Close())
+
+		recording, err := LoadRecordedMessages(getRecordingFile(t, directory))
+		require.Nil(t, err)
+		assert.True(t, recording.IsComplete())
+		messages := recording.Messages
+		require.Equal(t, 2, len(messages))
+
+		assert.Equal(t, int64(10), messages[0].LocalRound)
+		assert.Equal(t, firstReceivedTime, messages[0].ReceivedTime())
+		assert.Equal(t, core.PeerID("connected peer"), messages[0].ConnectedPeer())
+		assert.Equal(t, firstMessage.DataField, messages[0].P2PMessage().Data())
+
+		assert.Equal(t, int64(11), messages[1].LocalRound)
+		assert.Equal(t, secondReceivedTime, messages[1].ReceivedTime())
+		assert.Equal(t, core.PeerID([]byte{0xff}), messages[1].ConnectedPeer())
+
+		replayedMessage := messages[1].P2PMessage()
+		assert.Equal(t, secondMessage.From(), replayedMessage.From())
+		assert.Equal(t, secondMessage.Data(), replayedMessage.Data())
+		assert.Equal(t, secondMessage.Payload(), replayedMessage.Payload())
+		assert.Equal(t, secondMessage.SeqNo(), replayedMessage.SeqNo())
+		assert.Equal(t, secondMessage.Topic(), replayedMessage.Topic())
+		assert.Equal(t, secondMessage.Signature(), replayedMessage.Signature())
+		assert.Equal(t, secondMessage.Key(), replayedMessage.Key())
+		assert.Equal(t, secondMessage.Peer(), replayedMessage.Peer())
+		assert.Equal(t, secondMessage.Timestamp(), replayedMessage.Timestamp())
+		assert.Equal(t, secondMessage.BroadcastMethod(), replayedMessage.BroadcastMethod())
+	})
+	t.Run("messages received after close should not be recorded", func(t *testing.T) {
+		t.Parallel()
+
+		directory := t.TempDir()
+		mr, _ := NewMessagesRecorder(createMockArgMessagesRecorder(directory))
+
+		mr.RecordReceivedMessage(createMockP2PMessage("first"), "pid", 1, time.Now())
+		require.Nil(t, mr.Close())
+		require.Nil(t, mr.Close())
+		mr.RecordReceivedMessage(createMockP2PMessage("second"), "pid", 2, time.Now())
+
+		recording, err := LoadRecordedMessages(getRecordingFile(t, directory))
+		require.Nil(t, err)
+		assert.True(t, recording.IsComplete())
+		messages := recording.Messages
+		require.Equal(t, 1, len(messages))
+		assert.Equal(t, []byte("first"), messages[0].Data)
+	})
+	t.Run("messages should not be recorded after the file reached the max size", func(t *testing.T) {
+		t.Parallel()
+
+		directory := t.TempDir()
+		args := createMockArgMessagesRecorder(directory)
+		args.MaxFileSizeInBytes = 1000
+		mr, _ := NewMessagesRecorder(args)
+
+		numMessages := 20
+		for i := 0; i < numMessages; i++ {
+			mr.RecordReceivedMessage(createMockP2PMessage("data"), "pid", int64(i), time.Now())
+		}
+		require.Nil(t, mr.Close())
+
+		recordingFile := getRecordingFile(t, directory)
+		fileInfo, err := os.Stat(recordingFile)
+		require.Nil(t, err)
+		assert.LessOrEqual(t, fileInfo.Size(), int64(args.MaxFileSizeInBytes))
+
+		recording, err := LoadRecordedMessages(recordingFile)
+		require.Nil(t, err)
+		messages := recording.Messages
+		require.NotEmpty(t, messages)
+		require.Less(t, len(messages), numMessages)
+		for i, message := range messages {
+			assert.Equal(t, int64(i), message.LocalRound)
+		}
+	})
+	t.Run("messages should be dropped when the buffer is full", func(t *testing.T) {
+		t.Parallel()
+
+		// no writer is started, so the buffer is never drained
+		mr := &messagesRecorder{
+			messagesToRecord: make(chan *queuedMessage, 1),
+		}
+
+		mr.RecordReceivedMessage(createMockP2PMessage("first"), "pid", 1, time.Now())
+		mr.RecordReceivedMessage(createMockP2PMessage("second"), "pid", 2, time.Now())
+		mr.RecordReceivedMessage(createMockP2PMessage("third"), "pid", 3, time.Unix(0, 300))
+
+		require.Equal(t, 1, len(mr.messagesToRecord))
+		message := <-mr.messagesToRecord
+		assert.Nil(t, message.gapMarker)
+		assert.Equal(t, []byte("first"), message.record.Data)
+
+		require.NotNil(t, mr.gapMarker)
+		assert.Equal(t, uint64(2), mr.gapMarker.NumDroppedMessages)
+		assert.Equal(t, int64(3), mr.gapMarker.LocalRound)
+		assert.Equal(t, int64(300), mr.gapMarker.ReceivedTimestampNs)
+
+		mr.RecordReceivedMessage(createMockP2PMessage("fourth"), "pid", 4, time.Now())
+
+		message = <-mr.messagesToRecord
+		assert.Equal(t, uint64(2), message.gapMarker.NumDroppedMessages)
+		assert.Equal(t, []byte("fourth"), message.record.Data)
+		assert.Nil(t, mr.gapMarker)
+	})
+	t.Run("dropped messages should be marked in the recording file", func(t *testing.T) {
+		t.Parallel()
+
+		directory := t.TempDir()
+		mr, _ := NewMessagesRecorder(createMockArgMessagesRecorder(directory))
+		// the messages are dropped as if the buffer was full
+		mr.addDroppedMessage(newRecordedMessage(createMockP2PMessage("dropped"), "pid", 1, time.Now()))
+		mr.RecordReceivedMessage(createMockP2PMessage("first"), "pid", 2, time.Now())
+		mr.addDroppedMessage(newRecordedMessage(createMockP2PMessage("dropped"), "pid", 3, time.Now()))
+		mr.addDroppedMessage(newRecordedMessage(createMockP2PMessage("dropped"), "pid", 3, time.Now()))
+		require.Nil(t, mr.Close())
+
+		recordingFile := getRecordingFile(t, directory)
+		recording, err := LoadRecordedMessages(recordingFile)
+		require.Nil(t, err)
+		assert.False(t, recording.IsComplete())
+		assert.Equal(t, uint64(3), recording.NumDroppedMessages)
+		require.Equal(t, 1, len(recording.Messages))
+		assert.Equal(t, []byte("first"), recording.Messages[0].Data)
+
+		fileContent, err := os.ReadFile(recordingFile)
+		require.Nil(t, err)
+		lines := strings.Split(strings.TrimSpace(string(fileContent)), "\n")
+		require.Equal(t, 3, len(lines))
+		assert.Contains(t, lines[0], `"numDroppedMessages":1`)
+		assert.NotContains(t, lines[1], "numDroppedMessages")
+		assert.Contains(t, lines[2], `"numDroppedMessages":2`)
+	})
+}
+
+func TestLoadRecordedMessages(t *testing.T) {
+	t.Parallel()
+
+	t.Run("missing file should error", func(t *testing.T) {
+		t.Parallel()
+
+		recording, err := LoadRecordedMessages(filepath.Join(t.TempDir(), "missing.json"))
+		assert.NotNil(t, err)
+		assert.Nil(t, recording)
+	})
+	t.Run("malformed file should error", func(t *testing.T) {
+		t.Parallel()
+
+		filePath := filepath.Join(t.TempDir(), "malformed.json")
+		require.Nil(t, os.WriteFile(filePath, []byte(`{"localRound": 1}`+"\n"+`{"localRound": `), 0600))
+
+		recording, err := LoadRecordedMessages(filePath)
+		assert.NotNil(t, err)
+		assert.Contains(t, err.Error(), "index 1")
+		assert.Nil(t, recording)
+	})
+	t.Run("empty file should return no messages", func(t *testing.T) {
+		t.Parallel()
+
+		filePath := filepath.Join(t.TempDir(), "empty.json")
+		require.Nil(t, os.WriteFile(filePath, nil, 0600))
+
+		recording, err := LoadRecordedMessages(filePath)
+		assert.Nil(t, err)
+		assert.Empty(t, recording.Messages)
+		assert.True(t, recording.IsComplete())
+	})
+	t.Run("gap markers should be counted as dropped messages", func(t *testing.T) {
+		t.Parallel()
+
+		filePath := filepath.Join(t.TempDir(), "gaps.json")
+		content := `{"localRound": 1, "numDroppedMessages": 4}` + "\n" + `{"localRound": 2, "data": "AQ=="}` + "\n" + `{"localRound": 3, "numDroppedMessages": 2}`
+		require.Nil(t, os.WriteFile(filePath, []byte(content), 0600))
+
+		recording, err := LoadRecordedMessages(filePath)
+		assert.Nil(t, err)
+		assert.False(t, recording.IsComplete())
+		assert.Equal(t, uint64(6), recording.NumDroppedMessages)
+		require.Equal(t, 1, len(recording.Messages))
+		assert.Equal(t, int64(2), recording.Messages[0].LocalRound)
+		assert.Equal(t, []byte{1}, recording.Messages[0].Data)
+	})
+}
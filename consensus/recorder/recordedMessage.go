@@ -0,0 +1,97 @@
+package recorder
+
+import (
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-go/p2p"
+	p2pFactory "github.com/multiversx/mx-chain-go/p2p/factory"
+)
+
+// RecordedMessage holds a received consensus message along with its p2p metadata and the local receive time. A record
+// with a non-zero number of dropped messages is a gap marker, written in place of the messages the recorder dropped
+type RecordedMessage struct {
+	ReceivedTimestampNs int64               `json:"receivedTimestampNs"`
+	LocalRound          int64               `json:"localRound"`
+	FromConnectedPeer   []byte              `json:"fromConnectedPeer"`
+	Peer                []byte              `json:"peer"`
+	From                []byte              `json:"from"`
+	Data                []byte              `json:"data"`
+	Payload             []byte              `json:"payload,omitempty"`
+	SeqNo               []byte              `json:"seqNo"`
+	Topic               string              `json:"topic"`
+	Signature           []byte              `json:"signature"`
+	Key                 []byte              `json:"key,omitempty"`
+	Timestamp           int64               `json:"timestamp"`
+	BroadcastMethod     p2p.BroadcastMethod `json:"broadcastMethod"`
+	NumDroppedMessages  uint64              `json:"numDroppedMessages,omitempty"`
+}
+
+// Recording holds the messages loaded from a recording file
+type Recording struct {
+	Messages           []*RecordedMessage
+	NumDroppedMessages uint64
+}
+
+func newRecordedMessage(message p2p.MessageP2P, fromConnectedPeer core.PeerID, localRound int64, receivedTime time.Time) *RecordedMessage {
+	return &RecordedMessage{
+		ReceivedTimestampNs: receivedTime.UnixNano(),
+		LocalRound:          localRound,
+		FromConnectedPeer:   []byte(fromConnectedPeer),
+		Peer:                []byte(message.Peer()),
+		From:                message.From(),
+		Data:                message.Data(),
+		Payload:             message.Payload(),
+		SeqNo:               message.SeqNo(),
+		Topic:               message.Topic(),
+		Signature:           message.Signature(),
+		Key:                 message.Key(),
+		Timestamp:           message.Timestamp(),
+		BroadcastMethod:     message.BroadcastMethod(),
+	}
+}
+
+// newGapMarker creates the record marking the messages dropped before the message received at the provided round and time
+func newGapMarker(numDroppedMessages uint64, localRound int64, receivedTimestampNs int64) *RecordedMessage {
+	return &RecordedMessage{
+		ReceivedTimestampNs: receivedTimestampNs,
+		LocalRound:          localRound,
+		NumDroppedMessages:  numDroppedMessages,
+	}
+}
+
+// IsGapMarker returns true if the record marks dropped messages instead of holding a received message
+func (rm *RecordedMessage) IsGapMarker() bool {
+	return rm.NumDroppedMessages > 0
+}
+
+// P2PMessage rebuilds the p2p message as it was received
+func (rm *RecordedMessage) P2PMessage() p2p.MessageP2P {
+	return &p2pFactory.Message{
+		FromField:            rm.From,
+		DataField:            rm.Data,
+		PayloadField:         rm.Payload,
+		SeqNoField:           rm.SeqNo,
+		TopicField:           rm.Topic,
+		SignatureField:       rm.Signature,
+		KeyField:             rm.Key,
+		PeerField:            core.PeerID(rm.Peer),
+		TimestampField:       rm.Timestamp,
+		BroadcastMethodField: rm.BroadcastMethod,
+	}
+}
+
+// ConnectedPeer returns the peer that disseminated the message
+func (rm *RecordedMessage) ConnectedPeer() core.PeerID {
+	return core.PeerID(rm.FromConnectedPeer)
+}
+
+// ReceivedTime returns the local time when the message was received
+func (rm *RecordedMessage) ReceivedTime() time.Time {
+	return time.Unix(0, rm.ReceivedTimestampNs)
+}
+
+// IsComplete returns true if no received message was dropped while recording
+func (r *Recording) IsComplete() bool {
+	return r.NumDroppedMessages == 0
+}
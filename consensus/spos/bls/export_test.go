@@ -258,6 +258,11 @@ func (sr *subroundSignature) DoSignatureJob() bool {
 	return sr.doSignatureJob(context.Background())
 }
 
+// CreateAndSendSignatureMessage method creates and sends the signature message
+func (sr *subroundSignature) CreateAndSendSignatureMessage(signatureShare []byte, pkBytes []byte) bool {
+	return sr.createAndSendSignatureMessage(signatureShare, make(map[string][]byte), pkBytes)
+}
+
 // ReceivedSignature method is called when a signature is received through the signature channel
 func (sr *subroundSignature) ReceivedSignature(cnsDta *consensus.Message) bool {
 	return sr.receivedSignature(context.Background(), cnsDta)
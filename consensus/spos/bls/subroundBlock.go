@@ -658,13 +658,16 @@ func (sr *subroundBlock) printCancelRoundLogMessage(ctx context.Context, err err
 }
 
 func (sr *subroundBlock) computeSubroundProcessingMetric(startTime time.Time, metric string) {
+	processingDuration := time.Since(startTime)
+	sr.RoundTimelineRecorder().BlockProcessed(sr.RoundHandler().Index(), processingDuration)
+
 	subroundDuration := sr.EndTime() - sr.StartTime()
 	if subroundDuration == 0 {
 		// can not do division by 0
 		return
 	}
 
-	percent := uint64(time.Since(startTime)) * 100 / uint64(subroundDuration)
+	percent := uint64(processingDuration) * 100 / uint64(subroundDuration)
 	sr.AppStatusHandler().SetUInt64Value(metric, percent)
 }
 
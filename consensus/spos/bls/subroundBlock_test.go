@@ -15,6 +15,7 @@ import (
 	"github.com/multiversx/mx-chain-go/consensus/spos"
 	"github.com/multiversx/mx-chain-go/consensus/spos/bls"
 	"github.com/multiversx/mx-chain-go/testscommon"
+	consensusMocks "github.com/multiversx/mx-chain-go/testscommon/consensus"
 	"github.com/multiversx/mx-chain-go/testscommon/enableEpochsHandlerMock"
 	"github.com/multiversx/mx-chain-go/testscommon/hashingMocks"
 	"github.com/multiversx/mx-chain-go/testscommon/statusHandler"
@@ -1076,6 +1077,12 @@ func TestSubroundBlock_ReceivedBlockComputeProcessDuration(t *testing.T) {
 			return header, body, nil
 		},
 	})
+	recordedProcessingDuration := time.Duration(0)
+	container.SetRoundTimelineRecorder(&consensusMocks.RoundTimelineRecorderStub{
+		BlockProcessedCalled: func(round int64, duration time.Duration) {
+			recordedProcessingDuration = duration
+		},
+	})
 	sr := *initSubroundBlock(nil, container, &statusHandler.AppStatusHandlerStub{
 		SetUInt64ValueHandler: func(key string, value uint64) {
 			receivedValue = value
@@ -1111,6 +1118,7 @@ func TestSubroundBlock_ReceivedBlockComputeProcessDuration(t *testing.T) {
 		receivedValue >= minimumExpectedValue,
 		fmt.Sprintf("minimum expected was %d, got %d", minimumExpectedValue, receivedValue),
 	)
+	assert.True(t, recordedProcessingDuration >= time.Duration(delay))
 }
 
 func TestSubroundBlock_ReceivedBlockComputeProcessDurationWithZeroDurationShouldNotPanic(t *testing.T) {
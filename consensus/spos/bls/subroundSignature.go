@@ -138,7 +138,6 @@ func (sr *subroundSignature) createAndSendSignatureMessage(
 	extraSigShares map[string][]byte,
 	pkBytes []byte,
 ) bool {
-	// TODO: Analyze it is possible to send message only to leader with O(1) instead of O(n)
 	cnsMsg := consensus.NewConsensusMessage(
 		sr.GetData(),
 		signatureShare,
@@ -164,9 +163,9 @@ func (sr *subroundSignature) createAndSendSignatureMessage(
 		return false
 	}
 
-	err = sr.BroadcastMessenger().BroadcastConsensusMessage(cnsMsg)
+	err = sr.sendSignatureMessage(cnsMsg)
 	if err != nil {
-		log.Debug("createAndSendSignatureMessage.BroadcastConsensusMessage",
+		log.Debug("createAndSendSignatureMessage.sendSignatureMessage",
 			"error", err.Error(), "pk", pkBytes)
 		return false
 	}
@@ -176,6 +175,19 @@ func (sr *subroundSignature) createAndSendSignatureMessage(
 	return true
 }
 
+// sendSignatureMessage sends the signature share to the leader, as only the leader aggregates the signature shares and
+// the other nodes receive the aggregated signature on the end round. If the leader is not known, the message is broadcast
+func (sr *subroundSignature) sendSignatureMessage(cnsMsg *consensus.Message) error {
+	leader, err := sr.GetLeader()
+	if err != nil {
+		log.Debug("sendSignatureMessage.GetLeader: broadcasting the signature",
+			"error", err.Error(), "pk", cnsMsg.PubKey)
+		return sr.BroadcastMessenger().BroadcastConsensusMessage(cnsMsg)
+	}
+
+	return sr.BroadcastMessenger().SendConsensusMessageToLeader(cnsMsg, []byte(leader))
+}
+
 func (sr *subroundSignature) completeSignatureSubRound(
 	pk string,
 	index int,
@@ -439,6 +439,68 @@ func TestSubroundSignature_DoSignatureJob(t *testing.T) {
 	assert.False(t, sr.RoundCanceled)
 }
 
+func TestSubroundSignature_DoSignatureJobShouldSendTheSignatureToTheLeader(t *testing.T) {
+	t.Parallel()
+
+	container := mock.InitConsensusCore()
+	container.SetSigningHandler(&consensusMocks.SigningHandlerStub{
+		CreateSignatureShareForPublicKeyCalled: func(msg []byte, index uint16, epoch uint32, publicKeyBytes []byte) ([]byte, error) {
+			return []byte("SIG"), nil
+		},
+	})
+	sr := *initSubroundSignatureWithContainer(container, &enableEpochsHandlerMock.EnableEpochsHandlerStub{})
+	sr.Header = &block.Header{}
+	sr.Data = []byte("X")
+
+	leader, _ := sr.GetLeader()
+	wasSent := false
+	container.SetBroadcastMessenger(&mock.BroadcastMessengerMock{
+		BroadcastConsensusMessageCalled: func(message *consensus.Message) error {
+			assert.Fail(t, "should have not broadcast the signature")
+			return nil
+		},
+		SendConsensusMessageToLeaderCalled: func(message *consensus.Message, leaderPubKey []byte) error {
+			wasSent = true
+			assert.Equal(t, []byte(leader), leaderPubKey)
+			assert.Equal(t, []byte("SIG"), message.SignatureShare)
+			assert.Equal(t, int64(bls.MtSignature), message.MsgType)
+			return nil
+		},
+	})
+
+	r := sr.DoSignatureJob()
+	assert.True(t, r)
+	assert.True(t, wasSent)
+}
+
+func TestSubroundSignature_CreateAndSendSignatureMessageUnknownLeaderShouldBroadcast(t *testing.T) {
+	t.Parallel()
+
+	container := mock.InitConsensusCore()
+	sr := *initSubroundSignatureWithContainer(container, &enableEpochsHandlerMock.EnableEpochsHandlerStub{})
+	sr.Data = []byte("X")
+	pkBytes := []byte(sr.ConsensusGroup()[1])
+	sr.SetConsensusGroup(nil)
+
+	wasBroadcast := false
+	container.SetBroadcastMessenger(&mock.BroadcastMessengerMock{
+		BroadcastConsensusMessageCalled: func(message *consensus.Message) error {
+			wasBroadcast = true
+			assert.Equal(t, []byte("SIG"), message.SignatureShare)
+			assert.Equal(t, pkBytes, message.PubKey)
+			return nil
+		},
+		SendConsensusMessageToLeaderCalled: func(message *consensus.Message, leaderPubKey []byte) error {
+			assert.Fail(t, "should have not sent the signature to an unknown leader")
+			return nil
+		},
+	})
+
+	r := sr.CreateAndSendSignatureMessage([]byte("SIG"), pkBytes)
+	assert.True(t, r)
+	assert.True(t, wasBroadcast)
+}
+
 func TestSubroundSignature_DoSignatureJobWithExtraSigners(t *testing.T) {
 	t.Parallel()
 
@@ -38,6 +38,7 @@ type ConsensusCore struct {
 	messageSigningHandler         consensus.P2PSigningHandler
 	peerBlacklistHandler          consensus.PeerBlacklistHandler
 	signingHandler                consensus.SigningHandler
+	roundTimelineRecorder         consensus.RoundTimelineRecorder
 }
 
 // ConsensusCoreArgs store all arguments that are needed to create a ConsensusCore object
@@ -64,6 +65,7 @@ type ConsensusCoreArgs struct {
 	MessageSigningHandler         consensus.P2PSigningHandler
 	PeerBlacklistHandler          consensus.PeerBlacklistHandler
 	SigningHandler                consensus.SigningHandler
+	RoundTimelineRecorder         consensus.RoundTimelineRecorder
 }
 
 // NewConsensusCore creates a new ConsensusCore instance
@@ -93,6 +95,7 @@ func NewConsensusCore(
 		messageSigningHandler:         args.MessageSigningHandler,
 		peerBlacklistHandler:          args.PeerBlacklistHandler,
 		signingHandler:                args.SigningHandler,
+		roundTimelineRecorder:         args.RoundTimelineRecorder,
 	}
 
 	err := ValidateConsensusCore(consensusCore)
@@ -213,6 +216,11 @@ func (cc *ConsensusCore) SigningHandler() consensus.SigningHandler {
 	return cc.signingHandler
 }
 
+// RoundTimelineRecorder returns the recorder of the consensus rounds timeline
+func (cc *ConsensusCore) RoundTimelineRecorder() consensus.RoundTimelineRecorder {
+	return cc.roundTimelineRecorder
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (cc *ConsensusCore) IsInterfaceNil() bool {
 	return cc == nil
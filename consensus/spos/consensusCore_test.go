@@ -38,6 +38,7 @@ func createDefaultConsensusCoreArgs() *spos.ConsensusCoreArgs {
 		MessageSigningHandler:         consensusCoreMock.MessageSigningHandler(),
 		PeerBlacklistHandler:          consensusCoreMock.PeerBlacklistHandler(),
 		SigningHandler:                consensusCoreMock.SigningHandler(),
+		RoundTimelineRecorder:         consensusCoreMock.RoundTimelineRecorder(),
 	}
 	return args
 }
@@ -334,6 +335,20 @@ func TestConsensusCore_WithNilPeerBlacklistHandlerShouldFail(t *testing.T) {
 	assert.Equal(t, spos.ErrNilPeerBlacklistHandler, err)
 }
 
+func TestConsensusCore_WithNilRoundTimelineRecorderShouldFail(t *testing.T) {
+	t.Parallel()
+
+	args := createDefaultConsensusCoreArgs()
+	args.RoundTimelineRecorder = nil
+
+	consensusCore, err := spos.NewConsensusCore(
+		args,
+	)
+
+	assert.Nil(t, consensusCore)
+	assert.Equal(t, spos.ErrNilRoundTimelineRecorder, err)
+}
+
 func TestConsensusCore_CreateConsensusCoreShouldWork(t *testing.T) {
 	t.Parallel()
 
@@ -244,5 +244,11 @@ var ErrNilFunctionHandler = errors.New("nil function handler")
 // ErrWrongHashForHeader signals that the hash of the header is not the expected one
 var ErrWrongHashForHeader = errors.New("wrong hash for header")
 
+// ErrNilRoundTimelineRecorder signals that a nil round timeline recorder has been provided
+var ErrNilRoundTimelineRecorder = errors.New("nil round timeline recorder")
+
+// ErrNilReceivedMessagesRecorder signals that a nil received messages recorder has been provided
+var ErrNilReceivedMessagesRecorder = errors.New("nil received messages recorder")
+
 // ErrNilEnableEpochHandler signals that a nil enable epoch handler has been provided
 var ErrNilEnableEpochHandler = errors.New("nil enable epoch handler")
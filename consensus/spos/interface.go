@@ -65,6 +65,8 @@ type ConsensusCoreHandler interface {
 	PeerBlacklistHandler() consensus.PeerBlacklistHandler
 	// SigningHandler returns the signing handler component
 	SigningHandler() consensus.SigningHandler
+	// RoundTimelineRecorder returns the recorder of the consensus rounds timeline
+	RoundTimelineRecorder() consensus.RoundTimelineRecorder
 	// IsInterfaceNil returns true if there is no value under the interface
 	IsInterfaceNil() bool
 }
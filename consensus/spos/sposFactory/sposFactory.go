@@ -7,6 +7,7 @@ import (
 	"github.com/multiversx/mx-chain-core-go/marshal"
 	"github.com/multiversx/mx-chain-crypto-go"
 	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/config"
 	"github.com/multiversx/mx-chain-go/consensus"
 	"github.com/multiversx/mx-chain-go/consensus/broadcast"
 	"github.com/multiversx/mx-chain-go/consensus/spos"
@@ -80,6 +81,8 @@ func GetBroadcastMessenger(
 	interceptorsContainer process.InterceptorsContainer,
 	alarmScheduler core.TimersScheduler,
 	keysHandler consensus.KeysHandler,
+	peerIDsProvider consensus.PeerIDsProvider,
+	signaturesForwarding config.ConsensusSignaturesForwardingConfig,
 ) (consensus.BroadcastMessenger, error) {
 
 	if check.IfNil(shardCoordinator) {
@@ -98,6 +101,8 @@ func GetBroadcastMessenger(
 		InterceptorsContainer:      interceptorsContainer,
 		AlarmScheduler:             alarmScheduler,
 		KeysHandler:                keysHandler,
+		PeerIDsProvider:            peerIDsProvider,
+		SignaturesForwarding:       signaturesForwarding,
 	}
 
 	if shardCoordinator.SelfId() < shardCoordinator.NumberOfShards() {
@@ -5,6 +5,7 @@ import (
 
 	"github.com/multiversx/mx-chain-core-go/core"
 	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-go/config"
 	"github.com/multiversx/mx-chain-go/consensus"
 	"github.com/multiversx/mx-chain-go/consensus/mock"
 	"github.com/multiversx/mx-chain-go/consensus/spos"
@@ -173,6 +174,8 @@ func TestGetBroadcastMessenger_ShardShouldWork(t *testing.T) {
 		interceptosContainer,
 		alarmSchedulerStub,
 		&testscommon.KeysHandlerStub{},
+		&p2pmocks.NetworkShardingCollectorStub{},
+		config.ConsensusSignaturesForwardingConfig{},
 	)
 
 	assert.Nil(t, err)
@@ -204,6 +207,8 @@ func TestGetBroadcastMessenger_MetachainShouldWork(t *testing.T) {
 		interceptosContainer,
 		alarmSchedulerStub,
 		&testscommon.KeysHandlerStub{},
+		&p2pmocks.NetworkShardingCollectorStub{},
+		config.ConsensusSignaturesForwardingConfig{},
 	)
 
 	assert.Nil(t, err)
@@ -227,6 +232,8 @@ func TestGetBroadcastMessenger_NilShardCoordinatorShouldErr(t *testing.T) {
 		interceptosContainer,
 		alarmSchedulerStub,
 		&testscommon.KeysHandlerStub{},
+		&p2pmocks.NetworkShardingCollectorStub{},
+		config.ConsensusSignaturesForwardingConfig{},
 	)
 
 	assert.Nil(t, bm)
@@ -254,6 +261,8 @@ func TestGetBroadcastMessenger_InvalidShardIdShouldErr(t *testing.T) {
 		interceptosContainer,
 		alarmSchedulerStub,
 		&testscommon.KeysHandlerStub{},
+		&p2pmocks.NetworkShardingCollectorStub{},
+		config.ConsensusSignaturesForwardingConfig{},
 	)
 
 	assert.Nil(t, bm)
@@ -81,6 +81,8 @@ type Worker struct {
 	peerBlacklistHandler      consensus.PeerBlacklistHandler
 	closer                    core.SafeCloser
 	enableEpochHandler        common.EnableEpochsHandler
+	roundTimelineRecorder     consensus.RoundTimelineRecorder
+	receivedMessagesRecorder  consensus.ReceivedMessagesRecorder
 }
 
 // WorkerArgs holds the consensus worker arguments
@@ -111,6 +113,8 @@ type WorkerArgs struct {
 	NodeRedundancyHandler    consensus.NodeRedundancyHandler
 	PeerBlacklistHandler     consensus.PeerBlacklistHandler
 	EnableEpochHandler       common.EnableEpochsHandler
+	RoundTimelineRecorder    consensus.RoundTimelineRecorder
+	ReceivedMessagesRecorder consensus.ReceivedMessagesRecorder
 }
 
 // NewWorker creates a new Worker object
@@ -161,6 +165,8 @@ func NewWorker(args *WorkerArgs) (*Worker, error) {
 		peerBlacklistHandler:     args.PeerBlacklistHandler,
 		closer:                   closing.NewSafeChanCloser(),
 		enableEpochHandler:       args.EnableEpochHandler,
+		roundTimelineRecorder:    args.RoundTimelineRecorder,
+		receivedMessagesRecorder: args.ReceivedMessagesRecorder,
 	}
 
 	wrk.consensusMessageValidator = consensusMessageValidatorObj
@@ -264,6 +270,12 @@ func checkNewWorkerParams(args *WorkerArgs) error {
 	if check.IfNil(args.EnableEpochHandler) {
 		return ErrNilEnableEpochHandler
 	}
+	if check.IfNil(args.RoundTimelineRecorder) {
+		return ErrNilRoundTimelineRecorder
+	}
+	if check.IfNil(args.ReceivedMessagesRecorder) {
+		return ErrNilReceivedMessagesRecorder
+	}
 
 	return nil
 }
@@ -355,6 +367,9 @@ func (wrk *Worker) ProcessReceivedMessage(message p2p.MessageP2P, fromConnectedP
 		return ErrNilSignatureOnP2PMessage
 	}
 
+	// the message is recorded before any filtering so a replay will feed the same traffic as the one seen by this node
+	wrk.receivedMessagesRecorder.RecordReceivedMessage(message, fromConnectedPeer, wrk.roundHandler.Index(), wrk.syncTimer.CurrentTime())
+
 	isPeerBlacklisted := wrk.peerBlacklistHandler.IsPeerBlacklisted(fromConnectedPeer)
 	if isPeerBlacklisted {
 		log.Debug("received message from blacklisted peer",
@@ -461,6 +476,7 @@ func (wrk *Worker) shouldBlacklistPeer(err error) bool {
 }
 
 func (wrk *Worker) doJobOnMessageWithBlockBody(cnsMsg *consensus.Message) {
+	wrk.roundTimelineRecorder.BodyReceived(cnsMsg.RoundIndex, cnsMsg.PubKey)
 	wrk.addBlockToPool(cnsMsg.GetBody())
 }
 
@@ -511,6 +527,7 @@ func (wrk *Worker) doJobOnMessageWithHeader(cnsMsg *consensus.Message) error {
 	}
 
 	wrk.processReceivedHeaderMetric(cnsMsg)
+	wrk.roundTimelineRecorder.HeaderReceived(cnsMsg.RoundIndex, cnsMsg.PubKey, headerHash)
 
 	errNotCritical := wrk.forkDetector.AddHeader(header, headerHash, process.BHProposed, nil, nil)
 	if errNotCritical != nil {
@@ -536,6 +553,7 @@ func (wrk *Worker) doJobOnMessageWithSignature(cnsMsg *consensus.Message, p2pMsg
 	wrk.mapDisplayHashConsensusMessage[hash] = append(wrk.mapDisplayHashConsensusMessage[hash], cnsMsg)
 
 	wrk.consensusState.AddMessageWithSignature(string(cnsMsg.PubKey), p2pMsg)
+	wrk.roundTimelineRecorder.SignatureReceived(cnsMsg.RoundIndex, cnsMsg.PubKey)
 }
 
 func (wrk *Worker) addBlockToPool(bodyBytes []byte) {
@@ -27,6 +27,7 @@ import (
 	"github.com/multiversx/mx-chain-go/p2p"
 	"github.com/multiversx/mx-chain-go/process"
 	"github.com/multiversx/mx-chain-go/testscommon"
+	consensusMocks "github.com/multiversx/mx-chain-go/testscommon/consensus"
 	"github.com/multiversx/mx-chain-go/testscommon/enableEpochsHandlerMock"
 	"github.com/multiversx/mx-chain-go/testscommon/hashingMocks"
 	"github.com/multiversx/mx-chain-go/testscommon/p2pmocks"
@@ -118,6 +119,8 @@ func createDefaultWorkerArgs(appStatusHandler core.AppStatusHandler) *spos.Worke
 		NodeRedundancyHandler:    &mock.NodeRedundancyHandlerStub{},
 		PeerBlacklistHandler:     &mock.PeerBlacklistHandlerStub{},
 		EnableEpochHandler:       &enableEpochsHandlerMock.EnableEpochsHandlerStub{},
+		RoundTimelineRecorder:    &consensusMocks.RoundTimelineRecorderStub{},
+		ReceivedMessagesRecorder: &consensusMocks.ReceivedMessagesRecorderStub{},
 	}
 
 	return workerArgs
@@ -405,6 +408,28 @@ func TestNewWorker_NilEnableEpochHandlerShouldFail(t *testing.T) {
 	assert.Equal(t, spos.ErrNilEnableEpochHandler, err)
 }
 
+func TestNewWorker_NilRoundTimelineRecorderShouldFail(t *testing.T) {
+	t.Parallel()
+
+	workerArgs := createDefaultWorkerArgs(statusHandlerMock.NewAppStatusHandlerMock())
+	workerArgs.RoundTimelineRecorder = nil
+	wrk, err := spos.NewWorker(workerArgs)
+
+	assert.Nil(t, wrk)
+	assert.Equal(t, spos.ErrNilRoundTimelineRecorder, err)
+}
+
+func TestNewWorker_NilReceivedMessagesRecorderShouldFail(t *testing.T) {
+	t.Parallel()
+
+	workerArgs := createDefaultWorkerArgs(statusHandlerMock.NewAppStatusHandlerMock())
+	workerArgs.ReceivedMessagesRecorder = nil
+	wrk, err := spos.NewWorker(workerArgs)
+
+	assert.Nil(t, wrk)
+	assert.Equal(t, spos.ErrNilReceivedMessagesRecorder, err)
+}
+
 func TestNewWorker_ShouldWork(t *testing.T) {
 	t.Parallel()
 
@@ -441,6 +466,48 @@ func TestWorker_ProcessReceivedMessageShouldErrIfFloodIsDetectedOnTopic(t *testi
 	assert.Equal(t, expectedErr, err)
 }
 
+func TestWorker_ProcessReceivedMessageShouldRecordTheMessageBeforeFiltering(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("flood detected")
+	workerArgs := createDefaultWorkerArgs(&statusHandlerMock.AppStatusHandlerStub{})
+	workerArgs.AntifloodHandler = &mock.P2PAntifloodHandlerStub{
+		CanProcessMessagesOnTopicCalled: func(peer core.PeerID, topic string, numMessages uint32, totalSize uint64, sequence []byte) error {
+			return expectedErr
+		},
+	}
+	providedReceivedTime := time.Unix(1000, 0)
+	workerArgs.SyncTimer = &mock.SyncTimerMock{
+		CurrentTimeCalled: func() time.Time {
+			return providedReceivedTime
+		},
+	}
+	numRecorded := 0
+	workerArgs.ReceivedMessagesRecorder = &consensusMocks.ReceivedMessagesRecorderStub{
+		RecordReceivedMessageCalled: func(message p2p.MessageP2P, fromConnectedPeer core.PeerID, localRound int64, receivedTime time.Time) {
+			numRecorded++
+			assert.Equal(t, []byte("aaa"), message.Data())
+			assert.Equal(t, core.PeerID("peer"), fromConnectedPeer)
+			assert.Equal(t, workerArgs.RoundHandler.Index(), localRound)
+			assert.Equal(t, providedReceivedTime, receivedTime)
+		},
+	}
+	wrk, _ := spos.NewWorker(workerArgs)
+
+	err := wrk.ProcessReceivedMessage(&p2pmocks.P2PMessageMock{}, "peer", &p2pmocks.MessengerStub{})
+	assert.Equal(t, spos.ErrNilDataToProcess, err)
+	assert.Equal(t, 0, numRecorded)
+
+	msg := &p2pmocks.P2PMessageMock{
+		DataField:      []byte("aaa"),
+		TopicField:     "topic1",
+		SignatureField: []byte("signature"),
+	}
+	err = wrk.ProcessReceivedMessage(msg, "peer", &p2pmocks.MessengerStub{})
+	assert.Equal(t, expectedErr, err)
+	assert.Equal(t, 1, numRecorded)
+}
+
 func TestWorker_ReceivedSyncStateShouldNotSendOnChannelWhenInputIsFalse(t *testing.T) {
 	t.Parallel()
 	wrk := initWorker(&statusHandlerMock.AppStatusHandlerStub{})
@@ -1376,6 +1443,13 @@ func TestWorker_ProcessReceivedMessageOkValsShouldWork(t *testing.T) {
 			wasUpdatePeerIDInfoCalled = true
 		},
 	}
+	wasHeaderReceivedCalled := false
+	workerArgs.RoundTimelineRecorder = &consensusMocks.RoundTimelineRecorderStub{
+		HeaderReceivedCalled: func(round int64, pubKey []byte, headerHash []byte) {
+			assert.Equal(t, expectedPK, pubKey)
+			wasHeaderReceivedCalled = true
+		},
+	}
 	wrk, _ := spos.NewWorker(workerArgs)
 
 	wrk.SetBlockProcessor(
@@ -1430,6 +1504,7 @@ func TestWorker_ProcessReceivedMessageOkValsShouldWork(t *testing.T) {
 	assert.Equal(t, 1, len(wrk.ReceivedMessages()[bls.MtBlockHeader]))
 	assert.Nil(t, err)
 	assert.True(t, wasUpdatePeerIDInfoCalled)
+	assert.True(t, wasHeaderReceivedCalled)
 }
 
 func TestWorker_CheckSelfStateShouldErrMessageFromItself(t *testing.T) {
@@ -1965,6 +2040,12 @@ func TestWorker_ProcessReceivedMessageWithSignature(t *testing.T) {
 		t.Parallel()
 
 		workerArgs := createDefaultWorkerArgs(&statusHandlerMock.AppStatusHandlerStub{})
+		var recordedSigner []byte
+		workerArgs.RoundTimelineRecorder = &consensusMocks.RoundTimelineRecorderStub{
+			SignatureReceivedCalled: func(round int64, pubKey []byte) {
+				recordedSigner = pubKey
+			},
+		}
 		wrk, _ := spos.NewWorker(workerArgs)
 
 		hdr := &block.Header{}
@@ -2006,5 +2087,6 @@ func TestWorker_ProcessReceivedMessageWithSignature(t *testing.T) {
 		p2pMsgWithSignature, ok := wrk.ConsensusState().GetMessageWithSignature(string(pubKey))
 		require.True(t, ok)
 		require.Equal(t, msg, p2pMsgWithSignature)
+		require.Equal(t, pubKey, recordedSigner)
 	})
 }
@@ -0,0 +1,12 @@
+package timeline
+
+import "errors"
+
+// ErrInvalidCapacity signals that an invalid capacity has been provided
+var ErrInvalidCapacity = errors.New("invalid capacity")
+
+// ErrNilSyncTimer signals that a nil sync timer has been provided
+var ErrNilSyncTimer = errors.New("nil sync timer")
+
+// ErrNilOutportHandler signals that a nil outport handler has been provided
+var ErrNilOutportHandler = errors.New("nil outport handler")
@@ -0,0 +1,408 @@
+package timeline
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/consensus"
+	"github.com/multiversx/mx-chain-go/ntp"
+	"github.com/multiversx/mx-chain-go/outport"
+	logger "github.com/multiversx/mx-chain-logger-go"
+)
+
+var _ consensus.RoundTimelineRecorder = (*roundTimelineRecorder)(nil)
+
+var log = logger.GetOrCreate("consensus/timeline")
+
+const (
+	// OutcomeInProgress is the outcome of a round that did not end yet
+	OutcomeInProgress = "inProgress"
+	// OutcomeCompleted is the outcome of a round in which all the subrounds finished their job
+	OutcomeCompleted = "completed"
+	// OutcomeFailed is the outcome of a round in which one of the subrounds did not finish its job
+	OutcomeFailed = "failed"
+
+	// timelinesToSaveBufferSize is the number of ended rounds timelines waiting to be streamed on the outport. The
+	// timelines of the rounds ending while the buffer is full are dropped
+	timelinesToSaveBufferSize = 100
+)
+
+// ArgRoundTimelineRecorder holds the arguments needed for creating a new round timeline recorder
+type ArgRoundTimelineRecorder struct {
+	Capacity       uint32
+	ShardID        uint32
+	SyncTimer      ntp.SyncTimer
+	OutportHandler outport.OutportHandler
+}
+
+type subroundTiming struct {
+	name      string
+	startTime time.Time
+	endTime   time.Time
+	finished  bool
+}
+
+type messageArrival struct {
+	from        []byte
+	arrivalTime time.Time
+	hash        []byte
+}
+
+type roundTimeline struct {
+	round              int64
+	startTime          time.Time
+	subrounds          []*subroundTiming
+	header             *messageArrival
+	body               *messageArrival
+	signatures         []*messageArrival
+	signers            map[string]struct{}
+	processingDuration time.Duration
+	outcome            string
+	failedSubround     string
+}
+
+type roundTimelineRecorder struct {
+	mut             sync.RWMutex
+	timelines       []*roundTimeline
+	newestRound     int64
+	shardID         uint32
+	syncTimer       ntp.SyncTimer
+	outportHandler  outport.OutportHandler
+	timelinesToSave chan *common.ConsensusRoundTimeline
+	cancelFunc      func()
+}
+
+// NewRoundTimelineRecorder creates a component able to keep, in a ring buffer, the timelines of the last consensus
+// rounds: the subrounds timings, the arrival of the consensus messages and the round outcome
+func NewRoundTimelineRecorder(args ArgRoundTimelineRecorder) (*roundTimelineRecorder, error) {
+	if args.Capacity == 0 {
+		return nil, fmt.Errorf("%w, provided: %d", ErrInvalidCapacity, args.Capacity)
+	}
+	if check.IfNil(args.SyncTimer) {
+		return nil, ErrNilSyncTimer
+	}
+	if check.IfNil(args.OutportHandler) {
+		return nil, ErrNilOutportHandler
+	}
+
+	ctx, cancelFunc := context.WithCancel(context.Background())
+	rtr := &roundTimelineRecorder{
+		timelines:       make([]*roundTimeline, args.Capacity),
+		newestRound:     -1,
+		shardID:         args.ShardID,
+		syncTimer:       args.SyncTimer,
+		outportHandler:  args.OutportHandler,
+		timelinesToSave: make(chan *common.ConsensusRoundTimeline, timelinesToSaveBufferSize),
+		cancelFunc:      cancelFunc,
+	}
+
+	go rtr.saveTimelines(ctx)
+
+	return rtr, nil
+}
+
+// saveTimelines streams the timelines of the ended rounds on the outport, away from the chronology go routine
+func (rtr *roundTimelineRecorder) saveTimelines(ctx context.Context) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case timeline := <-rtr.timelinesToSave:
+			rtr.outportHandler.SaveConsensusRoundTimeline(timeline)
+		}
+	}
+}
+
+// RoundStarted records the start of the provided round
+func (rtr *roundTimelineRecorder) RoundStarted(round int64, roundTimeStamp time.Time) {
+	rtr.mut.Lock()
+	defer rtr.mut.Unlock()
+
+	timeline := rtr.getOrCreateTimeline(round)
+	if timeline == nil {
+		return
+	}
+
+	timeline.startTime = roundTimeStamp
+}
+
+// SubroundStarted records the start of a subround in the provided round
+func (rtr *roundTimelineRecorder) SubroundStarted(round int64, subroundName string) {
+	now := rtr.syncTimer.CurrentTime()
+
+	rtr.mut.Lock()
+	defer rtr.mut.Unlock()
+
+	timeline := rtr.getOrCreateTimeline(round)
+	if timeline == nil {
+		return
+	}
+
+	timeline.subrounds = append(timeline.subrounds, &subroundTiming{
+		name:      subroundName,
+		startTime: now,
+	})
+}
+
+// SubroundEnded records the end of a subround in the provided round
+func (rtr *roundTimelineRecorder) SubroundEnded(round int64, subroundName string, finished bool) {
+	now := rtr.syncTimer.CurrentTime()
+
+	rtr.mut.Lock()
+	defer rtr.mut.Unlock()
+
+	timeline := rtr.getOrCreateTimeline(round)
+	if timeline == nil {
+		return
+	}
+
+	for i := len(timeline.subrounds) - 1; i >= 0; i-- {
+		subround := timeline.subrounds[i]
+		if subround.name == subroundName && subround.endTime.IsZero() {
+			subround.endTime = now
+			subround.finished = finished
+			return
+		}
+	}
+}
+
+// HeaderReceived records the arrival of the proposed header in the provided round. Only the first arrival is kept
+func (rtr *roundTimelineRecorder) HeaderReceived(round int64, pubKey []byte, headerHash []byte) {
+	now := rtr.syncTimer.CurrentTime()
+
+	rtr.mut.Lock()
+	defer rtr.mut.Unlock()
+
+	timeline := rtr.getOrCreateTimeline(round)
+	if timeline == nil || timeline.header != nil {
+		return
+	}
+
+	timeline.header = &messageArrival{
+		from:        pubKey,
+		arrivalTime: now,
+		hash:        headerHash,
+	}
+}
+
+// BodyReceived records the arrival of the proposed block body in the provided round. Only the first arrival is kept
+func (rtr *roundTimelineRecorder) BodyReceived(round int64, pubKey []byte) {
+	now := rtr.syncTimer.CurrentTime()
+
+	rtr.mut.Lock()
+	defer rtr.mut.Unlock()
+
+	timeline := rtr.getOrCreateTimeline(round)
+	if timeline == nil || timeline.body != nil {
+		return
+	}
+
+	timeline.body = &messageArrival{
+		from:        pubKey,
+		arrivalTime: now,
+	}
+}
+
+// SignatureReceived records the arrival of a signature share in the provided round. Only the first arrival from
+// each signer is kept
+func (rtr *roundTimelineRecorder) SignatureReceived(round int64, pubKey []byte) {
+	now := rtr.syncTimer.CurrentTime()
+
+	rtr.mut.Lock()
+	defer rtr.mut.Unlock()
+
+	timeline := rtr.getOrCreateTimeline(round)
+	if timeline == nil {
+		return
+	}
+
+	_, alreadyReceived := timeline.signers[string(pubKey)]
+	if alreadyReceived {
+		return
+	}
+
+	timeline.signers[string(pubKey)] = struct{}{}
+	timeline.signatures = append(timeline.signatures, &messageArrival{
+		from:        pubKey,
+		arrivalTime: now,
+	})
+}
+
+// BlockProcessed records the time spent creating or processing the block of the provided round
+func (rtr *roundTimelineRecorder) BlockProcessed(round int64, duration time.Duration) {
+	rtr.mut.Lock()
+	defer rtr.mut.Unlock()
+
+	timeline := rtr.getOrCreateTimeline(round)
+	if timeline == nil {
+		return
+	}
+
+	timeline.processingDuration = duration
+}
+
+// RoundEnded records the outcome of the provided round and streams its timeline on the outport, if drivers are attached.
+// The timeline is streamed asynchronously and dropped if the outport falls behind
+func (rtr *roundTimelineRecorder) RoundEnded(round int64, completed bool, failedSubround string) {
+	rtr.mut.Lock()
+	timeline := rtr.getOrCreateTimeline(round)
+	if timeline == nil {
+		rtr.mut.Unlock()
+		return
+	}
+
+	timeline.outcome = OutcomeFailed
+	timeline.failedSubround = failedSubround
+	if completed {
+		timeline.outcome = OutcomeCompleted
+		timeline.failedSubround = ""
+	}
+
+	timelineResponse := rtr.convertTimeline(timeline)
+	rtr.mut.Unlock()
+
+	if !rtr.outportHandler.HasDrivers() {
+		return
+	}
+
+	select {
+	case rtr.timelinesToSave <- timelineResponse:
+	default:
+		log.Debug("roundTimelineRecorder.RoundEnded: the outport is too slow, dropped the round timeline", "round", round)
+	}
+}
+
+// GetLastRounds returns the timelines of the last recorded rounds, in ascending order of the rounds
+func (rtr *roundTimelineRecorder) GetLastRounds(numRounds uint32) []*common.ConsensusRoundTimeline {
+	rtr.mut.RLock()
+	defer rtr.mut.RUnlock()
+
+	capacity := uint32(len(rtr.timelines))
+	if numRounds > capacity {
+		numRounds = capacity
+	}
+
+	timelines := make([]*common.ConsensusRoundTimeline, 0, numRounds)
+	firstRound := rtr.newestRound - int64(numRounds) + 1
+	for round := firstRound; round <= rtr.newestRound; round++ {
+		if round < 0 {
+			continue
+		}
+
+		timeline := rtr.timelines[rtr.slot(round)]
+		if timeline == nil || timeline.round != round {
+			continue
+		}
+
+		timelines = append(timelines, rtr.convertTimeline(timeline))
+	}
+
+	return timelines
+}
+
+// getOrCreateTimeline returns the timeline of the provided round, creating it if needed. Returns nil if the round is
+// too old to be kept in the ring buffer. Should be called under mutex protection
+func (rtr *roundTimelineRecorder) getOrCreateTimeline(round int64) *roundTimeline {
+	if round < 0 || round <= rtr.newestRound-int64(len(rtr.timelines)) {
+		return nil
+	}
+
+	slot := rtr.slot(round)
+	timeline := rtr.timelines[slot]
+	if timeline != nil && timeline.round == round {
+		return timeline
+	}
+
+	timeline = &roundTimeline{
+		round:      round,
+		subrounds:  make([]*subroundTiming, 0),
+		signatures: make([]*messageArrival, 0),
+		signers:    make(map[string]struct{}),
+		outcome:    OutcomeInProgress,
+	}
+	rtr.timelines[slot] = timeline
+	if round > rtr.newestRound {
+		rtr.newestRound = round
+	}
+
+	return timeline
+}
+
+func (rtr *roundTimelineRecorder) slot(round int64) int {
+	return int(round % int64(len(rtr.timelines)))
+}
+
+func (rtr *roundTimelineRecorder) convertTimeline(timeline *roundTimeline) *common.ConsensusRoundTimeline {
+	response := &common.ConsensusRoundTimeline{
+		Round:                timeline.round,
+		ShardID:              rtr.shardID,
+		Subrounds:            make([]*common.ConsensusSubroundTiming, 0, len(timeline.subrounds)),
+		Header:               convertMessageArrival(timeline.header, timeline.startTime),
+		Body:                 convertMessageArrival(timeline.body, timeline.startTime),
+		Signatures:           make([]*common.ConsensusMessageArrival, 0, len(timeline.signatures)),
+		ProcessingDurationMs: timeline.processingDuration.Milliseconds(),
+		Outcome:              timeline.outcome,
+		FailedSubround:       timeline.failedSubround,
+	}
+	if !timeline.startTime.IsZero() {
+		response.RoundStartTimestampMs = timeline.startTime.UnixMilli()
+	}
+
+	for _, subround := range timeline.subrounds {
+		subroundResponse := &common.ConsensusSubroundTiming{
+			Name:          subround.name,
+			StartOffsetMs: offsetInMilliseconds(subround.startTime, timeline.startTime),
+			Finished:      subround.finished,
+		}
+		if !subround.endTime.IsZero() {
+			subroundResponse.EndOffsetMs = offsetInMilliseconds(subround.endTime, timeline.startTime)
+		}
+
+		response.Subrounds = append(response.Subrounds, subroundResponse)
+	}
+
+	for _, signature := range timeline.signatures {
+		response.Signatures = append(response.Signatures, convertMessageArrival(signature, timeline.startTime))
+	}
+
+	return response
+}
+
+func convertMessageArrival(arrival *messageArrival, roundStartTime time.Time) *common.ConsensusMessageArrival {
+	if arrival == nil {
+		return nil
+	}
+
+	return &common.ConsensusMessageArrival{
+		From:     hex.EncodeToString(arrival.from),
+		OffsetMs: offsetInMilliseconds(arrival.arrivalTime, roundStartTime),
+		Hash:     hex.EncodeToString(arrival.hash),
+	}
+}
+
+// offsetInMilliseconds returns the offset of the event relative to the round start. The offset can be negative for
+// messages received before the local round start, and is 0 if the round start was not recorded
+func offsetInMilliseconds(eventTime time.Time, roundStartTime time.Time) int64 {
+	if roundStartTime.IsZero() {
+		return 0
+	}
+
+	return eventTime.Sub(roundStartTime).Milliseconds()
+}
+
+// Close stops streaming the round timelines on the outport
+func (rtr *roundTimelineRecorder) Close() error {
+	rtr.cancelFunc()
+
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (rtr *roundTimelineRecorder) IsInterfaceNil() bool {
+	return rtr == nil
+}
@@ -0,0 +1,276 @@
+package timeline
+
+import (
+	"encoding/hex"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/consensus/mock"
+	outportStub "github.com/multiversx/mx-chain-go/testscommon/outport"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createMockArgRoundTimelineRecorder() ArgRoundTimelineRecorder {
+	return ArgRoundTimelineRecorder{
+		Capacity:       4,
+		ShardID:        1,
+		SyncTimer:      &mock.SyncTimerMock{},
+		OutportHandler: &outportStub.OutportStub{},
+	}
+}
+
+func TestNewRoundTimelineRecorder(t *testing.T) {
+	t.Parallel()
+
+	t.Run("zero capacity should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgRoundTimelineRecorder()
+		args.Capacity = 0
+		recorder, err := NewRoundTimelineRecorder(args)
+		assert.True(t, errors.Is(err, ErrInvalidCapacity))
+		assert.True(t, check.IfNil(recorder))
+	})
+	t.Run("nil sync timer should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgRoundTimelineRecorder()
+		args.SyncTimer = nil
+		recorder, err := NewRoundTimelineRecorder(args)
+		assert.Equal(t, ErrNilSyncTimer, err)
+		assert.True(t, check.IfNil(recorder))
+	})
+	t.Run("nil outport handler should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgRoundTimelineRecorder()
+		args.OutportHandler = nil
+		recorder, err := NewRoundTimelineRecorder(args)
+		assert.Equal(t, ErrNilOutportHandler, err)
+		assert.True(t, check.IfNil(recorder))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		recorder, err := NewRoundTimelineRecorder(createMockArgRoundTimelineRecorder())
+		assert.Nil(t, err)
+		assert.False(t, check.IfNil(recorder))
+		assert.Empty(t, recorder.GetLastRounds(10))
+		assert.Nil(t, recorder.Close())
+	})
+}
+
+func TestRoundTimelineRecorder_RecordRound(t *testing.T) {
+	t.Parallel()
+
+	roundStart := time.Unix(1000, 0)
+	currentTime := roundStart
+	args := createMockArgRoundTimelineRecorder()
+	args.SyncTimer = &mock.SyncTimerMock{
+		CurrentTimeCalled: func() time.Time {
+			return currentTime
+		},
+	}
+	streamedTimelines := make(chan *common.ConsensusRoundTimeline, 1)
+	args.OutportHandler = &outportStub.OutportStub{
+		HasDriversCalled: func() bool {
+			return true
+		},
+		SaveConsensusRoundTimelineCalled: func(timeline *common.ConsensusRoundTimeline) {
+			streamedTimelines <- timeline
+		},
+	}
+	recorder, _ := NewRoundTimelineRecorder(args)
+	defer func() {
+		_ = recorder.Close()
+	}()
+
+	round := int64(7)
+	recorder.RoundStarted(round, roundStart)
+	currentTime = roundStart.Add(10 * time.Millisecond)
+	recorder.SubroundStarted(round, "(START_ROUND)")
+	recorder.SubroundEnded(round, "(START_ROUND)", true)
+	recorder.SubroundStarted(round, "(BLOCK)")
+	currentTime = roundStart.Add(150 * time.Millisecond)
+	recorder.HeaderReceived(round, []byte("leader"), []byte("hash"))
+	recorder.BodyReceived(round, []byte("leader"))
+	currentTime = roundStart.Add(200 * time.Millisecond)
+	recorder.HeaderReceived(round, []byte("other"), []byte("other hash"))
+	recorder.BlockProcessed(round, 300*time.Millisecond)
+	currentTime = roundStart.Add(500 * time.Millisecond)
+	recorder.SubroundEnded(round, "(BLOCK)", true)
+	recorder.SubroundStarted(round, "(SIGNATURE)")
+	currentTime = roundStart.Add(700 * time.Millisecond)
+	recorder.SignatureReceived(round, []byte("validator"))
+	currentTime = roundStart.Add(800 * time.Millisecond)
+	recorder.SignatureReceived(round, []byte("validator"))
+	currentTime = roundStart.Add(2000 * time.Millisecond)
+	recorder.SubroundEnded(round, "(SIGNATURE)", false)
+	recorder.RoundEnded(round, false, "(SIGNATURE)")
+
+	expectedTimeline := &common.ConsensusRoundTimeline{
+		Round:                 round,
+		ShardID:               1,
+		RoundStartTimestampMs: roundStart.UnixMilli(),
+		Subrounds: []*common.ConsensusSubroundTiming{
+			{Name: "(START_ROUND)", StartOffsetMs: 10, EndOffsetMs: 10, Finished: true},
+			{Name: "(BLOCK)", StartOffsetMs: 10, EndOffsetMs: 500, Finished: true},
+			{Name: "(SIGNATURE)", StartOffsetMs: 500, EndOffsetMs: 2000, Finished: false},
+		},
+		Header: &common.ConsensusMessageArrival{
+			From:     hex.EncodeToString([]byte("leader")),
+			OffsetMs: 150,
+			Hash:     hex.EncodeToString([]byte("hash")),
+		},
+		Body: &common.ConsensusMessageArrival{
+			From:     hex.EncodeToString([]byte("leader")),
+			OffsetMs: 150,
+		},
+		Signatures: []*common.ConsensusMessageArrival{
+			{From: hex.EncodeToString([]byte("validator")), OffsetMs: 700},
+		},
+		ProcessingDurationMs: 300,
+		Outcome:              OutcomeFailed,
+		FailedSubround:       "(SIGNATURE)",
+	}
+
+	timelines := recorder.GetLastRounds(1)
+	require.Equal(t, 1, len(timelines))
+	assert.Equal(t, expectedTimeline, timelines[0])
+
+	select {
+	case streamedTimeline := <-streamedTimelines:
+		assert.Equal(t, expectedTimeline, streamedTimeline)
+	case <-time.After(time.Second):
+		assert.Fail(t, "the timeline should have been streamed")
+	}
+}
+
+func TestRoundTimelineRecorder_RoundEndedWithSlowOutportShouldNotBlock(t *testing.T) {
+	t.Parallel()
+
+	unblockOutport := make(chan struct{})
+	numStreamed := uint32(0)
+	args := createMockArgRoundTimelineRecorder()
+	args.OutportHandler = &outportStub.OutportStub{
+		HasDriversCalled: func() bool {
+			return true
+		},
+		SaveConsensusRoundTimelineCalled: func(timeline *common.ConsensusRoundTimeline) {
+			<-unblockOutport
+			atomic.AddUint32(&numStreamed, 1)
+		},
+	}
+	recorder, _ := NewRoundTimelineRecorder(args)
+	defer func() {
+		_ = recorder.Close()
+	}()
+
+	numRounds := int64(timelinesToSaveBufferSize * 2)
+	roundsEnded := make(chan struct{})
+	go func() {
+		for round := int64(0); round < numRounds; round++ {
+			recorder.RoundEnded(round, true, "")
+		}
+		close(roundsEnded)
+	}()
+
+	select {
+	case <-roundsEnded:
+	case <-time.After(time.Second):
+		require.Fail(t, "ending the rounds should not wait for the outport")
+	}
+
+	close(unblockOutport)
+	time.Sleep(100 * time.Millisecond)
+	streamed := atomic.LoadUint32(&numStreamed)
+	assert.True(t, streamed > 0)
+	assert.True(t, streamed <= timelinesToSaveBufferSize+1)
+}
+
+func TestRoundTimelineRecorder_RoundEndedWithoutDriversShouldNotStream(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgRoundTimelineRecorder()
+	args.OutportHandler = &outportStub.OutportStub{
+		HasDriversCalled: func() bool {
+			return false
+		},
+		SaveConsensusRoundTimelineCalled: func(timeline *common.ConsensusRoundTimeline) {
+			assert.Fail(t, "should have not been called")
+		},
+	}
+	recorder, _ := NewRoundTimelineRecorder(args)
+	defer func() {
+		_ = recorder.Close()
+	}()
+
+	recorder.RoundStarted(1, time.Unix(1000, 0))
+	recorder.RoundEnded(1, true, "")
+
+	timelines := recorder.GetLastRounds(1)
+	require.Equal(t, 1, len(timelines))
+	assert.Equal(t, OutcomeCompleted, timelines[0].Outcome)
+	assert.Empty(t, timelines[0].FailedSubround)
+}
+
+func TestRoundTimelineRecorder_GetLastRounds(t *testing.T) {
+	t.Parallel()
+
+	recorder, _ := NewRoundTimelineRecorder(createMockArgRoundTimelineRecorder())
+	defer func() {
+		_ = recorder.Close()
+	}()
+
+	for round := int64(1); round <= 10; round++ {
+		if round == 8 {
+			// missed round, nothing recorded
+			continue
+		}
+
+		recorder.RoundStarted(round, time.Unix(round, 0))
+		recorder.RoundEnded(round, true, "")
+	}
+
+	getRounds := func(timelines []*common.ConsensusRoundTimeline) []int64 {
+		rounds := make([]int64, 0, len(timelines))
+		for _, timeline := range timelines {
+			rounds = append(rounds, timeline.Round)
+		}
+
+		return rounds
+	}
+
+	assert.Equal(t, []int64{9, 10}, getRounds(recorder.GetLastRounds(2)))
+	assert.Equal(t, []int64{9, 10}, getRounds(recorder.GetLastRounds(3)))
+	assert.Equal(t, []int64{7, 9, 10}, getRounds(recorder.GetLastRounds(100)))
+	assert.Empty(t, recorder.GetLastRounds(0))
+
+	// events for rounds older than the ring buffer are ignored
+	recorder.SignatureReceived(5, []byte("late signer"))
+	assert.Equal(t, []int64{7, 9, 10}, getRounds(recorder.GetLastRounds(100)))
+
+	// events for a round not yet started create its timeline
+	recorder.HeaderReceived(11, []byte("leader"), []byte("hash"))
+	timelines := recorder.GetLastRounds(1)
+	require.Equal(t, 1, len(timelines))
+	assert.Equal(t, int64(11), timelines[0].Round)
+	assert.Equal(t, OutcomeInProgress, timelines[0].Outcome)
+	assert.Equal(t, int64(0), timelines[0].Header.OffsetMs)
+}
+
+func TestRoundTimelineRecorder_IsInterfaceNil(t *testing.T) {
+	t.Parallel()
+
+	var recorder *roundTimelineRecorder
+	assert.True(t, recorder.IsInterfaceNil())
+
+	recorder, _ = NewRoundTimelineRecorder(createMockArgRoundTimelineRecorder())
+	assert.False(t, recorder.IsInterfaceNil())
+	_ = recorder.Close()
+}
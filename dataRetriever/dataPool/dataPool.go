@@ -2,6 +2,7 @@ package dataPool
 
 import (
 	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-core-go/core/closing"
 	"github.com/multiversx/mx-chain-go/dataRetriever"
 	"github.com/multiversx/mx-chain-go/storage"
 	logger "github.com/multiversx/mx-chain-logger-go"
@@ -200,6 +201,16 @@ func (dp *dataPool) Close() error {
 		}
 	}
 
+	txPoolCloser, ok := dp.transactions.(closing.Closer)
+	if ok {
+		log.Debug("closing transactions data pool....")
+		err := txPoolCloser.Close()
+		if err != nil {
+			log.Error("failed to close transactions data pool", "error", err.Error())
+			lastError = err
+		}
+	}
+
 	return lastError
 }
 
@@ -265,3 +265,19 @@ var ErrNilValidatorInfoStorage = errors.New("nil validator info storage")
 
 // ErrValidatorInfoNotFound signals that no validator info was found
 var ErrValidatorInfoNotFound = errors.New("validator info not found")
+
+// ErrNilPersister signals that a nil persister has been provided
+var ErrNilPersister = errors.New("nil persister")
+
+// ErrNilTxPoolJournal signals that a nil transactions pool journal has been provided
+var ErrNilTxPoolJournal = errors.New("nil transactions pool journal")
+
+// ErrNilTxValidator signals that a nil transaction validator has been provided
+var ErrNilTxValidator = errors.New("nil transaction validator")
+
+// ErrTxReplacementUnderpriced signals that a transaction can not replace the pooled one with the same sender and nonce
+// because its gas price is not high enough
+var ErrTxReplacementUnderpriced = errors.New("replacement transaction underpriced")
+
+// ErrTxReplacementLimitReached signals that the maximum number of replacements for a sender and nonce has been reached
+var ErrTxReplacementLimitReached = errors.New("transaction replacement limit reached")
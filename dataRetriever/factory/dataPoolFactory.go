@@ -59,11 +59,18 @@ func NewDataPoolFromConfig(args ArgsDataPool) (dataRetriever.PoolsHolder, error)
 
 	mainConfig := args.Config
 
+	txPoolJournal, err := createTxPoolJournal(args)
+	if err != nil {
+		return nil, err
+	}
+
 	txPool, err := txpool.NewShardedTxPool(txpool.ArgShardedTxPool{
-		Config:         factory.GetCacherFromConfig(mainConfig.TxDataPool),
-		NumberOfShards: args.ShardCoordinator.NumberOfShards(),
-		SelfShardID:    args.ShardCoordinator.SelfId(),
-		TxGasHandler:   args.EconomicsData,
+		Config:            factory.GetCacherFromConfig(mainConfig.TxDataPool),
+		ReplacementConfig: mainConfig.TxPoolReplacement,
+		Journal:           txPoolJournal,
+		NumberOfShards:    args.ShardCoordinator.NumberOfShards(),
+		SelfShardID:       args.ShardCoordinator.SelfId(),
+		TxGasHandler:      args.EconomicsData,
 	})
 	if err != nil {
 		return nil, fmt.Errorf("%w while creating the cache for the transactions", err)
@@ -190,3 +197,38 @@ func createTrieSyncDB(args ArgsDataPool) (storage.Persister, error) {
 
 	return db, nil
 }
+
+func createTxPoolJournal(args ArgsDataPool) (txpool.TxPoolJournal, error) {
+	journalConfig := args.Config.TxPoolJournal
+
+	if !journalConfig.Enabled {
+		log.Debug("no journal for the transactions pool")
+		return txpool.NewDisabledJournal(), nil
+	}
+
+	shardId := core.GetShardIDString(args.ShardCoordinator.SelfId())
+	path := args.PathManager.PathForStatic(shardId, journalConfig.DB.FilePath)
+
+	persisterFactory, err := factory.NewPersisterFactory(journalConfig.DB)
+	if err != nil {
+		return nil, err
+	}
+
+	db, err := persisterFactory.CreateWithRetries(path)
+	if err != nil {
+		return nil, fmt.Errorf("%w while creating the db for the transactions pool journal", err)
+	}
+
+	journal, err := txpool.NewTxPoolJournal(txpool.ArgTxPoolJournal{
+		Persister:     db,
+		Marshalizer:   args.Marshalizer,
+		MaxAge:        time.Duration(journalConfig.MaxAgeInSeconds) * time.Second,
+		FlushInterval: time.Duration(journalConfig.FlushIntervalInMilliseconds) * time.Millisecond,
+		PruneInterval: time.Duration(journalConfig.PruneIntervalInSeconds) * time.Second,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("%w while creating the transactions pool journal", err)
+	}
+
+	return journal, nil
+}
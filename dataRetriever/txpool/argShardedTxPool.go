@@ -5,6 +5,7 @@ import (
 	"fmt"
 
 	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-go/config"
 	"github.com/multiversx/mx-chain-go/dataRetriever"
 	"github.com/multiversx/mx-chain-go/storage/storageunit"
 	"github.com/multiversx/mx-chain-go/storage/txcache"
@@ -12,10 +13,12 @@ import (
 
 // ArgShardedTxPool is the argument for ShardedTxPool's constructor
 type ArgShardedTxPool struct {
-	Config         storageunit.CacheConfig
-	TxGasHandler   txcache.TxGasHandler
-	NumberOfShards uint32
-	SelfShardID    uint32
+	Config            storageunit.CacheConfig
+	ReplacementConfig config.TxPoolReplacementConfig
+	TxGasHandler      txcache.TxGasHandler
+	Journal           TxPoolJournal
+	NumberOfShards    uint32
+	SelfShardID       uint32
 }
 
 // TODO: Upon further analysis and brainstorming, add some sensible minimum accepted values for the appropriate fields.
@@ -43,6 +46,9 @@ func (args *ArgShardedTxPool) verify() error {
 	if args.TxGasHandler.MinGasPrice() == 0 {
 		return fmt.Errorf("%w: MinGasPrice is not valid", dataRetriever.ErrCacheConfigInvalidEconomics)
 	}
+	if check.IfNil(args.Journal) {
+		return fmt.Errorf("%w: Journal is not valid", dataRetriever.ErrNilTxPoolJournal)
+	}
 	if args.NumberOfShards == 0 {
 		return fmt.Errorf("%w: NumberOfShards is not valid", dataRetriever.ErrCacheConfigInvalidSharding)
 	}
@@ -0,0 +1,44 @@
+package txpool
+
+import (
+	"github.com/multiversx/mx-chain-core-go/data"
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+)
+
+type disabledJournal struct {
+}
+
+// NewDisabledJournal creates a journal that does not record anything
+func NewDisabledJournal() *disabledJournal {
+	return &disabledJournal{}
+}
+
+// RecordAdded does nothing
+func (journal *disabledJournal) RecordAdded(_ []byte, _ data.TransactionHandler, _ int, _ string) {
+}
+
+// RecordRemoved does nothing
+func (journal *disabledJournal) RecordRemoved(_ []byte) {
+}
+
+// Prune does nothing
+func (journal *disabledJournal) Prune() {
+}
+
+// SetIsInPoolHandler does nothing
+func (journal *disabledJournal) SetIsInPoolHandler(_ func(txHash []byte) bool) {
+}
+
+// ForEachJournaledTx does nothing
+func (journal *disabledJournal) ForEachJournaledTx(_ func(txHash []byte, tx *transaction.Transaction, sizeInBytes int, cacheID string)) {
+}
+
+// Close returns nil
+func (journal *disabledJournal) Close() error {
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (journal *disabledJournal) IsInterfaceNil() bool {
+	return journal == nil
+}
@@ -1,6 +1,8 @@
 package txpool
 
 import (
+	"github.com/multiversx/mx-chain-core-go/data"
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
 	"github.com/multiversx/mx-chain-go/storage"
 	"github.com/multiversx/mx-chain-go/storage/txcache"
 )
@@ -17,3 +19,20 @@ type txCache interface {
 	Diagnose(deep bool)
 	GetTransactionsPoolForSender(sender string) []*txcache.WrappedTransaction
 }
+
+// TxPoolJournal defines the journal that records the transactions added in and removed from the pool
+type TxPoolJournal interface {
+	RecordAdded(txHash []byte, tx data.TransactionHandler, sizeInBytes int, cacheID string)
+	RecordRemoved(txHash []byte)
+	Prune()
+	SetIsInPoolHandler(handler func(txHash []byte) bool)
+	ForEachJournaledTx(handler func(txHash []byte, tx *transaction.Transaction, sizeInBytes int, cacheID string))
+	Close() error
+	IsInterfaceNil() bool
+}
+
+// TxValidator defines the validator used on the transactions restored from the journal
+type TxValidator interface {
+	ValidateTransaction(tx *transaction.Transaction) error
+	IsInterfaceNil() bool
+}
@@ -0,0 +1,417 @@
+package txpool
+
+import (
+	"context"
+	"encoding/binary"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-core-go/data"
+	"github.com/multiversx/mx-chain-core-go/data/batch"
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	"github.com/multiversx/mx-chain-core-go/marshal"
+	"github.com/multiversx/mx-chain-go/dataRetriever"
+	"github.com/multiversx/mx-chain-go/storage"
+)
+
+var _ TxPoolJournal = (*txPoolJournal)(nil)
+
+const (
+	entryTxBytesIndex = iota
+	entryCacheIDIndex
+	entrySizeIndex
+	entryTimestampIndex
+	numEntryFields
+)
+
+const uint64Size = 8
+
+// ArgTxPoolJournal is the argument for the transactions pool journal's constructor
+type ArgTxPoolJournal struct {
+	Persister     storage.Persister
+	Marshalizer   marshal.Marshalizer
+	MaxAge        time.Duration
+	FlushInterval time.Duration
+	PruneInterval time.Duration
+}
+
+// journalEntry is the record persisted for each transaction added in the pool
+type journalEntry struct {
+	cacheID     string
+	sizeInBytes int
+	timestamp   int64
+	txBytes     []byte
+}
+
+// pendingRecord is a journal change not yet written in the persister. A nil transaction marks a removal
+type pendingRecord struct {
+	tx          data.TransactionHandler
+	sizeInBytes int
+	cacheID     string
+}
+
+type txPoolJournal struct {
+	persister     storage.Persister
+	marshalizer   marshal.Marshalizer
+	maxAge        time.Duration
+	flushInterval time.Duration
+	pruneInterval time.Duration
+	getTimeFunc   func() time.Time
+
+	mutPending     sync.Mutex
+	pending        map[string]*pendingRecord
+	pruneRequested bool
+
+	mutJournaled sync.Mutex
+	journaled    map[string]int64
+
+	mutIsInPoolHandler sync.RWMutex
+	isInPoolHandler    func(txHash []byte) bool
+
+	cancelFunc   func()
+	chanLoopDone chan struct{}
+}
+
+// NewTxPoolJournal creates a journal that records the transactions added in and removed from the pool. The records
+// are kept in memory and written in the persister, in batches, on a separate go routine
+func NewTxPoolJournal(args ArgTxPoolJournal) (*txPoolJournal, error) {
+	if check.IfNil(args.Persister) {
+		return nil, dataRetriever.ErrNilPersister
+	}
+	if check.IfNil(args.Marshalizer) {
+		return nil, dataRetriever.ErrNilMarshalizer
+	}
+	if args.MaxAge <= 0 {
+		return nil, fmt.Errorf("%w: MaxAge is not valid", dataRetriever.ErrInvalidValue)
+	}
+	if args.FlushInterval <= 0 {
+		return nil, fmt.Errorf("%w: FlushInterval is not valid", dataRetriever.ErrInvalidValue)
+	}
+	if args.PruneInterval <= 0 {
+		return nil, fmt.Errorf("%w: PruneInterval is not valid", dataRetriever.ErrInvalidValue)
+	}
+
+	journal := &txPoolJournal{
+		persister:     args.Persister,
+		marshalizer:   args.Marshalizer,
+		maxAge:        args.MaxAge,
+		flushInterval: args.FlushInterval,
+		pruneInterval: args.PruneInterval,
+		getTimeFunc:   time.Now,
+		pending:       make(map[string]*pendingRecord),
+		journaled:     make(map[string]int64),
+		chanLoopDone:  make(chan struct{}),
+	}
+	journal.loadIndex()
+
+	var ctx context.Context
+	ctx, journal.cancelFunc = context.WithCancel(context.Background())
+	go journal.processLoop(ctx)
+
+	return journal, nil
+}
+
+// loadIndex reads the timestamps of the journaled transactions. The expired and the corrupted entries are removed
+func (journal *txPoolJournal) loadIndex() {
+	oldestAccepted := journal.getTimeFunc().Add(-journal.maxAge).Unix()
+
+	keysToRemove := make([][]byte, 0)
+	journal.persister.RangeKeys(func(key []byte, val []byte) bool {
+		txHash := make([]byte, len(key))
+		copy(txHash, key)
+
+		entry, err := journal.decodeEntry(val)
+		if err != nil {
+			log.Debug("txPoolJournal.loadIndex: decode entry", "txHash", txHash, "err", err)
+			keysToRemove = append(keysToRemove, txHash)
+			return true
+		}
+		if entry.timestamp < oldestAccepted {
+			keysToRemove = append(keysToRemove, txHash)
+			return true
+		}
+
+		journal.journaled[string(txHash)] = entry.timestamp
+
+		return true
+	})
+
+	for _, key := range keysToRemove {
+		journal.removeFromPersister(key)
+	}
+
+	log.Debug("txPoolJournal.loadIndex", "journaled", len(journal.journaled), "discarded", len(keysToRemove))
+}
+
+// SetIsInPoolHandler sets the handler used when pruning the journal of the transactions no longer in the pool
+func (journal *txPoolJournal) SetIsInPoolHandler(handler func(txHash []byte) bool) {
+	journal.mutIsInPoolHandler.Lock()
+	journal.isInPoolHandler = handler
+	journal.mutIsInPoolHandler.Unlock()
+}
+
+// RecordAdded schedules the persisting of the provided transaction. An already journaled transaction keeps its
+// original timestamp
+func (journal *txPoolJournal) RecordAdded(txHash []byte, tx data.TransactionHandler, sizeInBytes int, cacheID string) {
+	if check.IfNil(tx) {
+		return
+	}
+
+	journal.mutPending.Lock()
+	journal.pending[string(txHash)] = &pendingRecord{
+		tx:          tx,
+		sizeInBytes: sizeInBytes,
+		cacheID:     cacheID,
+	}
+	journal.mutPending.Unlock()
+}
+
+// RecordRemoved schedules the removal of the provided transaction from the journal
+func (journal *txPoolJournal) RecordRemoved(txHash []byte) {
+	journal.mutPending.Lock()
+	journal.pending[string(txHash)] = &pendingRecord{}
+	journal.mutPending.Unlock()
+}
+
+// Prune schedules the removal from the journal of all the transactions no longer found in the pool
+func (journal *txPoolJournal) Prune() {
+	journal.mutPending.Lock()
+	journal.pruneRequested = true
+	journal.mutPending.Unlock()
+}
+
+func (journal *txPoolJournal) processLoop(ctx context.Context) {
+	defer close(journal.chanLoopDone)
+
+	flushTicker := time.NewTicker(journal.flushInterval)
+	defer flushTicker.Stop()
+
+	pruneTicker := time.NewTicker(journal.pruneInterval)
+	defer pruneTicker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			log.Debug("txPoolJournal.processLoop: closing")
+			return
+		case <-flushTicker.C:
+			journal.flush()
+		case <-pruneTicker.C:
+			journal.Prune()
+		}
+	}
+}
+
+// flush writes the pending records in the persister and runs the requested pruning, if any
+func (journal *txPoolJournal) flush() {
+	journal.mutPending.Lock()
+	pending := journal.pending
+	journal.pending = make(map[string]*pendingRecord)
+	pruneRequested := journal.pruneRequested
+	journal.pruneRequested = false
+	journal.mutPending.Unlock()
+
+	journal.mutJournaled.Lock()
+	defer journal.mutJournaled.Unlock()
+
+	for txHash, record := range pending {
+		if check.IfNil(record.tx) {
+			journal.removeEntry([]byte(txHash))
+			continue
+		}
+
+		journal.putEntry([]byte(txHash), record)
+	}
+
+	if pruneRequested {
+		journal.prune()
+	}
+}
+
+func (journal *txPoolJournal) putEntry(txHash []byte, record *pendingRecord) {
+	timestamp, found := journal.journaled[string(txHash)]
+	if !found {
+		timestamp = journal.getTimeFunc().Unix()
+	}
+
+	txBytes, err := journal.marshalizer.Marshal(record.tx)
+	if err != nil {
+		log.Debug("txPoolJournal.putEntry: marshal tx", "txHash", txHash, "err", err)
+		return
+	}
+
+	entryBytes, err := journal.encodeEntry(&journalEntry{
+		cacheID:     record.cacheID,
+		sizeInBytes: record.sizeInBytes,
+		timestamp:   timestamp,
+		txBytes:     txBytes,
+	})
+	if err != nil {
+		log.Debug("txPoolJournal.putEntry: marshal entry", "txHash", txHash, "err", err)
+		return
+	}
+
+	err = journal.persister.Put(txHash, entryBytes)
+	if err != nil {
+		log.Debug("txPoolJournal.putEntry: put", "txHash", txHash, "err", err)
+		return
+	}
+
+	journal.journaled[string(txHash)] = timestamp
+}
+
+func (journal *txPoolJournal) removeEntry(txHash []byte) {
+	_, found := journal.journaled[string(txHash)]
+	if !found {
+		return
+	}
+
+	delete(journal.journaled, string(txHash))
+	journal.removeFromPersister(txHash)
+}
+
+func (journal *txPoolJournal) removeFromPersister(txHash []byte) {
+	err := journal.persister.Remove(txHash)
+	if err != nil {
+		log.Debug("txPoolJournal.removeFromPersister", "txHash", txHash, "err", err)
+	}
+}
+
+// prune removes the journaled transactions no longer found in the pool, covering the evicted and the cleared ones
+func (journal *txPoolJournal) prune() {
+	journal.mutIsInPoolHandler.RLock()
+	isInPool := journal.isInPoolHandler
+	journal.mutIsInPoolHandler.RUnlock()
+	if isInPool == nil {
+		return
+	}
+
+	numPruned := 0
+	for txHash := range journal.journaled {
+		if isInPool([]byte(txHash)) {
+			continue
+		}
+
+		journal.removeEntry([]byte(txHash))
+		numPruned++
+	}
+
+	log.Trace("txPoolJournal.prune", "pruned", numPruned, "journaled", len(journal.journaled))
+}
+
+func (journal *txPoolJournal) encodeEntry(entry *journalEntry) ([]byte, error) {
+	fields := make([][]byte, numEntryFields)
+	fields[entryTxBytesIndex] = entry.txBytes
+	fields[entryCacheIDIndex] = []byte(entry.cacheID)
+	fields[entrySizeIndex] = make([]byte, uint64Size)
+	binary.BigEndian.PutUint64(fields[entrySizeIndex], uint64(entry.sizeInBytes))
+	fields[entryTimestampIndex] = make([]byte, uint64Size)
+	binary.BigEndian.PutUint64(fields[entryTimestampIndex], uint64(entry.timestamp))
+
+	return journal.marshalizer.Marshal(&batch.Batch{Data: fields})
+}
+
+func (journal *txPoolJournal) decodeEntry(buff []byte) (*journalEntry, error) {
+	entryBatch := &batch.Batch{}
+	err := journal.marshalizer.Unmarshal(entryBatch, buff)
+	if err != nil {
+		return nil, err
+	}
+	if len(entryBatch.Data) != numEntryFields {
+		return nil, fmt.Errorf("%w: journal entry with %d fields", dataRetriever.ErrInvalidValue, len(entryBatch.Data))
+	}
+	if len(entryBatch.Data[entrySizeIndex]) != uint64Size || len(entryBatch.Data[entryTimestampIndex]) != uint64Size {
+		return nil, fmt.Errorf("%w: journal entry with invalid size or timestamp", dataRetriever.ErrInvalidValue)
+	}
+
+	return &journalEntry{
+		cacheID:     string(entryBatch.Data[entryCacheIDIndex]),
+		sizeInBytes: int(binary.BigEndian.Uint64(entryBatch.Data[entrySizeIndex])),
+		timestamp:   int64(binary.BigEndian.Uint64(entryBatch.Data[entryTimestampIndex])),
+		txBytes:     entryBatch.Data[entryTxBytesIndex],
+	}, nil
+}
+
+// ForEachJournaledTx calls the handler for each journaled transaction not older than the maximum age. The pending
+// records are written beforehand
+func (journal *txPoolJournal) ForEachJournaledTx(handler func(txHash []byte, tx *transaction.Transaction, sizeInBytes int, cacheID string)) {
+	if handler == nil {
+		return
+	}
+
+	journal.flush()
+
+	oldestAccepted := journal.getTimeFunc().Add(-journal.maxAge).Unix()
+	loaded := make([]*journaledTransaction, 0)
+	journal.mutJournaled.Lock()
+	for txHash, timestamp := range journal.journaled {
+		if timestamp < oldestAccepted {
+			journal.removeEntry([]byte(txHash))
+			continue
+		}
+
+		journaled, err := journal.loadTransaction([]byte(txHash))
+		if err != nil {
+			log.Debug("txPoolJournal.ForEachJournaledTx: load transaction", "txHash", []byte(txHash), "err", err)
+			journal.removeEntry([]byte(txHash))
+			continue
+		}
+
+		loaded = append(loaded, journaled)
+	}
+	journal.mutJournaled.Unlock()
+
+	for _, journaled := range loaded {
+		handler(journaled.txHash, journaled.tx, journaled.sizeInBytes, journaled.cacheID)
+	}
+}
+
+// journaledTransaction is a transaction loaded back from the journal
+type journaledTransaction struct {
+	txHash      []byte
+	tx          *transaction.Transaction
+	sizeInBytes int
+	cacheID     string
+}
+
+func (journal *txPoolJournal) loadTransaction(txHash []byte) (*journaledTransaction, error) {
+	buff, err := journal.persister.Get(txHash)
+	if err != nil {
+		return nil, err
+	}
+
+	entry, err := journal.decodeEntry(buff)
+	if err != nil {
+		return nil, err
+	}
+
+	tx := &transaction.Transaction{}
+	err = journal.marshalizer.Unmarshal(tx, entry.txBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	return &journaledTransaction{
+		txHash:      txHash,
+		tx:          tx,
+		sizeInBytes: entry.sizeInBytes,
+		cacheID:     entry.cacheID,
+	}, nil
+}
+
+// Close writes the pending records and closes the underlying persister
+func (journal *txPoolJournal) Close() error {
+	journal.cancelFunc()
+	<-journal.chanLoopDone
+
+	journal.flush()
+
+	return journal.persister.Close()
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (journal *txPoolJournal) IsInterfaceNil() bool {
+	return journal == nil
+}
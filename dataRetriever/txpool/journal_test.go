@@ -0,0 +1,262 @@
+package txpool
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	"github.com/multiversx/mx-chain-go/dataRetriever"
+	"github.com/multiversx/mx-chain-go/storage/database"
+	"github.com/multiversx/mx-chain-go/storage/mock"
+	"github.com/multiversx/mx-chain-go/testscommon/marshallerMock"
+	"github.com/stretchr/testify/require"
+)
+
+func createMockArgTxPoolJournal() ArgTxPoolJournal {
+	return ArgTxPoolJournal{
+		Persister:     database.NewMemDB(),
+		Marshalizer:   &marshallerMock.MarshalizerMock{},
+		MaxAge:        time.Minute,
+		FlushInterval: time.Hour,
+		PruneInterval: time.Hour,
+	}
+}
+
+func TestNewTxPoolJournal(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil persister should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgTxPoolJournal()
+		args.Persister = nil
+		journal, err := NewTxPoolJournal(args)
+		require.True(t, check.IfNil(journal))
+		require.Equal(t, dataRetriever.ErrNilPersister, err)
+	})
+	t.Run("nil marshalizer should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgTxPoolJournal()
+		args.Marshalizer = nil
+		journal, err := NewTxPoolJournal(args)
+		require.True(t, check.IfNil(journal))
+		require.Equal(t, dataRetriever.ErrNilMarshalizer, err)
+	})
+	t.Run("invalid max age should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgTxPoolJournal()
+		args.MaxAge = 0
+		journal, err := NewTxPoolJournal(args)
+		require.True(t, check.IfNil(journal))
+		require.True(t, errors.Is(err, dataRetriever.ErrInvalidValue))
+	})
+	t.Run("invalid flush interval should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgTxPoolJournal()
+		args.FlushInterval = 0
+		journal, err := NewTxPoolJournal(args)
+		require.True(t, check.IfNil(journal))
+		require.True(t, errors.Is(err, dataRetriever.ErrInvalidValue))
+	})
+	t.Run("invalid prune interval should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgTxPoolJournal()
+		args.PruneInterval = 0
+		journal, err := NewTxPoolJournal(args)
+		require.True(t, check.IfNil(journal))
+		require.True(t, errors.Is(err, dataRetriever.ErrInvalidValue))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		journal, err := NewTxPoolJournal(createMockArgTxPoolJournal())
+		require.False(t, check.IfNil(journal))
+		require.Nil(t, err)
+		_ = journal.Close()
+	})
+}
+
+func TestTxPoolJournal_RecordAddedAndRemoved(t *testing.T) {
+	t.Parallel()
+
+	journal, _ := NewTxPoolJournal(createMockArgTxPoolJournal())
+
+	journal.RecordAdded([]byte("hash-a"), createTx("alice", 1), 100, "0")
+	journal.RecordAdded([]byte("hash-b"), createTx("bob", 7), 120, "0_1")
+	journal.RecordRemoved([]byte("hash-a"))
+
+	numCalls := 0
+	journal.ForEachJournaledTx(func(txHash []byte, tx *transaction.Transaction, sizeInBytes int, cacheID string) {
+		numCalls++
+		require.Equal(t, []byte("hash-b"), txHash)
+		require.Equal(t, []byte("bob"), tx.SndAddr)
+		require.Equal(t, uint64(7), tx.Nonce)
+		require.Equal(t, 120, sizeInBytes)
+		require.Equal(t, "0_1", cacheID)
+	})
+	require.Equal(t, 1, numCalls)
+}
+
+func TestTxPoolJournal_RecordAddedShouldKeepTheOriginalTimestamp(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgTxPoolJournal()
+	journal, _ := NewTxPoolJournal(args)
+
+	currentTime := time.Now()
+	journal.getTimeFunc = func() time.Time {
+		return currentTime
+	}
+	journal.RecordAdded([]byte("hash-a"), createTx("alice", 1), 100, "0")
+	journal.flush()
+
+	currentTime = currentTime.Add(args.MaxAge / 2)
+	journal.RecordAdded([]byte("hash-a"), createTx("alice", 1), 100, "0_1")
+	journal.flush()
+
+	currentTime = currentTime.Add(args.MaxAge)
+	numCalls := 0
+	journal.ForEachJournaledTx(func(txHash []byte, tx *transaction.Transaction, sizeInBytes int, cacheID string) {
+		numCalls++
+	})
+	require.Equal(t, 0, numCalls)
+}
+
+func TestTxPoolJournal_ForEachJournaledTxShouldDiscardExpiredAndCorruptedEntries(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgTxPoolJournal()
+	journal, _ := NewTxPoolJournal(args)
+
+	currentTime := time.Now()
+	journal.getTimeFunc = func() time.Time {
+		return currentTime
+	}
+	journal.RecordAdded([]byte("hash-old"), createTx("alice", 1), 100, "0")
+	journal.flush()
+
+	currentTime = currentTime.Add(args.MaxAge * 2)
+	journal.RecordAdded([]byte("hash-new"), createTx("alice", 2), 100, "0")
+	journal.flush()
+	_ = args.Persister.Put([]byte("hash-corrupted"), []byte("not a journal entry"))
+
+	// the index is loaded from the persister at startup
+	journal, _ = NewTxPoolJournal(args)
+	journal.getTimeFunc = func() time.Time {
+		return currentTime
+	}
+
+	journaledHashes := make([]string, 0)
+	journal.ForEachJournaledTx(func(txHash []byte, tx *transaction.Transaction, sizeInBytes int, cacheID string) {
+		journaledHashes = append(journaledHashes, string(txHash))
+	})
+	require.Equal(t, []string{"hash-new"}, journaledHashes)
+
+	require.NotNil(t, args.Persister.Has([]byte("hash-old")))
+	require.NotNil(t, args.Persister.Has([]byte("hash-corrupted")))
+	require.Nil(t, args.Persister.Has([]byte("hash-new")))
+}
+
+func TestTxPoolJournal_RecordsShouldBeWrittenOnFlush(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgTxPoolJournal()
+	journal, _ := NewTxPoolJournal(args)
+
+	journal.RecordAdded([]byte("hash-a"), createTx("alice", 1), 100, "0")
+	journal.RecordAdded([]byte("hash-b"), createTx("bob", 1), 100, "0")
+	require.NotNil(t, args.Persister.Has([]byte("hash-a")))
+
+	journal.flush()
+	require.Nil(t, args.Persister.Has([]byte("hash-a")))
+	require.Nil(t, args.Persister.Has([]byte("hash-b")))
+
+	journal.RecordRemoved([]byte("hash-a"))
+	require.Nil(t, args.Persister.Has([]byte("hash-a")))
+
+	journal.flush()
+	require.NotNil(t, args.Persister.Has([]byte("hash-a")))
+	require.Nil(t, args.Persister.Has([]byte("hash-b")))
+}
+
+func TestTxPoolJournal_RecordAddedShouldUpdateTheCacheID(t *testing.T) {
+	t.Parallel()
+
+	journal, _ := NewTxPoolJournal(createMockArgTxPoolJournal())
+
+	journal.RecordAdded([]byte("hash-a"), createTx("alice", 1), 100, "1_0")
+	journal.flush()
+	journal.RecordAdded([]byte("hash-a"), createTx("alice", 1), 100, "0")
+
+	journal.ForEachJournaledTx(func(txHash []byte, tx *transaction.Transaction, sizeInBytes int, cacheID string) {
+		require.Equal(t, "0", cacheID)
+	})
+}
+
+func TestTxPoolJournal_PruneShouldRemoveTheTransactionsNoLongerInPool(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgTxPoolJournal()
+	journal, _ := NewTxPoolJournal(args)
+
+	journal.RecordAdded([]byte("hash-pooled"), createTx("alice", 1), 100, "0")
+	journal.RecordAdded([]byte("hash-evicted"), createTx("bob", 1), 100, "0")
+	journal.Prune()
+	journal.flush()
+	require.Nil(t, args.Persister.Has([]byte("hash-evicted")), "no pool handler set, nothing should be pruned")
+
+	journal.SetIsInPoolHandler(func(txHash []byte) bool {
+		return string(txHash) == "hash-pooled"
+	})
+	journal.Prune()
+	journal.flush()
+	require.Nil(t, args.Persister.Has([]byte("hash-pooled")))
+	require.NotNil(t, args.Persister.Has([]byte("hash-evicted")))
+}
+
+func TestTxPoolJournal_ShouldFlushOnTheProcessingLoop(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgTxPoolJournal()
+	args.FlushInterval = time.Millisecond * 10
+	journal, _ := NewTxPoolJournal(args)
+	defer func() {
+		_ = journal.Close()
+	}()
+
+	journal.RecordAdded([]byte("hash-a"), createTx("alice", 1), 100, "0")
+	require.Eventually(t, func() bool {
+		return args.Persister.Has([]byte("hash-a")) == nil
+	}, time.Second, time.Millisecond*10)
+}
+
+func TestTxPoolJournal_Close(t *testing.T) {
+	t.Parallel()
+
+	closeCalled := false
+	putCalled := false
+	args := createMockArgTxPoolJournal()
+	args.Persister = &mock.PersisterStub{
+		PutCalled: func(key, val []byte) error {
+			putCalled = true
+			return nil
+		},
+		CloseCalled: func() error {
+			require.True(t, putCalled, "pending records should be written before closing")
+			closeCalled = true
+			return nil
+		},
+	}
+	journal, _ := NewTxPoolJournal(args)
+	journal.RecordAdded([]byte("hash-a"), createTx("alice", 1), 100, "0")
+
+	err := journal.Close()
+	require.Nil(t, err)
+	require.True(t, closeCalled)
+}
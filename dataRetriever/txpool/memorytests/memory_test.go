@@ -116,6 +116,7 @@ func newPool() dataRetriever.ShardedDataCacherNotifier {
 			MinimumGasPrice:      200000000000,
 			GasProcessingDivisor: 100,
 		},
+		Journal:        txpool.NewDisabledJournal(),
 		NumberOfShards: 2,
 		SelfShardID:    0,
 	}
@@ -5,10 +5,13 @@ import (
 	"sync"
 
 	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/check"
 	"github.com/multiversx/mx-chain-core-go/core/counting"
 	"github.com/multiversx/mx-chain-core-go/data"
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
 	"github.com/multiversx/mx-chain-go/dataRetriever"
 	"github.com/multiversx/mx-chain-go/process"
+	"github.com/multiversx/mx-chain-go/sharding"
 	"github.com/multiversx/mx-chain-go/storage"
 	"github.com/multiversx/mx-chain-go/storage/txcache"
 	logger "github.com/multiversx/mx-chain-logger-go"
@@ -28,6 +31,8 @@ type shardedTxPool struct {
 	configPrototypeSourceMe      txcache.ConfigSourceMe
 	selfShardID                  uint32
 	txGasHandler                 txcache.TxGasHandler
+	journal                      TxPoolJournal
+	replacementPolicy            *txReplacementPolicy
 }
 
 type txPoolShard struct {
@@ -69,6 +74,11 @@ func NewShardedTxPool(args ArgShardedTxPool) (*shardedTxPool, error) {
 		NumItemsToPreemptivelyEvict: storage.TxPoolNumTxsToPreemptivelyEvict,
 	}
 
+	replacementPolicy, err := newTxReplacementPolicy(args.ReplacementConfig, args.Config.Capacity)
+	if err != nil {
+		return nil, err
+	}
+
 	shardedTxPoolObject := &shardedTxPool{
 		mutexBackingMap:              sync.RWMutex{},
 		backingMap:                   make(map[string]*txPoolShard),
@@ -78,7 +88,10 @@ func NewShardedTxPool(args ArgShardedTxPool) (*shardedTxPool, error) {
 		configPrototypeSourceMe:      configPrototypeSourceMe,
 		selfShardID:                  args.SelfShardID,
 		txGasHandler:                 args.TxGasHandler,
+		journal:                      args.Journal,
+		replacementPolicy:            replacementPolicy,
 	}
+	args.Journal.SetIsInPoolHandler(shardedTxPoolObject.isInPool)
 
 	return shardedTxPoolObject, nil
 }
@@ -181,15 +194,87 @@ func (txPool *shardedTxPool) AddData(key []byte, value interface{}, sizeInBytes
 		Size:            int64(sizeInBytes),
 	}
 
+	isForSenderMe := sourceShardID == txPool.selfShardID
+	if isForSenderMe && txPool.replacementPolicy.enabled {
+		txPool.addOrReplaceTx(wrapper, cacheID)
+		return
+	}
+
 	txPool.addTx(wrapper, cacheID)
 }
 
+// addOrReplaceTx adds the transaction to the cache, replacing the pooled one with the same sender and nonce
+// if the replacement policy allows it
+func (txPool *shardedTxPool) addOrReplaceTx(tx *txcache.WrappedTransaction, cacheID string) {
+	cache := txPool.getTxCache(cacheID)
+	_, found := txPool.replacementPolicy.findSameNonceTx(tx.TxHash, tx.Tx, cache)
+	if !found {
+		txPool.addTx(tx, cacheID)
+		return
+	}
+
+	txPool.replacementPolicy.mutReplacement.Lock()
+	defer txPool.replacementPolicy.mutReplacement.Unlock()
+
+	pooledTx, found := txPool.replacementPolicy.findSameNonceTx(tx.TxHash, tx.Tx, cache)
+	if !found {
+		txPool.addTx(tx, cacheID)
+		return
+	}
+
+	err := txPool.replacementPolicy.checkReplacement(tx.Tx, pooledTx.Tx)
+	if err != nil {
+		log.Trace("shardedTxPool.addOrReplaceTx: replacement rejected", "txHash", tx.TxHash, "pooled txHash", pooledTx.TxHash, "err", err)
+		return
+	}
+
+	txPool.journal.RecordRemoved(pooledTx.TxHash)
+	_ = cache.RemoveTxByHash(pooledTx.TxHash)
+	txPool.replacementPolicy.recordReplacement(tx, pooledTx)
+	txPool.addTx(tx, cacheID)
+
+	log.Debug("shardedTxPool.addOrReplaceTx: transaction replaced",
+		"sender", tx.Tx.GetSndAddr(),
+		"nonce", tx.Tx.GetNonce(),
+		"replaced txHash", pooledTx.TxHash,
+		"replaced gasPrice", pooledTx.Tx.GetGasPrice(),
+		"txHash", tx.TxHash,
+		"gasPrice", tx.Tx.GetGasPrice(),
+	)
+}
+
+// CheckTxReplacement returns an error if the provided transaction has the same sender and nonce as a pooled one
+// but is not allowed to replace it
+func (txPool *shardedTxPool) CheckTxReplacement(txHash []byte, tx data.TransactionHandler, cacheID string) error {
+	if check.IfNil(tx) {
+		return nil
+	}
+
+	isForSenderMe := process.IsShardCacherIdentifierForSourceMe(cacheID, txPool.selfShardID)
+	if !isForSenderMe {
+		return nil
+	}
+
+	pooledTx, found := txPool.replacementPolicy.findSameNonceTx(txHash, tx, txPool.getTxCache(cacheID))
+	if !found {
+		return nil
+	}
+
+	return txPool.replacementPolicy.checkReplacement(tx, pooledTx.Tx)
+}
+
+// GetReplacedTxHash returns the hash of the pooled transaction that the provided one has replaced, if any
+func (txPool *shardedTxPool) GetReplacedTxHash(txHash []byte) ([]byte, bool) {
+	return txPool.replacementPolicy.getReplacedTxHash(txHash)
+}
+
 // addTx adds the transaction to the cache
 func (txPool *shardedTxPool) addTx(tx *txcache.WrappedTransaction, cacheID string) {
 	shard := txPool.getOrCreateShard(cacheID)
 	cache := shard.Cache
 	_, added := cache.AddTx(tx)
 	if added {
+		txPool.journal.RecordAdded(tx.TxHash, tx.Tx, int(tx.Size), cacheID)
 		txPool.onAdded(tx.TxHash, tx)
 	}
 }
@@ -226,6 +311,11 @@ func (txPool *shardedTxPool) searchFirstTx(txHash []byte) (tx data.TransactionHa
 	return nil, false
 }
 
+func (txPool *shardedTxPool) isInPool(txHash []byte) bool {
+	_, ok := txPool.searchFirstTx(txHash)
+	return ok
+}
+
 // RemoveData removes the transaction from the pool
 func (txPool *shardedTxPool) RemoveData(key []byte, cacheID string) {
 	txPool.removeTx(key, cacheID)
@@ -233,6 +323,8 @@ func (txPool *shardedTxPool) RemoveData(key []byte, cacheID string) {
 
 // removeTx removes the transaction from the pool
 func (txPool *shardedTxPool) removeTx(txHash []byte, cacheID string) bool {
+	txPool.journal.RecordRemoved(txHash)
+
 	shard := txPool.getOrCreateShard(cacheID)
 	return shard.Cache.RemoveTxByHash(txHash)
 }
@@ -261,6 +353,8 @@ func (txPool *shardedTxPool) RemoveDataFromAllShards(key []byte) {
 
 // removeTxFromAllShards removes the transaction from the pool (it searches in all shards)
 func (txPool *shardedTxPool) removeTxFromAllShards(txHash []byte) {
+	txPool.journal.RecordRemoved(txHash)
+
 	txPool.mutexBackingMap.RLock()
 	defer txPool.mutexBackingMap.RUnlock()
 
@@ -270,7 +364,7 @@ func (txPool *shardedTxPool) removeTxFromAllShards(txHash []byte) {
 	}
 }
 
-// MergeShardStores merges two shards of the pool
+// MergeShardStores merges two shards of the pool. The merged transactions are journaled again, under the destination cache
 func (txPool *shardedTxPool) MergeShardStores(sourceCacheID, destCacheID string) {
 	sourceCacheID = txPool.routeToCacheUnions(sourceCacheID)
 	destCacheID = txPool.routeToCacheUnions(destCacheID)
@@ -292,12 +386,16 @@ func (txPool *shardedTxPool) Clear() {
 	txPool.mutexBackingMap.Lock()
 	txPool.backingMap = make(map[string]*txPoolShard)
 	txPool.mutexBackingMap.Unlock()
+
+	txPool.journal.Prune()
 }
 
 // ClearShardStore clears a specific cache
 func (txPool *shardedTxPool) ClearShardStore(cacheID string) {
 	shard := txPool.getOrCreateShard(cacheID)
 	shard.Cache.Clear()
+
+	txPool.journal.Prune()
 }
 
 // RegisterOnAdded registers a new handler to be called when a new transaction is added
@@ -327,6 +425,19 @@ func (txPool *shardedTxPool) GetCounts() counting.CountsWithSize {
 	return counts
 }
 
+// GetCountsPerCacheID returns the number of transactions held by each of the pool caches
+func (txPool *shardedTxPool) GetCountsPerCacheID() map[string]int64 {
+	txPool.mutexBackingMap.RLock()
+	defer txPool.mutexBackingMap.RUnlock()
+
+	counts := make(map[string]int64, len(txPool.backingMap))
+	for cacheID, shard := range txPool.backingMap {
+		counts[cacheID] = int64(shard.Cache.Len())
+	}
+
+	return counts
+}
+
 // Keys returns all the keys contained in shard caches
 func (txPool *shardedTxPool) Keys() [][]byte {
 	txPool.mutexBackingMap.RLock()
@@ -353,6 +464,55 @@ func (txPool *shardedTxPool) Diagnose(deep bool) {
 	}
 }
 
+// RestoreFromJournal re-adds in the pool the journaled transactions that still pass the provided validator,
+// against the current nonces and balances. The cache of each transaction is computed with the provided shard
+// coordinator, as the journaled one might be stale, and the transactions not belonging to the current shard are
+// dropped. It returns the number of restored transactions
+func (txPool *shardedTxPool) RestoreFromJournal(validator TxValidator, shardCoordinator sharding.Coordinator) (int, error) {
+	if check.IfNil(validator) {
+		return 0, dataRetriever.ErrNilTxValidator
+	}
+	if check.IfNil(shardCoordinator) {
+		return 0, dataRetriever.ErrNilShardCoordinator
+	}
+
+	numRestored := 0
+	numRejected := 0
+	numDropped := 0
+	txPool.journal.ForEachJournaledTx(func(txHash []byte, tx *transaction.Transaction, sizeInBytes int, _ string) {
+		senderShardID := shardCoordinator.ComputeId(tx.SndAddr)
+		receiverShardID := shardCoordinator.ComputeId(tx.RcvAddr)
+		selfShardID := shardCoordinator.SelfId()
+		if senderShardID != selfShardID && receiverShardID != selfShardID {
+			log.Trace("shardedTxPool.RestoreFromJournal: not for the current shard", "txHash", txHash)
+			txPool.journal.RecordRemoved(txHash)
+			numDropped++
+			return
+		}
+
+		err := validator.ValidateTransaction(tx)
+		if err != nil {
+			log.Trace("shardedTxPool.RestoreFromJournal: rejected", "txHash", txHash, "err", err)
+			txPool.journal.RecordRemoved(txHash)
+			numRejected++
+			return
+		}
+
+		cacheID := process.ShardCacherIdentifier(senderShardID, receiverShardID)
+		txPool.AddData(txHash, tx, sizeInBytes, cacheID)
+		numRestored++
+	})
+
+	log.Debug("shardedTxPool.RestoreFromJournal", "restored", numRestored, "rejected", numRejected, "dropped", numDropped)
+
+	return numRestored, nil
+}
+
+// Close closes the journal of the pool
+func (txPool *shardedTxPool) Close() error {
+	return txPool.journal.Close()
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (txPool *shardedTxPool) IsInterfaceNil() bool {
 	return txPool == nil
@@ -1,6 +1,7 @@
 package txpool
 
 import (
+	"errors"
 	"fmt"
 	"sync"
 	"sync/atomic"
@@ -10,8 +11,12 @@ import (
 	"github.com/multiversx/mx-chain-core-go/core/check"
 	"github.com/multiversx/mx-chain-core-go/data"
 	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	"github.com/multiversx/mx-chain-go/config"
 	"github.com/multiversx/mx-chain-go/dataRetriever"
+	"github.com/multiversx/mx-chain-go/storage/database"
 	"github.com/multiversx/mx-chain-go/storage/storageunit"
+	"github.com/multiversx/mx-chain-go/testscommon"
+	"github.com/multiversx/mx-chain-go/testscommon/marshallerMock"
 	"github.com/multiversx/mx-chain-go/testscommon/txcachemocks"
 	"github.com/stretchr/testify/require"
 )
@@ -38,6 +43,7 @@ func Test_NewShardedTxPool_WhenBadConfig(t *testing.T) {
 			MinimumGasPrice:      1000000000,
 			GasProcessingDivisor: 100,
 		},
+		Journal:        NewDisabledJournal(),
 		NumberOfShards: 1,
 	}
 
@@ -111,6 +117,7 @@ func Test_NewShardedTxPool_ComputesCacheConfig(t *testing.T) {
 			MinimumGasPrice:      1000000000,
 			GasProcessingDivisor: 1,
 		},
+		Journal:        NewDisabledJournal(),
 		NumberOfShards: 2,
 	}
 
@@ -341,6 +348,19 @@ func Test_GetCounts(t *testing.T) {
 	require.Equal(t, int64(0), pool.GetCounts().GetTotal())
 }
 
+func Test_GetCountsPerCacheID(t *testing.T) {
+	poolAsInterface, _ := newTxPoolToTest()
+	pool := poolAsInterface.(*shardedTxPool)
+
+	require.Empty(t, pool.GetCountsPerCacheID())
+	pool.AddData([]byte("hash-x"), createTx("alice", 42), 0, "1")
+	pool.AddData([]byte("hash-y"), createTx("alice", 43), 0, "1")
+	pool.AddData([]byte("hash-z"), createTx("bob", 15), 0, "3")
+	require.Equal(t, map[string]int64{"1": 2, "3": 1}, pool.GetCountsPerCacheID())
+	pool.RemoveDataFromAllShards([]byte("hash-x"))
+	require.Equal(t, map[string]int64{"1": 1, "3": 1}, pool.GetCountsPerCacheID())
+}
+
 func Test_Keys(t *testing.T) {
 	poolAsInterface, _ := newTxPoolToTest()
 	pool := poolAsInterface.(*shardedTxPool)
@@ -383,6 +403,293 @@ func Test_IsInterfaceNil(t *testing.T) {
 	require.True(t, check.IfNil(thisIsNil))
 }
 
+func Test_RestoreFromJournal(t *testing.T) {
+	journal, _ := NewTxPoolJournal(ArgTxPoolJournal{
+		Persister:     database.NewMemDB(),
+		Marshalizer:   &marshallerMock.MarshalizerMock{},
+		MaxAge:        time.Minute,
+		FlushInterval: time.Hour,
+		PruneInterval: time.Hour,
+	})
+	config := storageunit.CacheConfig{
+		Capacity:             100,
+		SizePerSender:        10,
+		SizeInBytes:          409600,
+		SizeInBytesPerSender: 40960,
+		Shards:               1,
+	}
+	args := ArgShardedTxPool{
+		Config: config,
+		TxGasHandler: &txcachemocks.TxGasHandlerMock{
+			MinimumGasMove:       50000,
+			MinimumGasPrice:      200000000000,
+			GasProcessingDivisor: 100,
+		},
+		Journal:        journal,
+		NumberOfShards: 4,
+		SelfShardID:    0,
+	}
+
+	pool, _ := NewShardedTxPool(args)
+	pool.AddData([]byte("hash-alice"), createTx("alice", 42), 0, "0")
+	pool.AddData([]byte("hash-bob"), createTx("bob", 7), 0, "0_1")
+	pool.AddData([]byte("hash-carol"), createTx("carol", 3), 0, "0")
+	pool.RemoveData([]byte("hash-carol"), "0")
+
+	restartedPool, _ := NewShardedTxPool(args)
+	require.Equal(t, int64(0), restartedPool.GetCounts().GetTotal())
+
+	shardCoordinator := &testscommon.ShardsCoordinatorMock{}
+	numRestored, err := restartedPool.RestoreFromJournal(nil, shardCoordinator)
+	require.Equal(t, dataRetriever.ErrNilTxValidator, err)
+	require.Zero(t, numRestored)
+
+	numRestored, err = restartedPool.RestoreFromJournal(&txValidatorStub{}, nil)
+	require.Equal(t, dataRetriever.ErrNilShardCoordinator, err)
+	require.Zero(t, numRestored)
+
+	validator := &txValidatorStub{
+		validateTransactionCalled: func(tx *transaction.Transaction) error {
+			if string(tx.SndAddr) == "bob" {
+				return errors.New("nonce too low")
+			}
+			return nil
+		},
+	}
+	numRestored, err = restartedPool.RestoreFromJournal(validator, shardCoordinator)
+	require.Nil(t, err)
+	require.Equal(t, 1, numRestored)
+
+	tx, ok := restartedPool.searchFirstTx([]byte("hash-alice"))
+	require.True(t, ok)
+	require.Equal(t, uint64(42), tx.GetNonce())
+	_, ok = restartedPool.searchFirstTx([]byte("hash-bob"))
+	require.False(t, ok)
+
+	// the rejected transaction was also removed from the journal
+	numRestored, _ = restartedPool.RestoreFromJournal(&txValidatorStub{}, shardCoordinator)
+	require.Equal(t, 1, numRestored)
+}
+
+func Test_RestoreFromJournalShouldRecomputeTheCacheID(t *testing.T) {
+	journal, _ := NewTxPoolJournal(ArgTxPoolJournal{
+		Persister:     database.NewMemDB(),
+		Marshalizer:   &marshallerMock.MarshalizerMock{},
+		MaxAge:        time.Minute,
+		FlushInterval: time.Hour,
+		PruneInterval: time.Hour,
+	})
+	args := ArgShardedTxPool{
+		Config: storageunit.CacheConfig{
+			Capacity:             100,
+			SizePerSender:        10,
+			SizeInBytes:          409600,
+			SizeInBytesPerSender: 40960,
+			Shards:               1,
+		},
+		TxGasHandler: &txcachemocks.TxGasHandlerMock{
+			MinimumGasMove:       50000,
+			MinimumGasPrice:      200000000000,
+			GasProcessingDivisor: 100,
+		},
+		Journal:        journal,
+		NumberOfShards: 4,
+		SelfShardID:    0,
+	}
+
+	// the transactions are journaled with stale cache identifiers, as if the shards split changed meanwhile
+	pool, _ := NewShardedTxPool(args)
+	pool.AddData([]byte("hash-alice"), &transaction.Transaction{SndAddr: []byte("alice"), RcvAddr: []byte("dave"), Nonce: 1}, 0, "0")
+	pool.AddData([]byte("hash-erin"), &transaction.Transaction{SndAddr: []byte("erin"), RcvAddr: []byte("dave"), Nonce: 2}, 0, "0")
+	pool.AddData([]byte("hash-frank"), &transaction.Transaction{SndAddr: []byte("frank"), RcvAddr: []byte("alice"), Nonce: 3}, 0, "0")
+
+	addressesShards := map[string]uint32{
+		"alice": 0,
+		"dave":  1,
+		"erin":  2,
+		"frank": 2,
+	}
+	shardCoordinator := &testscommon.ShardsCoordinatorMock{
+		CurrentShard: 0,
+		ComputeIdCalled: func(address []byte) uint32 {
+			return addressesShards[string(address)]
+		},
+	}
+
+	restartedPool, _ := NewShardedTxPool(args)
+	numRestored, err := restartedPool.RestoreFromJournal(&txValidatorStub{}, shardCoordinator)
+	require.Nil(t, err)
+	require.Equal(t, 2, numRestored)
+
+	_, ok := restartedPool.getTxCache("0_1").GetByTxHash([]byte("hash-alice"))
+	require.True(t, ok)
+	_, ok = restartedPool.getTxCache("2_0").GetByTxHash([]byte("hash-frank"))
+	require.True(t, ok)
+	_, ok = restartedPool.getTxCache("0").GetByTxHash([]byte("hash-frank"))
+	require.False(t, ok)
+	_, ok = restartedPool.searchFirstTx([]byte("hash-erin"))
+	require.False(t, ok)
+
+	// the transaction of the other shards was also removed from the journal
+	shardCoordinator.ComputeIdCalled = nil
+	anotherRestartedPool, _ := NewShardedTxPool(args)
+	numRestored, _ = anotherRestartedPool.RestoreFromJournal(&txValidatorStub{}, shardCoordinator)
+	require.Equal(t, 2, numRestored)
+}
+
+func Test_ClearShouldPruneTheJournal(t *testing.T) {
+	persister := database.NewMemDB()
+	journal, _ := NewTxPoolJournal(ArgTxPoolJournal{
+		Persister:     persister,
+		Marshalizer:   &marshallerMock.MarshalizerMock{},
+		MaxAge:        time.Minute,
+		FlushInterval: time.Hour,
+		PruneInterval: time.Hour,
+	})
+	args := ArgShardedTxPool{
+		Config: storageunit.CacheConfig{
+			Capacity:             100,
+			SizePerSender:        10,
+			SizeInBytes:          409600,
+			SizeInBytesPerSender: 40960,
+			Shards:               1,
+		},
+		TxGasHandler: &txcachemocks.TxGasHandlerMock{
+			MinimumGasMove:       50000,
+			MinimumGasPrice:      200000000000,
+			GasProcessingDivisor: 100,
+		},
+		Journal:        journal,
+		NumberOfShards: 4,
+		SelfShardID:    0,
+	}
+
+	pool, _ := NewShardedTxPool(args)
+	pool.AddData([]byte("hash-alice"), createTx("alice", 42), 0, "0")
+	pool.AddData([]byte("hash-bob"), createTx("bob", 7), 0, "1_0")
+	journal.flush()
+
+	pool.ClearShardStore("1_0")
+	journal.flush()
+	require.Nil(t, persister.Has([]byte("hash-alice")))
+	require.NotNil(t, persister.Has([]byte("hash-bob")))
+
+	pool.Clear()
+	journal.flush()
+	require.NotNil(t, persister.Has([]byte("hash-alice")))
+}
+
+func Test_AddData_ReplaceByFee(t *testing.T) {
+	cacheConfig := storageunit.CacheConfig{
+		Capacity:             100,
+		SizePerSender:        10,
+		SizeInBytes:          409600,
+		SizeInBytesPerSender: 40960,
+		Shards:               1,
+	}
+	args := ArgShardedTxPool{
+		Config: cacheConfig,
+		ReplacementConfig: config.TxPoolReplacementConfig{
+			Enabled:                 true,
+			GasPriceBumpPercentage:  10,
+			MaxReplacementsPerNonce: 2,
+		},
+		TxGasHandler: &txcachemocks.TxGasHandlerMock{
+			MinimumGasMove:       50000,
+			MinimumGasPrice:      200000000000,
+			GasProcessingDivisor: 100,
+		},
+		Journal:        NewDisabledJournal(),
+		NumberOfShards: 4,
+		SelfShardID:    0,
+	}
+	pool, _ := NewShardedTxPool(args)
+
+	pool.AddData([]byte("hash-1"), createTxWithGasPrice("alice", 7, 1000), 0, "0")
+
+	// not enough of a bump
+	underpriced := createTxWithGasPrice("alice", 7, 1099)
+	err := pool.CheckTxReplacement([]byte("hash-2"), underpriced, "0")
+	require.True(t, errors.Is(err, dataRetriever.ErrTxReplacementUnderpriced))
+	pool.AddData([]byte("hash-2"), underpriced, 0, "0")
+	require.Equal(t, int64(1), pool.GetCounts().GetTotal())
+	_, ok := pool.searchFirstTx([]byte("hash-1"))
+	require.True(t, ok)
+
+	// the same transaction is not a replacement
+	require.Nil(t, pool.CheckTxReplacement([]byte("hash-1"), createTxWithGasPrice("alice", 7, 1000), "0"))
+
+	// cross-shard transactions are not checked
+	require.Nil(t, pool.CheckTxReplacement([]byte("hash-2"), underpriced, "1_0"))
+
+	replacement := createTxWithGasPrice("alice", 7, 1100)
+	require.Nil(t, pool.CheckTxReplacement([]byte("hash-3"), replacement, "0"))
+	pool.AddData([]byte("hash-3"), replacement, 0, "0")
+	require.Equal(t, int64(1), pool.GetCounts().GetTotal())
+	_, ok = pool.searchFirstTx([]byte("hash-1"))
+	require.False(t, ok)
+	_, ok = pool.searchFirstTx([]byte("hash-3"))
+	require.True(t, ok)
+
+	replacedTxHash, ok := pool.GetReplacedTxHash([]byte("hash-3"))
+	require.True(t, ok)
+	require.Equal(t, []byte("hash-1"), replacedTxHash)
+
+	pool.AddData([]byte("hash-4"), createTxWithGasPrice("alice", 7, 2000), 0, "0")
+	_, ok = pool.searchFirstTx([]byte("hash-4"))
+	require.True(t, ok)
+
+	// the maximum number of replacements for this nonce has been reached
+	err = pool.CheckTxReplacement([]byte("hash-5"), createTxWithGasPrice("alice", 7, 5000), "0")
+	require.Equal(t, dataRetriever.ErrTxReplacementLimitReached, err)
+	pool.AddData([]byte("hash-5"), createTxWithGasPrice("alice", 7, 5000), 0, "0")
+	_, ok = pool.searchFirstTx([]byte("hash-5"))
+	require.False(t, ok)
+
+	// other nonces are not affected
+	pool.AddData([]byte("hash-6"), createTxWithGasPrice("alice", 8, 1000), 0, "0")
+	require.Equal(t, int64(2), pool.GetCounts().GetTotal())
+}
+
+func Test_NewShardedTxPool_WhenBadReplacementConfig(t *testing.T) {
+	args := ArgShardedTxPool{
+		Config: storageunit.CacheConfig{
+			Capacity:             100,
+			SizePerSender:        10,
+			SizeInBytes:          409600,
+			SizeInBytesPerSender: 40960,
+			Shards:               1,
+		},
+		ReplacementConfig: config.TxPoolReplacementConfig{
+			Enabled:                 true,
+			GasPriceBumpPercentage:  0,
+			MaxReplacementsPerNonce: 2,
+		},
+		TxGasHandler: &txcachemocks.TxGasHandlerMock{
+			MinimumGasMove:       50000,
+			MinimumGasPrice:      200000000000,
+			GasProcessingDivisor: 100,
+		},
+		Journal:        NewDisabledJournal(),
+		NumberOfShards: 4,
+	}
+	pool, err := NewShardedTxPool(args)
+	require.Nil(t, pool)
+	require.True(t, errors.Is(err, dataRetriever.ErrInvalidValue))
+
+	args.ReplacementConfig.GasPriceBumpPercentage = 10
+	args.ReplacementConfig.MaxReplacementsPerNonce = 0
+	pool, err = NewShardedTxPool(args)
+	require.Nil(t, pool)
+	require.True(t, errors.Is(err, dataRetriever.ErrInvalidValue))
+
+	// the replacement config is not verified when disabled
+	args.ReplacementConfig.Enabled = false
+	pool, err = NewShardedTxPool(args)
+	require.NotNil(t, pool)
+	require.Nil(t, err)
+}
+
 func Test_routeToCacheUnions(t *testing.T) {
 	config := storageunit.CacheConfig{
 		Capacity:             100,
@@ -398,6 +705,7 @@ func Test_routeToCacheUnions(t *testing.T) {
 			MinimumGasPrice:      200000000000,
 			GasProcessingDivisor: 100,
 		},
+		Journal:        NewDisabledJournal(),
 		NumberOfShards: 4,
 		SelfShardID:    42,
 	}
@@ -419,6 +727,14 @@ func createTx(sender string, nonce uint64) data.TransactionHandler {
 	}
 }
 
+func createTxWithGasPrice(sender string, nonce uint64, gasPrice uint64) data.TransactionHandler {
+	return &transaction.Transaction{
+		SndAddr:  []byte(sender),
+		Nonce:    nonce,
+		GasPrice: gasPrice,
+	}
+}
+
 func waitABit() {
 	time.Sleep(10 * time.Millisecond)
 }
@@ -426,6 +742,22 @@ func waitABit() {
 type thisIsNotATransaction struct {
 }
 
+type txValidatorStub struct {
+	validateTransactionCalled func(tx *transaction.Transaction) error
+}
+
+func (stub *txValidatorStub) ValidateTransaction(tx *transaction.Transaction) error {
+	if stub.validateTransactionCalled != nil {
+		return stub.validateTransactionCalled(tx)
+	}
+
+	return nil
+}
+
+func (stub *txValidatorStub) IsInterfaceNil() bool {
+	return stub == nil
+}
+
 func newTxPoolToTest() (dataRetriever.ShardedDataCacherNotifier, error) {
 	config := storageunit.CacheConfig{
 		Capacity:             100,
@@ -441,6 +773,7 @@ func newTxPoolToTest() (dataRetriever.ShardedDataCacherNotifier, error) {
 			MinimumGasPrice:      200000000000,
 			GasProcessingDivisor: 100,
 		},
+		Journal:        NewDisabledJournal(),
 		NumberOfShards: 4,
 		SelfShardID:    0,
 	}
@@ -0,0 +1,145 @@
+package txpool
+
+import (
+	"encoding/binary"
+	"fmt"
+	"math/big"
+	"sync"
+
+	"github.com/multiversx/mx-chain-core-go/data"
+	"github.com/multiversx/mx-chain-go/config"
+	"github.com/multiversx/mx-chain-go/dataRetriever"
+	"github.com/multiversx/mx-chain-go/storage"
+	"github.com/multiversx/mx-chain-go/storage/cache"
+	"github.com/multiversx/mx-chain-go/storage/txcache"
+)
+
+const percentageDivisor = 100
+
+// txReplacementPolicy implements the replace-by-fee rules: a transaction having the same sender and nonce as a
+// pooled one replaces it only if its gas price is higher by at least the configured percentage
+type txReplacementPolicy struct {
+	enabled                 bool
+	gasPriceBumpPercentage  uint64
+	maxReplacementsPerNonce uint32
+	mutReplacement          sync.Mutex
+	replacementsCounter     storage.Cacher
+	replacedTxHashes        storage.Cacher
+}
+
+func newTxReplacementPolicy(replacementConfig config.TxPoolReplacementConfig, capacity uint32) (*txReplacementPolicy, error) {
+	policy := &txReplacementPolicy{
+		enabled: replacementConfig.Enabled,
+	}
+	if !replacementConfig.Enabled {
+		return policy, nil
+	}
+
+	if replacementConfig.GasPriceBumpPercentage == 0 {
+		return nil, fmt.Errorf("%w: GasPriceBumpPercentage is not valid", dataRetriever.ErrInvalidValue)
+	}
+	if replacementConfig.MaxReplacementsPerNonce == 0 {
+		return nil, fmt.Errorf("%w: MaxReplacementsPerNonce is not valid", dataRetriever.ErrInvalidValue)
+	}
+
+	var err error
+	policy.replacementsCounter, err = cache.NewLRUCache(int(capacity))
+	if err != nil {
+		return nil, err
+	}
+	policy.replacedTxHashes, err = cache.NewLRUCache(int(capacity))
+	if err != nil {
+		return nil, err
+	}
+
+	policy.gasPriceBumpPercentage = uint64(replacementConfig.GasPriceBumpPercentage)
+	policy.maxReplacementsPerNonce = replacementConfig.MaxReplacementsPerNonce
+
+	return policy, nil
+}
+
+// findSameNonceTx returns the pooled transaction with the same sender and nonce as the provided one, if any
+func (policy *txReplacementPolicy) findSameNonceTx(txHash []byte, tx data.TransactionHandler, cache txCache) (*txcache.WrappedTransaction, bool) {
+	if !policy.enabled {
+		return nil, false
+	}
+
+	for _, pooledTx := range cache.GetTransactionsPoolForSender(string(tx.GetSndAddr())) {
+		if pooledTx.Tx.GetNonce() != tx.GetNonce() {
+			continue
+		}
+		if string(pooledTx.TxHash) == string(txHash) {
+			return nil, false
+		}
+
+		return pooledTx, true
+	}
+
+	return nil, false
+}
+
+// checkReplacement returns nil if the incoming transaction is allowed to replace the pooled one
+func (policy *txReplacementPolicy) checkReplacement(incomingTx data.TransactionHandler, pooledTx data.TransactionHandler) error {
+	pooledGasPrice := big.NewInt(0).SetUint64(pooledTx.GetGasPrice())
+	minAcceptedGasPrice := big.NewInt(0).Mul(pooledGasPrice, big.NewInt(0).SetUint64(percentageDivisor+policy.gasPriceBumpPercentage))
+	minAcceptedGasPrice.Div(minAcceptedGasPrice, big.NewInt(percentageDivisor))
+
+	incomingGasPrice := big.NewInt(0).SetUint64(incomingTx.GetGasPrice())
+	if incomingGasPrice.Cmp(minAcceptedGasPrice) < 0 {
+		return fmt.Errorf("%w: wanted at least %s, got %s",
+			dataRetriever.ErrTxReplacementUnderpriced,
+			minAcceptedGasPrice.String(),
+			incomingGasPrice.String(),
+		)
+	}
+
+	if policy.getNumReplacements(incomingTx) >= policy.maxReplacementsPerNonce {
+		return dataRetriever.ErrTxReplacementLimitReached
+	}
+
+	return nil
+}
+
+func (policy *txReplacementPolicy) getNumReplacements(tx data.TransactionHandler) uint32 {
+	value, ok := policy.replacementsCounter.Get(replacementKey(tx))
+	if !ok {
+		return 0
+	}
+
+	numReplacements, ok := value.(uint32)
+	if !ok {
+		return 0
+	}
+
+	return numReplacements
+}
+
+func (policy *txReplacementPolicy) recordReplacement(incoming *txcache.WrappedTransaction, replaced *txcache.WrappedTransaction) {
+	numReplacements := policy.getNumReplacements(incoming.Tx) + 1
+	policy.replacementsCounter.Put(replacementKey(incoming.Tx), numReplacements, 0)
+	policy.replacedTxHashes.Put(incoming.TxHash, replaced.TxHash, len(replaced.TxHash))
+}
+
+// getReplacedTxHash returns the hash of the transaction that was replaced by the provided one
+func (policy *txReplacementPolicy) getReplacedTxHash(txHash []byte) ([]byte, bool) {
+	if !policy.enabled {
+		return nil, false
+	}
+
+	value, ok := policy.replacedTxHashes.Get(txHash)
+	if !ok {
+		return nil, false
+	}
+
+	replacedTxHash, ok := value.([]byte)
+	return replacedTxHash, ok
+}
+
+func replacementKey(tx data.TransactionHandler) []byte {
+	sender := tx.GetSndAddr()
+	key := make([]byte, len(sender)+8)
+	copy(key, sender)
+	binary.BigEndian.PutUint64(key[len(sender):], tx.GetNonce())
+
+	return key
+}
@@ -53,6 +53,10 @@ const (
 	ExtendedShardHeadersNonceHashDataUnit UnitType = 25
 	// ExtendedShardHeadersUnit is the extended shard headers storage unit identifier
 	ExtendedShardHeadersUnit UnitType = 26
+	// ValidatorRewardsUnit is the per epoch validators rewards breakdown storage unit identifier
+	ValidatorRewardsUnit UnitType = 27
+	// ValidatorRatingsUnit is the per epoch validators ratings history storage unit identifier
+	ValidatorRatingsUnit UnitType = 28
 
 	// ShardHdrNonceHashDataUnit is the header nonce-hash pair data unit identifier
 	//TODO: Add only unit types lower than 100
@@ -118,6 +122,10 @@ func (ut UnitType) String() string {
 		return "ExtendedShardHeadersNonceHashDataUnit"
 	case ExtendedShardHeadersUnit:
 		return "ExtendedShardHeadersUnit"
+	case ValidatorRewardsUnit:
+		return "ValidatorRewardsUnit"
+	case ValidatorRatingsUnit:
+		return "ValidatorRatingsUnit"
 	}
 
 	if ut < ShardHdrNonceHashDataUnit {
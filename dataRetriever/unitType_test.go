@@ -55,6 +55,10 @@ func TestUnitType_String(t *testing.T) {
 	require.Equal(t, "PeerAccountsUnit", ut.String())
 	ut = ScheduledSCRsUnit
 	require.Equal(t, "ScheduledSCRsUnit", ut.String())
+	ut = ValidatorRewardsUnit
+	require.Equal(t, "ValidatorRewardsUnit", ut.String())
+	ut = ValidatorRatingsUnit
+	require.Equal(t, "ValidatorRatingsUnit", ut.String())
 
 	ut = 200
 	require.Equal(t, "ShardHdrNonceHashDataUnit100", ut.String())
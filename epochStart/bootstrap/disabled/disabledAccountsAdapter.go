@@ -31,6 +31,11 @@ func (a *accountsAdapter) GetTrie(_ []byte) (common.Trie, error) {
 	return nil, nil
 }
 
+// GetStatsForRootHash -
+func (a *accountsAdapter) GetStatsForRootHash(_ []byte, _ uint32) (common.TriesStatisticsCollector, error) {
+	return nil, nil
+}
+
 // GetCode -
 func (a *accountsAdapter) GetCode(_ []byte) []byte {
 	return nil
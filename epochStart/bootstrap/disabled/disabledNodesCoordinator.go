@@ -123,6 +123,11 @@ func (n *nodesCoordinator) GetWaitingEpochsLeftForPublicKey(_ []byte) (uint32, e
 func (n *nodesCoordinator) EpochStartPrepare(_ data.HeaderHandler, _ data.BodyHandler) {
 }
 
+// PreviewNodesShuffling returns an empty result
+func (n *nodesCoordinator) PreviewNodesShuffling(_ []*state.ShardValidatorInfo, _ []byte, _ uint32) (*nodesCoord.ShuffledNodes, error) {
+	return &nodesCoord.ShuffledNodes{}, nil
+}
+
 // NodesCoordinatorToRegistry -
 func (n *nodesCoordinator) NodesCoordinatorToRegistry(_ uint32) nodesCoord.NodesCoordinatorRegistryHandler {
 	return nil
@@ -35,6 +35,7 @@ import (
 	"github.com/multiversx/mx-chain-go/sharding"
 	"github.com/multiversx/mx-chain-go/sharding/nodesCoordinator"
 	"github.com/multiversx/mx-chain-go/state"
+	"github.com/multiversx/mx-chain-go/state/stateSnapshot"
 	"github.com/multiversx/mx-chain-go/state/syncer"
 	"github.com/multiversx/mx-chain-go/storage"
 	"github.com/multiversx/mx-chain-go/storage/cache"
@@ -122,6 +123,7 @@ type epochStartBootstrap struct {
 	nodeProcessingMode         common.NodeProcessingMode
 	nodeOperationMode          common.NodeOperation
 	stateStatsHandler          common.StateStatisticsHandler
+	stateSnapshotImporter      stateSnapshot.SnapshotImporter
 	// created components
 	requestHandler                  process.RequestHandler
 	mainInterceptorContainer        process.InterceptorsContainer
@@ -269,6 +271,14 @@ func NewEpochStartBootstrap(args ArgsEpochStartBootstrap) (*epochStartBootstrap,
 		return nil, err
 	}
 
+	epochStartProvider.stateSnapshotImporter, err = stateSnapshot.NewSnapshotImporter(stateSnapshot.ArgsSnapshotImporter{
+		Marshaller: args.CoreComponentsHolder.InternalMarshalizer(),
+		Hasher:     args.CoreComponentsHolder.Hasher(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
 	epochStartProvider.trieContainer = state.NewDataTriesHolder()
 	epochStartProvider.trieStorageManagers = make(map[string]common.StorageManager)
 
@@ -1120,6 +1130,10 @@ func (e *epochStartBootstrap) syncUserAccountsState(rootHash []byte) error {
 	trieStorageManager := e.trieStorageManagers[dataRetriever.UserAccountsUnit.String()]
 	e.mutTrieStorageManagers.RUnlock()
 
+	if e.importTrieFromStateSnapshot(stateSnapshot.UserAccountsTrie, rootHash, trieStorageManager) {
+		return nil
+	}
+
 	argsUserAccountsSyncer := syncer.ArgsNewUserAccountsSyncer{
 		ArgsNewBaseAccountsSyncer: syncer.ArgsNewBaseAccountsSyncer{
 			Hasher:                            e.coreComponentsHolder.Hasher(),
@@ -1195,6 +1209,10 @@ func (e *epochStartBootstrap) syncValidatorAccountsState(rootHash []byte) error
 	peerTrieStorageManager := e.trieStorageManagers[dataRetriever.PeerAccountsUnit.String()]
 	e.mutTrieStorageManagers.RUnlock()
 
+	if e.importTrieFromStateSnapshot(stateSnapshot.PeerAccountsTrie, rootHash, peerTrieStorageManager) {
+		return nil
+	}
+
 	argsValidatorAccountsSyncer := syncer.ArgsNewValidatorAccountsSyncer{
 		ArgsNewBaseAccountsSyncer: syncer.ArgsNewBaseAccountsSyncer{
 			Hasher:                            e.coreComponentsHolder.Hasher(),
@@ -1225,6 +1243,29 @@ func (e *epochStartBootstrap) syncValidatorAccountsState(rootHash []byte) error
 	return nil
 }
 
+// importTrieFromStateSnapshot imports the trie from the state snapshot file, if one was provided. It returns false if
+// the trie has to be synced from the network, as it is the case when the snapshot does not match the root hash
+func (e *epochStartBootstrap) importTrieFromStateSnapshot(
+	kind stateSnapshot.TrieKind,
+	rootHash []byte,
+	storageManager common.StorageManager,
+) bool {
+	if len(e.flagsConfig.StateSnapshotFile) == 0 {
+		return false
+	}
+
+	log.Info("start in epoch bootstrap: importing from state snapshot",
+		"trie", kind.String(), "root hash", rootHash, "file", e.flagsConfig.StateSnapshotFile)
+	err := e.stateSnapshotImporter.ImportTrie(e.flagsConfig.StateSnapshotFile, kind, rootHash, storageManager, storageMarker.NewTrieStorageMarker())
+	if err != nil {
+		log.Warn("start in epoch bootstrap: could not import from state snapshot, the trie will be synced from the network",
+			"trie", kind.String(), "root hash", rootHash, "error", err)
+		return false
+	}
+
+	return true
+}
+
 func (e *epochStartBootstrap) createResolversContainer() error {
 	dataPacker, err := partitioning.NewSimpleDataPacker(e.coreComponentsHolder.InternalMarshalizer())
 	if err != nil {
@@ -7,12 +7,15 @@ import (
 	"errors"
 	"fmt"
 	"math/big"
+	"os"
+	"path/filepath"
 	"strconv"
 	"strings"
 	"testing"
 	"time"
 
 	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/common/holders"
 	"github.com/multiversx/mx-chain-go/common/statistics"
 	disabledStatistics "github.com/multiversx/mx-chain-go/common/statistics/disabled"
 	"github.com/multiversx/mx-chain-go/config"
@@ -27,6 +30,7 @@ import (
 	"github.com/multiversx/mx-chain-go/sharding"
 	"github.com/multiversx/mx-chain-go/sharding/nodesCoordinator"
 	"github.com/multiversx/mx-chain-go/state"
+	"github.com/multiversx/mx-chain-go/state/stateSnapshot"
 	"github.com/multiversx/mx-chain-go/storage"
 	"github.com/multiversx/mx-chain-go/testscommon"
 	epochStartMocks "github.com/multiversx/mx-chain-go/testscommon/bootstrapMocks/epochStart"
@@ -47,6 +51,7 @@ import (
 	storageMocks "github.com/multiversx/mx-chain-go/testscommon/storage"
 	"github.com/multiversx/mx-chain-go/testscommon/syncer"
 	validatorInfoCacherStub "github.com/multiversx/mx-chain-go/testscommon/validatorInfoCacher"
+	"github.com/multiversx/mx-chain-go/trie"
 	"github.com/multiversx/mx-chain-go/trie/factory"
 
 	"github.com/multiversx/mx-chain-core-go/core"
@@ -1118,6 +1123,83 @@ func TestSyncValidatorAccountsState_NilRequestHandlerErr(t *testing.T) {
 	assert.Equal(t, state.ErrNilRequestHandler, err)
 }
 
+func TestSyncValidatorAccountsState_FromStateSnapshot(t *testing.T) {
+	t.Parallel()
+
+	coreComp, cryptoComp := createComponentsForEpochStart()
+	createStorageManager := func() common.StorageManager {
+		storageArgs := storageMocks.GetStorageManagerArgs()
+		storageArgs.MainStorer = testscommon.NewSnapshotPruningStorerMock()
+		storageArgs.Marshalizer = coreComp.InternalMarshalizer()
+		storageArgs.Hasher = coreComp.Hasher()
+		storageManager, err := trie.NewTrieStorageManager(storageArgs)
+		require.Nil(t, err)
+
+		return storageManager
+	}
+
+	sourceStorage := createStorageManager()
+	peerTrie, err := trie.NewTrie(sourceStorage, coreComp.InternalMarshalizer(), coreComp.Hasher(), &enableEpochsHandlerMock.EnableEpochsHandlerStub{}, 5)
+	require.Nil(t, err)
+	require.Nil(t, peerTrie.Update([]byte("validator"), []byte("peer account")))
+	require.Nil(t, peerTrie.Commit())
+	rootHash, _ := peerTrie.RootHash()
+
+	exporter, err := stateSnapshot.NewSnapshotExporter(stateSnapshot.ArgsSnapshotExporter{
+		UserAccountsStorage: createStorageManager(),
+		PeerAccountsStorage: sourceStorage,
+		Marshaller:          coreComp.InternalMarshalizer(),
+		Hasher:              coreComp.Hasher(),
+	})
+	require.Nil(t, err)
+	buff := &bytes.Buffer{}
+	require.Nil(t, exporter.Export(buff, stateSnapshot.SnapshotHeader{PeerAccountsRootHash: rootHash}))
+	snapshotFile := filepath.Join(t.TempDir(), "state.snapshot")
+	require.Nil(t, os.WriteFile(snapshotFile, buff.Bytes(), 0644))
+
+	createEpochStartProvider := func() (*epochStartBootstrap, common.StorageManager) {
+		args := createMockEpochStartBootstrapArgs(coreComp, cryptoComp)
+		args.FlagsConfig.StateSnapshotFile = snapshotFile
+		epochStartProvider, errCreate := NewEpochStartBootstrap(args)
+		require.Nil(t, errCreate)
+		targetStorage := createStorageManager()
+		epochStartProvider.trieStorageManagers = map[string]common.StorageManager{
+			dataRetriever.PeerAccountsUnit.String(): targetStorage,
+		}
+
+		return epochStartProvider, targetStorage
+	}
+
+	t.Run("should import the trie from the snapshot", func(t *testing.T) {
+		t.Parallel()
+
+		epochStartProvider, targetStorage := createEpochStartProvider()
+		err := epochStartProvider.syncValidatorAccountsState(rootHash)
+		assert.Nil(t, err)
+
+		emptyTrie, err := trie.NewTrie(targetStorage, coreComp.InternalMarshalizer(), coreComp.Hasher(), &enableEpochsHandlerMock.EnableEpochsHandlerStub{}, 5)
+		require.Nil(t, err)
+		importedTrie, err := emptyTrie.Recreate(holders.NewDefaultRootHashesHolder(rootHash))
+		require.Nil(t, err)
+		value, _, err := importedTrie.Get([]byte("validator"))
+		assert.Nil(t, err)
+		assert.Equal(t, []byte("peer account"), value)
+	})
+	t.Run("root hash mismatch should fall back to the network sync", func(t *testing.T) {
+		t.Parallel()
+
+		epochStartProvider, _ := createEpochStartProvider()
+		epochStartProvider.dataPool = &dataRetrieverMock.PoolsHolderStub{
+			TrieNodesCalled: func() storage.Cacher {
+				return &testscommon.CacherStub{}
+			},
+		}
+
+		err := epochStartProvider.syncValidatorAccountsState([]byte("another root hash"))
+		assert.Equal(t, state.ErrNilRequestHandler, err)
+	})
+}
+
 func TestCreateTriesForNewShardID(t *testing.T) {
 	coreComp, cryptoComp := createComponentsForEpochStart()
 	args := createMockEpochStartBootstrapArgs(coreComp, cryptoComp)
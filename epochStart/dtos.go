@@ -22,3 +22,11 @@ type ValidatorStatsInEpoch struct {
 	Waiting  map[uint32]int
 	Leaving  map[uint32]int
 }
+
+// AuctionSelectionResult holds the outcome of a simulated selection of nodes from the auction list
+type AuctionSelectionResult struct {
+	AvailableSlots        uint32
+	SelectedNodes         []state.ValidatorInfoHandler
+	QualifiedTopUpPerNode map[string]*big.Int
+	MinQualifiedTopUp     *big.Int
+}
@@ -326,6 +326,12 @@ var ErrNilEnableEpochsHandler = errors.New("nil enable epochs handler")
 // ErrNilValidatorInfoStorage signals that nil validator info storage has been provided
 var ErrNilValidatorInfoStorage = errors.New("nil validator info storage")
 
+// ErrNilValidatorRewardsStorage signals that nil validator rewards storage has been provided
+var ErrNilValidatorRewardsStorage = errors.New("nil validator rewards storage")
+
+// ErrNilValidatorRatingsStorage signals that nil validator ratings storage has been provided
+var ErrNilValidatorRatingsStorage = errors.New("nil validator ratings storage")
+
 // ErrNilTrieSyncStatistics signals that nil trie sync statistics has been provided
 var ErrNilTrieSyncStatistics = errors.New("nil trie sync statistics")
 
@@ -227,5 +227,9 @@ type AuctionListSelector interface {
 		validatorsInfoMap state.ShardValidatorsInfoMapHandler,
 		randomness []byte,
 	) error
+	SimulateNodesSelection(
+		ownersData map[string]*OwnerData,
+		randomness []byte,
+	) (*AuctionSelectionResult, error)
 	IsInterfaceNil() bool
 }
@@ -190,18 +190,97 @@ func (als *auctionListSelector) SelectNodesFromAuctionList(
 		return process.ErrNilRandSeed
 	}
 
-	ownersData, auctionListSize := als.getAuctionData()
+	ownersData, auctionListSize := getAuctionData(als.stakingDataProvider.GetOwnersData())
 	if auctionListSize == 0 {
 		log.Info("auctionListSelector.SelectNodesFromAuctionList: empty auction list; skip selection")
 		return nil
 	}
 
+	availableSlots, ok := als.computeAvailableSlots(auctionListSize, false)
+	if !ok {
+		return nil
+	}
+
+	als.auctionListDisplayer.DisplayOwnersData(ownersData)
+	numOfAvailableNodeSlots := core.MinUint32(auctionListSize, availableSlots)
+
+	sw := core.NewStopWatch()
+	sw.Start("auctionListSelector.sortAuctionList")
+	defer func() {
+		sw.Stop("auctionListSelector.sortAuctionList")
+		log.Debug("time measurements", sw.GetMeasurements()...)
+	}()
+
+	return als.sortAuctionList(ownersData, numOfAvailableNodeSlots, validatorsInfoMap, randomness)
+}
+
+// SimulateNodesSelection runs the same selection as SelectNodesFromAuctionList on the provided owners data, without
+// altering any validator info. It returns the nodes which would be selected along with the qualified top up per node
+// of each owner and the minimum qualified top up needed to get selected
+func (als *auctionListSelector) SimulateNodesSelection(
+	ownersData map[string]*epochStart.OwnerData,
+	randomness []byte,
+) (*epochStart.AuctionSelectionResult, error) {
+	if len(randomness) == 0 {
+		return nil, process.ErrNilRandSeed
+	}
+
+	result := &epochStart.AuctionSelectionResult{
+		SelectedNodes:         make([]state.ValidatorInfoHandler, 0),
+		QualifiedTopUpPerNode: make(map[string]*big.Int),
+		MinQualifiedTopUp:     big.NewInt(0),
+	}
+
+	auctionOwnersData, auctionListSize := getAuctionData(ownersData)
+	if auctionListSize == 0 {
+		return result, nil
+	}
+
+	availableSlots, ok := als.computeAvailableSlots(auctionListSize, true)
+	if !ok {
+		return result, nil
+	}
+
+	result.AvailableSlots = core.MinUint32(auctionListSize, availableSlots)
+	softAuctionNodesConfig := als.calcSoftAuctionNodesConfig(auctionOwnersData, result.AvailableSlots)
+
+	nodesOwner := make(map[string]string)
+	for owner, ownerData := range softAuctionNodesConfig {
+		result.QualifiedTopUpPerNode[owner] = big.NewInt(0).Set(ownerData.qualifiedTopUpPerNode)
+		for _, node := range ownerData.auctionList {
+			nodesOwner[string(node.GetPublicKey())] = owner
+		}
+	}
+
+	result.SelectedNodes = als.selectNodes(softAuctionNodesConfig, result.AvailableSlots, randomness)
+	for i, node := range result.SelectedNodes {
+		qualifiedTopUp := result.QualifiedTopUpPerNode[nodesOwner[string(node.GetPublicKey())]]
+		if i == 0 || qualifiedTopUp.Cmp(result.MinQualifiedTopUp) < 0 {
+			result.MinQualifiedTopUp = big.NewInt(0).Set(qualifiedTopUp)
+		}
+	}
+
+	return result, nil
+}
+
+// computeAvailableSlots returns the number of slots which can be filled from the auction list. The second return value
+// is false if no node can be selected. The computation is logged at debug level when it is done for a simulation, so
+// that it is not mistaken for the epoch start selection
+func (als *auctionListSelector) computeAvailableSlots(auctionListSize uint32, isSimulation bool) (uint32, bool) {
+	logPrefix := "auctionListSelector.SelectNodesFromAuctionList"
+	logInfo, logWarn := log.Info, log.Warn
+	if isSimulation {
+		logPrefix = "auctionListSelector.SimulateNodesSelection"
+		logInfo, logWarn = log.Debug, log.Debug
+	}
+
 	currNodesConfig := als.nodesConfigProvider.GetCurrentNodesConfig()
 	currNumOfValidators := als.stakingDataProvider.GetNumOfValidatorsInCurrentEpoch()
 	numOfShuffledNodes, numForcedToStay := als.computeNumShuffledNodes(currNodesConfig)
 	numOfValidatorsAfterShuffling, err := safeSub(currNumOfValidators, numOfShuffledNodes)
 	if err != nil {
-		log.Warn(fmt.Sprintf("auctionListSelector.SelectNodesFromAuctionList: %v when trying to compute numOfValidatorsAfterShuffling = %v - %v (currNumOfValidators - numOfShuffledNodes)",
+		logWarn(fmt.Sprintf("%s: %v when trying to compute numOfValidatorsAfterShuffling = %v - %v (currNumOfValidators - numOfShuffledNodes)",
+			logPrefix,
 			err,
 			currNumOfValidators,
 			numOfShuffledNodes,
@@ -213,15 +292,16 @@ func (als *auctionListSelector) SelectNodesFromAuctionList(
 	numValidatorsAfterShufflingWithForcedToStay := numOfValidatorsAfterShuffling + numForcedToStay
 	availableSlots, err := safeSub(maxNumNodes, numValidatorsAfterShufflingWithForcedToStay)
 	if availableSlots == 0 || err != nil {
-		log.Info(fmt.Sprintf("auctionListSelector.SelectNodesFromAuctionList: %v or zero value when trying to compute availableSlots = %v - %v (maxNodes - numOfValidatorsAfterShuffling+numForcedToStay); skip selecting nodes from auction list",
+		logInfo(fmt.Sprintf("%s: %v or zero value when trying to compute availableSlots = %v - %v (maxNodes - numOfValidatorsAfterShuffling+numForcedToStay); skip selecting nodes from auction list",
+			logPrefix,
 			err,
 			maxNumNodes,
 			numValidatorsAfterShufflingWithForcedToStay,
 		))
-		return nil
+		return 0, false
 	}
 
-	log.Info("auctionListSelector.SelectNodesFromAuctionList",
+	logInfo(logPrefix,
 		"max nodes", maxNumNodes,
 		"current number of validators", currNumOfValidators,
 		"num of nodes which will be shuffled out", numOfShuffledNodes,
@@ -231,24 +311,14 @@ func (als *auctionListSelector) SelectNodesFromAuctionList(
 		fmt.Sprintf("available slots (%v - %v)", maxNumNodes, numValidatorsAfterShufflingWithForcedToStay), availableSlots,
 	)
 
-	als.auctionListDisplayer.DisplayOwnersData(ownersData)
-	numOfAvailableNodeSlots := core.MinUint32(auctionListSize, availableSlots)
-
-	sw := core.NewStopWatch()
-	sw.Start("auctionListSelector.sortAuctionList")
-	defer func() {
-		sw.Stop("auctionListSelector.sortAuctionList")
-		log.Debug("time measurements", sw.GetMeasurements()...)
-	}()
-
-	return als.sortAuctionList(ownersData, numOfAvailableNodeSlots, validatorsInfoMap, randomness)
+	return availableSlots, true
 }
 
-func (als *auctionListSelector) getAuctionData() (map[string]*OwnerAuctionData, uint32) {
+func getAuctionData(stakingOwnersData map[string]*epochStart.OwnerData) (map[string]*OwnerAuctionData, uint32) {
 	ownersData := make(map[string]*OwnerAuctionData)
 	numOfNodesInAuction := uint32(0)
 
-	for owner, ownerData := range als.stakingDataProvider.GetOwnersData() {
+	for owner, ownerData := range stakingOwnersData {
 		if ownerData.Qualified && len(ownerData.AuctionList) > 0 {
 			numAuctionNodes := len(ownerData.AuctionList)
 
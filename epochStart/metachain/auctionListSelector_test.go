@@ -441,6 +441,106 @@ func TestAuctionListSelector_SelectNodesFromAuction(t *testing.T) {
 	})
 }
 
+func TestAuctionListSelector_SimulateNodesSelection(t *testing.T) {
+	t.Parallel()
+
+	owner1 := "owner1"
+	owner2 := "owner2"
+	owner3 := "owner3"
+	createOwnersData := func() map[string]*epochStart.OwnerData {
+		return map[string]*epochStart.OwnerData{
+			owner1: {
+				NumStakedNodes: 2,
+				TotalTopUp:     big.NewInt(100),
+				TopUpPerNode:   big.NewInt(50),
+				AuctionList: []state.ValidatorInfoHandler{
+					createValidatorInfo([]byte("pubKey0"), common.AuctionList, "", 0, []byte(owner1)),
+					createValidatorInfo([]byte("pubKey1"), common.AuctionList, "", 0, []byte(owner1)),
+				},
+				Qualified: true,
+			},
+			owner2: {
+				NumStakedNodes: 1,
+				TotalTopUp:     big.NewInt(20),
+				TopUpPerNode:   big.NewInt(20),
+				AuctionList: []state.ValidatorInfoHandler{
+					createValidatorInfo([]byte("pubKey2"), common.AuctionList, "", 0, []byte(owner2)),
+				},
+				Qualified: true,
+			},
+			owner3: {
+				NumStakedNodes: 1,
+				TotalTopUp:     big.NewInt(80),
+				TopUpPerNode:   big.NewInt(80),
+				AuctionList: []state.ValidatorInfoHandler{
+					createValidatorInfo([]byte("pubKey3"), common.AuctionList, "", 0, []byte(owner3)),
+				},
+				Qualified: true,
+			},
+		}
+	}
+	createSelector := func(maxNumNodes uint32) *auctionListSelector {
+		args := createAuctionListSelectorArgs([]config.MaxNodesChangeConfig{{MaxNumNodes: maxNumNodes}})
+		args.StakingDataProvider = &stakingcommon.StakingDataProviderStub{}
+		als, _ := NewAuctionListSelector(args)
+
+		return als
+	}
+
+	t.Run("nil randomness, expect error", func(t *testing.T) {
+		t.Parallel()
+
+		result, err := createSelector(2).SimulateNodesSelection(createOwnersData(), nil)
+		require.Equal(t, process.ErrNilRandSeed, err)
+		require.Nil(t, result)
+	})
+
+	t.Run("no available slots, expect empty selection", func(t *testing.T) {
+		t.Parallel()
+
+		result, err := createSelector(0).SimulateNodesSelection(createOwnersData(), []byte("rnd"))
+		require.Nil(t, err)
+		require.Zero(t, result.AvailableSlots)
+		require.Empty(t, result.SelectedNodes)
+		require.Equal(t, big.NewInt(0), result.MinQualifiedTopUp)
+	})
+
+	t.Run("should select based on soft auction and not alter the provided data", func(t *testing.T) {
+		t.Parallel()
+
+		ownersData := createOwnersData()
+		result, err := createSelector(2).SimulateNodesSelection(ownersData, []byte("rnd"))
+		require.Nil(t, err)
+		require.Equal(t, uint32(2), result.AvailableSlots)
+		require.Equal(t, []state.ValidatorInfoHandler{
+			createValidatorInfo([]byte("pubKey1"), common.AuctionList, "", 0, []byte(owner1)),
+			createValidatorInfo([]byte("pubKey3"), common.AuctionList, "", 0, []byte(owner3)),
+		}, result.SelectedNodes)
+		require.Equal(t, map[string]*big.Int{
+			owner1: big.NewInt(100),
+			owner3: big.NewInt(80),
+		}, result.QualifiedTopUpPerNode)
+		require.Equal(t, big.NewInt(80), result.MinQualifiedTopUp)
+		require.Equal(t, createOwnersData(), ownersData)
+	})
+
+	t.Run("more top up for an owner should change the selection", func(t *testing.T) {
+		t.Parallel()
+
+		ownersData := createOwnersData()
+		ownersData[owner2].TotalTopUp = big.NewInt(220)
+		ownersData[owner2].TopUpPerNode = big.NewInt(220)
+
+		result, err := createSelector(2).SimulateNodesSelection(ownersData, []byte("rnd"))
+		require.Nil(t, err)
+		require.Equal(t, []state.ValidatorInfoHandler{
+			createValidatorInfo([]byte("pubKey2"), common.AuctionList, "", 0, []byte(owner2)),
+			createValidatorInfo([]byte("pubKey1"), common.AuctionList, "", 0, []byte(owner1)),
+		}, result.SelectedNodes)
+		require.Equal(t, big.NewInt(100), result.MinQualifiedTopUp)
+	})
+}
+
 func TestAuctionListSelector_calcSoftAuctionNodesConfigEdgeCases(t *testing.T) {
 	t.Parallel()
 
@@ -10,6 +10,7 @@ import (
 	"github.com/multiversx/mx-chain-go/epochStart"
 	"github.com/multiversx/mx-chain-go/process"
 	"github.com/multiversx/mx-chain-go/state"
+	"github.com/multiversx/mx-chain-go/storage"
 )
 
 type configuredRewardsCreator string
@@ -24,9 +25,10 @@ const (
 // RewardsCreatorProxyArgs holds the proxy arguments
 type RewardsCreatorProxyArgs struct {
 	BaseRewardsCreatorArgs
-	StakingDataProvider   epochStart.StakingDataProvider
-	EconomicsDataProvider epochStart.EpochEconomicsDataProvider
-	RewardsHandler        process.RewardsHandler
+	StakingDataProvider     epochStart.StakingDataProvider
+	EconomicsDataProvider   epochStart.EpochEconomicsDataProvider
+	RewardsHandler          process.RewardsHandler
+	ValidatorRewardsStorage storage.Storer
 }
 
 type rewardsCreatorProxy struct {
@@ -178,10 +180,11 @@ func (rcp *rewardsCreatorProxy) switchToRewardsCreatorV2() error {
 
 func (rcp *rewardsCreatorProxy) createRewardsCreatorV2() (*rewardsCreatorV2, error) {
 	argsV2 := RewardsCreatorArgsV2{
-		BaseRewardsCreatorArgs: rcp.args.BaseRewardsCreatorArgs,
-		StakingDataProvider:    rcp.args.StakingDataProvider,
-		EconomicsDataProvider:  rcp.args.EconomicsDataProvider,
-		RewardsHandler:         rcp.args.RewardsHandler,
+		BaseRewardsCreatorArgs:  rcp.args.BaseRewardsCreatorArgs,
+		StakingDataProvider:     rcp.args.StakingDataProvider,
+		EconomicsDataProvider:   rcp.args.EconomicsDataProvider,
+		RewardsHandler:          rcp.args.RewardsHandler,
+		ValidatorRewardsStorage: rcp.args.ValidatorRewardsStorage,
 	}
 
 	return NewRewardsCreatorV2(argsV2)
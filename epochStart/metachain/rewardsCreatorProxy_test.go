@@ -381,10 +381,11 @@ func createDefaultRewardsCreatorProxyArgs() RewardsCreatorProxyArgs {
 	}
 
 	return RewardsCreatorProxyArgs{
-		BaseRewardsCreatorArgs: getBaseRewardsArguments(),
-		StakingDataProvider:    &stakingcommon.StakingDataProviderStub{},
-		EconomicsDataProvider:  NewEpochEconomicsStatistics(),
-		RewardsHandler:         rewardsHandler,
+		BaseRewardsCreatorArgs:  getBaseRewardsArguments(),
+		StakingDataProvider:     &stakingcommon.StakingDataProviderStub{},
+		EconomicsDataProvider:   NewEpochEconomicsStatistics(),
+		RewardsHandler:          rewardsHandler,
+		ValidatorRewardsStorage: mock.NewStorerMock(),
 	}
 }
 
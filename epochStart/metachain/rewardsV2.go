@@ -15,6 +15,7 @@ import (
 	"github.com/multiversx/mx-chain-go/process"
 	"github.com/multiversx/mx-chain-go/sharding"
 	"github.com/multiversx/mx-chain-go/state"
+	"github.com/multiversx/mx-chain-go/storage"
 )
 
 var _ process.RewardsCreator = (*rewardsCreatorV2)(nil)
@@ -31,16 +32,20 @@ type nodeRewardsData struct {
 // RewardsCreatorArgsV2 holds the data required to create end of epoch rewards
 type RewardsCreatorArgsV2 struct {
 	BaseRewardsCreatorArgs
-	StakingDataProvider   epochStart.StakingDataProvider
-	EconomicsDataProvider epochStart.EpochEconomicsDataProvider
-	RewardsHandler        process.RewardsHandler
+	StakingDataProvider     epochStart.StakingDataProvider
+	EconomicsDataProvider   epochStart.EpochEconomicsDataProvider
+	RewardsHandler          process.RewardsHandler
+	ValidatorRewardsStorage storage.Storer
 }
 
 type rewardsCreatorV2 struct {
 	*baseRewardsCreator
-	stakingDataProvider   epochStart.StakingDataProvider
-	economicsDataProvider epochStart.EpochEconomicsDataProvider
-	rewardsHandler        process.RewardsHandler
+	stakingDataProvider     epochStart.StakingDataProvider
+	economicsDataProvider   epochStart.EpochEconomicsDataProvider
+	rewardsHandler          process.RewardsHandler
+	validatorRewardsStorage storage.Storer
+	rewardsBreakdown        map[string]*common.ValidatorRewardsBreakdown
+	rewardsBreakdownEpoch   uint32
 }
 
 // NewRewardsCreatorV2 creates a new rewards creator object
@@ -59,12 +64,17 @@ func NewRewardsCreatorV2(args RewardsCreatorArgsV2) (*rewardsCreatorV2, error) {
 	if check.IfNil(args.RewardsHandler) {
 		return nil, epochStart.ErrNilRewardsHandler
 	}
+	if check.IfNil(args.ValidatorRewardsStorage) {
+		return nil, epochStart.ErrNilValidatorRewardsStorage
+	}
 
 	rc := &rewardsCreatorV2{
-		baseRewardsCreator:    brc,
-		economicsDataProvider: args.EconomicsDataProvider,
-		stakingDataProvider:   args.StakingDataProvider,
-		rewardsHandler:        args.RewardsHandler,
+		baseRewardsCreator:      brc,
+		economicsDataProvider:   args.EconomicsDataProvider,
+		stakingDataProvider:     args.StakingDataProvider,
+		rewardsHandler:          args.RewardsHandler,
+		validatorRewardsStorage: args.ValidatorRewardsStorage,
+		rewardsBreakdown:        make(map[string]*common.ValidatorRewardsBreakdown),
 	}
 
 	return rc, nil
@@ -100,6 +110,7 @@ func (rc *rewardsCreatorV2) CreateRewardsMiniBlocks(
 
 	miniBlocks := rc.initializeRewardsMiniBlocks()
 	rc.clean()
+	rc.rewardsBreakdown = make(map[string]*common.ValidatorRewardsBreakdown)
 	rc.flagDelegationSystemSCEnabled.SetValue(metaBlock.GetEpoch() >= rc.enableEpochsHandler.GetActivationEpoch(common.StakingV2Flag))
 
 	protRwdTx, protRwdShardId, err := rc.createProtocolSustainabilityRewardTransaction(metaBlock, computedEconomics)
@@ -115,6 +126,9 @@ func (rc *rewardsCreatorV2) CreateRewardsMiniBlocks(
 		return nil, err
 	}
 
+	rc.rewardsBreakdown = rc.createRewardsBreakdown(metaBlock.GetEpoch(), nodesRewardInfo)
+	rc.rewardsBreakdownEpoch = metaBlock.GetEpoch()
+
 	dust.Add(dust, dustFromRewardsPerNode)
 	log.Debug("accumulated dust for protocol sustainability", "value", dust)
 
@@ -257,6 +271,117 @@ func (rc *rewardsCreatorV2) computeValidatorInfoPerRewardAddress(
 	return rwdAddrValidatorInfo, accumulatedUnassigned
 }
 
+// SaveBlockDataToStorage saves block data to storage, along with the rewards breakdown per validator computed for
+// the provided epoch start block
+func (rc *rewardsCreatorV2) SaveBlockDataToStorage(metaBlock data.MetaHeaderHandler, body *block.Body) {
+	rc.baseRewardsCreator.SaveBlockDataToStorage(metaBlock, body)
+
+	rc.mutRewardsData.RLock()
+	defer rc.mutRewardsData.RUnlock()
+
+	if !rc.hasRewardsBreakdownFor(metaBlock) {
+		return
+	}
+
+	for blsKey, breakdown := range rc.rewardsBreakdown {
+		marshalledData, err := rc.marshalizer.Marshal(breakdown)
+		if err != nil {
+			log.Debug("rewardsCreatorV2.SaveBlockDataToStorage: marshal rewards breakdown", "error", err)
+			continue
+		}
+
+		err = rc.validatorRewardsStorage.Put(common.ValidatorEpochKey([]byte(blsKey), breakdown.Epoch), marshalledData)
+		if err != nil {
+			log.Debug("rewardsCreatorV2.SaveBlockDataToStorage: put rewards breakdown", "error", err)
+		}
+	}
+}
+
+// DeleteBlockDataFromStorage deletes block data from storage, along with the rewards breakdown per validator computed
+// for the provided epoch start block
+func (rc *rewardsCreatorV2) DeleteBlockDataFromStorage(metaBlock data.MetaHeaderHandler, body *block.Body) {
+	rc.baseRewardsCreator.DeleteBlockDataFromStorage(metaBlock, body)
+
+	rc.mutRewardsData.RLock()
+	defer rc.mutRewardsData.RUnlock()
+
+	if !rc.hasRewardsBreakdownFor(metaBlock) {
+		return
+	}
+
+	for blsKey, breakdown := range rc.rewardsBreakdown {
+		_ = rc.validatorRewardsStorage.Remove(common.ValidatorEpochKey([]byte(blsKey), breakdown.Epoch))
+	}
+}
+
+// to be called under locked mutex
+func (rc *rewardsCreatorV2) hasRewardsBreakdownFor(metaBlock data.MetaHeaderHandler) bool {
+	if check.IfNil(metaBlock) || !metaBlock.IsStartOfEpochBlock() {
+		return false
+	}
+
+	return len(rc.rewardsBreakdown) > 0 && rc.rewardsBreakdownEpoch == metaBlock.GetEpoch()
+}
+
+func (rc *rewardsCreatorV2) createRewardsBreakdown(
+	epoch uint32,
+	nodesRewardInfo map[uint32][]*nodeRewardsData,
+) map[string]*common.ValidatorRewardsBreakdown {
+	rewardsBreakdown := make(map[string]*common.ValidatorRewardsBreakdown)
+	unsupportedAddresses := make(map[string]bool)
+
+	for shardID, nodeInfoList := range nodesRewardInfo {
+		for _, nodeInfo := range nodeInfoList {
+			leaderFees := big.NewInt(0)
+			if nodeInfo.valInfo.GetAccumulatedFees() != nil {
+				leaderFees.Set(nodeInfo.valInfo.GetAccumulatedFees())
+			}
+
+			totalRewards := big.NewInt(0).Add(nodeInfo.fullRewards, leaderFees)
+			protocolSustainability := big.NewInt(0)
+			if !rc.isRewardedNode(nodeInfo, unsupportedAddresses) {
+				protocolSustainability, totalRewards = totalRewards, protocolSustainability
+			}
+
+			rewardsBreakdown[string(nodeInfo.valInfo.GetPublicKey())] = &common.ValidatorRewardsBreakdown{
+				Epoch:                      epoch,
+				ShardID:                    shardID,
+				RewardAddress:              rc.pubkeyConverter.SilentEncode(nodeInfo.valInfo.GetRewardAddress(), log),
+				NumSelectedInSuccessBlocks: nodeInfo.valInfo.GetNumSelectedInSuccessBlocks(),
+				TopUpStake:                 nodeInfo.topUpStake.String(),
+				BaseReward:                 nodeInfo.baseReward.String(),
+				TopUpReward:                nodeInfo.topUpReward.String(),
+				LeaderFees:                 leaderFees.String(),
+				ProtocolSustainability:     protocolSustainability.String(),
+				TotalRewards:               totalRewards.String(),
+			}
+		}
+	}
+
+	return rewardsBreakdown
+}
+
+// isRewardedNode returns false if the rewards of the node go to protocol sustainability, the same way as
+// computeValidatorInfoPerRewardAddress and addValidatorRewardsToMiniBlocks decide
+func (rc *rewardsCreatorV2) isRewardedNode(nodeInfo *nodeRewardsData, unsupportedAddresses map[string]bool) bool {
+	if nodeInfo.valInfo.GetLeaderSuccess() == 0 && nodeInfo.valInfo.GetValidatorSuccess() == 0 {
+		return false
+	}
+
+	rewardAddress := nodeInfo.valInfo.GetRewardAddress()
+	if rc.shardCoordinator.ComputeId(rewardAddress) != core.MetachainShardId {
+		return true
+	}
+
+	unsupported, checked := unsupportedAddresses[string(rewardAddress)]
+	if !checked {
+		unsupported = !rc.flagDelegationSystemSCEnabled.IsSet() || !rc.isSystemDelegationSC(rewardAddress)
+		unsupportedAddresses[string(rewardAddress)] = unsupported
+	}
+
+	return !unsupported
+}
+
 // IsInterfaceNil return true if underlying object is nil
 func (rc *rewardsCreatorV2) IsInterfaceNil() bool {
 	return rc == nil
@@ -86,6 +86,17 @@ func TestNewRewardsCreator_NilRewardsHandlerShouldErr(t *testing.T) {
 	require.Equal(t, epochStart.ErrNilRewardsHandler, err)
 }
 
+func TestNewRewardsCreator_NilValidatorRewardsStorageShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := getRewardsCreatorV2Arguments()
+	args.ValidatorRewardsStorage = nil
+
+	rwd, err := NewRewardsCreatorV2(args)
+	require.True(t, check.IfNil(rwd))
+	require.Equal(t, epochStart.ErrNilValidatorRewardsStorage, err)
+}
+
 func TestNewRewardsCreatorOK(t *testing.T) {
 	t.Parallel()
 
@@ -1667,6 +1678,139 @@ func TestNewRewardsCreatorV2_CreateRewardsMiniBlocks(t *testing.T) {
 	require.Nil(t, err)
 }
 
+func TestRewardsCreatorV2_SaveAndDeleteRewardsBreakdown(t *testing.T) {
+	t.Parallel()
+
+	args := getRewardsCreatorV2Arguments()
+	nbEligiblePerShard := uint32(10)
+	vInfo := createDefaultValidatorInfo(nbEligiblePerShard, args.ShardCoordinator, args.NodesConfigProvider, 100, defaultBlocksPerShard)
+	args.StakingDataProvider = &stakingcommon.StakingDataProviderStub{
+		GetTotalTopUpStakeEligibleNodesCalled: func() *big.Int {
+			return big.NewInt(0).Mul(big.NewInt(1000), big.NewInt(int64(len(vInfo.GetAllValidatorsInfo()))))
+		},
+		GetNodeStakedTopUpCalled: func(blsKey []byte) (*big.Int, error) {
+			return big.NewInt(1000), nil
+		},
+	}
+	blocksPerShard := make(map[uint32]uint64)
+	for shardID := range createShardsMap(args.ShardCoordinator) {
+		blocksPerShard[shardID] = 14400
+	}
+	args.EconomicsDataProvider.SetNumberOfBlocksPerShard(blocksPerShard)
+	rewardsForBlocks, _ := big.NewInt(0).SetString("5000000000000000000000", 10)
+	args.EconomicsDataProvider.SetRewardsToBeDistributedForBlocks(rewardsForBlocks)
+
+	rwd, _ := NewRewardsCreatorV2(args)
+	epochStartData := getDefaultEpochStart()
+	epochStartData.LastFinalizedHeaders = []block.EpochStartShardData{{ShardID: 0}}
+	metaBlock := &block.MetaBlock{
+		Epoch:          3,
+		EpochStart:     epochStartData,
+		DevFeesInEpoch: big.NewInt(0),
+	}
+	_, err := rwd.CreateRewardsMiniBlocks(metaBlock, vInfo, &metaBlock.EpochStart.Economics)
+	require.Nil(t, err)
+
+	allValidators := vInfo.GetAllValidatorsInfo()
+	require.Len(t, rwd.rewardsBreakdown, len(allValidators))
+	for _, v := range allValidators {
+		breakdown := rwd.rewardsBreakdown[string(v.GetPublicKey())]
+		require.NotNil(t, breakdown)
+		require.Equal(t, uint32(3), breakdown.Epoch)
+		require.Equal(t, v.GetShardId(), breakdown.ShardID)
+		require.Equal(t, v.GetAccumulatedFees().String(), breakdown.LeaderFees)
+		require.Equal(t, "0", breakdown.ProtocolSustainability)
+
+		baseReward, _ := big.NewInt(0).SetString(breakdown.BaseReward, 10)
+		topUpReward, _ := big.NewInt(0).SetString(breakdown.TopUpReward, 10)
+		expectedTotal := big.NewInt(0).Add(baseReward, topUpReward)
+		expectedTotal.Add(expectedTotal, v.GetAccumulatedFees())
+		require.Equal(t, expectedTotal.String(), breakdown.TotalRewards)
+	}
+
+	blsKey := allValidators[0].GetPublicKey()
+	key := common.ValidatorEpochKey(blsKey, 3)
+
+	rwd.SaveBlockDataToStorage(&block.MetaBlock{Epoch: 3}, &block.Body{})
+	_, err = args.ValidatorRewardsStorage.Get(key)
+	require.NotNil(t, err, "should not save for a block which is not an epoch start block")
+
+	rwd.SaveBlockDataToStorage(&block.MetaBlock{Epoch: 4, EpochStart: epochStartData}, &block.Body{})
+	_, err = args.ValidatorRewardsStorage.Get(key)
+	require.NotNil(t, err, "should not save for another epoch start block")
+
+	rwd.SaveBlockDataToStorage(metaBlock, &block.Body{})
+	marshalledData, err := args.ValidatorRewardsStorage.Get(key)
+	require.Nil(t, err)
+
+	savedBreakdown := &common.ValidatorRewardsBreakdown{}
+	err = args.Marshalizer.Unmarshal(savedBreakdown, marshalledData)
+	require.Nil(t, err)
+	require.Equal(t, rwd.rewardsBreakdown[string(blsKey)], savedBreakdown)
+
+	rwd.DeleteBlockDataFromStorage(metaBlock, &block.Body{})
+	_, err = args.ValidatorRewardsStorage.Get(key)
+	require.NotNil(t, err)
+}
+
+func TestRewardsCreatorV2_createRewardsBreakdownOfflineAndMetachainAddresses(t *testing.T) {
+	t.Parallel()
+
+	metaAddress := bytes.Repeat([]byte{255}, 32)
+	shardCoordinator := mock.NewMultiShardsCoordinatorMock(2)
+	shardCoordinator.CurrentShard = core.MetachainShardId
+	shardCoordinator.ComputeIdCalled = func(address []byte) uint32 {
+		if bytes.Equal(address, metaAddress) {
+			return core.MetachainShardId
+		}
+		return 0
+	}
+	args := getRewardsCreatorV2Arguments()
+	args.ShardCoordinator = shardCoordinator
+	rwd, _ := NewRewardsCreatorV2(args)
+
+	nodesRewardInfo := map[uint32][]*nodeRewardsData{
+		0: {
+			{
+				baseReward:  big.NewInt(100),
+				topUpReward: big.NewInt(10),
+				fullRewards: big.NewInt(110),
+				topUpStake:  big.NewInt(1000),
+				valInfo: &state.ValidatorInfo{
+					PublicKey:       []byte("offline"),
+					RewardAddress:   []byte("rewardAddress"),
+					AccumulatedFees: big.NewInt(5),
+				},
+			},
+			{
+				baseReward:  big.NewInt(200),
+				topUpReward: big.NewInt(20),
+				fullRewards: big.NewInt(220),
+				topUpStake:  big.NewInt(2000),
+				valInfo: &state.ValidatorInfo{
+					PublicKey:        []byte("metaAddress"),
+					RewardAddress:    metaAddress,
+					ValidatorSuccess: 1,
+					AccumulatedFees:  big.NewInt(6),
+				},
+			},
+		},
+	}
+
+	breakdown := rwd.createRewardsBreakdown(2, nodesRewardInfo)
+	require.Len(t, breakdown, 2)
+
+	require.Equal(t, "115", breakdown["offline"].ProtocolSustainability)
+	require.Equal(t, "0", breakdown["offline"].TotalRewards)
+	require.Equal(t, "100", breakdown["offline"].BaseReward)
+	require.Equal(t, "1000", breakdown["offline"].TopUpStake)
+
+	require.Equal(t, "226", breakdown["metaAddress"].ProtocolSustainability)
+	require.Equal(t, "0", breakdown["metaAddress"].TotalRewards)
+	require.Equal(t, "6", breakdown["metaAddress"].LeaderFees)
+	require.Equal(t, uint32(2), breakdown["metaAddress"].Epoch)
+}
+
 func TestNewRewardsCreatorV2_CreateRewardsMiniBlocks2169Nodes(t *testing.T) {
 	t.Parallel()
 
@@ -1775,10 +1919,11 @@ func getRewardsCreatorV2Arguments() RewardsCreatorArgsV2 {
 		},
 	}
 	return RewardsCreatorArgsV2{
-		BaseRewardsCreatorArgs: getBaseRewardsArguments(),
-		StakingDataProvider:    &stakingcommon.StakingDataProviderStub{},
-		EconomicsDataProvider:  NewEpochEconomicsStatistics(),
-		RewardsHandler:         rewardsHandler,
+		BaseRewardsCreatorArgs:  getBaseRewardsArguments(),
+		StakingDataProvider:     &stakingcommon.StakingDataProviderStub{},
+		EconomicsDataProvider:   NewEpochEconomicsStatistics(),
+		RewardsHandler:          rewardsHandler,
+		ValidatorRewardsStorage: mock.NewStorerMock(),
 	}
 }
 
@@ -1795,10 +1940,11 @@ func getRewardsCreatorV35Arguments() RewardsCreatorArgsV2 {
 		},
 	}
 	return RewardsCreatorArgsV2{
-		BaseRewardsCreatorArgs: getBaseRewardsArguments(),
-		StakingDataProvider:    &stakingcommon.StakingDataProviderStub{},
-		EconomicsDataProvider:  NewEpochEconomicsStatistics(),
-		RewardsHandler:         rewardsHandler,
+		BaseRewardsCreatorArgs:  getBaseRewardsArguments(),
+		StakingDataProvider:     &stakingcommon.StakingDataProviderStub{},
+		EconomicsDataProvider:   NewEpochEconomicsStatistics(),
+		RewardsHandler:          rewardsHandler,
+		ValidatorRewardsStorage: mock.NewStorerMock(),
 	}
 }
 
@@ -5,6 +5,7 @@ import (
 	"encoding/hex"
 	"sort"
 	"strings"
+	"sync"
 
 	"github.com/multiversx/mx-chain-core-go/core"
 	"github.com/multiversx/mx-chain-core-go/core/check"
@@ -26,23 +27,27 @@ var _ process.EpochStartValidatorInfoCreator = (*validatorInfoCreator)(nil)
 
 // ArgsNewValidatorInfoCreator defines the arguments structure needed to create a new validatorInfo creator
 type ArgsNewValidatorInfoCreator struct {
-	ShardCoordinator     sharding.Coordinator
-	ValidatorInfoStorage storage.Storer
-	MiniBlockStorage     storage.Storer
-	Hasher               hashing.Hasher
-	Marshalizer          marshal.Marshalizer
-	DataPool             dataRetriever.PoolsHolder
-	EnableEpochsHandler  common.EnableEpochsHandler
+	ShardCoordinator        sharding.Coordinator
+	ValidatorInfoStorage    storage.Storer
+	MiniBlockStorage        storage.Storer
+	ValidatorRatingsStorage storage.Storer
+	Hasher                  hashing.Hasher
+	Marshalizer             marshal.Marshalizer
+	DataPool                dataRetriever.PoolsHolder
+	EnableEpochsHandler     common.EnableEpochsHandler
 }
 
 type validatorInfoCreator struct {
-	shardCoordinator     sharding.Coordinator
-	validatorInfoStorage storage.Storer
-	miniBlockStorage     storage.Storer
-	hasher               hashing.Hasher
-	marshalizer          marshal.Marshalizer
-	dataPool             dataRetriever.PoolsHolder
-	enableEpochsHandler  common.EnableEpochsHandler
+	shardCoordinator        sharding.Coordinator
+	validatorInfoStorage    storage.Storer
+	miniBlockStorage        storage.Storer
+	validatorRatingsStorage storage.Storer
+	hasher                  hashing.Hasher
+	marshalizer             marshal.Marshalizer
+	dataPool                dataRetriever.PoolsHolder
+	enableEpochsHandler     common.EnableEpochsHandler
+	mutRatingsHistory       sync.RWMutex
+	ratingsHistory          map[string]*state.ValidatorInfo
 }
 
 // NewValidatorInfoCreator creates a new validatorInfo creator object
@@ -62,6 +67,9 @@ func NewValidatorInfoCreator(args ArgsNewValidatorInfoCreator) (*validatorInfoCr
 	if check.IfNil(args.MiniBlockStorage) {
 		return nil, epochStart.ErrNilStorage
 	}
+	if check.IfNil(args.ValidatorRatingsStorage) {
+		return nil, epochStart.ErrNilValidatorRatingsStorage
+	}
 	if check.IfNil(args.DataPool) {
 		return nil, epochStart.ErrNilDataPoolsHolder
 	}
@@ -80,13 +88,15 @@ func NewValidatorInfoCreator(args ArgsNewValidatorInfoCreator) (*validatorInfoCr
 	}
 
 	vic := &validatorInfoCreator{
-		shardCoordinator:     args.ShardCoordinator,
-		hasher:               args.Hasher,
-		marshalizer:          args.Marshalizer,
-		validatorInfoStorage: args.ValidatorInfoStorage,
-		miniBlockStorage:     args.MiniBlockStorage,
-		dataPool:             args.DataPool,
-		enableEpochsHandler:  args.EnableEpochsHandler,
+		shardCoordinator:        args.ShardCoordinator,
+		hasher:                  args.Hasher,
+		marshalizer:             args.Marshalizer,
+		validatorInfoStorage:    args.ValidatorInfoStorage,
+		miniBlockStorage:        args.MiniBlockStorage,
+		validatorRatingsStorage: args.ValidatorRatingsStorage,
+		dataPool:                args.DataPool,
+		enableEpochsHandler:     args.EnableEpochsHandler,
+		ratingsHistory:          make(map[string]*state.ValidatorInfo),
 	}
 
 	return vic, nil
@@ -99,6 +109,7 @@ func (vic *validatorInfoCreator) CreateValidatorInfoMiniBlocks(validatorsInfo st
 	}
 
 	vic.clean()
+	vic.setRatingsHistory(validatorsInfo)
 
 	miniBlocks := make([]*block.MiniBlock, 0)
 
@@ -132,6 +143,31 @@ func (vic *validatorInfoCreator) CreateValidatorInfoMiniBlocks(validatorsInfo st
 	return miniBlocks, nil
 }
 
+// setRatingsHistory keeps the ratings and the counters of all validators, as they are at the end of the epoch, before
+// being reset for the new one. The ratings are kept raw, as in the peer accounts, and are converted to the percentage
+// scale of the validator statistics when read
+func (vic *validatorInfoCreator) setRatingsHistory(validatorsInfo state.ShardValidatorsInfoMapHandler) {
+	ratingsHistory := make(map[string]*state.ValidatorInfo)
+	for _, validator := range validatorsInfo.GetAllValidatorsInfo() {
+		ratingsHistory[string(validator.GetPublicKey())] = &state.ValidatorInfo{
+			ShardId:                    validator.GetShardId(),
+			List:                       validator.GetList(),
+			PreviousList:               validator.GetPreviousList(),
+			Rating:                     validator.GetRating(),
+			TempRating:                 validator.GetTempRating(),
+			LeaderSuccess:              validator.GetLeaderSuccess(),
+			LeaderFailure:              validator.GetLeaderFailure(),
+			ValidatorSuccess:           validator.GetValidatorSuccess(),
+			ValidatorFailure:           validator.GetValidatorFailure(),
+			ValidatorIgnoredSignatures: validator.GetValidatorIgnoredSignatures(),
+		}
+	}
+
+	vic.mutRatingsHistory.Lock()
+	vic.ratingsHistory = ratingsHistory
+	vic.mutRatingsHistory.Unlock()
+}
+
 func (vic *validatorInfoCreator) createMiniBlock(validatorsInfo []state.ValidatorInfoHandler) (*block.MiniBlock, error) {
 	miniBlock := &block.MiniBlock{}
 	miniBlock.SenderShardID = vic.shardCoordinator.SelfId()
@@ -439,12 +475,15 @@ func (vic *validatorInfoCreator) getShardValidatorInfo(txHash []byte) (*state.Sh
 	return shardValidatorInfo, nil
 }
 
-// SaveBlockDataToStorage saves block data to storage
-func (vic *validatorInfoCreator) SaveBlockDataToStorage(_ data.HeaderHandler, body *block.Body) {
+// SaveBlockDataToStorage saves block data to storage, along with the ratings history of the epoch ended by the
+// provided epoch start block
+func (vic *validatorInfoCreator) SaveBlockDataToStorage(metaBlock data.HeaderHandler, body *block.Body) {
 	if check.IfNil(body) {
 		return
 	}
 
+	vic.saveRatingsHistory(metaBlock)
+
 	for _, miniBlock := range body.MiniBlocks {
 		if miniBlock.Type != block.PeerBlock {
 			continue
@@ -491,12 +530,38 @@ func (vic *validatorInfoCreator) saveValidatorInfo(miniBlock *block.MiniBlock) {
 	}
 }
 
-// DeleteBlockDataFromStorage deletes block data from storage
+func (vic *validatorInfoCreator) saveRatingsHistory(metaBlock data.HeaderHandler) {
+	endedEpoch, ok := getEndedEpoch(metaBlock)
+	if !ok {
+		return
+	}
+
+	vic.mutRatingsHistory.RLock()
+	defer vic.mutRatingsHistory.RUnlock()
+
+	for blsKey, ratingHistory := range vic.ratingsHistory {
+		marshalledData, err := vic.marshalizer.Marshal(ratingHistory)
+		if err != nil {
+			log.Debug("validatorInfoCreator.saveRatingsHistory.Marshal", "error", err)
+			continue
+		}
+
+		err = vic.validatorRatingsStorage.Put(common.ValidatorEpochKey([]byte(blsKey), endedEpoch), marshalledData)
+		if err != nil {
+			log.Debug("validatorInfoCreator.saveRatingsHistory.Put", "error", err)
+		}
+	}
+}
+
+// DeleteBlockDataFromStorage deletes block data from storage, along with the ratings history of the epoch ended by
+// the provided epoch start block
 func (vic *validatorInfoCreator) DeleteBlockDataFromStorage(metaBlock data.HeaderHandler, body *block.Body) {
 	if check.IfNil(metaBlock) || check.IfNil(body) {
 		return
 	}
 
+	vic.removeRatingsHistory(metaBlock, body)
+
 	if vic.enableEpochsHandler.IsFlagEnabled(common.RefactorPeersMiniBlocksFlag) {
 		vic.removeValidatorInfo(body)
 	}
@@ -511,6 +576,68 @@ func (vic *validatorInfoCreator) DeleteBlockDataFromStorage(metaBlock data.Heade
 	}
 }
 
+// removeRatingsHistory removes the ratings history saved by the provided epoch start block. The validators are taken
+// from the peer mini blocks of the block, as the kept ratings history might have been created for another block
+func (vic *validatorInfoCreator) removeRatingsHistory(metaBlock data.HeaderHandler, body *block.Body) {
+	endedEpoch, ok := getEndedEpoch(metaBlock)
+	if !ok {
+		return
+	}
+
+	for _, miniBlock := range body.MiniBlocks {
+		if miniBlock.Type != block.PeerBlock {
+			continue
+		}
+
+		vic.removeRatingsHistoryFromStorage(miniBlock, endedEpoch)
+	}
+}
+
+func (vic *validatorInfoCreator) removeRatingsHistoryFromStorage(miniBlock *block.MiniBlock, endedEpoch uint32) {
+	for _, txHash := range miniBlock.TxHashes {
+		shardValidatorInfo, err := vic.getSavedShardValidatorInfo(txHash)
+		if err != nil {
+			log.Debug("validatorInfoCreator.removeRatingsHistoryFromStorage.getSavedShardValidatorInfo", "hash", txHash, "error", err)
+			continue
+		}
+
+		err = vic.validatorRatingsStorage.Remove(common.ValidatorEpochKey(shardValidatorInfo.PublicKey, endedEpoch))
+		if err != nil {
+			log.Debug("validatorInfoCreator.removeRatingsHistoryFromStorage.Remove", "hash", txHash, "error", err)
+		}
+	}
+}
+
+// getSavedShardValidatorInfo returns the validator info of a peer mini block transaction, reading it first from the
+// storage, as the current epoch validator info pool might not hold it anymore
+func (vic *validatorInfoCreator) getSavedShardValidatorInfo(txHash []byte) (*state.ShardValidatorInfo, error) {
+	if !vic.enableEpochsHandler.IsFlagEnabled(common.RefactorPeersMiniBlocksFlag) {
+		return vic.getShardValidatorInfo(txHash)
+	}
+
+	marshalledData, err := vic.validatorInfoStorage.Get(txHash)
+	if err != nil {
+		return vic.getShardValidatorInfo(txHash)
+	}
+
+	shardValidatorInfo := &state.ShardValidatorInfo{}
+	err = vic.marshalizer.Unmarshal(shardValidatorInfo, marshalledData)
+	if err != nil {
+		return nil, err
+	}
+
+	return shardValidatorInfo, nil
+}
+
+// getEndedEpoch returns the epoch ended by the provided block, if it is an epoch start block
+func getEndedEpoch(metaBlock data.HeaderHandler) (uint32, bool) {
+	if check.IfNil(metaBlock) || !metaBlock.IsStartOfEpochBlock() || metaBlock.GetEpoch() == 0 {
+		return 0, false
+	}
+
+	return metaBlock.GetEpoch() - 1, true
+}
+
 func (vic *validatorInfoCreator) removeValidatorInfo(body *block.Body) {
 	for _, miniBlock := range body.MiniBlocks {
 		if miniBlock.Type != block.PeerBlock {
@@ -17,6 +17,7 @@ import (
 	"github.com/multiversx/mx-chain-core-go/marshal"
 	"github.com/multiversx/mx-chain-go/common"
 	"github.com/multiversx/mx-chain-go/dataRetriever"
+	"github.com/multiversx/mx-chain-go/dataRetriever/dataPool"
 	"github.com/multiversx/mx-chain-go/epochStart"
 	"github.com/multiversx/mx-chain-go/process/mock"
 	"github.com/multiversx/mx-chain-go/state"
@@ -121,11 +122,12 @@ func createMockEpochValidatorInfoCreatorsArguments() ArgsNewValidatorInfoCreator
 	_ = shardCoordinator.SetSelfId(core.MetachainShardId)
 
 	argsNewEpochEconomics := ArgsNewValidatorInfoCreator{
-		ShardCoordinator:     shardCoordinator,
-		ValidatorInfoStorage: testscommon.CreateMemUnit(),
-		MiniBlockStorage:     testscommon.CreateMemUnit(),
-		Hasher:               &hashingMocks.HasherMock{},
-		Marshalizer:          &mock.MarshalizerMock{},
+		ShardCoordinator:        shardCoordinator,
+		ValidatorInfoStorage:    testscommon.CreateMemUnit(),
+		MiniBlockStorage:        testscommon.CreateMemUnit(),
+		ValidatorRatingsStorage: testscommon.CreateMemUnit(),
+		Hasher:                  &hashingMocks.HasherMock{},
+		Marshalizer:             &mock.MarshalizerMock{},
 		DataPool: &dataRetrieverMock.PoolsHolderStub{
 			MiniBlocksCalled: func() storage.Cacher {
 				return &testscommon.CacherStub{
@@ -204,6 +206,17 @@ func TestEpochValidatorInfoCreator_NewValidatorInfoCreatorNilStore(t *testing.T)
 	require.Equal(t, epochStart.ErrNilStorage, err)
 }
 
+func TestEpochValidatorInfoCreator_NewValidatorInfoCreatorNilValidatorRatingsStorage(t *testing.T) {
+	t.Parallel()
+
+	arguments := createMockEpochValidatorInfoCreatorsArguments()
+	arguments.ValidatorRatingsStorage = nil
+	vic, err := NewValidatorInfoCreator(arguments)
+
+	require.Nil(t, vic)
+	require.Equal(t, epochStart.ErrNilValidatorRatingsStorage, err)
+}
+
 func TestEpochValidatorInfoCreator_NewValidatorInfoCreatorNilShardCoordinator(t *testing.T) {
 	t.Parallel()
 
@@ -493,6 +506,111 @@ func TestEpochValidatorInfoCreator_SaveValidatorInfoBlockDataToStorage(t *testin
 	}
 }
 
+func TestEpochValidatorInfoCreator_SaveAndDeleteRatingsHistory(t *testing.T) {
+	t.Parallel()
+
+	validatorInfo := createMockValidatorInfo()
+	jailedValidator := &state.ValidatorInfo{
+		PublicKey:                  []byte("a3"),
+		ShardId:                    core.MetachainShardId,
+		List:                       string(common.JailedList),
+		PreviousList:               string(common.EligibleList),
+		TempRating:                 10,
+		Rating:                     500,
+		LeaderFailure:              5,
+		ValidatorFailure:           50,
+		ValidatorIgnoredSignatures: 2,
+	}
+	_ = validatorInfo.Add(jailedValidator)
+
+	arguments := createMockEpochValidatorInfoCreatorsArguments()
+	currentEpochValidatorInfoPool := dataPool.NewCurrentEpochValidatorInfoPool()
+	arguments.DataPool = &dataRetrieverMock.PoolsHolderStub{
+		CurrEpochValidatorInfoCalled: func() dataRetriever.ValidatorInfoCacher {
+			return currentEpochValidatorInfoPool
+		},
+	}
+	ratingsStorage := arguments.ValidatorRatingsStorage
+	vic, _ := NewValidatorInfoCreator(arguments)
+
+	miniBlocks, err := vic.CreateValidatorInfoMiniBlocks(validatorInfo)
+	require.Nil(t, err)
+	body := &block.Body{MiniBlocks: miniBlocks}
+
+	t.Run("not an epoch start block should not save", func(t *testing.T) {
+		vic.SaveBlockDataToStorage(&block.MetaBlock{Epoch: 3}, body)
+
+		_, errGet := ratingsStorage.Get(common.ValidatorEpochKey([]byte("a1"), 2))
+		require.NotNil(t, errGet)
+	})
+	t.Run("epoch start block should save the history of the ended epoch", func(t *testing.T) {
+		epochStartBlock := &block.MetaBlock{
+			Epoch: 3,
+			EpochStart: block.EpochStart{
+				LastFinalizedHeaders: []block.EpochStartShardData{{ShardID: 0}},
+			},
+		}
+		vic.SaveBlockDataToStorage(epochStartBlock, body)
+
+		for _, validator := range validatorInfo.GetAllValidatorsInfo() {
+			marshalledData, errGet := ratingsStorage.Get(common.ValidatorEpochKey(validator.GetPublicKey(), 2))
+			require.Nil(t, errGet)
+
+			ratingHistory := &state.ValidatorInfo{}
+			require.Nil(t, arguments.Marshalizer.Unmarshal(ratingHistory, marshalledData))
+			assert.Equal(t, validator.GetRating(), ratingHistory.Rating)
+			assert.Equal(t, validator.GetTempRating(), ratingHistory.TempRating)
+			assert.Equal(t, validator.GetValidatorFailure(), ratingHistory.ValidatorFailure)
+		}
+
+		marshalledData, _ := ratingsStorage.Get(common.ValidatorEpochKey(jailedValidator.PublicKey, 2))
+		ratingHistory := &state.ValidatorInfo{}
+		_ = arguments.Marshalizer.Unmarshal(ratingHistory, marshalledData)
+		expectedHistory := &state.ValidatorInfo{
+			ShardId:                    core.MetachainShardId,
+			List:                       string(common.JailedList),
+			PreviousList:               string(common.EligibleList),
+			Rating:                     500,
+			TempRating:                 10,
+			LeaderFailure:              5,
+			ValidatorFailure:           50,
+			ValidatorIgnoredSignatures: 2,
+		}
+		assert.Equal(t, expectedHistory, ratingHistory)
+
+		vic.DeleteBlockDataFromStorage(epochStartBlock, body)
+		for _, validator := range validatorInfo.GetAllValidatorsInfo() {
+			_, errGet := ratingsStorage.Get(common.ValidatorEpochKey(validator.GetPublicKey(), 2))
+			require.NotNil(t, errGet)
+		}
+	})
+	t.Run("delete should remove the history saved by the provided block", func(t *testing.T) {
+		epochStartBlock := &block.MetaBlock{
+			Epoch: 5,
+			EpochStart: block.EpochStart{
+				LastFinalizedHeaders: []block.EpochStartShardData{{ShardID: 0}},
+			},
+		}
+		vic.SaveBlockDataToStorage(epochStartBlock, body)
+		for _, validator := range validatorInfo.GetAllValidatorsInfo() {
+			_, errGet := ratingsStorage.Get(common.ValidatorEpochKey(validator.GetPublicKey(), 4))
+			require.Nil(t, errGet)
+		}
+
+		// the mini blocks of another block, holding other validators, are created before the rollback
+		otherValidatorsInfo := state.NewShardValidatorsInfoMap()
+		_ = otherValidatorsInfo.Add(&state.ValidatorInfo{PublicKey: []byte("b1"), ShardId: 0, List: string(common.EligibleList)})
+		_, err = vic.CreateValidatorInfoMiniBlocks(otherValidatorsInfo)
+		require.Nil(t, err)
+
+		vic.DeleteBlockDataFromStorage(epochStartBlock, body)
+		for _, validator := range validatorInfo.GetAllValidatorsInfo() {
+			_, errGet := ratingsStorage.Get(common.ValidatorEpochKey(validator.GetPublicKey(), 4))
+			require.NotNil(t, errGet)
+		}
+	})
+}
+
 func TestEpochValidatorInfoCreator_DeleteValidatorInfoBlockDataFromStorage(t *testing.T) {
 	testDeleteValidatorInfoBlockData(t, block.PeerBlock, false)
 }
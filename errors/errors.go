@@ -130,6 +130,9 @@ var ErrNilBootstrapParamsHandler = errors.New("nil bootstrap parameters handler"
 // ErrNilBroadcastMessenger is raised when a valid broadcast messenger is expected but nil used
 var ErrNilBroadcastMessenger = errors.New("broadcast messenger is nil")
 
+// ErrNilRoundTimelineRecorder signals that a nil round timeline recorder has been provided
+var ErrNilRoundTimelineRecorder = errors.New("nil round timeline recorder")
+
 // ErrNilChronologyHandler is raised when a valid chronology handler is expected but nil used
 var ErrNilChronologyHandler = errors.New("chronology handler is nil")
 
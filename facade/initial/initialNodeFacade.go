@@ -161,6 +161,26 @@ func (inf *initialNodeFacade) AuctionListApi() ([]*common.AuctionListValidatorAP
 	return nil, errNodeStarting
 }
 
+// SimulateAuctionApi returns nil and error
+func (inf *initialNodeFacade) SimulateAuctionApi(_ []*common.AuctionSimulationOwnerChange) (*common.AuctionSimulationAPIResponse, error) {
+	return nil, errNodeStarting
+}
+
+// GetNodesShufflingPreview returns nil and error
+func (inf *initialNodeFacade) GetNodesShufflingPreview() (*common.NodesShufflingPreviewAPIResponse, error) {
+	return nil, errNodeStarting
+}
+
+// GetValidatorRatingsHistory returns nil and error
+func (inf *initialNodeFacade) GetValidatorRatingsHistory(_ string, _ core.OptionalUint32, _ core.OptionalUint32) ([]*common.ValidatorRatingHistory, error) {
+	return nil, errNodeStarting
+}
+
+// GetValidatorRewardsHistory returns nil and error
+func (inf *initialNodeFacade) GetValidatorRewardsHistory(_ string, _ core.OptionalUint32, _ core.OptionalUint32) ([]*common.ValidatorRewardsBreakdown, error) {
+	return nil, errNodeStarting
+}
+
 // SendBulkTransactions returns 0 and error
 func (inf *initialNodeFacade) SendBulkTransactions(_ []*transaction.Transaction) (uint64, error) {
 	return uint64(0), errNodeStarting
@@ -361,6 +381,31 @@ func (inf *initialNodeFacade) GetDelegatorsList() ([]*api.Delegator, error) {
 	return nil, errNodeStarting
 }
 
+// GetDelegationContract returns nil and error
+func (inf *initialNodeFacade) GetDelegationContract(_ string, _ uint32, _ uint32) (*common.DelegationContractAPIResponse, error) {
+	return nil, errNodeStarting
+}
+
+// GetDelegationPositions returns nil and error
+func (inf *initialNodeFacade) GetDelegationPositions(_ string) (*common.DelegationPositionsAPIResponse, error) {
+	return nil, errNodeStarting
+}
+
+// GetGovernanceProposals returns nil and error
+func (inf *initialNodeFacade) GetGovernanceProposals() (*common.GovernanceProposalsAPIResponse, error) {
+	return nil, errNodeStarting
+}
+
+// GetGovernanceProposal returns nil and error
+func (inf *initialNodeFacade) GetGovernanceProposal(_ uint64) (*common.GovernanceProposalAPIResponse, error) {
+	return nil, errNodeStarting
+}
+
+// GetGovernanceVotes returns nil and error
+func (inf *initialNodeFacade) GetGovernanceVotes(_ string) (*common.GovernanceVotesAPIResponse, error) {
+	return nil, errNodeStarting
+}
+
 // GetESDTData returns nil and error
 func (inf *initialNodeFacade) GetESDTData(_ string, _ string, _ uint64, _ api.AccountQueryOptions) (*esdt.ESDigitalToken, api.BlockInfo, error) {
 	return nil, api.BlockInfo{}, errNodeStarting
@@ -416,11 +461,41 @@ func (inf *initialNodeFacade) GetGasConfigs() (map[string]map[string]uint64, err
 	return nil, errNodeStarting
 }
 
+// GetGasPriceSuggestion returns a nil structure and error
+func (inf *initialNodeFacade) GetGasPriceSuggestion() (*common.GasPriceSuggestionAPIResponse, error) {
+	return nil, errNodeStarting
+}
+
+// GetTrieStatistics returns nil and error
+func (inf *initialNodeFacade) GetTrieStatistics(_ string) (*common.TrieStatisticsAPIResponse, error) {
+	return nil, errNodeStarting
+}
+
+// StartOutportReindexing returns error
+func (inf *initialNodeFacade) StartOutportReindexing(_ uint64, _ uint64) error {
+	return errNodeStarting
+}
+
+// GetOutportReindexingStatus returns an empty status and error
+func (inf *initialNodeFacade) GetOutportReindexingStatus() (common.OutportReindexStatus, error) {
+	return common.OutportReindexStatus{}, errNodeStarting
+}
+
+// GetConsensusRoundsTimeline returns nil and error
+func (inf *initialNodeFacade) GetConsensusRoundsTimeline(_ uint32) ([]*common.ConsensusRoundTimeline, error) {
+	return nil, errNodeStarting
+}
+
 // IsDataTrieMigrated returns false and error
 func (inf *initialNodeFacade) IsDataTrieMigrated(_ string, _ api.AccountQueryOptions) (bool, error) {
 	return false, errNodeStarting
 }
 
+// GetDataTrieStatistics returns nil and error
+func (inf *initialNodeFacade) GetDataTrieStatistics(_ string, _ api.AccountQueryOptions) (*common.DataTrieStatisticsAPIResponse, api.BlockInfo, error) {
+	return nil, api.BlockInfo{}, errNodeStarting
+}
+
 // GetManagedKeysCount returns 0
 func (inf *initialNodeFacade) GetManagedKeysCount() int {
 	return 0
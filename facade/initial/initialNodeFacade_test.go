@@ -4,7 +4,9 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/multiversx/mx-chain-core-go/core"
 	"github.com/multiversx/mx-chain-core-go/data/api"
+	"github.com/multiversx/mx-chain-go/common"
 	"github.com/multiversx/mx-chain-go/facade"
 	"github.com/multiversx/mx-chain-go/node/external"
 	"github.com/multiversx/mx-chain-go/testscommon"
@@ -99,6 +101,22 @@ func TestInitialNodeFacade_AllMethodsShouldNotPanic(t *testing.T) {
 	assert.Nil(t, v2)
 	assert.Equal(t, errNodeStarting, err)
 
+	simulation, err := inf.SimulateAuctionApi(nil)
+	assert.Nil(t, simulation)
+	assert.Equal(t, errNodeStarting, err)
+
+	rewardsHistory, err := inf.GetValidatorRewardsHistory("", core.OptionalUint32{}, core.OptionalUint32{})
+	assert.Nil(t, rewardsHistory)
+	assert.Equal(t, errNodeStarting, err)
+
+	shufflingPreview, err := inf.GetNodesShufflingPreview()
+	assert.Nil(t, shufflingPreview)
+	assert.Equal(t, errNodeStarting, err)
+
+	ratingsHistory, err := inf.GetValidatorRatingsHistory("", core.OptionalUint32{}, core.OptionalUint32{})
+	assert.Nil(t, ratingsHistory)
+	assert.Equal(t, errNodeStarting, err)
+
 	u1, err := inf.SendBulkTransactions(nil)
 	assert.Equal(t, uint64(0), u1)
 	assert.Equal(t, errNodeStarting, err)
@@ -237,6 +255,49 @@ func TestInitialNodeFacade_AllMethodsShouldNotPanic(t *testing.T) {
 	assert.Nil(t, gasConfig)
 	assert.Equal(t, errNodeStarting, err)
 
+	gasPriceSuggestion, err := inf.GetGasPriceSuggestion()
+	assert.Nil(t, gasPriceSuggestion)
+	assert.Equal(t, errNodeStarting, err)
+
+	delegationContract, err := inf.GetDelegationContract("", 0, 0)
+	assert.Nil(t, delegationContract)
+	assert.Equal(t, errNodeStarting, err)
+
+	delegationPositions, err := inf.GetDelegationPositions("")
+	assert.Nil(t, delegationPositions)
+	assert.Equal(t, errNodeStarting, err)
+
+	governanceProposals, err := inf.GetGovernanceProposals()
+	assert.Nil(t, governanceProposals)
+	assert.Equal(t, errNodeStarting, err)
+
+	governanceProposal, err := inf.GetGovernanceProposal(0)
+	assert.Nil(t, governanceProposal)
+	assert.Equal(t, errNodeStarting, err)
+
+	governanceVotes, err := inf.GetGovernanceVotes("")
+	assert.Nil(t, governanceVotes)
+	assert.Equal(t, errNodeStarting, err)
+
+	trieStatistics, err := inf.GetTrieStatistics("")
+	assert.Nil(t, trieStatistics)
+	assert.Equal(t, errNodeStarting, err)
+
+	err = inf.StartOutportReindexing(1, 2)
+	assert.Equal(t, errNodeStarting, err)
+
+	reindexingStatus, err := inf.GetOutportReindexingStatus()
+	assert.Equal(t, common.OutportReindexStatus{}, reindexingStatus)
+	assert.Equal(t, errNodeStarting, err)
+
+	roundsTimeline, err := inf.GetConsensusRoundsTimeline(10)
+	assert.Nil(t, roundsTimeline)
+	assert.Equal(t, errNodeStarting, err)
+
+	dataTrieStatistics, _, err := inf.GetDataTrieStatistics("", api.AccountQueryOptions{})
+	assert.Nil(t, dataTrieStatistics)
+	assert.Equal(t, errNodeStarting, err)
+
 	txs, err := inf.GetTransactionsPoolForSender("", "")
 	assert.Nil(t, txs)
 	assert.Equal(t, errNodeStarting, err)
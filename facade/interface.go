@@ -93,6 +93,11 @@ type NodeHandler interface {
 	ValidatorStatisticsApi() (map[string]*validator.ValidatorStatistics, error)
 
 	AuctionListApi() ([]*common.AuctionListValidatorAPIResponse, error)
+	SimulateAuctionApi(changes []*common.AuctionSimulationOwnerChange) (*common.AuctionSimulationAPIResponse, error)
+	GetNodesShufflingPreview() (*common.NodesShufflingPreviewAPIResponse, error)
+	GetValidatorRewardsHistory(blsKey string, fromEpoch core.OptionalUint32, toEpoch core.OptionalUint32) ([]*common.ValidatorRewardsBreakdown, error)
+	GetValidatorRatingsHistory(blsKey string, fromEpoch core.OptionalUint32, toEpoch core.OptionalUint32) ([]*common.ValidatorRatingHistory, error)
+	GetConsensusRoundsTimeline(numRounds uint32) ([]*common.ConsensusRoundTimeline, error)
 	DirectTrigger(epoch uint32, withEarlyEndOfEpoch bool) error
 	IsSelfTrigger() bool
 
@@ -109,6 +114,7 @@ type NodeHandler interface {
 	GetProofDataTrie(rootHash string, address string, key string) (*common.GetProofResponse, *common.GetProofResponse, error)
 	VerifyProof(rootHash string, address string, proof [][]byte) (bool, error)
 	IsDataTrieMigrated(address string, options api.AccountQueryOptions) (bool, error)
+	GetDataTrieStatistics(address string, options api.AccountQueryOptions) (*common.DataTrieStatisticsAPIResponse, api.BlockInfo, error)
 }
 
 // TransactionSimulatorProcessor defines the actions which a transaction simulator processor has to implement
@@ -126,6 +132,11 @@ type ApiResolver interface {
 	GetTotalStakedValue(ctx context.Context) (*api.StakeValues, error)
 	GetDirectStakedList(ctx context.Context) ([]*api.DirectStakedValue, error)
 	GetDelegatorsList(ctx context.Context) ([]*api.Delegator, error)
+	GetDelegationContract(ctx context.Context, address string, page uint32, pageSize uint32) (*common.DelegationContractAPIResponse, error)
+	GetDelegationPositions(ctx context.Context, address string) (*common.DelegationPositionsAPIResponse, error)
+	GetGovernanceProposals(ctx context.Context) (*common.GovernanceProposalsAPIResponse, error)
+	GetGovernanceProposal(nonce uint64) (*common.GovernanceProposalAPIResponse, error)
+	GetGovernanceVotes(address string) (*common.GovernanceVotesAPIResponse, error)
 	GetTransaction(hash string, withResults bool) (*transaction.ApiTransactionResult, error)
 	GetTransactionsPool(fields string) (*common.TransactionsPoolAPIResponse, error)
 	GetTransactionsPoolForSender(sender, fields string) (*common.TransactionsPoolForSenderApiResponse, error)
@@ -147,6 +158,10 @@ type ApiResolver interface {
 	GetGenesisNodesPubKeys() (map[uint32][]string, map[uint32][]string)
 	GetGenesisBalances() ([]*common.InitialAccountAPI, error)
 	GetGasConfigs() map[string]map[string]uint64
+	GetGasPriceSuggestion() (*common.GasPriceSuggestionAPIResponse, error)
+	GetTrieStatistics(rootHash string) (*common.TrieStatisticsAPIResponse, error)
+	StartOutportReindexing(startNonce uint64, endNonce uint64) error
+	GetOutportReindexingStatus() common.OutportReindexStatus
 	GetManagedKeysCount() int
 	GetManagedKeys() []string
 	GetLoadedKeys() []string
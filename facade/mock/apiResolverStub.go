@@ -23,6 +23,11 @@ type ApiResolverStub struct {
 	GetTotalStakedValueHandler                  func(ctx context.Context) (*api.StakeValues, error)
 	GetDirectStakedListHandler                  func(ctx context.Context) ([]*api.DirectStakedValue, error)
 	GetDelegatorsListHandler                    func(ctx context.Context) ([]*api.Delegator, error)
+	GetDelegationContractCalled                 func(ctx context.Context, address string, page uint32, pageSize uint32) (*common.DelegationContractAPIResponse, error)
+	GetDelegationPositionsCalled                func(ctx context.Context, address string) (*common.DelegationPositionsAPIResponse, error)
+	GetGovernanceProposalsCalled                func(ctx context.Context) (*common.GovernanceProposalsAPIResponse, error)
+	GetGovernanceProposalCalled                 func(nonce uint64) (*common.GovernanceProposalAPIResponse, error)
+	GetGovernanceVotesCalled                    func(address string) (*common.GovernanceVotesAPIResponse, error)
 	GetBlockByHashCalled                        func(hash string, options api.BlockQueryOptions) (*api.Block, error)
 	GetBlockByNonceCalled                       func(nonce uint64, options api.BlockQueryOptions) (*api.Block, error)
 	GetBlockByRoundCalled                       func(round uint64, options api.BlockQueryOptions) (*api.Block, error)
@@ -44,6 +49,10 @@ type ApiResolverStub struct {
 	GetLastPoolNonceForSenderCalled             func(sender string) (uint64, error)
 	GetTransactionsPoolNonceGapsForSenderCalled func(sender string, senderAccountNonce uint64) (*common.TransactionsPoolNonceGapsForSenderApiResponse, error)
 	GetGasConfigsCalled                         func() map[string]map[string]uint64
+	GetGasPriceSuggestionCalled                 func() (*common.GasPriceSuggestionAPIResponse, error)
+	GetTrieStatisticsCalled                     func(rootHash string) (*common.TrieStatisticsAPIResponse, error)
+	StartOutportReindexingCalled                func(startNonce uint64, endNonce uint64) error
+	GetOutportReindexingStatusCalled            func() common.OutportReindexStatus
 	GetManagedKeysCountCalled                   func() int
 	GetManagedKeysCalled                        func() []string
 	GetLoadedKeysCalled                         func() []string
@@ -150,6 +159,33 @@ func (ars *ApiResolverStub) GetDirectStakedList(ctx context.Context) ([]*api.Dir
 	return nil, nil
 }
 
+// GetGovernanceProposals -
+func (ars *ApiResolverStub) GetGovernanceProposals(ctx context.Context) (*common.GovernanceProposalsAPIResponse, error) {
+	if ars.GetGovernanceProposalsCalled != nil {
+		return ars.GetGovernanceProposalsCalled(ctx)
+	}
+
+	return nil, nil
+}
+
+// GetGovernanceProposal -
+func (ars *ApiResolverStub) GetGovernanceProposal(nonce uint64) (*common.GovernanceProposalAPIResponse, error) {
+	if ars.GetGovernanceProposalCalled != nil {
+		return ars.GetGovernanceProposalCalled(nonce)
+	}
+
+	return nil, nil
+}
+
+// GetGovernanceVotes -
+func (ars *ApiResolverStub) GetGovernanceVotes(address string) (*common.GovernanceVotesAPIResponse, error) {
+	if ars.GetGovernanceVotesCalled != nil {
+		return ars.GetGovernanceVotesCalled(address)
+	}
+
+	return nil, nil
+}
+
 // GetDelegatorsList -
 func (ars *ApiResolverStub) GetDelegatorsList(ctx context.Context) ([]*api.Delegator, error) {
 	if ars.GetDelegatorsListHandler != nil {
@@ -159,6 +195,24 @@ func (ars *ApiResolverStub) GetDelegatorsList(ctx context.Context) ([]*api.Deleg
 	return nil, nil
 }
 
+// GetDelegationContract -
+func (ars *ApiResolverStub) GetDelegationContract(ctx context.Context, address string, page uint32, pageSize uint32) (*common.DelegationContractAPIResponse, error) {
+	if ars.GetDelegationContractCalled != nil {
+		return ars.GetDelegationContractCalled(ctx, address, page, pageSize)
+	}
+
+	return nil, nil
+}
+
+// GetDelegationPositions -
+func (ars *ApiResolverStub) GetDelegationPositions(ctx context.Context, address string) (*common.DelegationPositionsAPIResponse, error) {
+	if ars.GetDelegationPositionsCalled != nil {
+		return ars.GetDelegationPositionsCalled(ctx, address)
+	}
+
+	return nil, nil
+}
+
 // GetInternalShardBlockByNonce -
 func (ars *ApiResolverStub) GetInternalShardBlockByNonce(format common.ApiOutputFormat, nonce uint64) (interface{}, error) {
 	if ars.GetInternalShardBlockByNonceCalled != nil {
@@ -285,6 +339,42 @@ func (ars *ApiResolverStub) GetGasConfigs() map[string]map[string]uint64 {
 	return nil
 }
 
+// GetGasPriceSuggestion -
+func (ars *ApiResolverStub) GetGasPriceSuggestion() (*common.GasPriceSuggestionAPIResponse, error) {
+	if ars.GetGasPriceSuggestionCalled != nil {
+		return ars.GetGasPriceSuggestionCalled()
+	}
+
+	return nil, nil
+}
+
+// GetTrieStatistics -
+func (ars *ApiResolverStub) GetTrieStatistics(rootHash string) (*common.TrieStatisticsAPIResponse, error) {
+	if ars.GetTrieStatisticsCalled != nil {
+		return ars.GetTrieStatisticsCalled(rootHash)
+	}
+
+	return nil, nil
+}
+
+// StartOutportReindexing -
+func (ars *ApiResolverStub) StartOutportReindexing(startNonce uint64, endNonce uint64) error {
+	if ars.StartOutportReindexingCalled != nil {
+		return ars.StartOutportReindexingCalled(startNonce, endNonce)
+	}
+
+	return nil
+}
+
+// GetOutportReindexingStatus -
+func (ars *ApiResolverStub) GetOutportReindexingStatus() common.OutportReindexStatus {
+	if ars.GetOutportReindexingStatusCalled != nil {
+		return ars.GetOutportReindexingStatusCalled()
+	}
+
+	return common.OutportReindexStatus{}
+}
+
 // GetInternalStartOfEpochValidatorsInfo -
 func (ars *ApiResolverStub) GetInternalStartOfEpochValidatorsInfo(epoch uint32) ([]*state.ShardValidatorInfo, error) {
 	if ars.GetInternalStartOfEpochValidatorsInfoCalled != nil {
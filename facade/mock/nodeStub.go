@@ -55,7 +55,13 @@ type NodeStub struct {
 	VerifyProofCalled                              func(rootHash string, address string, proof [][]byte) (bool, error)
 	GetTokenSupplyCalled                           func(token string) (*api.ESDTSupply, error)
 	IsDataTrieMigratedCalled                       func(address string, options api.AccountQueryOptions) (bool, error)
+	GetDataTrieStatisticsCalled                    func(address string, options api.AccountQueryOptions) (*common.DataTrieStatisticsAPIResponse, api.BlockInfo, error)
 	AuctionListApiCalled                           func() ([]*common.AuctionListValidatorAPIResponse, error)
+	SimulateAuctionApiCalled                       func(changes []*common.AuctionSimulationOwnerChange) (*common.AuctionSimulationAPIResponse, error)
+	GetNodesShufflingPreviewCalled                 func() (*common.NodesShufflingPreviewAPIResponse, error)
+	GetValidatorRewardsHistoryCalled               func(blsKey string, fromEpoch core.OptionalUint32, toEpoch core.OptionalUint32) ([]*common.ValidatorRewardsBreakdown, error)
+	GetValidatorRatingsHistoryCalled               func(blsKey string, fromEpoch core.OptionalUint32, toEpoch core.OptionalUint32) ([]*common.ValidatorRatingHistory, error)
+	GetConsensusRoundsTimelineCalled               func(numRounds uint32) ([]*common.ConsensusRoundTimeline, error)
 }
 
 // GetProof -
@@ -235,6 +241,51 @@ func (ns *NodeStub) AuctionListApi() ([]*common.AuctionListValidatorAPIResponse,
 	return nil, nil
 }
 
+// SimulateAuctionApi -
+func (ns *NodeStub) SimulateAuctionApi(changes []*common.AuctionSimulationOwnerChange) (*common.AuctionSimulationAPIResponse, error) {
+	if ns.SimulateAuctionApiCalled != nil {
+		return ns.SimulateAuctionApiCalled(changes)
+	}
+
+	return nil, nil
+}
+
+// GetNodesShufflingPreview -
+func (ns *NodeStub) GetNodesShufflingPreview() (*common.NodesShufflingPreviewAPIResponse, error) {
+	if ns.GetNodesShufflingPreviewCalled != nil {
+		return ns.GetNodesShufflingPreviewCalled()
+	}
+
+	return nil, nil
+}
+
+// GetValidatorRewardsHistory -
+func (ns *NodeStub) GetValidatorRewardsHistory(blsKey string, fromEpoch core.OptionalUint32, toEpoch core.OptionalUint32) ([]*common.ValidatorRewardsBreakdown, error) {
+	if ns.GetValidatorRewardsHistoryCalled != nil {
+		return ns.GetValidatorRewardsHistoryCalled(blsKey, fromEpoch, toEpoch)
+	}
+
+	return nil, nil
+}
+
+// GetValidatorRatingsHistory -
+func (ns *NodeStub) GetValidatorRatingsHistory(blsKey string, fromEpoch core.OptionalUint32, toEpoch core.OptionalUint32) ([]*common.ValidatorRatingHistory, error) {
+	if ns.GetValidatorRatingsHistoryCalled != nil {
+		return ns.GetValidatorRatingsHistoryCalled(blsKey, fromEpoch, toEpoch)
+	}
+
+	return nil, nil
+}
+
+// GetConsensusRoundsTimeline -
+func (ns *NodeStub) GetConsensusRoundsTimeline(numRounds uint32) ([]*common.ConsensusRoundTimeline, error) {
+	if ns.GetConsensusRoundsTimelineCalled != nil {
+		return ns.GetConsensusRoundsTimelineCalled(numRounds)
+	}
+
+	return nil, nil
+}
+
 // DirectTrigger -
 func (ns *NodeStub) DirectTrigger(epoch uint32, withEarlyEndOfEpoch bool) error {
 	if ns.DirectTriggerCalled != nil {
@@ -349,6 +400,14 @@ func (ns *NodeStub) IsDataTrieMigrated(address string, options api.AccountQueryO
 	return false, nil
 }
 
+// GetDataTrieStatistics -
+func (ns *NodeStub) GetDataTrieStatistics(address string, options api.AccountQueryOptions) (*common.DataTrieStatisticsAPIResponse, api.BlockInfo, error) {
+	if ns.GetDataTrieStatisticsCalled != nil {
+		return ns.GetDataTrieStatisticsCalled(address, options)
+	}
+	return nil, api.BlockInfo{}, nil
+}
+
 // GetNFTTokenIDsRegisteredByAddress -
 func (ns *NodeStub) GetNFTTokenIDsRegisteredByAddress(address string, options api.AccountQueryOptions, ctx context.Context) ([]string, api.BlockInfo, error) {
 	if ns.GetNFTTokenIDsRegisteredByAddressCalled != nil {
@@ -290,6 +290,26 @@ func (nf *nodeFacade) AuctionListApi() ([]*common.AuctionListValidatorAPIRespons
 	return nf.node.AuctionListApi()
 }
 
+// SimulateAuctionApi will return the outcome of the auction selection after applying the provided owners changes
+func (nf *nodeFacade) SimulateAuctionApi(changes []*common.AuctionSimulationOwnerChange) (*common.AuctionSimulationAPIResponse, error) {
+	return nf.node.SimulateAuctionApi(changes)
+}
+
+// GetNodesShufflingPreview will return the nodes lists of each shard projected for the next epoch
+func (nf *nodeFacade) GetNodesShufflingPreview() (*common.NodesShufflingPreviewAPIResponse, error) {
+	return nf.node.GetNodesShufflingPreview()
+}
+
+// GetValidatorRatingsHistory will return the ratings history of the provided validator for each epoch in the range
+func (nf *nodeFacade) GetValidatorRatingsHistory(blsKey string, fromEpoch core.OptionalUint32, toEpoch core.OptionalUint32) ([]*common.ValidatorRatingHistory, error) {
+	return nf.node.GetValidatorRatingsHistory(blsKey, fromEpoch, toEpoch)
+}
+
+// GetValidatorRewardsHistory will return the rewards breakdown of the provided validator for each epoch in the range
+func (nf *nodeFacade) GetValidatorRewardsHistory(blsKey string, fromEpoch core.OptionalUint32, toEpoch core.OptionalUint32) ([]*common.ValidatorRewardsBreakdown, error) {
+	return nf.node.GetValidatorRewardsHistory(blsKey, fromEpoch, toEpoch)
+}
+
 // SendBulkTransactions will send a bulk of transactions on the topic channel
 func (nf *nodeFacade) SendBulkTransactions(txs []*transaction.Transaction) (uint64, error) {
 	return nf.node.SendBulkTransactions(txs)
@@ -435,6 +455,40 @@ func (nf *nodeFacade) GetDelegatorsList() ([]*apiData.Delegator, error) {
 	return nf.apiResolver.GetDelegatorsList(ctx)
 }
 
+// GetDelegationContract will output the state of a delegation contract along with a page of its delegators
+func (nf *nodeFacade) GetDelegationContract(address string, page uint32, pageSize uint32) (*common.DelegationContractAPIResponse, error) {
+	ctx, cancel := nf.getContextForApiTrieRangeOperations()
+	defer cancel()
+
+	return nf.apiResolver.GetDelegationContract(ctx, address, page, pageSize)
+}
+
+// GetDelegationPositions will output the delegation positions of the provided address across all delegation contracts
+func (nf *nodeFacade) GetDelegationPositions(address string) (*common.DelegationPositionsAPIResponse, error) {
+	ctx, cancel := nf.getContextForApiTrieRangeOperations()
+	defer cancel()
+
+	return nf.apiResolver.GetDelegationPositions(ctx, address)
+}
+
+// GetGovernanceProposals will output all the governance proposals with their vote tallies
+func (nf *nodeFacade) GetGovernanceProposals() (*common.GovernanceProposalsAPIResponse, error) {
+	ctx, cancel := nf.getContextForApiTrieRangeOperations()
+	defer cancel()
+
+	return nf.apiResolver.GetGovernanceProposals(ctx)
+}
+
+// GetGovernanceProposal will output the governance proposal with the provided nonce
+func (nf *nodeFacade) GetGovernanceProposal(nonce uint64) (*common.GovernanceProposalAPIResponse, error) {
+	return nf.apiResolver.GetGovernanceProposal(nonce)
+}
+
+// GetGovernanceVotes will output the governance votes and the voting power of the provided address
+func (nf *nodeFacade) GetGovernanceVotes(address string) (*common.GovernanceVotesAPIResponse, error) {
+	return nf.apiResolver.GetGovernanceVotes(address)
+}
+
 // ExecuteSCQuery retrieves data from existing SC trie
 func (nf *nodeFacade) ExecuteSCQuery(query *process.SCQuery) (*vm.VMOutputApi, apiData.BlockInfo, error) {
 	vmOutput, blockInfo, err := nf.apiResolver.ExecuteSCQuery(query)
@@ -609,6 +663,11 @@ func (nf *nodeFacade) IsDataTrieMigrated(address string, options apiData.Account
 	return nf.node.IsDataTrieMigrated(address, options)
 }
 
+// GetDataTrieStatistics returns the size statistics of the data trie for the given address
+func (nf *nodeFacade) GetDataTrieStatistics(address string, options apiData.AccountQueryOptions) (*common.DataTrieStatisticsAPIResponse, apiData.BlockInfo, error) {
+	return nf.node.GetDataTrieStatistics(address, options)
+}
+
 // GetManagedKeysCount returns the number of managed keys when node is running in multikey mode
 func (nf *nodeFacade) GetManagedKeysCount() int {
 	return nf.apiResolver.GetManagedKeysCount()
@@ -768,6 +827,32 @@ func (nf *nodeFacade) GetGasConfigs() (map[string]map[string]uint64, error) {
 	return gasConfigs, nil
 }
 
+// GetGasPriceSuggestion returns the gas price suggestions based on the pool pressure and the recent blocks
+func (nf *nodeFacade) GetGasPriceSuggestion() (*common.GasPriceSuggestionAPIResponse, error) {
+	return nf.apiResolver.GetGasPriceSuggestion()
+}
+
+// GetTrieStatistics returns the statistics of the accounts trie, together with all the data tries, for the given root
+// hash or for the current one if the root hash is empty
+func (nf *nodeFacade) GetTrieStatistics(rootHash string) (*common.TrieStatisticsAPIResponse, error) {
+	return nf.apiResolver.GetTrieStatistics(rootHash)
+}
+
+// StartOutportReindexing starts pushing the blocks in the provided nonce range, rebuilt from storage, to the outport drivers
+func (nf *nodeFacade) StartOutportReindexing(startNonce uint64, endNonce uint64) error {
+	return nf.apiResolver.StartOutportReindexing(startNonce, endNonce)
+}
+
+// GetConsensusRoundsTimeline returns the consensus timelines of the last rounds recorded by the node
+func (nf *nodeFacade) GetConsensusRoundsTimeline(numRounds uint32) ([]*common.ConsensusRoundTimeline, error) {
+	return nf.node.GetConsensusRoundsTimeline(numRounds)
+}
+
+// GetOutportReindexingStatus returns the progress of the last outport re-indexing job
+func (nf *nodeFacade) GetOutportReindexingStatus() (common.OutportReindexStatus, error) {
+	return nf.apiResolver.GetOutportReindexingStatus(), nil
+}
+
 // P2PPrometheusMetricsEnabled returns if p2p prometheus metrics should be enabled or not on the application
 func (nf *nodeFacade) P2PPrometheusMetricsEnabled() bool {
 	return nf.config.P2PPrometheusMetricsEnabled
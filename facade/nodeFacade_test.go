@@ -1905,6 +1905,220 @@ func TestGetGasConfigs(t *testing.T) {
 	})
 }
 
+func TestNodeFacade_GetDelegationContract(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockArguments()
+
+	providedContract := &common.DelegationContractAPIResponse{
+		Address:          "erd1contract",
+		TotalActiveStake: "1000",
+	}
+	arg.ApiResolver = &mock.ApiResolverStub{
+		GetDelegationContractCalled: func(ctx context.Context, address string, page uint32, pageSize uint32) (*common.DelegationContractAPIResponse, error) {
+			require.NotNil(t, ctx)
+			require.Equal(t, "erd1contract", address)
+			require.Equal(t, uint32(2), page)
+			require.Equal(t, uint32(10), pageSize)
+			return providedContract, nil
+		},
+	}
+
+	nf, _ := NewNodeFacade(arg)
+	contract, err := nf.GetDelegationContract("erd1contract", 2, 10)
+	require.NoError(t, err)
+	require.Equal(t, providedContract, contract)
+}
+
+func TestNodeFacade_GetDelegationPositions(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockArguments()
+
+	providedPositions := &common.DelegationPositionsAPIResponse{
+		Address:          "erd1alice",
+		TotalActiveStake: "1000",
+	}
+	arg.ApiResolver = &mock.ApiResolverStub{
+		GetDelegationPositionsCalled: func(ctx context.Context, address string) (*common.DelegationPositionsAPIResponse, error) {
+			require.NotNil(t, ctx)
+			require.Equal(t, "erd1alice", address)
+			return providedPositions, nil
+		},
+	}
+
+	nf, _ := NewNodeFacade(arg)
+	positions, err := nf.GetDelegationPositions("erd1alice")
+	require.NoError(t, err)
+	require.Equal(t, providedPositions, positions)
+}
+
+func TestNodeFacade_GetGovernanceProposals(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockArguments()
+
+	providedProposals := &common.GovernanceProposalsAPIResponse{
+		CurrentEpoch: 3,
+		TotalStake:   "1000",
+	}
+	arg.ApiResolver = &mock.ApiResolverStub{
+		GetGovernanceProposalsCalled: func(ctx context.Context) (*common.GovernanceProposalsAPIResponse, error) {
+			require.NotNil(t, ctx)
+			return providedProposals, nil
+		},
+	}
+
+	nf, _ := NewNodeFacade(arg)
+	proposals, err := nf.GetGovernanceProposals()
+	require.NoError(t, err)
+	require.Equal(t, providedProposals, proposals)
+}
+
+func TestNodeFacade_GetGovernanceProposal(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockArguments()
+
+	providedProposal := &common.GovernanceProposalAPIResponse{
+		Nonce:  2,
+		Status: "active",
+	}
+	arg.ApiResolver = &mock.ApiResolverStub{
+		GetGovernanceProposalCalled: func(nonce uint64) (*common.GovernanceProposalAPIResponse, error) {
+			require.Equal(t, uint64(2), nonce)
+			return providedProposal, nil
+		},
+	}
+
+	nf, _ := NewNodeFacade(arg)
+	proposal, err := nf.GetGovernanceProposal(2)
+	require.NoError(t, err)
+	require.Equal(t, providedProposal, proposal)
+}
+
+func TestNodeFacade_GetGovernanceVotes(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockArguments()
+
+	providedVotes := &common.GovernanceVotesAPIResponse{
+		Address:     "address",
+		VotingPower: "10",
+	}
+	arg.ApiResolver = &mock.ApiResolverStub{
+		GetGovernanceVotesCalled: func(address string) (*common.GovernanceVotesAPIResponse, error) {
+			require.Equal(t, "address", address)
+			return providedVotes, nil
+		},
+	}
+
+	nf, _ := NewNodeFacade(arg)
+	votes, err := nf.GetGovernanceVotes("address")
+	require.NoError(t, err)
+	require.Equal(t, providedVotes, votes)
+}
+
+func TestNodeFacade_GetTrieStatistics(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockArguments()
+
+	providedStatistics := &common.TrieStatisticsAPIResponse{
+		RootHash:    "aabb",
+		NumAccounts: 37,
+	}
+	arg.ApiResolver = &mock.ApiResolverStub{
+		GetTrieStatisticsCalled: func(rootHash string) (*common.TrieStatisticsAPIResponse, error) {
+			require.Equal(t, "aabb", rootHash)
+			return providedStatistics, nil
+		},
+	}
+
+	nf, _ := NewNodeFacade(arg)
+	statistics, err := nf.GetTrieStatistics("aabb")
+	require.NoError(t, err)
+	require.Equal(t, providedStatistics, statistics)
+}
+
+func TestNodeFacade_OutportReindexing(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockArguments()
+
+	providedStatus := common.OutportReindexStatus{
+		Status:           "in progress",
+		StartNonce:       1,
+		EndNonce:         10,
+		LastIndexedNonce: 4,
+		NumIndexedBlocks: 4,
+	}
+	startCalled := false
+	arg.ApiResolver = &mock.ApiResolverStub{
+		StartOutportReindexingCalled: func(startNonce uint64, endNonce uint64) error {
+			require.Equal(t, uint64(1), startNonce)
+			require.Equal(t, uint64(10), endNonce)
+			startCalled = true
+			return nil
+		},
+		GetOutportReindexingStatusCalled: func() common.OutportReindexStatus {
+			return providedStatus
+		},
+	}
+
+	nf, _ := NewNodeFacade(arg)
+	err := nf.StartOutportReindexing(1, 10)
+	require.NoError(t, err)
+	require.True(t, startCalled)
+
+	status, err := nf.GetOutportReindexingStatus()
+	require.NoError(t, err)
+	require.Equal(t, providedStatus, status)
+}
+
+func TestNodeFacade_GetDataTrieStatistics(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockArguments()
+
+	providedStatistics := &common.DataTrieStatisticsAPIResponse{
+		Address:   "address",
+		TotalSize: 1024,
+	}
+	arg.Node = &mock.NodeStub{
+		GetDataTrieStatisticsCalled: func(address string, options api.AccountQueryOptions) (*common.DataTrieStatisticsAPIResponse, api.BlockInfo, error) {
+			require.Equal(t, "address", address)
+			return providedStatistics, api.BlockInfo{}, nil
+		},
+	}
+
+	nf, _ := NewNodeFacade(arg)
+	statistics, _, err := nf.GetDataTrieStatistics("address", api.AccountQueryOptions{})
+	require.NoError(t, err)
+	require.Equal(t, providedStatistics, statistics)
+}
+
+func TestNodeFacade_GetGasPriceSuggestion(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockArguments()
+
+	providedSuggestion := &common.GasPriceSuggestionAPIResponse{
+		MinGasPrice: 1000000000,
+		LastNonce:   37,
+	}
+	arg.ApiResolver = &mock.ApiResolverStub{
+		GetGasPriceSuggestionCalled: func() (*common.GasPriceSuggestionAPIResponse, error) {
+			return providedSuggestion, nil
+		},
+	}
+
+	nf, _ := NewNodeFacade(arg)
+	suggestion, err := nf.GetGasPriceSuggestion()
+	require.NoError(t, err)
+	require.Equal(t, providedSuggestion, suggestion)
+}
+
 func TestNodeFacade_GetTransactionsPoolForSender(t *testing.T) {
 	t.Parallel()
 
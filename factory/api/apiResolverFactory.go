@@ -27,12 +27,17 @@ import (
 	factoryVm "github.com/multiversx/mx-chain-go/factory/vm"
 	"github.com/multiversx/mx-chain-go/node/external"
 	"github.com/multiversx/mx-chain-go/node/external/blockAPI"
+	"github.com/multiversx/mx-chain-go/node/external/gasPriceAPI"
 	"github.com/multiversx/mx-chain-go/node/external/logs"
 	"github.com/multiversx/mx-chain-go/node/external/timemachine/fee"
 	"github.com/multiversx/mx-chain-go/node/external/transactionAPI"
+	"github.com/multiversx/mx-chain-go/node/external/trieStatisticsAPI"
 	"github.com/multiversx/mx-chain-go/node/trieIterators"
 	trieIteratorsFactory "github.com/multiversx/mx-chain-go/node/trieIterators/factory"
 	"github.com/multiversx/mx-chain-go/outport/process/alteredaccounts"
+	"github.com/multiversx/mx-chain-go/outport/process/transactionsfee"
+	"github.com/multiversx/mx-chain-go/outport/reindexer"
+	disabledReindexer "github.com/multiversx/mx-chain-go/outport/reindexer/disabled"
 	"github.com/multiversx/mx-chain-go/process"
 	"github.com/multiversx/mx-chain-go/process/coordinator"
 	"github.com/multiversx/mx-chain-go/process/smartContract"
@@ -74,6 +79,7 @@ type ApiResolverArgs struct {
 	DelegatedListFactoryHandler    trieIteratorsFactory.DelegatedListProcessorFactoryHandler
 	DirectStakedListFactoryHandler trieIteratorsFactory.DirectStakedListProcessorFactoryHandler
 	TotalStakedValueFactoryHandler trieIteratorsFactory.TotalStakedValueProcessorFactoryHandler
+	GovernanceFactoryHandler       trieIteratorsFactory.GovernanceProcessorFactoryHandler
 }
 
 type scQueryServiceArgs struct {
@@ -131,6 +137,9 @@ func CreateApiResolver(args *ApiResolverArgs) (facade.ApiResolver, error) {
 	if check.IfNilReflect(args.TotalStakedValueFactoryHandler) {
 		return nil, factory.ErrNilTotalStakedValueFactory
 	}
+	if check.IfNilReflect(args.GovernanceFactoryHandler) {
+		return nil, factory.ErrNilGovernanceFactory
+	}
 
 	argsSCQuery := &scQueryServiceArgs{
 		generalConfig:              args.Configs.GeneralConfig,
@@ -230,6 +239,16 @@ func CreateApiResolver(args *ApiResolverArgs) (facade.ApiResolver, error) {
 		return nil, err
 	}
 
+	argsGovernanceProcessor := trieIterators.ArgGovernanceProcessor{
+		ArgTrieIteratorProcessor: argsProcessors,
+		Marshaller:               args.CoreComponents.InternalMarshalizer(),
+		EpochNotifier:            args.CoreComponents.EpochNotifier(),
+	}
+	governanceHandler, err := args.GovernanceFactoryHandler.CreateGovernanceProcessorHandler(argsGovernanceProcessor)
+	if err != nil {
+		return nil, err
+	}
+
 	feeComputer, err := fee.NewFeeComputer(args.CoreComponents.EconomicsData())
 	if err != nil {
 		return nil, err
@@ -279,6 +298,37 @@ func CreateApiResolver(args *ApiResolverArgs) (facade.ApiResolver, error) {
 		return nil, err
 	}
 
+	argsGasPriceSuggester := gasPriceAPI.ArgsGasPriceSuggester{
+		TxPool:            args.DataComponents.Datapool().Transactions(),
+		TxPoolCapacity:    args.Configs.GeneralConfig.TxDataPool.Capacity,
+		ChainHandler:      args.DataComponents.Blockchain(),
+		APIBlockHandler:   apiBlockProcessor,
+		EconomicsHandler:  args.CoreComponents.EconomicsData(),
+		ShardCoordinator:  args.ProcessComponents.ShardCoordinator(),
+		RoundDurationInMs: args.CoreComponents.GenesisNodesSetup().GetRoundDuration(),
+		NumRecentBlocks:   args.Configs.GeneralConfig.GasPriceSuggestion.NumRecentBlocks,
+	}
+	gasPriceSuggester, err := gasPriceAPI.NewGasPriceSuggester(argsGasPriceSuggester)
+	if err != nil {
+		return nil, err
+	}
+
+	argsTrieStatisticsProvider := trieStatisticsAPI.ArgsTrieStatisticsProvider{
+		Accounts:            args.StateComponents.AccountsAdapterAPI(),
+		ChainHandler:        args.DataComponents.Blockchain(),
+		NumCachedRootHashes: args.Configs.GeneralConfig.TrieStatistics.NumCachedRootHashes,
+		NumLargestDataTries: args.Configs.GeneralConfig.TrieStatistics.NumLargestDataTries,
+	}
+	trieStatisticsProvider, err := trieStatisticsAPI.NewTrieStatisticsProvider(argsTrieStatisticsProvider)
+	if err != nil {
+		return nil, err
+	}
+
+	outportReindexer, err := createOutportReindexer(args)
+	if err != nil {
+		return nil, err
+	}
+
 	argsApiResolver := external.ArgNodeApiResolver{
 		SCQueryService:           scQueryService,
 		StatusMetricsHandler:     args.StatusCoreComponents.StatusMetrics(),
@@ -286,6 +336,7 @@ func CreateApiResolver(args *ApiResolverArgs) (facade.ApiResolver, error) {
 		TotalStakedValueHandler:  totalStakedValueHandler,
 		DirectStakedListHandler:  directStakedListHandler,
 		DelegatedListHandler:     delegatedListHandler,
+		GovernanceHandler:        governanceHandler,
 		APITransactionHandler:    apiTransactionProcessor,
 		APIBlockHandler:          apiBlockProcessor,
 		APIInternalBlockHandler:  apiInternalBlockProcessor,
@@ -293,10 +344,13 @@ func CreateApiResolver(args *ApiResolverArgs) (facade.ApiResolver, error) {
 		ValidatorPubKeyConverter: args.CoreComponents.ValidatorPubKeyConverter(),
 		AccountsParser:           args.ProcessComponents.AccountsParser(),
 		GasScheduleNotifier:      args.GasScheduleNotifier,
+		GasPriceSuggester:        gasPriceSuggester,
+		TrieStatisticsProvider:   trieStatisticsProvider,
 		ManagedPeersMonitor:      args.StatusComponents.ManagedPeersMonitor(),
 		PublicKey:                args.CryptoComponents.PublicKeyString(),
 		NodesCoordinator:         args.ProcessComponents.NodesCoordinator(),
 		StorageManagers:          storageManagers,
+		OutportReindexer:         outportReindexer,
 	}
 
 	return external.NewNodeApiResolver(argsApiResolver)
@@ -748,6 +802,61 @@ func createAPIBlockProcessorArgs(args *ApiResolverArgs, apiTransactionHandler ex
 	return blockApiArgs, nil
 }
 
+func createOutportReindexer(args *ApiResolverArgs) (external.OutportReindexer, error) {
+	if args.Configs.ExternalConfig == nil || !args.Configs.ExternalConfig.OutportReindexConfig.Enabled {
+		return disabledReindexer.NewDisabledOutportReindexer(), nil
+	}
+	reindexConfig := args.Configs.ExternalConfig.OutportReindexConfig
+
+	alteredAccountsProvider, err := alteredaccounts.NewAlteredAccountsProvider(alteredaccounts.ArgsAlteredAccountsProvider{
+		ShardCoordinator:       args.ProcessComponents.ShardCoordinator(),
+		AddressConverter:       args.CoreComponents.AddressPubKeyConverter(),
+		AccountsDB:             args.StateComponents.AccountsAdapterAPI(),
+		EsdtDataStorageHandler: args.ProcessComponents.ESDTDataStorageHandlerForAPI(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	txsStorer, err := args.DataComponents.StorageService().GetStorer(dataRetriever.TransactionUnit)
+	if err != nil {
+		return nil, err
+	}
+
+	transactionsFeeProcessor, err := transactionsfee.NewTransactionsFeeProcessor(transactionsfee.ArgTransactionsFeeProcessor{
+		Marshaller:         args.CoreComponents.InternalMarshalizer(),
+		TransactionsStorer: txsStorer,
+		ShardCoordinator:   args.ProcessComponents.ShardCoordinator(),
+		TxFeeCalculator:    args.CoreComponents.EconomicsData(),
+		PubKeyConverter:    args.CoreComponents.AddressPubKeyConverter(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	resumeFilePath := reindexConfig.ResumeFilePath
+	if len(resumeFilePath) > 0 && !filepath.IsAbs(resumeFilePath) {
+		resumeFilePath = filepath.Join(args.Configs.FlagsConfig.WorkingDir, resumeFilePath)
+	}
+
+	return reindexer.NewOutportReindexer(reindexer.ArgsOutportReindexer{
+		MaxBlocksPerSecond:       reindexConfig.MaxBlocksPerSecond,
+		ResumeFilePath:           resumeFilePath,
+		ShardCoordinator:         args.ProcessComponents.ShardCoordinator(),
+		NodesCoordinator:         args.ProcessComponents.NodesCoordinator(),
+		StorageService:           args.DataComponents.StorageService(),
+		ReceiptsRepository:       args.ProcessComponents.ReceiptsRepository(),
+		AccountsRepository:       args.StateComponents.AccountsRepository(),
+		AlteredAccountsProvider:  alteredAccountsProvider,
+		TransactionsFeeProcessor: transactionsFeeProcessor,
+		EconomicsData:            args.CoreComponents.EconomicsData(),
+		OutportHandler:           args.StatusComponents.OutportHandler(),
+		Marshaller:               args.CoreComponents.InternalMarshalizer(),
+		Hasher:                   args.CoreComponents.Hasher(),
+		Uint64Converter:          args.CoreComponents.Uint64ByteSliceConverter(),
+	})
+}
+
 func createLogsFacade(args *ApiResolverArgs) (factory.LogsFacade, error) {
 	return logs.NewLogsFacade(logs.ArgsNewLogsFacade{
 		StorageService:  args.DataComponents.StorageService(),
@@ -34,6 +34,7 @@ import (
 	"github.com/multiversx/mx-chain-go/testscommon/guardianMocks"
 	"github.com/multiversx/mx-chain-go/testscommon/mainFactoryMocks"
 	"github.com/multiversx/mx-chain-go/testscommon/marshallerMock"
+	outportStub "github.com/multiversx/mx-chain-go/testscommon/outport"
 	"github.com/multiversx/mx-chain-go/testscommon/shardingMocks"
 	stateMocks "github.com/multiversx/mx-chain-go/testscommon/state"
 	"github.com/multiversx/mx-chain-go/testscommon/statusHandler"
@@ -124,6 +125,7 @@ func createMockArgs(t *testing.T) *api.ApiResolverArgs {
 		DelegatedListFactoryHandler:    trieIteratorsFactory.NewDelegatedListProcessorFactory(),
 		DirectStakedListFactoryHandler: trieIteratorsFactory.NewDirectStakedListProcessorFactory(),
 		TotalStakedValueFactoryHandler: trieIteratorsFactory.NewTotalStakedListProcessorFactory(),
+		GovernanceFactoryHandler:       trieIteratorsFactory.NewGovernanceProcessorFactory(),
 	}
 }
 
@@ -341,6 +343,33 @@ func TestCreateApiResolver(t *testing.T) {
 		require.Equal(t, factoryErrors.ErrNilTotalStakedValueFactory, err)
 		require.True(t, check.IfNil(apiResolver))
 	})
+	t.Run("GovernanceFactoryHandler nil should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgs(t)
+		args.GovernanceFactoryHandler = nil
+		apiResolver, err := api.CreateApiResolver(args)
+		require.Equal(t, factoryErrors.ErrNilGovernanceFactory, err)
+		require.True(t, check.IfNil(apiResolver))
+	})
+	t.Run("outport re-indexing enabled should work", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgs(t)
+		args.Configs.ExternalConfig = &config.ExternalConfig{
+			OutportReindexConfig: config.OutportReindexConfig{
+				Enabled:            true,
+				MaxBlocksPerSecond: 10,
+			},
+		}
+		args.StatusComponents = &mainFactoryMocks.StatusComponentsStub{
+			ManagedPeersMonitorField: &testscommon.ManagedPeersMonitorStub{},
+			Outport:                  &outportStub.OutportStub{},
+		}
+		apiResolver, err := api.CreateApiResolver(args)
+		require.Nil(t, err)
+		require.False(t, check.IfNil(apiResolver))
+	})
 }
 
 func createMockSCQueryElementArgs(shardId uint32) api.SCQueryElementArgs {
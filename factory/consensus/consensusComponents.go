@@ -15,9 +15,12 @@ import (
 	"github.com/multiversx/mx-chain-go/consensus"
 	"github.com/multiversx/mx-chain-go/consensus/blacklist"
 	"github.com/multiversx/mx-chain-go/consensus/chronology"
+	"github.com/multiversx/mx-chain-go/consensus/recorder"
+	recorderDisabled "github.com/multiversx/mx-chain-go/consensus/recorder/disabled"
 	"github.com/multiversx/mx-chain-go/consensus/spos"
 	"github.com/multiversx/mx-chain-go/consensus/spos/bls"
 	"github.com/multiversx/mx-chain-go/consensus/spos/sposFactory"
+	"github.com/multiversx/mx-chain-go/consensus/timeline"
 	"github.com/multiversx/mx-chain-go/dataRetriever"
 	"github.com/multiversx/mx-chain-go/errors"
 	"github.com/multiversx/mx-chain-go/factory"
@@ -81,13 +84,15 @@ type consensusComponentsFactory struct {
 }
 
 type consensusComponents struct {
-	chronology           consensus.ChronologyHandler
-	bootstrapper         process.Bootstrapper
-	broadcastMessenger   consensus.BroadcastMessenger
-	worker               factory.ConsensusWorker
-	peerBlacklistHandler consensus.PeerBlacklistHandler
-	consensusTopic       string
-	consensusGroupSize   int
+	chronology            consensus.ChronologyHandler
+	bootstrapper          process.Bootstrapper
+	broadcastMessenger    consensus.BroadcastMessenger
+	worker                factory.ConsensusWorker
+	peerBlacklistHandler  consensus.PeerBlacklistHandler
+	roundTimelineRecorder consensus.RoundTimelineRecorder
+	messagesRecorder      consensus.ReceivedMessagesRecorder
+	consensusTopic        string
+	consensusGroupSize    int
 }
 
 // NewConsensusComponentsFactory creates an instance of consensusComponentsFactory
@@ -124,7 +129,8 @@ func (ccf *consensusComponentsFactory) Create() (*consensusComponents, error) {
 
 	cc := &consensusComponents{}
 
-	consensusGroupSize, err := getConsensusGroupSize(ccf.coreComponents.GenesisNodesSetup(), ccf.processComponents.ShardCoordinator())
+	shardCoordinator := ccf.processComponents.ShardCoordinator()
+	consensusGroupSize, err := getConsensusGroupSize(ccf.coreComponents.GenesisNodesSetup(), shardCoordinator)
 	if err != nil {
 		return nil, err
 	}
@@ -138,7 +144,17 @@ func (ccf *consensusComponentsFactory) Create() (*consensusComponents, error) {
 		return nil, errors.ErrGenesisBlockNotInitialized
 	}
 
-	cc.chronology, err = ccf.createChronology()
+	cc.roundTimelineRecorder, err = timeline.NewRoundTimelineRecorder(timeline.ArgRoundTimelineRecorder{
+		Capacity:       ccf.config.Consensus.NumRoundTimelinesToKeep,
+		ShardID:        shardCoordinator.SelfId(),
+		SyncTimer:      ccf.coreComponents.SyncTimer(),
+		OutportHandler: ccf.statusComponents.OutportHandler(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	cc.chronology, err = ccf.createChronology(cc.roundTimelineRecorder)
 	if err != nil {
 		return nil, err
 	}
@@ -174,6 +190,8 @@ func (ccf *consensusComponentsFactory) Create() (*consensusComponents, error) {
 		ccf.processComponents.InterceptorsContainer(),
 		ccf.coreComponents.AlarmScheduler(),
 		ccf.cryptoComponents.KeysHandler(),
+		ccf.processComponents.PeerShardMapper(),
+		ccf.config.Consensus.SignaturesForwarding,
 	)
 	if err != nil {
 		return nil, err
@@ -190,6 +208,11 @@ func (ccf *consensusComponentsFactory) Create() (*consensusComponents, error) {
 		return nil, err
 	}
 
+	cc.messagesRecorder, err = ccf.createReceivedMessagesRecorder(shardCoordinator.SelfId())
+	if err != nil {
+		return nil, err
+	}
+
 	workerArgs := &spos.WorkerArgs{
 		ConsensusService:         consensusService,
 		BlockChain:               ccf.dataComponents.Blockchain(),
@@ -217,6 +240,8 @@ func (ccf *consensusComponentsFactory) Create() (*consensusComponents, error) {
 		NodeRedundancyHandler:    ccf.processComponents.NodeRedundancyHandler(),
 		PeerBlacklistHandler:     cc.peerBlacklistHandler,
 		EnableEpochHandler:       ccf.coreComponents.EnableEpochsHandler(),
+		RoundTimelineRecorder:    cc.roundTimelineRecorder,
+		ReceivedMessagesRecorder: cc.messagesRecorder,
 	}
 
 	cc.worker, err = spos.NewWorker(workerArgs)
@@ -265,6 +290,7 @@ func (ccf *consensusComponentsFactory) Create() (*consensusComponents, error) {
 		MessageSigningHandler:         p2pSigningHandler,
 		PeerBlacklistHandler:          cc.peerBlacklistHandler,
 		SigningHandler:                ccf.cryptoComponents.ConsensusSigningHandler(),
+		RoundTimelineRecorder:         cc.roundTimelineRecorder,
 	}
 
 	consensusDataContainer, err := spos.NewConsensusCore(
@@ -327,11 +353,36 @@ func (cc *consensusComponents) Close() error {
 	if err != nil {
 		return err
 	}
+	err = cc.messagesRecorder.Close()
+	if err != nil {
+		return err
+	}
+	err = cc.roundTimelineRecorder.Close()
+	if err != nil {
+		return err
+	}
 
 	return nil
 }
 
-func (ccf *consensusComponentsFactory) createChronology() (consensus.ChronologyHandler, error) {
+func (ccf *consensusComponentsFactory) createReceivedMessagesRecorder(shardID uint32) (consensus.ReceivedMessagesRecorder, error) {
+	recorderConfig := ccf.config.Consensus.MessagesRecorder
+	if !recorderConfig.Enabled {
+		return recorderDisabled.NewDisabledMessagesRecorder(), nil
+	}
+
+	log.Warn("node is running with the consensus messages recorder enabled. All the received consensus messages " +
+		"will be written on disk, this should be used only for debugging purposes")
+
+	return recorder.NewMessagesRecorder(recorder.ArgMessagesRecorder{
+		Directory:          recorderConfig.Directory,
+		ShardID:            shardID,
+		NodeIdentifier:     ccf.networkComponents.NetworkMessenger().ID().Pretty(),
+		MaxFileSizeInBytes: recorderConfig.MaxFileSizeInMB * core.MegabyteSize,
+	})
+}
+
+func (ccf *consensusComponentsFactory) createChronology(roundTimelineRecorder consensus.RoundTimelineRecorder) (consensus.ChronologyHandler, error) {
 	wd := ccf.coreComponents.Watchdog()
 	if ccf.statusComponents.OutportHandler().HasDrivers() {
 		log.Warn("node is running with an outport with attached drivers. Chronology watchdog will be turned off as " +
@@ -349,11 +400,12 @@ func (ccf *consensusComponentsFactory) createChronology() (consensus.ChronologyH
 	}
 
 	chronologyArg := chronology.ArgChronology{
-		GenesisTime:      ccf.coreComponents.GenesisTime(),
-		RoundHandler:     ccf.processComponents.RoundHandler(),
-		SyncTimer:        ccf.coreComponents.SyncTimer(),
-		Watchdog:         wd,
-		AppStatusHandler: ccf.statusCoreComponents.AppStatusHandler(),
+		GenesisTime:           ccf.coreComponents.GenesisTime(),
+		RoundHandler:          ccf.processComponents.RoundHandler(),
+		SyncTimer:             ccf.coreComponents.SyncTimer(),
+		Watchdog:              wd,
+		AppStatusHandler:      ccf.statusCoreComponents.AppStatusHandler(),
+		RoundTimelineRecorder: roundTimelineRecorder,
 	}
 	return chronology.NewChronology(chronologyArg)
 }
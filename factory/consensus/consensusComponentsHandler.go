@@ -130,6 +130,9 @@ func (mcc *managedConsensusComponents) CheckSubcomponents() error {
 	if check.IfNil(mcc.broadcastMessenger) {
 		return errors.ErrNilBroadcastMessenger
 	}
+	if check.IfNil(mcc.roundTimelineRecorder) {
+		return errors.ErrNilRoundTimelineRecorder
+	}
 
 	return nil
 }
@@ -146,6 +149,18 @@ func (mcc *managedConsensusComponents) Bootstrapper() process.Bootstrapper {
 	return mcc.consensusComponents.bootstrapper
 }
 
+// RoundTimelineRecorder returns the recorder of the consensus rounds timeline
+func (mcc *managedConsensusComponents) RoundTimelineRecorder() consensus.RoundTimelineRecorder {
+	mcc.mutConsensusComponents.RLock()
+	defer mcc.mutConsensusComponents.RUnlock()
+
+	if mcc.consensusComponents == nil {
+		return nil
+	}
+
+	return mcc.consensusComponents.roundTimelineRecorder
+}
+
 // IsInterfaceNil returns true if the underlying object is nil
 func (mcc *managedConsensusComponents) IsInterfaceNil() bool {
 	return mcc == nil
@@ -3,6 +3,7 @@ package consensus_test
 import (
 	"errors"
 	"fmt"
+	"path/filepath"
 	"strings"
 	"testing"
 	"time"
@@ -12,6 +13,7 @@ import (
 	"github.com/multiversx/mx-chain-crypto-go"
 	"github.com/multiversx/mx-chain-go/common"
 	"github.com/multiversx/mx-chain-go/consensus"
+	"github.com/multiversx/mx-chain-go/consensus/recorder"
 	"github.com/multiversx/mx-chain-go/consensus/spos/bls"
 	retriever "github.com/multiversx/mx-chain-go/dataRetriever"
 	errorsMx "github.com/multiversx/mx-chain-go/errors"
@@ -1031,6 +1033,53 @@ func TestConsensusComponentsFactory_Create(t *testing.T) {
 		require.Equal(t, expectedErr, err)
 		require.Nil(t, cc)
 	})
+	t.Run("createReceivedMessagesRecorder fails due to empty directory should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockConsensusComponentsFactoryArgs()
+		args.Config.Consensus.MessagesRecorder.Enabled = true
+		args.Config.Consensus.MessagesRecorder.Directory = ""
+		ccf, _ := consensusComp.NewConsensusComponentsFactory(args)
+		require.NotNil(t, ccf)
+
+		cc, err := ccf.Create()
+		require.Equal(t, recorder.ErrEmptyDirectory, err)
+		require.Nil(t, cc)
+	})
+	t.Run("createReceivedMessagesRecorder fails due to invalid max file size should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockConsensusComponentsFactoryArgs()
+		args.Config.Consensus.MessagesRecorder.Enabled = true
+		args.Config.Consensus.MessagesRecorder.Directory = t.TempDir()
+		args.Config.Consensus.MessagesRecorder.MaxFileSizeInMB = 0
+		ccf, _ := consensusComp.NewConsensusComponentsFactory(args)
+		require.NotNil(t, ccf)
+
+		cc, err := ccf.Create()
+		require.Equal(t, recorder.ErrInvalidMaxFileSize, err)
+		require.Nil(t, cc)
+	})
+	t.Run("should work with the received messages recorder enabled", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockConsensusComponentsFactoryArgs()
+		args.Config.Consensus.MessagesRecorder.Enabled = true
+		args.Config.Consensus.MessagesRecorder.Directory = t.TempDir()
+		args.Config.Consensus.MessagesRecorder.MaxFileSizeInMB = 1
+		ccf, _ := consensusComp.NewConsensusComponentsFactory(args)
+		require.NotNil(t, ccf)
+
+		cc, err := ccf.Create()
+		require.NoError(t, err)
+		require.NotNil(t, cc)
+
+		require.Nil(t, cc.Close())
+
+		files, err := filepath.Glob(filepath.Join(args.Config.Consensus.MessagesRecorder.Directory, "consensus-messages_*.json"))
+		require.Nil(t, err)
+		require.Equal(t, 1, len(files))
+	})
 	t.Run("should work", func(t *testing.T) {
 		t.Parallel()
 
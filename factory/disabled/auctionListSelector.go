@@ -1,6 +1,11 @@
 package disabled
 
-import "github.com/multiversx/mx-chain-go/state"
+import (
+	"math/big"
+
+	"github.com/multiversx/mx-chain-go/epochStart"
+	"github.com/multiversx/mx-chain-go/state"
+)
 
 type auctionListSelector struct {
 }
@@ -15,6 +20,15 @@ func (als *auctionListSelector) SelectNodesFromAuctionList(state.ShardValidators
 	return nil
 }
 
+// SimulateNodesSelection returns an empty selection result
+func (als *auctionListSelector) SimulateNodesSelection(map[string]*epochStart.OwnerData, []byte) (*epochStart.AuctionSelectionResult, error) {
+	return &epochStart.AuctionSelectionResult{
+		SelectedNodes:         make([]state.ValidatorInfoHandler, 0),
+		QualifiedTopUpPerNode: make(map[string]*big.Int),
+		MinQualifiedTopUp:     big.NewInt(0),
+	}, nil
+}
+
 // IsInterfaceNil returns true if the underlying pointer is nil
 func (als *auctionListSelector) IsInterfaceNil() bool {
 	return als == nil
@@ -10,3 +10,6 @@ var ErrNilDirectStakedListFactory = errors.New("nil direct staked list factory h
 
 // ErrNilTotalStakedValueFactory signal that a nil total staked value handler factory has been provided
 var ErrNilTotalStakedValueFactory = errors.New("nil total staked value handler factory has been provided")
+
+// ErrNilGovernanceFactory signal that a nil governance handler factory has been provided
+var ErrNilGovernanceFactory = errors.New("nil governance handler factory has been provided")
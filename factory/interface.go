@@ -444,6 +444,7 @@ type ConsensusComponentsHolder interface {
 	BroadcastMessenger() consensus.BroadcastMessenger
 	ConsensusGroupSize() (int, error)
 	Bootstrapper() process.Bootstrapper
+	RoundTimelineRecorder() consensus.RoundTimelineRecorder
 	IsInterfaceNil() bool
 }
 
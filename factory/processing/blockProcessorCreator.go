@@ -868,6 +868,11 @@ func (pcf *processComponentsFactory) newMetaBlockProcessor(
 		return nil, err
 	}
 
+	validatorRewardsStorage, err := pcf.data.StorageService().GetStorer(dataRetriever.ValidatorRewardsUnit)
+	if err != nil {
+		return nil, err
+	}
+
 	stakingDataProviderAPI, err := metachainEpochStart.NewStakingDataProvider(argsStakingDataProvider)
 	if err != nil {
 		return nil, err
@@ -890,9 +895,10 @@ func (pcf *processComponentsFactory) newMetaBlockProcessor(
 			EnableEpochsHandler:           pcf.coreData.EnableEpochsHandler(),
 			ExecutionOrderHandler:         pcf.txExecutionOrderHandler,
 		},
-		StakingDataProvider:   stakingDataProvider,
-		RewardsHandler:        pcf.coreData.EconomicsData(),
-		EconomicsDataProvider: economicsDataProvider,
+		StakingDataProvider:     stakingDataProvider,
+		RewardsHandler:          pcf.coreData.EconomicsData(),
+		EconomicsDataProvider:   economicsDataProvider,
+		ValidatorRewardsStorage: validatorRewardsStorage,
 	}
 	epochRewards, err := metachainEpochStart.NewRewardsCreatorProxy(argsEpochRewards)
 	if err != nil {
@@ -903,14 +909,19 @@ func (pcf *processComponentsFactory) newMetaBlockProcessor(
 	if err != nil {
 		return nil, err
 	}
+	validatorRatingsStorage, err := pcf.data.StorageService().GetStorer(dataRetriever.ValidatorRatingsUnit)
+	if err != nil {
+		return nil, err
+	}
 	argsEpochValidatorInfo := metachainEpochStart.ArgsNewValidatorInfoCreator{
-		ShardCoordinator:     pcf.bootstrapComponents.ShardCoordinator(),
-		ValidatorInfoStorage: validatorInfoStorage,
-		MiniBlockStorage:     miniBlockStorage,
-		Hasher:               pcf.coreData.Hasher(),
-		Marshalizer:          pcf.coreData.InternalMarshalizer(),
-		DataPool:             pcf.data.Datapool(),
-		EnableEpochsHandler:  pcf.coreData.EnableEpochsHandler(),
+		ShardCoordinator:        pcf.bootstrapComponents.ShardCoordinator(),
+		ValidatorInfoStorage:    validatorInfoStorage,
+		MiniBlockStorage:        miniBlockStorage,
+		ValidatorRatingsStorage: validatorRatingsStorage,
+		Hasher:                  pcf.coreData.Hasher(),
+		Marshalizer:             pcf.coreData.InternalMarshalizer(),
+		DataPool:                pcf.data.Datapool(),
+		EnableEpochsHandler:     pcf.coreData.EnableEpochsHandler(),
 	}
 	validatorInfoCreator, err := metachainEpochStart.NewValidatorInfoCreator(argsEpochValidatorInfo)
 	if err != nil {
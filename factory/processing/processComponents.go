@@ -608,6 +608,12 @@ func (pcf *processComponentsFactory) Create() (*processComponents, error) {
 		}
 	}
 
+	conversionBase := 10
+	genesisNodePrice, ok := big.NewInt(0).SetString(pcf.systemSCConfig.StakingSystemSCConfig.GenesisNodePrice, conversionBase)
+	if !ok {
+		return nil, errors.New("invalid genesis node price")
+	}
+
 	cacheRefreshDuration := time.Duration(pcf.config.ValidatorStatistics.CacheRefreshIntervalInSec) * time.Second
 	argVSP := peer.ArgValidatorsProvider{
 		NodesCoordinator:                  pcf.nodesCoordinator,
@@ -620,6 +626,8 @@ func (pcf *processComponentsFactory) Create() (*processComponents, error) {
 		AddressPubKeyConverter:            pcf.coreData.AddressPubKeyConverter(),
 		AuctionListSelector:               pcf.auctionListSelectorAPI,
 		StakingDataProvider:               pcf.stakingDataProviderAPI,
+		MinNodePrice:                      genesisNodePrice,
+		MaxNumNodes:                       getMaxNumNodes(pcf.epochConfig.EnableEpochs.MaxNodesChangeEnableEpoch),
 	}
 
 	validatorsProvider, err := peer.NewValidatorsProvider(argVSP)
@@ -627,12 +635,6 @@ func (pcf *processComponentsFactory) Create() (*processComponents, error) {
 		return nil, err
 	}
 
-	conversionBase := 10
-	genesisNodePrice, ok := big.NewInt(0).SetString(pcf.systemSCConfig.StakingSystemSCConfig.GenesisNodePrice, conversionBase)
-	if !ok {
-		return nil, errors.New("invalid genesis node price")
-	}
-
 	argsNodesSetupChecker := checking.ArgsNodesSetupChecker{
 		AccountsParser:           pcf.runTypeComponents.AccountsParser(),
 		InitialNodePrice:         genesisNodePrice,
@@ -1939,6 +1941,18 @@ func createCache(cacheConfig config.CacheConfig) (storage.Cacher, error) {
 	return storageunit.NewCache(storageFactory.GetCacherFromConfig(cacheConfig))
 }
 
+// getMaxNumNodes returns the highest number of nodes allowed by the max nodes change configs
+func getMaxNumNodes(maxNodesChangeConfigs []config.MaxNodesChangeConfig) uint32 {
+	maxNumNodes := uint32(0)
+	for _, maxNodesChangeConfig := range maxNodesChangeConfigs {
+		if maxNodesChangeConfig.MaxNumNodes > maxNumNodes {
+			maxNumNodes = maxNodesChangeConfig.MaxNumNodes
+		}
+	}
+
+	return maxNumNodes
+}
+
 func checkProcessComponentsArgs(args ProcessComponentsFactoryArgs) error {
 	baseErrMessage := "error creating process components"
 	if check.IfNil(args.GasSchedule) {
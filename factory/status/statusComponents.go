@@ -221,12 +221,19 @@ func (scf *statusComponentsFactory) createOutportDriver() (outport.OutportHandle
 		return nil, err
 	}
 
+	fileDriverArgs, err := scf.makeFileDriverArgs()
+	if err != nil {
+		return nil, err
+	}
+
 	outportFactoryArgs := &outportDriverFactory.OutportFactoryArgs{
 		ShardID:                   scf.shardCoordinator.SelfId(),
 		RetrialInterval:           common.RetrialIntervalForOutportDriver,
 		ElasticIndexerFactoryArgs: scf.makeElasticIndexerArgs(),
 		EventNotifierFactoryArgs:  eventNotifierArgs,
 		HostDriversArgs:           hostDriversArgs,
+		FileDriverArgs:            fileDriverArgs,
+		DriverQueueConfig:         scf.externalConfig.OutportQueueConfig,
 		IsImportDB:                scf.isInImportMode,
 	}
 
@@ -293,3 +300,20 @@ func (scf *statusComponentsFactory) makeHostDriversArgs() ([]outportDriverFactor
 
 	return argsHostDriverFactorySlice, nil
 }
+
+func (scf *statusComponentsFactory) makeFileDriverArgs() (outportDriverFactory.ArgsFileDriverFactory, error) {
+	fileConfig := scf.externalConfig.FileDriverConfig
+	if !fileConfig.Enabled {
+		return outportDriverFactory.ArgsFileDriverFactory{}, nil
+	}
+
+	marshaller, err := factoryMarshalizer.NewMarshalizer(factoryMarshalizer.GogoProtobuf)
+	if err != nil {
+		return outportDriverFactory.ArgsFileDriverFactory{}, err
+	}
+
+	return outportDriverFactory.ArgsFileDriverFactory{
+		FileConfig: fileConfig,
+		Marshaller: marshaller,
+	}, nil
+}
@@ -0,0 +1,137 @@
+package consensus
+
+import (
+	"fmt"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-go/config"
+	"github.com/multiversx/mx-chain-go/consensus"
+	"github.com/multiversx/mx-chain-go/consensus/recorder"
+	"github.com/multiversx/mx-chain-go/factory"
+	"github.com/multiversx/mx-chain-go/integrationTests"
+	"github.com/multiversx/mx-chain-go/integrationTests/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestConsensusBLSRecordAndReplay(t *testing.T) {
+	if testing.Short() {
+		t.Skip("this is not a short test")
+	}
+
+	numMetaNodes := uint32(4)
+	numNodes := uint32(4)
+	consensusSize := uint32(4)
+	roundTime := uint64(1000)
+	numCommBlock := uint64(4)
+	nodes := initNodesAndTest(numMetaNodes, numNodes, consensusSize, 0, roundTime, blsConsensusType, 1, consensus.ConsensusModelV1)
+
+	defer func() {
+		for shardID := range nodes {
+			for _, n := range nodes[shardID] {
+				_ = n.MainMessenger.Close()
+				_ = n.FullArchiveMessenger.Close()
+			}
+		}
+	}()
+
+	fmt.Println("Start consensus with the messages recorder enabled...")
+	time.Sleep(time.Second * 2)
+
+	recordingsDirectory := t.TempDir()
+	recordingConsensusConfig := createConsensusConfig()
+	recordingConsensusConfig.MessagesRecorder = config.ConsensusMessagesRecorderConfig{
+		Enabled:         true,
+		Directory:       recordingsDirectory,
+		MaxFileSizeInMB: 100,
+	}
+
+	shardNodes := nodes[0]
+	consensusComponents := make([]factory.ConsensusComponentsHandler, 0, len(shardNodes))
+	for _, n := range shardNodes {
+		cc, err := startConsensus(n, recordingConsensusConfig)
+		require.Nil(t, err)
+		consensusComponents = append(consensusComponents, cc)
+	}
+
+	recordingNode := shardNodes[0]
+	waitForNonce(t, recordingNode, numCommBlock, time.Duration(roundTime)*time.Millisecond*time.Duration(numCommBlock)+time.Minute)
+
+	// closing the consensus components will also close the recordings
+	for _, cc := range consensusComponents {
+		require.Nil(t, cc.Close())
+	}
+
+	recordingFiles, err := filepath.Glob(filepath.Join(recordingsDirectory, fmt.Sprintf("consensus-messages_0_%s-*.json", recordingNode.MainMessenger.ID().Pretty())))
+	require.Nil(t, err)
+	require.Equal(t, 1, len(recordingFiles))
+
+	recording, err := recorder.LoadRecordedMessages(recordingFiles[0])
+	require.Nil(t, err)
+	messages := recording.Messages
+	require.NotEmpty(t, messages)
+
+	fmt.Printf("Replay %d recorded messages...\n", len(messages))
+
+	syncTimer := mock.NewManualSyncTimer(messages[0].ReceivedTime())
+	roundHandler := mock.NewManualRoundHandler(
+		recordingNode.Node.GetCoreComponents().GenesisTime(),
+		time.Duration(roundTime)*time.Millisecond,
+		syncTimer,
+	)
+	roundHandler.SetIndex(messages[0].LocalRound)
+
+	replayNode := recordingNode.CreateReplayNode(roundHandler, syncTimer)
+	defer func() {
+		_ = replayNode.MainMessenger.Close()
+		_ = replayNode.FullArchiveMessenger.Close()
+	}()
+
+	replayConsensusComponents, err := startConsensus(replayNode, createConsensusConfig())
+	require.Nil(t, err)
+	defer func() {
+		_ = replayConsensusComponents.Close()
+	}()
+
+	results, err := integrationTests.ReplayConsensusMessages(integrationTests.ArgsConsensusMessagesReplayer{
+		Recording:    recording,
+		Processor:    replayConsensusComponents.ConsensusWorker(),
+		RoundHandler: roundHandler,
+		SyncTimer:    syncTimer,
+
+		RoundTimelineRecorder: replayConsensusComponents.RoundTimelineRecorder(),
+		RoundWaitTimeout:      time.Duration(roundTime) * time.Millisecond * 2,
+	})
+	require.Nil(t, err, "the replaying node can not reach the same state from an incomplete recording")
+	require.Equal(t, len(messages), len(results))
+
+	numRejected := 0
+	for _, result := range results {
+		if result != nil {
+			numRejected++
+			log.Debug("replayed message rejected", "error", result.Error())
+		}
+	}
+	log.Info("consensus messages replayed", "num messages", len(messages), "num rejected", numRejected)
+
+	// the replaying node holds the same keys as the recording node so it should end up with the same committed block
+	waitForNonce(t, replayNode, recordingNode.ChainHandler.GetCurrentBlockHeader().GetNonce(), time.Duration(roundTime)*time.Millisecond*2)
+	assert.Equal(t, recordingNode.ChainHandler.GetCurrentBlockHeaderHash(), replayNode.ChainHandler.GetCurrentBlockHeaderHash())
+}
+
+func waitForNonce(t *testing.T, n *integrationTests.TestConsensusNode, nonce uint64, timeout time.Duration) {
+	deadline := time.Now().Add(timeout)
+	for time.Now().Before(deadline) {
+		header := n.ChainHandler.GetCurrentBlockHeader()
+		if !check.IfNil(header) && header.GetNonce() >= nonce {
+			return
+		}
+
+		time.Sleep(time.Millisecond * 100)
+	}
+
+	assert.Fail(t, fmt.Sprintf("node did not reach nonce %d in %v", nonce, timeout))
+}
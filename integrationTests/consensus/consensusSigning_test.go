@@ -109,7 +109,7 @@ func runConsensusWithInvalidSigners(t *testing.T, consensusModel consensus.Conse
 		nonceForRoundMap := make(map[uint64]uint64)
 		totalCalled := 0
 
-		err := startNodesWithCommitBlock(nodes[shardID], mutex, nonceForRoundMap, &totalCalled)
+		err := startNodesWithCommitBlock(nodes[shardID], mutex, nonceForRoundMap, &totalCalled, createConsensusConfig())
 		assert.Nil(t, err)
 
 		chDone := make(chan bool)
@@ -14,6 +14,7 @@ import (
 	"github.com/multiversx/mx-chain-go/config"
 	"github.com/multiversx/mx-chain-go/consensus"
 	"github.com/multiversx/mx-chain-go/consensus/spos/bls"
+	"github.com/multiversx/mx-chain-go/factory"
 	consensusComp "github.com/multiversx/mx-chain-go/factory/consensus"
 	"github.com/multiversx/mx-chain-go/integrationTests"
 	"github.com/multiversx/mx-chain-go/process"
@@ -111,6 +112,7 @@ func startNodesWithCommitBlock(
 	mutex *sync.Mutex,
 	nonceForRoundMap map[uint64]uint64,
 	totalCalled *int,
+	consensusConfig config.ConsensusConfig,
 ) error {
 	for idx, n := range nodes {
 		nCopy := n
@@ -142,55 +144,7 @@ func startNodesWithCommitBlock(
 			return nil
 		}
 
-		statusComponents := integrationTests.GetDefaultStatusComponents()
-
-		consensusArgs := consensusComp.ConsensusComponentsFactoryArgs{
-			Config: config.Config{
-				Consensus: config.ConsensusConfig{
-					Type: blsConsensusType,
-				},
-				ValidatorPubkeyConverter: config.PubkeyConfig{
-					Length:          96,
-					Type:            "bls",
-					SignatureLength: 48,
-				},
-				TrieSync: config.TrieSyncConfig{
-					NumConcurrentTrieSyncers:  5,
-					MaxHardCapForMissingNodes: 5,
-					TrieSyncerVersion:         2,
-					CheckNodesOnDisk:          false,
-				},
-				GeneralSettings: config.GeneralSettingsConfig{
-					SyncProcessTimeInMillis: 6000,
-				},
-			},
-			BootstrapRoundIndex:  0,
-			CoreComponents:       n.Node.GetCoreComponents(),
-			NetworkComponents:    n.Node.GetNetworkComponents(),
-			CryptoComponents:     n.Node.GetCryptoComponents(),
-			DataComponents:       n.Node.GetDataComponents(),
-			ProcessComponents:    n.Node.GetProcessComponents(),
-			StateComponents:      n.Node.GetStateComponents(),
-			StatusComponents:     statusComponents,
-			StatusCoreComponents: n.Node.GetStatusCoreComponents(),
-			ScheduledProcessor:   &consensusMocks.ScheduledProcessorStub{},
-			IsInImportMode:       n.Node.IsInImportMode(),
-			RunTypeComponents:    n.Node.GetRunTypeComponents(),
-			SubRoundEndV2Creator: bls.NewSubRoundEndV2Creator(),
-			ExtraSignersHolder:   &subRoundsHolder.ExtraSignersHolderMock{},
-		}
-
-		consensusFactory, err := consensusComp.NewConsensusComponentsFactory(consensusArgs)
-		if err != nil {
-			return fmt.Errorf("NewConsensusComponentsFactory failed: %w", err)
-		}
-
-		managedConsensusComponents, err := consensusComp.NewManagedConsensusComponents(consensusFactory)
-		if err != nil {
-			return err
-		}
-
-		err = managedConsensusComponents.Create()
+		_, err := startConsensus(n, consensusConfig)
 		if err != nil {
 			return err
 		}
@@ -199,6 +153,68 @@ func startNodesWithCommitBlock(
 	return nil
 }
 
+func createConsensusConfig() config.ConsensusConfig {
+	return config.ConsensusConfig{
+		Type:                    blsConsensusType,
+		NumRoundTimelinesToKeep: 100,
+	}
+}
+
+func startConsensus(n *integrationTests.TestConsensusNode, consensusConfig config.ConsensusConfig) (factory.ConsensusComponentsHandler, error) {
+	statusComponents := integrationTests.GetDefaultStatusComponents()
+
+	consensusArgs := consensusComp.ConsensusComponentsFactoryArgs{
+		Config: config.Config{
+			Consensus: consensusConfig,
+			ValidatorPubkeyConverter: config.PubkeyConfig{
+				Length:          96,
+				Type:            "bls",
+				SignatureLength: 48,
+			},
+			TrieSync: config.TrieSyncConfig{
+				NumConcurrentTrieSyncers:  5,
+				MaxHardCapForMissingNodes: 5,
+				TrieSyncerVersion:         2,
+				CheckNodesOnDisk:          false,
+			},
+			GeneralSettings: config.GeneralSettingsConfig{
+				SyncProcessTimeInMillis: 6000,
+			},
+		},
+		BootstrapRoundIndex:  0,
+		CoreComponents:       n.Node.GetCoreComponents(),
+		NetworkComponents:    n.Node.GetNetworkComponents(),
+		CryptoComponents:     n.Node.GetCryptoComponents(),
+		DataComponents:       n.Node.GetDataComponents(),
+		ProcessComponents:    n.Node.GetProcessComponents(),
+		StateComponents:      n.Node.GetStateComponents(),
+		StatusComponents:     statusComponents,
+		StatusCoreComponents: n.Node.GetStatusCoreComponents(),
+		ScheduledProcessor:   &consensusMocks.ScheduledProcessorStub{},
+		IsInImportMode:       n.Node.IsInImportMode(),
+		RunTypeComponents:    n.Node.GetRunTypeComponents(),
+		SubRoundEndV2Creator: bls.NewSubRoundEndV2Creator(),
+		ExtraSignersHolder:   &subRoundsHolder.ExtraSignersHolderMock{},
+	}
+
+	consensusFactory, err := consensusComp.NewConsensusComponentsFactory(consensusArgs)
+	if err != nil {
+		return nil, fmt.Errorf("NewConsensusComponentsFactory failed: %w", err)
+	}
+
+	managedConsensusComponents, err := consensusComp.NewManagedConsensusComponents(consensusFactory)
+	if err != nil {
+		return nil, err
+	}
+
+	err = managedConsensusComponents.Create()
+	if err != nil {
+		return nil, err
+	}
+
+	return managedConsensusComponents, nil
+}
+
 func checkBlockProposedEveryRound(numCommBlock uint64, nonceForRoundMap map[uint64]uint64, mutex *sync.Mutex, chDone chan bool, t *testing.T) {
 	for {
 		mutex.Lock()
@@ -236,7 +252,13 @@ func checkBlockProposedEveryRound(numCommBlock uint64, nonceForRoundMap map[uint
 	}
 }
 
-func runFullConsensusTest(t *testing.T, consensusType string, numKeysOnEachNode int, consensusModel consensus.ConsensusModel) {
+func runFullConsensusTest(
+	t *testing.T,
+	consensusType string,
+	numKeysOnEachNode int,
+	consensusModel consensus.ConsensusModel,
+	consensusConfig config.ConsensusConfig,
+) {
 	numMetaNodes := uint32(4)
 	numNodes := uint32(4)
 	consensusSize := uint32(4 * numKeysOnEachNode)
@@ -270,7 +292,7 @@ func runFullConsensusTest(t *testing.T, consensusType string, numKeysOnEachNode
 		nonceForRoundMap := make(map[uint64]uint64)
 		totalCalled := 0
 
-		err := startNodesWithCommitBlock(nodes[shardID], mutex, nonceForRoundMap, &totalCalled)
+		err := startNodesWithCommitBlock(nodes[shardID], mutex, nonceForRoundMap, &totalCalled, consensusConfig)
 		assert.Nil(t, err)
 
 		chDone := make(chan bool)
@@ -296,7 +318,7 @@ func TestConsensusBLSFullTestSingleKeysConsensusModelV1(t *testing.T) {
 		t.Skip("this is not a short test")
 	}
 
-	runFullConsensusTest(t, blsConsensusType, 1, consensus.ConsensusModelV1)
+	runFullConsensusTest(t, blsConsensusType, 1, consensus.ConsensusModelV1, createConsensusConfig())
 }
 
 func TestConsensusBLSFullTestSingleKeysConsensusModelV2(t *testing.T) {
@@ -304,7 +326,7 @@ func TestConsensusBLSFullTestSingleKeysConsensusModelV2(t *testing.T) {
 		t.Skip("this is not a short test")
 	}
 
-	runFullConsensusTest(t, blsConsensusType, 1, consensus.ConsensusModelV2)
+	runFullConsensusTest(t, blsConsensusType, 1, consensus.ConsensusModelV2, createConsensusConfig())
 }
 
 func TestConsensusBLSFullTestMultiKeysConsensusModelV1(t *testing.T) {
@@ -312,7 +334,27 @@ func TestConsensusBLSFullTestMultiKeysConsensusModelV1(t *testing.T) {
 		t.Skip("this is not a short test")
 	}
 
-	runFullConsensusTest(t, blsConsensusType, 5, consensus.ConsensusModelV1)
+	runFullConsensusTest(t, blsConsensusType, 5, consensus.ConsensusModelV1, createConsensusConfig())
+}
+
+func TestConsensusBLSFullTestSingleKeysLeaderOnlySignatures(t *testing.T) {
+	if testing.Short() {
+		t.Skip("this is not a short test")
+	}
+
+	consensusConfig := createConsensusConfig()
+	consensusConfig.SignaturesForwarding.LeaderOnly = true
+	runFullConsensusTest(t, blsConsensusType, 1, consensus.ConsensusModelV1, consensusConfig)
+}
+
+func TestConsensusBLSFullTestMultiKeysLeaderOnlySignatures(t *testing.T) {
+	if testing.Short() {
+		t.Skip("this is not a short test")
+	}
+
+	consensusConfig := createConsensusConfig()
+	consensusConfig.SignaturesForwarding.LeaderOnly = true
+	runFullConsensusTest(t, blsConsensusType, 5, consensus.ConsensusModelV1, consensusConfig)
 }
 
 func runConsensusWithNotEnoughValidators(t *testing.T, consensusType string, consensusModel consensus.ConsensusModel) {
@@ -341,7 +383,7 @@ func runConsensusWithNotEnoughValidators(t *testing.T, consensusType string, con
 		mutex := &sync.Mutex{}
 		nonceForRoundMap := make(map[uint64]uint64)
 
-		err := startNodesWithCommitBlock(nodes[shardID], mutex, nonceForRoundMap, &totalCalled)
+		err := startNodesWithCommitBlock(nodes[shardID], mutex, nonceForRoundMap, &totalCalled, createConsensusConfig())
 		assert.Nil(t, err)
 
 		waitTime := time.Second * 30
@@ -0,0 +1,96 @@
+package integrationTests
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/consensus"
+	"github.com/multiversx/mx-chain-go/consensus/recorder"
+	"github.com/multiversx/mx-chain-go/consensus/timeline"
+	"github.com/multiversx/mx-chain-go/integrationTests/mock"
+	"github.com/multiversx/mx-chain-go/p2p"
+)
+
+const pollingIntervalForRoundState = time.Millisecond
+
+// ArgsConsensusMessagesReplayer represents the arguments for replaying recorded consensus messages
+type ArgsConsensusMessagesReplayer struct {
+	Recording             *recorder.Recording
+	Processor             p2p.MessageProcessor
+	RoundHandler          *mock.ManualRoundHandler
+	SyncTimer             *mock.ManualSyncTimer
+	RoundTimelineRecorder consensus.RoundTimelineRecorder
+	RoundWaitTimeout      time.Duration
+}
+
+// ReplayConsensusMessages feeds the recorded messages, in the recorded order, to the provided processor (usually the
+// consensus worker). Before each message, the sync timer is moved to the time the message was originally received at.
+// Each time the recorded local round changes, the replay waits for the replaying node to end the previous round, moves
+// the round handler on the new round and waits for the replaying node to start it. This way, the messages are processed
+// in the same conditions as on the recording node, regardless of the replay speed.
+// The returned slice holds the processing error of each message, on the same position as the message. The messages of
+// an incomplete recording are replayed as well, but the replay is reported as incomplete by the returned error
+func ReplayConsensusMessages(args ArgsConsensusMessagesReplayer) ([]error, error) {
+	messages := args.Recording.Messages
+	results := make([]error, 0, len(messages))
+	for i, message := range messages {
+		isNewRound := i == 0 || message.LocalRound != messages[i-1].LocalRound
+		if isNewRound && i > 0 {
+			waitForRoundState(args.RoundTimelineRecorder, messages[i-1].LocalRound, isRoundEnded, args.RoundWaitTimeout)
+		}
+
+		args.SyncTimer.SetCurrentTime(message.ReceivedTime())
+		if isNewRound {
+			args.RoundHandler.SetIndex(message.LocalRound)
+			waitForRoundState(args.RoundTimelineRecorder, message.LocalRound, isRoundStarted, args.RoundWaitTimeout)
+		}
+
+		err := args.Processor.ProcessReceivedMessage(message.P2PMessage(), message.ConnectedPeer(), nil)
+		results = append(results, err)
+	}
+
+	if !args.Recording.IsComplete() {
+		log.Warn("replayed an incomplete consensus messages recording", "num dropped messages", args.Recording.NumDroppedMessages)
+		return results, fmt.Errorf("%w, %d messages were dropped", recorder.ErrIncompleteRecording, args.Recording.NumDroppedMessages)
+	}
+
+	return results, nil
+}
+
+func waitForRoundState(
+	roundTimelineRecorder consensus.RoundTimelineRecorder,
+	round int64,
+	isStateReached func(lastRound *common.ConsensusRoundTimeline, round int64) bool,
+	timeout time.Duration,
+) {
+	deadline := time.Now().Add(timeout)
+	for time.Now().Before(deadline) {
+		lastRounds := roundTimelineRecorder.GetLastRounds(1)
+		if len(lastRounds) > 0 && isStateReached(lastRounds[0], round) {
+			return
+		}
+
+		time.Sleep(pollingIntervalForRoundState)
+	}
+
+	log.Warn("replayed round did not reach the expected state in time", "round", round, "timeout", timeout)
+}
+
+// isRoundStarted returns true if the consensus state was moved on the provided round. This happens in the first
+// subround so the round is considered started once the next subround began or the round ended
+func isRoundStarted(lastRound *common.ConsensusRoundTimeline, round int64) bool {
+	if lastRound.Round < round {
+		return false
+	}
+
+	return len(lastRound.Subrounds) > 1 || lastRound.Outcome != timeline.OutcomeInProgress
+}
+
+func isRoundEnded(lastRound *common.ConsensusRoundTimeline, round int64) bool {
+	if lastRound.Round < round {
+		return false
+	}
+
+	return lastRound.Outcome != timeline.OutcomeInProgress
+}
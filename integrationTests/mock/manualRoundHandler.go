@@ -0,0 +1,70 @@
+package mock
+
+import (
+	"sync/atomic"
+	"time"
+
+	"github.com/multiversx/mx-chain-go/ntp"
+)
+
+// ManualRoundHandler is a round handler whose index is only changed by explicit calls. The remaining time is computed
+// against the provided sync timer, as the real round handler does
+type ManualRoundHandler struct {
+	index         int64
+	nextIndex     int64
+	genesisTime   time.Time
+	roundDuration time.Duration
+	syncTimer     ntp.SyncTimer
+}
+
+// NewManualRoundHandler creates a new manual round handler positioned on round 0
+func NewManualRoundHandler(genesisTime time.Time, roundDuration time.Duration, syncTimer ntp.SyncTimer) *ManualRoundHandler {
+	return &ManualRoundHandler{
+		genesisTime:   genesisTime,
+		roundDuration: roundDuration,
+		syncTimer:     syncTimer,
+	}
+}
+
+// SetIndex schedules the provided round index, which becomes the current one on the next UpdateRound call. This
+// mimics the real round handler, as the chronology only starts a new round if the index changes while updating the round
+func (mrh *ManualRoundHandler) SetIndex(index int64) {
+	atomic.StoreInt64(&mrh.nextIndex, index)
+}
+
+// Index returns the current round index
+func (mrh *ManualRoundHandler) Index() int64 {
+	return atomic.LoadInt64(&mrh.index)
+}
+
+// BeforeGenesis returns false
+func (mrh *ManualRoundHandler) BeforeGenesis() bool {
+	return false
+}
+
+// UpdateRound moves the current round index on the one scheduled through SetIndex
+func (mrh *ManualRoundHandler) UpdateRound(_ time.Time, _ time.Time) {
+	atomic.StoreInt64(&mrh.index, atomic.LoadInt64(&mrh.nextIndex))
+}
+
+// TimeStamp returns the start time of the current round
+func (mrh *ManualRoundHandler) TimeStamp() time.Time {
+	return mrh.genesisTime.Add(time.Duration(mrh.Index()) * mrh.roundDuration)
+}
+
+// TimeDuration returns the round duration
+func (mrh *ManualRoundHandler) TimeDuration() time.Duration {
+	return mrh.roundDuration
+}
+
+// RemainingTime returns the remaining time from the provided start time, measured on the sync timer
+func (mrh *ManualRoundHandler) RemainingTime(startTime time.Time, maxTime time.Duration) time.Duration {
+	elapsedTime := mrh.syncTimer.CurrentTime().Sub(startTime)
+
+	return maxTime - elapsedTime
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (mrh *ManualRoundHandler) IsInterfaceNil() bool {
+	return mrh == nil
+}
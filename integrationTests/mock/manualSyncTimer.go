@@ -0,0 +1,58 @@
+package mock
+
+import (
+	"sync"
+	"time"
+)
+
+// ManualSyncTimer is a sync timer whose current time is only changed by explicit calls
+type ManualSyncTimer struct {
+	mut         sync.RWMutex
+	currentTime time.Time
+}
+
+// NewManualSyncTimer creates a new manual sync timer set on the provided time
+func NewManualSyncTimer(currentTime time.Time) *ManualSyncTimer {
+	return &ManualSyncTimer{
+		currentTime: currentTime,
+	}
+}
+
+// SetCurrentTime sets the time returned by the timer
+func (mst *ManualSyncTimer) SetCurrentTime(currentTime time.Time) {
+	mst.mut.Lock()
+	mst.currentTime = currentTime
+	mst.mut.Unlock()
+}
+
+// StartSyncingTime does nothing as the time is not synchronized
+func (mst *ManualSyncTimer) StartSyncingTime() {
+}
+
+// ClockOffset returns 0
+func (mst *ManualSyncTimer) ClockOffset() time.Duration {
+	return 0
+}
+
+// FormattedCurrentTime returns the formatted current time
+func (mst *ManualSyncTimer) FormattedCurrentTime() string {
+	return mst.CurrentTime().String()
+}
+
+// CurrentTime returns the last set time
+func (mst *ManualSyncTimer) CurrentTime() time.Time {
+	mst.mut.RLock()
+	defer mst.mut.RUnlock()
+
+	return mst.currentTime
+}
+
+// Close returns nil
+func (mst *ManualSyncTimer) Close() error {
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (mst *ManualSyncTimer) IsInterfaceNil() bool {
+	return mst == nil
+}
@@ -96,6 +96,19 @@ func (nscm *networkShardingCollectorMock) GetLastKnownPeerID(pk []byte) (core.Pe
 	return pid, ok
 }
 
+// GetPeerIDs -
+func (nscm *networkShardingCollectorMock) GetPeerIDs(pk []byte) []core.PeerID {
+	nscm.mutMaps.RLock()
+	defer nscm.mutMaps.RUnlock()
+
+	pid, ok := nscm.pkPeerIdMap[string(pk)]
+	if !ok {
+		return nil
+	}
+
+	return []core.PeerID{pid}
+}
+
 // IsInterfaceNil -
 func (nscm *networkShardingCollectorMock) IsInterfaceNil() bool {
 	return nscm == nil
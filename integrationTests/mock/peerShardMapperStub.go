@@ -9,6 +9,7 @@ type PeerShardMapperStub struct {
 	PutPeerIdShardIdCalled          func(pid core.PeerID, shardID uint32)
 	PutPeerIdSubTypeCalled          func(pid core.PeerID, peerSubType core.P2PPeerSubType)
 	UpdatePeerIDInfoCalled          func(pid core.PeerID, pk []byte, shardID uint32)
+	GetPeerIDsCalled                func(pk []byte) []core.PeerID
 }
 
 // UpdatePeerIDInfo -
@@ -48,6 +49,15 @@ func (psms *PeerShardMapperStub) GetLastKnownPeerID(pk []byte) (core.PeerID, boo
 	return "", false
 }
 
+// GetPeerIDs -
+func (psms *PeerShardMapperStub) GetPeerIDs(pk []byte) []core.PeerID {
+	if psms.GetPeerIDsCalled != nil {
+		return psms.GetPeerIDsCalled(pk)
+	}
+
+	return nil
+}
+
 // GetPeerInfo -
 func (psms *PeerShardMapperStub) GetPeerInfo(_ core.PeerID) core.P2PPeerInfo {
 	return core.P2PPeerInfo{}
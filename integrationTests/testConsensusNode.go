@@ -77,6 +77,9 @@ type ArgsTestConsensusNode struct {
 	MultiSigner    *cryptoMocks.MultisignerMock
 	StartTime      int64
 	ConsensusModel consensus.ConsensusModel
+	// RoundHandler and SyncTimer are optional, if set they replace the NTP driven round handler and sync timer
+	RoundHandler consensus.RoundHandler
+	SyncTimer    ntp.SyncTimer
 }
 
 // TestConsensusNode represents a structure used in integration tests used for consensus tests
@@ -92,6 +95,8 @@ type TestConsensusNode struct {
 	AccountsDB           *state.AccountsDB
 	NodeKeys             *TestKeyPair
 	MultiSigner          *cryptoMocks.MultisignerMock
+
+	args ArgsTestConsensusNode
 }
 
 // NewTestConsensusNode returns a new TestConsensusNode
@@ -103,12 +108,23 @@ func NewTestConsensusNode(args ArgsTestConsensusNode) *TestConsensusNode {
 		NodeKeys:         args.NodeKeys.MainKey,
 		ShardCoordinator: shardCoordinator,
 		MultiSigner:      args.MultiSigner,
+		args:             args,
 	}
 	tcn.initNode(args)
 
 	return tcn
 }
 
+// CreateReplayNode creates a new, not connected, node with the same keys and validators set as the current one but
+// driven by the provided round handler and sync timer. It is used to replay the consensus messages recorded by this node
+func (tcn *TestConsensusNode) CreateReplayNode(roundHandler consensus.RoundHandler, syncTimer ntp.SyncTimer) *TestConsensusNode {
+	args := tcn.args
+	args.RoundHandler = roundHandler
+	args.SyncTimer = syncTimer
+
+	return NewTestConsensusNode(args)
+}
+
 // CreateNodesWithTestConsensusNode returns a map with nodes per shard each using TestConsensusNode
 func CreateNodesWithTestConsensusNode(
 	numMetaNodes int,
@@ -194,15 +210,21 @@ func (tcn *TestConsensusNode) initNode(args ArgsTestConsensusNode) {
 	tcn.initBlockChain(testHasher)
 	tcn.initBlockProcessor()
 
-	syncer := ntp.NewSyncTime(ntp.NewNTPGoogleConfig(), nil)
-	syncer.StartSyncingTime()
+	syncer := args.SyncTimer
+	if check.IfNil(syncer) {
+		syncer = ntp.NewSyncTime(ntp.NewNTPGoogleConfig(), nil)
+		syncer.StartSyncingTime()
+	}
 
-	roundHandler, _ := round.NewRound(
-		time.Unix(args.StartTime, 0),
-		syncer.CurrentTime(),
-		time.Millisecond*time.Duration(args.RoundTime),
-		syncer,
-		0)
+	roundHandler := args.RoundHandler
+	if check.IfNil(roundHandler) {
+		roundHandler, _ = round.NewRound(
+			time.Unix(args.StartTime, 0),
+			syncer.CurrentTime(),
+			time.Millisecond*time.Duration(args.RoundTime),
+			syncer,
+			0)
+	}
 
 	dataPool := dataRetrieverMock.CreatePoolsHolder(1, 0)
 
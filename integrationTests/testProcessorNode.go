@@ -875,6 +875,8 @@ func (tpn *TestProcessorNode) initTestNodeWithArgs(args ArgTestProcessorNode) {
 			tpn.NodeKeys.MainKey.Sk,
 			tpn.MainMessenger.ID(),
 		),
+		&p2pmocks.NetworkShardingCollectorStub{},
+		config.ConsensusSignaturesForwardingConfig{},
 	)
 
 	if args.WithSync {
@@ -1101,6 +1103,8 @@ func (tpn *TestProcessorNode) InitializeProcessors(gasMap map[string]map[string]
 			tpn.NodeKeys.MainKey.Sk,
 			tpn.MainMessenger.ID(),
 		),
+		&p2pmocks.NetworkShardingCollectorStub{},
+		config.ConsensusSignaturesForwardingConfig{},
 	)
 	tpn.setGenesisBlock()
 	tpn.initNode()
@@ -2363,21 +2367,23 @@ func (tpn *TestProcessorNode) initBlockProcessor() {
 				EnableEpochsHandler:           tpn.EnableEpochsHandler,
 				ExecutionOrderHandler:         tpn.TxExecutionOrderHandler,
 			},
-			StakingDataProvider:   stakingDataProvider,
-			RewardsHandler:        tpn.EconomicsData,
-			EconomicsDataProvider: economicsDataProvider,
+			StakingDataProvider:     stakingDataProvider,
+			RewardsHandler:          tpn.EconomicsData,
+			EconomicsDataProvider:   economicsDataProvider,
+			ValidatorRewardsStorage: CreateMemUnit(),
 		}
 		epochStartRewards, _ := metachain.NewRewardsCreatorProxy(argsEpochRewards)
 
 		validatorInfoStorage, _ := tpn.Storage.GetStorer(dataRetriever.UnsignedTransactionUnit)
 		argsEpochValidatorInfo := metachain.ArgsNewValidatorInfoCreator{
-			ShardCoordinator:     tpn.ShardCoordinator,
-			ValidatorInfoStorage: validatorInfoStorage,
-			MiniBlockStorage:     miniBlockStorage,
-			Hasher:               TestHasher,
-			Marshalizer:          TestMarshalizer,
-			DataPool:             tpn.DataPool,
-			EnableEpochsHandler:  tpn.EnableEpochsHandler,
+			ShardCoordinator:        tpn.ShardCoordinator,
+			ValidatorInfoStorage:    validatorInfoStorage,
+			MiniBlockStorage:        miniBlockStorage,
+			ValidatorRatingsStorage: CreateMemUnit(),
+			Hasher:                  TestHasher,
+			Marshalizer:             TestMarshalizer,
+			DataPool:                tpn.DataPool,
+			EnableEpochsHandler:     tpn.EnableEpochsHandler,
 		}
 		epochStartValidatorInfo, _ := metachain.NewValidatorInfoCreator(argsEpochValidatorInfo)
 
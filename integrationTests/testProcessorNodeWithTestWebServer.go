@@ -220,6 +220,14 @@ func createFacadeComponents(tpn *TestProcessorNode) nodeFacade.ApiResolver {
 	delegatedListHandler, err := factory.NewDelegatedListProcessorFactory().CreateDelegatedListProcessorHandler(args)
 	log.LogIfError(err)
 
+	argsGovernance := trieIterators.ArgGovernanceProcessor{
+		ArgTrieIteratorProcessor: args,
+		Marshaller:               TestMarshalizer,
+		EpochNotifier:            tpn.EpochNotifier,
+	}
+	governanceHandler, err := factory.NewGovernanceProcessorFactory().CreateGovernanceProcessorHandler(argsGovernance)
+	log.LogIfError(err)
+
 	logsFacade := &testscommon.LogsFacadeStub{}
 	receiptsRepository := &testscommon.ReceiptsRepositoryStub{}
 
@@ -272,6 +280,7 @@ func createFacadeComponents(tpn *TestProcessorNode) nodeFacade.ApiResolver {
 		TotalStakedValueHandler:  totalStakedValueHandler,
 		DirectStakedListHandler:  directStakedListHandler,
 		DelegatedListHandler:     delegatedListHandler,
+		GovernanceHandler:        governanceHandler,
 		APITransactionHandler:    apiTransactionHandler,
 		APIBlockHandler:          blockAPIHandler,
 		APIInternalBlockHandler:  apiInternalBlockProcessor,
@@ -279,6 +288,9 @@ func createFacadeComponents(tpn *TestProcessorNode) nodeFacade.ApiResolver {
 		ValidatorPubKeyConverter: &testscommon.PubkeyConverterMock{},
 		AccountsParser:           &genesisMocks.AccountsParserStub{},
 		GasScheduleNotifier:      &testscommon.GasScheduleNotifierMock{},
+		GasPriceSuggester:        &testscommon.GasPriceSuggesterStub{},
+		TrieStatisticsProvider:   &testscommon.TrieStatisticsProviderStub{},
+		OutportReindexer:         &testscommon.OutportReindexerStub{},
 		ManagedPeersMonitor:      &testscommon.ManagedPeersMonitorStub{},
 		NodesCoordinator:         tpn.NodesCoordinator,
 	}
@@ -133,13 +133,14 @@ func createValidatorInfoCreator(
 	mbStorer, _ := dataComponents.StorageService().GetStorer(dataRetriever.MiniBlockUnit)
 
 	args := metachain.ArgsNewValidatorInfoCreator{
-		ShardCoordinator:     shardCoordinator,
-		MiniBlockStorage:     mbStorer,
-		Hasher:               coreComponents.Hasher(),
-		Marshalizer:          coreComponents.InternalMarshalizer(),
-		DataPool:             dataComponents.Datapool(),
-		EnableEpochsHandler:  coreComponents.EnableEpochsHandler(),
-		ValidatorInfoStorage: integrationtests.CreateMemUnit(),
+		ShardCoordinator:        shardCoordinator,
+		MiniBlockStorage:        mbStorer,
+		Hasher:                  coreComponents.Hasher(),
+		Marshalizer:             coreComponents.InternalMarshalizer(),
+		DataPool:                dataComponents.Datapool(),
+		EnableEpochsHandler:     coreComponents.EnableEpochsHandler(),
+		ValidatorInfoStorage:    integrationtests.CreateMemUnit(),
+		ValidatorRatingsStorage: integrationtests.CreateMemUnit(),
 	}
 
 	valInfoCreator, _ := metachain.NewValidatorInfoCreator(args)
@@ -23,6 +23,7 @@ import (
 	processing "github.com/multiversx/mx-chain-go/process"
 	"github.com/multiversx/mx-chain-go/process/rating"
 	mxChainSharding "github.com/multiversx/mx-chain-go/sharding"
+	"github.com/multiversx/mx-chain-go/state/stateExport"
 	"github.com/multiversx/mx-chain-go/testscommon/sovereign"
 
 	"github.com/multiversx/mx-chain-core-go/core"
@@ -59,6 +60,7 @@ type ArgsChainSimulator struct {
 	MetaChainConsensusGroupSize    uint32
 	NumNodesWaitingListShard       uint32
 	NumNodesWaitingListMeta        uint32
+	InitialStateFilePath           string
 	GenesisTimestamp               int64
 	InitialRound                   int64
 	InitialEpoch                   uint32
@@ -106,6 +108,14 @@ func NewChainSimulator(args ArgsChainSimulator) (*simulator, error) {
 		return nil, err
 	}
 
+	if len(args.InitialStateFilePath) > 0 {
+		err = instance.LoadStateFromFile(args.InitialStateFilePath)
+		if err != nil {
+			instance.Close()
+			return nil, err
+		}
+	}
+
 	return instance, nil
 }
 
@@ -531,6 +541,28 @@ func (s *simulator) SetStateMultiple(stateSlice []*dtos.AddressState) error {
 	return nil
 }
 
+// LoadStateFromFile will set the state of all the accounts found in a state export file, as written by the stateexport tool.
+// The simulator runs with its own validators, so an export holding validators (a metachain export) is rejected
+func (s *simulator) LoadStateFromFile(filePath string) error {
+	exportedState, err := stateExport.LoadExportedState(filePath)
+	if err != nil {
+		return err
+	}
+	if len(exportedState.Validators) > 0 {
+		return fmt.Errorf("%w: the state export file %s holds %d validators, remove them to load only the accounts",
+			stateExport.ErrValidatorsCanNotBeLoaded, filePath, len(exportedState.Validators))
+	}
+
+	log.Info("loading the exported state",
+		"file", filePath,
+		"shard", exportedState.Header.ShardID,
+		"nonce", exportedState.Header.Nonce,
+		"num accounts", len(exportedState.Accounts),
+	)
+
+	return s.SetStateMultiple(exportedState.Accounts)
+}
+
 // RemoveAccounts will try to remove all accounts data for the addresses provided
 func (s *simulator) RemoveAccounts(addresses []string) error {
 	s.mutex.Lock()
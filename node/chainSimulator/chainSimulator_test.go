@@ -1,7 +1,11 @@
 package chainSimulator
 
 import (
+	"encoding/json"
+	"errors"
 	"math/big"
+	"os"
+	"path/filepath"
 	"testing"
 	"time"
 
@@ -9,6 +13,7 @@ import (
 	chainSimulatorCommon "github.com/multiversx/mx-chain-go/integrationTests/chainSimulator"
 	"github.com/multiversx/mx-chain-go/node/chainSimulator/components/api"
 	"github.com/multiversx/mx-chain-go/node/chainSimulator/dtos"
+	"github.com/multiversx/mx-chain-go/state/stateExport"
 
 	"github.com/multiversx/mx-chain-core-go/core"
 	"github.com/stretchr/testify/assert"
@@ -234,6 +239,108 @@ func TestChainSimulator_SetEntireState(t *testing.T) {
 	chainSimulatorCommon.CheckSetEntireState(t, chainSimulator, chainSimulator.GetNodeHandler(1), accountState)
 }
 
+func TestChainSimulator_LoadStateFromFile(t *testing.T) {
+	if testing.Short() {
+		t.Skip("this is not a short test")
+	}
+
+	address := "erd1qtc600lryvytxuy4h7vn7xmsy5tw6vuw3tskr75cwnmv4mnyjgsq6e5zgj"
+	nonce := uint64(37)
+	exportedState := &stateExport.ExportedState{
+		Header: stateExport.ExportHeader{
+			Nonce: 1000,
+		},
+		Accounts: []*dtos.AddressState{
+			{
+				Address: address,
+				Nonce:   &nonce,
+				Balance: "431271308732096033771131",
+				Keys: map[string]string{
+					"01": "02",
+				},
+			},
+		},
+	}
+	exportedStateBytes, err := json.Marshal(exportedState)
+	require.Nil(t, err)
+	stateFilePath := filepath.Join(t.TempDir(), "state.json")
+	require.Nil(t, os.WriteFile(stateFilePath, exportedStateBytes, 0644))
+
+	startTime := time.Now().Unix()
+	roundDurationInMillis := uint64(6000)
+	chainSimulator, err := NewChainSimulator(ArgsChainSimulator{
+		BypassTxSignatureCheck:      false,
+		TempDir:                     t.TempDir(),
+		PathToInitialConfig:         defaultPathToInitialConfig,
+		NumOfShards:                 3,
+		InitialStateFilePath:        stateFilePath,
+		GenesisTimestamp:            startTime,
+		RoundDurationInMillis:       roundDurationInMillis,
+		RoundsPerEpoch:              core.OptionalUint64{},
+		ApiInterface:                api.NewNoApiInterface(),
+		MinNodesPerShard:            1,
+		MetaChainMinNodes:           1,
+		ConsensusGroupSize:          1,
+		MetaChainConsensusGroupSize: 1,
+	})
+	require.Nil(t, err)
+	require.NotNil(t, chainSimulator)
+
+	defer chainSimulator.Close()
+
+	err = chainSimulator.GenerateBlocks(1)
+	require.Nil(t, err)
+
+	addressBytes, err := chainSimulator.GetNodeHandler(0).GetCoreComponents().AddressPubKeyConverter().Decode(address)
+	require.Nil(t, err)
+	account, err := chainSimulator.GetAccount(dtos.WalletAddress{Bech32: address, Bytes: addressBytes})
+	require.Nil(t, err)
+	require.Equal(t, "431271308732096033771131", account.Balance)
+	require.Equal(t, nonce, account.Nonce)
+}
+
+func TestChainSimulator_LoadStateFromFileWithValidatorsShouldErr(t *testing.T) {
+	if testing.Short() {
+		t.Skip("this is not a short test")
+	}
+
+	exportedState := &stateExport.ExportedState{
+		Header: stateExport.ExportHeader{
+			ShardID: core.MetachainShardId,
+			Nonce:   1000,
+		},
+		Validators: []*stateExport.ExportedValidator{
+			{
+				PubKey:  "b0b6349b3f693e08c433970d10efb2fe943eac4057a945146bee5fd163687f4e1800d541aa0f11bf9e4cb6552f512e126068e68eb471d18fcc477ddfe0b9b3334f34e30d8b7b2c08f914f4ae54a6bb2b43a5d5e0b0ab6227e1c7f6c8c4dbb9f5",
+				Address: "erd1qtc600lryvytxuy4h7vn7xmsy5tw6vuw3tskr75cwnmv4mnyjgsq6e5zgj",
+				List:    "eligible",
+			},
+		},
+	}
+	exportedStateBytes, err := json.Marshal(exportedState)
+	require.Nil(t, err)
+	stateFilePath := filepath.Join(t.TempDir(), "state.json")
+	require.Nil(t, os.WriteFile(stateFilePath, exportedStateBytes, 0644))
+
+	chainSimulator, err := NewChainSimulator(ArgsChainSimulator{
+		BypassTxSignatureCheck:      false,
+		TempDir:                     t.TempDir(),
+		PathToInitialConfig:         defaultPathToInitialConfig,
+		NumOfShards:                 3,
+		InitialStateFilePath:        stateFilePath,
+		GenesisTimestamp:            time.Now().Unix(),
+		RoundDurationInMillis:       uint64(6000),
+		RoundsPerEpoch:              core.OptionalUint64{},
+		ApiInterface:                api.NewNoApiInterface(),
+		MinNodesPerShard:            1,
+		MetaChainMinNodes:           1,
+		ConsensusGroupSize:          1,
+		MetaChainConsensusGroupSize: 1,
+	})
+	require.True(t, errors.Is(err, stateExport.ErrValidatorsCanNotBeLoaded))
+	require.Nil(t, chainSimulator)
+}
+
 func TestChainSimulator_SetEntireStateWithRemoval(t *testing.T) {
 	if testing.Short() {
 		t.Skip("this is not a short test")
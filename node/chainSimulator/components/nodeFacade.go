@@ -68,6 +68,7 @@ func (node *testOnlyProcessingNode) createFacade(configs config.Configs, apiInte
 		DelegatedListFactoryHandler:    factory.NewDelegatedListProcessorFactory(),
 		DirectStakedListFactoryHandler: factory.NewDirectStakedListProcessorFactory(),
 		TotalStakedValueFactoryHandler: factory.NewTotalStakedListProcessorFactory(),
+		GovernanceFactoryHandler:       factory.NewGovernanceProcessorFactory(),
 	}
 
 	apiResolver, err := apiComp.CreateApiResolver(apiResolverArgs)
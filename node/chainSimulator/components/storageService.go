@@ -31,6 +31,8 @@ func CreateStore(numOfShards uint32) dataRetriever.StorageService {
 	store.AddStorer(dataRetriever.TrieEpochRootHashUnit, CreateMemUnit())
 	store.AddStorer(dataRetriever.ExtendedShardHeadersUnit, CreateMemUnit())
 	store.AddStorer(dataRetriever.ExtendedShardHeadersNonceHashDataUnit, CreateMemUnit())
+	store.AddStorer(dataRetriever.ValidatorRewardsUnit, CreateMemUnit())
+	store.AddStorer(dataRetriever.ValidatorRatingsUnit, CreateMemUnit())
 
 	for i := uint32(0); i < numOfShards; i++ {
 		hdrNonceHashDataUnit := dataRetriever.ShardHdrNonceHashDataUnit + dataRetriever.UnitType(i)
@@ -40,6 +40,8 @@ func TestCreateStore(t *testing.T) {
 		dataRetriever.ShardHdrNonceHashDataUnit,
 		dataRetriever.ExtendedShardHeadersUnit,
 		dataRetriever.ExtendedShardHeadersNonceHashDataUnit,
+		dataRetriever.ValidatorRewardsUnit,
+		dataRetriever.ValidatorRatingsUnit,
 		dataRetriever.UnitType(101), // shard 2
 	}
 
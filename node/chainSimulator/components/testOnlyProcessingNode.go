@@ -256,7 +256,7 @@ func NewTestOnlyProcessingNode(args ArgsTestOnlyProcessingNode) (*testOnlyProces
 		return nil, err
 	}
 
-	err = instance.createBroadcastMessenger()
+	err = instance.createBroadcastMessenger(args.Configs.GeneralConfig.Consensus.SignaturesForwarding)
 	if err != nil {
 		return nil, err
 	}
@@ -354,7 +354,7 @@ func (node *testOnlyProcessingNode) createNodesCoordinator(pref config.Preferenc
 	return nil
 }
 
-func (node *testOnlyProcessingNode) createBroadcastMessenger() error {
+func (node *testOnlyProcessingNode) createBroadcastMessenger(signaturesForwarding config.ConsensusSignaturesForwardingConfig) error {
 	broadcastMessenger, err := sposFactory.GetBroadcastMessenger(
 		node.CoreComponentsHolder.InternalMarshalizer(),
 		node.CoreComponentsHolder.Hasher(),
@@ -365,6 +365,8 @@ func (node *testOnlyProcessingNode) createBroadcastMessenger() error {
 		node.ProcessComponentsHolder.InterceptorsContainer(),
 		node.CoreComponentsHolder.AlarmScheduler(),
 		node.CryptoComponentsHolder.KeysHandler(),
+		node.ProcessComponentsHolder.PeerShardMapper(),
+		signaturesForwarding,
 	)
 	if err != nil {
 		return err
@@ -40,6 +40,9 @@ var ErrNilRequestedItemsHandler = errors.New("nil requested items handler")
 // ErrSystemBusyGeneratingTransactions signals that to many transactions are trying to get generated
 var ErrSystemBusyGeneratingTransactions = errors.New("system busy while generating bulk transactions")
 
+// ErrNilRoundTimelineRecorder signals that a nil round timeline recorder has been provided
+var ErrNilRoundTimelineRecorder = errors.New("nil round timeline recorder")
+
 // ErrInvalidValue signals that an invalid value has been provided such as NaN to an integer field
 var ErrInvalidValue = errors.New("invalid value")
 
@@ -141,3 +144,9 @@ var ErrNilCreateTransactionArgs = errors.New("nil args for create transaction")
 
 // ErrNilNodeRunner signals that a nil node runner was provided
 var ErrNilNodeRunner = errors.New("nil node runner")
+
+// ErrInvalidValidatorPubKey signals that an invalid validator public key has been provided
+var ErrInvalidValidatorPubKey = errors.New("invalid validator public key")
+
+// ErrInvalidEpochsRange signals that an invalid range of epochs has been provided
+var ErrInvalidEpochsRange = errors.New("invalid epochs range")
@@ -41,8 +41,20 @@ var ErrNilAccountsParser = errors.New("nil accounts parser")
 // ErrNilGasScheduler signals that a nil gas scheduler has been provided
 var ErrNilGasScheduler = errors.New("nil gas scheduler")
 
+// ErrNilTrieStatisticsHandler signals that a nil trie statistics handler has been provided
+var ErrNilTrieStatisticsHandler = errors.New("nil trie statistics handler")
+
+// ErrNilGasPriceSuggestionHandler signals that a nil gas price suggestion handler has been provided
+var ErrNilGasPriceSuggestionHandler = errors.New("nil gas price suggestion handler")
+
 // ErrNilManagedPeersMonitor signals that a nil managed peers monitor has been provided
 var ErrNilManagedPeersMonitor = errors.New("nil managed peers monitor")
 
 // ErrNilNodesCoordinator signals a nil nodes coordinator has been provided
 var ErrNilNodesCoordinator = errors.New("nil nodes coordinator")
+
+// ErrNilOutportReindexer signals that a nil outport re-indexer has been provided
+var ErrNilOutportReindexer = errors.New("nil outport re-indexer")
+
+// ErrNilGovernanceHandler signals that a nil governance handler has been provided
+var ErrNilGovernanceHandler = errors.New("nil governance handler")
@@ -0,0 +1,13 @@
+package gasPriceAPI
+
+import "errors"
+
+var errNilTxPool = errors.New("nil transactions pool")
+var errNilChainHandler = errors.New("nil chain handler")
+var errNilAPIBlockHandler = errors.New("nil api block handler")
+var errNilEconomicsHandler = errors.New("nil economics handler")
+var errNilShardCoordinator = errors.New("nil shard coordinator")
+var errInvalidTxPoolCapacity = errors.New("invalid transactions pool capacity")
+var errInvalidRoundDuration = errors.New("invalid round duration")
+var errInvalidNumRecentBlocks = errors.New("invalid number of recent blocks")
+var errNoBlockAvailable = errors.New("no block available")
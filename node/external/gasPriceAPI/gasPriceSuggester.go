@@ -0,0 +1,380 @@
+package gasPriceAPI
+
+import (
+	"encoding/hex"
+	"math"
+	"sort"
+	"sync"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-core-go/data"
+	"github.com/multiversx/mx-chain-core-go/data/api"
+	"github.com/multiversx/mx-chain-core-go/data/block"
+	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/dataRetriever"
+	"github.com/multiversx/mx-chain-go/process"
+	"github.com/multiversx/mx-chain-go/sharding"
+	logger "github.com/multiversx/mx-chain-logger-go"
+)
+
+var log = logger.GetOrCreate("node/external/gasPriceAPI")
+
+const (
+	// congestionThreshold is the pool occupancy or block fullness ratio above which the gas prices of the recently
+	// included transactions are taken into account. Below it, the minimum gas price is enough for a timely inclusion
+	congestionThreshold = 0.5
+	slowPercentile      = 25
+	normalPercentile    = 50
+	fastPercentile      = 90
+)
+
+// ArgsGasPriceSuggester holds the arguments needed to create a gas price suggester
+type ArgsGasPriceSuggester struct {
+	TxPool            dataRetriever.ShardedDataCacherNotifier
+	TxPoolCapacity    uint32
+	ChainHandler      data.ChainHandler
+	APIBlockHandler   apiBlockHandler
+	EconomicsHandler  economicsHandler
+	ShardCoordinator  sharding.Coordinator
+	RoundDurationInMs uint64
+	NumRecentBlocks   uint32
+}
+
+type recentBlocksStatistics struct {
+	lastNonce          uint64
+	lastHeaderHash     string
+	numBlocks          int
+	averageFullness    float64
+	averageTxsPerBlock float64
+	sortedGasPrices    []uint64
+}
+
+// blockGasData holds the gas related data of a committed block, kept so that each block is fetched only once
+type blockGasData struct {
+	hash        string
+	gasConsumed uint64
+	gasPrices   []uint64
+}
+
+type gasPriceSuggester struct {
+	txPool            dataRetriever.ShardedDataCacherNotifier
+	txPoolCapacity    uint32
+	chainHandler      data.ChainHandler
+	apiBlockHandler   apiBlockHandler
+	economicsHandler  economicsHandler
+	shardCoordinator  sharding.Coordinator
+	roundDurationInMs uint64
+	numRecentBlocks   uint32
+
+	mutStatistics sync.RWMutex
+	statistics    *recentBlocksStatistics
+	blocksGasData map[uint64]*blockGasData
+}
+
+// NewGasPriceSuggester creates a component able to suggest gas prices based on the pool pressure and on the recent blocks
+func NewGasPriceSuggester(args ArgsGasPriceSuggester) (*gasPriceSuggester, error) {
+	err := checkArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	return &gasPriceSuggester{
+		txPool:            args.TxPool,
+		txPoolCapacity:    args.TxPoolCapacity,
+		chainHandler:      args.ChainHandler,
+		apiBlockHandler:   args.APIBlockHandler,
+		economicsHandler:  args.EconomicsHandler,
+		shardCoordinator:  args.ShardCoordinator,
+		roundDurationInMs: args.RoundDurationInMs,
+		numRecentBlocks:   args.NumRecentBlocks,
+		blocksGasData:     make(map[uint64]*blockGasData),
+	}, nil
+}
+
+func checkArgs(args ArgsGasPriceSuggester) error {
+	if check.IfNil(args.TxPool) {
+		return errNilTxPool
+	}
+	if args.TxPoolCapacity == 0 {
+		return errInvalidTxPoolCapacity
+	}
+	if check.IfNil(args.ChainHandler) {
+		return errNilChainHandler
+	}
+	if check.IfNil(args.APIBlockHandler) {
+		return errNilAPIBlockHandler
+	}
+	if check.IfNil(args.EconomicsHandler) {
+		return errNilEconomicsHandler
+	}
+	if check.IfNil(args.ShardCoordinator) {
+		return errNilShardCoordinator
+	}
+	if args.RoundDurationInMs == 0 {
+		return errInvalidRoundDuration
+	}
+	if args.NumRecentBlocks == 0 {
+		return errInvalidNumRecentBlocks
+	}
+
+	return nil
+}
+
+// GetGasPriceSuggestion returns the slow, normal and fast gas price suggestions for the transactions sent from the
+// self shard, along with the statistics they were computed from
+func (gps *gasPriceSuggester) GetGasPriceSuggestion() (*common.GasPriceSuggestionAPIResponse, error) {
+	lastNonce := uint64(0)
+	currentHeader := gps.chainHandler.GetCurrentBlockHeader()
+	if !check.IfNil(currentHeader) {
+		lastNonce = currentHeader.GetNonce()
+	}
+	lastHeaderHash := hex.EncodeToString(gps.chainHandler.GetCurrentBlockHeaderHash())
+
+	statistics := gps.getRecentBlocksStatistics(lastNonce, lastHeaderHash)
+
+	selfShardID := gps.shardCoordinator.SelfId()
+	selfCacheID := process.ShardCacherIdentifier(selfShardID, selfShardID)
+	countsPerCache := gps.getCountsPerCache(selfCacheID)
+	pendingTxs := int(countsPerCache[selfCacheID])
+	occupancyPerCache := gps.computeOccupancyPerCache(countsPerCache)
+	poolOccupancy := occupancyPerCache[selfCacheID]
+
+	minGasPrice := gps.economicsHandler.MinGasPrice()
+	slowGasPrice, normalGasPrice, fastGasPrice := minGasPrice, minGasPrice, minGasPrice
+	congestion := math.Max(statistics.averageFullness, poolOccupancy)
+	if congestion >= congestionThreshold && len(statistics.sortedGasPrices) > 0 {
+		slowGasPrice = computePercentile(statistics.sortedGasPrices, slowPercentile, minGasPrice)
+		normalGasPrice = computePercentile(statistics.sortedGasPrices, normalPercentile, minGasPrice)
+		fastGasPrice = computePercentile(statistics.sortedGasPrices, fastPercentile, minGasPrice)
+	}
+
+	backlogRounds := computeBacklogRounds(pendingTxs, statistics.averageTxsPerBlock)
+
+	return &common.GasPriceSuggestionAPIResponse{
+		ShardID:               selfShardID,
+		MinGasPrice:           minGasPrice,
+		Slow:                  gps.createSuggestion(slowGasPrice, backlogRounds),
+		Normal:                gps.createSuggestion(normalGasPrice, ceilDivision(backlogRounds, 2)),
+		Fast:                  gps.createSuggestion(fastGasPrice, 1),
+		BlocksAnalyzed:        statistics.numBlocks,
+		AverageBlockFullness:  statistics.averageFullness,
+		PoolOccupancy:         poolOccupancy,
+		PoolOccupancyPerCache: occupancyPerCache,
+		PendingTransactions:   pendingTxs,
+		TotalPoolTransactions: gps.txPool.GetCounts().GetTotal(),
+		LastNonce:             lastNonce,
+	}, nil
+}
+
+// getCountsPerCache returns the number of transactions held by each of the pool caches. If the pool is not able to
+// provide them, only the self cache is reported
+func (gps *gasPriceSuggester) getCountsPerCache(selfCacheID string) map[string]int64 {
+	countsHandler, ok := gps.txPool.(txPoolCountsHandler)
+	if ok {
+		return countsHandler.GetCountsPerCacheID()
+	}
+
+	return map[string]int64{
+		selfCacheID: int64(gps.txPool.ShardDataStore(selfCacheID).Len()),
+	}
+}
+
+func (gps *gasPriceSuggester) computeOccupancyPerCache(countsPerCache map[string]int64) map[string]float64 {
+	occupancyPerCache := make(map[string]float64, len(countsPerCache))
+	for cacheID, count := range countsPerCache {
+		occupancyPerCache[cacheID] = math.Min(1, float64(count)/float64(gps.getCacheCapacity(cacheID)))
+	}
+
+	return occupancyPerCache
+}
+
+// getCacheCapacity mirrors the way the transactions pool splits its capacity: half of it is reserved for the
+// transactions sent from the self shard, while the other half is evenly split between the cross shard caches
+func (gps *gasPriceSuggester) getCacheCapacity(cacheID string) uint32 {
+	halfOfCapacity := core.MaxUint32(1, gps.txPoolCapacity/2)
+	if process.IsShardCacherIdentifierForSourceMe(cacheID, gps.shardCoordinator.SelfId()) {
+		return halfOfCapacity
+	}
+
+	numCrossTxCaches := core.MaxUint32(1, gps.shardCoordinator.NumberOfShards()-1)
+
+	return core.MaxUint32(1, halfOfCapacity/numCrossTxCaches)
+}
+
+func (gps *gasPriceSuggester) createSuggestion(gasPrice uint64, numRounds uint64) *common.GasPriceSuggestion {
+	return &common.GasPriceSuggestion{
+		GasPrice:                 gasPrice,
+		ExpectedInclusionRounds:  numRounds,
+		ExpectedInclusionDelayMs: numRounds * gps.roundDurationInMs,
+	}
+}
+
+// getRecentBlocksStatistics returns the statistics of the last blocks, recomputing them only when a new block was
+// committed. Only the blocks not analyzed before are fetched, and they are fetched outside the statistics lock
+func (gps *gasPriceSuggester) getRecentBlocksStatistics(lastNonce uint64, lastHeaderHash string) *recentBlocksStatistics {
+	gps.mutStatistics.RLock()
+	statistics := gps.statistics
+	if statistics != nil && statistics.lastNonce == lastNonce && statistics.lastHeaderHash == lastHeaderHash {
+		gps.mutStatistics.RUnlock()
+		return statistics
+	}
+	missingNonces := gps.getMissingNonces(lastNonce, lastHeaderHash)
+	gps.mutStatistics.RUnlock()
+
+	fetchedBlocksGasData := gps.fetchBlocksGasData(missingNonces)
+
+	gps.mutStatistics.Lock()
+	defer gps.mutStatistics.Unlock()
+
+	for nonce, gasData := range fetchedBlocksGasData {
+		gps.blocksGasData[nonce] = gasData
+	}
+	gps.pruneBlocksGasData(lastNonce)
+	gps.statistics = gps.computeRecentBlocksStatistics(lastNonce, lastHeaderHash)
+
+	return gps.statistics
+}
+
+// getMissingNonces returns, in descending order, the nonces of the recent blocks that were not analyzed yet. The last
+// block is fetched again if it was replaced by a block with the same nonce
+func (gps *gasPriceSuggester) getMissingNonces(lastNonce uint64, lastHeaderHash string) []uint64 {
+	missingNonces := make([]uint64, 0)
+	numBlocks := 0
+	for nonce := lastNonce; nonce > 0 && numBlocks < int(gps.numRecentBlocks); nonce-- {
+		numBlocks++
+
+		gasData, found := gps.blocksGasData[nonce]
+		isReplaced := found && nonce == lastNonce && gasData.hash != lastHeaderHash
+		if !found || isReplaced {
+			missingNonces = append(missingNonces, nonce)
+		}
+	}
+
+	return missingNonces
+}
+
+// fetchBlocksGasData fetches the provided blocks, stopping at the first block that cannot be fetched
+func (gps *gasPriceSuggester) fetchBlocksGasData(nonces []uint64) map[uint64]*blockGasData {
+	fetchedBlocksGasData := make(map[uint64]*blockGasData, len(nonces))
+	options := api.BlockQueryOptions{WithTransactions: true}
+	for _, nonce := range nonces {
+		apiBlock, err := gps.apiBlockHandler.GetBlockByNonce(nonce, options)
+		if err != nil {
+			log.Debug("gasPriceSuggester.fetchBlocksGasData: cannot get block", "nonce", nonce, "error", err)
+			break
+		}
+
+		fetchedBlocksGasData[nonce] = extractBlockGasData(apiBlock)
+	}
+
+	return fetchedBlocksGasData
+}
+
+func extractBlockGasData(apiBlock *api.Block) *blockGasData {
+	gasData := &blockGasData{
+		hash:      apiBlock.Hash,
+		gasPrices: make([]uint64, 0),
+	}
+
+	for _, miniBlock := range apiBlock.MiniBlocks {
+		if miniBlock.Type != block.TxBlock.String() {
+			continue
+		}
+
+		for _, tx := range miniBlock.Transactions {
+			gasData.gasConsumed += getGasConsumed(tx.GasUsed, tx.GasLimit)
+			gasData.gasPrices = append(gasData.gasPrices, tx.GasPrice)
+		}
+	}
+
+	return gasData
+}
+
+// pruneBlocksGasData removes the blocks that are no longer among the recent ones, including the reverted ones
+func (gps *gasPriceSuggester) pruneBlocksGasData(lastNonce uint64) {
+	for nonce := range gps.blocksGasData {
+		isReverted := nonce > lastNonce
+		isTooOld := nonce+uint64(gps.numRecentBlocks) <= lastNonce
+		if isReverted || isTooOld {
+			delete(gps.blocksGasData, nonce)
+		}
+	}
+}
+
+// computeRecentBlocksStatistics aggregates the cached data of the last blocks, stopping at the first missing block
+func (gps *gasPriceSuggester) computeRecentBlocksStatistics(lastNonce uint64, lastHeaderHash string) *recentBlocksStatistics {
+	statistics := &recentBlocksStatistics{
+		lastNonce:       lastNonce,
+		lastHeaderHash:  lastHeaderHash,
+		sortedGasPrices: make([]uint64, 0),
+	}
+
+	maxGasLimitPerBlock := gps.economicsHandler.MaxGasLimitPerBlock(gps.shardCoordinator.SelfId())
+	sumFullness := float64(0)
+	numTxs := 0
+	for nonce := lastNonce; nonce > 0 && statistics.numBlocks < int(gps.numRecentBlocks); nonce-- {
+		gasData, found := gps.blocksGasData[nonce]
+		if !found {
+			break
+		}
+
+		statistics.sortedGasPrices = append(statistics.sortedGasPrices, gasData.gasPrices...)
+		numTxs += len(gasData.gasPrices)
+		if maxGasLimitPerBlock > 0 {
+			sumFullness += math.Min(1, float64(gasData.gasConsumed)/float64(maxGasLimitPerBlock))
+		}
+		statistics.numBlocks++
+	}
+
+	if statistics.numBlocks > 0 {
+		statistics.averageFullness = sumFullness / float64(statistics.numBlocks)
+		statistics.averageTxsPerBlock = float64(numTxs) / float64(statistics.numBlocks)
+	}
+
+	sort.Slice(statistics.sortedGasPrices, func(i, j int) bool {
+		return statistics.sortedGasPrices[i] < statistics.sortedGasPrices[j]
+	})
+
+	return statistics
+}
+
+func getGasConsumed(gasUsed uint64, gasLimit uint64) uint64 {
+	if gasUsed > 0 {
+		return gasUsed
+	}
+
+	return gasLimit
+}
+
+func computePercentile(sortedValues []uint64, percentile int, minValue uint64) uint64 {
+	index := (len(sortedValues) - 1) * percentile / 100
+	if sortedValues[index] < minValue {
+		return minValue
+	}
+
+	return sortedValues[index]
+}
+
+// computeBacklogRounds returns the number of rounds needed to include all the pending transactions, at the recent pace
+func computeBacklogRounds(pendingTxs int, averageTxsPerBlock float64) uint64 {
+	if pendingTxs == 0 || averageTxsPerBlock == 0 {
+		return 1
+	}
+
+	numRounds := uint64(math.Ceil(float64(pendingTxs) / averageTxsPerBlock))
+	if numRounds == 0 {
+		return 1
+	}
+
+	return numRounds
+}
+
+func ceilDivision(value uint64, divisor uint64) uint64 {
+	return (value + divisor - 1) / divisor
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (gps *gasPriceSuggester) IsInterfaceNil() bool {
+	return gps == nil
+}
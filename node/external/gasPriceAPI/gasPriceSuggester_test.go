@@ -0,0 +1,391 @@
+package gasPriceAPI
+
+import (
+	"encoding/hex"
+	"errors"
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-core-go/data"
+	"github.com/multiversx/mx-chain-core-go/data/api"
+	"github.com/multiversx/mx-chain-core-go/data/block"
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	"github.com/multiversx/mx-chain-go/node/mock"
+	"github.com/multiversx/mx-chain-go/storage"
+	"github.com/multiversx/mx-chain-go/testscommon"
+	"github.com/multiversx/mx-chain-go/testscommon/economicsmocks"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	minGasPrice         = uint64(1000)
+	maxGasLimitPerBlock = uint64(1000000)
+	roundDurationInMs   = uint64(6000)
+)
+
+func createMockArgsGasPriceSuggester() ArgsGasPriceSuggester {
+	return ArgsGasPriceSuggester{
+		TxPool: &testscommon.ShardedDataStub{
+			ShardDataStoreCalled: func(cacheID string) storage.Cacher {
+				return &testscommon.CacherStub{}
+			},
+		},
+		TxPoolCapacity: 100,
+		ChainHandler: &testscommon.ChainHandlerStub{
+			GetCurrentBlockHeaderCalled: func() data.HeaderHandler {
+				return &block.Header{Nonce: 10}
+			},
+		},
+		APIBlockHandler: &mock.BlockAPIHandlerStub{},
+		EconomicsHandler: &economicsmocks.EconomicsHandlerStub{
+			MinGasPriceCalled: func() uint64 {
+				return minGasPrice
+			},
+			MaxGasLimitPerBlockCalled: func(shardID uint32) uint64 {
+				return maxGasLimitPerBlock
+			},
+		},
+		ShardCoordinator:  testscommon.NewMultiShardsCoordinatorMock(3),
+		RoundDurationInMs: roundDurationInMs,
+		NumRecentBlocks:   3,
+	}
+}
+
+func createApiBlock(nonce uint64, gasPrices []uint64, gasLimitPerTx uint64) *api.Block {
+	txs := make([]*transaction.ApiTransactionResult, 0, len(gasPrices))
+	for _, gasPrice := range gasPrices {
+		txs = append(txs, &transaction.ApiTransactionResult{
+			GasPrice: gasPrice,
+			GasLimit: gasLimitPerTx,
+		})
+	}
+
+	return &api.Block{
+		Nonce: nonce,
+		MiniBlocks: []*api.MiniBlock{
+			{
+				Type:         block.TxBlock.String(),
+				Transactions: txs,
+			},
+			{
+				Type: block.SmartContractResultBlock.String(),
+				Transactions: []*transaction.ApiTransactionResult{
+					{GasPrice: 1, GasLimit: maxGasLimitPerBlock},
+				},
+			},
+		},
+	}
+}
+
+func TestNewGasPriceSuggester(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil tx pool should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsGasPriceSuggester()
+		args.TxPool = nil
+		suggester, err := NewGasPriceSuggester(args)
+		require.True(t, check.IfNil(suggester))
+		require.Equal(t, errNilTxPool, err)
+	})
+	t.Run("invalid tx pool capacity should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsGasPriceSuggester()
+		args.TxPoolCapacity = 0
+		suggester, err := NewGasPriceSuggester(args)
+		require.True(t, check.IfNil(suggester))
+		require.Equal(t, errInvalidTxPoolCapacity, err)
+	})
+	t.Run("nil chain handler should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsGasPriceSuggester()
+		args.ChainHandler = nil
+		suggester, err := NewGasPriceSuggester(args)
+		require.True(t, check.IfNil(suggester))
+		require.Equal(t, errNilChainHandler, err)
+	})
+	t.Run("nil api block handler should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsGasPriceSuggester()
+		args.APIBlockHandler = nil
+		suggester, err := NewGasPriceSuggester(args)
+		require.True(t, check.IfNil(suggester))
+		require.Equal(t, errNilAPIBlockHandler, err)
+	})
+	t.Run("nil economics handler should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsGasPriceSuggester()
+		args.EconomicsHandler = nil
+		suggester, err := NewGasPriceSuggester(args)
+		require.True(t, check.IfNil(suggester))
+		require.Equal(t, errNilEconomicsHandler, err)
+	})
+	t.Run("nil shard coordinator should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsGasPriceSuggester()
+		args.ShardCoordinator = nil
+		suggester, err := NewGasPriceSuggester(args)
+		require.True(t, check.IfNil(suggester))
+		require.Equal(t, errNilShardCoordinator, err)
+	})
+	t.Run("invalid round duration should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsGasPriceSuggester()
+		args.RoundDurationInMs = 0
+		suggester, err := NewGasPriceSuggester(args)
+		require.True(t, check.IfNil(suggester))
+		require.Equal(t, errInvalidRoundDuration, err)
+	})
+	t.Run("invalid number of recent blocks should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsGasPriceSuggester()
+		args.NumRecentBlocks = 0
+		suggester, err := NewGasPriceSuggester(args)
+		require.True(t, check.IfNil(suggester))
+		require.Equal(t, errInvalidNumRecentBlocks, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		suggester, err := NewGasPriceSuggester(createMockArgsGasPriceSuggester())
+		require.False(t, check.IfNil(suggester))
+		require.Nil(t, err)
+	})
+}
+
+func TestGasPriceSuggester_GetGasPriceSuggestion(t *testing.T) {
+	t.Parallel()
+
+	t.Run("no block committed should suggest the minimum gas price", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsGasPriceSuggester()
+		args.ChainHandler = &testscommon.ChainHandlerStub{}
+		args.APIBlockHandler = &mock.BlockAPIHandlerStub{
+			GetBlockByNonceCalled: func(nonce uint64, options api.BlockQueryOptions) (*api.Block, error) {
+				require.Fail(t, "should have not been called")
+				return nil, nil
+			},
+		}
+		suggester, _ := NewGasPriceSuggester(args)
+
+		suggestion, err := suggester.GetGasPriceSuggestion()
+		require.Nil(t, err)
+		require.Equal(t, uint64(0), suggestion.LastNonce)
+		require.Equal(t, 0, suggestion.BlocksAnalyzed)
+		require.Equal(t, minGasPrice, suggestion.Slow.GasPrice)
+		require.Equal(t, minGasPrice, suggestion.Normal.GasPrice)
+		require.Equal(t, minGasPrice, suggestion.Fast.GasPrice)
+		require.Equal(t, uint64(1), suggestion.Fast.ExpectedInclusionRounds)
+		require.Equal(t, roundDurationInMs, suggestion.Fast.ExpectedInclusionDelayMs)
+	})
+	t.Run("low congestion should suggest the minimum gas price", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsGasPriceSuggester()
+		args.APIBlockHandler = &mock.BlockAPIHandlerStub{
+			GetBlockByNonceCalled: func(nonce uint64, options api.BlockQueryOptions) (*api.Block, error) {
+				require.True(t, options.WithTransactions)
+				return createApiBlock(nonce, []uint64{5000, 6000}, 1000), nil
+			},
+		}
+		suggester, _ := NewGasPriceSuggester(args)
+
+		suggestion, err := suggester.GetGasPriceSuggestion()
+		require.Nil(t, err)
+		require.Equal(t, uint64(10), suggestion.LastNonce)
+		require.Equal(t, 3, suggestion.BlocksAnalyzed)
+		require.Equal(t, minGasPrice, suggestion.Slow.GasPrice)
+		require.Equal(t, minGasPrice, suggestion.Normal.GasPrice)
+		require.Equal(t, minGasPrice, suggestion.Fast.GasPrice)
+	})
+	t.Run("full blocks should suggest percentiles of the recent gas prices", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsGasPriceSuggester()
+		args.APIBlockHandler = &mock.BlockAPIHandlerStub{
+			GetBlockByNonceCalled: func(nonce uint64, options api.BlockQueryOptions) (*api.Block, error) {
+				gasPrices := []uint64{500, 1500, 2000, 2500, 3000}
+				return createApiBlock(nonce, gasPrices, maxGasLimitPerBlock/uint64(len(gasPrices))), nil
+			},
+		}
+		suggester, _ := NewGasPriceSuggester(args)
+
+		suggestion, err := suggester.GetGasPriceSuggestion()
+		require.Nil(t, err)
+		require.Equal(t, float64(1), suggestion.AverageBlockFullness)
+		require.Equal(t, uint64(1500), suggestion.Slow.GasPrice)
+		require.Equal(t, uint64(2000), suggestion.Normal.GasPrice)
+		require.Equal(t, uint64(3000), suggestion.Fast.GasPrice)
+	})
+	t.Run("full pool should suggest percentiles floored at the minimum gas price", func(t *testing.T) {
+		t.Parallel()
+
+		providedCacheID := ""
+		args := createMockArgsGasPriceSuggester()
+		args.TxPool = &testscommon.ShardedDataStub{
+			ShardDataStoreCalled: func(cacheID string) storage.Cacher {
+				providedCacheID = cacheID
+				return &testscommon.CacherStub{
+					LenCalled: func() int {
+						return 40
+					},
+				}
+			},
+		}
+		args.APIBlockHandler = &mock.BlockAPIHandlerStub{
+			GetBlockByNonceCalled: func(nonce uint64, options api.BlockQueryOptions) (*api.Block, error) {
+				return createApiBlock(nonce, []uint64{10, 20, 30, 40, 50, 60, 70, 80, 90, 5000}, 1000), nil
+			},
+		}
+		suggester, _ := NewGasPriceSuggester(args)
+
+		suggestion, err := suggester.GetGasPriceSuggestion()
+		require.Nil(t, err)
+		require.Equal(t, "0", providedCacheID)
+		require.Equal(t, 40, suggestion.PendingTransactions)
+		require.Equal(t, 0.8, suggestion.PoolOccupancy)
+		require.Equal(t, map[string]float64{"0": 0.8}, suggestion.PoolOccupancyPerCache)
+		require.Equal(t, minGasPrice, suggestion.Slow.GasPrice)
+		require.Equal(t, minGasPrice, suggestion.Normal.GasPrice)
+		require.Equal(t, minGasPrice, suggestion.Fast.GasPrice)
+
+		// 10 transactions per block and 40 pending transactions
+		require.Equal(t, uint64(4), suggestion.Slow.ExpectedInclusionRounds)
+		require.Equal(t, 4*roundDurationInMs, suggestion.Slow.ExpectedInclusionDelayMs)
+		require.Equal(t, uint64(2), suggestion.Normal.ExpectedInclusionRounds)
+		require.Equal(t, uint64(1), suggestion.Fast.ExpectedInclusionRounds)
+	})
+	t.Run("should compute the occupancy of each pool cache", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsGasPriceSuggester()
+		args.TxPool = &txPoolWithCountsStub{
+			ShardedDataStub: &testscommon.ShardedDataStub{},
+			countsPerCacheID: map[string]int64{
+				"0":   10,
+				"1_0": 5,
+				"2_0": 40,
+			},
+		}
+		args.APIBlockHandler = &mock.BlockAPIHandlerStub{
+			GetBlockByNonceCalled: func(nonce uint64, options api.BlockQueryOptions) (*api.Block, error) {
+				return createApiBlock(nonce, []uint64{5000}, 1000), nil
+			},
+		}
+		suggester, _ := NewGasPriceSuggester(args)
+
+		suggestion, err := suggester.GetGasPriceSuggestion()
+		require.Nil(t, err)
+		require.Equal(t, 10, suggestion.PendingTransactions)
+		require.Equal(t, 0.2, suggestion.PoolOccupancy)
+
+		// half of the capacity is reserved for the self cache, the other half being split between the 2 cross caches
+		expectedOccupancyPerCache := map[string]float64{
+			"0":   0.2,
+			"1_0": 0.2,
+			"2_0": 1,
+		}
+		require.Equal(t, expectedOccupancyPerCache, suggestion.PoolOccupancyPerCache)
+	})
+	t.Run("block fetching error should analyze only the fetched blocks", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsGasPriceSuggester()
+		args.APIBlockHandler = &mock.BlockAPIHandlerStub{
+			GetBlockByNonceCalled: func(nonce uint64, options api.BlockQueryOptions) (*api.Block, error) {
+				if nonce < 10 {
+					return nil, errors.New("block not found")
+				}
+
+				return createApiBlock(nonce, []uint64{5000}, 1000), nil
+			},
+		}
+		suggester, _ := NewGasPriceSuggester(args)
+
+		suggestion, err := suggester.GetGasPriceSuggestion()
+		require.Nil(t, err)
+		require.Equal(t, 1, suggestion.BlocksAnalyzed)
+	})
+	t.Run("should recompute the blocks statistics only on new blocks", func(t *testing.T) {
+		t.Parallel()
+
+		currentNonce := uint64(10)
+		numGetBlockCalls := 0
+		args := createMockArgsGasPriceSuggester()
+		args.ChainHandler = &testscommon.ChainHandlerStub{
+			GetCurrentBlockHeaderCalled: func() data.HeaderHandler {
+				return &block.Header{Nonce: currentNonce}
+			},
+		}
+		args.APIBlockHandler = &mock.BlockAPIHandlerStub{
+			GetBlockByNonceCalled: func(nonce uint64, options api.BlockQueryOptions) (*api.Block, error) {
+				numGetBlockCalls++
+				return createApiBlock(nonce, []uint64{5000}, 1000), nil
+			},
+		}
+		suggester, _ := NewGasPriceSuggester(args)
+
+		_, _ = suggester.GetGasPriceSuggestion()
+		_, _ = suggester.GetGasPriceSuggestion()
+		require.Equal(t, 3, numGetBlockCalls)
+
+		currentNonce++
+		suggestion, _ := suggester.GetGasPriceSuggestion()
+		require.Equal(t, 4, numGetBlockCalls)
+		require.Equal(t, uint64(11), suggestion.LastNonce)
+		require.Equal(t, 3, suggestion.BlocksAnalyzed)
+	})
+	t.Run("should fetch again the last block if it was replaced", func(t *testing.T) {
+		t.Parallel()
+
+		currentHash := []byte("hash A")
+		fetchedNonces := make([]uint64, 0)
+		args := createMockArgsGasPriceSuggester()
+		args.ChainHandler = &testscommon.ChainHandlerStub{
+			GetCurrentBlockHeaderCalled: func() data.HeaderHandler {
+				return &block.Header{Nonce: 10}
+			},
+			GetCurrentBlockHeaderHashCalled: func() []byte {
+				return currentHash
+			},
+		}
+		args.APIBlockHandler = &mock.BlockAPIHandlerStub{
+			GetBlockByNonceCalled: func(nonce uint64, options api.BlockQueryOptions) (*api.Block, error) {
+				fetchedNonces = append(fetchedNonces, nonce)
+				apiBlock := createApiBlock(nonce, []uint64{5000}, 1000)
+				if nonce == 10 {
+					apiBlock.Hash = hex.EncodeToString(currentHash)
+				}
+
+				return apiBlock, nil
+			},
+		}
+		suggester, _ := NewGasPriceSuggester(args)
+
+		_, _ = suggester.GetGasPriceSuggestion()
+		require.Equal(t, []uint64{10, 9, 8}, fetchedNonces)
+
+		currentHash = []byte("hash B")
+		suggestion, _ := suggester.GetGasPriceSuggestion()
+		require.Equal(t, []uint64{10, 9, 8, 10}, fetchedNonces)
+		require.Equal(t, 3, suggestion.BlocksAnalyzed)
+	})
+}
+
+type txPoolWithCountsStub struct {
+	*testscommon.ShardedDataStub
+	countsPerCacheID map[string]int64
+}
+
+func (stub *txPoolWithCountsStub) GetCountsPerCacheID() map[string]int64 {
+	return stub.countsPerCacheID
+}
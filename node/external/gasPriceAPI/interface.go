@@ -0,0 +1,20 @@
+package gasPriceAPI
+
+import (
+	"github.com/multiversx/mx-chain-core-go/data/api"
+)
+
+type economicsHandler interface {
+	MinGasPrice() uint64
+	MaxGasLimitPerBlock(shardID uint32) uint64
+	IsInterfaceNil() bool
+}
+
+type apiBlockHandler interface {
+	GetBlockByNonce(nonce uint64, options api.BlockQueryOptions) (*api.Block, error)
+	IsInterfaceNil() bool
+}
+
+type txPoolCountsHandler interface {
+	GetCountsPerCacheID() map[string]int64
+}
@@ -55,6 +55,16 @@ type DirectStakedListHandler interface {
 // DelegatedListHandler defines the behavior of a component able to return the complete delegated list
 type DelegatedListHandler interface {
 	GetDelegatorsList(ctx context.Context) ([]*api.Delegator, error)
+	GetDelegationContract(ctx context.Context, address string, page uint32, pageSize uint32) (*common.DelegationContractAPIResponse, error)
+	GetDelegationPositions(ctx context.Context, address string) (*common.DelegationPositionsAPIResponse, error)
+	IsInterfaceNil() bool
+}
+
+// GovernanceHandler defines the behavior of a component able to return the governance proposals and votes
+type GovernanceHandler interface {
+	GetGovernanceProposals(ctx context.Context) (*common.GovernanceProposalsAPIResponse, error)
+	GetGovernanceProposal(nonce uint64) (*common.GovernanceProposalAPIResponse, error)
+	GetGovernanceVotes(address string) (*common.GovernanceVotesAPIResponse, error)
 	IsInterfaceNil() bool
 }
 
@@ -70,3 +80,23 @@ type APITransactionHandler interface {
 	UnmarshalReceipt(receiptBytes []byte) (*transaction.ApiReceipt, error)
 	IsInterfaceNil() bool
 }
+
+// GasPriceSuggestionHandler defines the behavior of a component able to suggest gas prices
+type GasPriceSuggestionHandler interface {
+	GetGasPriceSuggestion() (*common.GasPriceSuggestionAPIResponse, error)
+	IsInterfaceNil() bool
+}
+
+// TrieStatisticsHandler defines the behavior of a component able to provide the statistics of the state tries
+type TrieStatisticsHandler interface {
+	GetTrieStatistics(rootHash string) (*common.TrieStatisticsAPIResponse, error)
+	IsInterfaceNil() bool
+}
+
+// OutportReindexer defines the behavior of a component able to push historical blocks to the outport drivers
+type OutportReindexer interface {
+	StartReindexing(startNonce uint64, endNonce uint64) error
+	GetReindexingStatus() common.OutportReindexStatus
+	Close() error
+	IsInterfaceNil() bool
+}
@@ -32,6 +32,7 @@ type ArgNodeApiResolver struct {
 	TotalStakedValueHandler  TotalStakedValueHandler
 	DirectStakedListHandler  DirectStakedListHandler
 	DelegatedListHandler     DelegatedListHandler
+	GovernanceHandler        GovernanceHandler
 	APITransactionHandler    APITransactionHandler
 	APIBlockHandler          blockAPI.APIBlockHandler
 	APIInternalBlockHandler  blockAPI.APIInternalBlockHandler
@@ -39,6 +40,9 @@ type ArgNodeApiResolver struct {
 	ValidatorPubKeyConverter core.PubkeyConverter
 	AccountsParser           genesis.AccountsParser
 	GasScheduleNotifier      common.GasScheduleNotifierAPI
+	GasPriceSuggester        GasPriceSuggestionHandler
+	TrieStatisticsProvider   TrieStatisticsHandler
+	OutportReindexer         OutportReindexer
 	ManagedPeersMonitor      common.ManagedPeersMonitor
 	PublicKey                string
 	NodesCoordinator         nodesCoordinator.NodesCoordinator
@@ -53,6 +57,7 @@ type nodeApiResolver struct {
 	totalStakedValueHandler  TotalStakedValueHandler
 	directStakedListHandler  DirectStakedListHandler
 	delegatedListHandler     DelegatedListHandler
+	governanceHandler        GovernanceHandler
 	apiTransactionHandler    APITransactionHandler
 	apiBlockHandler          blockAPI.APIBlockHandler
 	apiInternalBlockHandler  blockAPI.APIInternalBlockHandler
@@ -60,6 +65,9 @@ type nodeApiResolver struct {
 	validatorPubKeyConverter core.PubkeyConverter
 	accountsParser           genesis.AccountsParser
 	gasScheduleNotifier      common.GasScheduleNotifierAPI
+	gasPriceSuggester        GasPriceSuggestionHandler
+	trieStatisticsProvider   TrieStatisticsHandler
+	outportReindexer         OutportReindexer
 	managedPeersMonitor      common.ManagedPeersMonitor
 	publicKey                string
 	nodesCoordinator         nodesCoordinator.NodesCoordinator
@@ -86,6 +94,9 @@ func NewNodeApiResolver(arg ArgNodeApiResolver) (*nodeApiResolver, error) {
 	if check.IfNil(arg.DelegatedListHandler) {
 		return nil, ErrNilDelegatedListHandler
 	}
+	if check.IfNil(arg.GovernanceHandler) {
+		return nil, ErrNilGovernanceHandler
+	}
 	if check.IfNil(arg.APITransactionHandler) {
 		return nil, ErrNilAPITransactionHandler
 	}
@@ -107,6 +118,15 @@ func NewNodeApiResolver(arg ArgNodeApiResolver) (*nodeApiResolver, error) {
 	if check.IfNil(arg.GasScheduleNotifier) {
 		return nil, ErrNilGasScheduler
 	}
+	if check.IfNil(arg.GasPriceSuggester) {
+		return nil, ErrNilGasPriceSuggestionHandler
+	}
+	if check.IfNil(arg.TrieStatisticsProvider) {
+		return nil, ErrNilTrieStatisticsHandler
+	}
+	if check.IfNil(arg.OutportReindexer) {
+		return nil, ErrNilOutportReindexer
+	}
 	if check.IfNil(arg.ManagedPeersMonitor) {
 		return nil, ErrNilManagedPeersMonitor
 	}
@@ -121,6 +141,7 @@ func NewNodeApiResolver(arg ArgNodeApiResolver) (*nodeApiResolver, error) {
 		totalStakedValueHandler:  arg.TotalStakedValueHandler,
 		directStakedListHandler:  arg.DirectStakedListHandler,
 		delegatedListHandler:     arg.DelegatedListHandler,
+		governanceHandler:        arg.GovernanceHandler,
 		apiBlockHandler:          arg.APIBlockHandler,
 		apiTransactionHandler:    arg.APITransactionHandler,
 		apiInternalBlockHandler:  arg.APIInternalBlockHandler,
@@ -128,6 +149,9 @@ func NewNodeApiResolver(arg ArgNodeApiResolver) (*nodeApiResolver, error) {
 		validatorPubKeyConverter: arg.ValidatorPubKeyConverter,
 		accountsParser:           arg.AccountsParser,
 		gasScheduleNotifier:      arg.GasScheduleNotifier,
+		gasPriceSuggester:        arg.GasPriceSuggester,
+		trieStatisticsProvider:   arg.TrieStatisticsProvider,
+		outportReindexer:         arg.OutportReindexer,
 		managedPeersMonitor:      arg.ManagedPeersMonitor,
 		publicKey:                arg.PublicKey,
 		nodesCoordinator:         arg.NodesCoordinator,
@@ -166,6 +190,8 @@ func (nar *nodeApiResolver) Close() error {
 		log.LogIfError(err)
 	}
 
+	log.LogIfError(nar.outportReindexer.Close())
+
 	return nar.scQueryService.Close()
 }
 
@@ -184,6 +210,31 @@ func (nar *nodeApiResolver) GetDelegatorsList(ctx context.Context) ([]*api.Deleg
 	return nar.delegatedListHandler.GetDelegatorsList(ctx)
 }
 
+// GetDelegationContract will return the state of a delegation contract along with a page of its delegators
+func (nar *nodeApiResolver) GetDelegationContract(ctx context.Context, address string, page uint32, pageSize uint32) (*common.DelegationContractAPIResponse, error) {
+	return nar.delegatedListHandler.GetDelegationContract(ctx, address, page, pageSize)
+}
+
+// GetDelegationPositions will return the delegation positions of the provided address across all delegation contracts
+func (nar *nodeApiResolver) GetDelegationPositions(ctx context.Context, address string) (*common.DelegationPositionsAPIResponse, error) {
+	return nar.delegatedListHandler.GetDelegationPositions(ctx, address)
+}
+
+// GetGovernanceProposals will return all the governance proposals with their vote tallies
+func (nar *nodeApiResolver) GetGovernanceProposals(ctx context.Context) (*common.GovernanceProposalsAPIResponse, error) {
+	return nar.governanceHandler.GetGovernanceProposals(ctx)
+}
+
+// GetGovernanceProposal will return the governance proposal with the provided nonce
+func (nar *nodeApiResolver) GetGovernanceProposal(nonce uint64) (*common.GovernanceProposalAPIResponse, error) {
+	return nar.governanceHandler.GetGovernanceProposal(nonce)
+}
+
+// GetGovernanceVotes will return the governance votes and the voting power of the provided address
+func (nar *nodeApiResolver) GetGovernanceVotes(address string) (*common.GovernanceVotesAPIResponse, error) {
+	return nar.governanceHandler.GetGovernanceVotes(address)
+}
+
 // GetTransaction will return the transaction with the given hash and optionally with results
 func (nar *nodeApiResolver) GetTransaction(hash string, withResults bool) (*transaction.ApiTransactionResult, error) {
 	return nar.apiTransactionHandler.GetTransaction(hash, withResults)
@@ -355,6 +406,26 @@ func (nar *nodeApiResolver) GetGasConfigs() map[string]map[string]uint64 {
 	return nar.gasScheduleNotifier.LatestGasScheduleCopy()
 }
 
+// GetGasPriceSuggestion returns the gas price suggestions computed from the pool pressure and the recent blocks
+func (nar *nodeApiResolver) GetGasPriceSuggestion() (*common.GasPriceSuggestionAPIResponse, error) {
+	return nar.gasPriceSuggester.GetGasPriceSuggestion()
+}
+
+// GetTrieStatistics returns the statistics of the accounts trie, together with all the data tries, for the given root hash
+func (nar *nodeApiResolver) GetTrieStatistics(rootHash string) (*common.TrieStatisticsAPIResponse, error) {
+	return nar.trieStatisticsProvider.GetTrieStatistics(rootHash)
+}
+
+// StartOutportReindexing starts pushing the blocks in the provided nonce range, rebuilt from storage, to the outport drivers
+func (nar *nodeApiResolver) StartOutportReindexing(startNonce uint64, endNonce uint64) error {
+	return nar.outportReindexer.StartReindexing(startNonce, endNonce)
+}
+
+// GetOutportReindexingStatus returns the progress of the last outport re-indexing job
+func (nar *nodeApiResolver) GetOutportReindexingStatus() common.OutportReindexStatus {
+	return nar.outportReindexer.GetReindexingStatus()
+}
+
 // GetManagedKeysCount returns the number of managed keys when node is running in multikey mode
 func (nar *nodeApiResolver) GetManagedKeysCount() int {
 	return nar.managedPeersMonitor.GetManagedKeysCount()
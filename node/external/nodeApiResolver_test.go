@@ -36,6 +36,7 @@ func createMockArgs() external.ArgNodeApiResolver {
 		TotalStakedValueHandler:  &mock.StakeValuesProcessorStub{},
 		DirectStakedListHandler:  &mock.DirectStakedListProcessorStub{},
 		DelegatedListHandler:     &mock.DelegatedListProcessorStub{},
+		GovernanceHandler:        &mock.GovernanceProcessorStub{},
 		APIBlockHandler:          &mock.BlockAPIHandlerStub{},
 		APITransactionHandler:    &mock.TransactionAPIHandlerStub{},
 		APIInternalBlockHandler:  &mock.InternalBlockApiHandlerStub{},
@@ -43,6 +44,9 @@ func createMockArgs() external.ArgNodeApiResolver {
 		ValidatorPubKeyConverter: &testscommon.PubkeyConverterMock{},
 		AccountsParser:           &genesisMocks.AccountsParserStub{},
 		GasScheduleNotifier:      &testscommon.GasScheduleNotifierMock{},
+		GasPriceSuggester:        &testscommon.GasPriceSuggesterStub{},
+		TrieStatisticsProvider:   &testscommon.TrieStatisticsProviderStub{},
+		OutportReindexer:         &testscommon.OutportReindexerStub{},
 		ManagedPeersMonitor:      &testscommon.ManagedPeersMonitorStub{},
 		NodesCoordinator:         &shardingMocks.NodesCoordinatorStub{},
 	}
@@ -114,6 +118,17 @@ func TestNewNodeApiResolver_NilDelegatedListHandler(t *testing.T) {
 	assert.Equal(t, external.ErrNilDelegatedListHandler, err)
 }
 
+func TestNewNodeApiResolver_NilGovernanceHandler(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockArgs()
+	arg.GovernanceHandler = nil
+	nar, err := external.NewNodeApiResolver(arg)
+
+	assert.Nil(t, nar)
+	assert.Equal(t, external.ErrNilGovernanceHandler, err)
+}
+
 func TestNewNodeApiResolver_NilGasSchedules(t *testing.T) {
 	t.Parallel()
 
@@ -125,6 +140,39 @@ func TestNewNodeApiResolver_NilGasSchedules(t *testing.T) {
 	assert.Equal(t, external.ErrNilGasScheduler, err)
 }
 
+func TestNewNodeApiResolver_NilGasPriceSuggester(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockArgs()
+	arg.GasPriceSuggester = nil
+	nar, err := external.NewNodeApiResolver(arg)
+
+	assert.Nil(t, nar)
+	assert.Equal(t, external.ErrNilGasPriceSuggestionHandler, err)
+}
+
+func TestNewNodeApiResolver_NilTrieStatisticsProvider(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockArgs()
+	arg.TrieStatisticsProvider = nil
+	nar, err := external.NewNodeApiResolver(arg)
+
+	assert.Nil(t, nar)
+	assert.Equal(t, external.ErrNilTrieStatisticsHandler, err)
+}
+
+func TestNewNodeApiResolver_NilOutportReindexer(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockArgs()
+	arg.OutportReindexer = nil
+	nar, err := external.NewNodeApiResolver(arg)
+
+	assert.Nil(t, nar)
+	assert.Equal(t, external.ErrNilOutportReindexer, err)
+}
+
 func TestNewNodeApiResolver_NilNodesCoordinator(t *testing.T) {
 	t.Parallel()
 
@@ -158,11 +206,20 @@ func TestNodeApiResolver_CloseShouldReturnNil(t *testing.T) {
 			return nil
 		},
 	}
+	reindexerCloseCalled := false
+	args.OutportReindexer = &testscommon.OutportReindexerStub{
+		CloseCalled: func() error {
+			reindexerCloseCalled = true
+
+			return nil
+		},
+	}
 	nar, _ := external.NewNodeApiResolver(args)
 
 	err := nar.Close()
 	assert.Nil(t, err)
 	assert.True(t, closeCalled)
+	assert.True(t, reindexerCloseCalled)
 }
 
 func TestNodeApiResolver_GetDataValueShouldCall(t *testing.T) {
@@ -277,6 +334,106 @@ func TestNodeApiResolver_GetDelegatorsList(t *testing.T) {
 	assert.True(t, wasCalled)
 }
 
+func TestNodeApiResolver_GetDelegationContract(t *testing.T) {
+	t.Parallel()
+
+	wasCalled := false
+	arg := createMockArgs()
+	contract := &common.DelegationContractAPIResponse{Address: "erd1contract"}
+	arg.DelegatedListHandler = &mock.DelegatedListProcessorStub{
+		GetDelegationContractCalled: func(_ context.Context, address string, page uint32, pageSize uint32) (*common.DelegationContractAPIResponse, error) {
+			wasCalled = true
+			assert.Equal(t, "erd1contract", address)
+			assert.Equal(t, uint32(1), page)
+			assert.Equal(t, uint32(50), pageSize)
+			return contract, nil
+		},
+	}
+
+	nar, _ := external.NewNodeApiResolver(arg)
+	recoveredContract, err := nar.GetDelegationContract(context.Background(), "erd1contract", 1, 50)
+	assert.Nil(t, err)
+	assert.True(t, recoveredContract == contract) //pointer testing
+	assert.True(t, wasCalled)
+}
+
+func TestNodeApiResolver_GetDelegationPositions(t *testing.T) {
+	t.Parallel()
+
+	wasCalled := false
+	arg := createMockArgs()
+	positions := &common.DelegationPositionsAPIResponse{Address: "erd1alice"}
+	arg.DelegatedListHandler = &mock.DelegatedListProcessorStub{
+		GetDelegationPositionsCalled: func(_ context.Context, address string) (*common.DelegationPositionsAPIResponse, error) {
+			wasCalled = true
+			assert.Equal(t, "erd1alice", address)
+			return positions, nil
+		},
+	}
+
+	nar, _ := external.NewNodeApiResolver(arg)
+	recoveredPositions, err := nar.GetDelegationPositions(context.Background(), "erd1alice")
+	assert.Nil(t, err)
+	assert.True(t, recoveredPositions == positions) //pointer testing
+	assert.True(t, wasCalled)
+}
+
+func TestNodeApiResolver_GetGovernanceProposals(t *testing.T) {
+	t.Parallel()
+
+	wasCalled := false
+	arg := createMockArgs()
+	proposals := &common.GovernanceProposalsAPIResponse{CurrentEpoch: 5}
+	arg.GovernanceHandler = &mock.GovernanceProcessorStub{
+		GetGovernanceProposalsCalled: func(_ context.Context) (*common.GovernanceProposalsAPIResponse, error) {
+			wasCalled = true
+			return proposals, nil
+		},
+	}
+
+	nar, _ := external.NewNodeApiResolver(arg)
+	recoveredProposals, err := nar.GetGovernanceProposals(context.Background())
+	assert.Nil(t, err)
+	assert.True(t, recoveredProposals == proposals) //pointer testing
+	assert.True(t, wasCalled)
+}
+
+func TestNodeApiResolver_GetGovernanceProposal(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockArgs()
+	proposal := &common.GovernanceProposalAPIResponse{Nonce: 3}
+	arg.GovernanceHandler = &mock.GovernanceProcessorStub{
+		GetGovernanceProposalCalled: func(nonce uint64) (*common.GovernanceProposalAPIResponse, error) {
+			assert.Equal(t, uint64(3), nonce)
+			return proposal, nil
+		},
+	}
+
+	nar, _ := external.NewNodeApiResolver(arg)
+	recoveredProposal, err := nar.GetGovernanceProposal(3)
+	assert.Nil(t, err)
+	assert.True(t, recoveredProposal == proposal) //pointer testing
+}
+
+func TestNodeApiResolver_GetGovernanceVotes(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockArgs()
+	votes := &common.GovernanceVotesAPIResponse{Address: "erd1"}
+	arg.GovernanceHandler = &mock.GovernanceProcessorStub{
+		GetGovernanceVotesCalled: func(address string) (*common.GovernanceVotesAPIResponse, error) {
+			assert.Equal(t, "erd1", address)
+			return votes, nil
+		},
+	}
+
+	nar, _ := external.NewNodeApiResolver(arg)
+	recoveredVotes, err := nar.GetGovernanceVotes("erd1")
+	assert.Nil(t, err)
+	assert.True(t, recoveredVotes == votes) //pointer testing
+}
+
 func TestNodeApiResolver_GetDirectStakedList(t *testing.T) {
 	t.Parallel()
 
@@ -693,6 +850,81 @@ func TestNodeApiResolver_GetGasConfigs(t *testing.T) {
 	require.True(t, wasCalled)
 }
 
+func TestNodeApiResolver_GetGasPriceSuggestion(t *testing.T) {
+	t.Parallel()
+
+	providedSuggestion := &common.GasPriceSuggestionAPIResponse{
+		MinGasPrice: 1000000000,
+		LastNonce:   37,
+	}
+	args := createMockArgs()
+	args.GasPriceSuggester = &testscommon.GasPriceSuggesterStub{
+		GetGasPriceSuggestionCalled: func() (*common.GasPriceSuggestionAPIResponse, error) {
+			return providedSuggestion, nil
+		},
+	}
+
+	nar, err := external.NewNodeApiResolver(args)
+	require.Nil(t, err)
+
+	suggestion, err := nar.GetGasPriceSuggestion()
+	require.Nil(t, err)
+	require.Equal(t, providedSuggestion, suggestion)
+}
+
+func TestNodeApiResolver_GetTrieStatistics(t *testing.T) {
+	t.Parallel()
+
+	providedStatistics := &common.TrieStatisticsAPIResponse{
+		RootHash:    "aabb",
+		NumAccounts: 37,
+	}
+	args := createMockArgs()
+	args.TrieStatisticsProvider = &testscommon.TrieStatisticsProviderStub{
+		GetTrieStatisticsCalled: func(rootHash string) (*common.TrieStatisticsAPIResponse, error) {
+			require.Equal(t, "aabb", rootHash)
+			return providedStatistics, nil
+		},
+	}
+
+	nar, err := external.NewNodeApiResolver(args)
+	require.Nil(t, err)
+
+	statistics, err := nar.GetTrieStatistics("aabb")
+	require.Nil(t, err)
+	require.Equal(t, providedStatistics, statistics)
+}
+
+func TestNodeApiResolver_OutportReindexing(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("expected error")
+	providedStatus := common.OutportReindexStatus{
+		Status:           "in progress",
+		StartNonce:       10,
+		EndNonce:         20,
+		LastIndexedNonce: 15,
+	}
+	args := createMockArgs()
+	args.OutportReindexer = &testscommon.OutportReindexerStub{
+		StartReindexingCalled: func(startNonce uint64, endNonce uint64) error {
+			require.Equal(t, uint64(10), startNonce)
+			require.Equal(t, uint64(20), endNonce)
+			return expectedErr
+		},
+		GetReindexingStatusCalled: func() common.OutportReindexStatus {
+			return providedStatus
+		},
+	}
+
+	nar, err := external.NewNodeApiResolver(args)
+	require.Nil(t, err)
+
+	err = nar.StartOutportReindexing(10, 20)
+	require.Equal(t, expectedErr, err)
+	require.Equal(t, providedStatus, nar.GetOutportReindexingStatus())
+}
+
 func TestNodeApiResolver_GetManagedKeysCount(t *testing.T) {
 	t.Parallel()
 
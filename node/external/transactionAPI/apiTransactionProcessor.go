@@ -345,9 +345,23 @@ func (atp *apiTransactionProcessor) getFieldGettersForTx(wrappedTx *txcache.Wrap
 		fieldGetters[guardianSignatureField] = hex.EncodeToString(guardedTx.GetGuardianSignature())
 	}
 
+	replacedTxHash, isReplacement := atp.getReplacedTxHash(wrappedTx.TxHash)
+	if isReplacement {
+		fieldGetters[replacedTxHashField] = hex.EncodeToString(replacedTxHash)
+	}
+
 	return fieldGetters
 }
 
+func (atp *apiTransactionProcessor) getReplacedTxHash(txHash []byte) ([]byte, bool) {
+	replacementsHandler, ok := atp.dataPool.Transactions().(txReplacementsHandler)
+	if !ok {
+		return nil, false
+	}
+
+	return replacementsHandler.GetReplacedTxHash(txHash)
+}
+
 func (atp *apiTransactionProcessor) fetchTxsForSender(sender string, senderShard uint32) []*txcache.WrappedTransaction {
 	cacheId := process.ShardCacherIdentifier(senderShard, senderShard)
 	cache := atp.dataPool.Transactions().ShardDataStore(cacheId)
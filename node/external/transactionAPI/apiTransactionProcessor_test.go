@@ -744,6 +744,60 @@ func TestApiTransactionProcessor_GetTransactionsPool(t *testing.T) {
 	require.Equal(t, rewardTxs, res.Rewards)
 }
 
+func TestApiTransactionProcessor_GetTransactionsPoolShouldReturnReplacedTxHash(t *testing.T) {
+	t.Parallel()
+
+	txHash0, txHash1 := []byte("txHash0"), []byte("txHash1")
+	args := createMockArgAPITransactionProcessor()
+	args.DataPool = &dataRetrieverMock.PoolsHolderStub{
+		TransactionsCalled: func() dataRetriever.ShardedDataCacherNotifier {
+			return &txReplacementsPoolStub{
+				ShardedDataStub: &testscommon.ShardedDataStub{
+					KeysCalled: func() [][]byte {
+						return [][]byte{txHash1}
+					},
+					SearchFirstDataCalled: func(key []byte) (value interface{}, ok bool) {
+						return createTx(key, "alice", 1).Tx, true
+					},
+				},
+				replacedTxHashes: map[string][]byte{
+					string(txHash1): txHash0,
+				},
+			}
+		},
+		UnsignedTransactionsCalled: func() dataRetriever.ShardedDataCacherNotifier {
+			return &testscommon.ShardedDataStub{}
+		},
+		RewardTransactionsCalled: func() dataRetriever.ShardedDataCacherNotifier {
+			return &testscommon.ShardedDataStub{}
+		},
+	}
+	atp, _ := NewAPITransactionProcessor(args)
+
+	res, err := atp.GetTransactionsPool("hash,replacedtxhash")
+	require.NoError(t, err)
+
+	regularTxs := []common.Transaction{
+		{
+			TxFields: map[string]interface{}{
+				"hash":           hex.EncodeToString(txHash1),
+				"replacedtxhash": hex.EncodeToString(txHash0),
+			},
+		},
+	}
+	require.Equal(t, regularTxs, res.RegularTransactions)
+}
+
+type txReplacementsPoolStub struct {
+	*testscommon.ShardedDataStub
+	replacedTxHashes map[string][]byte
+}
+
+func (stub *txReplacementsPoolStub) GetReplacedTxHash(txHash []byte) ([]byte, bool) {
+	replacedTxHash, ok := stub.replacedTxHashes[string(txHash)]
+	return replacedTxHash, ok
+}
+
 func createTx(hash []byte, sender string, nonce uint64) *txcache.WrappedTransaction {
 	tx := &transaction.Transaction{
 		SndAddr: []byte(sender),
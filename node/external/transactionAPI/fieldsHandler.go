@@ -19,6 +19,7 @@ const (
 	guardianSignatureField = "guardiansignature"
 	senderShardID          = "sendershard"
 	receiverShardID        = "receivershard"
+	replacedTxHashField    = "replacedtxhash"
 	wildCard               = "*"
 
 	separator = ","
@@ -30,3 +30,7 @@ type LogsFacade interface {
 type DataFieldParser interface {
 	Parse(dataField []byte, sender, receiver []byte, numOfShards uint32) *datafield.ResponseParseData
 }
+
+type txReplacementsHandler interface {
+	GetReplacedTxHash(txHash []byte) ([]byte, bool)
+}
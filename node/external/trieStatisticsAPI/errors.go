@@ -0,0 +1,11 @@
+package trieStatisticsAPI
+
+import "errors"
+
+var errNilAccountsAdapter = errors.New("nil accounts adapter")
+var errNilChainHandler = errors.New("nil chain handler")
+var errInvalidNumCachedRootHashes = errors.New("invalid number of cached root hashes")
+var errInvalidNumLargestDataTries = errors.New("invalid number of largest data tries")
+var errNoCurrentRootHash = errors.New("no current root hash available")
+var errNilTriesStatistics = errors.New("nil tries statistics")
+var errComputationInProgress = errors.New("the trie statistics are being computed for another root hash")
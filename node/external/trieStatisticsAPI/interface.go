@@ -0,0 +1,8 @@
+package trieStatisticsAPI
+
+import "github.com/multiversx/mx-chain-go/common"
+
+type accountsStatisticsHandler interface {
+	GetStatsForRootHash(rootHash []byte, numLargestDataTries uint32) (common.TriesStatisticsCollector, error)
+	IsInterfaceNil() bool
+}
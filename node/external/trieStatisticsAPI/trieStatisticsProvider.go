@@ -0,0 +1,215 @@
+package trieStatisticsAPI
+
+import (
+	"encoding/hex"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-core-go/data"
+	"github.com/multiversx/mx-chain-go/common"
+	logger "github.com/multiversx/mx-chain-logger-go"
+)
+
+var log = logger.GetOrCreate("node/external/trieStatisticsAPI")
+
+const (
+	// StatusInProgress is the status of the statistics that are still being computed
+	StatusInProgress = "in progress"
+	// StatusReady is the status of the statistics that were successfully computed
+	StatusReady = "ready"
+	// StatusFailed is the status of the statistics that could not be computed
+	StatusFailed = "failed"
+)
+
+// ArgsTrieStatisticsProvider holds the arguments needed to create a trie statistics provider
+type ArgsTrieStatisticsProvider struct {
+	Accounts            accountsStatisticsHandler
+	ChainHandler        data.ChainHandler
+	NumCachedRootHashes uint32
+	NumLargestDataTries uint32
+}
+
+type trieStatisticsProvider struct {
+	accounts            accountsStatisticsHandler
+	chainHandler        data.ChainHandler
+	numCachedRootHashes int
+	numLargestDataTries uint32
+
+	mutStatistics     sync.Mutex
+	statistics        map[string]*common.TrieStatisticsAPIResponse
+	cachedRootHashes  []string
+	computingRootHash string
+}
+
+// NewTrieStatisticsProvider creates a component able to compute, in background, the statistics of the accounts trie and
+// of all the data tries for a root hash. The computed statistics are cached by root hash
+func NewTrieStatisticsProvider(args ArgsTrieStatisticsProvider) (*trieStatisticsProvider, error) {
+	if check.IfNil(args.Accounts) {
+		return nil, errNilAccountsAdapter
+	}
+	if check.IfNil(args.ChainHandler) {
+		return nil, errNilChainHandler
+	}
+	if args.NumCachedRootHashes == 0 {
+		return nil, errInvalidNumCachedRootHashes
+	}
+	if args.NumLargestDataTries == 0 {
+		return nil, errInvalidNumLargestDataTries
+	}
+
+	return &trieStatisticsProvider{
+		accounts:            args.Accounts,
+		chainHandler:        args.ChainHandler,
+		numCachedRootHashes: int(args.NumCachedRootHashes),
+		numLargestDataTries: args.NumLargestDataTries,
+		statistics:          make(map[string]*common.TrieStatisticsAPIResponse),
+		cachedRootHashes:    make([]string, 0, args.NumCachedRootHashes),
+	}, nil
+}
+
+// GetTrieStatistics returns the statistics for the provided hex encoded root hash or, if empty, for the root hash of
+// the current block. The first call for a root hash starts the computation in background and returns the in progress
+// status. Only one computation runs at a time
+func (tsp *trieStatisticsProvider) GetTrieStatistics(rootHash string) (*common.TrieStatisticsAPIResponse, error) {
+	rootHashBytes, err := tsp.getRootHash(rootHash)
+	if err != nil {
+		return nil, err
+	}
+
+	key := string(rootHashBytes)
+	encodedRootHash := hex.EncodeToString(rootHashBytes)
+
+	tsp.mutStatistics.Lock()
+	defer tsp.mutStatistics.Unlock()
+
+	statistics, found := tsp.statistics[key]
+	if found {
+		if statistics.Status == StatusFailed {
+			// the failure is reported once, the next call will retry the computation
+			tsp.removeFromCache(key)
+		}
+
+		return statistics, nil
+	}
+
+	if len(tsp.computingRootHash) > 0 {
+		if tsp.computingRootHash != key {
+			return nil, fmt.Errorf("%w: %s", errComputationInProgress, hex.EncodeToString([]byte(tsp.computingRootHash)))
+		}
+
+		return newInProgressResponse(encodedRootHash), nil
+	}
+
+	tsp.computingRootHash = key
+	go tsp.computeStatistics(rootHashBytes)
+
+	return newInProgressResponse(encodedRootHash), nil
+}
+
+func (tsp *trieStatisticsProvider) getRootHash(rootHash string) ([]byte, error) {
+	if len(rootHash) > 0 {
+		return hex.DecodeString(rootHash)
+	}
+
+	currentRootHash := tsp.chainHandler.GetCurrentBlockRootHash()
+	if len(currentRootHash) == 0 {
+		return nil, errNoCurrentRootHash
+	}
+
+	return currentRootHash, nil
+}
+
+func newInProgressResponse(rootHash string) *common.TrieStatisticsAPIResponse {
+	return &common.TrieStatisticsAPIResponse{
+		RootHash: rootHash,
+		Status:   StatusInProgress,
+	}
+}
+
+func (tsp *trieStatisticsProvider) computeStatistics(rootHash []byte) {
+	startTime := time.Now()
+	log.Debug("started computing trie statistics", "root hash", rootHash)
+
+	statistics := &common.TrieStatisticsAPIResponse{
+		RootHash: hex.EncodeToString(rootHash),
+	}
+	stats, err := tsp.accounts.GetStatsForRootHash(rootHash, tsp.numLargestDataTries)
+	if err == nil && stats == nil {
+		err = errNilTriesStatistics
+	}
+	if err != nil {
+		log.Warn("could not compute trie statistics", "root hash", rootHash, "error", err)
+		statistics.Status = StatusFailed
+		statistics.Error = err.Error()
+	} else {
+		fillStatistics(statistics, stats)
+		statistics.Status = StatusReady
+	}
+	statistics.DurationInMs = time.Since(startTime).Milliseconds()
+
+	log.Debug("finished computing trie statistics", "root hash", rootHash, "status", statistics.Status, "duration", time.Since(startTime))
+
+	tsp.mutStatistics.Lock()
+	tsp.addToCache(string(rootHash), statistics)
+	tsp.computingRootHash = ""
+	tsp.mutStatistics.Unlock()
+}
+
+func fillStatistics(statistics *common.TrieStatisticsAPIResponse, stats common.TriesStatisticsCollector) {
+	mainTrieStats := stats.GetTrieStatistics(common.MainTrie)
+	dataTriesStats := stats.GetTrieStatistics(common.DataTrie)
+
+	statistics.NumAccounts = stats.GetNumAccounts()
+	statistics.NumDataTries = stats.GetNumTries(common.DataTrie)
+	statistics.NumBranchNodes = mainTrieStats.GetNumBranchNodes() + dataTriesStats.GetNumBranchNodes()
+	statistics.NumExtensionNodes = mainTrieStats.GetNumExtensionNodes() + dataTriesStats.GetNumExtensionNodes()
+	statistics.NumLeafNodes = mainTrieStats.GetNumLeafNodes() + dataTriesStats.GetNumLeafNodes()
+	statistics.MainTrieSize = mainTrieStats.GetTotalNodesSize()
+	statistics.DataTriesSize = dataTriesStats.GetTotalNodesSize()
+	statistics.TotalSize = statistics.MainTrieSize + statistics.DataTriesSize
+	statistics.MaxMainTrieDepth = mainTrieStats.GetMaxTrieDepth()
+	statistics.MaxDataTrieDepth = dataTriesStats.GetMaxTrieDepth()
+	statistics.MainTrieNodes = mainTrieStats.GetNodesPerDepth()
+	statistics.DataTriesNodes = dataTriesStats.GetNodesPerDepth()
+
+	largestDataTries := stats.GetLargestDataTries()
+	statistics.LargestDataTries = make([]*common.DataTrieStatisticsAPIResponse, 0, len(largestDataTries))
+	for _, trieStats := range largestDataTries {
+		statistics.LargestDataTries = append(statistics.LargestDataTries, &common.DataTrieStatisticsAPIResponse{
+			Address:      trieStats.GetAddress(),
+			RootHash:     hex.EncodeToString(trieStats.GetRootHash()),
+			TotalSize:    trieStats.GetTotalNodesSize(),
+			NumNodes:     trieStats.GetTotalNumNodes(),
+			NumLeaves:    trieStats.GetNumLeafNodes(),
+			MaxTrieDepth: trieStats.GetMaxTrieDepth(),
+		})
+	}
+}
+
+func (tsp *trieStatisticsProvider) addToCache(key string, statistics *common.TrieStatisticsAPIResponse) {
+	if len(tsp.cachedRootHashes) >= tsp.numCachedRootHashes {
+		oldestKey := tsp.cachedRootHashes[0]
+		tsp.cachedRootHashes = tsp.cachedRootHashes[1:]
+		delete(tsp.statistics, oldestKey)
+	}
+
+	tsp.cachedRootHashes = append(tsp.cachedRootHashes, key)
+	tsp.statistics[key] = statistics
+}
+
+func (tsp *trieStatisticsProvider) removeFromCache(key string) {
+	delete(tsp.statistics, key)
+	for i, cachedKey := range tsp.cachedRootHashes {
+		if cachedKey == key {
+			tsp.cachedRootHashes = append(tsp.cachedRootHashes[:i], tsp.cachedRootHashes[i+1:]...)
+			return
+		}
+	}
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (tsp *trieStatisticsProvider) IsInterfaceNil() bool {
+	return tsp == nil
+}
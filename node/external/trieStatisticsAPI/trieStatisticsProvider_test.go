@@ -0,0 +1,253 @@
+package trieStatisticsAPI
+
+import (
+	"encoding/hex"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/testscommon"
+	stateMock "github.com/multiversx/mx-chain-go/testscommon/state"
+	"github.com/multiversx/mx-chain-go/trie/statistics"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var currentRootHash = []byte("current root hash")
+
+func createMockArgsTrieStatisticsProvider() ArgsTrieStatisticsProvider {
+	return ArgsTrieStatisticsProvider{
+		Accounts: &stateMock.AccountsStub{
+			GetStatsForRootHashCalled: func(rootHash []byte, numLargestDataTries uint32) (common.TriesStatisticsCollector, error) {
+				return createTriesStatistics(), nil
+			},
+		},
+		ChainHandler: &testscommon.ChainHandlerStub{
+			GetCurrentBlockRootHashCalled: func() []byte {
+				return currentRootHash
+			},
+		},
+		NumCachedRootHashes: 2,
+		NumLargestDataTries: 10,
+	}
+}
+
+func createTriesStatistics() common.TriesStatisticsCollector {
+	collector := statistics.NewTrieStatisticsCollector()
+
+	mainTrieStats := statistics.NewTrieStatistics()
+	mainTrieStats.AddBranchNode(0, 100)
+	mainTrieStats.AddLeafNode(1, 50, 0)
+	mainTrieStats.AddLeafNode(1, 50, 0)
+	mainTrieStats.AddLeafNode(1, 40, 0)
+	collector.Add(mainTrieStats, common.MainTrie)
+
+	for i := 0; i < 2; i++ {
+		dataTrieStats := statistics.NewTrieStatistics()
+		dataTrieStats.AddExtensionNode(0, 20)
+		dataTrieStats.AddLeafNode(1, uint64(10*(i+1)), 0)
+		dataTrieStats.AddAccountInfo("address"+string(rune('0'+i)), []byte{byte(i)})
+		collector.Add(dataTrieStats, common.DataTrie)
+	}
+
+	collector.IncrementNumAccounts()
+	collector.IncrementNumAccounts()
+	collector.IncrementNumAccounts()
+
+	return collector
+}
+
+func getStatisticsWhenReady(t *testing.T, provider *trieStatisticsProvider, rootHash string) *common.TrieStatisticsAPIResponse {
+	for i := 0; i < 100; i++ {
+		statistics, err := provider.GetTrieStatistics(rootHash)
+		require.Nil(t, err)
+		if statistics.Status != StatusInProgress {
+			return statistics
+		}
+
+		time.Sleep(10 * time.Millisecond)
+	}
+
+	require.Fail(t, "statistics were not computed in time")
+	return nil
+}
+
+func TestNewTrieStatisticsProvider(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil accounts should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsTrieStatisticsProvider()
+		args.Accounts = nil
+		provider, err := NewTrieStatisticsProvider(args)
+		assert.Equal(t, errNilAccountsAdapter, err)
+		assert.True(t, check.IfNil(provider))
+	})
+	t.Run("nil chain handler should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsTrieStatisticsProvider()
+		args.ChainHandler = nil
+		provider, err := NewTrieStatisticsProvider(args)
+		assert.Equal(t, errNilChainHandler, err)
+		assert.True(t, check.IfNil(provider))
+	})
+	t.Run("invalid number of cached root hashes should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsTrieStatisticsProvider()
+		args.NumCachedRootHashes = 0
+		provider, err := NewTrieStatisticsProvider(args)
+		assert.Equal(t, errInvalidNumCachedRootHashes, err)
+		assert.True(t, check.IfNil(provider))
+	})
+	t.Run("invalid number of largest data tries should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsTrieStatisticsProvider()
+		args.NumLargestDataTries = 0
+		provider, err := NewTrieStatisticsProvider(args)
+		assert.Equal(t, errInvalidNumLargestDataTries, err)
+		assert.True(t, check.IfNil(provider))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		provider, err := NewTrieStatisticsProvider(createMockArgsTrieStatisticsProvider())
+		assert.Nil(t, err)
+		assert.False(t, check.IfNil(provider))
+	})
+}
+
+func TestTrieStatisticsProvider_GetTrieStatistics(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid root hash should error", func(t *testing.T) {
+		t.Parallel()
+
+		provider, _ := NewTrieStatisticsProvider(createMockArgsTrieStatisticsProvider())
+		statistics, err := provider.GetTrieStatistics("not hex")
+		assert.NotNil(t, err)
+		assert.Nil(t, statistics)
+	})
+	t.Run("no current root hash should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsTrieStatisticsProvider()
+		args.ChainHandler = &testscommon.ChainHandlerStub{}
+		provider, _ := NewTrieStatisticsProvider(args)
+		statistics, err := provider.GetTrieStatistics("")
+		assert.Equal(t, errNoCurrentRootHash, err)
+		assert.Nil(t, statistics)
+	})
+	t.Run("should compute in background and cache the statistics of the current root hash", func(t *testing.T) {
+		t.Parallel()
+
+		numCalls := 0
+		args := createMockArgsTrieStatisticsProvider()
+		args.Accounts = &stateMock.AccountsStub{
+			GetStatsForRootHashCalled: func(rootHash []byte, numLargestDataTries uint32) (common.TriesStatisticsCollector, error) {
+				assert.Equal(t, currentRootHash, rootHash)
+				assert.Equal(t, args.NumLargestDataTries, numLargestDataTries)
+				numCalls++
+				return createTriesStatistics(), nil
+			},
+		}
+		provider, _ := NewTrieStatisticsProvider(args)
+
+		statistics := getStatisticsWhenReady(t, provider, "")
+		assert.Equal(t, StatusReady, statistics.Status)
+		assert.Equal(t, hex.EncodeToString(currentRootHash), statistics.RootHash)
+		assert.Equal(t, uint64(3), statistics.NumAccounts)
+		assert.Equal(t, uint64(2), statistics.NumDataTries)
+		assert.Equal(t, uint64(1), statistics.NumBranchNodes)
+		assert.Equal(t, uint64(2), statistics.NumExtensionNodes)
+		assert.Equal(t, uint64(5), statistics.NumLeafNodes)
+		assert.Equal(t, uint64(240), statistics.MainTrieSize)
+		assert.Equal(t, uint64(70), statistics.DataTriesSize)
+		assert.Equal(t, uint64(310), statistics.TotalSize)
+		assert.Equal(t, uint32(1), statistics.MaxMainTrieDepth)
+		assert.Equal(t, []*common.TrieNodesPerDepth{
+			{Depth: 0, NumBranchNodes: 1},
+			{Depth: 1, NumLeafNodes: 3},
+		}, statistics.MainTrieNodes)
+		require.Equal(t, 2, len(statistics.LargestDataTries))
+		assert.Equal(t, "address1", statistics.LargestDataTries[0].Address)
+		assert.Equal(t, uint64(40), statistics.LargestDataTries[0].TotalSize)
+		assert.Equal(t, "address0", statistics.LargestDataTries[1].Address)
+
+		cachedStatistics, err := provider.GetTrieStatistics(hex.EncodeToString(currentRootHash))
+		assert.Nil(t, err)
+		assert.Equal(t, statistics, cachedStatistics)
+		assert.Equal(t, 1, numCalls)
+	})
+	t.Run("computation for another root hash in progress should error", func(t *testing.T) {
+		t.Parallel()
+
+		chDone := make(chan struct{})
+		args := createMockArgsTrieStatisticsProvider()
+		args.Accounts = &stateMock.AccountsStub{
+			GetStatsForRootHashCalled: func(rootHash []byte, numLargestDataTries uint32) (common.TriesStatisticsCollector, error) {
+				<-chDone
+				return createTriesStatistics(), nil
+			},
+		}
+		provider, _ := NewTrieStatisticsProvider(args)
+
+		statistics, err := provider.GetTrieStatistics("aa")
+		assert.Nil(t, err)
+		assert.Equal(t, StatusInProgress, statistics.Status)
+
+		statistics, err = provider.GetTrieStatistics("aa")
+		assert.Nil(t, err)
+		assert.Equal(t, StatusInProgress, statistics.Status)
+
+		statistics, err = provider.GetTrieStatistics("bb")
+		assert.True(t, errors.Is(err, errComputationInProgress))
+		assert.Nil(t, statistics)
+
+		close(chDone)
+		statistics = getStatisticsWhenReady(t, provider, "aa")
+		assert.Equal(t, StatusReady, statistics.Status)
+	})
+	t.Run("failed computation should be reported once and then retried", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		numCalls := 0
+		args := createMockArgsTrieStatisticsProvider()
+		args.Accounts = &stateMock.AccountsStub{
+			GetStatsForRootHashCalled: func(rootHash []byte, numLargestDataTries uint32) (common.TriesStatisticsCollector, error) {
+				numCalls++
+				return nil, expectedErr
+			},
+		}
+		provider, _ := NewTrieStatisticsProvider(args)
+
+		statistics := getStatisticsWhenReady(t, provider, "aa")
+		assert.Equal(t, StatusFailed, statistics.Status)
+		assert.Equal(t, expectedErr.Error(), statistics.Error)
+
+		statistics = getStatisticsWhenReady(t, provider, "aa")
+		assert.Equal(t, StatusFailed, statistics.Status)
+		assert.Equal(t, 2, numCalls)
+	})
+	t.Run("should evict the oldest root hash", func(t *testing.T) {
+		t.Parallel()
+
+		provider, _ := NewTrieStatisticsProvider(createMockArgsTrieStatisticsProvider())
+		_ = getStatisticsWhenReady(t, provider, "aa")
+		_ = getStatisticsWhenReady(t, provider, "bb")
+		_ = getStatisticsWhenReady(t, provider, "cc")
+
+		provider.mutStatistics.Lock()
+		_, found := provider.statistics[string([]byte{0xaa})]
+		assert.False(t, found)
+		assert.Equal(t, 2, len(provider.statistics))
+		assert.Equal(t, 2, len(provider.cachedRootHashes))
+		provider.mutStatistics.Unlock()
+	})
+}
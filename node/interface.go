@@ -5,10 +5,12 @@ import (
 	"time"
 
 	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-go/dataRetriever/txpool"
 	"github.com/multiversx/mx-chain-go/debug"
 	"github.com/multiversx/mx-chain-go/facade"
 	mainFactory "github.com/multiversx/mx-chain-go/factory"
 	"github.com/multiversx/mx-chain-go/p2p"
+	"github.com/multiversx/mx-chain-go/sharding"
 	"github.com/multiversx/mx-chain-go/update"
 	vmcommon "github.com/multiversx/mx-chain-vm-common-go"
 )
@@ -91,3 +93,8 @@ type NodeHandler interface {
 	GetRunTypeComponents() mainFactory.RunTypeComponentsHolder
 	Close() error
 }
+
+// TransactionsPoolRestorer defines a transactions pool able to restore its content from a journal
+type TransactionsPoolRestorer interface {
+	RestoreFromJournal(validator txpool.TxValidator, shardCoordinator sharding.Coordinator) (int, error)
+}
@@ -4,11 +4,14 @@ import (
 	"context"
 
 	"github.com/multiversx/mx-chain-core-go/data/api"
+	"github.com/multiversx/mx-chain-go/common"
 )
 
 // DelegatedListProcessorStub -
 type DelegatedListProcessorStub struct {
-	GetDelegatorsListCalled func(ctx context.Context) ([]*api.Delegator, error)
+	GetDelegatorsListCalled      func(ctx context.Context) ([]*api.Delegator, error)
+	GetDelegationContractCalled  func(ctx context.Context, address string, page uint32, pageSize uint32) (*common.DelegationContractAPIResponse, error)
+	GetDelegationPositionsCalled func(ctx context.Context, address string) (*common.DelegationPositionsAPIResponse, error)
 }
 
 // GetDelegatorsList -
@@ -20,6 +23,24 @@ func (dlps *DelegatedListProcessorStub) GetDelegatorsList(ctx context.Context) (
 	return nil, nil
 }
 
+// GetDelegationContract -
+func (dlps *DelegatedListProcessorStub) GetDelegationContract(ctx context.Context, address string, page uint32, pageSize uint32) (*common.DelegationContractAPIResponse, error) {
+	if dlps.GetDelegationContractCalled != nil {
+		return dlps.GetDelegationContractCalled(ctx, address, page, pageSize)
+	}
+
+	return nil, nil
+}
+
+// GetDelegationPositions -
+func (dlps *DelegatedListProcessorStub) GetDelegationPositions(ctx context.Context, address string) (*common.DelegationPositionsAPIResponse, error) {
+	if dlps.GetDelegationPositionsCalled != nil {
+		return dlps.GetDelegationPositionsCalled(ctx, address)
+	}
+
+	return nil, nil
+}
+
 // IsInterfaceNil -
 func (dlps *DelegatedListProcessorStub) IsInterfaceNil() bool {
 	return dlps == nil
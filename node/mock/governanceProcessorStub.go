@@ -0,0 +1,46 @@
+package mock
+
+import (
+	"context"
+
+	"github.com/multiversx/mx-chain-go/common"
+)
+
+// GovernanceProcessorStub -
+type GovernanceProcessorStub struct {
+	GetGovernanceProposalsCalled func(ctx context.Context) (*common.GovernanceProposalsAPIResponse, error)
+	GetGovernanceProposalCalled  func(nonce uint64) (*common.GovernanceProposalAPIResponse, error)
+	GetGovernanceVotesCalled     func(address string) (*common.GovernanceVotesAPIResponse, error)
+}
+
+// GetGovernanceProposals -
+func (gps *GovernanceProcessorStub) GetGovernanceProposals(ctx context.Context) (*common.GovernanceProposalsAPIResponse, error) {
+	if gps.GetGovernanceProposalsCalled != nil {
+		return gps.GetGovernanceProposalsCalled(ctx)
+	}
+
+	return nil, nil
+}
+
+// GetGovernanceProposal -
+func (gps *GovernanceProcessorStub) GetGovernanceProposal(nonce uint64) (*common.GovernanceProposalAPIResponse, error) {
+	if gps.GetGovernanceProposalCalled != nil {
+		return gps.GetGovernanceProposalCalled(nonce)
+	}
+
+	return nil, nil
+}
+
+// GetGovernanceVotes -
+func (gps *GovernanceProcessorStub) GetGovernanceVotes(address string) (*common.GovernanceVotesAPIResponse, error) {
+	if gps.GetGovernanceVotesCalled != nil {
+		return gps.GetGovernanceVotesCalled(address)
+	}
+
+	return nil, nil
+}
+
+// IsInterfaceNil -
+func (gps *GovernanceProcessorStub) IsInterfaceNil() bool {
+	return gps == nil
+}
@@ -48,6 +48,9 @@ import (
 const (
 	// esdtTickerNumChars represents the number of hex-encoded characters of a ticker
 	esdtTickerNumChars = 6
+
+	// maxValidatorHistoryEpochs represents the maximum number of epochs returned by a validator history request
+	maxValidatorHistoryEpochs = 100
 )
 
 var log = logger.GetOrCreate("node")
@@ -812,6 +815,7 @@ func (n *Node) commonTransactionValidation(
 		enableSignWithTxHash,
 		n.coreComponents.TxSignHasher(),
 		n.coreComponents.TxVersionChecker(),
+		n.getTxReplacementChecker(),
 	)
 	if err != nil {
 		return nil, nil, err
@@ -822,9 +826,25 @@ func (n *Node) commonTransactionValidation(
 		return nil, nil, err
 	}
 
+	err = intTx.CheckReplacement()
+	if err != nil {
+		return nil, nil, err
+	}
+
 	return txValidator, intTx, nil
 }
 
+// getTxReplacementChecker returns the transactions pool, able to tell if a transaction can replace the pooled one
+// with the same sender and nonce
+func (n *Node) getTxReplacementChecker() process.TxReplacementChecker {
+	if check.IfNil(n.dataComponents) || check.IfNil(n.dataComponents.Datapool()) {
+		return nil
+	}
+
+	txReplacementChecker, _ := n.dataComponents.Datapool().Transactions().(process.TxReplacementChecker)
+	return txReplacementChecker
+}
+
 func (n *Node) checkSenderIsInShard(tx *transaction.Transaction) error {
 	shardCoordinator := n.bootstrapComponents.ShardCoordinator()
 	senderShardID := shardCoordinator.ComputeId(tx.SndAddr)
@@ -1084,6 +1104,183 @@ func (n *Node) AuctionListApi() ([]*common.AuctionListValidatorAPIResponse, erro
 	return n.processComponents.ValidatorsProvider().GetAuctionList()
 }
 
+// SimulateAuctionApi will return the outcome of the auction selection after applying the provided owners changes. The
+// selection is seeded with the current block randomness, as the epoch start selection is seeded with the randomness of
+// the block preceding the epoch start block
+func (n *Node) SimulateAuctionApi(changes []*common.AuctionSimulationOwnerChange) (*common.AuctionSimulationAPIResponse, error) {
+	currentHeader := n.dataComponents.Blockchain().GetCurrentBlockHeader()
+	if check.IfNil(currentHeader) {
+		return nil, process.ErrNilBlockHeader
+	}
+
+	return n.processComponents.ValidatorsProvider().SimulateAuction(changes, currentHeader.GetRandSeed())
+}
+
+// GetNodesShufflingPreview will return the nodes lists of each shard projected for the next epoch. The randomness of
+// the next epoch is known only after the epoch start was triggered, the current block randomness being used otherwise.
+// Works only on metachain nodes
+func (n *Node) GetNodesShufflingPreview() (*common.NodesShufflingPreviewAPIResponse, error) {
+	if n.processComponents.ShardCoordinator().SelfId() != core.MetachainShardId {
+		return nil, ErrMetachainOnlyEndpoint
+	}
+
+	currentHeader := n.dataComponents.Blockchain().GetCurrentBlockHeader()
+	if check.IfNil(currentHeader) {
+		return nil, process.ErrNilBlockHeader
+	}
+
+	preview, err := n.processComponents.ValidatorsProvider().GetNodesShufflingPreview(
+		currentHeader.GetRandSeed(),
+		currentHeader.GetEpoch()+1,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	preview.RandomnessKnown = n.processComponents.EpochStartTrigger().IsEpochStart()
+
+	return preview, nil
+}
+
+// GetConsensusRoundsTimeline returns the consensus timelines of the last rounds recorded by the node, as seen by it
+func (n *Node) GetConsensusRoundsTimeline(numRounds uint32) ([]*common.ConsensusRoundTimeline, error) {
+	if check.IfNil(n.consensusComponents) {
+		return nil, ErrNilRoundTimelineRecorder
+	}
+
+	roundTimelineRecorder := n.consensusComponents.RoundTimelineRecorder()
+	if check.IfNil(roundTimelineRecorder) {
+		return nil, ErrNilRoundTimelineRecorder
+	}
+
+	return roundTimelineRecorder.GetLastRounds(numRounds), nil
+}
+
+// GetValidatorRewardsHistory returns the rewards breakdown of the provided validator for each epoch in the provided
+// range. If not provided, the range ends with the current epoch and spans the maximum number of epochs allowed.
+// Works only on metachain nodes, the history being recorded only if the db lookup extensions are enabled
+func (n *Node) GetValidatorRewardsHistory(
+	blsKey string,
+	fromEpoch core.OptionalUint32,
+	toEpoch core.OptionalUint32,
+) ([]*common.ValidatorRewardsBreakdown, error) {
+	history := make([]*common.ValidatorRewardsBreakdown, 0)
+	err := n.readValidatorHistory(blsKey, fromEpoch, toEpoch, dataRetriever.ValidatorRewardsUnit, func(_ uint32, marshalledData []byte) error {
+		breakdown := &common.ValidatorRewardsBreakdown{}
+		errUnmarshal := n.coreComponents.InternalMarshalizer().Unmarshal(breakdown, marshalledData)
+		if errUnmarshal != nil {
+			return errUnmarshal
+		}
+
+		breakdown.BlsKey = blsKey
+		history = append(history, breakdown)
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return history, nil
+}
+
+// GetValidatorRatingsHistory returns the start and end ratings of the provided validator for each epoch in the
+// provided range, along with the counters that changed them. The ratings are returned as percentages of the maximum
+// rating, as in the validator statistics. The range defaults the same way as for the rewards history. Works only on
+// metachain nodes, the history being recorded only if the db lookup extensions are enabled
+func (n *Node) GetValidatorRatingsHistory(
+	blsKey string,
+	fromEpoch core.OptionalUint32,
+	toEpoch core.OptionalUint32,
+) ([]*common.ValidatorRatingHistory, error) {
+	history := make([]*common.ValidatorRatingHistory, 0)
+	maxRating := float32(n.coreComponents.RatingsData().MaxRating())
+	err := n.readValidatorHistory(blsKey, fromEpoch, toEpoch, dataRetriever.ValidatorRatingsUnit, func(epoch uint32, marshalledData []byte) error {
+		validatorInfo := &state.ValidatorInfo{}
+		errUnmarshal := n.coreComponents.InternalMarshalizer().Unmarshal(validatorInfo, marshalledData)
+		if errUnmarshal != nil {
+			return errUnmarshal
+		}
+
+		history = append(history, &common.ValidatorRatingHistory{
+			Epoch:                      epoch,
+			ShardID:                    validatorInfo.ShardId,
+			BlsKey:                     blsKey,
+			List:                       validatorInfo.List,
+			PreviousList:               validatorInfo.PreviousList,
+			Jailed:                     validatorInfo.List == string(common.JailedList),
+			StartRating:                float32(validatorInfo.Rating) * 100 / maxRating,
+			EndRating:                  float32(validatorInfo.TempRating) * 100 / maxRating,
+			LeaderSuccess:              validatorInfo.LeaderSuccess,
+			LeaderFailure:              validatorInfo.LeaderFailure,
+			ValidatorSuccess:           validatorInfo.ValidatorSuccess,
+			ValidatorFailure:           validatorInfo.ValidatorFailure,
+			ValidatorIgnoredSignatures: validatorInfo.ValidatorIgnoredSignatures,
+		})
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return history, nil
+}
+
+// readValidatorHistory calls the provided handler, in ascending order of the epochs, with each record stored for the
+// provided validator in the provided unit. Missing epochs are skipped
+func (n *Node) readValidatorHistory(
+	blsKey string,
+	fromEpoch core.OptionalUint32,
+	toEpoch core.OptionalUint32,
+	unitType dataRetriever.UnitType,
+	handler func(epoch uint32, marshalledData []byte) error,
+) error {
+	if n.processComponents.ShardCoordinator().SelfId() != core.MetachainShardId {
+		return ErrMetachainOnlyEndpoint
+	}
+
+	pubKey, err := n.coreComponents.ValidatorPubKeyConverter().Decode(blsKey)
+	if err != nil {
+		return fmt.Errorf("%w: %v", ErrInvalidValidatorPubKey, err)
+	}
+
+	lastEpoch := n.coreComponents.EpochNotifier().CurrentEpoch()
+	if toEpoch.HasValue {
+		lastEpoch = toEpoch.Value
+	}
+	firstEpoch := uint32(0)
+	if lastEpoch >= maxValidatorHistoryEpochs {
+		firstEpoch = lastEpoch - maxValidatorHistoryEpochs + 1
+	}
+	if fromEpoch.HasValue {
+		firstEpoch = fromEpoch.Value
+	}
+	if firstEpoch > lastEpoch || lastEpoch-firstEpoch >= maxValidatorHistoryEpochs {
+		return fmt.Errorf("%w, from epoch %d to epoch %d, maximum %d epochs",
+			ErrInvalidEpochsRange, firstEpoch, lastEpoch, maxValidatorHistoryEpochs)
+	}
+
+	storer, err := n.dataComponents.StorageService().GetStorer(unitType)
+	if err != nil {
+		return fmt.Errorf("%w for identifier %s", err, unitType.String())
+	}
+
+	for epoch := uint64(firstEpoch); epoch <= uint64(lastEpoch); epoch++ {
+		marshalledData, errGet := storer.Get(common.ValidatorEpochKey(pubKey, uint32(epoch)))
+		if errGet != nil {
+			continue
+		}
+
+		err = handler(uint32(epoch), marshalledData)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 // DirectTrigger will start the hardfork trigger
 func (n *Node) DirectTrigger(epoch uint32, withEarlyEndOfEpoch bool) error {
 	return n.processComponents.HardforkTrigger().Trigger(epoch, withEarlyEndOfEpoch)
@@ -1478,6 +1675,45 @@ func (n *Node) IsDataTrieMigrated(address string, options api.AccountQueryOption
 	return acc.IsDataTrieMigrated()
 }
 
+// GetDataTrieStatistics returns the size statistics of the data trie for the given address
+func (n *Node) GetDataTrieStatistics(address string, options api.AccountQueryOptions) (*common.DataTrieStatisticsAPIResponse, api.BlockInfo, error) {
+	userAccount, blockInfo, err := n.loadUserAccountHandlerByAddress(address, options)
+	if err != nil {
+		adaptedBlockInfo, isEmptyAccount := extractBlockInfoIfNewAccount(err)
+		if isEmptyAccount {
+			return &common.DataTrieStatisticsAPIResponse{Address: address}, adaptedBlockInfo, nil
+		}
+
+		return nil, api.BlockInfo{}, err
+	}
+
+	statistics := &common.DataTrieStatisticsAPIResponse{
+		Address: address,
+	}
+	rootHash := userAccount.GetRootHash()
+	if common.IsEmptyTrie(rootHash) || check.IfNil(userAccount.DataTrie()) {
+		return statistics, blockInfo, nil
+	}
+
+	dataTrie, ok := userAccount.DataTrie().(common.TrieStats)
+	if !ok {
+		return nil, api.BlockInfo{}, fmt.Errorf("wrong type assertion for the data trie of address %s, data trie type %T", address, userAccount.DataTrie())
+	}
+
+	trieStats, err := dataTrie.GetTrieStats(address, rootHash)
+	if err != nil {
+		return nil, api.BlockInfo{}, err
+	}
+
+	statistics.RootHash = hex.EncodeToString(rootHash)
+	statistics.TotalSize = trieStats.GetTotalNodesSize()
+	statistics.NumNodes = trieStats.GetTotalNumNodes()
+	statistics.NumLeaves = trieStats.GetNumLeafNodes()
+	statistics.MaxTrieDepth = trieStats.GetMaxTrieDepth()
+
+	return statistics, blockInfo, nil
+}
+
 func (n *Node) getRootHashAndAddressAsBytes(rootHash string, address string) ([]byte, []byte, error) {
 	rootHashBytes, err := hex.DecodeString(rootHash)
 	if err != nil {
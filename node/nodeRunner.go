@@ -24,6 +24,7 @@ import (
 	"github.com/multiversx/mx-chain-go/consensus/spos"
 	"github.com/multiversx/mx-chain-go/consensus/spos/bls"
 	"github.com/multiversx/mx-chain-go/dataRetriever"
+	"github.com/multiversx/mx-chain-go/dataRetriever/txpool"
 	dbLookupFactory "github.com/multiversx/mx-chain-go/dblookupext/factory"
 	"github.com/multiversx/mx-chain-go/facade"
 	"github.com/multiversx/mx-chain-go/facade/initial"
@@ -530,6 +531,8 @@ func (nr *nodeRunner) executeOneComponentCreationCycle(
 		return true, err
 	}
 
+	restoreTransactionsPoolFromJournal(managedDataComponents.Datapool().Transactions(), nodeHandler, managedBootstrapComponents.ShardCoordinator())
+
 	if managedBootstrapComponents.ShardCoordinator().SelfId() == core.MetachainShardId {
 		log.Debug("activating nodesCoordinator's validators indexing")
 		indexValidatorsListIfNeeded(
@@ -742,6 +745,7 @@ func (nr *nodeRunner) createApiFacade(
 		DelegatedListFactoryHandler:    trieIteratorsFactory.NewDelegatedListProcessorFactory(),
 		DirectStakedListFactoryHandler: trieIteratorsFactory.NewDirectStakedListProcessorFactory(),
 		TotalStakedValueFactoryHandler: trieIteratorsFactory.NewTotalStakedListProcessorFactory(),
+		GovernanceFactoryHandler:       trieIteratorsFactory.NewGovernanceProcessorFactory(),
 	}
 
 	apiResolver, err := apiComp.CreateApiResolver(apiResolverArgs)
@@ -1750,6 +1754,25 @@ func indexValidatorsListIfNeeded(
 	}
 }
 
+func restoreTransactionsPoolFromJournal(
+	txPool dataRetriever.ShardedDataCacherNotifier,
+	validator txpool.TxValidator,
+	shardCoordinator sharding.Coordinator,
+) {
+	restorer, ok := txPool.(TransactionsPoolRestorer)
+	if !ok {
+		return
+	}
+
+	numRestored, err := restorer.RestoreFromJournal(validator, shardCoordinator)
+	if err != nil {
+		log.Warn("could not restore the transactions pool from journal", "error", err)
+		return
+	}
+
+	log.Debug("restored the transactions pool from journal", "num transactions", numRestored)
+}
+
 func enableGopsIfNeeded(gopsEnabled bool) {
 	if gopsEnabled {
 		if err := agent.Listen(agent.Options{}); err != nil {
@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"context"
 	"crypto/rand"
+	"encoding/binary"
 	"encoding/hex"
 	"errors"
 	"fmt"
@@ -51,6 +52,8 @@ import (
 	"github.com/multiversx/mx-chain-go/storage"
 	"github.com/multiversx/mx-chain-go/testscommon"
 	"github.com/multiversx/mx-chain-go/testscommon/bootstrapMocks"
+	consensusMocks "github.com/multiversx/mx-chain-go/testscommon/consensus"
+	"github.com/multiversx/mx-chain-go/testscommon/consensus/factoryMocks"
 	dataRetrieverMock "github.com/multiversx/mx-chain-go/testscommon/dataRetriever"
 	"github.com/multiversx/mx-chain-go/testscommon/dblookupext"
 	"github.com/multiversx/mx-chain-go/testscommon/economicsmocks"
@@ -70,6 +73,7 @@ import (
 	"github.com/multiversx/mx-chain-go/testscommon/storageManager"
 	trieMock "github.com/multiversx/mx-chain-go/testscommon/trie"
 	"github.com/multiversx/mx-chain-go/testscommon/txsSenderMock"
+	"github.com/multiversx/mx-chain-go/trie/statistics"
 	"github.com/multiversx/mx-chain-go/vm/systemSmartContracts"
 	vmcommon "github.com/multiversx/mx-chain-vm-common-go"
 	"github.com/stretchr/testify/assert"
@@ -3148,6 +3152,41 @@ func TestValidateTransaction_ShouldAdaptAccountNotFoundError(t *testing.T) {
 	require.Equal(t, "insufficient funds for address erd1xycnzvf3xycnzvf3xycnzvf3xycnzvf3xycnzvf3xycnzvf3xycspcqad6", err.Error())
 }
 
+func TestValidateTransaction_RejectedReplacementShouldErr(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("replacement underpriced")
+	dataComponents := getDefaultDataComponents()
+	dataComponents.DataPool = &dataRetrieverMock.PoolsHolderStub{
+		TransactionsCalled: func() dataRetriever.ShardedDataCacherNotifier {
+			return &txReplacementCheckerPoolStub{
+				ShardedDataStub: testscommon.NewShardedDataStub(),
+				checkTxReplacementCalled: func(txHash []byte, tx data.TransactionHandler, cacheID string) error {
+					return expectedErr
+				},
+			}
+		},
+	}
+	n, _ := node.NewNode(
+		node.WithCoreComponents(getDefaultCoreComponents()),
+		node.WithBootstrapComponents(getDefaultBootstrapComponents()),
+		node.WithProcessComponents(getDefaultProcessComponents()),
+		node.WithStateComponents(getDefaultStateComponents()),
+		node.WithCryptoComponents(getDefaultCryptoComponents()),
+		node.WithDataComponents(dataComponents),
+	)
+
+	tx := &transaction.Transaction{
+		SndAddr:   bytes.Repeat([]byte("1"), 32),
+		RcvAddr:   bytes.Repeat([]byte("1"), 32),
+		Value:     big.NewInt(37),
+		Signature: []byte("signature"),
+		ChainID:   []byte("chainID"),
+	}
+	err := n.ValidateTransaction(tx)
+	require.Equal(t, expectedErr, err)
+}
+
 func TestCreateShardedStores_NilShardCoordinatorShouldError(t *testing.T) {
 	messenger := getMessenger()
 	dataPool := dataRetrieverMock.NewPoolsHolderStub()
@@ -4573,6 +4612,103 @@ func TestNode_IsDataTrieMigrated(t *testing.T) {
 	})
 }
 
+func TestNode_GetDataTrieStatistics(t *testing.T) {
+	t.Parallel()
+
+	address := "erd1qqqqqqqqqqqqqqqpqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqplllst77y4l"
+	createNodeWithAccount := func(acc vmcommon.AccountHandler, err error) *node.Node {
+		stateComponents := getDefaultStateComponents()
+		stateComponents.AccountsRepo = &stateMock.AccountsRepositoryStub{
+			GetAccountWithBlockInfoCalled: func(_ []byte, _ api.AccountQueryOptions) (vmcommon.AccountHandler, common.BlockInfo, error) {
+				return acc, nil, err
+			},
+		}
+
+		n, _ := node.NewNode(
+			node.WithStateComponents(stateComponents),
+			node.WithCoreComponents(getDefaultCoreComponents()),
+		)
+
+		return n
+	}
+
+	t.Run("invalid address should error", func(t *testing.T) {
+		t.Parallel()
+
+		n := createNodeWithAccount(nil, nil)
+		dataTrieStatistics, _, err := n.GetDataTrieStatistics("invalid address", api.AccountQueryOptions{})
+		assert.Nil(t, dataTrieStatistics)
+		assert.NotNil(t, err)
+	})
+	t.Run("load account error should error", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("load account error")
+		n := createNodeWithAccount(nil, expectedErr)
+		dataTrieStatistics, _, err := n.GetDataTrieStatistics(address, api.AccountQueryOptions{})
+		assert.Nil(t, dataTrieStatistics)
+		assert.Equal(t, expectedErr, err)
+	})
+	t.Run("account without data trie should return empty statistics", func(t *testing.T) {
+		t.Parallel()
+
+		acc := createAcc([]byte("000000000000000000010000000000000000000000000000000000000001ffff"))
+		n := createNodeWithAccount(acc, nil)
+		dataTrieStatistics, _, err := n.GetDataTrieStatistics(address, api.AccountQueryOptions{})
+		assert.Nil(t, err)
+		assert.Equal(t, &common.DataTrieStatisticsAPIResponse{Address: address}, dataTrieStatistics)
+	})
+	t.Run("get trie stats error should error", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("trie stats error")
+		acc := createAcc([]byte("000000000000000000010000000000000000000000000000000000000001ffff"))
+		acc.SetRootHash([]byte("root hash"))
+		acc.SetDataTrie(&trieMock.TrieStub{
+			GetTrieStatsCalled: func(_ string, _ []byte) (common.TrieStatisticsHandler, error) {
+				return nil, expectedErr
+			},
+		})
+
+		n := createNodeWithAccount(acc, nil)
+		dataTrieStatistics, _, err := n.GetDataTrieStatistics(address, api.AccountQueryOptions{})
+		assert.Nil(t, dataTrieStatistics)
+		assert.Equal(t, expectedErr, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		rootHash := []byte("root hash")
+		acc := createAcc([]byte("000000000000000000010000000000000000000000000000000000000001ffff"))
+		acc.SetRootHash(rootHash)
+		acc.SetDataTrie(&trieMock.TrieStub{
+			GetTrieStatsCalled: func(addr string, providedRootHash []byte) (common.TrieStatisticsHandler, error) {
+				assert.Equal(t, address, addr)
+				assert.Equal(t, rootHash, providedRootHash)
+
+				trieStats := statistics.NewTrieStatistics()
+				trieStats.AddBranchNode(0, 100)
+				trieStats.AddLeafNode(1, 30, core.NotSpecified)
+				trieStats.AddLeafNode(1, 20, core.NotSpecified)
+
+				return trieStats, nil
+			},
+		})
+
+		n := createNodeWithAccount(acc, nil)
+		dataTrieStatistics, _, err := n.GetDataTrieStatistics(address, api.AccountQueryOptions{})
+		assert.Nil(t, err)
+		assert.Equal(t, &common.DataTrieStatisticsAPIResponse{
+			Address:      address,
+			RootHash:     hex.EncodeToString(rootHash),
+			TotalSize:    150,
+			NumNodes:     3,
+			NumLeaves:    2,
+			MaxTrieDepth: 1,
+		}, dataTrieStatistics)
+	})
+}
+
 func TestGetESDTSupplyError(t *testing.T) {
 	t.Parallel()
 
@@ -4711,6 +4847,359 @@ func TestNode_Getters(t *testing.T) {
 	assert.True(t, n.IsInImportMode())
 }
 
+func TestNode_GetValidatorRewardsHistory(t *testing.T) {
+	t.Parallel()
+
+	blsKey := []byte("blsKey")
+	encodedBlsKey := hex.EncodeToString(blsKey)
+	marshaller := &marshal.GogoProtoMarshalizer{}
+	createNode := func(selfShardID uint32, currentEpoch uint32, unit storage.Storer) *node.Node {
+		processComponents := getDefaultProcessComponents()
+		processComponents.ShardCoord = &mock.ShardCoordinatorMock{
+			SelfShardId: selfShardID,
+		}
+		coreComponents := getDefaultCoreComponents()
+		coreComponents.IntMarsh = marshaller
+		coreComponents.EpochChangeNotifier = &epochNotifier.EpochNotifierStub{
+			CurrentEpochCalled: func() uint32 {
+				return currentEpoch
+			},
+		}
+		dataComponents := getDefaultDataComponents()
+		storageService := dataComponents.StorageService().(*mockStorage.ChainStorerStub)
+		storageService.GetStorerCalled = func(unitType dataRetriever.UnitType) (storage.Storer, error) {
+			require.Equal(t, dataRetriever.ValidatorRewardsUnit, unitType)
+			return unit, nil
+		}
+
+		n, _ := node.NewNode(
+			node.WithCoreComponents(coreComponents),
+			node.WithDataComponents(dataComponents),
+			node.WithProcessComponents(processComponents),
+		)
+
+		return n
+	}
+	createUnit := func(epochs ...uint32) (storage.Storer, *[]uint32) {
+		requestedEpochs := make([]uint32, 0)
+		stored := make(map[string][]byte)
+		for _, epoch := range epochs {
+			stored[string(common.ValidatorEpochKey(blsKey, epoch))], _ = marshaller.Marshal(&common.ValidatorRewardsBreakdown{
+				Epoch:      epoch,
+				BaseReward: "100",
+			})
+		}
+
+		unit := &mockStorage.StorerStub{
+			GetCalled: func(key []byte) ([]byte, error) {
+				requestedEpochs = append(requestedEpochs, binary.BigEndian.Uint32(key[len(blsKey):]))
+				value, found := stored[string(key)]
+				if !found {
+					return nil, errors.New("key not found")
+				}
+
+				return value, nil
+			},
+		}
+
+		return unit, &requestedEpochs
+	}
+
+	t.Run("shard node should error", func(t *testing.T) {
+		t.Parallel()
+
+		n := createNode(0, 10, &mockStorage.StorerStub{})
+		history, err := n.GetValidatorRewardsHistory(encodedBlsKey, core.OptionalUint32{}, core.OptionalUint32{})
+		require.Nil(t, history)
+		require.Equal(t, node.ErrMetachainOnlyEndpoint, err)
+	})
+	t.Run("invalid bls key should error", func(t *testing.T) {
+		t.Parallel()
+
+		n := createNode(core.MetachainShardId, 10, &mockStorage.StorerStub{})
+		history, err := n.GetValidatorRewardsHistory("not hex", core.OptionalUint32{}, core.OptionalUint32{})
+		require.Nil(t, history)
+		require.True(t, errors.Is(err, node.ErrInvalidValidatorPubKey))
+	})
+	t.Run("invalid epochs range should error", func(t *testing.T) {
+		t.Parallel()
+
+		n := createNode(core.MetachainShardId, 10, &mockStorage.StorerStub{})
+		history, err := n.GetValidatorRewardsHistory(
+			encodedBlsKey,
+			core.OptionalUint32{Value: 5, HasValue: true},
+			core.OptionalUint32{Value: 4, HasValue: true},
+		)
+		require.Nil(t, history)
+		require.True(t, errors.Is(err, node.ErrInvalidEpochsRange))
+
+		history, err = n.GetValidatorRewardsHistory(
+			encodedBlsKey,
+			core.OptionalUint32{Value: 0, HasValue: true},
+			core.OptionalUint32{Value: 100, HasValue: true},
+		)
+		require.Nil(t, history)
+		require.True(t, errors.Is(err, node.ErrInvalidEpochsRange))
+	})
+	t.Run("should return the stored epochs of the requested range", func(t *testing.T) {
+		t.Parallel()
+
+		unit, requestedEpochs := createUnit(2, 4, 7)
+		n := createNode(core.MetachainShardId, 10, unit)
+		history, err := n.GetValidatorRewardsHistory(
+			encodedBlsKey,
+			core.OptionalUint32{Value: 3, HasValue: true},
+			core.OptionalUint32{Value: 8, HasValue: true},
+		)
+		require.Nil(t, err)
+		require.Equal(t, []uint32{3, 4, 5, 6, 7, 8}, *requestedEpochs)
+		require.Equal(t, []*common.ValidatorRewardsBreakdown{
+			{Epoch: 4, BlsKey: encodedBlsKey, BaseReward: "100"},
+			{Epoch: 7, BlsKey: encodedBlsKey, BaseReward: "100"},
+		}, history)
+	})
+	t.Run("should default to the latest epochs", func(t *testing.T) {
+		t.Parallel()
+
+		unit, requestedEpochs := createUnit(150)
+		n := createNode(core.MetachainShardId, 150, unit)
+		history, err := n.GetValidatorRewardsHistory(encodedBlsKey, core.OptionalUint32{}, core.OptionalUint32{})
+		require.Nil(t, err)
+		require.Len(t, *requestedEpochs, 100)
+		require.Equal(t, uint32(51), (*requestedEpochs)[0])
+		require.Len(t, history, 1)
+		require.Equal(t, uint32(150), history[0].Epoch)
+	})
+}
+
+func TestNode_GetValidatorRatingsHistory(t *testing.T) {
+	t.Parallel()
+
+	blsKey := []byte("blsKey")
+	encodedBlsKey := hex.EncodeToString(blsKey)
+	createNode := func(selfShardID uint32, unit storage.Storer) *node.Node {
+		processComponents := getDefaultProcessComponents()
+		processComponents.ShardCoord = &mock.ShardCoordinatorMock{
+			SelfShardId: selfShardID,
+		}
+		coreComponents := getDefaultCoreComponents()
+		coreComponents.EpochChangeNotifier = &epochNotifier.EpochNotifierStub{
+			CurrentEpochCalled: func() uint32 {
+				return 10
+			},
+		}
+		coreComponents.IntMarsh = getMarshalizer()
+		coreComponents.RatingsConfig = &testscommon.RatingsInfoMock{
+			MaxRatingProperty: 10000000,
+		}
+		dataComponents := getDefaultDataComponents()
+		storageService := dataComponents.StorageService().(*mockStorage.ChainStorerStub)
+		storageService.GetStorerCalled = func(unitType dataRetriever.UnitType) (storage.Storer, error) {
+			require.Equal(t, dataRetriever.ValidatorRatingsUnit, unitType)
+			return unit, nil
+		}
+
+		n, _ := node.NewNode(
+			node.WithCoreComponents(coreComponents),
+			node.WithDataComponents(dataComponents),
+			node.WithProcessComponents(processComponents),
+		)
+
+		return n
+	}
+
+	t.Run("shard node should error", func(t *testing.T) {
+		t.Parallel()
+
+		n := createNode(0, &mockStorage.StorerStub{})
+		history, err := n.GetValidatorRatingsHistory(encodedBlsKey, core.OptionalUint32{}, core.OptionalUint32{})
+		require.Nil(t, history)
+		require.Equal(t, node.ErrMetachainOnlyEndpoint, err)
+	})
+	t.Run("invalid stored data should error", func(t *testing.T) {
+		t.Parallel()
+
+		unit := &mockStorage.StorerStub{
+			GetCalled: func(key []byte) ([]byte, error) {
+				return []byte("not a validator info"), nil
+			},
+		}
+		n := createNode(core.MetachainShardId, unit)
+		history, err := n.GetValidatorRatingsHistory(encodedBlsKey, core.OptionalUint32{}, core.OptionalUint32{})
+		require.Nil(t, history)
+		require.NotNil(t, err)
+	})
+	t.Run("should return the stored epochs of the requested range", func(t *testing.T) {
+		t.Parallel()
+
+		stored := make(map[string][]byte)
+		for _, epoch := range []uint32{1, 3} {
+			stored[string(common.ValidatorEpochKey(blsKey, epoch))], _ = getMarshalizer().Marshal(&state.ValidatorInfo{
+				ShardId:          2,
+				List:             "jailed",
+				Rating:           4000000,
+				TempRating:       3000000,
+				ValidatorFailure: 7,
+			})
+		}
+		unit := &mockStorage.StorerStub{
+			GetCalled: func(key []byte) ([]byte, error) {
+				value, found := stored[string(key)]
+				if !found {
+					return nil, errors.New("key not found")
+				}
+
+				return value, nil
+			},
+		}
+
+		n := createNode(core.MetachainShardId, unit)
+		history, err := n.GetValidatorRatingsHistory(
+			encodedBlsKey,
+			core.OptionalUint32{Value: 1, HasValue: true},
+			core.OptionalUint32{Value: 5, HasValue: true},
+		)
+		require.Nil(t, err)
+		require.Equal(t, []*common.ValidatorRatingHistory{
+			{Epoch: 1, ShardID: 2, BlsKey: encodedBlsKey, List: "jailed", Jailed: true, StartRating: 40, EndRating: 30, ValidatorFailure: 7},
+			{Epoch: 3, ShardID: 2, BlsKey: encodedBlsKey, List: "jailed", Jailed: true, StartRating: 40, EndRating: 30, ValidatorFailure: 7},
+		}, history)
+	})
+}
+
+func TestNode_GetNodesShufflingPreview(t *testing.T) {
+	t.Parallel()
+
+	currentHeader := &block.MetaBlock{Epoch: 7, RandSeed: []byte("rand seed")}
+	createNode := func(selfShardID uint32, header data.HeaderHandler, isEpochStart bool, provider process.ValidatorsProvider) *node.Node {
+		processComponents := getDefaultProcessComponents()
+		processComponents.ShardCoord = &mock.ShardCoordinatorMock{
+			SelfShardId: selfShardID,
+		}
+		processComponents.EpochTrigger = &testscommon.EpochStartTriggerStub{
+			IsEpochStartCalled: func() bool {
+				return isEpochStart
+			},
+		}
+		processComponents.ValidatorProvider = provider
+		dataComponents := getDefaultDataComponents()
+		dataComponents.BlockChain = &testscommon.ChainHandlerStub{
+			GetCurrentBlockHeaderCalled: func() data.HeaderHandler {
+				return header
+			},
+		}
+
+		n, _ := node.NewNode(
+			node.WithDataComponents(dataComponents),
+			node.WithProcessComponents(processComponents),
+		)
+
+		return n
+	}
+
+	t.Run("shard node should error", func(t *testing.T) {
+		t.Parallel()
+
+		n := createNode(0, currentHeader, false, &stakingcommon.ValidatorsProviderStub{})
+		preview, err := n.GetNodesShufflingPreview()
+		require.Nil(t, preview)
+		require.Equal(t, node.ErrMetachainOnlyEndpoint, err)
+	})
+	t.Run("nil current header should error", func(t *testing.T) {
+		t.Parallel()
+
+		n := createNode(core.MetachainShardId, nil, false, &stakingcommon.ValidatorsProviderStub{})
+		preview, err := n.GetNodesShufflingPreview()
+		require.Nil(t, preview)
+		require.Equal(t, process.ErrNilBlockHeader, err)
+	})
+	t.Run("validators provider error should error", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		provider := &stakingcommon.ValidatorsProviderStub{
+			GetNodesShufflingPreviewCalled: func(randomness []byte, epoch uint32) (*common.NodesShufflingPreviewAPIResponse, error) {
+				return nil, expectedErr
+			},
+		}
+		n := createNode(core.MetachainShardId, currentHeader, false, provider)
+		preview, err := n.GetNodesShufflingPreview()
+		require.Nil(t, preview)
+		require.Equal(t, expectedErr, err)
+	})
+	t.Run("should use the current block randomness for the next epoch", func(t *testing.T) {
+		t.Parallel()
+
+		provider := &stakingcommon.ValidatorsProviderStub{
+			GetNodesShufflingPreviewCalled: func(randomness []byte, epoch uint32) (*common.NodesShufflingPreviewAPIResponse, error) {
+				require.Equal(t, currentHeader.RandSeed, randomness)
+				require.Equal(t, uint32(8), epoch)
+				return &common.NodesShufflingPreviewAPIResponse{IsProjection: true, Epoch: epoch}, nil
+			},
+		}
+
+		n := createNode(core.MetachainShardId, currentHeader, false, provider)
+		preview, err := n.GetNodesShufflingPreview()
+		require.Nil(t, err)
+		require.Equal(t, &common.NodesShufflingPreviewAPIResponse{IsProjection: true, Epoch: 8}, preview)
+
+		n = createNode(core.MetachainShardId, currentHeader, true, provider)
+		preview, err = n.GetNodesShufflingPreview()
+		require.Nil(t, err)
+		require.Equal(t, &common.NodesShufflingPreviewAPIResponse{IsProjection: true, RandomnessKnown: true, Epoch: 8}, preview)
+	})
+}
+
+func TestNode_SimulateAuctionApi(t *testing.T) {
+	t.Parallel()
+
+	changes := []*common.AuctionSimulationOwnerChange{{Owner: "owner", AddedNodes: 1}}
+	createNode := func(header data.HeaderHandler, provider process.ValidatorsProvider) *node.Node {
+		processComponents := getDefaultProcessComponents()
+		processComponents.ValidatorProvider = provider
+		dataComponents := getDefaultDataComponents()
+		dataComponents.BlockChain = &testscommon.ChainHandlerStub{
+			GetCurrentBlockHeaderCalled: func() data.HeaderHandler {
+				return header
+			},
+		}
+
+		n, _ := node.NewNode(
+			node.WithDataComponents(dataComponents),
+			node.WithProcessComponents(processComponents),
+		)
+
+		return n
+	}
+
+	t.Run("nil current header should error", func(t *testing.T) {
+		t.Parallel()
+
+		n := createNode(nil, &stakingcommon.ValidatorsProviderStub{})
+		result, err := n.SimulateAuctionApi(changes)
+		require.Nil(t, result)
+		require.Equal(t, process.ErrNilBlockHeader, err)
+	})
+	t.Run("should use the current block randomness", func(t *testing.T) {
+		t.Parallel()
+
+		currentHeader := &block.MetaBlock{RandSeed: []byte("rand seed"), RootHash: []byte("root hash")}
+		expectedResult := &common.AuctionSimulationAPIResponse{AvailableSlots: 2}
+		provider := &stakingcommon.ValidatorsProviderStub{
+			SimulateAuctionCalled: func(providedChanges []*common.AuctionSimulationOwnerChange, randomness []byte) (*common.AuctionSimulationAPIResponse, error) {
+				require.Equal(t, changes, providedChanges)
+				require.Equal(t, currentHeader.RandSeed, randomness)
+				return expectedResult, nil
+			},
+		}
+
+		n := createNode(currentHeader, provider)
+		result, err := n.SimulateAuctionApi(changes)
+		require.Nil(t, err)
+		require.Equal(t, expectedResult, result)
+	})
+}
+
 func TestNode_GetEpochStartDataAPI(t *testing.T) {
 	t.Parallel()
 
@@ -5432,3 +5921,64 @@ func TestNode_IsInterfaceNil(t *testing.T) {
 	n, _ = node.NewNode()
 	require.False(t, n.IsInterfaceNil())
 }
+
+func TestNode_GetConsensusRoundsTimeline(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil consensus components should error", func(t *testing.T) {
+		t.Parallel()
+
+		n, _ := node.NewNode()
+		timelines, err := n.GetConsensusRoundsTimeline(10)
+		require.Nil(t, timelines)
+		require.Equal(t, node.ErrNilRoundTimelineRecorder, err)
+	})
+	t.Run("nil round timeline recorder should error", func(t *testing.T) {
+		t.Parallel()
+
+		n, _ := node.NewNode(
+			node.WithConsensusComponents(&factoryMocks.ConsensusComponentsStub{}),
+		)
+		timelines, err := n.GetConsensusRoundsTimeline(10)
+		require.Nil(t, timelines)
+		require.Equal(t, node.ErrNilRoundTimelineRecorder, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		expectedTimelines := []*common.ConsensusRoundTimeline{
+			{Round: 4, Outcome: "completed"},
+			{Round: 5, Outcome: "failed", FailedSubround: "(SIGNATURE)"},
+		}
+		n, _ := node.NewNode(
+			node.WithConsensusComponents(&factoryMocks.ConsensusComponentsStub{
+				RoundTimelineRecorderField: &consensusMocks.RoundTimelineRecorderStub{
+					GetLastRoundsCalled: func(numRounds uint32) []*common.ConsensusRoundTimeline {
+						require.Equal(t, uint32(2), numRounds)
+						return expectedTimelines
+					},
+				},
+			}),
+		)
+		timelines, err := n.GetConsensusRoundsTimeline(2)
+		require.Nil(t, err)
+		require.Equal(t, expectedTimelines, timelines)
+	})
+}
+
+type txReplacementCheckerPoolStub struct {
+	*testscommon.ShardedDataStub
+	checkTxReplacementCalled func(txHash []byte, tx data.TransactionHandler, cacheID string) error
+}
+
+func (stub *txReplacementCheckerPoolStub) CheckTxReplacement(txHash []byte, tx data.TransactionHandler, cacheID string) error {
+	if stub.checkTxReplacementCalled != nil {
+		return stub.checkTxReplacementCalled(txHash, tx, cacheID)
+	}
+
+	return nil
+}
+
+func (stub *txReplacementCheckerPoolStub) IsInterfaceNil() bool {
+	return stub == nil
+}
@@ -21,6 +21,10 @@ import (
 type delegatedListProcessor struct {
 	*commonStakingProcessor
 	publicKeyConverter core.PubkeyConverter
+
+	// the delegators index is guarded by the accounts mutex
+	delegatorsIndex         map[string][][]byte
+	delegatorsIndexRootHash []byte
 }
 
 // NewDelegatedListProcessor will create a new instance of delegatedListProc
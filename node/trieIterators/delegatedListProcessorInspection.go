@@ -0,0 +1,328 @@
+package trieIterators
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	"fmt"
+	"math/big"
+	"sort"
+
+	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/epochStart"
+	"github.com/multiversx/mx-chain-go/process"
+	vmcommon "github.com/multiversx/mx-chain-vm-common-go"
+)
+
+const (
+	defaultDelegatorsPageSize = 100
+	maxDelegatorsPageSize     = 1000
+	numContractConfigValues   = 10
+)
+
+// the markers returned by the delegation contract before each group of node keys
+const (
+	nodeStateStaked    = "staked"
+	nodeStateNotStaked = "notStaked"
+	nodeStateUnStaked  = "unStaked"
+)
+
+// GetDelegationContract will return the configuration, the stake totals and the nodes of the provided delegation
+// contract, along with the requested page of its delegators sorted by address. Pages start from 0
+func (dlp *delegatedListProcessor) GetDelegationContract(
+	ctx context.Context,
+	address string,
+	page uint32,
+	pageSize uint32,
+) (*common.DelegationContractAPIResponse, error) {
+	if pageSize == 0 {
+		pageSize = defaultDelegatorsPageSize
+	}
+	if pageSize > maxDelegatorsPageSize {
+		return nil, fmt.Errorf("%w, provided %d, maximum %d", ErrInvalidPageSize, pageSize, maxDelegatorsPageSize)
+	}
+
+	delegationSC, err := dlp.publicKeyConverter.Decode(address)
+	if err != nil {
+		return nil, err
+	}
+
+	dlp.accounts.Lock()
+	defer dlp.accounts.Unlock()
+
+	config, err := dlp.getContractConfig(delegationSC)
+	if err != nil {
+		return nil, err
+	}
+
+	totalActiveStake, err := dlp.getSingleValue(delegationSC, "getTotalActiveStake", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	totalUnStaked, err := dlp.getSingleValue(delegationSC, "getTotalUnStaked", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	nodes, err := dlp.getNodeStates(delegationSC)
+	if err != nil {
+		return nil, err
+	}
+
+	delegators, err := dlp.getDelegatorsList(delegationSC, ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	sort.Slice(delegators, func(i, j int) bool {
+		return bytes.Compare(delegators[i], delegators[j]) < 0
+	})
+
+	response := &common.DelegationContractAPIResponse{
+		Address:          address,
+		Config:           config,
+		TotalActiveStake: totalActiveStake.String(),
+		TotalUnStaked:    totalUnStaked.String(),
+		Nodes:            nodes,
+		NumDelegators:    uint32(len(delegators)),
+		Page:             page,
+		PageSize:         pageSize,
+		Delegators:       make([]*common.DelegationPositionAPIResponse, 0, pageSize),
+	}
+
+	start := uint64(page) * uint64(pageSize)
+	if start >= uint64(len(delegators)) {
+		return response, nil
+	}
+	end := start + uint64(pageSize)
+	if end > uint64(len(delegators)) {
+		end = uint64(len(delegators))
+	}
+
+	for _, delegator := range delegators[start:end] {
+		position, errPosition := dlp.getDelegationPosition(delegationSC, delegator)
+		if errPosition != nil {
+			// delegator byte slice might not represent a real delegator address
+			continue
+		}
+
+		position.Delegator = dlp.publicKeyConverter.SilentEncode(delegator, log)
+		response.Delegators = append(response.Delegators, position)
+	}
+
+	return response, nil
+}
+
+// GetDelegationPositions will return the positions of the provided address in every delegation contract it delegated
+// to. The delegators of all contracts are indexed in a single pass over the delegation contracts, once per root hash
+func (dlp *delegatedListProcessor) GetDelegationPositions(ctx context.Context, address string) (*common.DelegationPositionsAPIResponse, error) {
+	delegator, err := dlp.publicKeyConverter.Decode(address)
+	if err != nil {
+		return nil, err
+	}
+
+	dlp.accounts.Lock()
+	defer dlp.accounts.Unlock()
+
+	delegatorsIndex, err := dlp.getDelegatorsIndex(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	totalActiveStake := big.NewInt(0)
+	totalClaimableRewards := big.NewInt(0)
+	positions := make([]*common.DelegationPositionAPIResponse, 0)
+	for _, delegationSC := range delegatorsIndex[string(delegator)] {
+		position, errPosition := dlp.getDelegationPosition(delegationSC, delegator)
+		if errPosition != nil {
+			return nil, fmt.Errorf("%w for delegation SC %s", errPosition, hex.EncodeToString(delegationSC))
+		}
+
+		position.Contract = dlp.publicKeyConverter.SilentEncode(delegationSC, log)
+		positions = append(positions, position)
+
+		activeStake, _ := big.NewInt(0).SetString(position.ActiveStake, 10)
+		totalActiveStake.Add(totalActiveStake, activeStake)
+		claimableRewards, _ := big.NewInt(0).SetString(position.ClaimableRewards, 10)
+		totalClaimableRewards.Add(totalClaimableRewards, claimableRewards)
+	}
+
+	return &common.DelegationPositionsAPIResponse{
+		Address:               address,
+		TotalActiveStake:      totalActiveStake.String(),
+		TotalClaimableRewards: totalClaimableRewards.String(),
+		Positions:             positions,
+	}, nil
+}
+
+// getDelegatorsIndex returns the delegation contracts of each delegator, rebuilding the index only if the accounts
+// root hash changed since it was last computed
+func (dlp *delegatedListProcessor) getDelegatorsIndex(ctx context.Context) (map[string][][]byte, error) {
+	rootHash, err := dlp.accounts.RootHash()
+	if err != nil {
+		return nil, err
+	}
+	if dlp.delegatorsIndex != nil && bytes.Equal(rootHash, dlp.delegatorsIndexRootHash) {
+		return dlp.delegatorsIndex, nil
+	}
+
+	delegationScAddresses, err := dlp.getAllDelegationContractAddresses()
+	if err != nil {
+		return nil, err
+	}
+
+	delegatorsIndex := make(map[string][][]byte)
+	for _, delegationSC := range delegationScAddresses {
+		delegators, errList := dlp.getDelegatorsList(delegationSC, ctx)
+		if errList != nil {
+			return nil, errList
+		}
+
+		for _, delegator := range delegators {
+			delegatorsIndex[string(delegator)] = append(delegatorsIndex[string(delegator)], delegationSC)
+		}
+	}
+
+	dlp.delegatorsIndex = delegatorsIndex
+	dlp.delegatorsIndexRootHash = rootHash
+
+	return delegatorsIndex, nil
+}
+
+func (dlp *delegatedListProcessor) getDelegationPosition(delegationSC []byte, delegator []byte) (*common.DelegationPositionAPIResponse, error) {
+	activeStake, err := dlp.getActiveFund(delegationSC, delegator)
+	if err != nil {
+		return nil, err
+	}
+
+	unStakedFunds, err := dlp.getUnStakedFunds(delegationSC, delegator)
+	if err != nil {
+		return nil, err
+	}
+
+	claimableRewards, err := dlp.getSingleValue(delegationSC, "getClaimableRewards", [][]byte{delegator})
+	if err != nil {
+		return nil, err
+	}
+
+	return &common.DelegationPositionAPIResponse{
+		ActiveStake:      activeStake.String(),
+		UnStakedFunds:    unStakedFunds,
+		ClaimableRewards: claimableRewards.String(),
+	}, nil
+}
+
+// getUnStakedFunds parses the pairs of value and remaining epochs returned by the delegation contract
+func (dlp *delegatedListProcessor) getUnStakedFunds(delegationSC []byte, delegator []byte) ([]*common.DelegationUnStakedFundAPIResponse, error) {
+	returnData, err := dlp.executeDelegationQuery(delegationSC, "getUserUnDelegatedList", [][]byte{delegator})
+	if err != nil {
+		return nil, err
+	}
+	if len(returnData)%2 != 0 {
+		return nil, fmt.Errorf("%w, getUserUnDelegatedList returned an odd number of values", ErrInvalidDelegationContractResponse)
+	}
+
+	unStakedFunds := make([]*common.DelegationUnStakedFundAPIResponse, 0, len(returnData)/2)
+	for i := 0; i < len(returnData); i += 2 {
+		unStakedFunds = append(unStakedFunds, &common.DelegationUnStakedFundAPIResponse{
+			Value:           big.NewInt(0).SetBytes(returnData[i]).String(),
+			RemainingEpochs: uint32(big.NewInt(0).SetBytes(returnData[i+1]).Uint64()),
+		})
+	}
+
+	return unStakedFunds, nil
+}
+
+func (dlp *delegatedListProcessor) getContractConfig(delegationSC []byte) (*common.DelegationContractConfigAPIResponse, error) {
+	returnData, err := dlp.executeDelegationQuery(delegationSC, "getContractConfig", nil)
+	if err != nil {
+		return nil, err
+	}
+	if len(returnData) < numContractConfigValues {
+		return nil, fmt.Errorf("%w, getContractConfig should have returned %d values", ErrInvalidDelegationContractResponse, numContractConfigValues)
+	}
+
+	return &common.DelegationContractConfigAPIResponse{
+		Owner:                       dlp.publicKeyConverter.SilentEncode(returnData[0], log),
+		ServiceFee:                  big.NewInt(0).SetBytes(returnData[1]).Uint64(),
+		MaxDelegationCap:            big.NewInt(0).SetBytes(returnData[2]).String(),
+		InitialOwnerFunds:           big.NewInt(0).SetBytes(returnData[3]).String(),
+		AutomaticActivation:         string(returnData[4]) == "true",
+		WithDelegationCap:           string(returnData[5]) == "true",
+		ChangeableServiceFee:        string(returnData[6]) == "true",
+		CheckCapOnReDelegateRewards: string(returnData[7]) == "true",
+		CreatedNonce:                big.NewInt(0).SetBytes(returnData[8]).Uint64(),
+		UnBondPeriodInEpochs:        uint32(big.NewInt(0).SetBytes(returnData[9]).Uint64()),
+	}, nil
+}
+
+// getNodeStates groups the node keys returned by the delegation contract, each group being preceded by its state
+func (dlp *delegatedListProcessor) getNodeStates(delegationSC []byte) (*common.DelegationNodesAPIResponse, error) {
+	returnData, err := dlp.executeDelegationQuery(delegationSC, "getAllNodeStates", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	nodes := &common.DelegationNodesAPIResponse{
+		Staked:    make([]string, 0),
+		NotStaked: make([]string, 0),
+		UnStaked:  make([]string, 0),
+	}
+
+	var currentList *[]string
+	for _, value := range returnData {
+		switch string(value) {
+		case nodeStateStaked:
+			currentList = &nodes.Staked
+		case nodeStateNotStaked:
+			currentList = &nodes.NotStaked
+		case nodeStateUnStaked:
+			currentList = &nodes.UnStaked
+		default:
+			if currentList == nil {
+				return nil, fmt.Errorf("%w, getAllNodeStates returned a key without a state", ErrInvalidDelegationContractResponse)
+			}
+			*currentList = append(*currentList, hex.EncodeToString(value))
+		}
+	}
+
+	return nodes, nil
+}
+
+func (dlp *delegatedListProcessor) getSingleValue(delegationSC []byte, funcName string, arguments [][]byte) (*big.Int, error) {
+	returnData, err := dlp.executeDelegationQuery(delegationSC, funcName, arguments)
+	if err != nil {
+		return nil, err
+	}
+	if len(returnData) != 1 {
+		return nil, fmt.Errorf("%w, %s should have returned one value", ErrInvalidDelegationContractResponse, funcName)
+	}
+
+	return big.NewInt(0).SetBytes(returnData[0]), nil
+}
+
+func (dlp *delegatedListProcessor) executeDelegationQuery(delegationSC []byte, funcName string, arguments [][]byte) ([][]byte, error) {
+	if arguments == nil {
+		arguments = make([][]byte, 0)
+	}
+
+	scQuery := &process.SCQuery{
+		ScAddress:  delegationSC,
+		FuncName:   funcName,
+		CallerAddr: delegationSC,
+		CallValue:  big.NewInt(0),
+		Arguments:  arguments,
+	}
+
+	vmOutput, _, err := dlp.queryService.ExecuteQuery(scQuery)
+	if err != nil {
+		return nil, err
+	}
+	if vmOutput.ReturnCode != vmcommon.Ok {
+		return nil, fmt.Errorf("%w, function: %s, return code: %v, message: %s",
+			epochStart.ErrExecutingSystemScCode, funcName, vmOutput.ReturnCode, vmOutput.ReturnMessage)
+	}
+
+	return vmOutput.ReturnData, nil
+}
@@ -3,6 +3,7 @@ package trieIterators
 import (
 	"bytes"
 	"context"
+	"encoding/hex"
 	"errors"
 	"fmt"
 	"math/big"
@@ -222,6 +223,234 @@ func TestDelegatedListProc_GetDelegatorsListShouldWork(t *testing.T) {
 	assert.Equal(t, []*api.Delegator{&expectedDelegator1, &expectedDelegator2}, delegatorsValues)
 }
 
+func createDelegationContractQueryServiceStub(delegators [][]byte) *mock.SCQueryServiceStub {
+	return &mock.SCQueryServiceStub{
+		ExecuteQueryCalled: func(query *process.SCQuery) (*vmcommon.VMOutput, common.BlockInfo, error) {
+			switch query.FuncName {
+			case "getContractConfig":
+				return &vmcommon.VMOutput{
+					ReturnData: [][]byte{
+						[]byte("owner12345"),
+						big.NewInt(1000).Bytes(),
+						big.NewInt(0).Bytes(),
+						big.NewInt(1250).Bytes(),
+						[]byte("true"),
+						[]byte("false"),
+						[]byte("true"),
+						[]byte("false"),
+						big.NewInt(37).Bytes(),
+						big.NewInt(10).Bytes(),
+					},
+				}, nil, nil
+			case "getTotalActiveStake":
+				return &vmcommon.VMOutput{ReturnData: [][]byte{big.NewInt(6000).Bytes()}}, nil, nil
+			case "getTotalUnStaked":
+				return &vmcommon.VMOutput{ReturnData: [][]byte{big.NewInt(300).Bytes()}}, nil, nil
+			case "getAllNodeStates":
+				return &vmcommon.VMOutput{
+					ReturnData: [][]byte{[]byte("staked"), []byte("bls1"), []byte("bls2"), []byte("unStaked"), []byte("bls3")},
+				}, nil, nil
+			}
+
+			for index, delegator := range delegators {
+				if !bytes.Equal(delegator, query.Arguments[0]) {
+					continue
+				}
+
+				value := int64(index + 1)
+				switch query.FuncName {
+				case "getUserActiveStake":
+					return &vmcommon.VMOutput{ReturnData: [][]byte{big.NewInt(value * 1000).Bytes()}}, nil, nil
+				case "getUserUnDelegatedList":
+					return &vmcommon.VMOutput{ReturnData: [][]byte{big.NewInt(value * 100).Bytes(), big.NewInt(value).Bytes()}}, nil, nil
+				case "getClaimableRewards":
+					return &vmcommon.VMOutput{ReturnData: [][]byte{big.NewInt(value).Bytes()}}, nil, nil
+				}
+			}
+
+			return &vmcommon.VMOutput{ReturnCode: vmcommon.UserError, ReturnMessage: "not a delegator"}, nil, nil
+		},
+	}
+}
+
+func TestDelegatedListProc_GetDelegationContract(t *testing.T) {
+	t.Parallel()
+
+	delegators := [][]byte{[]byte("delegator3"), []byte("delegator1"), []byte("delegator2"), []byte("notDelegat")}
+	delegationSc := []byte("delegation")
+
+	createProcessor := func() *delegatedListProcessor {
+		arg := createMockArgs()
+		arg.PublicKeyConverter = testscommon.NewPubkeyConverterMock(10)
+		arg.QueryService = createDelegationContractQueryServiceStub(delegators[:3])
+		arg.Accounts.AccountsAdapter = &stateMock.AccountsStub{
+			GetExistingAccountCalled: func(addressContainer []byte) (vmcommon.AccountHandler, error) {
+				return createScAccount(addressContainer, delegators, addressContainer, 0), nil
+			},
+		}
+		dlp, _ := NewDelegatedListProcessor(arg)
+
+		return dlp
+	}
+
+	t.Run("page size too large should error", func(t *testing.T) {
+		t.Parallel()
+
+		dlp := createProcessor()
+		contract, err := dlp.GetDelegationContract(context.Background(), hex.EncodeToString(delegationSc), 0, maxDelegatorsPageSize+1)
+		assert.Nil(t, contract)
+		assert.True(t, errors.Is(err, ErrInvalidPageSize))
+	})
+	t.Run("invalid address should error", func(t *testing.T) {
+		t.Parallel()
+
+		dlp := createProcessor()
+		contract, err := dlp.GetDelegationContract(context.Background(), "not hex", 0, 0)
+		assert.Nil(t, contract)
+		assert.NotNil(t, err)
+	})
+	t.Run("should return the first page", func(t *testing.T) {
+		t.Parallel()
+
+		dlp := createProcessor()
+		contract, err := dlp.GetDelegationContract(context.Background(), hex.EncodeToString(delegationSc), 0, 2)
+		require.Nil(t, err)
+
+		expectedConfig := &common.DelegationContractConfigAPIResponse{
+			Owner:                       hex.EncodeToString([]byte("owner12345")),
+			ServiceFee:                  1000,
+			MaxDelegationCap:            "0",
+			InitialOwnerFunds:           "1250",
+			AutomaticActivation:         true,
+			WithDelegationCap:           false,
+			ChangeableServiceFee:        true,
+			CheckCapOnReDelegateRewards: false,
+			CreatedNonce:                37,
+			UnBondPeriodInEpochs:        10,
+		}
+		assert.Equal(t, expectedConfig, contract.Config)
+		assert.Equal(t, "6000", contract.TotalActiveStake)
+		assert.Equal(t, "300", contract.TotalUnStaked)
+		assert.Equal(t, &common.DelegationNodesAPIResponse{
+			Staked:    []string{hex.EncodeToString([]byte("bls1")), hex.EncodeToString([]byte("bls2"))},
+			NotStaked: []string{},
+			UnStaked:  []string{hex.EncodeToString([]byte("bls3"))},
+		}, contract.Nodes)
+		assert.Equal(t, uint32(4), contract.NumDelegators)
+
+		expectedDelegators := []*common.DelegationPositionAPIResponse{
+			{
+				Delegator:        hex.EncodeToString([]byte("delegator1")),
+				ActiveStake:      "2000",
+				UnStakedFunds:    []*common.DelegationUnStakedFundAPIResponse{{Value: "200", RemainingEpochs: 2}},
+				ClaimableRewards: "2",
+			},
+			{
+				Delegator:        hex.EncodeToString([]byte("delegator2")),
+				ActiveStake:      "3000",
+				UnStakedFunds:    []*common.DelegationUnStakedFundAPIResponse{{Value: "300", RemainingEpochs: 3}},
+				ClaimableRewards: "3",
+			},
+		}
+		assert.Equal(t, expectedDelegators, contract.Delegators)
+	})
+	t.Run("should skip the keys which are not delegators", func(t *testing.T) {
+		t.Parallel()
+
+		dlp := createProcessor()
+		contract, err := dlp.GetDelegationContract(context.Background(), hex.EncodeToString(delegationSc), 1, 2)
+		require.Nil(t, err)
+		require.Equal(t, 1, len(contract.Delegators))
+		assert.Equal(t, hex.EncodeToString([]byte("delegator3")), contract.Delegators[0].Delegator)
+		assert.Equal(t, "1000", contract.Delegators[0].ActiveStake)
+	})
+	t.Run("page out of range should return no delegators", func(t *testing.T) {
+		t.Parallel()
+
+		dlp := createProcessor()
+		contract, err := dlp.GetDelegationContract(context.Background(), hex.EncodeToString(delegationSc), 5, 2)
+		require.Nil(t, err)
+		assert.Empty(t, contract.Delegators)
+		assert.Equal(t, uint32(4), contract.NumDelegators)
+	})
+}
+
+func TestDelegatedListProc_GetDelegationPositions(t *testing.T) {
+	t.Parallel()
+
+	delegator := []byte("delegator1")
+	otherDelegator := []byte("delegator2")
+	delegationScs := [][]byte{[]byte("delegation"), []byte("delegatio2"), []byte("delegatio3")}
+	leaves := map[string][][]byte{
+		string(delegationScs[0]): {delegator, otherDelegator},
+		string(delegationScs[1]): {otherDelegator},
+		string(delegationScs[2]): {delegator},
+	}
+
+	numGetAllContractAddressesCalls := 0
+	rootHash := []byte("root hash 1")
+	queryService := createDelegationContractQueryServiceStub([][]byte{delegator, otherDelegator})
+	arg := createMockArgs()
+	arg.PublicKeyConverter = testscommon.NewPubkeyConverterMock(10)
+	arg.QueryService = &mock.SCQueryServiceStub{
+		ExecuteQueryCalled: func(query *process.SCQuery) (*vmcommon.VMOutput, common.BlockInfo, error) {
+			if query.FuncName == "getAllContractAddresses" {
+				numGetAllContractAddressesCalls++
+				return &vmcommon.VMOutput{ReturnData: delegationScs}, nil, nil
+			}
+
+			return queryService.ExecuteQuery(query)
+		},
+	}
+	arg.Accounts.AccountsAdapter = &stateMock.AccountsStub{
+		GetExistingAccountCalled: func(addressContainer []byte) (vmcommon.AccountHandler, error) {
+			return createScAccount(addressContainer, leaves[string(addressContainer)], addressContainer, 0), nil
+		},
+		RootHashCalled: func() ([]byte, error) {
+			return rootHash, nil
+		},
+	}
+	dlp, _ := NewDelegatedListProcessor(arg)
+
+	positions, err := dlp.GetDelegationPositions(context.Background(), hex.EncodeToString(delegator))
+	require.Nil(t, err)
+
+	expectedPositions := &common.DelegationPositionsAPIResponse{
+		Address:               hex.EncodeToString(delegator),
+		TotalActiveStake:      "2000",
+		TotalClaimableRewards: "2",
+		Positions: []*common.DelegationPositionAPIResponse{
+			{
+				Contract:         hex.EncodeToString(delegationScs[0]),
+				ActiveStake:      "1000",
+				UnStakedFunds:    []*common.DelegationUnStakedFundAPIResponse{{Value: "100", RemainingEpochs: 1}},
+				ClaimableRewards: "1",
+			},
+			{
+				Contract:         hex.EncodeToString(delegationScs[2]),
+				ActiveStake:      "1000",
+				UnStakedFunds:    []*common.DelegationUnStakedFundAPIResponse{{Value: "100", RemainingEpochs: 1}},
+				ClaimableRewards: "1",
+			},
+		},
+	}
+	assert.Equal(t, expectedPositions, positions)
+	assert.Equal(t, 1, numGetAllContractAddressesCalls)
+
+	positions, err = dlp.GetDelegationPositions(context.Background(), hex.EncodeToString(otherDelegator))
+	require.Nil(t, err)
+	require.Equal(t, 2, len(positions.Positions))
+	assert.Equal(t, "4000", positions.TotalActiveStake)
+	assert.Equal(t, 1, numGetAllContractAddressesCalls)
+
+	rootHash = []byte("root hash 2")
+	positions, err = dlp.GetDelegationPositions(context.Background(), hex.EncodeToString([]byte("delegator5")))
+	require.Nil(t, err)
+	assert.Empty(t, positions.Positions)
+	assert.Equal(t, "0", positions.TotalActiveStake)
+	assert.Equal(t, 2, numGetAllContractAddressesCalls)
+}
+
 func TestDelegatedListProcessor_IsInterfaceNil(t *testing.T) {
 	t.Parallel()
 
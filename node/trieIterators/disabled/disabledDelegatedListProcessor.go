@@ -5,6 +5,7 @@ import (
 	"errors"
 
 	"github.com/multiversx/mx-chain-core-go/data/api"
+	"github.com/multiversx/mx-chain-go/common"
 )
 
 var errCannotReturnDelegatedListFromShardNode = errors.New("total staked value cannot be returned by a shard node")
@@ -21,6 +22,16 @@ func (dlp *delegatedListProcessor) GetDelegatorsList(_ context.Context) ([]*api.
 	return nil, errCannotReturnDelegatedListFromShardNode
 }
 
+// GetDelegationContract returns the errCannotReturnDelegatedListFromShardNode error
+func (dlp *delegatedListProcessor) GetDelegationContract(_ context.Context, _ string, _ uint32, _ uint32) (*common.DelegationContractAPIResponse, error) {
+	return nil, errCannotReturnDelegatedListFromShardNode
+}
+
+// GetDelegationPositions returns the errCannotReturnDelegatedListFromShardNode error
+func (dlp *delegatedListProcessor) GetDelegationPositions(_ context.Context, _ string) (*common.DelegationPositionsAPIResponse, error) {
+	return nil, errCannotReturnDelegatedListFromShardNode
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (dlp *delegatedListProcessor) IsInterfaceNil() bool {
 	return dlp == nil
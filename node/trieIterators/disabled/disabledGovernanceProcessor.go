@@ -0,0 +1,37 @@
+package disabled
+
+import (
+	"context"
+	"errors"
+
+	"github.com/multiversx/mx-chain-go/common"
+)
+
+var errCannotReturnGovernanceDataFromShardNode = errors.New("governance data cannot be returned by a shard node")
+
+type governanceProcessor struct{}
+
+// NewDisabledGovernanceProcessor returns a disabled implementation to be used on shard nodes
+func NewDisabledGovernanceProcessor() *governanceProcessor {
+	return &governanceProcessor{}
+}
+
+// GetGovernanceProposals returns the errCannotReturnGovernanceDataFromShardNode error
+func (gp *governanceProcessor) GetGovernanceProposals(_ context.Context) (*common.GovernanceProposalsAPIResponse, error) {
+	return nil, errCannotReturnGovernanceDataFromShardNode
+}
+
+// GetGovernanceProposal returns the errCannotReturnGovernanceDataFromShardNode error
+func (gp *governanceProcessor) GetGovernanceProposal(_ uint64) (*common.GovernanceProposalAPIResponse, error) {
+	return nil, errCannotReturnGovernanceDataFromShardNode
+}
+
+// GetGovernanceVotes returns the errCannotReturnGovernanceDataFromShardNode error
+func (gp *governanceProcessor) GetGovernanceVotes(_ string) (*common.GovernanceVotesAPIResponse, error) {
+	return nil, errCannotReturnGovernanceDataFromShardNode
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (gp *governanceProcessor) IsInterfaceNil() bool {
+	return gp == nil
+}
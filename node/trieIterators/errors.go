@@ -19,3 +19,21 @@ var ErrNilMutex = errors.New("nil mutex")
 
 // ErrTrieOperationsTimeout signals a timeout during trie operations
 var ErrTrieOperationsTimeout = errors.New("trie operations timeout")
+
+// ErrNilMarshaller signals that a nil marshaller has been provided
+var ErrNilMarshaller = errors.New("nil marshaller")
+
+// ErrNilEpochNotifier signals that a nil epoch notifier has been provided
+var ErrNilEpochNotifier = errors.New("nil epoch notifier")
+
+// ErrGovernanceNotInitialized signals that the governance system smart contract has no configuration stored
+var ErrGovernanceNotInitialized = errors.New("governance contract is not initialized")
+
+// ErrProposalNotFound signals that the requested governance proposal was not found
+var ErrProposalNotFound = errors.New("proposal not found")
+
+// ErrInvalidPageSize signals that an invalid page size was provided
+var ErrInvalidPageSize = errors.New("invalid page size")
+
+// ErrInvalidDelegationContractResponse signals that a delegation contract returned an unexpected response
+var ErrInvalidDelegationContractResponse = errors.New("invalid delegation contract response")
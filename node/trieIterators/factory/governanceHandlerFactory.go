@@ -0,0 +1,30 @@
+package factory
+
+import (
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-go/node/external"
+	"github.com/multiversx/mx-chain-go/node/trieIterators"
+	"github.com/multiversx/mx-chain-go/node/trieIterators/disabled"
+)
+
+type governanceProcessorFactory struct {
+}
+
+// NewGovernanceProcessorFactory create a new governance handler
+func NewGovernanceProcessorFactory() *governanceProcessorFactory {
+	return &governanceProcessorFactory{}
+}
+
+// CreateGovernanceProcessorHandler will create a new instance of governance processor for regular/normal chain
+func (g *governanceProcessorFactory) CreateGovernanceProcessorHandler(args trieIterators.ArgGovernanceProcessor) (external.GovernanceHandler, error) {
+	if args.ShardID != core.MetachainShardId {
+		return disabled.NewDisabledGovernanceProcessor(), nil
+	}
+
+	return trieIterators.NewGovernanceProcessor(args)
+}
+
+// IsInterfaceNil checks if the underlying pointer is nil
+func (g *governanceProcessorFactory) IsInterfaceNil() bool {
+	return g == nil
+}
@@ -0,0 +1,48 @@
+package factory_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-go/node/trieIterators"
+	trieIteratorsFactory "github.com/multiversx/mx-chain-go/node/trieIterators/factory"
+	"github.com/multiversx/mx-chain-go/testscommon"
+	epochNotifierMock "github.com/multiversx/mx-chain-go/testscommon/epochNotifier"
+	"github.com/stretchr/testify/require"
+)
+
+func createMockGovernanceArgs(shardId uint32) trieIterators.ArgGovernanceProcessor {
+	return trieIterators.ArgGovernanceProcessor{
+		ArgTrieIteratorProcessor: createMockArgs(shardId),
+		Marshaller:               &testscommon.MarshallerStub{},
+		EpochNotifier:            &epochNotifierMock.EpochNotifierStub{},
+	}
+}
+
+func TestNewGovernanceProcessorFactory(t *testing.T) {
+	t.Parallel()
+
+	governanceHandlerFactory := trieIteratorsFactory.NewGovernanceProcessorFactory()
+	require.False(t, governanceHandlerFactory.IsInterfaceNil())
+}
+
+func TestGovernanceProcessorFactory_CreateGovernanceProcessorHandlerDisabledProcessor(t *testing.T) {
+	t.Parallel()
+
+	args := createMockGovernanceArgs(0)
+
+	governanceHandler, err := trieIteratorsFactory.NewGovernanceProcessorFactory().CreateGovernanceProcessorHandler(args)
+	require.Nil(t, err)
+	require.Equal(t, "*disabled.governanceProcessor", fmt.Sprintf("%T", governanceHandler))
+}
+
+func TestGovernanceProcessorFactory_CreateGovernanceProcessorHandler(t *testing.T) {
+	t.Parallel()
+
+	args := createMockGovernanceArgs(core.MetachainShardId)
+
+	governanceHandler, err := trieIteratorsFactory.NewGovernanceProcessorFactory().CreateGovernanceProcessorHandler(args)
+	require.Nil(t, err)
+	require.Equal(t, "*trieIterators.governanceProcessor", fmt.Sprintf("%T", governanceHandler))
+}
@@ -22,3 +22,9 @@ type TotalStakedValueProcessorFactoryHandler interface {
 	CreateTotalStakedValueProcessorHandler(args trieIterators.ArgTrieIteratorProcessor) (external.TotalStakedValueHandler, error)
 	IsInterfaceNil() bool
 }
+
+// GovernanceProcessorFactoryHandler can create governance processor handler
+type GovernanceProcessorFactoryHandler interface {
+	CreateGovernanceProcessorHandler(args trieIterators.ArgGovernanceProcessor) (external.GovernanceHandler, error)
+	IsInterfaceNil() bool
+}
@@ -0,0 +1,24 @@
+package factory
+
+import (
+	"github.com/multiversx/mx-chain-go/node/external"
+	"github.com/multiversx/mx-chain-go/node/trieIterators"
+)
+
+type sovereignGovernanceProcessorFactory struct {
+}
+
+// NewSovereignGovernanceProcessorFactory creates a new sovereign governance handler
+func NewSovereignGovernanceProcessorFactory() *sovereignGovernanceProcessorFactory {
+	return &sovereignGovernanceProcessorFactory{}
+}
+
+// CreateGovernanceProcessorHandler creates a new instance of governance processor for sovereign chains
+func (sg *sovereignGovernanceProcessorFactory) CreateGovernanceProcessorHandler(args trieIterators.ArgGovernanceProcessor) (external.GovernanceHandler, error) {
+	return trieIterators.NewGovernanceProcessor(args)
+}
+
+// IsInterfaceNil checks if the underlying pointer is nil
+func (sg *sovereignGovernanceProcessorFactory) IsInterfaceNil() bool {
+	return sg == nil
+}
@@ -0,0 +1,27 @@
+package factory_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	trieIteratorsFactory "github.com/multiversx/mx-chain-go/node/trieIterators/factory"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewSovereignGovernanceProcessorFactory(t *testing.T) {
+	t.Parallel()
+
+	sovereignGovernanceHandlerFactory := trieIteratorsFactory.NewSovereignGovernanceProcessorFactory()
+	require.False(t, sovereignGovernanceHandlerFactory.IsInterfaceNil())
+}
+
+func TestSovereignGovernanceProcessorFactory_CreateGovernanceProcessorHandler(t *testing.T) {
+	t.Parallel()
+
+	args := createMockGovernanceArgs(core.SovereignChainShardId)
+
+	sovereignGovernanceHandler, err := trieIteratorsFactory.NewSovereignGovernanceProcessorFactory().CreateGovernanceProcessorHandler(args)
+	require.Nil(t, err)
+	require.Equal(t, "*trieIterators.governanceProcessor", fmt.Sprintf("%T", sovereignGovernanceHandler))
+}
@@ -0,0 +1,465 @@
+package trieIterators
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	"fmt"
+	"math/big"
+	"sort"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-core-go/marshal"
+	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/common/errChan"
+	"github.com/multiversx/mx-chain-go/process"
+	"github.com/multiversx/mx-chain-go/state"
+	"github.com/multiversx/mx-chain-go/vm"
+	"github.com/multiversx/mx-chain-go/vm/systemSmartContracts"
+	vmcommon "github.com/multiversx/mx-chain-vm-common-go"
+)
+
+// keys and prefixes used by the governance system smart contract
+const (
+	governanceConfigKey      = "governanceConfig"
+	governanceNoncePrefix    = "n_"
+	governanceProposalPrefix = "p_"
+)
+
+// the statuses of a governance proposal
+const (
+	ProposalStatusPending  = "pending"
+	ProposalStatusActive   = "active"
+	ProposalStatusEnded    = "ended"
+	ProposalStatusPassed   = "passed"
+	ProposalStatusRejected = "rejected"
+)
+
+// ArgGovernanceProcessor represents the arguments DTO used in the governance processor constructor
+type ArgGovernanceProcessor struct {
+	ArgTrieIteratorProcessor
+	Marshaller    marshal.Marshalizer
+	EpochNotifier process.EpochNotifier
+}
+
+type governanceProcessor struct {
+	*commonStakingProcessor
+	publicKeyConverter core.PubkeyConverter
+	marshaller         marshal.Marshalizer
+	epochNotifier      process.EpochNotifier
+}
+
+// NewGovernanceProcessor will create a new instance of governanceProcessor
+func NewGovernanceProcessor(arg ArgGovernanceProcessor) (*governanceProcessor, error) {
+	err := checkArguments(arg.ArgTrieIteratorProcessor)
+	if err != nil {
+		return nil, err
+	}
+	if check.IfNil(arg.Marshaller) {
+		return nil, ErrNilMarshaller
+	}
+	if check.IfNil(arg.EpochNotifier) {
+		return nil, ErrNilEpochNotifier
+	}
+
+	return &governanceProcessor{
+		commonStakingProcessor: &commonStakingProcessor{
+			queryService: arg.QueryService,
+			accounts:     arg.Accounts,
+		},
+		publicKeyConverter: arg.PublicKeyConverter,
+		marshaller:         arg.Marshaller,
+		epochNotifier:      arg.EpochNotifier,
+	}, nil
+}
+
+// GetGovernanceProposals will return all the proposals found in the governance contract storage, sorted by nonce
+func (gp *governanceProcessor) GetGovernanceProposals(ctx context.Context) (*common.GovernanceProposalsAPIResponse, error) {
+	gp.accounts.Lock()
+	defer gp.accounts.Unlock()
+
+	governanceAccount, err := gp.getAccount(vm.GovernanceSCAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	config, err := gp.getConfig(governanceAccount)
+	if err != nil {
+		return nil, err
+	}
+
+	totalStake, err := gp.getTotalStake()
+	if err != nil {
+		return nil, err
+	}
+
+	proposals, err := gp.getAllProposals(governanceAccount, ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &common.GovernanceProposalsAPIResponse{
+		CurrentEpoch: gp.epochNotifier.CurrentEpoch(),
+		TotalStake:   totalStake.String(),
+		Config:       gp.convertConfig(config),
+		Proposals:    make([]*common.GovernanceProposalAPIResponse, 0, len(proposals)),
+	}
+	for _, proposal := range proposals {
+		response.Proposals = append(response.Proposals, gp.convertProposal(proposal, config, totalStake))
+	}
+
+	return response, nil
+}
+
+func (gp *governanceProcessor) getAllProposals(governanceAccount state.UserAccountHandler, ctx context.Context) ([]*systemSmartContracts.GeneralProposal, error) {
+	chLeaves := &common.TrieIteratorChannels{
+		LeavesChan: make(chan core.KeyValueHolder, common.TrieLeavesChannelDefaultCapacity),
+		ErrChan:    errChan.NewErrChanWrapper(),
+	}
+	err := governanceAccount.GetAllLeaves(chLeaves, ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	proposals := make([]*systemSmartContracts.GeneralProposal, 0)
+	for leaf := range chLeaves.LeavesChan {
+		if !bytes.HasPrefix(leaf.Key(), []byte(governanceProposalPrefix)) {
+			continue
+		}
+
+		proposal := &systemSmartContracts.GeneralProposal{}
+		errUnmarshal := gp.marshaller.Unmarshal(proposal, leaf.Value())
+		if errUnmarshal != nil {
+			log.Warn("governanceProcessor.getAllProposals: cannot unmarshal proposal",
+				"key", hex.EncodeToString(leaf.Key()), "error", errUnmarshal)
+			continue
+		}
+
+		proposals = append(proposals, proposal)
+	}
+
+	err = chLeaves.ErrChan.ReadFromChanNonBlocking()
+	if err != nil {
+		return nil, err
+	}
+
+	if common.IsContextDone(ctx) {
+		return nil, ErrTrieOperationsTimeout
+	}
+
+	sort.Slice(proposals, func(i, j int) bool {
+		return proposals[i].Nonce < proposals[j].Nonce
+	})
+
+	return proposals, nil
+}
+
+// GetGovernanceProposal will return the proposal with the provided nonce
+func (gp *governanceProcessor) GetGovernanceProposal(nonce uint64) (*common.GovernanceProposalAPIResponse, error) {
+	gp.accounts.Lock()
+	defer gp.accounts.Unlock()
+
+	governanceAccount, err := gp.getAccount(vm.GovernanceSCAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	config, err := gp.getConfig(governanceAccount)
+	if err != nil {
+		return nil, err
+	}
+
+	totalStake, err := gp.getTotalStake()
+	if err != nil {
+		return nil, err
+	}
+
+	proposal, err := gp.getProposalByNonce(governanceAccount, nonce)
+	if err != nil {
+		return nil, err
+	}
+
+	return gp.convertProposal(proposal, config, totalStake), nil
+}
+
+// GetGovernanceVotes will return the proposals the provided address voted on, directly or through delegation
+// contracts, its current voting power and, for delegation contracts, the voting power used on behalf of the delegators
+func (gp *governanceProcessor) GetGovernanceVotes(address string) (*common.GovernanceVotesAPIResponse, error) {
+	addressBytes, err := gp.publicKeyConverter.Decode(address)
+	if err != nil {
+		return nil, err
+	}
+
+	gp.accounts.Lock()
+	defer gp.accounts.Unlock()
+
+	governanceAccount, err := gp.getAccount(vm.GovernanceSCAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	config, err := gp.getConfig(governanceAccount)
+	if err != nil {
+		return nil, err
+	}
+
+	votedList, err := gp.getVotedList(governanceAccount, addressBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	directVotes, err := gp.getVotes(governanceAccount, votedList.Direct)
+	if err != nil {
+		return nil, err
+	}
+
+	delegatedVotes, err := gp.getVotes(governanceAccount, votedList.Delegated)
+	if err != nil {
+		return nil, err
+	}
+
+	delegatedVotingPower, err := gp.getDelegatedVotingPower(governanceAccount, addressBytes, config.LastProposalNonce)
+	if err != nil {
+		return nil, err
+	}
+
+	return &common.GovernanceVotesAPIResponse{
+		Address:              address,
+		VotingPower:          gp.getVotingPower(addressBytes).String(),
+		DirectVotes:          directVotes,
+		DelegatedVotes:       delegatedVotes,
+		DelegatedVotingPower: delegatedVotingPower,
+	}, nil
+}
+
+func (gp *governanceProcessor) getVotedList(governanceAccount state.UserAccountHandler, address []byte) (*systemSmartContracts.OngoingVotedList, error) {
+	votedList := &systemSmartContracts.OngoingVotedList{
+		Direct:    make([]uint64, 0),
+		Delegated: make([]uint64, 0),
+	}
+
+	marshalledData, _, err := governanceAccount.RetrieveValue(address)
+	if err != nil || len(marshalledData) == 0 {
+		return votedList, nil
+	}
+
+	err = gp.marshaller.Unmarshal(votedList, marshalledData)
+	if err != nil {
+		return nil, err
+	}
+
+	return votedList, nil
+}
+
+func (gp *governanceProcessor) getVotes(governanceAccount state.UserAccountHandler, nonces []uint64) ([]*common.GovernanceVoteAPIResponse, error) {
+	currentEpoch := uint64(gp.epochNotifier.CurrentEpoch())
+
+	votes := make([]*common.GovernanceVoteAPIResponse, 0, len(nonces))
+	for _, nonce := range nonces {
+		proposal, err := gp.getProposalByNonce(governanceAccount, nonce)
+		if err != nil {
+			return nil, err
+		}
+
+		votes = append(votes, &common.GovernanceVoteAPIResponse{
+			ProposalNonce: nonce,
+			CommitHash:    string(proposal.CommitHash),
+			Status:        computeProposalStatus(proposal, currentEpoch),
+		})
+	}
+
+	return votes, nil
+}
+
+func (gp *governanceProcessor) getDelegatedVotingPower(
+	governanceAccount state.UserAccountHandler,
+	address []byte,
+	lastProposalNonce uint64,
+) ([]*common.GovernanceDelegatedVotingPowerAPIResponse, error) {
+	result := make([]*common.GovernanceDelegatedVotingPowerAPIResponse, 0)
+	for nonce := uint64(1); nonce <= lastProposalNonce; nonce++ {
+		key := append(append(make([]byte, 0), address...), big.NewInt(0).SetUint64(nonce).Bytes()...)
+		marshalledData, _, err := governanceAccount.RetrieveValue(key)
+		if err != nil || len(marshalledData) == 0 {
+			continue
+		}
+
+		voteInfo := &systemSmartContracts.DelegatedSCVoteInfo{}
+		err = gp.marshaller.Unmarshal(voteInfo, marshalledData)
+		if err != nil {
+			return nil, err
+		}
+
+		result = append(result, &common.GovernanceDelegatedVotingPowerAPIResponse{
+			ProposalNonce: nonce,
+			TotalPower:    bigIntToString(voteInfo.TotalPower),
+			UsedPower:     bigIntToString(voteInfo.UsedPower),
+			TotalStake:    bigIntToString(voteInfo.TotalStake),
+			UsedStake:     bigIntToString(voteInfo.UsedStake),
+		})
+	}
+
+	return result, nil
+}
+
+// getVotingPower returns the voting power computed by the governance contract, or 0 if the address does not
+// have enough stake to vote
+func (gp *governanceProcessor) getVotingPower(address []byte) *big.Int {
+	scQuery := &process.SCQuery{
+		ScAddress:  vm.GovernanceSCAddress,
+		FuncName:   "viewVotingPower",
+		CallerAddr: vm.GovernanceSCAddress,
+		CallValue:  big.NewInt(0),
+		Arguments:  [][]byte{address},
+	}
+
+	vmOutput, _, err := gp.queryService.ExecuteQuery(scQuery)
+	if err != nil {
+		log.Debug("governanceProcessor.getVotingPower", "error", err)
+		return big.NewInt(0)
+	}
+	if vmOutput.ReturnCode != vmcommon.Ok || len(vmOutput.ReturnData) == 0 {
+		log.Debug("governanceProcessor.getVotingPower", "return code", vmOutput.ReturnCode, "message", vmOutput.ReturnMessage)
+		return big.NewInt(0)
+	}
+
+	return big.NewInt(0).SetBytes(vmOutput.ReturnData[0])
+}
+
+func (gp *governanceProcessor) getConfig(governanceAccount state.UserAccountHandler) (*systemSmartContracts.GovernanceConfigV2, error) {
+	marshalledData, _, err := governanceAccount.RetrieveValue([]byte(governanceConfigKey))
+	if err != nil {
+		return nil, err
+	}
+	if len(marshalledData) == 0 {
+		return nil, ErrGovernanceNotInitialized
+	}
+
+	config := &systemSmartContracts.GovernanceConfigV2{}
+	err = gp.marshaller.Unmarshal(config, marshalledData)
+	if err != nil {
+		return nil, err
+	}
+
+	return config, nil
+}
+
+func (gp *governanceProcessor) getProposalByNonce(governanceAccount state.UserAccountHandler, nonce uint64) (*systemSmartContracts.GeneralProposal, error) {
+	nonceKey := append([]byte(governanceNoncePrefix), big.NewInt(0).SetUint64(nonce).Bytes()...)
+	commitHash, _, err := governanceAccount.RetrieveValue(nonceKey)
+	if err != nil {
+		return nil, err
+	}
+	if len(commitHash) == 0 {
+		return nil, fmt.Errorf("%w, nonce %d", ErrProposalNotFound, nonce)
+	}
+
+	proposalKey := append([]byte(governanceProposalPrefix), commitHash...)
+	marshalledData, _, err := governanceAccount.RetrieveValue(proposalKey)
+	if err != nil {
+		return nil, err
+	}
+	if len(marshalledData) == 0 {
+		return nil, fmt.Errorf("%w, nonce %d", ErrProposalNotFound, nonce)
+	}
+
+	proposal := &systemSmartContracts.GeneralProposal{}
+	err = gp.marshaller.Unmarshal(proposal, marshalledData)
+	if err != nil {
+		return nil, err
+	}
+
+	return proposal, nil
+}
+
+// getTotalStake returns the total stake in the system, the same way the governance contract computes it
+func (gp *governanceProcessor) getTotalStake() (*big.Int, error) {
+	validatorAccount, err := gp.getAccount(vm.ValidatorSCAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	return validatorAccount.GetBalance(), nil
+}
+
+func (gp *governanceProcessor) convertConfig(config *systemSmartContracts.GovernanceConfigV2) *common.GovernanceConfigAPIResponse {
+	return &common.GovernanceConfigAPIResponse{
+		MinQuorum:         config.MinQuorum,
+		MinPassThreshold:  config.MinPassThreshold,
+		MinVetoThreshold:  config.MinVetoThreshold,
+		ProposalFee:       bigIntToString(config.ProposalFee),
+		LostProposalFee:   bigIntToString(config.LostProposalFee),
+		LastProposalNonce: config.LastProposalNonce,
+	}
+}
+
+func (gp *governanceProcessor) convertProposal(
+	proposal *systemSmartContracts.GeneralProposal,
+	config *systemSmartContracts.GovernanceConfigV2,
+	totalStake *big.Int,
+) *common.GovernanceProposalAPIResponse {
+	totalVotes := big.NewInt(0)
+	for _, votes := range []*big.Int{proposal.Yes, proposal.No, proposal.Veto, proposal.Abstain} {
+		if votes != nil {
+			totalVotes.Add(totalVotes, votes)
+		}
+	}
+
+	requiredQuorum := core.GetIntTrimmedPercentageOfValue(totalStake, float64(config.MinQuorum))
+	quorumProgress := float64(0)
+	if requiredQuorum.Sign() > 0 {
+		quorumProgress, _ = big.NewFloat(0).Quo(
+			big.NewFloat(0).SetInt(big.NewInt(0).Mul(totalVotes, big.NewInt(100))),
+			big.NewFloat(0).SetInt(requiredQuorum),
+		).Float64()
+	}
+
+	return &common.GovernanceProposalAPIResponse{
+		Nonce:          proposal.Nonce,
+		CommitHash:     string(proposal.CommitHash),
+		Issuer:         gp.publicKeyConverter.SilentEncode(proposal.IssuerAddress, log),
+		Status:         computeProposalStatus(proposal, uint64(gp.epochNotifier.CurrentEpoch())),
+		StartVoteEpoch: proposal.StartVoteEpoch,
+		EndVoteEpoch:   proposal.EndVoteEpoch,
+		Closed:         proposal.Closed,
+		Passed:         proposal.Passed,
+		ProposalCost:   bigIntToString(proposal.ProposalCost),
+		Yes:            bigIntToString(proposal.Yes),
+		No:             bigIntToString(proposal.No),
+		Veto:           bigIntToString(proposal.Veto),
+		Abstain:        bigIntToString(proposal.Abstain),
+		TotalVotes:     totalVotes.String(),
+		QuorumStake:    bigIntToString(proposal.QuorumStake),
+		RequiredQuorum: requiredQuorum.String(),
+		QuorumProgress: quorumProgress,
+	}
+}
+
+func computeProposalStatus(proposal *systemSmartContracts.GeneralProposal, currentEpoch uint64) string {
+	switch {
+	case proposal.Closed && proposal.Passed:
+		return ProposalStatusPassed
+	case proposal.Closed:
+		return ProposalStatusRejected
+	case currentEpoch < proposal.StartVoteEpoch:
+		return ProposalStatusPending
+	case currentEpoch <= proposal.EndVoteEpoch:
+		return ProposalStatusActive
+	default:
+		return ProposalStatusEnded
+	}
+}
+
+func bigIntToString(value *big.Int) string {
+	if value == nil {
+		return "0"
+	}
+
+	return value.String()
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (gp *governanceProcessor) IsInterfaceNil() bool {
+	return gp == nil
+}
@@ -0,0 +1,422 @@
+package trieIterators
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	"errors"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/core/keyValStorage"
+	"github.com/multiversx/mx-chain-core-go/marshal"
+	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/node/mock"
+	"github.com/multiversx/mx-chain-go/process"
+	"github.com/multiversx/mx-chain-go/state"
+	"github.com/multiversx/mx-chain-go/state/accounts"
+	"github.com/multiversx/mx-chain-go/testscommon"
+	"github.com/multiversx/mx-chain-go/testscommon/epochNotifier"
+	stateMock "github.com/multiversx/mx-chain-go/testscommon/state"
+	trieMock "github.com/multiversx/mx-chain-go/testscommon/trie"
+	"github.com/multiversx/mx-chain-go/vm"
+	"github.com/multiversx/mx-chain-go/vm/systemSmartContracts"
+	vmcommon "github.com/multiversx/mx-chain-vm-common-go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var governanceMarshaller = &marshal.GogoProtoMarshalizer{}
+
+func createMockGovernanceArgs() ArgGovernanceProcessor {
+	return ArgGovernanceProcessor{
+		ArgTrieIteratorProcessor: createMockArgs(),
+		Marshaller:               governanceMarshaller,
+		EpochNotifier:            &epochNotifier.EpochNotifierStub{},
+	}
+}
+
+type governanceStorage struct {
+	keys   [][]byte
+	values map[string][]byte
+}
+
+func newGovernanceStorage() *governanceStorage {
+	return &governanceStorage{
+		values: make(map[string][]byte),
+	}
+}
+
+func (gs *governanceStorage) put(t *testing.T, key []byte, value interface{}) {
+	var buff []byte
+	switch v := value.(type) {
+	case []byte:
+		buff = v
+	default:
+		var err error
+		buff, err = governanceMarshaller.Marshal(value)
+		require.Nil(t, err)
+	}
+
+	gs.keys = append(gs.keys, key)
+	gs.values[string(key)] = buff
+}
+
+func (gs *governanceStorage) addProposal(t *testing.T, proposal *systemSmartContracts.GeneralProposal) {
+	gs.put(t, append([]byte(governanceProposalPrefix), proposal.CommitHash...), proposal)
+	gs.put(t, append([]byte(governanceNoncePrefix), big.NewInt(0).SetUint64(proposal.Nonce).Bytes()...), proposal.CommitHash)
+}
+
+func (gs *governanceStorage) createAccount(timeSleep time.Duration) state.UserAccountHandler {
+	dtt := &trieMock.DataTrieTrackerStub{
+		RetrieveValueCalled: func(key []byte) ([]byte, uint32, error) {
+			return gs.values[string(key)], 0, nil
+		},
+		DataTrieCalled: func() common.Trie {
+			return &trieMock.TrieStub{
+				RootCalled: func() ([]byte, error) {
+					return []byte("root hash"), nil
+				},
+				GetAllLeavesOnChannelCalled: func(leavesChannels *common.TrieIteratorChannels, ctx context.Context, rootHash []byte, _ common.KeyBuilder, _ common.TrieLeafParser) error {
+					go func() {
+						time.Sleep(timeSleep)
+						for _, key := range gs.keys {
+							leavesChannels.LeavesChan <- keyValStorage.NewKeyValStorage(key, gs.values[string(key)])
+						}
+
+						close(leavesChannels.LeavesChan)
+						leavesChannels.ErrChan.Close()
+					}()
+
+					return nil
+				},
+			}
+		},
+	}
+
+	acc, _ := accounts.NewUserAccount(vm.GovernanceSCAddress, dtt, &trieMock.TrieLeafParserStub{})
+
+	return acc
+}
+
+func createGovernanceProcessorArgs(storage *governanceStorage, totalStake *big.Int, currentEpoch uint32, timeSleep time.Duration) ArgGovernanceProcessor {
+	arg := createMockGovernanceArgs()
+	arg.PublicKeyConverter = testscommon.NewPubkeyConverterMock(32)
+	arg.EpochNotifier = &epochNotifier.EpochNotifierStub{
+		CurrentEpochCalled: func() uint32 {
+			return currentEpoch
+		},
+	}
+	arg.Accounts.AccountsAdapter = &stateMock.AccountsStub{
+		GetExistingAccountCalled: func(address []byte) (vmcommon.AccountHandler, error) {
+			if bytes.Equal(address, vm.ValidatorSCAddress) {
+				acc, _ := accounts.NewUserAccount(address, &trieMock.DataTrieTrackerStub{}, &trieMock.TrieLeafParserStub{})
+				_ = acc.AddToBalance(totalStake)
+				return acc, nil
+			}
+
+			return storage.createAccount(timeSleep), nil
+		},
+	}
+
+	return arg
+}
+
+func createGovernanceStorageWithProposals(t *testing.T) *governanceStorage {
+	storage := newGovernanceStorage()
+	storage.put(t, []byte(governanceConfigKey), &systemSmartContracts.GovernanceConfigV2{
+		MinQuorum:         0.5,
+		MinPassThreshold:  0.5,
+		MinVetoThreshold:  0.33,
+		ProposalFee:       big.NewInt(1000),
+		LostProposalFee:   big.NewInt(10),
+		LastProposalNonce: 3,
+	})
+	storage.addProposal(t, &systemSmartContracts.GeneralProposal{
+		Nonce:          2,
+		CommitHash:     []byte("second"),
+		StartVoteEpoch: 10,
+		EndVoteEpoch:   20,
+		Yes:            big.NewInt(100),
+		No:             big.NewInt(50),
+		Veto:           big.NewInt(25),
+		Abstain:        big.NewInt(25),
+		QuorumStake:    big.NewInt(200),
+		IssuerAddress:  bytes.Repeat([]byte("a"), 32),
+		ProposalCost:   big.NewInt(1000),
+	})
+	storage.addProposal(t, &systemSmartContracts.GeneralProposal{
+		Nonce:          1,
+		CommitHash:     []byte("first"),
+		StartVoteEpoch: 1,
+		EndVoteEpoch:   5,
+		Yes:            big.NewInt(600),
+		No:             big.NewInt(0),
+		Veto:           big.NewInt(0),
+		Abstain:        big.NewInt(0),
+		QuorumStake:    big.NewInt(600),
+		Passed:         true,
+		Closed:         true,
+		IssuerAddress:  bytes.Repeat([]byte("a"), 32),
+		ProposalCost:   big.NewInt(1000),
+	})
+	storage.addProposal(t, &systemSmartContracts.GeneralProposal{
+		Nonce:          3,
+		CommitHash:     []byte("third"),
+		StartVoteEpoch: 30,
+		EndVoteEpoch:   40,
+		IssuerAddress:  bytes.Repeat([]byte("b"), 32),
+		ProposalCost:   big.NewInt(1000),
+	})
+
+	return storage
+}
+
+func TestNewGovernanceProcessor(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		argsFunc func() ArgGovernanceProcessor
+		exError  error
+	}{
+		{
+			name: "NilAccounts",
+			argsFunc: func() ArgGovernanceProcessor {
+				arg := createMockGovernanceArgs()
+				arg.Accounts = nil
+
+				return arg
+			},
+			exError: ErrNilAccountsAdapter,
+		},
+		{
+			name: "NilMarshaller",
+			argsFunc: func() ArgGovernanceProcessor {
+				arg := createMockGovernanceArgs()
+				arg.Marshaller = nil
+
+				return arg
+			},
+			exError: ErrNilMarshaller,
+		},
+		{
+			name: "NilEpochNotifier",
+			argsFunc: func() ArgGovernanceProcessor {
+				arg := createMockGovernanceArgs()
+				arg.EpochNotifier = nil
+
+				return arg
+			},
+			exError: ErrNilEpochNotifier,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gp, err := NewGovernanceProcessor(tt.argsFunc())
+			require.True(t, errors.Is(err, tt.exError))
+			require.Nil(t, gp)
+		})
+	}
+
+	gp, err := NewGovernanceProcessor(createMockGovernanceArgs())
+	require.NotNil(t, gp)
+	require.Nil(t, err)
+}
+
+func TestGovernanceProcessor_GetGovernanceProposals(t *testing.T) {
+	t.Parallel()
+
+	t.Run("governance not initialized should error", func(t *testing.T) {
+		t.Parallel()
+
+		arg := createGovernanceProcessorArgs(newGovernanceStorage(), big.NewInt(1000), 15, 0)
+		gp, _ := NewGovernanceProcessor(arg)
+
+		proposals, err := gp.GetGovernanceProposals(context.Background())
+		require.Equal(t, ErrGovernanceNotInitialized, err)
+		require.Nil(t, proposals)
+	})
+	t.Run("context timeout should error", func(t *testing.T) {
+		t.Parallel()
+
+		arg := createGovernanceProcessorArgs(createGovernanceStorageWithProposals(t), big.NewInt(1000), 15, time.Second)
+		gp, _ := NewGovernanceProcessor(arg)
+
+		ctxWithTimeout, cancel := context.WithTimeout(context.Background(), time.Millisecond)
+		defer cancel()
+
+		proposals, err := gp.GetGovernanceProposals(ctxWithTimeout)
+		require.Equal(t, ErrTrieOperationsTimeout, err)
+		require.Nil(t, proposals)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		arg := createGovernanceProcessorArgs(createGovernanceStorageWithProposals(t), big.NewInt(1000), 15, 0)
+		gp, _ := NewGovernanceProcessor(arg)
+
+		response, err := gp.GetGovernanceProposals(context.Background())
+		require.Nil(t, err)
+		require.Equal(t, uint32(15), response.CurrentEpoch)
+		require.Equal(t, "1000", response.TotalStake)
+		require.Equal(t, &common.GovernanceConfigAPIResponse{
+			MinQuorum:         0.5,
+			MinPassThreshold:  0.5,
+			MinVetoThreshold:  0.33,
+			ProposalFee:       "1000",
+			LostProposalFee:   "10",
+			LastProposalNonce: 3,
+		}, response.Config)
+
+		require.Equal(t, 3, len(response.Proposals))
+		assert.Equal(t, &common.GovernanceProposalAPIResponse{
+			Nonce:          1,
+			CommitHash:     "first",
+			Issuer:         hex.EncodeToString(bytes.Repeat([]byte("a"), 32)),
+			Status:         ProposalStatusPassed,
+			StartVoteEpoch: 1,
+			EndVoteEpoch:   5,
+			Closed:         true,
+			Passed:         true,
+			ProposalCost:   "1000",
+			Yes:            "600",
+			No:             "0",
+			Veto:           "0",
+			Abstain:        "0",
+			TotalVotes:     "600",
+			QuorumStake:    "600",
+			RequiredQuorum: "500",
+			QuorumProgress: 120,
+		}, response.Proposals[0])
+		assert.Equal(t, uint64(2), response.Proposals[1].Nonce)
+		assert.Equal(t, ProposalStatusActive, response.Proposals[1].Status)
+		assert.Equal(t, "200", response.Proposals[1].TotalVotes)
+		assert.Equal(t, float64(40), response.Proposals[1].QuorumProgress)
+		assert.Equal(t, uint64(3), response.Proposals[2].Nonce)
+		assert.Equal(t, ProposalStatusPending, response.Proposals[2].Status)
+		assert.Equal(t, "0", response.Proposals[2].Yes)
+		assert.Equal(t, "0", response.Proposals[2].TotalVotes)
+	})
+}
+
+func TestGovernanceProcessor_GetGovernanceProposal(t *testing.T) {
+	t.Parallel()
+
+	t.Run("unknown nonce should error", func(t *testing.T) {
+		t.Parallel()
+
+		arg := createGovernanceProcessorArgs(createGovernanceStorageWithProposals(t), big.NewInt(1000), 15, 0)
+		gp, _ := NewGovernanceProcessor(arg)
+
+		proposal, err := gp.GetGovernanceProposal(4)
+		require.True(t, errors.Is(err, ErrProposalNotFound))
+		require.Nil(t, proposal)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		arg := createGovernanceProcessorArgs(createGovernanceStorageWithProposals(t), big.NewInt(1000), 25, 0)
+		gp, _ := NewGovernanceProcessor(arg)
+
+		proposal, err := gp.GetGovernanceProposal(2)
+		require.Nil(t, err)
+		require.Equal(t, "second", proposal.CommitHash)
+		require.Equal(t, ProposalStatusEnded, proposal.Status)
+		require.Equal(t, "500", proposal.RequiredQuorum)
+	})
+}
+
+func TestGovernanceProcessor_GetGovernanceVotes(t *testing.T) {
+	t.Parallel()
+
+	voter := bytes.Repeat([]byte("v"), 32)
+	delegationSC := bytes.Repeat([]byte("d"), 32)
+
+	t.Run("invalid address should error", func(t *testing.T) {
+		t.Parallel()
+
+		arg := createGovernanceProcessorArgs(createGovernanceStorageWithProposals(t), big.NewInt(1000), 15, 0)
+		gp, _ := NewGovernanceProcessor(arg)
+
+		votes, err := gp.GetGovernanceVotes("not hex")
+		require.NotNil(t, err)
+		require.Nil(t, votes)
+	})
+	t.Run("address without votes should return empty lists", func(t *testing.T) {
+		t.Parallel()
+
+		arg := createGovernanceProcessorArgs(createGovernanceStorageWithProposals(t), big.NewInt(1000), 15, 0)
+		arg.QueryService = &mock.SCQueryServiceStub{
+			ExecuteQueryCalled: func(query *process.SCQuery) (*vmcommon.VMOutput, common.BlockInfo, error) {
+				return &vmcommon.VMOutput{ReturnCode: vmcommon.UserError}, nil, nil
+			},
+		}
+		gp, _ := NewGovernanceProcessor(arg)
+
+		votes, err := gp.GetGovernanceVotes(hex.EncodeToString(voter))
+		require.Nil(t, err)
+		require.Equal(t, "0", votes.VotingPower)
+		require.Empty(t, votes.DirectVotes)
+		require.Empty(t, votes.DelegatedVotes)
+		require.Empty(t, votes.DelegatedVotingPower)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		storage := createGovernanceStorageWithProposals(t)
+		storage.put(t, delegationSC, &systemSmartContracts.OngoingVotedList{
+			Direct:    []uint64{1},
+			Delegated: []uint64{2},
+		})
+		storage.put(t, append(append([]byte{}, delegationSC...), big.NewInt(2).Bytes()...), &systemSmartContracts.DelegatedSCVoteInfo{
+			TotalPower: big.NewInt(300),
+			UsedPower:  big.NewInt(120),
+			TotalStake: big.NewInt(300),
+			UsedStake:  big.NewInt(120),
+		})
+
+		arg := createGovernanceProcessorArgs(storage, big.NewInt(1000), 15, 0)
+		arg.QueryService = &mock.SCQueryServiceStub{
+			ExecuteQueryCalled: func(query *process.SCQuery) (*vmcommon.VMOutput, common.BlockInfo, error) {
+				assert.Equal(t, "viewVotingPower", query.FuncName)
+				assert.Equal(t, vm.GovernanceSCAddress, query.ScAddress)
+				assert.Equal(t, [][]byte{delegationSC}, query.Arguments)
+
+				return &vmcommon.VMOutput{
+					ReturnCode: vmcommon.Ok,
+					ReturnData: [][]byte{big.NewInt(300).Bytes()},
+				}, nil, nil
+			},
+		}
+		gp, _ := NewGovernanceProcessor(arg)
+
+		votes, err := gp.GetGovernanceVotes(hex.EncodeToString(delegationSC))
+		require.Nil(t, err)
+		require.Equal(t, &common.GovernanceVotesAPIResponse{
+			Address:     hex.EncodeToString(delegationSC),
+			VotingPower: "300",
+			DirectVotes: []*common.GovernanceVoteAPIResponse{
+				{ProposalNonce: 1, CommitHash: "first", Status: ProposalStatusPassed},
+			},
+			DelegatedVotes: []*common.GovernanceVoteAPIResponse{
+				{ProposalNonce: 2, CommitHash: "second", Status: ProposalStatusActive},
+			},
+			DelegatedVotingPower: []*common.GovernanceDelegatedVotingPowerAPIResponse{
+				{ProposalNonce: 2, TotalPower: "300", UsedPower: "120", TotalStake: "300", UsedStake: "120"},
+			},
+		}, votes)
+	})
+}
+
+func TestGovernanceProcessor_IsInterfaceNil(t *testing.T) {
+	t.Parallel()
+
+	var gp *governanceProcessor
+	require.True(t, gp.IsInterfaceNil())
+
+	gp, _ = NewGovernanceProcessor(createMockGovernanceArgs())
+	require.False(t, gp.IsInterfaceNil())
+}
@@ -2,6 +2,7 @@ package disabled
 
 import (
 	outportcore "github.com/multiversx/mx-chain-core-go/data/outport"
+	"github.com/multiversx/mx-chain-go/common"
 	"github.com/multiversx/mx-chain-go/outport"
 )
 
@@ -42,6 +43,10 @@ func (n *disabledOutport) SaveAccounts(_ *outportcore.Accounts) {
 func (n *disabledOutport) FinalizedBlock(_ *outportcore.FinalizedBlock) {
 }
 
+// SaveConsensusRoundTimeline does nothing
+func (n *disabledOutport) SaveConsensusRoundTimeline(_ *common.ConsensusRoundTimeline) {
+}
+
 // Close does nothing
 func (n *disabledOutport) Close() error {
 	return nil
@@ -0,0 +1,22 @@
+package factory
+
+import (
+	"github.com/multiversx/mx-chain-es-indexer-go/process/dataindexer"
+	"github.com/multiversx/mx-chain-go/common"
+)
+
+// elasticDriver adapts the elastic indexer to the outport driver interface. The consensus round timelines are not
+// indexed in elastic, so they are ignored
+type elasticDriver struct {
+	dataindexer.Indexer
+}
+
+// SaveConsensusRoundTimeline does nothing
+func (ed *elasticDriver) SaveConsensusRoundTimeline(_ *common.ConsensusRoundTimeline) error {
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (ed *elasticDriver) IsInterfaceNil() bool {
+	return ed == nil || ed.Indexer == nil || ed.Indexer.IsInterfaceNil()
+}
@@ -0,0 +1,25 @@
+package factory
+
+import (
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/marshal"
+	"github.com/multiversx/mx-chain-go/config"
+	"github.com/multiversx/mx-chain-go/outport"
+	"github.com/multiversx/mx-chain-go/outport/file"
+)
+
+// ArgsFileDriverFactory holds the arguments needed for creating a file driver
+type ArgsFileDriverFactory struct {
+	FileConfig config.FileDriverConfig
+	Marshaller marshal.Marshalizer
+}
+
+// CreateFileDriver will create a new instance of outport.Driver that writes to local segment files
+func CreateFileDriver(args ArgsFileDriverFactory) (outport.Driver, error) {
+	return file.NewFileDriver(file.ArgsFileDriver{
+		Marshaller:            args.Marshaller,
+		Path:                  args.FileConfig.Path,
+		MaxSegmentSizeInBytes: args.FileConfig.MaxSegmentSizeInMB * core.MegabyteSize,
+		RotateOnEpochChange:   args.FileConfig.RotateOnEpochChange,
+	})
+}
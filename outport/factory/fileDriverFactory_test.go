@@ -0,0 +1,29 @@
+package factory
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/multiversx/mx-chain-go/config"
+	"github.com/multiversx/mx-chain-go/testscommon/marshallerMock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreateFileDriver(t *testing.T) {
+	t.Parallel()
+
+	args := ArgsFileDriverFactory{
+		FileConfig: config.FileDriverConfig{
+			Enabled:            true,
+			Path:               t.TempDir(),
+			MaxSegmentSizeInMB: 1,
+		},
+		Marshaller: &marshallerMock.MarshalizerStub{},
+	}
+
+	driver, err := CreateFileDriver(args)
+	require.Nil(t, err)
+	require.NotNil(t, driver)
+	require.Equal(t, "*file.fileDriver", fmt.Sprintf("%T", driver))
+	require.Nil(t, driver.Close())
+}
@@ -6,6 +6,7 @@ import (
 
 	outportcore "github.com/multiversx/mx-chain-core-go/data/outport"
 	indexerFactory "github.com/multiversx/mx-chain-es-indexer-go/process/factory"
+	"github.com/multiversx/mx-chain-go/config"
 	"github.com/multiversx/mx-chain-go/outport"
 )
 
@@ -17,6 +18,8 @@ type OutportFactoryArgs struct {
 	ElasticIndexerFactoryArgs indexerFactory.ArgsIndexerFactory
 	EventNotifierFactoryArgs  *EventNotifierFactoryArgs
 	HostDriversArgs           []ArgsHostDriverFactory
+	FileDriverArgs            ArgsFileDriverFactory
+	DriverQueueConfig         config.OutportQueueConfig
 }
 
 // CreateOutport will create a new instance of OutportHandler
@@ -45,45 +48,67 @@ func CreateOutport(args *OutportFactoryArgs) (outport.OutportHandler, error) {
 }
 
 func createAndSubscribeDrivers(outport outport.OutportHandler, args *OutportFactoryArgs) error {
-	err := createAndSubscribeElasticDriverIfNeeded(outport, args.ElasticIndexerFactoryArgs)
+	queueArgs := ArgsQueuedDriverFactory{
+		QueueConfig:     args.DriverQueueConfig,
+		RetrialInterval: args.RetrialInterval,
+	}
+
+	err := createAndSubscribeElasticDriverIfNeeded(outport, args.ElasticIndexerFactoryArgs, queueArgs)
 	if err != nil {
 		return err
 	}
 
-	err = createAndSubscribeEventNotifierIfNeeded(outport, args.EventNotifierFactoryArgs)
+	err = createAndSubscribeEventNotifierIfNeeded(outport, args.EventNotifierFactoryArgs, queueArgs)
 	if err != nil {
 		return err
 	}
 
 	for idx := 0; idx < len(args.HostDriversArgs); idx++ {
-		err = createAndSubscribeHostDriverIfNeeded(outport, args.HostDriversArgs[idx])
+		err = createAndSubscribeHostDriverIfNeeded(outport, args.HostDriversArgs[idx], idx, queueArgs)
 		if err != nil {
 			return fmt.Errorf("%w when calling createAndSubscribeHostDriverIfNeeded, host driver index %d", err, idx)
 		}
 	}
 
-	return nil
+	return createAndSubscribeFileDriverIfNeeded(outport, args.FileDriverArgs, queueArgs)
+}
+
+func subscribeDriver(
+	outport outport.OutportHandler,
+	driver outport.Driver,
+	driverName string,
+	queueArgs ArgsQueuedDriverFactory,
+) error {
+	queuedDriver, err := CreateQueuedDriverIfNeeded(driver, driverName, queueArgs)
+	if err != nil {
+		_ = driver.Close()
+		return fmt.Errorf("%w while creating the queue for the %s driver", err, driverName)
+	}
+
+	return outport.SubscribeDriver(queuedDriver)
 }
 
 func createAndSubscribeElasticDriverIfNeeded(
 	outport outport.OutportHandler,
 	args indexerFactory.ArgsIndexerFactory,
+	queueArgs ArgsQueuedDriverFactory,
 ) error {
 	if !args.Enabled {
 		return nil
 	}
 
-	elasticDriver, err := indexerFactory.NewIndexer(args)
+	indexer, err := indexerFactory.NewIndexer(args)
 	if err != nil {
 		return err
 	}
 
-	return outport.SubscribeDriver(elasticDriver)
+	return subscribeDriver(outport, &elasticDriver{Indexer: indexer}, "elastic", queueArgs)
 }
 
 func createAndSubscribeEventNotifierIfNeeded(
 	outport outport.OutportHandler,
 	args *EventNotifierFactoryArgs,
+	queueArgs ArgsQueuedDriverFactory,
 ) error {
 	if !args.Enabled {
 		return nil
@@ -94,7 +119,7 @@ func createAndSubscribeEventNotifierIfNeeded(
 		return err
 	}
 
-	return outport.SubscribeDriver(eventNotifier)
+	return subscribeDriver(outport, eventNotifier, "notifier", queueArgs)
 }
 
 func checkArguments(args *OutportFactoryArgs) error {
@@ -108,6 +133,8 @@ func checkArguments(args *OutportFactoryArgs) error {
 func createAndSubscribeHostDriverIfNeeded(
 	outport outport.OutportHandler,
 	args ArgsHostDriverFactory,
+	index int,
+	queueArgs ArgsQueuedDriverFactory,
 ) error {
 	if !args.HostConfig.Enabled {
 		return nil
@@ -118,5 +145,22 @@ func createAndSubscribeHostDriverIfNeeded(
 		return err
 	}
 
-	return outport.SubscribeDriver(hostDriver)
+	return subscribeDriver(outport, hostDriver, fmt.Sprintf("host-%d", index), queueArgs)
+}
+
+func createAndSubscribeFileDriverIfNeeded(
+	outport outport.OutportHandler,
+	args ArgsFileDriverFactory,
+	queueArgs ArgsQueuedDriverFactory,
+) error {
+	if !args.FileConfig.Enabled {
+		return nil
+	}
+
+	fileDriver, err := CreateFileDriver(args)
+	if err != nil {
+		return err
+	}
+
+	return subscribeDriver(outport, fileDriver, "file", queueArgs)
 }
@@ -11,6 +11,7 @@ import (
 	"github.com/multiversx/mx-chain-go/outport"
 	"github.com/multiversx/mx-chain-go/outport/factory"
 	notifierFactory "github.com/multiversx/mx-chain-go/outport/factory"
+	"github.com/multiversx/mx-chain-go/outport/queue"
 	"github.com/multiversx/mx-chain-go/process/mock"
 	"github.com/multiversx/mx-chain-storage-go/testscommon"
 	"github.com/stretchr/testify/require"
@@ -188,3 +189,59 @@ func TestCreateAndSubscribeDriversShouldReturnError(t *testing.T) {
 	require.Nil(t, outPort)
 	require.ErrorIs(t, err, data.ErrInvalidWebSocketHostMode)
 }
+
+func TestCreateOutport_SubscribeFileDriver(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsOutportHandler(false, false)
+	args.FileDriverArgs = factory.ArgsFileDriverFactory{
+		Marshaller: &testscommon.MarshalizerMock{},
+		FileConfig: config.FileDriverConfig{
+			Enabled:            true,
+			Path:               t.TempDir(),
+			MaxSegmentSizeInMB: 1,
+		},
+	}
+
+	outPort, err := factory.CreateOutport(args)
+	require.Nil(t, err)
+	require.True(t, outPort.HasDrivers())
+	require.Nil(t, outPort.Close())
+
+	args = createMockArgsOutportHandler(false, false)
+	args.FileDriverArgs.FileConfig.Enabled = true
+
+	outPort, err = factory.CreateOutport(args)
+	require.Nil(t, outPort)
+	require.NotNil(t, err)
+}
+
+func TestCreateOutport_SubscribeQueuedFileDriver(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsOutportHandler(false, false)
+	args.FileDriverArgs = factory.ArgsFileDriverFactory{
+		Marshaller: &testscommon.MarshalizerMock{},
+		FileConfig: config.FileDriverConfig{
+			Enabled:            true,
+			Path:               t.TempDir(),
+			MaxSegmentSizeInMB: 1,
+		},
+	}
+	args.DriverQueueConfig = config.OutportQueueConfig{
+		Enabled:            true,
+		Path:               t.TempDir(),
+		MaxQueueSizeInMB:   1,
+		MaxSegmentSizeInMB: 1,
+	}
+
+	outPort, err := factory.CreateOutport(args)
+	require.Nil(t, err)
+	require.True(t, outPort.HasDrivers())
+	require.Nil(t, outPort.Close())
+
+	args.DriverQueueConfig.MaxSegmentSizeInMB = 0
+	outPort, err = factory.CreateOutport(args)
+	require.Nil(t, outPort)
+	require.ErrorIs(t, err, queue.ErrInvalidMaxSegmentSize)
+}
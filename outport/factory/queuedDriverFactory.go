@@ -0,0 +1,33 @@
+package factory
+
+import (
+	"path/filepath"
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-go/config"
+	"github.com/multiversx/mx-chain-go/outport"
+	"github.com/multiversx/mx-chain-go/outport/queue"
+)
+
+// ArgsQueuedDriverFactory holds the arguments needed for placing disk-backed queues in front of the drivers
+type ArgsQueuedDriverFactory struct {
+	QueueConfig     config.OutportQueueConfig
+	RetrialInterval time.Duration
+}
+
+// CreateQueuedDriverIfNeeded will wrap the provided driver in a disk-backed queue, if the queues are enabled.
+// Every driver gets its own queue, stored in a subdirectory named after the driver
+func CreateQueuedDriverIfNeeded(driver outport.Driver, driverName string, args ArgsQueuedDriverFactory) (outport.Driver, error) {
+	if !args.QueueConfig.Enabled {
+		return driver, nil
+	}
+
+	return queue.NewQueuedDriver(queue.ArgsQueuedDriver{
+		Driver:                driver,
+		Path:                  filepath.Join(args.QueueConfig.Path, driverName),
+		MaxQueueSizeInBytes:   args.QueueConfig.MaxQueueSizeInMB * core.MegabyteSize,
+		MaxSegmentSizeInBytes: args.QueueConfig.MaxSegmentSizeInMB * core.MegabyteSize,
+		RetrialInterval:       args.RetrialInterval,
+	})
+}
@@ -0,0 +1,63 @@
+package factory
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/multiversx/mx-chain-go/config"
+	"github.com/multiversx/mx-chain-go/outport/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreateQueuedDriverIfNeeded(t *testing.T) {
+	t.Parallel()
+
+	t.Run("disabled queue should return the driver", func(t *testing.T) {
+		t.Parallel()
+
+		providedDriver := &mock.DriverStub{}
+		driver, err := CreateQueuedDriverIfNeeded(providedDriver, "stub", ArgsQueuedDriverFactory{})
+		require.Nil(t, err)
+		require.True(t, driver == providedDriver)
+	})
+	t.Run("invalid config should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := ArgsQueuedDriverFactory{
+			QueueConfig: config.OutportQueueConfig{
+				Enabled: true,
+				Path:    t.TempDir(),
+			},
+			RetrialInterval: time.Second,
+		}
+
+		driver, err := CreateQueuedDriverIfNeeded(&mock.DriverStub{}, "stub", args)
+		require.NotNil(t, err)
+		require.Nil(t, driver)
+	})
+	t.Run("enabled queue should wrap the driver", func(t *testing.T) {
+		t.Parallel()
+
+		queuePath := t.TempDir()
+		args := ArgsQueuedDriverFactory{
+			QueueConfig: config.OutportQueueConfig{
+				Enabled:            true,
+				Path:               queuePath,
+				MaxQueueSizeInMB:   1,
+				MaxSegmentSizeInMB: 1,
+			},
+			RetrialInterval: time.Second,
+		}
+
+		driver, err := CreateQueuedDriverIfNeeded(&mock.DriverStub{}, "stub", args)
+		require.Nil(t, err)
+		require.Equal(t, "*queue.queuedDriver", fmt.Sprintf("%T", driver))
+		require.Nil(t, driver.Close())
+
+		_, err = os.Stat(filepath.Join(queuePath, "stub"))
+		require.Nil(t, err)
+	})
+}
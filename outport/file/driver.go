@@ -0,0 +1,339 @@
+package file
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-core-go/data/block"
+	"github.com/multiversx/mx-chain-core-go/data/outport"
+	"github.com/multiversx/mx-chain-core-go/marshal"
+	"github.com/multiversx/mx-chain-go/common"
+	logger "github.com/multiversx/mx-chain-logger-go"
+)
+
+var log = logger.GetOrCreate("outport/file")
+
+// ArgsFileDriver holds the arguments needed for creating a new fileDriver
+type ArgsFileDriver struct {
+	Marshaller            marshal.Marshalizer
+	Path                  string
+	MaxSegmentSizeInBytes uint64
+	RotateOnEpochChange   bool
+}
+
+type fileDriver struct {
+	mut                 sync.Mutex
+	marshaller          marshal.Marshalizer
+	blockCreators       map[core.HeaderType]block.EmptyBlockCreator
+	path                string
+	maxSegmentSize      uint64
+	rotateOnEpochChange bool
+	manifest            *Manifest
+	currentSegment      *SegmentInfo
+	currentFile         *os.File
+	isClosed            bool
+}
+
+// NewFileDriver will create a new instance of fileDriver which appends every outport message to local segment files
+func NewFileDriver(args ArgsFileDriver) (*fileDriver, error) {
+	if check.IfNil(args.Marshaller) {
+		return nil, core.ErrNilMarshalizer
+	}
+	if len(args.Path) == 0 {
+		return nil, ErrEmptyPath
+	}
+	if args.MaxSegmentSizeInBytes == 0 {
+		return nil, ErrInvalidMaxSegmentSize
+	}
+
+	err := os.MkdirAll(args.Path, os.ModePerm)
+	if err != nil {
+		return nil, err
+	}
+
+	manifest, err := ReadManifest(args.Path)
+	if err != nil {
+		return nil, err
+	}
+
+	fd := &fileDriver{
+		marshaller:          args.Marshaller,
+		blockCreators:       createBlockCreators(),
+		path:                args.Path,
+		maxSegmentSize:      args.MaxSegmentSizeInBytes,
+		rotateOnEpochChange: args.RotateOnEpochChange,
+		manifest:            manifest,
+	}
+
+	err = fd.sealPreviousSegments()
+	if err != nil {
+		return nil, err
+	}
+
+	return fd, nil
+}
+
+func createBlockCreators() map[core.HeaderType]block.EmptyBlockCreator {
+	return map[core.HeaderType]block.EmptyBlockCreator{
+		core.ShardHeaderV1:        block.NewEmptyHeaderCreator(),
+		core.ShardHeaderV2:        block.NewEmptyHeaderV2Creator(),
+		core.MetaHeader:           block.NewEmptyMetaBlockCreator(),
+		core.SovereignChainHeader: block.NewEmptySovereignHeaderCreator(),
+	}
+}
+
+// sealPreviousSegments closes the segments left open by a previous run. Since the manifest is updated after
+// every record, except the round timelines, anything written past the recorded size is a partial record or a round
+// timeline and gets truncated
+func (fd *fileDriver) sealPreviousSegments() error {
+	for _, segment := range fd.manifest.Segments {
+		if segment.Sealed {
+			continue
+		}
+
+		err := os.Truncate(filepath.Join(fd.path, segment.FileName), int64(segment.SizeInBytes))
+		if err != nil && !os.IsNotExist(err) {
+			return err
+		}
+
+		segment.Sealed = true
+		log.Debug("fileDriver: sealed segment left open by a previous run", "segment", segment.FileName)
+	}
+
+	return writeManifest(fd.path, fd.manifest)
+}
+
+// SaveBlock will append the outport block to the current segment
+func (fd *fileDriver) SaveBlock(outportBlock *outport.OutportBlock) error {
+	epoch, hasEpoch := fd.getBlockEpoch(outportBlock)
+	return fd.handleAction(outportBlock, outport.TopicSaveBlock, epoch, hasEpoch)
+}
+
+// RevertIndexedBlock will append the reverted block data to the current segment
+func (fd *fileDriver) RevertIndexedBlock(blockData *outport.BlockData) error {
+	return fd.handleAction(blockData, outport.TopicRevertIndexedBlock, 0, false)
+}
+
+// SaveRoundsInfo will append the rounds info to the current segment
+func (fd *fileDriver) SaveRoundsInfo(roundsInfos *outport.RoundsInfo) error {
+	return fd.handleAction(roundsInfos, outport.TopicSaveRoundsInfo, 0, false)
+}
+
+// SaveValidatorsPubKeys will append the validators' public keys to the current segment
+func (fd *fileDriver) SaveValidatorsPubKeys(validatorsPubKeys *outport.ValidatorsPubKeys) error {
+	return fd.handleAction(validatorsPubKeys, outport.TopicSaveValidatorsPubKeys, 0, false)
+}
+
+// SaveValidatorsRating will append the validators' rating to the current segment
+func (fd *fileDriver) SaveValidatorsRating(validatorsRating *outport.ValidatorsRating) error {
+	return fd.handleAction(validatorsRating, outport.TopicSaveValidatorsRating, 0, false)
+}
+
+// SaveAccounts will append the accounts to the current segment
+func (fd *fileDriver) SaveAccounts(accounts *outport.Accounts) error {
+	return fd.handleAction(accounts, outport.TopicSaveAccounts, 0, false)
+}
+
+// FinalizedBlock will append the finalized block to the current segment
+func (fd *fileDriver) FinalizedBlock(finalizedBlock *outport.FinalizedBlock) error {
+	return fd.handleAction(finalizedBlock, outport.TopicFinalizedBlock, 0, false)
+}
+
+// SaveConsensusRoundTimeline will append the consensus round timeline to the current segment. The timeline is not a
+// protobuf structure, so it is always JSON encoded, regardless of the configured marshaller. Since a timeline is
+// written each round, the manifest is not rewritten for it but along with the next record or when the segment is
+// sealed, so the timelines written after the last manifest update are dropped if the node crashes
+func (fd *fileDriver) SaveConsensusRoundTimeline(timeline *common.ConsensusRoundTimeline) error {
+	marshalledPayload, err := json.Marshal(timeline)
+	if err != nil {
+		return fmt.Errorf("%w while marshaling payload for topic %s", err, common.TopicSaveConsensusRoundTimeline)
+	}
+
+	return fd.writeRecord(marshalledPayload, common.TopicSaveConsensusRoundTimeline, 0, false, false)
+}
+
+// GetMarshaller returns the internal marshaller
+func (fd *fileDriver) GetMarshaller() marshal.Marshalizer {
+	return fd.marshaller
+}
+
+// SetCurrentSettings will record the shard ID in the manifest
+func (fd *fileDriver) SetCurrentSettings(config outport.OutportConfig) error {
+	fd.mut.Lock()
+	defer fd.mut.Unlock()
+
+	if fd.isClosed {
+		return ErrDriverIsClosed
+	}
+
+	fd.manifest.ShardID = config.ShardID
+
+	return writeManifest(fd.path, fd.manifest)
+}
+
+// RegisterHandler will do nothing as there is no consumer to acknowledge the written records
+func (fd *fileDriver) RegisterHandler(_ func() error, _ string) error {
+	return nil
+}
+
+func (fd *fileDriver) getBlockEpoch(outportBlock *outport.OutportBlock) (uint32, bool) {
+	if outportBlock == nil || outportBlock.BlockData == nil {
+		return 0, false
+	}
+
+	creator, found := fd.blockCreators[core.HeaderType(outportBlock.BlockData.HeaderType)]
+	if !found {
+		log.Warn("fileDriver.getBlockEpoch: unknown header type", "type", outportBlock.BlockData.HeaderType)
+		return 0, false
+	}
+
+	header, err := block.GetHeaderFromBytes(fd.marshaller, creator, outportBlock.BlockData.HeaderBytes)
+	if err != nil {
+		log.Warn("fileDriver.getBlockEpoch: cannot decode header", "error", err)
+		return 0, false
+	}
+
+	return header.GetEpoch(), true
+}
+
+func (fd *fileDriver) handleAction(args interface{}, topic string, epoch uint32, hasEpoch bool) error {
+	marshalledPayload, err := fd.marshaller.Marshal(args)
+	if err != nil {
+		return fmt.Errorf("%w while marshaling payload for topic %s", err, topic)
+	}
+
+	return fd.writeRecord(marshalledPayload, topic, epoch, hasEpoch, true)
+}
+
+func (fd *fileDriver) writeRecord(marshalledPayload []byte, topic string, epoch uint32, hasEpoch bool, updateManifest bool) error {
+	record, err := EncodeRecord(topic, marshalledPayload)
+	if err != nil {
+		return fmt.Errorf("%w while encoding record for topic %s", err, topic)
+	}
+
+	fd.mut.Lock()
+	defer fd.mut.Unlock()
+
+	if fd.isClosed {
+		return ErrDriverIsClosed
+	}
+
+	err = fd.rotateIfNeeded(uint64(len(record)), epoch, hasEpoch)
+	if err != nil {
+		return fmt.Errorf("%w while rotating segment for topic %s", err, topic)
+	}
+
+	_, err = fd.currentFile.Write(record)
+	if err != nil {
+		return fmt.Errorf("%w while writing record for topic %s", err, topic)
+	}
+
+	fd.updateCurrentSegment(uint64(len(record)), epoch, hasEpoch)
+	if !updateManifest {
+		return nil
+	}
+
+	return writeManifest(fd.path, fd.manifest)
+}
+
+func (fd *fileDriver) rotateIfNeeded(recordSize uint64, epoch uint32, hasEpoch bool) error {
+	if fd.currentSegment == nil {
+		return fd.openNewSegment()
+	}
+	if fd.currentSegment.NumRecords == 0 {
+		return nil
+	}
+
+	isNewEpoch := fd.rotateOnEpochChange && hasEpoch && fd.currentSegment.HasEpoch && epoch != fd.currentSegment.LastEpoch
+	isSegmentFull := fd.currentSegment.SizeInBytes+recordSize > fd.maxSegmentSize
+	if !isNewEpoch && !isSegmentFull {
+		return nil
+	}
+
+	err := fd.sealCurrentSegment()
+	if err != nil {
+		return err
+	}
+
+	return fd.openNewSegment()
+}
+
+func (fd *fileDriver) openNewSegment() error {
+	segment := &SegmentInfo{
+		FileName: segmentFileName(len(fd.manifest.Segments)),
+	}
+
+	file, err := os.OpenFile(filepath.Join(fd.path, segment.FileName), os.O_CREATE|os.O_TRUNC|os.O_WRONLY, 0644)
+	if err != nil {
+		return err
+	}
+
+	fd.currentFile = file
+	fd.currentSegment = segment
+	fd.manifest.Segments = append(fd.manifest.Segments, segment)
+
+	log.Debug("fileDriver: opened new segment", "segment", segment.FileName)
+
+	return nil
+}
+
+func (fd *fileDriver) sealCurrentSegment() error {
+	if fd.currentSegment == nil {
+		return nil
+	}
+
+	err := fd.currentFile.Sync()
+	if err != nil {
+		return err
+	}
+	err = fd.currentFile.Close()
+	if err != nil {
+		return err
+	}
+
+	fd.currentSegment.Sealed = true
+	log.Debug("fileDriver: sealed segment", "segment", fd.currentSegment.FileName,
+		"num records", fd.currentSegment.NumRecords, "size", fd.currentSegment.SizeInBytes)
+
+	fd.currentSegment = nil
+	fd.currentFile = nil
+
+	return writeManifest(fd.path, fd.manifest)
+}
+
+func (fd *fileDriver) updateCurrentSegment(recordSize uint64, epoch uint32, hasEpoch bool) {
+	fd.currentSegment.NumRecords++
+	fd.currentSegment.SizeInBytes += recordSize
+
+	if !hasEpoch {
+		return
+	}
+	if !fd.currentSegment.HasEpoch {
+		fd.currentSegment.HasEpoch = true
+		fd.currentSegment.FirstEpoch = epoch
+	}
+	fd.currentSegment.LastEpoch = epoch
+}
+
+// Close will seal the current segment and close the driver
+func (fd *fileDriver) Close() error {
+	fd.mut.Lock()
+	defer fd.mut.Unlock()
+
+	if fd.isClosed {
+		return nil
+	}
+	fd.isClosed = true
+
+	return fd.sealCurrentSegment()
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (fd *fileDriver) IsInterfaceNil() bool {
+	return fd == nil
+}
@@ -0,0 +1,287 @@
+package file
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/data/block"
+	"github.com/multiversx/mx-chain-core-go/data/outport"
+	"github.com/multiversx/mx-chain-core-go/marshal"
+	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/testscommon/marshallerMock"
+	"github.com/stretchr/testify/require"
+)
+
+func createMockArgs(t *testing.T) ArgsFileDriver {
+	return ArgsFileDriver{
+		Marshaller:            &marshal.GogoProtoMarshalizer{},
+		Path:                  t.TempDir(),
+		MaxSegmentSizeInBytes: core.MegabyteSize,
+		RotateOnEpochChange:   true,
+	}
+}
+
+func createOutportBlock(t *testing.T, marshaller marshal.Marshalizer, epoch uint32) *outport.OutportBlock {
+	headerBytes, err := marshaller.Marshal(&block.Header{Epoch: epoch})
+	require.Nil(t, err)
+
+	return &outport.OutportBlock{
+		BlockData: &outport.BlockData{
+			HeaderBytes: headerBytes,
+			HeaderType:  string(core.ShardHeaderV1),
+		},
+	}
+}
+
+func readSegment(t *testing.T, dirPath string, segment *SegmentInfo) []*Record {
+	file, err := os.Open(filepath.Join(dirPath, segment.FileName))
+	require.Nil(t, err)
+	defer func() {
+		_ = file.Close()
+	}()
+
+	records := make([]*Record, 0)
+	reader := NewRecordReader(file)
+	for {
+		record, errRead := reader.ReadRecord()
+		if errRead != nil {
+			require.Equal(t, "EOF", errRead.Error())
+			return records
+		}
+		records = append(records, record)
+	}
+}
+
+func TestNewFileDriver(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil marshaller should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgs(t)
+		args.Marshaller = nil
+
+		fd, err := NewFileDriver(args)
+		require.Nil(t, fd)
+		require.Equal(t, core.ErrNilMarshalizer, err)
+	})
+	t.Run("empty path should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgs(t)
+		args.Path = ""
+
+		fd, err := NewFileDriver(args)
+		require.Nil(t, fd)
+		require.Equal(t, ErrEmptyPath, err)
+	})
+	t.Run("invalid max segment size should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgs(t)
+		args.MaxSegmentSizeInBytes = 0
+
+		fd, err := NewFileDriver(args)
+		require.Nil(t, fd)
+		require.Equal(t, ErrInvalidMaxSegmentSize, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		fd, err := NewFileDriver(createMockArgs(t))
+		require.Nil(t, err)
+		require.False(t, fd.IsInterfaceNil())
+		require.Nil(t, fd.Close())
+	})
+}
+
+func TestFileDriver_SaveMethodsShouldAppendRecords(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgs(t)
+	fd, _ := NewFileDriver(args)
+
+	outportBlock := createOutportBlock(t, args.Marshaller, 1)
+	require.Nil(t, fd.SetCurrentSettings(outport.OutportConfig{ShardID: 2}))
+	require.Nil(t, fd.SaveBlock(outportBlock))
+	require.Nil(t, fd.RevertIndexedBlock(&outport.BlockData{HeaderHash: []byte("hash")}))
+	require.Nil(t, fd.SaveRoundsInfo(&outport.RoundsInfo{}))
+	require.Nil(t, fd.SaveValidatorsPubKeys(&outport.ValidatorsPubKeys{Epoch: 1}))
+	require.Nil(t, fd.SaveValidatorsRating(&outport.ValidatorsRating{Epoch: 1}))
+	require.Nil(t, fd.SaveAccounts(&outport.Accounts{ShardID: 2}))
+	require.Nil(t, fd.FinalizedBlock(&outport.FinalizedBlock{HeaderHash: []byte("hash")}))
+	require.Nil(t, fd.Close())
+
+	manifest, err := ReadManifest(args.Path)
+	require.Nil(t, err)
+	require.Equal(t, uint32(2), manifest.ShardID)
+	require.Len(t, manifest.Segments, 1)
+
+	segment := manifest.Segments[0]
+	require.True(t, segment.Sealed)
+	require.True(t, segment.HasEpoch)
+	require.Equal(t, uint32(1), segment.FirstEpoch)
+	require.Equal(t, uint64(7), segment.NumRecords)
+
+	records := readSegment(t, args.Path, segment)
+	expectedTopics := []string{
+		outport.TopicSaveBlock,
+		outport.TopicRevertIndexedBlock,
+		outport.TopicSaveRoundsInfo,
+		outport.TopicSaveValidatorsPubKeys,
+		outport.TopicSaveValidatorsRating,
+		outport.TopicSaveAccounts,
+		outport.TopicFinalizedBlock,
+	}
+	require.Len(t, records, len(expectedTopics))
+	for idx, topic := range expectedTopics {
+		require.Equal(t, topic, records[idx].Topic)
+	}
+
+	recoveredBlock := &outport.OutportBlock{}
+	require.Nil(t, args.Marshaller.Unmarshal(recoveredBlock, records[0].Payload))
+	require.Equal(t, outportBlock, recoveredBlock)
+}
+
+func TestFileDriver_ShouldRotateBySize(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgs(t)
+	payload, _ := args.Marshaller.Marshal(&outport.FinalizedBlock{HeaderHash: []byte("hash")})
+	record, _ := EncodeRecord(outport.TopicFinalizedBlock, payload)
+	args.MaxSegmentSizeInBytes = uint64(2 * len(record))
+	fd, _ := NewFileDriver(args)
+
+	for i := 0; i < 5; i++ {
+		require.Nil(t, fd.FinalizedBlock(&outport.FinalizedBlock{HeaderHash: []byte("hash")}))
+	}
+	require.Nil(t, fd.Close())
+
+	manifest, _ := ReadManifest(args.Path)
+	require.Len(t, manifest.Segments, 3)
+	require.Equal(t, uint64(2), manifest.Segments[0].NumRecords)
+	require.Equal(t, uint64(2), manifest.Segments[1].NumRecords)
+	require.Equal(t, uint64(1), manifest.Segments[2].NumRecords)
+	for _, segment := range manifest.Segments {
+		require.True(t, segment.Sealed)
+		require.Len(t, readSegment(t, args.Path, segment), int(segment.NumRecords))
+	}
+}
+
+func TestFileDriver_ShouldRotateByEpoch(t *testing.T) {
+	t.Parallel()
+
+	t.Run("rotation enabled", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgs(t)
+		fd, _ := NewFileDriver(args)
+
+		require.Nil(t, fd.SaveBlock(createOutportBlock(t, args.Marshaller, 1)))
+		require.Nil(t, fd.SaveBlock(createOutportBlock(t, args.Marshaller, 1)))
+		require.Nil(t, fd.SaveBlock(createOutportBlock(t, args.Marshaller, 2)))
+		require.Nil(t, fd.Close())
+
+		manifest, _ := ReadManifest(args.Path)
+		require.Len(t, manifest.Segments, 2)
+		require.Equal(t, uint32(1), manifest.Segments[0].LastEpoch)
+		require.Equal(t, uint64(2), manifest.Segments[0].NumRecords)
+		require.Equal(t, uint32(2), manifest.Segments[1].FirstEpoch)
+		require.Equal(t, uint64(1), manifest.Segments[1].NumRecords)
+	})
+	t.Run("rotation disabled", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgs(t)
+		args.RotateOnEpochChange = false
+		fd, _ := NewFileDriver(args)
+
+		require.Nil(t, fd.SaveBlock(createOutportBlock(t, args.Marshaller, 1)))
+		require.Nil(t, fd.SaveBlock(createOutportBlock(t, args.Marshaller, 2)))
+		require.Nil(t, fd.Close())
+
+		manifest, _ := ReadManifest(args.Path)
+		require.Len(t, manifest.Segments, 1)
+		require.Equal(t, uint32(1), manifest.Segments[0].FirstEpoch)
+		require.Equal(t, uint32(2), manifest.Segments[0].LastEpoch)
+	})
+}
+
+func TestFileDriver_ReopenShouldSealAndTruncatePreviousSegment(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgs(t)
+	fd, _ := NewFileDriver(args)
+	require.Nil(t, fd.FinalizedBlock(&outport.FinalizedBlock{HeaderHash: []byte("hash")}))
+
+	// simulate a crash in the middle of writing a record
+	segmentPath := filepath.Join(args.Path, segmentFileName(0))
+	file, err := os.OpenFile(segmentPath, os.O_APPEND|os.O_WRONLY, 0644)
+	require.Nil(t, err)
+	_, _ = file.Write([]byte{0, 0, 1})
+	_ = file.Close()
+
+	fd, err = NewFileDriver(args)
+	require.Nil(t, err)
+	require.Nil(t, fd.FinalizedBlock(&outport.FinalizedBlock{HeaderHash: []byte("hash")}))
+	require.Nil(t, fd.Close())
+
+	manifest, _ := ReadManifest(args.Path)
+	require.Len(t, manifest.Segments, 2)
+	require.Equal(t, segmentFileName(1), manifest.Segments[1].FileName)
+	for _, segment := range manifest.Segments {
+		require.True(t, segment.Sealed)
+		require.Len(t, readSegment(t, args.Path, segment), 1)
+	}
+}
+
+func TestFileDriver_SaveConsensusRoundTimelineShouldNotRewriteTheManifest(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgs(t)
+	fd, _ := NewFileDriver(args)
+	require.Nil(t, fd.FinalizedBlock(&outport.FinalizedBlock{HeaderHash: []byte("hash")}))
+	require.Nil(t, fd.SaveConsensusRoundTimeline(&common.ConsensusRoundTimeline{Round: 1}))
+	require.Nil(t, fd.SaveConsensusRoundTimeline(&common.ConsensusRoundTimeline{Round: 2}))
+
+	manifest, _ := ReadManifest(args.Path)
+	require.Equal(t, uint64(1), manifest.Segments[0].NumRecords)
+
+	require.Nil(t, fd.Close())
+	manifest, _ = ReadManifest(args.Path)
+	require.Equal(t, uint64(3), manifest.Segments[0].NumRecords)
+	require.Len(t, readSegment(t, args.Path, manifest.Segments[0]), 3)
+}
+
+func TestFileDriver_ErrorsShouldPropagate(t *testing.T) {
+	t.Parallel()
+
+	t.Run("marshal error", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		args := createMockArgs(t)
+		args.Marshaller = &marshallerMock.MarshalizerStub{
+			MarshalCalled: func(_ interface{}) ([]byte, error) {
+				return nil, expectedErr
+			},
+		}
+		fd, _ := NewFileDriver(args)
+
+		err := fd.SaveAccounts(&outport.Accounts{})
+		require.ErrorIs(t, err, expectedErr)
+	})
+	t.Run("closed driver", func(t *testing.T) {
+		t.Parallel()
+
+		fd, _ := NewFileDriver(createMockArgs(t))
+		require.Nil(t, fd.Close())
+		require.Nil(t, fd.Close())
+
+		require.Equal(t, ErrDriverIsClosed, fd.SaveAccounts(&outport.Accounts{}))
+		require.Equal(t, ErrDriverIsClosed, fd.SetCurrentSettings(outport.OutportConfig{}))
+	})
+}
@@ -0,0 +1,24 @@
+package file
+
+import "errors"
+
+// ErrDriverIsClosed signals that the file driver was closed while trying to perform actions
+var ErrDriverIsClosed = errors.New("file driver is closed")
+
+// ErrEmptyPath signals that an empty path was provided
+var ErrEmptyPath = errors.New("empty path provided")
+
+// ErrInvalidMaxSegmentSize signals that an invalid maximum segment size was provided
+var ErrInvalidMaxSegmentSize = errors.New("invalid maximum segment size")
+
+// ErrInvalidChecksum signals that a record's checksum does not match its content
+var ErrInvalidChecksum = errors.New("invalid record checksum")
+
+// ErrTruncatedRecord signals that a record was only partially written
+var ErrTruncatedRecord = errors.New("truncated record")
+
+// ErrRecordTooLarge signals that a record exceeds the maximum encodable size
+var ErrRecordTooLarge = errors.New("record too large")
+
+// ErrInvalidRecord signals that a record has a valid checksum but a malformed body
+var ErrInvalidRecord = errors.New("invalid record")
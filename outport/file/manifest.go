@@ -0,0 +1,75 @@
+package file
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+)
+
+const (
+	manifestFileName     = "manifest.json"
+	segmentFileExtension = ".seg"
+)
+
+// Manifest lists the segment files written by the file driver, in writing order
+type Manifest struct {
+	ShardID  uint32         `json:"shardID"`
+	Segments []*SegmentInfo `json:"segments"`
+}
+
+// SegmentInfo holds the details of a segment file
+type SegmentInfo struct {
+	FileName    string `json:"fileName"`
+	FirstEpoch  uint32 `json:"firstEpoch"`
+	LastEpoch   uint32 `json:"lastEpoch"`
+	HasEpoch    bool   `json:"hasEpoch"`
+	NumRecords  uint64 `json:"numRecords"`
+	SizeInBytes uint64 `json:"sizeInBytes"`
+	Sealed      bool   `json:"sealed"`
+}
+
+// ReadManifest loads the manifest stored in the provided directory. A missing manifest yields an empty one
+func ReadManifest(dirPath string) (*Manifest, error) {
+	buff, err := os.ReadFile(filepath.Join(dirPath, manifestFileName))
+	if os.IsNotExist(err) {
+		return &Manifest{
+			Segments: make([]*SegmentInfo, 0),
+		}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	manifest := &Manifest{}
+	err = json.Unmarshal(buff, manifest)
+	if err != nil {
+		return nil, fmt.Errorf("%w while decoding %s", err, manifestFileName)
+	}
+	if manifest.Segments == nil {
+		manifest.Segments = make([]*SegmentInfo, 0)
+	}
+
+	return manifest, nil
+}
+
+// writeManifest stores the manifest through a temporary file so readers never observe a partially written one
+func writeManifest(dirPath string, manifest *Manifest) error {
+	buff, err := json.MarshalIndent(manifest, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	manifestPath := filepath.Join(dirPath, manifestFileName)
+	tempPath := manifestPath + ".tmp"
+	err = os.WriteFile(tempPath, buff, 0644)
+	if err != nil {
+		return err
+	}
+
+	return os.Rename(tempPath, manifestPath)
+}
+
+func segmentFileName(index int) string {
+	return fmt.Sprintf("segment-%08d%s", index, segmentFileExtension)
+}
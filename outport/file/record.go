@@ -0,0 +1,104 @@
+package file
+
+import (
+	"encoding/binary"
+	"hash/crc32"
+	"io"
+	"math"
+)
+
+// A record is stored as:
+//
+//	| body length (uint32, BE) | crc32 IEEE of body (uint32, BE) | body |
+//
+// where body is:
+//
+//	| topic length (uint16, BE) | topic | protobuf payload |
+const (
+	recordHeaderSize  = 8
+	topicLengthSize   = 2
+	maxRecordBodySize = math.MaxUint32
+)
+
+// Record holds a decoded outport record
+type Record struct {
+	Topic   string
+	Payload []byte
+}
+
+// EncodeRecord returns the on-disk representation of a record, as written by the file driver
+func EncodeRecord(topic string, payload []byte) ([]byte, error) {
+	if len(topic) > math.MaxUint16 {
+		return nil, ErrRecordTooLarge
+	}
+	bodySize := uint64(topicLengthSize + len(topic) + len(payload))
+	if bodySize > maxRecordBodySize {
+		return nil, ErrRecordTooLarge
+	}
+
+	buff := make([]byte, recordHeaderSize+bodySize)
+	body := buff[recordHeaderSize:]
+	binary.BigEndian.PutUint16(body, uint16(len(topic)))
+	copy(body[topicLengthSize:], topic)
+	copy(body[topicLengthSize+len(topic):], payload)
+
+	binary.BigEndian.PutUint32(buff, uint32(bodySize))
+	binary.BigEndian.PutUint32(buff[4:], crc32.ChecksumIEEE(body))
+
+	return buff, nil
+}
+
+type recordReader struct {
+	reader io.Reader
+}
+
+// NewRecordReader creates a reader able to decode the records written by the file driver
+func NewRecordReader(reader io.Reader) *recordReader {
+	return &recordReader{
+		reader: reader,
+	}
+}
+
+// ReadRecord returns the next record. It returns io.EOF when there are no more records, ErrTruncatedRecord
+// if the segment ends in a partially written record and ErrInvalidChecksum if the record is corrupted
+func (rr *recordReader) ReadRecord() (*Record, error) {
+	header := make([]byte, recordHeaderSize)
+	_, err := io.ReadFull(rr.reader, header)
+	if err == io.EOF {
+		return nil, io.EOF
+	}
+	if err == io.ErrUnexpectedEOF {
+		return nil, ErrTruncatedRecord
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	bodySize := binary.BigEndian.Uint32(header)
+	checksum := binary.BigEndian.Uint32(header[4:])
+	if bodySize < topicLengthSize {
+		return nil, ErrInvalidRecord
+	}
+
+	body := make([]byte, bodySize)
+	_, err = io.ReadFull(rr.reader, body)
+	if err == io.EOF || err == io.ErrUnexpectedEOF {
+		return nil, ErrTruncatedRecord
+	}
+	if err != nil {
+		return nil, err
+	}
+	if crc32.ChecksumIEEE(body) != checksum {
+		return nil, ErrInvalidChecksum
+	}
+
+	topicLength := int(binary.BigEndian.Uint16(body))
+	if topicLengthSize+topicLength > len(body) {
+		return nil, ErrInvalidRecord
+	}
+
+	return &Record{
+		Topic:   string(body[topicLengthSize : topicLengthSize+topicLength]),
+		Payload: body[topicLengthSize+topicLength:],
+	}, nil
+}
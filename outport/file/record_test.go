@@ -0,0 +1,69 @@
+package file
+
+import (
+	"bytes"
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestRecordReader_ReadRecord(t *testing.T) {
+	t.Parallel()
+
+	t.Run("should decode consecutive records", func(t *testing.T) {
+		t.Parallel()
+
+		first, err := EncodeRecord("topic1", []byte("payload1"))
+		require.Nil(t, err)
+		second, err := EncodeRecord("topic2", nil)
+		require.Nil(t, err)
+
+		reader := NewRecordReader(bytes.NewReader(append(first, second...)))
+
+		record, err := reader.ReadRecord()
+		require.Nil(t, err)
+		require.Equal(t, "topic1", record.Topic)
+		require.Equal(t, []byte("payload1"), record.Payload)
+
+		record, err = reader.ReadRecord()
+		require.Nil(t, err)
+		require.Equal(t, "topic2", record.Topic)
+		require.Empty(t, record.Payload)
+
+		record, err = reader.ReadRecord()
+		require.Nil(t, record)
+		require.Equal(t, io.EOF, err)
+	})
+	t.Run("truncated header should error", func(t *testing.T) {
+		t.Parallel()
+
+		buff, _ := EncodeRecord("topic", []byte("payload"))
+		reader := NewRecordReader(bytes.NewReader(buff[:recordHeaderSize-1]))
+
+		record, err := reader.ReadRecord()
+		require.Nil(t, record)
+		require.Equal(t, ErrTruncatedRecord, err)
+	})
+	t.Run("truncated body should error", func(t *testing.T) {
+		t.Parallel()
+
+		buff, _ := EncodeRecord("topic", []byte("payload"))
+		reader := NewRecordReader(bytes.NewReader(buff[:len(buff)-1]))
+
+		record, err := reader.ReadRecord()
+		require.Nil(t, record)
+		require.Equal(t, ErrTruncatedRecord, err)
+	})
+	t.Run("corrupted body should error", func(t *testing.T) {
+		t.Parallel()
+
+		buff, _ := EncodeRecord("topic", []byte("payload"))
+		buff[len(buff)-1]++
+		reader := NewRecordReader(bytes.NewReader(buff))
+
+		record, err := reader.ReadRecord()
+		require.Nil(t, record)
+		require.Equal(t, ErrInvalidChecksum, err)
+	})
+}
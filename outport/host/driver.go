@@ -1,6 +1,7 @@
 package host
 
 import (
+	"encoding/json"
 	"fmt"
 
 	"github.com/multiversx/mx-chain-core-go/core"
@@ -8,6 +9,7 @@ import (
 	"github.com/multiversx/mx-chain-core-go/core/check"
 	"github.com/multiversx/mx-chain-core-go/data/outport"
 	"github.com/multiversx/mx-chain-core-go/marshal"
+	"github.com/multiversx/mx-chain-go/common"
 )
 
 // ArgsHostDriver holds the arguments needed for creating a new hostDriver
@@ -91,22 +93,37 @@ func (o *hostDriver) FinalizedBlock(finalizedBlock *outport.FinalizedBlock) erro
 	return o.handleAction(finalizedBlock, outport.TopicFinalizedBlock)
 }
 
+// SaveConsensusRoundTimeline will handle the consensus round timeline. The timeline is not a protobuf structure, so
+// it is always JSON encoded, regardless of the configured marshaller
+func (o *hostDriver) SaveConsensusRoundTimeline(timeline *common.ConsensusRoundTimeline) error {
+	marshalledPayload, err := json.Marshal(timeline)
+	if err != nil {
+		return fmt.Errorf("%w while marshaling payload for topic %s", err, common.TopicSaveConsensusRoundTimeline)
+	}
+
+	return o.sendPayload(marshalledPayload, common.TopicSaveConsensusRoundTimeline)
+}
+
 // GetMarshaller returns the internal marshaller
 func (o *hostDriver) GetMarshaller() marshal.Marshalizer {
 	return o.marshaller
 }
 
 func (o *hostDriver) handleAction(args interface{}, topic string) error {
-	if o.isClosed.IsSet() {
-		return ErrHostIsClosed
-	}
-
 	marshalledPayload, err := o.marshaller.Marshal(args)
 	if err != nil {
 		return fmt.Errorf("%w while marshaling block for topic %s", err, topic)
 	}
 
-	err = o.senderHost.Send(marshalledPayload, topic)
+	return o.sendPayload(marshalledPayload, topic)
+}
+
+func (o *hostDriver) sendPayload(marshalledPayload []byte, topic string) error {
+	if o.isClosed.IsSet() {
+		return ErrHostIsClosed
+	}
+
+	err := o.senderHost.Send(marshalledPayload, topic)
 	if err != nil {
 		return fmt.Errorf("%w while sending data on route for topic %s", err, topic)
 	}
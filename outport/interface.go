@@ -3,6 +3,7 @@ package outport
 import (
 	outportcore "github.com/multiversx/mx-chain-core-go/data/outport"
 	"github.com/multiversx/mx-chain-core-go/marshal"
+	"github.com/multiversx/mx-chain-go/common"
 	"github.com/multiversx/mx-chain-go/outport/process"
 )
 
@@ -16,6 +17,7 @@ type Driver interface {
 	SaveValidatorsRating(validatorsRating *outportcore.ValidatorsRating) error
 	SaveAccounts(accounts *outportcore.Accounts) error
 	FinalizedBlock(finalizedBlock *outportcore.FinalizedBlock) error
+	SaveConsensusRoundTimeline(timeline *common.ConsensusRoundTimeline) error
 	GetMarshaller() marshal.Marshalizer
 	SetCurrentSettings(config outportcore.OutportConfig) error
 	RegisterHandler(handlerFunction func() error, topic string) error
@@ -33,6 +35,7 @@ type OutportHandler interface {
 	SaveValidatorsRating(validatorsRating *outportcore.ValidatorsRating)
 	SaveAccounts(accounts *outportcore.Accounts)
 	FinalizedBlock(finalizedBlock *outportcore.FinalizedBlock)
+	SaveConsensusRoundTimeline(timeline *common.ConsensusRoundTimeline)
 	SubscribeDriver(driver Driver) error
 	HasDrivers() bool
 	Close() error
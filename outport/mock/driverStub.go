@@ -3,21 +3,23 @@ package mock
 import (
 	outportcore "github.com/multiversx/mx-chain-core-go/data/outport"
 	"github.com/multiversx/mx-chain-core-go/marshal"
+	"github.com/multiversx/mx-chain-go/common"
 	"github.com/multiversx/mx-chain-go/testscommon/marshallerMock"
 )
 
 // DriverStub -
 type DriverStub struct {
-	SaveBlockCalled             func(outportBlock *outportcore.OutportBlock) error
-	RevertIndexedBlockCalled    func(blockData *outportcore.BlockData) error
-	SaveRoundsInfoCalled        func(roundsInfos *outportcore.RoundsInfo) error
-	SaveValidatorsPubKeysCalled func(validatorsPubKeys *outportcore.ValidatorsPubKeys) error
-	SaveValidatorsRatingCalled  func(validatorsRating *outportcore.ValidatorsRating) error
-	SaveAccountsCalled          func(accounts *outportcore.Accounts) error
-	FinalizedBlockCalled        func(finalizedBlock *outportcore.FinalizedBlock) error
-	CloseCalled                 func() error
-	RegisterHandlerCalled       func(handlerFunction func() error, topic string) error
-	SetCurrentSettingsCalled    func(config outportcore.OutportConfig) error
+	SaveBlockCalled                  func(outportBlock *outportcore.OutportBlock) error
+	RevertIndexedBlockCalled         func(blockData *outportcore.BlockData) error
+	SaveRoundsInfoCalled             func(roundsInfos *outportcore.RoundsInfo) error
+	SaveValidatorsPubKeysCalled      func(validatorsPubKeys *outportcore.ValidatorsPubKeys) error
+	SaveValidatorsRatingCalled       func(validatorsRating *outportcore.ValidatorsRating) error
+	SaveAccountsCalled               func(accounts *outportcore.Accounts) error
+	FinalizedBlockCalled             func(finalizedBlock *outportcore.FinalizedBlock) error
+	SaveConsensusRoundTimelineCalled func(timeline *common.ConsensusRoundTimeline) error
+	CloseCalled                      func() error
+	RegisterHandlerCalled            func(handlerFunction func() error, topic string) error
+	SetCurrentSettingsCalled         func(config outportcore.OutportConfig) error
 }
 
 // SaveBlock -
@@ -83,6 +85,15 @@ func (d *DriverStub) FinalizedBlock(finalizedBlock *outportcore.FinalizedBlock)
 	return nil
 }
 
+// SaveConsensusRoundTimeline -
+func (d *DriverStub) SaveConsensusRoundTimeline(timeline *common.ConsensusRoundTimeline) error {
+	if d.SaveConsensusRoundTimelineCalled != nil {
+		return d.SaveConsensusRoundTimelineCalled(timeline)
+	}
+
+	return nil
+}
+
 // GetMarshaller -
 func (d *DriverStub) GetMarshaller() marshal.Marshalizer {
 	return marshallerMock.MarshalizerMock{}
@@ -6,6 +6,7 @@ import (
 	"github.com/multiversx/mx-chain-core-go/core/check"
 	"github.com/multiversx/mx-chain-core-go/data/outport"
 	"github.com/multiversx/mx-chain-core-go/marshal"
+	"github.com/multiversx/mx-chain-go/common"
 	logger "github.com/multiversx/mx-chain-logger-go"
 )
 
@@ -115,6 +116,11 @@ func (en *eventNotifier) SaveAccounts(_ *outport.Accounts) error {
 	return nil
 }
 
+// SaveConsensusRoundTimeline does nothing
+func (en *eventNotifier) SaveConsensusRoundTimeline(_ *common.ConsensusRoundTimeline) error {
+	return nil
+}
+
 // GetMarshaller returns internal marshaller
 func (en *eventNotifier) GetMarshaller() marshal.Marshalizer {
 	return en.marshalizer
@@ -8,6 +8,7 @@ import (
 
 	"github.com/multiversx/mx-chain-core-go/core/check"
 	outportcore "github.com/multiversx/mx-chain-core-go/data/outport"
+	"github.com/multiversx/mx-chain-go/common"
 	logger "github.com/multiversx/mx-chain-logger-go"
 )
 
@@ -341,6 +342,30 @@ func (o *outport) finalizedBlockBlocking(finalizedBlock *outportcore.FinalizedBl
 	}
 }
 
+// SaveConsensusRoundTimeline will send the consensus round timeline to every driver. The timelines are diagnostic
+// data, so the call is made only once for each driver, without retrials
+func (o *outport) SaveConsensusRoundTimeline(timeline *common.ConsensusRoundTimeline) {
+	o.mutex.RLock()
+	defer o.mutex.RUnlock()
+
+	for _, driver := range o.drivers {
+		o.saveConsensusRoundTimeline(timeline, driver)
+	}
+}
+
+func (o *outport) saveConsensusRoundTimeline(timeline *common.ConsensusRoundTimeline, driver Driver) {
+	ch := o.monitorCompletionOnDriver("saveConsensusRoundTimeline", driver)
+	defer close(ch)
+
+	err := driver.SaveConsensusRoundTimeline(timeline)
+	if err != nil {
+		log.Debug("error calling SaveConsensusRoundTimeline",
+			"driver", driverString(driver),
+			"round", timeline.Round,
+			"error", err)
+	}
+}
+
 // Close will close all the drivers that are in outport
 func (o *outport) Close() error {
 	close(o.chanClose)
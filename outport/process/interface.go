@@ -5,6 +5,7 @@ import (
 
 	"github.com/multiversx/mx-chain-core-go/data"
 	"github.com/multiversx/mx-chain-core-go/data/alteredAccount"
+	"github.com/multiversx/mx-chain-core-go/data/block"
 	"github.com/multiversx/mx-chain-core-go/data/outport"
 	"github.com/multiversx/mx-chain-go/outport/process/alteredaccounts/shared"
 )
@@ -39,3 +40,12 @@ type EconomicsDataHandler interface {
 	IsInterfaceNil() bool
 	MaxGasLimitPerBlock(shardID uint32) uint64
 }
+
+// TransactionsDataProvider defines the functionality needed for fetching the transactions, logs and intra shard
+// miniblocks of the block being indexed
+type TransactionsDataProvider interface {
+	GetAllCurrentUsedTxs(blockType block.Type) map[string]data.TransactionHandler
+	GetAllCurrentLogs() []*data.LogData
+	GetCreatedInShardMiniBlocks() []*block.MiniBlock
+	IsInterfaceNil() bool
+}
@@ -8,6 +8,7 @@ import (
 	"github.com/multiversx/mx-chain-core-go/core"
 	"github.com/multiversx/mx-chain-core-go/core/check"
 	"github.com/multiversx/mx-chain-core-go/data"
+	"github.com/multiversx/mx-chain-core-go/data/api"
 	"github.com/multiversx/mx-chain-core-go/data/block"
 	outportcore "github.com/multiversx/mx-chain-core-go/data/outport"
 	"github.com/multiversx/mx-chain-core-go/data/receipt"
@@ -18,9 +19,9 @@ import (
 	"github.com/multiversx/mx-chain-core-go/marshal"
 	"github.com/multiversx/mx-chain-go/common"
 	"github.com/multiversx/mx-chain-go/outport/process/alteredaccounts/shared"
-	"github.com/multiversx/mx-chain-go/process"
 	"github.com/multiversx/mx-chain-go/sharding"
 	"github.com/multiversx/mx-chain-go/sharding/nodesCoordinator"
+	"github.com/multiversx/mx-chain-go/state"
 	logger "github.com/multiversx/mx-chain-logger-go"
 )
 
@@ -32,7 +33,7 @@ type ArgOutportDataProvider struct {
 	ShardCoordinator         sharding.Coordinator
 	AlteredAccountsProvider  AlteredAccountsProviderHandler
 	TransactionsFeeProcessor TransactionsFeeHandler
-	TxCoordinator            process.TransactionCoordinator
+	TxCoordinator            TransactionsDataProvider
 	NodesCoordinator         nodesCoordinator.NodesCoordinator
 	GasConsumedProvider      GasConsumedProvider
 	EconomicsData            EconomicsDataHandler
@@ -53,6 +54,9 @@ type ArgPrepareOutportSaveBlockData struct {
 	NotarizedHeadersHashes []string
 	HighestFinalBlockNonce uint64
 	HighestFinalBlockHash  []byte
+	// HistoricalAccountsRepository, when set, is used to read the altered accounts from the state at the header's root
+	// hash instead of the current state. This is needed when the block is rebuilt from storage
+	HistoricalAccountsRepository state.AccountsRepository
 }
 
 type outportDataProvider struct {
@@ -60,7 +64,7 @@ type outportDataProvider struct {
 	numOfShards              uint32
 	alteredAccountsProvider  AlteredAccountsProviderHandler
 	transactionsFeeProcessor TransactionsFeeHandler
-	txCoordinator            process.TransactionCoordinator
+	txCoordinator            TransactionsDataProvider
 	nodesCoordinator         nodesCoordinator.NodesCoordinator
 	gasConsumedProvider      GasConsumedProvider
 	economicsData            EconomicsDataHandler
@@ -117,9 +121,7 @@ func (odp *outportDataProvider) PrepareOutportSaveBlockData(arg ArgPrepareOutpor
 		log.Warn("PrepareOutportSaveBlockData - checkTxOrder", "error", err.Error())
 	}
 
-	alteredAccounts, err := odp.alteredAccountsProvider.ExtractAlteredAccountsFromPool(pool, shared.AlteredAccountsOptions{
-		WithAdditionalOutportData: true,
-	})
+	alteredAccounts, err := odp.alteredAccountsProvider.ExtractAlteredAccountsFromPool(pool, createAlteredAccountsOptions(arg))
 	if err != nil {
 		return nil, fmt.Errorf("alteredAccountsProvider.ExtractAlteredAccountsFromPool %s", err)
 	}
@@ -162,6 +164,24 @@ func (odp *outportDataProvider) PrepareOutportSaveBlockData(arg ArgPrepareOutpor
 	}, nil
 }
 
+func createAlteredAccountsOptions(arg ArgPrepareOutportSaveBlockData) shared.AlteredAccountsOptions {
+	if check.IfNil(arg.HistoricalAccountsRepository) {
+		return shared.AlteredAccountsOptions{
+			WithAdditionalOutportData: true,
+		}
+	}
+
+	return shared.AlteredAccountsOptions{
+		WithCustomAccountsRepository: true,
+		WithAdditionalOutportData:    true,
+		AccountsRepository:           arg.HistoricalAccountsRepository,
+		AccountQueryOptions: api.AccountQueryOptions{
+			BlockRootHash: arg.Header.GetRootHash(),
+			HintEpoch:     core.OptionalUint32{Value: arg.Header.GetEpoch(), HasValue: true},
+		},
+	}
+}
+
 func collectExecutedTxHashes(bodyHandler data.BodyHandler, headerHandler data.HeaderHandler) (map[string]struct{}, error) {
 	executedTxHashes := make(map[string]struct{})
 	mbHeaders := headerHandler.GetMiniBlockHeaderHandlers()
@@ -7,12 +7,15 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/data/alteredAccount"
 	"github.com/multiversx/mx-chain-core-go/data/block"
 	outportcore "github.com/multiversx/mx-chain-core-go/data/outport"
 	"github.com/multiversx/mx-chain-core-go/data/rewardTx"
 	"github.com/multiversx/mx-chain-core-go/data/smartContractResult"
 	"github.com/multiversx/mx-chain-core-go/data/transaction"
 	"github.com/multiversx/mx-chain-go/outport/mock"
+	"github.com/multiversx/mx-chain-go/outport/process/alteredaccounts/shared"
 	"github.com/multiversx/mx-chain-go/outport/process/transactionsfee"
 	"github.com/multiversx/mx-chain-go/testscommon"
 	commonMocks "github.com/multiversx/mx-chain-go/testscommon/common"
@@ -20,6 +23,7 @@ import (
 	"github.com/multiversx/mx-chain-go/testscommon/hashingMocks"
 	"github.com/multiversx/mx-chain-go/testscommon/marshallerMock"
 	"github.com/multiversx/mx-chain-go/testscommon/shardingMocks"
+	stateMock "github.com/multiversx/mx-chain-go/testscommon/state"
 	"github.com/stretchr/testify/require"
 )
 
@@ -105,6 +109,50 @@ func TestPrepareOutportSaveBlockData(t *testing.T) {
 	require.NotNil(t, res.TransactionPool)
 }
 
+func TestPrepareOutportSaveBlockData_WithHistoricalAccountsRepository(t *testing.T) {
+	t.Parallel()
+
+	accountsRepository := &stateMock.AccountsRepositoryStub{}
+	providedRootHash := []byte("root hash")
+	providedEpoch := uint32(7)
+
+	wasCalled := false
+	arg := createArgOutportDataProvider()
+	arg.NodesCoordinator = &shardingMocks.NodesCoordinatorMock{
+		GetValidatorsPublicKeysCalled: func(randomness []byte, round uint64, shardId uint32, epoch uint32) ([]string, error) {
+			return nil, nil
+		},
+		GetValidatorsIndexesCalled: func(publicKeys []string, epoch uint32) ([]uint64, error) {
+			return []uint64{0, 1}, nil
+		},
+	}
+	arg.AlteredAccountsProvider = &testscommon.AlteredAccountsProviderStub{
+		ExtractAlteredAccountsFromPoolCalled: func(txPool *outportcore.TransactionPool, options shared.AlteredAccountsOptions) (map[string]*alteredAccount.AlteredAccount, error) {
+			wasCalled = true
+			require.True(t, options.WithCustomAccountsRepository)
+			require.True(t, options.WithAdditionalOutportData)
+			require.True(t, options.AccountsRepository == accountsRepository)
+			require.Equal(t, providedRootHash, options.AccountQueryOptions.BlockRootHash)
+			require.Equal(t, core.OptionalUint32{Value: providedEpoch, HasValue: true}, options.AccountQueryOptions.HintEpoch)
+
+			return nil, nil
+		},
+	}
+	outportDataP, _ := NewOutportDataProvider(arg)
+
+	_, err := outportDataP.PrepareOutportSaveBlockData(ArgPrepareOutportSaveBlockData{
+		Header: &block.Header{
+			RootHash: providedRootHash,
+			Epoch:    providedEpoch,
+		},
+		Body:                         &block.Body{},
+		HeaderHash:                   []byte("something"),
+		HistoricalAccountsRepository: accountsRepository,
+	})
+	require.Nil(t, err)
+	require.True(t, wasCalled)
+}
+
 func TestOutportDataProvider_GetIntraShardMiniBlocks(t *testing.T) {
 	t.Parallel()
 
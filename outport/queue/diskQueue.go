@@ -0,0 +1,564 @@
+package queue
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/multiversx/mx-chain-go/outport/file"
+)
+
+const (
+	stateFileName      = "state.json"
+	deadLetterFileName = "dead-letter.dat"
+	segmentFilePrefix  = "queue-"
+	segmentFileSuffix  = ".dat"
+)
+
+// queueState holds the delivery progress of a queue and is persisted after every acknowledgement
+type queueState struct {
+	ReadSegment    uint64 `json:"readSegment"`
+	ReadOffset     uint64 `json:"readOffset"`
+	LastAckedNonce uint64 `json:"lastAckedNonce"`
+	HasAckedNonce  bool   `json:"hasAckedNonce"`
+}
+
+// diskQueue is an append-only queue of records split in segment files. Records are appended to the last segment
+// and consumed from the segment pointed by the persisted state. Fully consumed segments are removed
+type diskQueue struct {
+	mut            sync.Mutex
+	cond           *sync.Cond
+	path           string
+	maxSegmentSize uint64
+	state          queueState
+	segmentSizes   map[uint64]uint64
+	writeSegment   uint64
+	writeFile      *os.File
+	writeOffset    uint64
+	readFile       *os.File
+	readSegment    uint64
+	pendingBytes   uint64
+	chanNewRecord  chan struct{}
+	isClosed       bool
+}
+
+func newDiskQueue(path string, maxSegmentSize uint64) (*diskQueue, error) {
+	err := os.MkdirAll(path, os.ModePerm)
+	if err != nil {
+		return nil, err
+	}
+
+	state, err := readQueueState(path)
+	if err != nil {
+		return nil, err
+	}
+
+	dq := &diskQueue{
+		path:           path,
+		maxSegmentSize: maxSegmentSize,
+		state:          *state,
+		segmentSizes:   make(map[uint64]uint64),
+		chanNewRecord:  make(chan struct{}, 1),
+	}
+	dq.cond = sync.NewCond(&dq.mut)
+
+	err = dq.loadSegments()
+	if err != nil {
+		return nil, err
+	}
+
+	return dq, nil
+}
+
+func (dq *diskQueue) loadSegments() error {
+	segments, err := listSegments(dq.path)
+	if err != nil {
+		return err
+	}
+
+	dq.writeSegment = dq.state.ReadSegment
+	for _, index := range segments {
+		if index < dq.state.ReadSegment {
+			err = os.Remove(segmentPath(dq.path, index))
+			if err != nil {
+				return err
+			}
+			continue
+		}
+		if index > dq.writeSegment {
+			dq.writeSegment = index
+		}
+	}
+
+	for index := dq.state.ReadSegment; index < dq.writeSegment; index++ {
+		size, errSize := getFileSize(segmentPath(dq.path, index))
+		if errSize != nil {
+			return errSize
+		}
+
+		dq.segmentSizes[index] = size
+		dq.pendingBytes += size
+	}
+
+	dq.writeOffset, err = recoverSegment(segmentPath(dq.path, dq.writeSegment))
+	if err != nil {
+		return err
+	}
+	dq.pendingBytes += dq.writeOffset
+
+	readSegmentSize := dq.getCommittedSize(dq.state.ReadSegment)
+	if dq.state.ReadOffset > readSegmentSize {
+		log.Warn("diskQueue: read offset is past the end of its segment, resetting it",
+			"path", dq.path, "segment", dq.state.ReadSegment, "offset", dq.state.ReadOffset, "size", readSegmentSize)
+		dq.state.ReadOffset = readSegmentSize
+	}
+	dq.pendingBytes -= dq.state.ReadOffset
+
+	dq.writeFile, err = os.OpenFile(segmentPath(dq.path, dq.writeSegment), os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0644)
+	return err
+}
+
+// push appends the record to the last segment, starting a new one if the record does not fit
+func (dq *diskQueue) push(record []byte) error {
+	dq.mut.Lock()
+	defer dq.mut.Unlock()
+
+	if dq.isClosed {
+		return ErrQueueClosed
+	}
+
+	recordSize := uint64(len(record))
+	if dq.writeOffset > 0 && dq.writeOffset+recordSize > dq.maxSegmentSize {
+		err := dq.rotateWriteSegment()
+		if err != nil {
+			return err
+		}
+	}
+
+	_, err := dq.writeFile.Write(record)
+	if err != nil {
+		return err
+	}
+	err = dq.writeFile.Sync()
+	if err != nil {
+		return err
+	}
+
+	dq.writeOffset += recordSize
+	dq.pendingBytes += recordSize
+
+	select {
+	case dq.chanNewRecord <- struct{}{}:
+	default:
+	}
+
+	return nil
+}
+
+func (dq *diskQueue) rotateWriteSegment() error {
+	err := dq.writeFile.Close()
+	if err != nil {
+		return err
+	}
+
+	dq.segmentSizes[dq.writeSegment] = dq.writeOffset
+	dq.writeSegment++
+	dq.writeOffset = 0
+	dq.writeFile, err = os.OpenFile(segmentPath(dq.path, dq.writeSegment), os.O_CREATE|os.O_TRUNC|os.O_WRONLY, 0644)
+
+	return err
+}
+
+// peek returns the oldest unacknowledged record and its size on disk, or errQueueEmpty if there is none
+func (dq *diskQueue) peek() (*file.Record, uint64, error) {
+	dq.mut.Lock()
+	defer dq.mut.Unlock()
+
+	for {
+		if dq.isClosed {
+			return nil, 0, ErrQueueClosed
+		}
+		if dq.state.ReadOffset < dq.getCommittedSize(dq.state.ReadSegment) {
+			break
+		}
+		if dq.state.ReadSegment == dq.writeSegment {
+			return nil, 0, errQueueEmpty
+		}
+
+		err := dq.moveToNextSegment()
+		if err != nil {
+			return nil, 0, err
+		}
+	}
+
+	err := dq.openReadFileIfNeeded()
+	if err != nil {
+		return nil, 0, err
+	}
+
+	offset := int64(dq.state.ReadOffset)
+	available := int64(dq.getCommittedSize(dq.state.ReadSegment)) - offset
+	section := io.NewSectionReader(dq.readFile, offset, available)
+	record, err := file.NewRecordReader(section).ReadRecord()
+	if err != nil {
+		return nil, 0, fmt.Errorf("%w while reading segment %d at offset %d", err, dq.state.ReadSegment, offset)
+	}
+
+	recordSize, err := section.Seek(0, io.SeekCurrent)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return record, uint64(recordSize), nil
+}
+
+func (dq *diskQueue) moveToNextSegment() error {
+	if dq.readFile != nil {
+		err := dq.readFile.Close()
+		if err != nil {
+			return err
+		}
+		dq.readFile = nil
+	}
+
+	consumedSegment := dq.state.ReadSegment
+	dq.state.ReadSegment++
+	dq.state.ReadOffset = 0
+	err := writeQueueState(dq.path, &dq.state)
+	if err != nil {
+		return err
+	}
+
+	delete(dq.segmentSizes, consumedSegment)
+	err = os.Remove(segmentPath(dq.path, consumedSegment))
+	if err != nil && !os.IsNotExist(err) {
+		return err
+	}
+
+	log.Debug("diskQueue: removed consumed segment", "path", dq.path, "segment", consumedSegment)
+
+	return nil
+}
+
+func (dq *diskQueue) openReadFileIfNeeded() error {
+	if dq.readFile != nil && dq.readSegment == dq.state.ReadSegment {
+		return nil
+	}
+	if dq.readFile != nil {
+		err := dq.readFile.Close()
+		if err != nil {
+			return err
+		}
+	}
+
+	readFile, err := os.Open(segmentPath(dq.path, dq.state.ReadSegment))
+	if err != nil {
+		return err
+	}
+
+	dq.readFile = readFile
+	dq.readSegment = dq.state.ReadSegment
+
+	return nil
+}
+
+// ack marks the record returned by the last peek call as delivered
+func (dq *diskQueue) ack(recordSize uint64, nonce uint64, hasNonce bool) error {
+	dq.mut.Lock()
+	defer dq.mut.Unlock()
+
+	dq.state.ReadOffset += recordSize
+	dq.pendingBytes -= recordSize
+	if hasNonce {
+		dq.state.LastAckedNonce = nonce
+		dq.state.HasAckedNonce = true
+	}
+
+	dq.cond.Broadcast()
+
+	return writeQueueState(dq.path, &dq.state)
+}
+
+// ackToDeadLetter appends the record returned by the last peek call to the dead letter file and marks it as consumed
+func (dq *diskQueue) ackToDeadLetter(record *file.Record, recordSize uint64) error {
+	buff, err := file.EncodeRecord(record.Topic, record.Payload)
+	if err != nil {
+		return err
+	}
+
+	err = dq.appendToDeadLetter(buff)
+	if err != nil {
+		return err
+	}
+
+	return dq.ack(recordSize, 0, false)
+}
+
+// skipUnreadableRecords moves the unread part of the current read segment to the dead letter file and marks it as
+// consumed. It is called when the next record can not be decoded, case in which its length can not be trusted and the
+// start of the following records can not be found
+func (dq *diskQueue) skipUnreadableRecords() (uint64, error) {
+	dq.mut.Lock()
+	defer dq.mut.Unlock()
+
+	err := dq.openReadFileIfNeeded()
+	if err != nil {
+		return 0, err
+	}
+
+	offset := dq.state.ReadOffset
+	committedSize := dq.getCommittedSize(dq.state.ReadSegment)
+	if offset >= committedSize {
+		return 0, nil
+	}
+
+	buff := make([]byte, committedSize-offset)
+	_, err = dq.readFile.ReadAt(buff, int64(offset))
+	if err != nil {
+		return 0, err
+	}
+
+	err = dq.appendToDeadLetter(buff)
+	if err != nil {
+		return 0, err
+	}
+
+	skippedBytes := uint64(len(buff))
+	dq.state.ReadOffset = committedSize
+	dq.pendingBytes -= skippedBytes
+	dq.cond.Broadcast()
+
+	return skippedBytes, writeQueueState(dq.path, &dq.state)
+}
+
+func (dq *diskQueue) appendToDeadLetter(buff []byte) error {
+	deadLetterFile, err := os.OpenFile(filepath.Join(dq.path, deadLetterFileName), os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0644)
+	if err != nil {
+		return err
+	}
+
+	_, err = deadLetterFile.Write(buff)
+	if err != nil {
+		_ = deadLetterFile.Close()
+		return err
+	}
+
+	return deadLetterFile.Close()
+}
+
+// waitForSpace blocks while the size of the unacknowledged records exceeds the provided limit. A 0 limit disables the wait
+func (dq *diskQueue) waitForSpace(maxPendingBytes uint64) error {
+	dq.mut.Lock()
+	defer dq.mut.Unlock()
+
+	isAboveLimit := func() bool {
+		return maxPendingBytes > 0 && dq.pendingBytes > maxPendingBytes
+	}
+	if isAboveLimit() && !dq.isClosed {
+		log.Warn("diskQueue: queue size exceeds the configured limit, waiting for the driver to catch up",
+			"path", dq.path, "pending bytes", dq.pendingBytes, "limit", maxPendingBytes)
+	}
+
+	for isAboveLimit() && !dq.isClosed {
+		dq.cond.Wait()
+	}
+
+	if dq.isClosed {
+		return ErrQueueClosed
+	}
+
+	return nil
+}
+
+func (dq *diskQueue) getCommittedSize(segment uint64) uint64 {
+	if segment == dq.writeSegment {
+		return dq.writeOffset
+	}
+
+	return dq.segmentSizes[segment]
+}
+
+func (dq *diskQueue) getPendingBytes() uint64 {
+	dq.mut.Lock()
+	defer dq.mut.Unlock()
+
+	return dq.pendingBytes
+}
+
+func (dq *diskQueue) getState() queueState {
+	dq.mut.Lock()
+	defer dq.mut.Unlock()
+
+	return dq.state
+}
+
+// markClosed releases the callers waiting for space and makes the following push and peek calls fail
+func (dq *diskQueue) markClosed() {
+	dq.mut.Lock()
+	defer dq.mut.Unlock()
+
+	dq.isClosed = true
+	dq.cond.Broadcast()
+}
+
+// close marks the queue as closed and closes the segment files
+func (dq *diskQueue) close() error {
+	dq.markClosed()
+
+	dq.mut.Lock()
+	defer dq.mut.Unlock()
+
+	var lastErr error
+	if dq.readFile != nil {
+		lastErr = dq.readFile.Close()
+		dq.readFile = nil
+	}
+	if dq.writeFile != nil {
+		err := dq.writeFile.Close()
+		if err != nil {
+			lastErr = err
+		}
+		dq.writeFile = nil
+	}
+
+	return lastErr
+}
+
+// recoverSegment returns the size of the valid records of a segment, truncating a partially written record at its end
+func recoverSegment(segmentFilePath string) (uint64, error) {
+	segmentFile, err := os.OpenFile(segmentFilePath, os.O_CREATE|os.O_RDWR, 0644)
+	if err != nil {
+		return 0, err
+	}
+	defer func() {
+		_ = segmentFile.Close()
+	}()
+
+	info, err := segmentFile.Stat()
+	if err != nil {
+		return 0, err
+	}
+
+	section := io.NewSectionReader(segmentFile, 0, info.Size())
+	reader := file.NewRecordReader(section)
+	validSize := int64(0)
+	for {
+		_, err = reader.ReadRecord()
+		if err == io.EOF {
+			return uint64(validSize), nil
+		}
+		if isCorruptedRecordError(err) {
+			break
+		}
+		if err != nil {
+			return 0, err
+		}
+
+		validSize, err = section.Seek(0, io.SeekCurrent)
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	log.Warn("diskQueue: truncating the partially written record at the end of the segment",
+		"segment", segmentFilePath, "valid size", validSize, "file size", info.Size())
+
+	err = segmentFile.Truncate(validSize)
+	if err != nil {
+		return 0, err
+	}
+
+	return uint64(validSize), nil
+}
+
+func isCorruptedRecordError(err error) bool {
+	return errors.Is(err, file.ErrTruncatedRecord) ||
+		errors.Is(err, file.ErrInvalidChecksum) ||
+		errors.Is(err, file.ErrInvalidRecord)
+}
+
+func listSegments(path string) ([]uint64, error) {
+	entries, err := os.ReadDir(path)
+	if err != nil {
+		return nil, err
+	}
+
+	segments := make([]uint64, 0, len(entries))
+	for _, entry := range entries {
+		name := entry.Name()
+		if entry.IsDir() || !strings.HasPrefix(name, segmentFilePrefix) || !strings.HasSuffix(name, segmentFileSuffix) {
+			continue
+		}
+
+		var index uint64
+		_, err = fmt.Sscanf(name, segmentFilePrefix+"%d"+segmentFileSuffix, &index)
+		if err != nil {
+			log.Warn("diskQueue: ignoring file with invalid segment name", "file", name)
+			continue
+		}
+
+		segments = append(segments, index)
+	}
+
+	sort.Slice(segments, func(i, j int) bool {
+		return segments[i] < segments[j]
+	})
+
+	return segments, nil
+}
+
+func segmentPath(path string, index uint64) string {
+	return filepath.Join(path, fmt.Sprintf("%s%08d%s", segmentFilePrefix, index, segmentFileSuffix))
+}
+
+func getFileSize(filePath string) (uint64, error) {
+	info, err := os.Stat(filePath)
+	if os.IsNotExist(err) {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, err
+	}
+
+	return uint64(info.Size()), nil
+}
+
+func readQueueState(path string) (*queueState, error) {
+	buff, err := os.ReadFile(filepath.Join(path, stateFileName))
+	if os.IsNotExist(err) {
+		return &queueState{}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	state := &queueState{}
+	err = json.Unmarshal(buff, state)
+	if err != nil {
+		return nil, err
+	}
+
+	return state, nil
+}
+
+func writeQueueState(path string, state *queueState) error {
+	buff, err := json.Marshal(state)
+	if err != nil {
+		return err
+	}
+
+	statePath := filepath.Join(path, stateFileName)
+	tempPath := statePath + ".tmp"
+	err = os.WriteFile(tempPath, buff, 0644)
+	if err != nil {
+		return err
+	}
+
+	return os.Rename(tempPath, statePath)
+}
@@ -0,0 +1,200 @@
+package queue
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/multiversx/mx-chain-go/outport/file"
+	"github.com/stretchr/testify/require"
+)
+
+func encodeTestRecord(t *testing.T, index int) []byte {
+	record, err := file.EncodeRecord("topic", []byte(fmt.Sprintf("payload-%d", index)))
+	require.Nil(t, err)
+
+	return record
+}
+
+func popRecord(t *testing.T, dq *diskQueue) string {
+	record, recordSize, err := dq.peek()
+	require.Nil(t, err)
+	require.Nil(t, dq.ack(recordSize, 0, false))
+
+	return string(record.Payload)
+}
+
+func TestDiskQueue_PushPeekAck(t *testing.T) {
+	t.Parallel()
+
+	dq, err := newDiskQueue(t.TempDir(), 1024)
+	require.Nil(t, err)
+	defer func() {
+		_ = dq.close()
+	}()
+
+	_, _, err = dq.peek()
+	require.Equal(t, errQueueEmpty, err)
+
+	first := encodeTestRecord(t, 0)
+	second := encodeTestRecord(t, 1)
+	require.Nil(t, dq.push(first))
+	require.Nil(t, dq.push(second))
+	require.Equal(t, uint64(len(first)+len(second)), dq.getPendingBytes())
+
+	record, recordSize, err := dq.peek()
+	require.Nil(t, err)
+	require.Equal(t, "payload-0", string(record.Payload))
+	require.Equal(t, uint64(len(first)), recordSize)
+
+	record, _, err = dq.peek()
+	require.Nil(t, err)
+	require.Equal(t, "payload-0", string(record.Payload), "peek should not consume the record")
+
+	require.Nil(t, dq.ack(recordSize, 37, true))
+	require.Equal(t, uint64(len(second)), dq.getPendingBytes())
+	require.Equal(t, "payload-1", popRecord(t, dq))
+	require.Equal(t, uint64(0), dq.getPendingBytes())
+
+	state := dq.getState()
+	require.Equal(t, uint64(37), state.LastAckedNonce)
+	require.True(t, state.HasAckedNonce)
+
+	_, _, err = dq.peek()
+	require.Equal(t, errQueueEmpty, err)
+}
+
+func TestDiskQueue_SegmentRotation(t *testing.T) {
+	t.Parallel()
+
+	dirPath := t.TempDir()
+	recordSize := uint64(len(encodeTestRecord(t, 0)))
+	dq, err := newDiskQueue(dirPath, recordSize*2)
+	require.Nil(t, err)
+	defer func() {
+		_ = dq.close()
+	}()
+
+	for i := 0; i < 5; i++ {
+		require.Nil(t, dq.push(encodeTestRecord(t, i)))
+	}
+
+	segments, err := listSegments(dirPath)
+	require.Nil(t, err)
+	require.Equal(t, []uint64{0, 1, 2}, segments)
+
+	for i := 0; i < 3; i++ {
+		require.Equal(t, fmt.Sprintf("payload-%d", i), popRecord(t, dq))
+	}
+
+	// the first segment is removed when the reader moves past it
+	segments, err = listSegments(dirPath)
+	require.Nil(t, err)
+	require.Equal(t, []uint64{1, 2}, segments)
+
+	for i := 3; i < 5; i++ {
+		require.Equal(t, fmt.Sprintf("payload-%d", i), popRecord(t, dq))
+	}
+	_, _, err = dq.peek()
+	require.Equal(t, errQueueEmpty, err)
+}
+
+func TestDiskQueue_ReopenShouldResumeFromTheLastAck(t *testing.T) {
+	t.Parallel()
+
+	dirPath := t.TempDir()
+	recordSize := uint64(len(encodeTestRecord(t, 0)))
+	dq, err := newDiskQueue(dirPath, recordSize*2)
+	require.Nil(t, err)
+
+	for i := 0; i < 5; i++ {
+		require.Nil(t, dq.push(encodeTestRecord(t, i)))
+	}
+	require.Equal(t, "payload-0", popRecord(t, dq))
+	require.Equal(t, "payload-1", popRecord(t, dq))
+	require.Equal(t, "payload-2", popRecord(t, dq))
+
+	// peeked but not acknowledged, should be delivered again
+	_, _, err = dq.peek()
+	require.Nil(t, err)
+	require.Nil(t, dq.close())
+
+	dq, err = newDiskQueue(dirPath, recordSize*2)
+	require.Nil(t, err)
+	defer func() {
+		_ = dq.close()
+	}()
+
+	require.Equal(t, recordSize*2, dq.getPendingBytes())
+	require.Equal(t, "payload-3", popRecord(t, dq))
+	require.Nil(t, dq.push(encodeTestRecord(t, 5)))
+	require.Equal(t, "payload-4", popRecord(t, dq))
+	require.Equal(t, "payload-5", popRecord(t, dq))
+}
+
+func TestDiskQueue_ReopenShouldTruncatePartialRecord(t *testing.T) {
+	t.Parallel()
+
+	dirPath := t.TempDir()
+	dq, err := newDiskQueue(dirPath, 1024)
+	require.Nil(t, err)
+
+	first := encodeTestRecord(t, 0)
+	require.Nil(t, dq.push(first))
+	require.Nil(t, dq.close())
+
+	partialRecord := encodeTestRecord(t, 1)
+	segmentFile, err := os.OpenFile(segmentPath(dirPath, 0), os.O_APPEND|os.O_WRONLY, 0644)
+	require.Nil(t, err)
+	_, err = segmentFile.Write(partialRecord[:len(partialRecord)-3])
+	require.Nil(t, err)
+	require.Nil(t, segmentFile.Close())
+
+	dq, err = newDiskQueue(dirPath, 1024)
+	require.Nil(t, err)
+	defer func() {
+		_ = dq.close()
+	}()
+
+	require.Equal(t, uint64(len(first)), dq.getPendingBytes())
+	require.Nil(t, dq.push(encodeTestRecord(t, 2)))
+	require.Equal(t, "payload-0", popRecord(t, dq))
+	require.Equal(t, "payload-2", popRecord(t, dq))
+}
+
+func TestDiskQueue_WaitForSpace(t *testing.T) {
+	t.Parallel()
+
+	dq, err := newDiskQueue(t.TempDir(), 1024)
+	require.Nil(t, err)
+	defer func() {
+		_ = dq.close()
+	}()
+
+	record := encodeTestRecord(t, 0)
+	require.Nil(t, dq.push(record))
+	require.Nil(t, dq.push(record))
+
+	require.Nil(t, dq.waitForSpace(0))
+	require.Nil(t, dq.waitForSpace(uint64(len(record)*2)))
+
+	chanDone := make(chan error)
+	go func() {
+		chanDone <- dq.waitForSpace(uint64(len(record)))
+	}()
+
+	select {
+	case <-chanDone:
+		require.Fail(t, "should have waited for the queue to be drained")
+	default:
+	}
+
+	popRecord(t, dq)
+	require.Nil(t, <-chanDone)
+
+	go func() {
+		chanDone <- dq.waitForSpace(1)
+	}()
+	dq.markClosed()
+	require.Equal(t, ErrQueueClosed, <-chanDone)
+}
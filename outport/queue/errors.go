@@ -0,0 +1,25 @@
+package queue
+
+import "errors"
+
+// ErrNilDriver signals that a nil driver was provided
+var ErrNilDriver = errors.New("nil driver")
+
+// ErrEmptyPath signals that an empty path was provided
+var ErrEmptyPath = errors.New("empty path provided")
+
+// ErrInvalidMaxSegmentSize signals that an invalid maximum segment size was provided
+var ErrInvalidMaxSegmentSize = errors.New("invalid maximum segment size")
+
+// ErrInvalidRetrialInterval signals that an invalid retrial interval was provided
+var ErrInvalidRetrialInterval = errors.New("invalid retrial interval")
+
+// ErrQueueClosed signals that the queue was closed while trying to perform actions
+var ErrQueueClosed = errors.New("queue is closed")
+
+// ErrUnknownTopic signals that a queued record has an unknown topic
+var ErrUnknownTopic = errors.New("unknown topic")
+
+var errUndecodableRecord = errors.New("undecodable record")
+
+var errQueueEmpty = errors.New("queue is empty")
@@ -0,0 +1,394 @@
+package queue
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-core-go/data/block"
+	outportcore "github.com/multiversx/mx-chain-core-go/data/outport"
+	"github.com/multiversx/mx-chain-core-go/marshal"
+	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/outport"
+	"github.com/multiversx/mx-chain-go/outport/file"
+	logger "github.com/multiversx/mx-chain-logger-go"
+)
+
+var log = logger.GetOrCreate("outport/queue")
+
+// ArgsQueuedDriver holds the arguments needed for creating a new queuedDriver
+type ArgsQueuedDriver struct {
+	Driver                outport.Driver
+	Path                  string
+	MaxQueueSizeInBytes   uint64
+	MaxSegmentSizeInBytes uint64
+	RetrialInterval       time.Duration
+}
+
+type queuedDriver struct {
+	driver              outport.Driver
+	marshaller          marshal.Marshalizer
+	blockCreators       map[core.HeaderType]block.EmptyBlockCreator
+	queue               *diskQueue
+	maxQueueSizeInBytes uint64
+	retrialInterval     time.Duration
+	chanClose           chan struct{}
+	closeOnce           sync.Once
+	wgWorker            sync.WaitGroup
+}
+
+// NewQueuedDriver creates a driver that persists every outport message in a disk-backed queue and returns
+// immediately. A background worker delivers the queued messages to the wrapped driver, in order, and removes them
+// from the queue only after the wrapped driver accepted them, so every message is delivered at least once. The records
+// which can never be delivered (unreadable, undecodable or with an unknown topic) are moved to a dead letter file
+func NewQueuedDriver(args ArgsQueuedDriver) (*queuedDriver, error) {
+	err := checkArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	queue, err := newDiskQueue(args.Path, args.MaxSegmentSizeInBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	qd := &queuedDriver{
+		driver:              args.Driver,
+		marshaller:          args.Driver.GetMarshaller(),
+		blockCreators:       createBlockCreators(),
+		queue:               queue,
+		maxQueueSizeInBytes: args.MaxQueueSizeInBytes,
+		retrialInterval:     args.RetrialInterval,
+		chanClose:           make(chan struct{}),
+	}
+
+	state := queue.getState()
+	log.Debug("queuedDriver: opened queue", "driver", driverString(args.Driver), "path", args.Path,
+		"pending bytes", queue.getPendingBytes(), "last acked nonce", state.LastAckedNonce, "has acked nonce", state.HasAckedNonce)
+
+	qd.wgWorker.Add(1)
+	go qd.deliverQueuedRecords()
+
+	return qd, nil
+}
+
+func checkArgs(args ArgsQueuedDriver) error {
+	if check.IfNil(args.Driver) {
+		return ErrNilDriver
+	}
+	if check.IfNil(args.Driver.GetMarshaller()) {
+		return core.ErrNilMarshalizer
+	}
+	if len(args.Path) == 0 {
+		return ErrEmptyPath
+	}
+	if args.MaxSegmentSizeInBytes == 0 {
+		return ErrInvalidMaxSegmentSize
+	}
+	if args.RetrialInterval <= 0 {
+		return ErrInvalidRetrialInterval
+	}
+
+	return nil
+}
+
+func createBlockCreators() map[core.HeaderType]block.EmptyBlockCreator {
+	return map[core.HeaderType]block.EmptyBlockCreator{
+		core.ShardHeaderV1:        block.NewEmptyHeaderCreator(),
+		core.ShardHeaderV2:        block.NewEmptyHeaderV2Creator(),
+		core.MetaHeader:           block.NewEmptyMetaBlockCreator(),
+		core.SovereignChainHeader: block.NewEmptySovereignHeaderCreator(),
+	}
+}
+
+// SaveBlock will queue the outport block
+func (qd *queuedDriver) SaveBlock(outportBlock *outportcore.OutportBlock) error {
+	return qd.enqueue(outportBlock, outportcore.TopicSaveBlock)
+}
+
+// RevertIndexedBlock will queue the reverted block data
+func (qd *queuedDriver) RevertIndexedBlock(blockData *outportcore.BlockData) error {
+	return qd.enqueue(blockData, outportcore.TopicRevertIndexedBlock)
+}
+
+// SaveRoundsInfo will queue the rounds info
+func (qd *queuedDriver) SaveRoundsInfo(roundsInfos *outportcore.RoundsInfo) error {
+	return qd.enqueue(roundsInfos, outportcore.TopicSaveRoundsInfo)
+}
+
+// SaveValidatorsPubKeys will queue the validators' public keys
+func (qd *queuedDriver) SaveValidatorsPubKeys(validatorsPubKeys *outportcore.ValidatorsPubKeys) error {
+	return qd.enqueue(validatorsPubKeys, outportcore.TopicSaveValidatorsPubKeys)
+}
+
+// SaveValidatorsRating will queue the validators' rating
+func (qd *queuedDriver) SaveValidatorsRating(validatorsRating *outportcore.ValidatorsRating) error {
+	return qd.enqueue(validatorsRating, outportcore.TopicSaveValidatorsRating)
+}
+
+// SaveAccounts will queue the accounts
+func (qd *queuedDriver) SaveAccounts(accounts *outportcore.Accounts) error {
+	return qd.enqueue(accounts, outportcore.TopicSaveAccounts)
+}
+
+// SaveConsensusRoundTimeline will forward the consensus round timeline directly to the wrapped driver. The timelines
+// are diagnostic data which lose their value when delivered late, so they are not persisted in the queue
+func (qd *queuedDriver) SaveConsensusRoundTimeline(timeline *common.ConsensusRoundTimeline) error {
+	return qd.driver.SaveConsensusRoundTimeline(timeline)
+}
+
+// FinalizedBlock will queue the finalized block
+func (qd *queuedDriver) FinalizedBlock(finalizedBlock *outportcore.FinalizedBlock) error {
+	return qd.enqueue(finalizedBlock, outportcore.TopicFinalizedBlock)
+}
+
+// GetMarshaller returns the marshaller of the wrapped driver
+func (qd *queuedDriver) GetMarshaller() marshal.Marshalizer {
+	return qd.marshaller
+}
+
+// SetCurrentSettings will forward the settings to the wrapped driver
+func (qd *queuedDriver) SetCurrentSettings(config outportcore.OutportConfig) error {
+	return qd.driver.SetCurrentSettings(config)
+}
+
+// RegisterHandler will register the handler on the wrapped driver
+func (qd *queuedDriver) RegisterHandler(handlerFunction func() error, topic string) error {
+	return qd.driver.RegisterHandler(handlerFunction, topic)
+}
+
+func (qd *queuedDriver) enqueue(args interface{}, topic string) error {
+	payload, err := qd.marshaller.Marshal(args)
+	if err != nil {
+		return fmt.Errorf("%w while marshaling payload for topic %s", err, topic)
+	}
+
+	record, err := file.EncodeRecord(topic, payload)
+	if err != nil {
+		return fmt.Errorf("%w while encoding record for topic %s", err, topic)
+	}
+
+	err = qd.queue.waitForSpace(qd.maxQueueSizeInBytes)
+	if err != nil {
+		return err
+	}
+
+	return qd.queue.push(record)
+}
+
+func (qd *queuedDriver) deliverQueuedRecords() {
+	defer qd.wgWorker.Done()
+
+	for {
+		record, recordSize, err := qd.queue.peek()
+		if errors.Is(err, ErrQueueClosed) {
+			return
+		}
+		if errors.Is(err, errQueueEmpty) {
+			if !qd.waitForNewRecord() {
+				return
+			}
+			continue
+		}
+		if isCorruptedRecordError(err) {
+			qd.skipUnreadableRecords(err)
+			continue
+		}
+		if err != nil {
+			log.Error("queuedDriver: cannot read the next queued record, will retry",
+				"driver", driverString(qd.driver), "retrial in", qd.retrialInterval, "error", err)
+			if qd.shouldTerminate() {
+				return
+			}
+			continue
+		}
+
+		nonce, hasNonce, err := qd.deliverRecord(record)
+		if isPermanentDeliveryError(err) {
+			qd.moveToDeadLetter(record, recordSize, err)
+			continue
+		}
+		if err != nil {
+			log.Error("queuedDriver: error delivering queued record, will retry",
+				"driver", driverString(qd.driver), "topic", record.Topic, "retrial in", qd.retrialInterval, "error", err)
+			if qd.shouldTerminate() {
+				return
+			}
+			continue
+		}
+
+		err = qd.queue.ack(recordSize, nonce, hasNonce)
+		if err != nil {
+			log.Warn("queuedDriver: cannot persist the acknowledgement", "driver", driverString(qd.driver), "error", err)
+		}
+	}
+}
+
+// skipUnreadableRecords moves the undecodable part of the queue to the dead letter file, so it will not block the
+// delivery of the records written after it. Retrying would fail the same way
+func (qd *queuedDriver) skipUnreadableRecords(readErr error) {
+	skippedBytes, err := qd.queue.skipUnreadableRecords()
+	if err != nil {
+		log.Error("queuedDriver: cannot skip the unreadable queued records, will retry",
+			"driver", driverString(qd.driver), "read error", readErr, "retrial in", qd.retrialInterval, "error", err)
+		qd.shouldTerminate()
+		return
+	}
+
+	log.Error("queuedDriver: moved the unreadable queued records to the dead letter file",
+		"driver", driverString(qd.driver), "skipped bytes", skippedBytes, "error", readErr)
+}
+
+// moveToDeadLetter acknowledges a record that the wrapped driver can never accept, after saving it in the dead letter
+// file for inspection
+func (qd *queuedDriver) moveToDeadLetter(record *file.Record, recordSize uint64, deliveryErr error) {
+	err := qd.queue.ackToDeadLetter(record, recordSize)
+	if err != nil {
+		log.Error("queuedDriver: cannot move the undeliverable queued record to the dead letter file, will retry",
+			"driver", driverString(qd.driver), "topic", record.Topic, "delivery error", deliveryErr, "retrial in", qd.retrialInterval, "error", err)
+		qd.shouldTerminate()
+		return
+	}
+
+	log.Error("queuedDriver: moved the undeliverable queued record to the dead letter file",
+		"driver", driverString(qd.driver), "topic", record.Topic, "payload size", len(record.Payload), "error", deliveryErr)
+}
+
+func isPermanentDeliveryError(err error) bool {
+	return errors.Is(err, ErrUnknownTopic) || errors.Is(err, errUndecodableRecord)
+}
+
+func (qd *queuedDriver) waitForNewRecord() bool {
+	select {
+	case <-qd.queue.chanNewRecord:
+		return true
+	case <-qd.chanClose:
+		return false
+	}
+}
+
+func (qd *queuedDriver) shouldTerminate() bool {
+	select {
+	case <-qd.chanClose:
+		return true
+	case <-time.After(qd.retrialInterval):
+		return false
+	}
+}
+
+func (qd *queuedDriver) deliverRecord(record *file.Record) (uint64, bool, error) {
+	switch record.Topic {
+	case outportcore.TopicSaveBlock:
+		outportBlock := &outportcore.OutportBlock{}
+		err := qd.marshaller.Unmarshal(outportBlock, record.Payload)
+		if err != nil {
+			return 0, false, fmt.Errorf("%w: %s", errUndecodableRecord, err.Error())
+		}
+
+		nonce, hasNonce := qd.getBlockNonce(outportBlock)
+		return nonce, hasNonce, qd.driver.SaveBlock(outportBlock)
+	case outportcore.TopicRevertIndexedBlock:
+		blockData := &outportcore.BlockData{}
+		return 0, false, qd.unmarshalAndCall(blockData, record.Payload, func() error {
+			return qd.driver.RevertIndexedBlock(blockData)
+		})
+	case outportcore.TopicSaveRoundsInfo:
+		roundsInfo := &outportcore.RoundsInfo{}
+		return 0, false, qd.unmarshalAndCall(roundsInfo, record.Payload, func() error {
+			return qd.driver.SaveRoundsInfo(roundsInfo)
+		})
+	case outportcore.TopicSaveValidatorsPubKeys:
+		validatorsPubKeys := &outportcore.ValidatorsPubKeys{}
+		return 0, false, qd.unmarshalAndCall(validatorsPubKeys, record.Payload, func() error {
+			return qd.driver.SaveValidatorsPubKeys(validatorsPubKeys)
+		})
+	case outportcore.TopicSaveValidatorsRating:
+		validatorsRating := &outportcore.ValidatorsRating{}
+		return 0, false, qd.unmarshalAndCall(validatorsRating, record.Payload, func() error {
+			return qd.driver.SaveValidatorsRating(validatorsRating)
+		})
+	case outportcore.TopicSaveAccounts:
+		accounts := &outportcore.Accounts{}
+		return 0, false, qd.unmarshalAndCall(accounts, record.Payload, func() error {
+			return qd.driver.SaveAccounts(accounts)
+		})
+	case outportcore.TopicFinalizedBlock:
+		finalizedBlock := &outportcore.FinalizedBlock{}
+		return 0, false, qd.unmarshalAndCall(finalizedBlock, record.Payload, func() error {
+			return qd.driver.FinalizedBlock(finalizedBlock)
+		})
+	default:
+		return 0, false, fmt.Errorf("%w: %s", ErrUnknownTopic, record.Topic)
+	}
+}
+
+func (qd *queuedDriver) unmarshalAndCall(obj interface{}, payload []byte, handler func() error) error {
+	err := qd.marshaller.Unmarshal(obj, payload)
+	if err != nil {
+		return fmt.Errorf("%w: %s", errUndecodableRecord, err.Error())
+	}
+
+	return handler()
+}
+
+func (qd *queuedDriver) getBlockNonce(outportBlock *outportcore.OutportBlock) (uint64, bool) {
+	if outportBlock.BlockData == nil {
+		return 0, false
+	}
+
+	creator, found := qd.blockCreators[core.HeaderType(outportBlock.BlockData.HeaderType)]
+	if !found {
+		return 0, false
+	}
+
+	header, err := block.GetHeaderFromBytes(qd.marshaller, creator, outportBlock.BlockData.HeaderBytes)
+	if err != nil {
+		log.Debug("queuedDriver.getBlockNonce: cannot decode header", "error", err)
+		return 0, false
+	}
+
+	return header.GetNonce(), true
+}
+
+// GetLastAckedNonce returns the nonce of the last block delivered to the wrapped driver, including previous runs
+func (qd *queuedDriver) GetLastAckedNonce() (uint64, bool) {
+	state := qd.queue.getState()
+	return state.LastAckedNonce, state.HasAckedNonce
+}
+
+// GetPendingBytes returns the size of the queued messages not yet delivered to the wrapped driver
+func (qd *queuedDriver) GetPendingBytes() uint64 {
+	return qd.queue.getPendingBytes()
+}
+
+// Close stops the delivery, closes the wrapped driver and the queue. Undelivered messages are kept on disk
+// and delivered after restart
+func (qd *queuedDriver) Close() error {
+	var err error
+	qd.closeOnce.Do(func() {
+		close(qd.chanClose)
+		qd.queue.markClosed()
+
+		err = qd.driver.Close()
+		qd.wgWorker.Wait()
+
+		errQueue := qd.queue.close()
+		if errQueue != nil {
+			err = errQueue
+		}
+	})
+
+	return err
+}
+
+func driverString(driver outport.Driver) string {
+	return fmt.Sprintf("%T", driver)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (qd *queuedDriver) IsInterfaceNil() bool {
+	return qd == nil
+}
@@ -0,0 +1,408 @@
+package queue
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-core-go/data/block"
+	outportcore "github.com/multiversx/mx-chain-core-go/data/outport"
+	"github.com/multiversx/mx-chain-go/outport/file"
+	"github.com/multiversx/mx-chain-go/outport/mock"
+	"github.com/multiversx/mx-chain-go/testscommon/marshallerMock"
+	"github.com/stretchr/testify/require"
+)
+
+func createMockArgsQueuedDriver(t *testing.T) ArgsQueuedDriver {
+	return ArgsQueuedDriver{
+		Driver:                &mock.DriverStub{},
+		Path:                  t.TempDir(),
+		MaxQueueSizeInBytes:   0,
+		MaxSegmentSizeInBytes: core.MegabyteSize,
+		RetrialInterval:       time.Millisecond * 10,
+	}
+}
+
+func createOutportBlock(t *testing.T, nonce uint64) *outportcore.OutportBlock {
+	headerBytes, err := marshallerMock.MarshalizerMock{}.Marshal(&block.Header{Nonce: nonce})
+	require.Nil(t, err)
+
+	return &outportcore.OutportBlock{
+		BlockData: &outportcore.BlockData{
+			HeaderBytes: headerBytes,
+			HeaderType:  string(core.ShardHeaderV1),
+		},
+	}
+}
+
+func waitForEmptyQueue(t *testing.T, qd *queuedDriver) {
+	for i := 0; i < 500; i++ {
+		if qd.GetPendingBytes() == 0 {
+			return
+		}
+		time.Sleep(time.Millisecond * 10)
+	}
+
+	require.Fail(t, "queue was not drained in time")
+}
+
+func TestNewQueuedDriver(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil driver should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsQueuedDriver(t)
+		args.Driver = nil
+		qd, err := NewQueuedDriver(args)
+		require.Equal(t, ErrNilDriver, err)
+		require.True(t, check.IfNil(qd))
+	})
+	t.Run("empty path should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsQueuedDriver(t)
+		args.Path = ""
+		qd, err := NewQueuedDriver(args)
+		require.Equal(t, ErrEmptyPath, err)
+		require.True(t, check.IfNil(qd))
+	})
+	t.Run("invalid max segment size should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsQueuedDriver(t)
+		args.MaxSegmentSizeInBytes = 0
+		qd, err := NewQueuedDriver(args)
+		require.Equal(t, ErrInvalidMaxSegmentSize, err)
+		require.True(t, check.IfNil(qd))
+	})
+	t.Run("invalid retrial interval should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsQueuedDriver(t)
+		args.RetrialInterval = 0
+		qd, err := NewQueuedDriver(args)
+		require.Equal(t, ErrInvalidRetrialInterval, err)
+		require.True(t, check.IfNil(qd))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		qd, err := NewQueuedDriver(createMockArgsQueuedDriver(t))
+		require.Nil(t, err)
+		require.False(t, check.IfNil(qd))
+		require.Nil(t, qd.Close())
+	})
+}
+
+func TestQueuedDriver_ShouldDeliverAllTopicsInOrder(t *testing.T) {
+	t.Parallel()
+
+	mut := sync.Mutex{}
+	delivered := make([]string, 0)
+	addDelivered := func(topic string) {
+		mut.Lock()
+		delivered = append(delivered, topic)
+		mut.Unlock()
+	}
+
+	args := createMockArgsQueuedDriver(t)
+	args.Driver = &mock.DriverStub{
+		SaveBlockCalled: func(outportBlock *outportcore.OutportBlock) error {
+			addDelivered(outportcore.TopicSaveBlock)
+			return nil
+		},
+		RevertIndexedBlockCalled: func(blockData *outportcore.BlockData) error {
+			addDelivered(outportcore.TopicRevertIndexedBlock)
+			return nil
+		},
+		SaveRoundsInfoCalled: func(roundsInfos *outportcore.RoundsInfo) error {
+			addDelivered(outportcore.TopicSaveRoundsInfo)
+			return nil
+		},
+		SaveValidatorsPubKeysCalled: func(validatorsPubKeys *outportcore.ValidatorsPubKeys) error {
+			addDelivered(outportcore.TopicSaveValidatorsPubKeys)
+			return nil
+		},
+		SaveValidatorsRatingCalled: func(validatorsRating *outportcore.ValidatorsRating) error {
+			addDelivered(outportcore.TopicSaveValidatorsRating)
+			return nil
+		},
+		SaveAccountsCalled: func(accounts *outportcore.Accounts) error {
+			addDelivered(outportcore.TopicSaveAccounts)
+			return nil
+		},
+		FinalizedBlockCalled: func(finalizedBlock *outportcore.FinalizedBlock) error {
+			require.Equal(t, []byte("hash"), finalizedBlock.HeaderHash)
+			addDelivered(outportcore.TopicFinalizedBlock)
+			return nil
+		},
+	}
+	qd, _ := NewQueuedDriver(args)
+
+	require.Nil(t, qd.SaveBlock(createOutportBlock(t, 7)))
+	require.Nil(t, qd.RevertIndexedBlock(&outportcore.BlockData{}))
+	require.Nil(t, qd.SaveRoundsInfo(&outportcore.RoundsInfo{}))
+	require.Nil(t, qd.SaveValidatorsPubKeys(&outportcore.ValidatorsPubKeys{}))
+	require.Nil(t, qd.SaveValidatorsRating(&outportcore.ValidatorsRating{}))
+	require.Nil(t, qd.SaveAccounts(&outportcore.Accounts{}))
+	require.Nil(t, qd.FinalizedBlock(&outportcore.FinalizedBlock{HeaderHash: []byte("hash")}))
+
+	waitForEmptyQueue(t, qd)
+	require.Nil(t, qd.Close())
+
+	mut.Lock()
+	require.Equal(t, []string{
+		outportcore.TopicSaveBlock,
+		outportcore.TopicRevertIndexedBlock,
+		outportcore.TopicSaveRoundsInfo,
+		outportcore.TopicSaveValidatorsPubKeys,
+		outportcore.TopicSaveValidatorsRating,
+		outportcore.TopicSaveAccounts,
+		outportcore.TopicFinalizedBlock,
+	}, delivered)
+	mut.Unlock()
+
+	nonce, hasNonce := qd.GetLastAckedNonce()
+	require.True(t, hasNonce)
+	require.Equal(t, uint64(7), nonce)
+}
+
+func TestQueuedDriver_ShouldRetryUntilTheDriverAccepts(t *testing.T) {
+	t.Parallel()
+
+	mut := sync.Mutex{}
+	numCalls := 0
+	args := createMockArgsQueuedDriver(t)
+	args.Driver = &mock.DriverStub{
+		SaveBlockCalled: func(outportBlock *outportcore.OutportBlock) error {
+			mut.Lock()
+			defer mut.Unlock()
+
+			numCalls++
+			if numCalls < 3 {
+				return errors.New("driver unavailable")
+			}
+			return nil
+		},
+	}
+	qd, _ := NewQueuedDriver(args)
+
+	require.Nil(t, qd.SaveBlock(createOutportBlock(t, 1)))
+	waitForEmptyQueue(t, qd)
+	require.Nil(t, qd.Close())
+
+	mut.Lock()
+	require.Equal(t, 3, numCalls)
+	mut.Unlock()
+}
+
+func readDeadLetterRecords(t *testing.T, path string) []*file.Record {
+	buff, err := os.ReadFile(filepath.Join(path, deadLetterFileName))
+	require.Nil(t, err)
+
+	records := make([]*file.Record, 0)
+	reader := file.NewRecordReader(bytes.NewReader(buff))
+	for {
+		record, errRead := reader.ReadRecord()
+		if errRead == io.EOF {
+			return records
+		}
+		require.Nil(t, errRead)
+		records = append(records, record)
+	}
+}
+
+func TestQueuedDriver_ShouldMoveUndeliverableRecordsToDeadLetter(t *testing.T) {
+	t.Parallel()
+
+	mut := sync.Mutex{}
+	numFinalizedBlocks := 0
+	numSaveBlockCalls := 0
+	args := createMockArgsQueuedDriver(t)
+	args.Driver = &mock.DriverStub{
+		SaveBlockCalled: func(outportBlock *outportcore.OutportBlock) error {
+			mut.Lock()
+			numSaveBlockCalls++
+			mut.Unlock()
+			return nil
+		},
+		FinalizedBlockCalled: func(finalizedBlock *outportcore.FinalizedBlock) error {
+			mut.Lock()
+			numFinalizedBlocks++
+			mut.Unlock()
+			return nil
+		},
+	}
+	qd, _ := NewQueuedDriver(args)
+
+	unknownTopicRecord, _ := file.EncodeRecord("unknown topic", []byte("payload"))
+	require.Nil(t, qd.queue.push(unknownTopicRecord))
+	undecodableRecord, _ := file.EncodeRecord(outportcore.TopicSaveBlock, []byte("not a block"))
+	require.Nil(t, qd.queue.push(undecodableRecord))
+	require.Nil(t, qd.FinalizedBlock(&outportcore.FinalizedBlock{}))
+
+	waitForEmptyQueue(t, qd)
+	require.Nil(t, qd.Close())
+
+	mut.Lock()
+	require.Equal(t, 1, numFinalizedBlocks)
+	require.Equal(t, 0, numSaveBlockCalls)
+	mut.Unlock()
+
+	records := readDeadLetterRecords(t, args.Path)
+	require.Equal(t, 2, len(records))
+	require.Equal(t, "unknown topic", records[0].Topic)
+	require.Equal(t, outportcore.TopicSaveBlock, records[1].Topic)
+	require.Equal(t, []byte("not a block"), records[1].Payload)
+}
+
+func TestQueuedDriver_ShouldSkipTheUnreadableRecords(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsQueuedDriver(t)
+	args.MaxSegmentSizeInBytes = 1
+
+	corruptedRecord, _ := file.EncodeRecord(outportcore.TopicFinalizedBlock, []byte("payload"))
+	corruptedRecord[len(corruptedRecord)-1]++
+	validRecord, _ := file.EncodeRecord(outportcore.TopicFinalizedBlock, []byte("{}"))
+
+	dq, err := newDiskQueue(args.Path, args.MaxSegmentSizeInBytes)
+	require.Nil(t, err)
+	require.Nil(t, dq.push(corruptedRecord))
+	require.Nil(t, dq.push(validRecord))
+	require.Nil(t, dq.close())
+
+	mut := sync.Mutex{}
+	numFinalizedBlocks := 0
+	args.Driver = &mock.DriverStub{
+		FinalizedBlockCalled: func(finalizedBlock *outportcore.FinalizedBlock) error {
+			mut.Lock()
+			numFinalizedBlocks++
+			mut.Unlock()
+			return nil
+		},
+	}
+	qd, err := NewQueuedDriver(args)
+	require.Nil(t, err)
+
+	waitForEmptyQueue(t, qd)
+	require.Nil(t, qd.Close())
+
+	mut.Lock()
+	require.Equal(t, 1, numFinalizedBlocks)
+	mut.Unlock()
+
+	buff, err := os.ReadFile(filepath.Join(args.Path, deadLetterFileName))
+	require.Nil(t, err)
+	require.Equal(t, corruptedRecord, buff)
+}
+
+func TestQueuedDriver_ShouldRedeliverAfterRestart(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsQueuedDriver(t)
+	args.Driver = &mock.DriverStub{
+		SaveBlockCalled: func(outportBlock *outportcore.OutportBlock) error {
+			return errors.New("driver unavailable")
+		},
+	}
+	qd, _ := NewQueuedDriver(args)
+	require.Nil(t, qd.SaveBlock(createOutportBlock(t, 1)))
+	require.Nil(t, qd.SaveBlock(createOutportBlock(t, 2)))
+	require.Nil(t, qd.Close())
+
+	mut := sync.Mutex{}
+	deliveredNonces := make([]uint64, 0)
+	args.Driver = &mock.DriverStub{
+		SaveBlockCalled: func(outportBlock *outportcore.OutportBlock) error {
+			header := &block.Header{}
+			err := marshallerMock.MarshalizerMock{}.Unmarshal(header, outportBlock.BlockData.HeaderBytes)
+			require.Nil(t, err)
+
+			mut.Lock()
+			deliveredNonces = append(deliveredNonces, header.Nonce)
+			mut.Unlock()
+
+			return nil
+		},
+	}
+	qd, err := NewQueuedDriver(args)
+	require.Nil(t, err)
+
+	waitForEmptyQueue(t, qd)
+	require.Nil(t, qd.Close())
+
+	mut.Lock()
+	require.Equal(t, []uint64{1, 2}, deliveredNonces)
+	mut.Unlock()
+
+	qd, err = NewQueuedDriver(args)
+	require.Nil(t, err)
+	nonce, hasNonce := qd.GetLastAckedNonce()
+	require.True(t, hasNonce)
+	require.Equal(t, uint64(2), nonce)
+	require.Nil(t, qd.Close())
+}
+
+func TestQueuedDriver_ShouldApplyBackpressure(t *testing.T) {
+	t.Parallel()
+
+	chanRelease := make(chan struct{})
+	args := createMockArgsQueuedDriver(t)
+	args.MaxQueueSizeInBytes = 1
+	args.Driver = &mock.DriverStub{
+		FinalizedBlockCalled: func(finalizedBlock *outportcore.FinalizedBlock) error {
+			<-chanRelease
+			return nil
+		},
+	}
+	qd, _ := NewQueuedDriver(args)
+
+	require.Nil(t, qd.FinalizedBlock(&outportcore.FinalizedBlock{}))
+
+	chanDone := make(chan error)
+	go func() {
+		chanDone <- qd.FinalizedBlock(&outportcore.FinalizedBlock{})
+	}()
+
+	select {
+	case <-chanDone:
+		require.Fail(t, "should have waited for the queue to be drained")
+	case <-time.After(time.Millisecond * 100):
+	}
+
+	close(chanRelease)
+	require.Nil(t, <-chanDone)
+
+	waitForEmptyQueue(t, qd)
+	require.Nil(t, qd.Close())
+}
+
+func TestQueuedDriver_CloseShouldCloseTheDriver(t *testing.T) {
+	t.Parallel()
+
+	numCloseCalls := 0
+	args := createMockArgsQueuedDriver(t)
+	args.Driver = &mock.DriverStub{
+		CloseCalled: func() error {
+			numCloseCalls++
+			return nil
+		},
+	}
+	qd, _ := NewQueuedDriver(args)
+
+	require.Nil(t, qd.Close())
+	require.Nil(t, qd.Close())
+	require.Equal(t, 1, numCloseCalls)
+
+	err := qd.SaveBlock(createOutportBlock(t, 1))
+	require.Equal(t, ErrQueueClosed, err)
+}
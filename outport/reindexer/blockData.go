@@ -0,0 +1,101 @@
+package reindexer
+
+import (
+	"github.com/multiversx/mx-chain-core-go/data"
+	"github.com/multiversx/mx-chain-core-go/data/block"
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/common/ordering"
+)
+
+type executionOrderCollection interface {
+	common.ExecutionOrderGetter
+	Add(item []byte)
+}
+
+// blockData holds the transactions of a block, as they were loaded from storage. It replaces the transaction
+// coordinator and the gas handler that provide this data when a block is processed
+type blockData struct {
+	txs              map[block.Type]map[string]data.TransactionHandler
+	logs             []*data.LogData
+	intraMiniBlocks  []*block.MiniBlock
+	executionOrder   executionOrderCollection
+	totalGasProvided uint64
+}
+
+func newBlockData() *blockData {
+	return &blockData{
+		txs:             make(map[block.Type]map[string]data.TransactionHandler),
+		logs:            make([]*data.LogData, 0),
+		intraMiniBlocks: make([]*block.MiniBlock, 0),
+		executionOrder:  ordering.NewOrderedCollection(),
+	}
+}
+
+func (bd *blockData) addTransaction(blockType block.Type, txHash []byte, tx data.TransactionHandler) {
+	txsOfType, found := bd.txs[blockType]
+	if !found {
+		txsOfType = make(map[string]data.TransactionHandler)
+		bd.txs[blockType] = txsOfType
+	}
+	txsOfType[string(txHash)] = tx
+
+	if blockType == block.TxBlock {
+		bd.totalGasProvided += tx.GetGasLimit()
+	}
+	if blockType != block.ReceiptBlock {
+		bd.executionOrder.Add(txHash)
+	}
+}
+
+func (bd *blockData) addLog(txHash []byte, txLog *transaction.Log) {
+	bd.logs = append(bd.logs, &data.LogData{
+		LogHandler: txLog,
+		TxHash:     string(txHash),
+	})
+}
+
+// GetAllCurrentUsedTxs returns the transactions of the provided type
+func (bd *blockData) GetAllCurrentUsedTxs(blockType block.Type) map[string]data.TransactionHandler {
+	txsOfType, found := bd.txs[blockType]
+	if !found {
+		return make(map[string]data.TransactionHandler)
+	}
+
+	return txsOfType
+}
+
+// GetAllCurrentLogs returns the logs of the block
+func (bd *blockData) GetAllCurrentLogs() []*data.LogData {
+	return bd.logs
+}
+
+// GetCreatedInShardMiniBlocks returns the intra shard miniblocks saved in the receipts storage
+func (bd *blockData) GetCreatedInShardMiniBlocks() []*block.MiniBlock {
+	return bd.intraMiniBlocks
+}
+
+// TotalGasProvided returns the sum of the gas limits of the block's transactions
+func (bd *blockData) TotalGasProvided() uint64 {
+	return bd.totalGasProvided
+}
+
+// TotalGasProvidedWithScheduled returns the sum of the gas limits of the block's transactions
+func (bd *blockData) TotalGasProvidedWithScheduled() uint64 {
+	return bd.totalGasProvided
+}
+
+// TotalGasRefunded returns 0 as the refunded gas is not kept in storage
+func (bd *blockData) TotalGasRefunded() uint64 {
+	return 0
+}
+
+// TotalGasPenalized returns 0 as the penalized gas is not kept in storage
+func (bd *blockData) TotalGasPenalized() uint64 {
+	return 0
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (bd *blockData) IsInterfaceNil() bool {
+	return bd == nil
+}
@@ -0,0 +1,200 @@
+package reindexer
+
+import (
+	"encoding/hex"
+	"fmt"
+
+	"github.com/multiversx/mx-chain-core-go/data"
+	"github.com/multiversx/mx-chain-core-go/data/block"
+	"github.com/multiversx/mx-chain-core-go/data/receipt"
+	"github.com/multiversx/mx-chain-core-go/data/rewardTx"
+	"github.com/multiversx/mx-chain-core-go/data/smartContractResult"
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	"github.com/multiversx/mx-chain-core-go/data/typeConverters"
+	"github.com/multiversx/mx-chain-core-go/marshal"
+	"github.com/multiversx/mx-chain-go/dataRetriever"
+	"github.com/multiversx/mx-chain-go/process"
+)
+
+type loadedBlock struct {
+	header     data.HeaderHandler
+	headerHash []byte
+	body       *block.Body
+	data       *blockData
+}
+
+type blockLoader struct {
+	shardID            uint32
+	store              dataRetriever.StorageService
+	marshaller         marshal.Marshalizer
+	uint64Converter    typeConverters.Uint64ByteSliceConverter
+	receiptsRepository ReceiptsRepository
+}
+
+// loadBlock rebuilds the header, the body and the transactions of the block with the provided nonce from storage
+func (bl *blockLoader) loadBlock(nonce uint64) (*loadedBlock, error) {
+	header, headerHash, err := process.GetHeaderFromStorageWithNonce(nonce, bl.shardID, bl.store, bl.uint64Converter, bl.marshaller)
+	if err != nil {
+		return nil, fmt.Errorf("%w while loading header with nonce %d", err, nonce)
+	}
+
+	loaded := &loadedBlock{
+		header:     header,
+		headerHash: headerHash,
+		body:       &block.Body{},
+		data:       newBlockData(),
+	}
+
+	for _, mbHeader := range header.GetMiniBlockHeaderHandlers() {
+		err = bl.loadMiniBlock(loaded, mbHeader)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	err = bl.loadIntraShardMiniBlocks(loaded)
+	if err != nil {
+		return nil, err
+	}
+
+	err = bl.loadLogs(loaded)
+	if err != nil {
+		return nil, err
+	}
+
+	return loaded, nil
+}
+
+func (bl *blockLoader) loadMiniBlock(loaded *loadedBlock, mbHeader data.MiniBlockHeaderHandler) error {
+	epoch := loaded.header.GetEpoch()
+	buff, err := bl.getFromStorerWithEpoch(dataRetriever.MiniBlockUnit, mbHeader.GetHash(), epoch)
+	if err != nil {
+		return fmt.Errorf("%w while loading miniblock %s", err, hex.EncodeToString(mbHeader.GetHash()))
+	}
+
+	miniBlock := &block.MiniBlock{}
+	err = bl.marshaller.Unmarshal(miniBlock, buff)
+	if err != nil {
+		return fmt.Errorf("%w while decoding miniblock %s", err, hex.EncodeToString(mbHeader.GetHash()))
+	}
+	loaded.body.MiniBlocks = append(loaded.body.MiniBlocks, miniBlock)
+
+	executedTxHashes := extractExecutedTxHashes(miniBlock.TxHashes, mbHeader.GetIndexOfFirstTxProcessed(), mbHeader.GetIndexOfLastTxProcessed())
+
+	return bl.loadTransactions(loaded.data, miniBlock.Type, executedTxHashes, epoch)
+}
+
+func (bl *blockLoader) loadIntraShardMiniBlocks(loaded *loadedBlock) error {
+	receiptsHolder, err := bl.receiptsRepository.LoadReceipts(loaded.header, loaded.headerHash)
+	if err != nil {
+		return fmt.Errorf("%w while loading the intra shard miniblocks", err)
+	}
+
+	for _, miniBlock := range receiptsHolder.GetMiniblocks() {
+		loaded.data.intraMiniBlocks = append(loaded.data.intraMiniBlocks, miniBlock)
+
+		err = bl.loadTransactions(loaded.data, miniBlock.Type, miniBlock.TxHashes, loaded.header.GetEpoch())
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (bl *blockLoader) loadTransactions(bd *blockData, blockType block.Type, txHashes [][]byte, epoch uint32) error {
+	unit, createTx, isKnown := getStorageDetails(blockType)
+	if !isKnown || len(txHashes) == 0 {
+		return nil
+	}
+
+	storer, err := bl.store.GetStorer(unit)
+	if err != nil {
+		return err
+	}
+
+	pairs, err := storer.GetBulkFromEpoch(txHashes, epoch)
+	if err != nil {
+		return fmt.Errorf("%w while loading transactions from %s", err, unit.String())
+	}
+
+	for _, pair := range pairs {
+		tx := createTx()
+		err = bl.marshaller.Unmarshal(tx, pair.Value)
+		if err != nil {
+			return fmt.Errorf("%w while decoding transaction %s", err, hex.EncodeToString(pair.Key))
+		}
+
+		bd.addTransaction(blockType, pair.Key, tx)
+	}
+
+	return nil
+}
+
+func (bl *blockLoader) loadLogs(loaded *loadedBlock) error {
+	txHashes := loaded.data.executionOrder.GetItems()
+	if len(txHashes) == 0 {
+		return nil
+	}
+
+	storer, err := bl.store.GetStorer(dataRetriever.TxLogsUnit)
+	if err != nil {
+		return err
+	}
+
+	pairs, err := storer.GetBulkFromEpoch(txHashes, loaded.header.GetEpoch())
+	if err != nil {
+		return fmt.Errorf("%w while loading logs", err)
+	}
+
+	for _, pair := range pairs {
+		txLog := &transaction.Log{}
+		err = bl.marshaller.Unmarshal(txLog, pair.Value)
+		if err != nil {
+			return fmt.Errorf("%w while decoding log %s", err, hex.EncodeToString(pair.Key))
+		}
+
+		loaded.data.addLog(pair.Key, txLog)
+	}
+
+	return nil
+}
+
+func (bl *blockLoader) getFromStorerWithEpoch(unit dataRetriever.UnitType, key []byte, epoch uint32) ([]byte, error) {
+	storer, err := bl.store.GetStorer(unit)
+	if err != nil {
+		return nil, err
+	}
+
+	return storer.GetFromEpoch(key, epoch)
+}
+
+func getStorageDetails(blockType block.Type) (dataRetriever.UnitType, func() data.TransactionHandler, bool) {
+	switch blockType {
+	case block.TxBlock, block.InvalidBlock:
+		return dataRetriever.TransactionUnit, func() data.TransactionHandler { return &transaction.Transaction{} }, true
+	case block.SmartContractResultBlock:
+		return dataRetriever.UnsignedTransactionUnit, func() data.TransactionHandler { return &smartContractResult.SmartContractResult{} }, true
+	case block.RewardsBlock:
+		return dataRetriever.RewardTransactionUnit, func() data.TransactionHandler { return &rewardTx.RewardTx{} }, true
+	case block.ReceiptBlock:
+		// receipts are saved in the unsigned transactions storer
+		return dataRetriever.UnsignedTransactionUnit, func() data.TransactionHandler { return &receipt.Receipt{} }, true
+	default:
+		return 0, nil, false
+	}
+}
+
+func extractExecutedTxHashes(txHashes [][]byte, firstProcessed, lastProcessed int32) [][]byte {
+	if len(txHashes) == 0 {
+		return txHashes
+	}
+
+	invalidIndexes := firstProcessed < 0 || lastProcessed >= int32(len(txHashes)) || firstProcessed > lastProcessed
+	if invalidIndexes {
+		log.Warn("extractExecutedTxHashes: invalid indexes, returning all tx hashes", "first", firstProcessed, "last", lastProcessed, "num hashes", len(txHashes))
+		return txHashes
+	}
+
+	return txHashes[firstProcessed : lastProcessed+1]
+}
@@ -0,0 +1,178 @@
+package reindexer
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/data"
+	"github.com/multiversx/mx-chain-core-go/data/block"
+	"github.com/multiversx/mx-chain-core-go/data/smartContractResult"
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	"github.com/multiversx/mx-chain-core-go/data/typeConverters/uint64ByteSlice"
+	"github.com/multiversx/mx-chain-core-go/marshal"
+	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/common/holders"
+	"github.com/multiversx/mx-chain-go/testscommon"
+	"github.com/multiversx/mx-chain-go/testscommon/genericMocks"
+	"github.com/stretchr/testify/require"
+)
+
+const testEpoch = uint32(2)
+
+type storedBlock struct {
+	nonce      uint64
+	headerHash []byte
+	txHash     []byte
+	scrHash    []byte
+}
+
+func storeTestBlock(t *testing.T, store *genericMocks.ChainStorerMock, nonce uint64) storedBlock {
+	marshaller := &marshal.GogoProtoMarshalizer{}
+	converter := uint64ByteSlice.NewBigEndianConverter()
+
+	stored := storedBlock{
+		nonce:      nonce,
+		headerHash: []byte("header" + string(converter.ToByteSlice(nonce))),
+		txHash:     []byte("tx" + string(converter.ToByteSlice(nonce))),
+		scrHash:    []byte("scr" + string(converter.ToByteSlice(nonce))),
+	}
+
+	tx := &transaction.Transaction{Nonce: nonce, GasLimit: 50000, SndAddr: []byte("sender"), RcvAddr: []byte("receiver")}
+	txBuff, err := marshaller.Marshal(tx)
+	require.Nil(t, err)
+	require.Nil(t, store.Transactions.PutInEpoch(stored.txHash, txBuff, testEpoch))
+
+	scr := &smartContractResult.SmartContractResult{Nonce: nonce, SndAddr: []byte("receiver"), RcvAddr: []byte("sender")}
+	scrBuff, err := marshaller.Marshal(scr)
+	require.Nil(t, err)
+	require.Nil(t, store.Unsigned.PutInEpoch(stored.scrHash, scrBuff, testEpoch))
+
+	txLog := &transaction.Log{Address: []byte("receiver"), Events: []*transaction.Event{{Identifier: []byte("event")}}}
+	logBuff, err := marshaller.Marshal(txLog)
+	require.Nil(t, err)
+	require.Nil(t, store.Logs.PutInEpoch(stored.txHash, logBuff, testEpoch))
+
+	miniBlock := &block.MiniBlock{TxHashes: [][]byte{stored.txHash}, Type: block.TxBlock}
+	mbBuff, err := marshaller.Marshal(miniBlock)
+	require.Nil(t, err)
+	mbHash := []byte("mb" + string(converter.ToByteSlice(nonce)))
+	require.Nil(t, store.Miniblocks.PutInEpoch(mbHash, mbBuff, testEpoch))
+
+	mbHeader := block.MiniBlockHeader{Hash: mbHash, TxCount: 1, Type: block.TxBlock}
+	require.Nil(t, mbHeader.SetIndexOfLastTxProcessed(0))
+
+	header := &block.HeaderV2{
+		Header: &block.Header{
+			Nonce:            nonce,
+			Round:            nonce,
+			Epoch:            testEpoch,
+			RootHash:         []byte("root hash"),
+			MiniBlockHeaders: []block.MiniBlockHeader{mbHeader},
+		},
+	}
+	headerBuff, err := marshaller.Marshal(header)
+	require.Nil(t, err)
+	require.Nil(t, store.BlockHeaders.Put(stored.headerHash, headerBuff))
+	require.Nil(t, store.ShardHdrNonce.Put(converter.ToByteSlice(nonce), stored.headerHash))
+
+	return stored
+}
+
+func createTestReceiptsRepository(scrHashes map[string][]byte) *testscommon.ReceiptsRepositoryStub {
+	return &testscommon.ReceiptsRepositoryStub{
+		LoadReceiptsCalled: func(header data.HeaderHandler, headerHash []byte) (common.ReceiptsHolder, error) {
+			scrHash, found := scrHashes[string(headerHash)]
+			if !found {
+				return holders.NewReceiptsHolder(nil), nil
+			}
+
+			return holders.NewReceiptsHolder([]*block.MiniBlock{
+				{TxHashes: [][]byte{scrHash}, Type: block.SmartContractResultBlock},
+			}), nil
+		},
+	}
+}
+
+func createTestBlockLoader(store *genericMocks.ChainStorerMock, receiptsRepository ReceiptsRepository) *blockLoader {
+	return &blockLoader{
+		shardID:            0,
+		store:              store,
+		marshaller:         &marshal.GogoProtoMarshalizer{},
+		uint64Converter:    uint64ByteSlice.NewBigEndianConverter(),
+		receiptsRepository: receiptsRepository,
+	}
+}
+
+func TestBlockLoader_LoadBlock(t *testing.T) {
+	t.Parallel()
+
+	t.Run("missing header should error", func(t *testing.T) {
+		t.Parallel()
+
+		store := genericMocks.NewChainStorerMock(testEpoch)
+		loader := createTestBlockLoader(store, &testscommon.ReceiptsRepositoryStub{})
+
+		loaded, err := loader.loadBlock(1)
+		require.NotNil(t, err)
+		require.Nil(t, loaded)
+	})
+	t.Run("receipts repository error should error", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		store := genericMocks.NewChainStorerMock(testEpoch)
+		storeTestBlock(t, store, 1)
+		loader := createTestBlockLoader(store, &testscommon.ReceiptsRepositoryStub{
+			LoadReceiptsCalled: func(header data.HeaderHandler, headerHash []byte) (common.ReceiptsHolder, error) {
+				return nil, expectedErr
+			},
+		})
+
+		loaded, err := loader.loadBlock(1)
+		require.ErrorIs(t, err, expectedErr)
+		require.Nil(t, loaded)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		store := genericMocks.NewChainStorerMock(testEpoch)
+		stored := storeTestBlock(t, store, 1)
+		loader := createTestBlockLoader(store, createTestReceiptsRepository(map[string][]byte{
+			string(stored.headerHash): stored.scrHash,
+		}))
+
+		loaded, err := loader.loadBlock(1)
+		require.Nil(t, err)
+		require.Equal(t, stored.headerHash, loaded.headerHash)
+		require.Equal(t, uint64(1), loaded.header.GetNonce())
+		require.Equal(t, 1, len(loaded.body.MiniBlocks))
+
+		txs := loaded.data.GetAllCurrentUsedTxs(block.TxBlock)
+		require.Equal(t, 1, len(txs))
+		require.Equal(t, uint64(50000), txs[string(stored.txHash)].GetGasLimit())
+		require.Equal(t, uint64(50000), loaded.data.TotalGasProvided())
+
+		scrs := loaded.data.GetAllCurrentUsedTxs(block.SmartContractResultBlock)
+		require.Equal(t, 1, len(scrs))
+		require.Equal(t, 1, len(loaded.data.GetCreatedInShardMiniBlocks()))
+
+		require.Equal(t, [][]byte{stored.txHash, stored.scrHash}, loaded.data.executionOrder.GetItems())
+
+		logs := loaded.data.GetAllCurrentLogs()
+		require.Equal(t, 1, len(logs))
+		require.Equal(t, string(stored.txHash), logs[0].TxHash)
+	})
+}
+
+func TestExtractExecutedTxHashes(t *testing.T) {
+	t.Parallel()
+
+	txHashes := [][]byte{[]byte("a"), []byte("b"), []byte("c")}
+
+	require.Equal(t, txHashes[1:3], extractExecutedTxHashes(txHashes, 1, 2))
+	require.Equal(t, txHashes, extractExecutedTxHashes(txHashes, 0, 2))
+	require.Equal(t, txHashes, extractExecutedTxHashes(txHashes, -1, 2))
+	require.Equal(t, txHashes, extractExecutedTxHashes(txHashes, 0, 3))
+	require.Equal(t, txHashes, extractExecutedTxHashes(txHashes, 2, 1))
+	require.Equal(t, 0, len(extractExecutedTxHashes(nil, 0, 0)))
+}
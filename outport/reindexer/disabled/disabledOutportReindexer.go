@@ -0,0 +1,40 @@
+package disabled
+
+import (
+	"errors"
+
+	"github.com/multiversx/mx-chain-go/common"
+)
+
+const statusDisabled = "disabled"
+
+var errOutportReindexingDisabled = errors.New("outport re-indexing is disabled")
+
+type disabledOutportReindexer struct{}
+
+// NewDisabledOutportReindexer will create a new instance of disabledOutportReindexer
+func NewDisabledOutportReindexer() *disabledOutportReindexer {
+	return &disabledOutportReindexer{}
+}
+
+// StartReindexing returns an error as the re-indexing is disabled
+func (d *disabledOutportReindexer) StartReindexing(_ uint64, _ uint64) error {
+	return errOutportReindexingDisabled
+}
+
+// GetReindexingStatus returns the disabled status
+func (d *disabledOutportReindexer) GetReindexingStatus() common.OutportReindexStatus {
+	return common.OutportReindexStatus{
+		Status: statusDisabled,
+	}
+}
+
+// Close does nothing
+func (d *disabledOutportReindexer) Close() error {
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (d *disabledOutportReindexer) IsInterfaceNil() bool {
+	return d == nil
+}
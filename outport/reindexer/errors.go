@@ -0,0 +1,27 @@
+package reindexer
+
+import "errors"
+
+var errNilStorageService = errors.New("nil storage service")
+
+var errNilUint64Converter = errors.New("nil uint64 byte slice converter")
+
+var errNilReceiptsRepository = errors.New("nil receipts repository")
+
+var errNilAccountsRepository = errors.New("nil accounts repository")
+
+var errNilAlteredAccountsProvider = errors.New("nil altered accounts provider")
+
+var errNilTransactionsFeeProcessor = errors.New("nil transactions fee processor")
+
+var errNoResumeFile = errors.New("no resume file configured")
+
+var errNilOutportHandler = errors.New("nil outport handler")
+
+var errInvalidNonceRange = errors.New("invalid nonce range")
+
+var errReindexingInProgress = errors.New("re-indexing already in progress")
+
+var errNoOutportDrivers = errors.New("no outport drivers are enabled")
+
+var errReindexerClosed = errors.New("re-indexer is closed")
@@ -0,0 +1,127 @@
+package reindexer
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-core-go/data/block"
+	"github.com/multiversx/mx-chain-core-go/marshal"
+	"github.com/multiversx/mx-chain-go/dataRetriever"
+	"github.com/multiversx/mx-chain-go/sharding/nodesCoordinator"
+)
+
+// historicalNodesCoordinator wraps the nodes coordinator of the node, which keeps in memory only the nodes configs of
+// the last epochs. For older epochs, the consensus group and the signers indexes are computed from the nodes
+// coordinator registry saved in storage at the start of the epoch
+type historicalNodesCoordinator struct {
+	nodesCoordinator.NodesCoordinator
+	historicalHandler historicalNodesCoordinatorHandler
+	shardID           uint32
+	store             dataRetriever.StorageService
+	marshaller        marshal.Marshalizer
+
+	mutEpochStartMetaBlock   sync.Mutex
+	epochStartMetaBlock      *block.MetaBlock
+	epochStartMetaBlockEpoch uint32
+}
+
+func newHistoricalNodesCoordinator(
+	nc nodesCoordinator.NodesCoordinator,
+	shardID uint32,
+	store dataRetriever.StorageService,
+	marshaller marshal.Marshalizer,
+) *historicalNodesCoordinator {
+	historicalHandler, ok := nc.(historicalNodesCoordinatorHandler)
+	if !ok {
+		log.Debug("historicalNodesCoordinator: the nodes coordinator can not compute the consensus of old epochs", "type", fmt.Sprintf("%T", nc))
+	}
+
+	return &historicalNodesCoordinator{
+		NodesCoordinator:  nc,
+		historicalHandler: historicalHandler,
+		shardID:           shardID,
+		store:             store,
+		marshaller:        marshaller,
+	}
+}
+
+// GetConsensusValidatorsPublicKeys returns the public keys of the consensus group, falling back to the saved registry
+// if the nodes coordinator no longer holds the nodes config of the epoch
+func (hnc *historicalNodesCoordinator) GetConsensusValidatorsPublicKeys(
+	randomness []byte,
+	round uint64,
+	shardID uint32,
+	epoch uint32,
+) ([]string, error) {
+	pubKeys, err := hnc.NodesCoordinator.GetConsensusValidatorsPublicKeys(randomness, round, shardID, epoch)
+	if !hnc.shouldUseHistory(err) {
+		return pubKeys, err
+	}
+
+	epochStartMetaBlock, err := hnc.getEpochStartMetaBlock(epoch)
+	if err != nil {
+		return nil, err
+	}
+
+	return hnc.historicalHandler.GetHistoricalConsensusValidatorsPublicKeys(epochStartMetaBlock, randomness, round, shardID, epoch)
+}
+
+// GetValidatorsIndexes returns the indexes of the provided public keys in the eligible list of the self shard, falling
+// back to the saved registry if the nodes coordinator no longer holds the nodes config of the epoch
+func (hnc *historicalNodesCoordinator) GetValidatorsIndexes(publicKeys []string, epoch uint32) ([]uint64, error) {
+	indexes, err := hnc.NodesCoordinator.GetValidatorsIndexes(publicKeys, epoch)
+	if !hnc.shouldUseHistory(err) {
+		return indexes, err
+	}
+
+	epochStartMetaBlock, err := hnc.getEpochStartMetaBlock(epoch)
+	if err != nil {
+		return nil, err
+	}
+
+	return hnc.historicalHandler.GetHistoricalValidatorsIndexes(epochStartMetaBlock, publicKeys, hnc.shardID, epoch)
+}
+
+func (hnc *historicalNodesCoordinator) shouldUseHistory(err error) bool {
+	return errors.Is(err, nodesCoordinator.ErrEpochNodesConfigDoesNotExist) && !check.IfNil(hnc.historicalHandler)
+}
+
+// getEpochStartMetaBlock loads the meta block whose randomness was used as key for the registry holding the nodes
+// config of the provided epoch. The config of the genesis epoch is found in the registry saved at the start of epoch 1
+func (hnc *historicalNodesCoordinator) getEpochStartMetaBlock(epoch uint32) (*block.MetaBlock, error) {
+	registryEpoch := epoch
+	if registryEpoch == 0 {
+		registryEpoch = 1
+	}
+
+	hnc.mutEpochStartMetaBlock.Lock()
+	defer hnc.mutEpochStartMetaBlock.Unlock()
+
+	if hnc.epochStartMetaBlock != nil && hnc.epochStartMetaBlockEpoch == registryEpoch {
+		return hnc.epochStartMetaBlock, nil
+	}
+
+	storer, err := hnc.store.GetStorer(dataRetriever.MetaBlockUnit)
+	if err != nil {
+		return nil, err
+	}
+
+	buff, err := storer.GetFromEpoch([]byte(core.EpochStartIdentifier(registryEpoch)), registryEpoch)
+	if err != nil {
+		return nil, fmt.Errorf("%w while loading the epoch start meta block of epoch %d", err, registryEpoch)
+	}
+
+	metaBlock := &block.MetaBlock{}
+	err = hnc.marshaller.Unmarshal(metaBlock, buff)
+	if err != nil {
+		return nil, fmt.Errorf("%w while decoding the epoch start meta block of epoch %d", err, registryEpoch)
+	}
+
+	hnc.epochStartMetaBlock = metaBlock
+	hnc.epochStartMetaBlockEpoch = registryEpoch
+
+	return metaBlock, nil
+}
@@ -0,0 +1,169 @@
+package reindexer
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/data"
+	"github.com/multiversx/mx-chain-core-go/data/block"
+	"github.com/multiversx/mx-chain-core-go/marshal"
+	"github.com/multiversx/mx-chain-go/sharding/nodesCoordinator"
+	"github.com/multiversx/mx-chain-go/testscommon/genericMocks"
+	"github.com/multiversx/mx-chain-go/testscommon/shardingMocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type nodesCoordinatorWithHistoryStub struct {
+	*shardingMocks.NodesCoordinatorMock
+	GetHistoricalConsensusValidatorsPublicKeysCalled func(epochStartMetaBlock data.HeaderHandler, randomness []byte, round uint64, shardID uint32, epoch uint32) ([]string, error)
+	GetHistoricalValidatorsIndexesCalled             func(epochStartMetaBlock data.HeaderHandler, publicKeys []string, shardID uint32, epoch uint32) ([]uint64, error)
+}
+
+func (stub *nodesCoordinatorWithHistoryStub) GetHistoricalConsensusValidatorsPublicKeys(
+	epochStartMetaBlock data.HeaderHandler,
+	randomness []byte,
+	round uint64,
+	shardID uint32,
+	epoch uint32,
+) ([]string, error) {
+	return stub.GetHistoricalConsensusValidatorsPublicKeysCalled(epochStartMetaBlock, randomness, round, shardID, epoch)
+}
+
+func (stub *nodesCoordinatorWithHistoryStub) GetHistoricalValidatorsIndexes(
+	epochStartMetaBlock data.HeaderHandler,
+	publicKeys []string,
+	shardID uint32,
+	epoch uint32,
+) ([]uint64, error) {
+	return stub.GetHistoricalValidatorsIndexesCalled(epochStartMetaBlock, publicKeys, shardID, epoch)
+}
+
+func TestHistoricalNodesCoordinator(t *testing.T) {
+	t.Parallel()
+
+	marshaller := &marshal.GogoProtoMarshalizer{}
+	randomness := []byte("randomness")
+	errMissingEpoch := fmt.Errorf("%w epoch=%v", nodesCoordinator.ErrEpochNodesConfigDoesNotExist, 0)
+	createLiveNodesCoordinator := func() *shardingMocks.NodesCoordinatorMock {
+		return &shardingMocks.NodesCoordinatorMock{
+			GetValidatorsPublicKeysCalled: func(randomness []byte, round uint64, shardId uint32, epoch uint32) ([]string, error) {
+				if epoch < testEpoch {
+					return nil, errMissingEpoch
+				}
+				return []string{"live"}, nil
+			},
+			GetValidatorsIndexesCalled: func(publicKeys []string, epoch uint32) ([]uint64, error) {
+				if epoch < testEpoch {
+					return nil, errMissingEpoch
+				}
+				return []uint64{7}, nil
+			},
+		}
+	}
+	createStoreWithEpochStartMetaBlocks := func() *genericMocks.ChainStorerMock {
+		store := genericMocks.NewChainStorerMock(testEpoch)
+		for epoch := uint32(1); epoch <= testEpoch; epoch++ {
+			metaBlock := &block.MetaBlock{
+				Epoch:        epoch,
+				PrevRandSeed: []byte(fmt.Sprintf("rand seed %d", epoch)),
+			}
+			buff, _ := marshaller.Marshal(metaBlock)
+			_ = store.Metablocks.PutInEpoch([]byte(core.EpochStartIdentifier(epoch)), buff, epoch)
+		}
+
+		return store
+	}
+
+	t.Run("kept epoch should use the live nodes coordinator", func(t *testing.T) {
+		t.Parallel()
+
+		nc := &nodesCoordinatorWithHistoryStub{
+			NodesCoordinatorMock: createLiveNodesCoordinator(),
+			GetHistoricalConsensusValidatorsPublicKeysCalled: func(_ data.HeaderHandler, _ []byte, _ uint64, _ uint32, _ uint32) ([]string, error) {
+				require.Fail(t, "should not have been called")
+				return nil, nil
+			},
+		}
+		hnc := newHistoricalNodesCoordinator(nc, 1, createStoreWithEpochStartMetaBlocks(), marshaller)
+
+		pubKeys, err := hnc.GetConsensusValidatorsPublicKeys(randomness, 10, 1, testEpoch)
+		assert.Nil(t, err)
+		assert.Equal(t, []string{"live"}, pubKeys)
+	})
+	t.Run("old epoch should use the saved registry", func(t *testing.T) {
+		t.Parallel()
+
+		numHistoricalCalls := 0
+		nc := &nodesCoordinatorWithHistoryStub{
+			NodesCoordinatorMock: createLiveNodesCoordinator(),
+			GetHistoricalConsensusValidatorsPublicKeysCalled: func(epochStartMetaBlock data.HeaderHandler, rand []byte, round uint64, shardID uint32, epoch uint32) ([]string, error) {
+				numHistoricalCalls++
+				assert.Equal(t, []byte("rand seed 1"), epochStartMetaBlock.GetPrevRandSeed())
+				assert.Equal(t, randomness, rand)
+				assert.Equal(t, uint64(10), round)
+				assert.Equal(t, uint32(1), shardID)
+				assert.Equal(t, uint32(1), epoch)
+				return []string{"historical"}, nil
+			},
+			GetHistoricalValidatorsIndexesCalled: func(epochStartMetaBlock data.HeaderHandler, publicKeys []string, shardID uint32, epoch uint32) ([]uint64, error) {
+				numHistoricalCalls++
+				assert.Equal(t, []byte("rand seed 1"), epochStartMetaBlock.GetPrevRandSeed())
+				assert.Equal(t, []string{"historical"}, publicKeys)
+				assert.Equal(t, uint32(1), shardID)
+				assert.Equal(t, uint32(1), epoch)
+				return []uint64{3}, nil
+			},
+		}
+		hnc := newHistoricalNodesCoordinator(nc, 1, createStoreWithEpochStartMetaBlocks(), marshaller)
+
+		pubKeys, err := hnc.GetConsensusValidatorsPublicKeys(randomness, 10, 1, 1)
+		assert.Nil(t, err)
+		assert.Equal(t, []string{"historical"}, pubKeys)
+
+		indexes, err := hnc.GetValidatorsIndexes(pubKeys, 1)
+		assert.Nil(t, err)
+		assert.Equal(t, []uint64{3}, indexes)
+		assert.Equal(t, 2, numHistoricalCalls)
+	})
+	t.Run("genesis epoch should use the registry saved at the start of epoch 1", func(t *testing.T) {
+		t.Parallel()
+
+		nc := &nodesCoordinatorWithHistoryStub{
+			NodesCoordinatorMock: createLiveNodesCoordinator(),
+			GetHistoricalConsensusValidatorsPublicKeysCalled: func(epochStartMetaBlock data.HeaderHandler, _ []byte, _ uint64, _ uint32, epoch uint32) ([]string, error) {
+				assert.Equal(t, uint32(1), epochStartMetaBlock.GetEpoch())
+				assert.Equal(t, uint32(0), epoch)
+				return []string{"genesis"}, nil
+			},
+		}
+		hnc := newHistoricalNodesCoordinator(nc, 1, createStoreWithEpochStartMetaBlocks(), marshaller)
+
+		pubKeys, err := hnc.GetConsensusValidatorsPublicKeys(randomness, 10, 1, 0)
+		assert.Nil(t, err)
+		assert.Equal(t, []string{"genesis"}, pubKeys)
+	})
+	t.Run("missing epoch start meta block should error", func(t *testing.T) {
+		t.Parallel()
+
+		nc := &nodesCoordinatorWithHistoryStub{
+			NodesCoordinatorMock: createLiveNodesCoordinator(),
+		}
+		hnc := newHistoricalNodesCoordinator(nc, 1, genericMocks.NewChainStorerMock(testEpoch), marshaller)
+
+		pubKeys, err := hnc.GetConsensusValidatorsPublicKeys(randomness, 10, 1, 1)
+		assert.NotNil(t, err)
+		assert.Nil(t, pubKeys)
+	})
+	t.Run("nodes coordinator without history should return the original error", func(t *testing.T) {
+		t.Parallel()
+
+		hnc := newHistoricalNodesCoordinator(createLiveNodesCoordinator(), 1, createStoreWithEpochStartMetaBlocks(), marshaller)
+
+		pubKeys, err := hnc.GetConsensusValidatorsPublicKeys(randomness, 10, 1, 1)
+		assert.True(t, errors.Is(err, nodesCoordinator.ErrEpochNodesConfigDoesNotExist))
+		assert.Nil(t, pubKeys)
+	})
+}
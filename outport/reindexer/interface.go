@@ -0,0 +1,20 @@
+package reindexer
+
+import (
+	"github.com/multiversx/mx-chain-core-go/data"
+	"github.com/multiversx/mx-chain-go/common"
+)
+
+// ReceiptsRepository defines the functionality needed for loading the intra shard miniblocks saved in the receipts storage
+type ReceiptsRepository interface {
+	LoadReceipts(header data.HeaderHandler, headerHash []byte) (common.ReceiptsHolder, error)
+	IsInterfaceNil() bool
+}
+
+// historicalNodesCoordinatorHandler defines the functionality needed for computing the consensus group and the signers
+// indexes of the epochs whose nodes config is no longer kept in memory by the nodes coordinator
+type historicalNodesCoordinatorHandler interface {
+	GetHistoricalConsensusValidatorsPublicKeys(epochStartMetaBlock data.HeaderHandler, randomness []byte, round uint64, shardID uint32, epoch uint32) ([]string, error)
+	GetHistoricalValidatorsIndexes(epochStartMetaBlock data.HeaderHandler, publicKeys []string, shardID uint32, epoch uint32) ([]uint64, error)
+	IsInterfaceNil() bool
+}
@@ -0,0 +1,423 @@
+package reindexer
+
+import (
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-core-go/data"
+	"github.com/multiversx/mx-chain-core-go/data/block"
+	outportcore "github.com/multiversx/mx-chain-core-go/data/outport"
+	"github.com/multiversx/mx-chain-core-go/data/typeConverters"
+	"github.com/multiversx/mx-chain-core-go/hashing"
+	"github.com/multiversx/mx-chain-core-go/marshal"
+	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/dataRetriever"
+	"github.com/multiversx/mx-chain-go/outport"
+	outportProcess "github.com/multiversx/mx-chain-go/outport/process"
+	"github.com/multiversx/mx-chain-go/process"
+	"github.com/multiversx/mx-chain-go/sharding"
+	"github.com/multiversx/mx-chain-go/sharding/nodesCoordinator"
+	"github.com/multiversx/mx-chain-go/state"
+	logger "github.com/multiversx/mx-chain-logger-go"
+)
+
+var log = logger.GetOrCreate("outport/reindexer")
+
+const (
+	// StatusIdle signals that no re-indexing job was started
+	StatusIdle = "idle"
+	// StatusInProgress signals that a re-indexing job is running
+	StatusInProgress = "in progress"
+	// StatusFinished signals that the last re-indexing job pushed all the blocks of its range
+	StatusFinished = "finished"
+	// StatusFailed signals that the last re-indexing job stopped because of an error
+	StatusFailed = "failed"
+	// StatusStopped signals that the last re-indexing job was stopped before reaching the end of its range
+	StatusStopped = "stopped"
+)
+
+// ArgsOutportReindexer holds the arguments needed for creating a new outportReindexer
+type ArgsOutportReindexer struct {
+	MaxBlocksPerSecond       uint32
+	ResumeFilePath           string
+	ShardCoordinator         sharding.Coordinator
+	NodesCoordinator         nodesCoordinator.NodesCoordinator
+	StorageService           dataRetriever.StorageService
+	ReceiptsRepository       ReceiptsRepository
+	AccountsRepository       state.AccountsRepository
+	AlteredAccountsProvider  outportProcess.AlteredAccountsProviderHandler
+	TransactionsFeeProcessor outportProcess.TransactionsFeeHandler
+	EconomicsData            outportProcess.EconomicsDataHandler
+	OutportHandler           outport.OutportHandler
+	Marshaller               marshal.Marshalizer
+	Hasher                   hashing.Hasher
+	Uint64Converter          typeConverters.Uint64ByteSliceConverter
+}
+
+type resumePoint struct {
+	StartNonce       uint64 `json:"startNonce"`
+	EndNonce         uint64 `json:"endNonce"`
+	LastIndexedNonce uint64 `json:"lastIndexedNonce"`
+}
+
+type outportReindexer struct {
+	shardCoordinator         sharding.Coordinator
+	nodesCoordinator         nodesCoordinator.NodesCoordinator
+	accountsRepository       state.AccountsRepository
+	alteredAccountsProvider  outportProcess.AlteredAccountsProviderHandler
+	transactionsFeeProcessor outportProcess.TransactionsFeeHandler
+	economicsData            outportProcess.EconomicsDataHandler
+	outportHandler           outport.OutportHandler
+	marshaller               marshal.Marshalizer
+	hasher                   hashing.Hasher
+	loader                   *blockLoader
+	resumeFilePath           string
+	timeBetweenBlocks        time.Duration
+
+	mutState   sync.RWMutex
+	status     common.OutportReindexStatus
+	isRunning  bool
+	isClosed   bool
+	cancelFunc func()
+}
+
+// NewOutportReindexer creates a component able to push historical blocks, rebuilt from storage, to the outport drivers
+func NewOutportReindexer(args ArgsOutportReindexer) (*outportReindexer, error) {
+	err := checkArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	timeBetweenBlocks := time.Duration(0)
+	if args.MaxBlocksPerSecond > 0 {
+		timeBetweenBlocks = time.Second / time.Duration(args.MaxBlocksPerSecond)
+	}
+
+	return &outportReindexer{
+		shardCoordinator:         args.ShardCoordinator,
+		nodesCoordinator:         newHistoricalNodesCoordinator(args.NodesCoordinator, args.ShardCoordinator.SelfId(), args.StorageService, args.Marshaller),
+		accountsRepository:       args.AccountsRepository,
+		alteredAccountsProvider:  args.AlteredAccountsProvider,
+		transactionsFeeProcessor: args.TransactionsFeeProcessor,
+		economicsData:            args.EconomicsData,
+		outportHandler:           args.OutportHandler,
+		marshaller:               args.Marshaller,
+		hasher:                   args.Hasher,
+		loader: &blockLoader{
+			shardID:            args.ShardCoordinator.SelfId(),
+			store:              args.StorageService,
+			marshaller:         args.Marshaller,
+			uint64Converter:    args.Uint64Converter,
+			receiptsRepository: args.ReceiptsRepository,
+		},
+		resumeFilePath:    args.ResumeFilePath,
+		timeBetweenBlocks: timeBetweenBlocks,
+		status: common.OutportReindexStatus{
+			Status: StatusIdle,
+		},
+	}, nil
+}
+
+func checkArgs(args ArgsOutportReindexer) error {
+	if check.IfNil(args.ShardCoordinator) {
+		return process.ErrNilShardCoordinator
+	}
+	if check.IfNil(args.NodesCoordinator) {
+		return process.ErrNilNodesCoordinator
+	}
+	if check.IfNil(args.StorageService) {
+		return errNilStorageService
+	}
+	if check.IfNil(args.ReceiptsRepository) {
+		return errNilReceiptsRepository
+	}
+	if check.IfNil(args.AccountsRepository) {
+		return errNilAccountsRepository
+	}
+	if check.IfNil(args.AlteredAccountsProvider) {
+		return errNilAlteredAccountsProvider
+	}
+	if check.IfNil(args.TransactionsFeeProcessor) {
+		return errNilTransactionsFeeProcessor
+	}
+	if check.IfNil(args.EconomicsData) {
+		return process.ErrNilEconomicsData
+	}
+	if check.IfNil(args.OutportHandler) {
+		return errNilOutportHandler
+	}
+	if check.IfNil(args.Marshaller) {
+		return process.ErrNilMarshalizer
+	}
+	if check.IfNil(args.Hasher) {
+		return process.ErrNilHasher
+	}
+	if check.IfNil(args.Uint64Converter) {
+		return errNilUint64Converter
+	}
+
+	return nil
+}
+
+// StartReindexing starts pushing the blocks in the provided nonce range to the outport drivers, in background.
+// If the resume file holds the progress of an unfinished job with the same range, the job continues from there
+func (or *outportReindexer) StartReindexing(startNonce uint64, endNonce uint64) error {
+	if startNonce == 0 || endNonce < startNonce {
+		return fmt.Errorf("%w: start nonce %d, end nonce %d", errInvalidNonceRange, startNonce, endNonce)
+	}
+	if !or.outportHandler.HasDrivers() {
+		return errNoOutportDrivers
+	}
+
+	or.mutState.Lock()
+	defer or.mutState.Unlock()
+
+	if or.isClosed {
+		return errReindexerClosed
+	}
+	if or.isRunning {
+		return errReindexingInProgress
+	}
+
+	firstNonce := or.computeFirstNonce(startNonce, endNonce)
+	ctx, cancelFunc := context.WithCancel(context.Background())
+	or.cancelFunc = cancelFunc
+	or.isRunning = true
+	or.status = common.OutportReindexStatus{
+		Status:           StatusInProgress,
+		StartNonce:       startNonce,
+		EndNonce:         endNonce,
+		LastIndexedNonce: firstNonce - 1,
+	}
+
+	log.Info("outport re-indexing started", "start nonce", startNonce, "end nonce", endNonce, "first nonce", firstNonce)
+
+	go or.reindex(ctx, firstNonce, endNonce)
+
+	return nil
+}
+
+func (or *outportReindexer) computeFirstNonce(startNonce uint64, endNonce uint64) uint64 {
+	point, err := or.loadResumePoint()
+	if err != nil {
+		log.Debug("outportReindexer: no resume point loaded", "error", err)
+		return startNonce
+	}
+
+	isSameJob := point.StartNonce == startNonce && point.EndNonce == endNonce
+	isUnfinished := point.LastIndexedNonce >= startNonce && point.LastIndexedNonce < endNonce
+	if isSameJob && isUnfinished {
+		return point.LastIndexedNonce + 1
+	}
+
+	return startNonce
+}
+
+func (or *outportReindexer) reindex(ctx context.Context, firstNonce uint64, endNonce uint64) {
+	for nonce := firstNonce; nonce <= endNonce; nonce++ {
+		select {
+		case <-ctx.Done():
+			or.finish(StatusStopped, nil)
+			return
+		default:
+		}
+
+		startTime := time.Now()
+		err := or.reindexBlock(nonce)
+		if err != nil {
+			or.finish(StatusFailed, err)
+			return
+		}
+
+		or.markBlockIndexed(nonce)
+
+		if !or.waitForNextBlock(ctx, time.Since(startTime)) {
+			or.finish(StatusStopped, nil)
+			return
+		}
+	}
+
+	or.finish(StatusFinished, nil)
+}
+
+func (or *outportReindexer) reindexBlock(nonce uint64) error {
+	loaded, err := or.loader.loadBlock(nonce)
+	if err != nil {
+		return err
+	}
+
+	dataProvider, err := outportProcess.NewOutportDataProvider(outportProcess.ArgOutportDataProvider{
+		ShardCoordinator:         or.shardCoordinator,
+		AlteredAccountsProvider:  or.alteredAccountsProvider,
+		TransactionsFeeProcessor: or.transactionsFeeProcessor,
+		TxCoordinator:            loaded.data,
+		NodesCoordinator:         or.nodesCoordinator,
+		GasConsumedProvider:      loaded.data,
+		EconomicsData:            or.economicsData,
+		ExecutionOrderHandler:    loaded.data.executionOrder,
+		Marshaller:               or.marshaller,
+		Hasher:                   or.hasher,
+	})
+	if err != nil {
+		return err
+	}
+
+	argSaveBlock, err := dataProvider.PrepareOutportSaveBlockData(outportProcess.ArgPrepareOutportSaveBlockData{
+		HeaderHash:                   loaded.headerHash,
+		Header:                       loaded.header,
+		Body:                         loaded.body,
+		RewardsTxs:                   loaded.data.GetAllCurrentUsedTxs(block.RewardsBlock),
+		NotarizedHeadersHashes:       getNotarizedHeadersHashes(loaded.header),
+		HighestFinalBlockNonce:       loaded.header.GetNonce(),
+		HighestFinalBlockHash:        loaded.headerHash,
+		HistoricalAccountsRepository: or.accountsRepository,
+	})
+	if err != nil {
+		return fmt.Errorf("%w while preparing block with nonce %d", err, nonce)
+	}
+
+	err = or.outportHandler.SaveBlock(argSaveBlock)
+	if err != nil {
+		return fmt.Errorf("%w while saving block with nonce %d", err, nonce)
+	}
+
+	or.outportHandler.FinalizedBlock(&outportcore.FinalizedBlock{
+		ShardID:    or.shardCoordinator.SelfId(),
+		HeaderHash: loaded.headerHash,
+	})
+
+	log.Debug("outportReindexer: re-indexed block", "nonce", nonce, "hash", loaded.headerHash)
+
+	return nil
+}
+
+func getNotarizedHeadersHashes(header data.HeaderHandler) []string {
+	metaBlock, isMetaBlock := header.(*block.MetaBlock)
+	if !isMetaBlock {
+		return nil
+	}
+
+	notarizedHeadersHashes := make([]string, 0, len(metaBlock.ShardInfo))
+	for _, shardData := range metaBlock.ShardInfo {
+		notarizedHeadersHashes = append(notarizedHeadersHashes, hex.EncodeToString(shardData.HeaderHash))
+	}
+
+	return notarizedHeadersHashes
+}
+
+func (or *outportReindexer) waitForNextBlock(ctx context.Context, elapsed time.Duration) bool {
+	if elapsed >= or.timeBetweenBlocks {
+		return true
+	}
+
+	timer := time.NewTimer(or.timeBetweenBlocks - elapsed)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return false
+	case <-timer.C:
+		return true
+	}
+}
+
+func (or *outportReindexer) markBlockIndexed(nonce uint64) {
+	or.mutState.Lock()
+	or.status.LastIndexedNonce = nonce
+	or.status.NumIndexedBlocks++
+	point := resumePoint{
+		StartNonce:       or.status.StartNonce,
+		EndNonce:         or.status.EndNonce,
+		LastIndexedNonce: nonce,
+	}
+	or.mutState.Unlock()
+
+	err := or.saveResumePoint(point)
+	if err != nil {
+		log.Warn("outportReindexer: cannot save resume point", "nonce", nonce, "error", err)
+	}
+}
+
+func (or *outportReindexer) finish(status string, err error) {
+	or.mutState.Lock()
+	defer or.mutState.Unlock()
+
+	or.status.Status = status
+	if err != nil {
+		or.status.Error = err.Error()
+	}
+	or.isRunning = false
+
+	log.Info("outport re-indexing ended", "status", status, "last indexed nonce", or.status.LastIndexedNonce,
+		"num indexed blocks", or.status.NumIndexedBlocks, "error", err)
+}
+
+func (or *outportReindexer) loadResumePoint() (*resumePoint, error) {
+	if len(or.resumeFilePath) == 0 {
+		return nil, errNoResumeFile
+	}
+
+	buff, err := os.ReadFile(or.resumeFilePath)
+	if err != nil {
+		return nil, err
+	}
+
+	point := &resumePoint{}
+	err = json.Unmarshal(buff, point)
+	if err != nil {
+		return nil, err
+	}
+
+	return point, nil
+}
+
+func (or *outportReindexer) saveResumePoint(point resumePoint) error {
+	if len(or.resumeFilePath) == 0 {
+		return nil
+	}
+
+	buff, err := json.Marshal(point)
+	if err != nil {
+		return err
+	}
+
+	tempPath := or.resumeFilePath + ".tmp"
+	err = os.WriteFile(tempPath, buff, 0644)
+	if err != nil {
+		return err
+	}
+
+	return os.Rename(tempPath, or.resumeFilePath)
+}
+
+// GetReindexingStatus returns the progress of the last re-indexing job
+func (or *outportReindexer) GetReindexingStatus() common.OutportReindexStatus {
+	or.mutState.RLock()
+	defer or.mutState.RUnlock()
+
+	return or.status
+}
+
+// Close stops the running re-indexing job, if any
+func (or *outportReindexer) Close() error {
+	or.mutState.Lock()
+	defer or.mutState.Unlock()
+
+	or.isClosed = true
+	if or.cancelFunc != nil {
+		or.cancelFunc()
+	}
+
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (or *outportReindexer) IsInterfaceNil() bool {
+	return or == nil
+}
@@ -0,0 +1,388 @@
+package reindexer
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	outportcore "github.com/multiversx/mx-chain-core-go/data/outport"
+	"github.com/multiversx/mx-chain-core-go/data/typeConverters/uint64ByteSlice"
+	"github.com/multiversx/mx-chain-core-go/marshal"
+	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/outport/mock"
+	"github.com/multiversx/mx-chain-go/outport/process/transactionsfee"
+	"github.com/multiversx/mx-chain-go/process"
+	"github.com/multiversx/mx-chain-go/testscommon"
+	"github.com/multiversx/mx-chain-go/testscommon/genericMocks"
+	"github.com/multiversx/mx-chain-go/testscommon/hashingMocks"
+	"github.com/multiversx/mx-chain-go/testscommon/outport"
+	"github.com/multiversx/mx-chain-go/testscommon/shardingMocks"
+	stateMock "github.com/multiversx/mx-chain-go/testscommon/state"
+	"github.com/stretchr/testify/require"
+)
+
+func createMockArgsOutportReindexer() ArgsOutportReindexer {
+	marshaller := &marshal.GogoProtoMarshalizer{}
+	txsFeeProc, _ := transactionsfee.NewTransactionsFeeProcessor(transactionsfee.ArgTransactionsFeeProcessor{
+		Marshaller:         marshaller,
+		TransactionsStorer: genericMocks.NewStorerMock(),
+		ShardCoordinator:   &testscommon.ShardsCoordinatorMock{},
+		TxFeeCalculator:    &mock.EconomicsHandlerMock{},
+		PubKeyConverter:    testscommon.NewPubkeyConverterMock(32),
+	})
+
+	return ArgsOutportReindexer{
+		ShardCoordinator: &testscommon.ShardsCoordinatorMock{},
+		NodesCoordinator: &shardingMocks.NodesCoordinatorMock{
+			GetValidatorsPublicKeysCalled: func(randomness []byte, round uint64, shardId uint32, epoch uint32) ([]string, error) {
+				return nil, nil
+			},
+			GetValidatorsIndexesCalled: func(publicKeys []string, epoch uint32) ([]uint64, error) {
+				return []uint64{0, 1}, nil
+			},
+		},
+		StorageService:           genericMocks.NewChainStorerMock(testEpoch),
+		ReceiptsRepository:       &testscommon.ReceiptsRepositoryStub{},
+		AccountsRepository:       &stateMock.AccountsRepositoryStub{},
+		AlteredAccountsProvider:  &testscommon.AlteredAccountsProviderStub{},
+		TransactionsFeeProcessor: txsFeeProc,
+		EconomicsData:            &mock.EconomicsHandlerMock{},
+		OutportHandler: &outport.OutportStub{
+			HasDriversCalled: func() bool {
+				return true
+			},
+		},
+		Marshaller:      marshaller,
+		Hasher:          &hashingMocks.HasherMock{},
+		Uint64Converter: uint64ByteSlice.NewBigEndianConverter(),
+	}
+}
+
+func waitForReindexingEnd(t *testing.T, reindexer *outportReindexer) common.OutportReindexStatus {
+	for i := 0; i < 500; i++ {
+		status := reindexer.GetReindexingStatus()
+		if status.Status != StatusInProgress {
+			return status
+		}
+		time.Sleep(time.Millisecond * 10)
+	}
+
+	require.Fail(t, "re-indexing did not end in time")
+	return common.OutportReindexStatus{}
+}
+
+func TestNewOutportReindexer(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil shard coordinator should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsOutportReindexer()
+		args.ShardCoordinator = nil
+		reindexer, err := NewOutportReindexer(args)
+		require.Equal(t, process.ErrNilShardCoordinator, err)
+		require.True(t, check.IfNil(reindexer))
+	})
+	t.Run("nil nodes coordinator should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsOutportReindexer()
+		args.NodesCoordinator = nil
+		reindexer, err := NewOutportReindexer(args)
+		require.Equal(t, process.ErrNilNodesCoordinator, err)
+		require.True(t, check.IfNil(reindexer))
+	})
+	t.Run("nil storage service should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsOutportReindexer()
+		args.StorageService = nil
+		reindexer, err := NewOutportReindexer(args)
+		require.Equal(t, errNilStorageService, err)
+		require.True(t, check.IfNil(reindexer))
+	})
+	t.Run("nil receipts repository should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsOutportReindexer()
+		args.ReceiptsRepository = nil
+		reindexer, err := NewOutportReindexer(args)
+		require.Equal(t, errNilReceiptsRepository, err)
+		require.True(t, check.IfNil(reindexer))
+	})
+	t.Run("nil accounts repository should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsOutportReindexer()
+		args.AccountsRepository = nil
+		reindexer, err := NewOutportReindexer(args)
+		require.Equal(t, errNilAccountsRepository, err)
+		require.True(t, check.IfNil(reindexer))
+	})
+	t.Run("nil altered accounts provider should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsOutportReindexer()
+		args.AlteredAccountsProvider = nil
+		reindexer, err := NewOutportReindexer(args)
+		require.Equal(t, errNilAlteredAccountsProvider, err)
+		require.True(t, check.IfNil(reindexer))
+	})
+	t.Run("nil transactions fee processor should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsOutportReindexer()
+		args.TransactionsFeeProcessor = nil
+		reindexer, err := NewOutportReindexer(args)
+		require.Equal(t, errNilTransactionsFeeProcessor, err)
+		require.True(t, check.IfNil(reindexer))
+	})
+	t.Run("nil economics data should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsOutportReindexer()
+		args.EconomicsData = nil
+		reindexer, err := NewOutportReindexer(args)
+		require.Equal(t, process.ErrNilEconomicsData, err)
+		require.True(t, check.IfNil(reindexer))
+	})
+	t.Run("nil outport handler should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsOutportReindexer()
+		args.OutportHandler = nil
+		reindexer, err := NewOutportReindexer(args)
+		require.Equal(t, errNilOutportHandler, err)
+		require.True(t, check.IfNil(reindexer))
+	})
+	t.Run("nil marshaller should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsOutportReindexer()
+		args.Marshaller = nil
+		reindexer, err := NewOutportReindexer(args)
+		require.Equal(t, process.ErrNilMarshalizer, err)
+		require.True(t, check.IfNil(reindexer))
+	})
+	t.Run("nil hasher should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsOutportReindexer()
+		args.Hasher = nil
+		reindexer, err := NewOutportReindexer(args)
+		require.Equal(t, process.ErrNilHasher, err)
+		require.True(t, check.IfNil(reindexer))
+	})
+	t.Run("nil uint64 converter should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsOutportReindexer()
+		args.Uint64Converter = nil
+		reindexer, err := NewOutportReindexer(args)
+		require.Equal(t, errNilUint64Converter, err)
+		require.True(t, check.IfNil(reindexer))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		reindexer, err := NewOutportReindexer(createMockArgsOutportReindexer())
+		require.Nil(t, err)
+		require.False(t, check.IfNil(reindexer))
+		require.Equal(t, StatusIdle, reindexer.GetReindexingStatus().Status)
+	})
+}
+
+func TestOutportReindexer_StartReindexing(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid nonce range should error", func(t *testing.T) {
+		t.Parallel()
+
+		reindexer, _ := NewOutportReindexer(createMockArgsOutportReindexer())
+
+		err := reindexer.StartReindexing(0, 10)
+		require.ErrorIs(t, err, errInvalidNonceRange)
+
+		err = reindexer.StartReindexing(10, 9)
+		require.ErrorIs(t, err, errInvalidNonceRange)
+	})
+	t.Run("no outport drivers should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsOutportReindexer()
+		args.OutportHandler = &outport.OutportStub{}
+		reindexer, _ := NewOutportReindexer(args)
+
+		err := reindexer.StartReindexing(1, 10)
+		require.Equal(t, errNoOutportDrivers, err)
+	})
+	t.Run("closed reindexer should error", func(t *testing.T) {
+		t.Parallel()
+
+		reindexer, _ := NewOutportReindexer(createMockArgsOutportReindexer())
+		require.Nil(t, reindexer.Close())
+
+		err := reindexer.StartReindexing(1, 10)
+		require.Equal(t, errReindexerClosed, err)
+	})
+	t.Run("missing block should fail the job", func(t *testing.T) {
+		t.Parallel()
+
+		reindexer, _ := NewOutportReindexer(createMockArgsOutportReindexer())
+
+		err := reindexer.StartReindexing(1, 10)
+		require.Nil(t, err)
+
+		status := waitForReindexingEnd(t, reindexer)
+		require.Equal(t, StatusFailed, status.Status)
+		require.Equal(t, uint64(0), status.NumIndexedBlocks)
+		require.NotEmpty(t, status.Error)
+	})
+	t.Run("outport error should fail the job", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		args := createMockArgsOutportReindexer()
+		store := genericMocks.NewChainStorerMock(testEpoch)
+		storeTestBlock(t, store, 1)
+		args.StorageService = store
+		args.OutportHandler = &outport.OutportStub{
+			HasDriversCalled: func() bool {
+				return true
+			},
+			SaveBlockCalled: func(args *outportcore.OutportBlockWithHeaderAndBody) error {
+				return expectedErr
+			},
+		}
+		reindexer, _ := NewOutportReindexer(args)
+
+		err := reindexer.StartReindexing(1, 1)
+		require.Nil(t, err)
+
+		status := waitForReindexingEnd(t, reindexer)
+		require.Equal(t, StatusFailed, status.Status)
+		require.Contains(t, status.Error, expectedErr.Error())
+	})
+	t.Run("should push all the blocks of the range", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsOutportReindexer()
+		store := genericMocks.NewChainStorerMock(testEpoch)
+		for nonce := uint64(1); nonce <= 3; nonce++ {
+			storeTestBlock(t, store, nonce)
+		}
+		args.StorageService = store
+		args.ResumeFilePath = filepath.Join(t.TempDir(), "resume.json")
+
+		mut := sync.Mutex{}
+		savedNonces := make([]uint64, 0)
+		args.OutportHandler = &outport.OutportStub{
+			HasDriversCalled: func() bool {
+				return true
+			},
+			SaveBlockCalled: func(args *outportcore.OutportBlockWithHeaderAndBody) error {
+				mut.Lock()
+				savedNonces = append(savedNonces, args.HeaderDataWithBody.Header.GetNonce())
+				mut.Unlock()
+
+				require.Equal(t, 1, len(args.OutportBlock.TransactionPool.Transactions))
+				return nil
+			},
+		}
+		reindexer, _ := NewOutportReindexer(args)
+
+		err := reindexer.StartReindexing(1, 3)
+		require.Nil(t, err)
+
+		status := waitForReindexingEnd(t, reindexer)
+		require.Equal(t, StatusFinished, status.Status)
+		require.Equal(t, uint64(3), status.NumIndexedBlocks)
+		require.Equal(t, uint64(3), status.LastIndexedNonce)
+		require.Empty(t, status.Error)
+
+		mut.Lock()
+		require.Equal(t, []uint64{1, 2, 3}, savedNonces)
+		mut.Unlock()
+
+		_, err = os.Stat(args.ResumeFilePath)
+		require.Nil(t, err)
+	})
+	t.Run("unfinished job with the same range should resume", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsOutportReindexer()
+		store := genericMocks.NewChainStorerMock(testEpoch)
+		for nonce := uint64(1); nonce <= 4; nonce++ {
+			storeTestBlock(t, store, nonce)
+		}
+		args.StorageService = store
+		args.ResumeFilePath = filepath.Join(t.TempDir(), "resume.json")
+		err := os.WriteFile(args.ResumeFilePath, []byte(`{"startNonce":1,"endNonce":4,"lastIndexedNonce":2}`), 0644)
+		require.Nil(t, err)
+
+		mut := sync.Mutex{}
+		savedNonces := make([]uint64, 0)
+		args.OutportHandler = &outport.OutportStub{
+			HasDriversCalled: func() bool {
+				return true
+			},
+			SaveBlockCalled: func(args *outportcore.OutportBlockWithHeaderAndBody) error {
+				mut.Lock()
+				savedNonces = append(savedNonces, args.HeaderDataWithBody.Header.GetNonce())
+				mut.Unlock()
+
+				return nil
+			},
+		}
+		reindexer, _ := NewOutportReindexer(args)
+
+		err = reindexer.StartReindexing(1, 4)
+		require.Nil(t, err)
+
+		status := waitForReindexingEnd(t, reindexer)
+		require.Equal(t, StatusFinished, status.Status)
+		require.Equal(t, uint64(2), status.NumIndexedBlocks)
+
+		mut.Lock()
+		require.Equal(t, []uint64{3, 4}, savedNonces)
+		mut.Unlock()
+	})
+	t.Run("close should stop the running job", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsOutportReindexer()
+		store := genericMocks.NewChainStorerMock(testEpoch)
+		for nonce := uint64(1); nonce <= 3; nonce++ {
+			storeTestBlock(t, store, nonce)
+		}
+		args.StorageService = store
+		args.MaxBlocksPerSecond = 1
+		reindexer, _ := NewOutportReindexer(args)
+
+		err := reindexer.StartReindexing(1, 3)
+		require.Nil(t, err)
+
+		err = reindexer.StartReindexing(1, 3)
+		require.Equal(t, errReindexingInProgress, err)
+
+		require.Nil(t, reindexer.Close())
+
+		status := waitForReindexingEnd(t, reindexer)
+		require.Equal(t, StatusStopped, status.Status)
+		require.Less(t, status.NumIndexedBlocks, uint64(3))
+	})
+}
+
+func TestOutportReindexer_IsInterfaceNil(t *testing.T) {
+	t.Parallel()
+
+	var reindexer *outportReindexer
+	require.True(t, reindexer.IsInterfaceNil())
+
+	reindexer, _ = NewOutportReindexer(createMockArgsOutportReindexer())
+	require.False(t, reindexer.IsInterfaceNil())
+}
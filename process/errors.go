@@ -1268,3 +1268,6 @@ var ErrNilSCProcessorCreator = errors.New("nil sc processor creator")
 
 // ErrNilSCProcessorHelper signals that a nil sc processor helper was provided
 var ErrNilSCProcessorHelper = errors.New("nil sc processor helper")
+
+// ErrInvalidAuctionSimulationChange signals that an invalid change was provided for an auction simulation
+var ErrInvalidAuctionSimulationChange = errors.New("invalid auction simulation change")
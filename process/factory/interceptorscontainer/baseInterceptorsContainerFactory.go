@@ -280,7 +280,12 @@ func (bicf *baseInterceptorsContainerFactory) createOneTxInterceptor(topic strin
 		return nil, err
 	}
 
-	txFactory, err := interceptorFactory.NewInterceptedTxDataFactory(bicf.argInterceptorFactory)
+	// only the pool holding the regular transactions is able to check the same-nonce replacements, which are
+	// verified before propagating a batch made only of rejected replacements and for each processed transaction otherwise,
+	// so that a rejected replacement does not drop the whole batch
+	argTxFactory := *bicf.argInterceptorFactory
+	argTxFactory.TxReplacementChecker, _ = bicf.dataPool.Transactions().(process.TxReplacementChecker)
+	txFactory, err := interceptorFactory.NewInterceptedTxDataFactory(&argTxFactory)
 	if err != nil {
 		return nil, err
 	}
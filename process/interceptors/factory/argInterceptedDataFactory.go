@@ -57,4 +57,5 @@ type ArgInterceptedDataFactory struct {
 	SignaturesHandler            process.SignaturesHandler
 	HeartbeatExpiryTimespanInSec int64
 	PeerID                       core.PeerID
+	TxReplacementChecker         process.TxReplacementChecker
 }
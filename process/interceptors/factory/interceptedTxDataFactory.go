@@ -31,6 +31,7 @@ type interceptedTxDataFactory struct {
 	txSignHasher           hashing.Hasher
 	txVersionChecker       process.TxVersionCheckerHandler
 	enableEpochsHandler    common.EnableEpochsHandler
+	txReplacementChecker   process.TxReplacementChecker
 }
 
 // NewInterceptedTxDataFactory creates an instance of interceptedTxDataFactory
@@ -107,6 +108,7 @@ func NewInterceptedTxDataFactory(argument *ArgInterceptedDataFactory) (*intercep
 		txSignHasher:           argument.CoreComponents.TxSignHasher(),
 		txVersionChecker:       argument.CoreComponents.TxVersionChecker(),
 		enableEpochsHandler:    argument.CoreComponents.EnableEpochsHandler(),
+		txReplacementChecker:   argument.TxReplacementChecker,
 	}
 
 	return itdf, nil
@@ -130,6 +132,7 @@ func (itdf *interceptedTxDataFactory) Create(buff []byte) (process.InterceptedDa
 		itdf.enableEpochsHandler.IsFlagEnabled(common.TransactionSignedWithTxHashFlag),
 		itdf.txSignHasher,
 		itdf.txVersionChecker,
+		itdf.txReplacementChecker,
 	)
 }
 
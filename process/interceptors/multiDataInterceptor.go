@@ -184,6 +184,12 @@ func (mdi *MultiDataInterceptor) ProcessReceivedMessage(message p2p.MessageP2P,
 		}
 	}
 
+	err = checkTxReplacements(listInterceptedData)
+	if err != nil {
+		mdi.throttler.EndProcessing()
+		return err
+	}
+
 	go func() {
 		for _, interceptedData := range listInterceptedData {
 			mdi.processInterceptedData(interceptedData, message)
@@ -225,6 +231,27 @@ func (mdi *MultiDataInterceptor) interceptedData(dataBuff []byte, originator cor
 	return interceptedData, nil
 }
 
+// checkTxReplacements returns an error, so that the message is not propagated, if all the intercepted data are
+// transactions not allowed to replace the pooled ones with the same sender and nonce. The p2p messages are propagated
+// as a whole, therefore a batch also holding other data is propagated and its rejected replacements are only dropped
+// when processed, instead of dropping the valid data along with them
+func checkTxReplacements(listInterceptedData []process.InterceptedData) error {
+	var lastErr error
+	for _, interceptedData := range listInterceptedData {
+		replacementHandler, ok := interceptedData.(process.InterceptedTxReplacementHandler)
+		if !ok {
+			return nil
+		}
+
+		lastErr = replacementHandler.CheckReplacement()
+		if lastErr == nil {
+			return nil
+		}
+	}
+
+	return lastErr
+}
+
 // RegisterHandler registers a callback function to be notified on received data
 func (mdi *MultiDataInterceptor) RegisterHandler(handler func(topic string, hash []byte, data interface{})) {
 	mdi.processor.RegisterHandler(handler)
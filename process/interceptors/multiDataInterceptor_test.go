@@ -3,15 +3,19 @@ package interceptors_test
 import (
 	"bytes"
 	"errors"
+	"sync"
 	"sync/atomic"
 	"testing"
 	"time"
 
 	"github.com/multiversx/mx-chain-core-go/core"
 	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-core-go/data"
 	"github.com/multiversx/mx-chain-core-go/data/batch"
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
 	"github.com/multiversx/mx-chain-go/process"
 	"github.com/multiversx/mx-chain-go/process/interceptors"
+	"github.com/multiversx/mx-chain-go/process/interceptors/processor"
 	"github.com/multiversx/mx-chain-go/process/mock"
 	"github.com/multiversx/mx-chain-go/testscommon"
 	"github.com/multiversx/mx-chain-go/testscommon/p2pmocks"
@@ -371,6 +375,86 @@ func testProcessReceiveMessageMultiData(t *testing.T, isForCurrentShard bool, ex
 	assert.Equal(t, int32(1), throttler.EndProcessingCount())
 }
 
+func TestMultiDataInterceptor_ProcessReceivedMessageRejectedReplacementShouldProcessTheOtherTxs(t *testing.T) {
+	t.Parallel()
+
+	underpricedBuff := []byte("underpriced replacement")
+	buffData := [][]byte{[]byte("tx1"), underpricedBuff, []byte("tx2")}
+
+	marshalizer := &mock.MarshalizerMock{}
+	mutAddedHashes := sync.Mutex{}
+	addedHashes := make([][]byte, 0)
+	arg := createMockArgMultiDataInterceptor()
+	arg.DataFactory = &mock.InterceptedDataFactoryStub{
+		CreateCalled: func(buff []byte) (process.InterceptedData, error) {
+			return createInterceptedTxReplacementStub(buff, bytes.Equal(buff, underpricedBuff)), nil
+		},
+	}
+	arg.Processor, _ = processor.NewTxInterceptorProcessor(&processor.ArgTxInterceptorProcessor{
+		ShardedDataCache: &testscommon.ShardedDataStub{
+			AddDataCalled: func(key []byte, data interface{}, sizeInBytes int, cacheID string) {
+				mutAddedHashes.Lock()
+				addedHashes = append(addedHashes, key)
+				mutAddedHashes.Unlock()
+			},
+		},
+		TxValidator: &mock.TxValidatorStub{
+			CheckTxValidityCalled: func(interceptedTx process.InterceptedTransactionHandler) error {
+				return nil
+			},
+		},
+	})
+	mdi, _ := interceptors.NewMultiDataInterceptor(arg)
+
+	dataField, _ := marshalizer.Marshal(&batch.Batch{Data: buffData})
+	msg := &p2pmocks.P2PMessageMock{
+		DataField: dataField,
+	}
+	err := mdi.ProcessReceivedMessage(msg, fromConnectedPeerId, &p2pmocks.MessengerStub{})
+	assert.Nil(t, err)
+
+	time.Sleep(time.Second)
+
+	mutAddedHashes.Lock()
+	defer mutAddedHashes.Unlock()
+	assert.Equal(t, [][]byte{[]byte("tx1"), []byte("tx2")}, addedHashes)
+}
+
+func TestMultiDataInterceptor_ProcessReceivedMessageOnlyRejectedReplacementsShouldNotPropagate(t *testing.T) {
+	t.Parallel()
+
+	buffData := [][]byte{[]byte("underpriced replacement 1"), []byte("underpriced replacement 2")}
+
+	marshalizer := &mock.MarshalizerMock{}
+	checkCalledNum := int32(0)
+	processCalledNum := int32(0)
+	throttler := createMockThrottler()
+	arg := createMockArgMultiDataInterceptor()
+	arg.DataFactory = &mock.InterceptedDataFactoryStub{
+		CreateCalled: func(buff []byte) (process.InterceptedData, error) {
+			return createInterceptedTxReplacementStub(buff, true), nil
+		},
+	}
+	arg.Processor = createMockInterceptorStub(&checkCalledNum, &processCalledNum)
+	arg.Throttler = throttler
+	mdi, _ := interceptors.NewMultiDataInterceptor(arg)
+
+	dataField, _ := marshalizer.Marshal(&batch.Batch{Data: buffData})
+	msg := &p2pmocks.P2PMessageMock{
+		DataField: dataField,
+	}
+	// an error returned for the message stops its propagation on the topic
+	err := mdi.ProcessReceivedMessage(msg, fromConnectedPeerId, &p2pmocks.MessengerStub{})
+	assert.Equal(t, errReplacementUnderpriced, err)
+
+	time.Sleep(time.Second)
+
+	assert.Equal(t, int32(0), atomic.LoadInt32(&checkCalledNum))
+	assert.Equal(t, int32(0), atomic.LoadInt32(&processCalledNum))
+	assert.Equal(t, int32(1), throttler.StartProcessingCount())
+	assert.Equal(t, int32(1), throttler.EndProcessingCount())
+}
+
 func TestMultiDataInterceptor_ProcessReceivedMessageCheckBatchErrors(t *testing.T) {
 	buffData := [][]byte{[]byte("buff1"), []byte("buff2")}
 
@@ -759,3 +843,38 @@ func TestMultiDataInterceptor_Close(t *testing.T) {
 	assert.Nil(t, err)
 	assert.True(t, closeCalled)
 }
+
+var errReplacementUnderpriced = errors.New("replacement underpriced")
+
+type interceptedTxReplacementStub struct {
+	testscommon.InterceptedDataStub
+	mock.InterceptedTxHandlerStub
+	isRejectedReplacement bool
+}
+
+func createInterceptedTxReplacementStub(hash []byte, isRejectedReplacement bool) *interceptedTxReplacementStub {
+	return &interceptedTxReplacementStub{
+		InterceptedDataStub: testscommon.InterceptedDataStub{
+			HashCalled: func() []byte {
+				return hash
+			},
+			IsForCurrentShardCalled: func() bool {
+				return true
+			},
+		},
+		InterceptedTxHandlerStub: mock.InterceptedTxHandlerStub{
+			TransactionCalled: func() data.TransactionHandler {
+				return &transaction.Transaction{}
+			},
+		},
+		isRejectedReplacement: isRejectedReplacement,
+	}
+}
+
+func (stub *interceptedTxReplacementStub) CheckReplacement() error {
+	if stub.isRejectedReplacement {
+		return errReplacementUnderpriced
+	}
+
+	return nil
+}
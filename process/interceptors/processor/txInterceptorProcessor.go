@@ -42,7 +42,18 @@ func (txip *TxInterceptorProcessor) Validate(data process.InterceptedData, _ cor
 		return process.ErrWrongTypeAssertion
 	}
 
-	return txip.txValidator.CheckTxValidity(interceptedTx)
+	err := txip.txValidator.CheckTxValidity(interceptedTx)
+	if err != nil {
+		return err
+	}
+
+	// only the regular transactions are checked against the pooled transaction with the same sender and nonce
+	replacementHandler, ok := data.(process.InterceptedTxReplacementHandler)
+	if !ok {
+		return nil
+	}
+
+	return replacementHandler.CheckReplacement()
 }
 
 // Save will save the received data into the cacher
@@ -116,6 +116,31 @@ func TestTxInterceptorProcessor_ValidateReturnsTrueShouldWork(t *testing.T) {
 	assert.Nil(t, err)
 }
 
+func TestTxInterceptorProcessor_ValidateRejectedReplacementShouldErr(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("replacement underpriced")
+	arg := createMockTxArgument()
+	arg.TxValidator = &mock.TxValidatorStub{
+		CheckTxValidityCalled: func(interceptedTx process.InterceptedTransactionHandler) error {
+			return nil
+		},
+	}
+	txip, _ := processor.NewTxInterceptorProcessor(arg)
+
+	checkReplacementCalled := false
+	txInterceptedData := &interceptedTxReplacementStub{
+		checkReplacementCalled: func() error {
+			checkReplacementCalled = true
+			return expectedErr
+		},
+	}
+	err := txip.Validate(txInterceptedData, "")
+
+	assert.Equal(t, expectedErr, err)
+	assert.True(t, checkReplacementCalled)
+}
+
 //------- Save
 
 func TestTxInterceptorProcessor_SaveNilDataShouldErr(t *testing.T) {
@@ -176,3 +201,17 @@ func TestTxInterceptorProcessor_IsInterfaceNil(t *testing.T) {
 
 	assert.True(t, check.IfNil(txip))
 }
+
+type interceptedTxReplacementStub struct {
+	testscommon.InterceptedDataStub
+	mock.InterceptedTxHandlerStub
+	checkReplacementCalled func() error
+}
+
+func (stub *interceptedTxReplacementStub) CheckReplacement() error {
+	if stub.checkReplacementCalled != nil {
+		return stub.checkReplacementCalled()
+	}
+
+	return nil
+}
@@ -335,6 +335,8 @@ type TransactionLogProcessorDatabase interface {
 type ValidatorsProvider interface {
 	GetLatestValidators() map[string]*validator.ValidatorStatistics
 	GetAuctionList() ([]*common.AuctionListValidatorAPIResponse, error)
+	SimulateAuction(changes []*common.AuctionSimulationOwnerChange, randomness []byte) (*common.AuctionSimulationAPIResponse, error)
+	GetNodesShufflingPreview(randomness []byte, epoch uint32) (*common.NodesShufflingPreviewAPIResponse, error)
 	ForceUpdate() error
 	IsInterfaceNil() bool
 	Close() error
@@ -794,6 +796,7 @@ type PeerShardMapper interface {
 type NetworkShardingCollector interface {
 	PeerShardMapper
 	UpdatePeerIDInfo(pid core.PeerID, pk []byte, shardID uint32)
+	GetPeerIDs(pk []byte) []core.PeerID
 }
 
 // NetworkConnectionWatcher defines a watchdog functionality used to specify if the current node
@@ -1140,6 +1143,7 @@ type NodesCoordinator interface {
 	GetAllEligibleValidatorsPublicKeys(epoch uint32) (map[uint32][][]byte, error)
 	GetAllWaitingValidatorsPublicKeys(epoch uint32) (map[uint32][][]byte, error)
 	GetAllLeavingValidatorsPublicKeys(epoch uint32) (map[uint32][][]byte, error)
+	PreviewNodesShuffling(validatorsInfo []*state.ShardValidatorInfo, randomness []byte, epoch uint32) (*nodesCoordinator.ShuffledNodes, error)
 	IsInterfaceNil() bool
 }
 
@@ -1302,6 +1306,18 @@ type ShardedPool interface {
 	AddData(key []byte, data interface{}, sizeInBytes int, cacheID string)
 }
 
+// TxReplacementChecker can tell if a transaction is allowed to replace the pooled one with the same sender and nonce
+type TxReplacementChecker interface {
+	CheckTxReplacement(txHash []byte, tx data.TransactionHandler, cacheID string) error
+	IsInterfaceNil() bool
+}
+
+// InterceptedTxReplacementHandler defines an intercepted transaction able to tell if it is allowed to replace the
+// pooled one with the same sender and nonce
+type InterceptedTxReplacementHandler interface {
+	CheckReplacement() error
+}
+
 // InterceptedSignedTransactionHandler provides additional handling for signed transactions
 type InterceptedSignedTransactionHandler interface {
 	InterceptedTransactionHandler
@@ -3,6 +3,7 @@ package peer
 import (
 	"context"
 	"fmt"
+	"math/big"
 	"sync"
 	"time"
 
@@ -33,11 +34,14 @@ type validatorsProvider struct {
 	lastAuctionCacheUpdate       time.Time
 	lock                         sync.RWMutex
 	auctionMutex                 sync.RWMutex
+	stakingDataMutex             sync.Mutex
 	cancelFunc                   func()
 	validatorPubKeyConverter     core.PubkeyConverter
 	addressPubKeyConverter       core.PubkeyConverter
 	stakingDataProvider          StakingDataProviderAPI
 	auctionListSelector          epochStart.AuctionListSelector
+	minNodePrice                 *big.Int
+	maxNumNodes                  uint32
 
 	maxRating    uint32
 	currentEpoch uint32
@@ -53,8 +57,10 @@ type ArgValidatorsProvider struct {
 	AddressPubKeyConverter            core.PubkeyConverter
 	StakingDataProvider               StakingDataProviderAPI
 	AuctionListSelector               epochStart.AuctionListSelector
+	MinNodePrice                      *big.Int
 	StartEpoch                        uint32
 	MaxRating                         uint32
+	MaxNumNodes                       uint32
 }
 
 // NewValidatorsProvider instantiates a new validatorsProvider structure responsible for keeping account of
@@ -83,6 +89,9 @@ func NewValidatorsProvider(
 	if check.IfNil(args.AuctionListSelector) {
 		return nil, epochStart.ErrNilAuctionListSelector
 	}
+	if args.MinNodePrice == nil || args.MinNodePrice.Sign() <= 0 {
+		return nil, epochStart.ErrInvalidMinNodePrice
+	}
 	if args.MaxRating == 0 {
 		return nil, process.ErrMaxRatingZero
 	}
@@ -109,6 +118,8 @@ func NewValidatorsProvider(
 		addressPubKeyConverter:       args.AddressPubKeyConverter,
 		currentEpoch:                 args.StartEpoch,
 		auctionListSelector:          args.AuctionListSelector,
+		minNodePrice:                 big.NewInt(0).Set(args.MinNodePrice),
+		maxNumNodes:                  args.MaxNumNodes,
 	}
 
 	go valProvider.startRefreshProcess(currentContext)
@@ -67,6 +67,8 @@ func (vp *validatorsProvider) updateAuctionListCache() error {
 }
 
 func (vp *validatorsProvider) createValidatorsAuctionCache(validatorsMap state.ShardValidatorsInfoMapHandler) ([]*common.AuctionListValidatorAPIResponse, error) {
+	vp.stakingDataMutex.Lock()
+	defer vp.stakingDataMutex.Unlock()
 	defer vp.stakingDataProvider.Clean()
 
 	err := vp.fillAllValidatorsInfo(validatorsMap)
@@ -79,7 +81,7 @@ func (vp *validatorsProvider) createValidatorsAuctionCache(validatorsMap state.S
 		return nil, err
 	}
 
-	auctionListValidators, qualifiedOwners := vp.getAuctionListValidatorsAPIResponse(selectedNodes)
+	auctionListValidators, qualifiedOwners := vp.getAuctionListValidatorsAPIResponse(vp.stakingDataProvider.GetOwnersData(), selectedNodes)
 	sortList(auctionListValidators, qualifiedOwners)
 	return auctionListValidators, nil
 }
@@ -155,12 +157,13 @@ func getNumQualified(nodes []*common.AuctionNode) uint32 {
 }
 
 func (vp *validatorsProvider) getAuctionListValidatorsAPIResponse(
+	ownersData map[string]*epochStart.OwnerData,
 	selectedNodes []state.ValidatorInfoHandler,
 ) ([]*common.AuctionListValidatorAPIResponse, map[string]bool) {
 	auctionListValidators := make([]*common.AuctionListValidatorAPIResponse, 0)
 	qualifiedOwners := make(map[string]bool)
 
-	for ownerPubKey, ownerData := range vp.stakingDataProvider.GetOwnersData() {
+	for ownerPubKey, ownerData := range ownersData {
 		numAuctionNodes := len(ownerData.AuctionList)
 		if numAuctionNodes > 0 {
 			ownerEncodedPubKey := vp.addressPubKeyConverter.SilentEncode([]byte(ownerPubKey), log)
@@ -0,0 +1,256 @@
+package peer
+
+import (
+	"bytes"
+	"fmt"
+	"math"
+	"math/big"
+
+	"github.com/multiversx/mx-chain-core-go/hashing/sha256"
+	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/epochStart"
+	"github.com/multiversx/mx-chain-go/process"
+	"github.com/multiversx/mx-chain-go/state"
+)
+
+const (
+	defaultBlsKeyLength = 96
+
+	// maxAuctionSimulationChanges is the maximum number of owners changes accepted by an auction simulation
+	maxAuctionSimulationChanges = 100
+)
+
+type ownerAuctionChange struct {
+	owner      string
+	addedStake *big.Int
+	addedNodes int64
+}
+
+// SimulateAuction runs the auction selection on a copy of the current validators info, after applying the provided
+// hypothetical changes of the owners' stake and number of nodes. The selection is seeded with the provided randomness,
+// as the system smart contracts do at the epoch start. The current auction list and the validators info are not altered
+func (vp *validatorsProvider) SimulateAuction(changes []*common.AuctionSimulationOwnerChange, randomness []byte) (*common.AuctionSimulationAPIResponse, error) {
+	ownerChanges, err := vp.parseAuctionChanges(changes)
+	if err != nil {
+		return nil, err
+	}
+
+	rootHash := vp.validatorStatistics.LastFinalizedRootHash()
+	if len(rootHash) == 0 {
+		return nil, state.ErrNilRootHash
+	}
+
+	validatorsMap, err := vp.validatorStatistics.GetValidatorInfoForRootHash(rootHash)
+	if err != nil {
+		return nil, err
+	}
+
+	return vp.simulateAuctionSelection(validatorsMap, ownerChanges, randomness)
+}
+
+func (vp *validatorsProvider) parseAuctionChanges(changes []*common.AuctionSimulationOwnerChange) ([]*ownerAuctionChange, error) {
+	if len(changes) > maxAuctionSimulationChanges {
+		return nil, fmt.Errorf("%w: too many changes, provided %d, maximum %d",
+			process.ErrInvalidAuctionSimulationChange, len(changes), maxAuctionSimulationChanges)
+	}
+
+	ownerChanges := make([]*ownerAuctionChange, 0, len(changes))
+	for _, change := range changes {
+		if change == nil {
+			return nil, fmt.Errorf("%w: nil change", process.ErrInvalidAuctionSimulationChange)
+		}
+
+		owner, err := vp.addressPubKeyConverter.Decode(change.Owner)
+		if err != nil {
+			return nil, fmt.Errorf("%w for owner %s: %v", process.ErrInvalidAuctionSimulationChange, change.Owner, err)
+		}
+
+		err = vp.checkAddedNodes(change.AddedNodes)
+		if err != nil {
+			return nil, fmt.Errorf("%w for owner %s", err, change.Owner)
+		}
+
+		addedStake := big.NewInt(0)
+		if len(change.AddedStake) > 0 {
+			var ok bool
+			addedStake, ok = big.NewInt(0).SetString(change.AddedStake, 10)
+			if !ok {
+				return nil, fmt.Errorf("%w for owner %s: invalid added stake %s",
+					process.ErrInvalidAuctionSimulationChange, change.Owner, change.AddedStake)
+			}
+		}
+
+		ownerChanges = append(ownerChanges, &ownerAuctionChange{
+			owner:      string(owner),
+			addedStake: addedStake,
+			addedNodes: change.AddedNodes,
+		})
+	}
+
+	return ownerChanges, nil
+}
+
+// checkAddedNodes bounds the number of nodes staked or unstaked by a change, as each simulated node is allocated while
+// the staking data provider is locked
+func (vp *validatorsProvider) checkAddedNodes(addedNodes int64) error {
+	if addedNodes == math.MinInt64 {
+		return fmt.Errorf("%w: invalid added nodes %d", process.ErrInvalidAuctionSimulationChange, addedNodes)
+	}
+
+	absAddedNodes := addedNodes
+	if absAddedNodes < 0 {
+		absAddedNodes = -absAddedNodes
+	}
+	if absAddedNodes > int64(vp.maxNumNodes) {
+		return fmt.Errorf("%w: added nodes %d exceed the maximum number of nodes %d",
+			process.ErrInvalidAuctionSimulationChange, addedNodes, vp.maxNumNodes)
+	}
+
+	return nil
+}
+
+// simulateAuctionSelection runs the selection while the staking data provider still holds the data filled for the
+// provided validators, as the number of available slots depends on its number of validators in the current epoch
+func (vp *validatorsProvider) simulateAuctionSelection(
+	validatorsMap state.ShardValidatorsInfoMapHandler,
+	ownerChanges []*ownerAuctionChange,
+	randomness []byte,
+) (*common.AuctionSimulationAPIResponse, error) {
+	vp.stakingDataMutex.Lock()
+	defer vp.stakingDataMutex.Unlock()
+	defer vp.stakingDataProvider.Clean()
+
+	err := vp.fillAllValidatorsInfo(validatorsMap)
+	if err != nil {
+		return nil, err
+	}
+
+	ownersData := vp.stakingDataProvider.GetOwnersData()
+	simulatedNodes, err := vp.applyAuctionChanges(ownersData, ownerChanges, getBlsKeyLength(validatorsMap))
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := vp.auctionListSelector.SimulateNodesSelection(ownersData, randomness)
+	if err != nil {
+		return nil, err
+	}
+
+	return vp.createAuctionSimulationAPIResponse(ownersData, result, simulatedNodes), nil
+}
+
+// applyAuctionChanges alters the provided owners data as the validator system smart contract would after the owners
+// stake or unstake tokens and nodes. The unstaked nodes are removed along with their stake, so only the newly staked
+// nodes are paid from the added stake. It returns the public keys of the simulated new nodes
+func (vp *validatorsProvider) applyAuctionChanges(
+	ownersData map[string]*epochStart.OwnerData,
+	changes []*ownerAuctionChange,
+	blsKeyLength int,
+) (map[string]struct{}, error) {
+	simulatedNodes := make(map[string]struct{})
+	for _, change := range changes {
+		ownerData, exists := ownersData[change.owner]
+		if !exists {
+			ownerData = &epochStart.OwnerData{
+				TotalTopUp:   big.NewInt(0),
+				TopUpPerNode: big.NewInt(0),
+				AuctionList:  make([]state.ValidatorInfoHandler, 0),
+			}
+			ownersData[change.owner] = ownerData
+		}
+
+		if change.addedNodes < 0 && -change.addedNodes > int64(len(ownerData.AuctionList)) {
+			return nil, fmt.Errorf("%w: cannot remove %d nodes, owner %s has only %d nodes in auction",
+				process.ErrInvalidAuctionSimulationChange,
+				-change.addedNodes,
+				vp.addressPubKeyConverter.SilentEncode([]byte(change.owner), log),
+				len(ownerData.AuctionList),
+			)
+		}
+
+		if change.addedNodes < 0 {
+			ownerData.AuctionList = ownerData.AuctionList[:int64(len(ownerData.AuctionList))+change.addedNodes]
+		}
+		for i := int64(0); i < change.addedNodes; i++ {
+			blsKey := createSimulatedBlsKey(change.owner, len(ownerData.AuctionList), blsKeyLength)
+			ownerData.AuctionList = append(ownerData.AuctionList, &state.ValidatorInfo{
+				PublicKey: blsKey,
+				List:      string(common.AuctionList),
+			})
+			simulatedNodes[string(blsKey)] = struct{}{}
+		}
+
+		topUpChange := big.NewInt(0).Set(change.addedStake)
+		if change.addedNodes > 0 {
+			nodesStake := big.NewInt(0).Mul(vp.minNodePrice, big.NewInt(change.addedNodes))
+			topUpChange.Sub(topUpChange, nodesStake)
+		}
+		ownerData.TotalTopUp = big.NewInt(0).Add(ownerData.TotalTopUp, topUpChange)
+		ownerData.NumStakedNodes += change.addedNodes
+		ownerData.Qualified = ownerData.NumStakedNodes > 0 && ownerData.TotalTopUp.Sign() >= 0
+
+		ownerData.TopUpPerNode = big.NewInt(0)
+		if ownerData.NumStakedNodes > 0 {
+			ownerData.TopUpPerNode = big.NewInt(0).Div(ownerData.TotalTopUp, big.NewInt(ownerData.NumStakedNodes))
+		}
+	}
+
+	return simulatedNodes, nil
+}
+
+func (vp *validatorsProvider) createAuctionSimulationAPIResponse(
+	ownersData map[string]*epochStart.OwnerData,
+	result *epochStart.AuctionSelectionResult,
+	simulatedNodes map[string]struct{},
+) *common.AuctionSimulationAPIResponse {
+	auctionList, qualifiedOwners := vp.getAuctionListValidatorsAPIResponse(ownersData, result.SelectedNodes)
+	sortList(auctionList, qualifiedOwners)
+
+	encodedSimulatedNodes := make(map[string]struct{}, len(simulatedNodes))
+	for blsKey := range simulatedNodes {
+		encodedSimulatedNodes[vp.validatorPubKeyConverter.SilentEncode([]byte(blsKey), log)] = struct{}{}
+	}
+
+	minQualifiedTopUp := big.NewInt(0)
+	if result.MinQualifiedTopUp != nil {
+		minQualifiedTopUp = result.MinQualifiedTopUp
+	}
+
+	response := &common.AuctionSimulationAPIResponse{
+		AvailableSlots:    result.AvailableSlots,
+		MinQualifiedTopUp: minQualifiedTopUp.String(),
+		SelectedNodes:     make([]string, 0),
+		UnselectedNodes:   make([]string, 0),
+		AuctionList:       auctionList,
+	}
+	for _, owner := range auctionList {
+		for _, node := range owner.Nodes {
+			_, node.Simulated = encodedSimulatedNodes[node.BlsKey]
+			if node.Qualified {
+				response.SelectedNodes = append(response.SelectedNodes, node.BlsKey)
+				continue
+			}
+
+			response.UnselectedNodes = append(response.UnselectedNodes, node.BlsKey)
+		}
+	}
+
+	return response
+}
+
+func getBlsKeyLength(validatorsMap state.ShardValidatorsInfoMapHandler) int {
+	for _, validator := range validatorsMap.GetAllValidatorsInfo() {
+		return len(validator.GetPublicKey())
+	}
+
+	return defaultBlsKeyLength
+}
+
+// createSimulatedBlsKey creates a deterministic public key for a simulated node, with the same length as the real
+// ones, since the auction selection compares the keys XOR-ed with the randomness
+func createSimulatedBlsKey(owner string, index int, length int) []byte {
+	hash := sha256.NewSha256().Compute(fmt.Sprintf("simulated-%s-%d", owner, index))
+	repeated := bytes.Repeat(hash, length/len(hash)+1)
+
+	return repeated[:length]
+}
@@ -0,0 +1,130 @@
+package peer
+
+import (
+	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/sharding/nodesCoordinator"
+	"github.com/multiversx/mx-chain-go/state"
+)
+
+// GetNodesShufflingPreview runs the auction selection and the nodes shuffler on the current validators info, using the
+// provided randomness, and returns the nodes lists of each shard projected for the provided epoch. The other changes
+// done by the system smart contracts at the epoch start are not simulated, being listed in the response. The current
+// nodes configuration is not altered
+func (vp *validatorsProvider) GetNodesShufflingPreview(randomness []byte, epoch uint32) (*common.NodesShufflingPreviewAPIResponse, error) {
+	rootHash := vp.validatorStatistics.LastFinalizedRootHash()
+	if len(rootHash) == 0 {
+		return nil, state.ErrNilRootHash
+	}
+
+	validatorsMap, err := vp.validatorStatistics.GetValidatorInfoForRootHash(rootHash)
+	if err != nil {
+		return nil, err
+	}
+
+	err = vp.selectNodesFromAuctionForPreview(validatorsMap, randomness)
+	if err != nil {
+		return nil, err
+	}
+
+	allValidators := validatorsMap.GetAllValidatorsInfo()
+	validatorsInfo := make([]*state.ShardValidatorInfo, 0, len(allValidators))
+	for _, validatorInfo := range allValidators {
+		validatorsInfo = append(validatorsInfo, &state.ShardValidatorInfo{
+			PublicKey:     validatorInfo.GetPublicKey(),
+			ShardId:       validatorInfo.GetShardId(),
+			List:          validatorInfo.GetList(),
+			PreviousList:  validatorInfo.GetPreviousList(),
+			Index:         validatorInfo.GetIndex(),
+			PreviousIndex: validatorInfo.GetPreviousIndex(),
+			TempRating:    validatorInfo.GetTempRating(),
+		})
+	}
+
+	shuffledNodes, err := vp.nodesCoordinator.PreviewNodesShuffling(validatorsInfo, randomness, epoch)
+	if err != nil {
+		return nil, err
+	}
+
+	return vp.createShufflingPreviewAPIResponse(shuffledNodes, epoch), nil
+}
+
+// selectNodesFromAuctionForPreview moves the nodes selected from the auction list in the selected from auction list,
+// as the system smart contracts do at the epoch start, so that the shuffler distributes them in the waiting lists
+func (vp *validatorsProvider) selectNodesFromAuctionForPreview(validatorsMap state.ShardValidatorsInfoMapHandler, randomness []byte) error {
+	if !hasNodesInAuction(validatorsMap) {
+		return nil
+	}
+
+	vp.stakingDataMutex.Lock()
+	defer vp.stakingDataMutex.Unlock()
+	defer vp.stakingDataProvider.Clean()
+
+	err := vp.fillAllValidatorsInfo(validatorsMap)
+	if err != nil {
+		return err
+	}
+
+	return vp.auctionListSelector.SelectNodesFromAuctionList(validatorsMap, randomness)
+}
+
+func hasNodesInAuction(validatorsMap state.ShardValidatorsInfoMapHandler) bool {
+	for _, validator := range validatorsMap.GetAllValidatorsInfo() {
+		if validator.GetList() == string(common.AuctionList) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (vp *validatorsProvider) createShufflingPreviewAPIResponse(
+	shuffledNodes *nodesCoordinator.ShuffledNodes,
+	epoch uint32,
+) *common.NodesShufflingPreviewAPIResponse {
+	response := &common.NodesShufflingPreviewAPIResponse{
+		IsProjection: true,
+		// the changes done on the validators lists at the epoch start, before the shuffling, which are not simulated
+		NotSimulated: []string{"jailing", "unjailing", "unqualifiedNodesUnStaking"},
+		Epoch:        epoch,
+		Shards:       make(map[uint32]*common.NodesShufflingPreviewShardAPIResponse),
+	}
+
+	getShard := func(shardID uint32) *common.NodesShufflingPreviewShardAPIResponse {
+		shard, exists := response.Shards[shardID]
+		if !exists {
+			shard = &common.NodesShufflingPreviewShardAPIResponse{
+				Eligible:    make([]string, 0),
+				Waiting:     make([]string, 0),
+				Leaving:     make([]string, 0),
+				ShuffledOut: make([]string, 0),
+			}
+			response.Shards[shardID] = shard
+		}
+
+		return shard
+	}
+
+	for shardID, validators := range shuffledNodes.Eligible {
+		getShard(shardID).Eligible = vp.encodeValidatorsKeys(validators)
+	}
+	for shardID, validators := range shuffledNodes.Waiting {
+		getShard(shardID).Waiting = vp.encodeValidatorsKeys(validators)
+	}
+	for shardID, validators := range shuffledNodes.Leaving {
+		getShard(shardID).Leaving = vp.encodeValidatorsKeys(validators)
+	}
+	for shardID, validators := range shuffledNodes.ShuffledOut {
+		getShard(shardID).ShuffledOut = vp.encodeValidatorsKeys(validators)
+	}
+
+	return response
+}
+
+func (vp *validatorsProvider) encodeValidatorsKeys(validators []nodesCoordinator.Validator) []string {
+	encodedKeys := make([]string, 0, len(validators))
+	for _, validator := range validators {
+		encodedKeys = append(encodedKeys, vp.validatorPubKeyConverter.SilentEncode(validator.PubKey(), log))
+	}
+
+	return encodedKeys
+}
@@ -5,6 +5,7 @@ import (
 	"context"
 	"encoding/hex"
 	"fmt"
+	"math"
 	"math/big"
 	"strings"
 	"sync"
@@ -18,13 +19,21 @@ import (
 	"github.com/multiversx/mx-chain-core-go/data/block"
 	"github.com/multiversx/mx-chain-core-go/data/validator"
 	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/common/forking"
+	"github.com/multiversx/mx-chain-go/config"
 	"github.com/multiversx/mx-chain-go/epochStart"
+	"github.com/multiversx/mx-chain-go/epochStart/metachain"
+	"github.com/multiversx/mx-chain-go/epochStart/notifier"
 	"github.com/multiversx/mx-chain-go/process"
 	"github.com/multiversx/mx-chain-go/process/mock"
+	"github.com/multiversx/mx-chain-go/sharding"
+	"github.com/multiversx/mx-chain-go/sharding/nodesCoordinator"
 	"github.com/multiversx/mx-chain-go/state"
 	"github.com/multiversx/mx-chain-go/testscommon"
+	"github.com/multiversx/mx-chain-go/testscommon/enableEpochsHandlerMock"
 	"github.com/multiversx/mx-chain-go/testscommon/shardingMocks"
 	"github.com/multiversx/mx-chain-go/testscommon/stakingcommon"
+	vmcommon "github.com/multiversx/mx-chain-vm-common-go"
 	"github.com/pkg/errors"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -111,6 +120,19 @@ func TestNewValidatorsProvider_WithNilAuctionListSelectorShouldErr(t *testing.T)
 	require.Equal(t, epochStart.ErrNilAuctionListSelector, err)
 }
 
+func TestNewValidatorsProvider_WithInvalidMinNodePriceShouldErr(t *testing.T) {
+	arg := createDefaultValidatorsProviderArg()
+	arg.MinNodePrice = nil
+	vp, err := NewValidatorsProvider(arg)
+	require.Nil(t, vp)
+	require.Equal(t, epochStart.ErrInvalidMinNodePrice, err)
+
+	arg.MinNodePrice = big.NewInt(0)
+	vp, err = NewValidatorsProvider(arg)
+	require.Nil(t, vp)
+	require.Equal(t, epochStart.ErrInvalidMinNodePrice, err)
+}
+
 func TestValidatorsProvider_GetLatestValidatorsSecondHashDoesNotExist(t *testing.T) {
 	mut := sync.Mutex{}
 	root := []byte("rootHash")
@@ -1046,6 +1068,341 @@ func TestValidatorsProvider_GetAuctionList(t *testing.T) {
 
 }
 
+func TestValidatorsProvider_SimulateAuction(t *testing.T) {
+	t.Parallel()
+
+	owner1 := []byte("owner1")
+	owner2 := []byte("owner2")
+	v1 := &state.ValidatorInfo{PublicKey: []byte("pk1"), List: string(common.AuctionList)}
+	v2 := &state.ValidatorInfo{PublicKey: []byte("pk2"), List: string(common.AuctionList)}
+	expectedRootHash := []byte("root hash")
+	randomness := []byte("randomness")
+
+	createArgs := func() ArgValidatorsProvider {
+		args := createDefaultValidatorsProviderArg()
+		args.ValidatorStatistics = &testscommon.ValidatorStatisticsProcessorStub{
+			LastFinalizedRootHashCalled: func() []byte {
+				return expectedRootHash
+			},
+			GetValidatorInfoForRootHashCalled: func(rootHash []byte) (state.ShardValidatorsInfoMapHandler, error) {
+				validatorsMap := state.NewShardValidatorsInfoMap()
+				_ = validatorsMap.Add(v1.ShallowClone())
+				_ = validatorsMap.Add(v2.ShallowClone())
+				return validatorsMap, nil
+			},
+		}
+		args.StakingDataProvider = &stakingcommon.StakingDataProviderStub{
+			GetOwnersDataCalled: func() map[string]*epochStart.OwnerData {
+				return map[string]*epochStart.OwnerData{
+					string(owner1): {
+						NumStakedNodes: 2,
+						TotalTopUp:     big.NewInt(1000),
+						TopUpPerNode:   big.NewInt(500),
+						AuctionList:    []state.ValidatorInfoHandler{v1, v2},
+						Qualified:      true,
+					},
+				}
+			},
+		}
+
+		return args
+	}
+
+	t.Run("invalid change should error", func(t *testing.T) {
+		t.Parallel()
+
+		vp, _ := NewValidatorsProvider(createArgs())
+		defer func() {
+			_ = vp.Close()
+		}()
+
+		result, err := vp.SimulateAuction([]*common.AuctionSimulationOwnerChange{nil}, randomness)
+		require.Nil(t, result)
+		require.True(t, errors.Is(err, process.ErrInvalidAuctionSimulationChange))
+
+		result, err = vp.SimulateAuction([]*common.AuctionSimulationOwnerChange{{Owner: "not hex"}}, randomness)
+		require.Nil(t, result)
+		require.True(t, errors.Is(err, process.ErrInvalidAuctionSimulationChange))
+
+		result, err = vp.SimulateAuction([]*common.AuctionSimulationOwnerChange{{Owner: hex.EncodeToString(owner1), AddedStake: "abc"}}, randomness)
+		require.Nil(t, result)
+		require.True(t, errors.Is(err, process.ErrInvalidAuctionSimulationChange))
+	})
+
+	t.Run("removing more nodes than in auction should error", func(t *testing.T) {
+		t.Parallel()
+
+		vp, _ := NewValidatorsProvider(createArgs())
+		defer func() {
+			_ = vp.Close()
+		}()
+
+		result, err := vp.SimulateAuction([]*common.AuctionSimulationOwnerChange{{Owner: hex.EncodeToString(owner1), AddedNodes: -3}}, randomness)
+		require.Nil(t, result)
+		require.True(t, errors.Is(err, process.ErrInvalidAuctionSimulationChange))
+	})
+
+	t.Run("removing nodes should unstake them without adding top-up", func(t *testing.T) {
+		t.Parallel()
+
+		args := createArgs()
+		args.AuctionListSelector = &stakingcommon.AuctionListSelectorStub{
+			SimulateNodesSelectionCalled: func(ownersData map[string]*epochStart.OwnerData, randomness []byte) (*epochStart.AuctionSelectionResult, error) {
+				owner1Data := ownersData[string(owner1)]
+				require.Equal(t, int64(1), owner1Data.NumStakedNodes)
+				require.Equal(t, big.NewInt(1000), owner1Data.TotalTopUp)
+				require.Equal(t, big.NewInt(1000), owner1Data.TopUpPerNode)
+				require.Equal(t, []state.ValidatorInfoHandler{v1}, owner1Data.AuctionList)
+				require.True(t, owner1Data.Qualified)
+
+				return &epochStart.AuctionSelectionResult{
+					AvailableSlots: 1,
+					SelectedNodes:  owner1Data.AuctionList,
+				}, nil
+			},
+		}
+		vp, _ := NewValidatorsProvider(args)
+		defer func() {
+			_ = vp.Close()
+		}()
+
+		result, err := vp.SimulateAuction([]*common.AuctionSimulationOwnerChange{{Owner: hex.EncodeToString(owner1), AddedNodes: -1}}, randomness)
+		require.Nil(t, err)
+		require.Equal(t, []string{hex.EncodeToString(v1.PublicKey)}, result.SelectedNodes)
+		require.Empty(t, result.UnselectedNodes)
+	})
+
+	t.Run("added nodes out of bounds should error", func(t *testing.T) {
+		t.Parallel()
+
+		getRootHashCalled := false
+		args := createArgs()
+		args.ValidatorStatistics = &testscommon.ValidatorStatisticsProcessorStub{
+			LastFinalizedRootHashCalled: func() []byte {
+				getRootHashCalled = true
+				return expectedRootHash
+			},
+		}
+		vp, _ := NewValidatorsProvider(args)
+		defer func() {
+			_ = vp.Close()
+		}()
+
+		for _, addedNodes := range []int64{1_000_000_000_000, -1_000_000_000_000, 101, -101, math.MinInt64} {
+			result, err := vp.SimulateAuction([]*common.AuctionSimulationOwnerChange{{Owner: hex.EncodeToString(owner1), AddedNodes: addedNodes}}, randomness)
+			require.Nil(t, result)
+			require.True(t, errors.Is(err, process.ErrInvalidAuctionSimulationChange))
+		}
+		require.False(t, getRootHashCalled)
+	})
+
+	t.Run("too many changes should error", func(t *testing.T) {
+		t.Parallel()
+
+		vp, _ := NewValidatorsProvider(createArgs())
+		defer func() {
+			_ = vp.Close()
+		}()
+
+		changes := make([]*common.AuctionSimulationOwnerChange, maxAuctionSimulationChanges+1)
+		for i := range changes {
+			changes[i] = &common.AuctionSimulationOwnerChange{Owner: hex.EncodeToString(owner1), AddedNodes: 1}
+		}
+		result, err := vp.SimulateAuction(changes, randomness)
+		require.Nil(t, result)
+		require.True(t, errors.Is(err, process.ErrInvalidAuctionSimulationChange))
+	})
+
+	t.Run("error getting root hash", func(t *testing.T) {
+		t.Parallel()
+
+		args := createArgs()
+		args.ValidatorStatistics = &testscommon.ValidatorStatisticsProcessorStub{}
+		vp, _ := NewValidatorsProvider(args)
+		defer func() {
+			_ = vp.Close()
+		}()
+
+		result, err := vp.SimulateAuction(nil, randomness)
+		require.Nil(t, result)
+		require.Equal(t, state.ErrNilRootHash, err)
+	})
+
+	t.Run("error simulating selection", func(t *testing.T) {
+		t.Parallel()
+
+		args := createArgs()
+		expectedErr := errors.New("local error")
+		args.AuctionListSelector = &stakingcommon.AuctionListSelectorStub{
+			SimulateNodesSelectionCalled: func(ownersData map[string]*epochStart.OwnerData, randomness []byte) (*epochStart.AuctionSelectionResult, error) {
+				return nil, expectedErr
+			},
+		}
+		vp, _ := NewValidatorsProvider(args)
+		defer func() {
+			_ = vp.Close()
+		}()
+
+		result, err := vp.SimulateAuction(nil, randomness)
+		require.Nil(t, result)
+		require.Equal(t, expectedErr, err)
+	})
+
+	t.Run("should apply changes and simulate selection", func(t *testing.T) {
+		t.Parallel()
+
+		args := createArgs()
+		args.AuctionListSelector = &stakingcommon.AuctionListSelectorStub{
+			SimulateNodesSelectionCalled: func(ownersData map[string]*epochStart.OwnerData, randomness []byte) (*epochStart.AuctionSelectionResult, error) {
+				require.Equal(t, []byte("randomness"), randomness)
+				require.Len(t, ownersData, 2)
+
+				owner1Data := ownersData[string(owner1)]
+				require.Equal(t, int64(3), owner1Data.NumStakedNodes)
+				require.Equal(t, big.NewInt(1500), owner1Data.TotalTopUp)
+				require.Equal(t, big.NewInt(500), owner1Data.TopUpPerNode)
+				require.Len(t, owner1Data.AuctionList, 3)
+				require.True(t, owner1Data.Qualified)
+
+				owner2Data := ownersData[string(owner2)]
+				require.Equal(t, int64(1), owner2Data.NumStakedNodes)
+				require.Equal(t, big.NewInt(0), owner2Data.TotalTopUp)
+				require.Len(t, owner2Data.AuctionList, 1)
+				require.Len(t, owner2Data.AuctionList[0].GetPublicKey(), len(v1.PublicKey))
+				require.True(t, owner2Data.Qualified)
+
+				return &epochStart.AuctionSelectionResult{
+					AvailableSlots:    3,
+					SelectedNodes:     owner1Data.AuctionList,
+					MinQualifiedTopUp: big.NewInt(500),
+				}, nil
+			},
+		}
+		vp, _ := NewValidatorsProvider(args)
+		defer func() {
+			_ = vp.Close()
+		}()
+
+		result, err := vp.SimulateAuction([]*common.AuctionSimulationOwnerChange{
+			{Owner: hex.EncodeToString(owner1), AddedStake: "3000", AddedNodes: 1},
+			{Owner: hex.EncodeToString(owner2), AddedStake: "2500", AddedNodes: 1},
+		}, randomness)
+		require.Nil(t, err)
+		require.Equal(t, uint32(3), result.AvailableSlots)
+		require.Equal(t, "500", result.MinQualifiedTopUp)
+		require.Len(t, result.SelectedNodes, 3)
+		require.Len(t, result.UnselectedNodes, 1)
+		require.Len(t, result.AuctionList, 2)
+
+		owner1Response := result.AuctionList[0]
+		require.Equal(t, hex.EncodeToString(owner1), owner1Response.Owner)
+		require.Equal(t, hex.EncodeToString(v1.PublicKey), owner1Response.Nodes[0].BlsKey)
+		require.False(t, owner1Response.Nodes[0].Simulated)
+		require.False(t, owner1Response.Nodes[1].Simulated)
+		require.True(t, owner1Response.Nodes[2].Simulated)
+
+		owner2Response := result.AuctionList[1]
+		require.Equal(t, hex.EncodeToString(owner2), owner2Response.Owner)
+		require.True(t, owner2Response.Nodes[0].Simulated)
+		require.False(t, owner2Response.Nodes[0].Qualified)
+		require.Equal(t, owner2Response.Nodes[0].BlsKey, result.UnselectedNodes[0])
+	})
+}
+
+func TestValidatorsProvider_SimulateAuctionWithRealSelector(t *testing.T) {
+	t.Parallel()
+
+	eligibleOwner := []byte("eligibleOwner")
+	owner1 := []byte("owner1")
+	owner2 := []byte("owner2")
+	nodesOwners := map[string][]byte{
+		"pk0": eligibleOwner,
+		"pk1": eligibleOwner,
+		"pk2": owner1,
+		"pk3": owner1,
+		"pk4": owner2,
+	}
+	ownersStake := map[string][]*big.Int{
+		string(eligibleOwner): {big.NewInt(0), big.NewInt(5000), big.NewInt(2)},
+		string(owner1):        {big.NewInt(4000), big.NewInt(9000), big.NewInt(2)},
+		string(owner2):        {big.NewInt(100), big.NewInt(2600), big.NewInt(1)},
+	}
+	systemVM := &mock.VMExecutionHandlerStub{
+		RunSmartContractCallCalled: func(input *vmcommon.ContractCallInput) (*vmcommon.VMOutput, error) {
+			if input.Function == "getOwner" {
+				return &vmcommon.VMOutput{ReturnData: [][]byte{nodesOwners[string(input.Arguments[0])]}}, nil
+			}
+
+			stake := ownersStake[string(input.Arguments[0])]
+			return &vmcommon.VMOutput{ReturnData: [][]byte{stake[0].Bytes(), stake[1].Bytes(), stake[2].Bytes()}}, nil
+		},
+	}
+	stakingDataProvider, err := metachain.NewStakingDataProvider(metachain.StakingDataProviderArgs{
+		EnableEpochsHandler: enableEpochsHandlerMock.NewEnableEpochsHandlerStub(common.StakingV4StartedFlag),
+		SystemVM:            systemVM,
+		MinNodePrice:        "2500",
+	})
+	require.Nil(t, err)
+
+	// 4 slots, 2 of them taken by the eligible nodes which are not shuffled out
+	nodesConfigProvider, err := notifier.NewNodesConfigProvider(forking.NewGenericEpochNotifier(), []config.MaxNodesChangeConfig{
+		{EpochEnable: 0, MaxNumNodes: 4, NodesToShufflePerShard: 0},
+	})
+	require.Nil(t, err)
+	shardCoordinator, err := sharding.NewMultiShardCoordinator(1, core.MetachainShardId)
+	require.Nil(t, err)
+	softAuctionConfig := config.SoftAuctionConfig{
+		TopUpStep:             "10",
+		MinTopUp:              "1",
+		MaxTopUp:              "32000000",
+		MaxNumberOfIterations: 100000,
+	}
+	auctionListDisplayer, err := metachain.NewAuctionListDisplayer(metachain.ArgsAuctionListDisplayer{
+		TableDisplayHandler:      metachain.NewTableDisplayer(),
+		ValidatorPubKeyConverter: &testscommon.PubkeyConverterMock{},
+		AddressPubKeyConverter:   &testscommon.PubkeyConverterMock{},
+		AuctionConfig:            softAuctionConfig,
+	})
+	require.Nil(t, err)
+	auctionListSelector, err := metachain.NewAuctionListSelector(metachain.AuctionListSelectorArgs{
+		ShardCoordinator:             shardCoordinator,
+		StakingDataProvider:          stakingDataProvider,
+		MaxNodesChangeConfigProvider: nodesConfigProvider,
+		AuctionListDisplayHandler:    auctionListDisplayer,
+		SoftAuctionConfig:            softAuctionConfig,
+	})
+	require.Nil(t, err)
+
+	args := createDefaultValidatorsProviderArg()
+	args.StakingDataProvider = stakingDataProvider
+	args.AuctionListSelector = auctionListSelector
+	args.ValidatorStatistics = &testscommon.ValidatorStatisticsProcessorStub{
+		LastFinalizedRootHashCalled: func() []byte {
+			return []byte("root hash")
+		},
+		GetValidatorInfoForRootHashCalled: func(rootHash []byte) (state.ShardValidatorsInfoMapHandler, error) {
+			validatorsMap := state.NewShardValidatorsInfoMap()
+			_ = validatorsMap.Add(&state.ValidatorInfo{PublicKey: []byte("pk0"), List: string(common.EligibleList), ShardId: 0})
+			_ = validatorsMap.Add(&state.ValidatorInfo{PublicKey: []byte("pk1"), List: string(common.EligibleList), ShardId: core.MetachainShardId})
+			_ = validatorsMap.Add(&state.ValidatorInfo{PublicKey: []byte("pk2"), List: string(common.AuctionList)})
+			_ = validatorsMap.Add(&state.ValidatorInfo{PublicKey: []byte("pk3"), List: string(common.AuctionList)})
+			_ = validatorsMap.Add(&state.ValidatorInfo{PublicKey: []byte("pk4"), List: string(common.AuctionList)})
+			return validatorsMap, nil
+		},
+	}
+	vp, _ := NewValidatorsProvider(args)
+	defer func() {
+		_ = vp.Close()
+	}()
+
+	result, err := vp.SimulateAuction(nil, []byte("randomness"))
+	require.Nil(t, err)
+	require.Equal(t, uint32(2), result.AvailableSlots)
+	require.Equal(t, []string{hex.EncodeToString([]byte("pk2")), hex.EncodeToString([]byte("pk3"))}, result.SelectedNodes)
+	require.Equal(t, []string{hex.EncodeToString([]byte("pk4"))}, result.UnselectedNodes)
+	require.Equal(t, uint32(0), stakingDataProvider.GetNumOfValidatorsInCurrentEpoch())
+}
+
 func createMockValidatorInfo() *state.ValidatorInfo {
 	initialInfo := &state.ValidatorInfo{
 		PublicKey:                  []byte("aaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa"),
@@ -1080,6 +1437,185 @@ func createMockShardValidatorInfo() *state.ShardValidatorInfo {
 	return initialInfo
 }
 
+func TestValidatorsProvider_GetNodesShufflingPreview(t *testing.T) {
+	t.Parallel()
+
+	randomness := []byte("randomness")
+	v1 := &state.ValidatorInfo{PublicKey: []byte("pk1"), ShardId: 0, List: string(common.EligibleList), TempRating: 50}
+	v2 := &state.ValidatorInfo{PublicKey: []byte("pk2"), ShardId: core.MetachainShardId, List: string(common.WaitingList), Index: 3}
+	v3 := &state.ValidatorInfo{PublicKey: []byte("pk3"), ShardId: 0, List: string(common.AuctionList)}
+	createArgs := func() ArgValidatorsProvider {
+		args := createDefaultValidatorsProviderArg()
+		args.ValidatorStatistics = &testscommon.ValidatorStatisticsProcessorStub{
+			LastFinalizedRootHashCalled: func() []byte {
+				return []byte("root hash")
+			},
+			GetValidatorInfoForRootHashCalled: func(rootHash []byte) (state.ShardValidatorsInfoMapHandler, error) {
+				validatorsMap := state.NewShardValidatorsInfoMap()
+				_ = validatorsMap.Add(v1.ShallowClone())
+				_ = validatorsMap.Add(v2.ShallowClone())
+				return validatorsMap, nil
+			},
+		}
+
+		return args
+	}
+
+	t.Run("nodes coordinator error should error", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		args := createArgs()
+		args.NodesCoordinator = &shardingMocks.NodesCoordinatorMock{
+			PreviewNodesShufflingCalled: func(validatorsInfo []*state.ShardValidatorInfo, randomness []byte, epoch uint32) (*nodesCoordinator.ShuffledNodes, error) {
+				return nil, expectedErr
+			},
+		}
+		vp, _ := NewValidatorsProvider(args)
+		defer func() {
+			_ = vp.Close()
+		}()
+
+		preview, err := vp.GetNodesShufflingPreview(randomness, 5)
+		require.Nil(t, preview)
+		require.Equal(t, expectedErr, err)
+	})
+	t.Run("auction selection error should error", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		args := createArgs()
+		args.ValidatorStatistics.(*testscommon.ValidatorStatisticsProcessorStub).GetValidatorInfoForRootHashCalled = func(rootHash []byte) (state.ShardValidatorsInfoMapHandler, error) {
+			validatorsMap := state.NewShardValidatorsInfoMap()
+			_ = validatorsMap.Add(v1.ShallowClone())
+			_ = validatorsMap.Add(v3.ShallowClone())
+			return validatorsMap, nil
+		}
+		args.AuctionListSelector = &stakingcommon.AuctionListSelectorStub{
+			SelectNodesFromAuctionListCalled: func(validatorsInfoMap state.ShardValidatorsInfoMapHandler, randomness []byte) error {
+				return expectedErr
+			},
+		}
+		vp, _ := NewValidatorsProvider(args)
+		defer func() {
+			_ = vp.Close()
+		}()
+
+		preview, err := vp.GetNodesShufflingPreview(randomness, 5)
+		require.Nil(t, preview)
+		require.Equal(t, expectedErr, err)
+	})
+	t.Run("should select the nodes from auction before shuffling", func(t *testing.T) {
+		t.Parallel()
+
+		args := createArgs()
+		args.ValidatorStatistics.(*testscommon.ValidatorStatisticsProcessorStub).GetValidatorInfoForRootHashCalled = func(rootHash []byte) (state.ShardValidatorsInfoMapHandler, error) {
+			validatorsMap := state.NewShardValidatorsInfoMap()
+			_ = validatorsMap.Add(v1.ShallowClone())
+			_ = validatorsMap.Add(v3.ShallowClone())
+			return validatorsMap, nil
+		}
+		filledValidators := make([][]byte, 0)
+		cleaned := false
+		args.StakingDataProvider = &stakingcommon.StakingDataProviderStub{
+			FillValidatorInfoCalled: func(validator state.ValidatorInfoHandler) error {
+				require.False(t, cleaned)
+				filledValidators = append(filledValidators, validator.GetPublicKey())
+				return nil
+			},
+			CleanCalled: func() {
+				cleaned = true
+			},
+		}
+		args.AuctionListSelector = &stakingcommon.AuctionListSelectorStub{
+			SelectNodesFromAuctionListCalled: func(validatorsInfoMap state.ShardValidatorsInfoMapHandler, rand []byte) error {
+				require.False(t, cleaned)
+				require.Equal(t, randomness, rand)
+				for _, validator := range validatorsInfoMap.GetAllValidatorsInfo() {
+					if validator.GetList() == string(common.AuctionList) {
+						validator.SetList(string(common.SelectedFromAuctionList))
+					}
+				}
+				return nil
+			},
+		}
+		args.NodesCoordinator = &shardingMocks.NodesCoordinatorMock{
+			PreviewNodesShufflingCalled: func(validatorsInfo []*state.ShardValidatorInfo, rand []byte, epoch uint32) (*nodesCoordinator.ShuffledNodes, error) {
+				require.True(t, cleaned)
+				require.ElementsMatch(t, []*state.ShardValidatorInfo{
+					{PublicKey: v1.PublicKey, ShardId: 0, List: string(common.EligibleList), TempRating: 50},
+					{PublicKey: v3.PublicKey, ShardId: 0, List: string(common.SelectedFromAuctionList)},
+				}, validatorsInfo)
+
+				return &nodesCoordinator.ShuffledNodes{}, nil
+			},
+		}
+		vp, _ := NewValidatorsProvider(args)
+		defer func() {
+			_ = vp.Close()
+		}()
+
+		preview, err := vp.GetNodesShufflingPreview(randomness, 5)
+		require.Nil(t, err)
+		require.NotNil(t, preview)
+		require.ElementsMatch(t, [][]byte{v1.PublicKey, v3.PublicKey}, filledValidators)
+	})
+	t.Run("should return the projected lists of each shard", func(t *testing.T) {
+		t.Parallel()
+
+		args := createArgs()
+		args.NodesCoordinator = &shardingMocks.NodesCoordinatorMock{
+			PreviewNodesShufflingCalled: func(validatorsInfo []*state.ShardValidatorInfo, rand []byte, epoch uint32) (*nodesCoordinator.ShuffledNodes, error) {
+				require.Equal(t, randomness, rand)
+				require.Equal(t, uint32(5), epoch)
+				require.ElementsMatch(t, []*state.ShardValidatorInfo{
+					{PublicKey: v1.PublicKey, ShardId: 0, List: string(common.EligibleList), TempRating: 50},
+					{PublicKey: v2.PublicKey, ShardId: core.MetachainShardId, List: string(common.WaitingList), Index: 3},
+				}, validatorsInfo)
+
+				return &nodesCoordinator.ShuffledNodes{
+					Eligible: map[uint32][]nodesCoordinator.Validator{
+						0:                     {shardingMocks.NewValidatorMock(v2.PublicKey, 1, 0)},
+						core.MetachainShardId: {},
+					},
+					Leaving: map[uint32][]nodesCoordinator.Validator{
+						0: {shardingMocks.NewValidatorMock(v1.PublicKey, 1, 0)},
+					},
+					ShuffledOut: map[uint32][]nodesCoordinator.Validator{
+						core.MetachainShardId: {shardingMocks.NewValidatorMock(v2.PublicKey, 1, 0)},
+					},
+				}, nil
+			},
+		}
+		vp, _ := NewValidatorsProvider(args)
+		defer func() {
+			_ = vp.Close()
+		}()
+
+		preview, err := vp.GetNodesShufflingPreview(randomness, 5)
+		require.Nil(t, err)
+		require.Equal(t, &common.NodesShufflingPreviewAPIResponse{
+			IsProjection: true,
+			NotSimulated: []string{"jailing", "unjailing", "unqualifiedNodesUnStaking"},
+			Epoch:        5,
+			Shards: map[uint32]*common.NodesShufflingPreviewShardAPIResponse{
+				0: {
+					Eligible:    []string{hex.EncodeToString(v2.PublicKey)},
+					Waiting:     []string{},
+					Leaving:     []string{hex.EncodeToString(v1.PublicKey)},
+					ShuffledOut: []string{},
+				},
+				core.MetachainShardId: {
+					Eligible:    []string{},
+					Waiting:     []string{},
+					Leaving:     []string{},
+					ShuffledOut: []string{hex.EncodeToString(v2.PublicKey)},
+				},
+			},
+		}, preview)
+	})
+}
+
 func createDefaultValidatorsProviderArg() ArgValidatorsProvider {
 	return ArgValidatorsProvider{
 		NodesCoordinator:                  &shardingMocks.NodesCoordinatorMock{},
@@ -1096,5 +1632,7 @@ func createDefaultValidatorsProviderArg() ArgValidatorsProvider {
 		ValidatorPubKeyConverter: testscommon.NewPubkeyConverterMock(32),
 		AddressPubKeyConverter:   testscommon.NewPubkeyConverterMock(32),
 		AuctionListSelector:      &stakingcommon.AuctionListSelectorStub{},
+		MinNodePrice:             big.NewInt(2500),
+		MaxNumNodes:              100,
 	}
 }
@@ -20,6 +20,7 @@ import (
 
 var _ process.TxValidatorHandler = (*InterceptedTransaction)(nil)
 var _ process.InterceptedData = (*InterceptedTransaction)(nil)
+var _ process.InterceptedTxReplacementHandler = (*InterceptedTransaction)(nil)
 
 // InterceptedTransaction holds and manages a transaction based struct with extended functionality
 type InterceptedTransaction struct {
@@ -37,6 +38,7 @@ type InterceptedTransaction struct {
 	whiteListerVerifiedTxs process.WhiteListHandler
 	argsParser             process.ArgumentsParser
 	txVersionChecker       process.TxVersionCheckerHandler
+	txReplacementChecker   process.TxReplacementChecker
 	chainID                []byte
 	rcvShard               uint32
 	sndShard               uint32
@@ -44,7 +46,8 @@ type InterceptedTransaction struct {
 	enableSignedTxWithHash bool
 }
 
-// NewInterceptedTransaction returns a new instance of InterceptedTransaction
+// NewInterceptedTransaction returns a new instance of InterceptedTransaction. The transaction replacement checker is
+// optional, a nil value disabling the same-nonce replacement checks
 func NewInterceptedTransaction(
 	txBuff []byte,
 	protoMarshalizer marshal.Marshalizer,
@@ -61,6 +64,7 @@ func NewInterceptedTransaction(
 	enableSignedTxWithHash bool,
 	txSignHasher hashing.Hasher,
 	txVersionChecker process.TxVersionCheckerHandler,
+	txReplacementChecker process.TxReplacementChecker,
 ) (*InterceptedTransaction, error) {
 
 	if txBuff == nil {
@@ -126,6 +130,7 @@ func NewInterceptedTransaction(
 		chainID:                chainID,
 		enableSignedTxWithHash: enableSignedTxWithHash,
 		txVersionChecker:       txVersionChecker,
+		txReplacementChecker:   txReplacementChecker,
 		txSignHasher:           txSignHasher,
 	}
 
@@ -205,6 +210,18 @@ func (inTx *InterceptedTransaction) CheckValidity() error {
 	return nil
 }
 
+// CheckReplacement returns an error if the transaction is not allowed to replace the pooled transaction with the
+// same sender and nonce. It is not part of the validity check, so that a rejected replacement does not invalidate the
+// other transactions received in the same batch
+func (inTx *InterceptedTransaction) CheckReplacement() error {
+	if check.IfNil(inTx.txReplacementChecker) {
+		return nil
+	}
+
+	cacheID := process.ShardCacherIdentifier(inTx.sndShard, inTx.rcvShard)
+	return inTx.txReplacementChecker.CheckTxReplacement(inTx.hash, inTx.tx, cacheID)
+}
+
 func isRelayedTx(funcName string) bool {
 	return core.RelayedTransaction == funcName || core.RelayedTransactionV2 == funcName
 }
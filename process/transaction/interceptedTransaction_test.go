@@ -113,10 +113,21 @@ func createInterceptedTxWithTxFeeHandlerAndVersionChecker(tx *dataTransaction.Tr
 		false,
 		&hashingMocks.HasherMock{},
 		txVerChecker,
+		nil,
 	)
 }
 
 func createInterceptedTxFromPlainTx(tx *dataTransaction.Transaction, txFeeHandler process.FeeHandler, chainID []byte, minTxVersion uint32) (*transaction.InterceptedTransaction, error) {
+	return createInterceptedTxFromPlainTxWithReplacementChecker(tx, txFeeHandler, chainID, minTxVersion, nil)
+}
+
+func createInterceptedTxFromPlainTxWithReplacementChecker(
+	tx *dataTransaction.Transaction,
+	txFeeHandler process.FeeHandler,
+	chainID []byte,
+	minTxVersion uint32,
+	txReplacementChecker process.TxReplacementChecker,
+) (*transaction.InterceptedTransaction, error) {
 	marshalizer := &mock.MarshalizerMock{}
 	txBuff, err := marshalizer.Marshal(tx)
 	if err != nil {
@@ -156,6 +167,7 @@ func createInterceptedTxFromPlainTx(tx *dataTransaction.Transaction, txFeeHandle
 		false,
 		&hashingMocks.HasherMock{},
 		versioning.NewTxVersionChecker(minTxVersion),
+		txReplacementChecker,
 	)
 }
 
@@ -199,6 +211,7 @@ func createInterceptedTxFromPlainTxWithArgParser(tx *dataTransaction.Transaction
 		false,
 		&hashingMocks.HasherMock{},
 		versioning.NewTxVersionChecker(tx.Version),
+		nil,
 	)
 }
 
@@ -223,6 +236,7 @@ func TestNewInterceptedTransaction_NilBufferShouldErr(t *testing.T) {
 		false,
 		&hashingMocks.HasherMock{},
 		versioning.NewTxVersionChecker(1),
+		nil,
 	)
 
 	assert.Nil(t, txi)
@@ -248,6 +262,7 @@ func TestNewInterceptedTransaction_NilArgsParser(t *testing.T) {
 		false,
 		&hashingMocks.HasherMock{},
 		versioning.NewTxVersionChecker(1),
+		nil,
 	)
 
 	assert.Nil(t, txi)
@@ -273,6 +288,7 @@ func TestNewInterceptedTransaction_NilVersionChecker(t *testing.T) {
 		false,
 		&hashingMocks.HasherMock{},
 		nil,
+		nil,
 	)
 
 	assert.Nil(t, txi)
@@ -298,6 +314,7 @@ func TestNewInterceptedTransaction_NilMarshalizerShouldErr(t *testing.T) {
 		false,
 		&hashingMocks.HasherMock{},
 		versioning.NewTxVersionChecker(1),
+		nil,
 	)
 
 	assert.Nil(t, txi)
@@ -323,6 +340,7 @@ func TestNewInterceptedTransaction_NilSignMarshalizerShouldErr(t *testing.T) {
 		false,
 		&hashingMocks.HasherMock{},
 		versioning.NewTxVersionChecker(1),
+		nil,
 	)
 
 	assert.Nil(t, txi)
@@ -348,6 +366,7 @@ func TestNewInterceptedTransaction_NilHasherShouldErr(t *testing.T) {
 		false,
 		&hashingMocks.HasherMock{},
 		versioning.NewTxVersionChecker(1),
+		nil,
 	)
 
 	assert.Nil(t, txi)
@@ -373,6 +392,7 @@ func TestNewInterceptedTransaction_NilKeyGenShouldErr(t *testing.T) {
 		false,
 		&hashingMocks.HasherMock{},
 		versioning.NewTxVersionChecker(1),
+		nil,
 	)
 
 	assert.Nil(t, txi)
@@ -398,6 +418,7 @@ func TestNewInterceptedTransaction_NilSignerShouldErr(t *testing.T) {
 		false,
 		&hashingMocks.HasherMock{},
 		versioning.NewTxVersionChecker(1),
+		nil,
 	)
 
 	assert.Nil(t, txi)
@@ -423,6 +444,7 @@ func TestNewInterceptedTransaction_NilPubkeyConverterShouldErr(t *testing.T) {
 		false,
 		&hashingMocks.HasherMock{},
 		versioning.NewTxVersionChecker(1),
+		nil,
 	)
 
 	assert.Nil(t, txi)
@@ -448,6 +470,7 @@ func TestNewInterceptedTransaction_NilCoordinatorShouldErr(t *testing.T) {
 		false,
 		&hashingMocks.HasherMock{},
 		versioning.NewTxVersionChecker(1),
+		nil,
 	)
 
 	assert.Nil(t, txi)
@@ -473,6 +496,7 @@ func TestNewInterceptedTransaction_NilFeeHandlerShouldErr(t *testing.T) {
 		false,
 		&hashingMocks.HasherMock{},
 		versioning.NewTxVersionChecker(1),
+		nil,
 	)
 
 	assert.Nil(t, txi)
@@ -498,6 +522,7 @@ func TestNewInterceptedTransaction_NilWhiteListerVerifiedTxsShouldErr(t *testing
 		false,
 		&hashingMocks.HasherMock{},
 		versioning.NewTxVersionChecker(1),
+		nil,
 	)
 
 	assert.Nil(t, txi)
@@ -523,6 +548,7 @@ func TestNewInterceptedTransaction_InvalidChainIDShouldErr(t *testing.T) {
 		false,
 		&hashingMocks.HasherMock{},
 		versioning.NewTxVersionChecker(1),
+		nil,
 	)
 
 	assert.Nil(t, txi)
@@ -548,6 +574,7 @@ func TestNewInterceptedTransaction_NilTxSignHasherShouldErr(t *testing.T) {
 		false,
 		nil,
 		versioning.NewTxVersionChecker(1),
+		nil,
 	)
 
 	assert.Nil(t, txi)
@@ -579,6 +606,7 @@ func TestNewInterceptedTransaction_UnmarshalingTxFailsShouldErr(t *testing.T) {
 		false,
 		&hashingMocks.HasherMock{},
 		versioning.NewTxVersionChecker(1),
+		nil,
 	)
 
 	assert.Nil(t, txi)
@@ -995,6 +1023,66 @@ func TestInterceptedTransaction_CheckValidityOkValsShouldWork(t *testing.T) {
 	assert.Nil(t, err)
 }
 
+func TestInterceptedTransaction_CheckReplacementRejectedShouldErr(t *testing.T) {
+	t.Parallel()
+
+	minTxVersion := uint32(1)
+	chainID := []byte("chain")
+	tx := &dataTransaction.Transaction{
+		Nonce:     1,
+		Value:     big.NewInt(2),
+		Data:      []byte("data"),
+		GasLimit:  3,
+		GasPrice:  4,
+		RcvAddr:   recvAddress,
+		SndAddr:   senderAddress,
+		Signature: sigOk,
+		ChainID:   chainID,
+		Version:   minTxVersion,
+	}
+	expectedErr := errors.New("replacement underpriced")
+	checkReplacementCalled := false
+	checker := &txReplacementCheckerStub{
+		checkTxReplacementCalled: func(txHash []byte, txHandler data.TransactionHandler, cacheID string) error {
+			checkReplacementCalled = true
+			assert.Equal(t, process.ShardCacherIdentifier(senderShard, recvShard), cacheID)
+			assert.Equal(t, tx.Nonce, txHandler.GetNonce())
+			return expectedErr
+		},
+	}
+	txi, _ := createInterceptedTxFromPlainTxWithReplacementChecker(tx, createFreeTxFeeHandler(), chainID, minTxVersion, checker)
+
+	err := txi.CheckValidity()
+	assert.Nil(t, err)
+	assert.False(t, checkReplacementCalled)
+
+	err = txi.CheckReplacement()
+	assert.Equal(t, expectedErr, err)
+	assert.True(t, checkReplacementCalled)
+}
+
+func TestInterceptedTransaction_CheckReplacementWithoutCheckerShouldWork(t *testing.T) {
+	t.Parallel()
+
+	tx := &dataTransaction.Transaction{
+		Nonce:     1,
+		Value:     big.NewInt(2),
+		Data:      []byte("data"),
+		GasLimit:  3,
+		GasPrice:  4,
+		RcvAddr:   recvAddress,
+		SndAddr:   senderAddress,
+		Signature: sigOk,
+		ChainID:   []byte("chain"),
+		Version:   1,
+	}
+	txi, _ := createInterceptedTxFromPlainTx(tx, createFreeTxFeeHandler(), []byte("chain"), 1)
+
+	err := txi.CheckReplacement()
+
+	assert.Nil(t, err)
+}
+
 func TestInterceptedTransaction_CheckValiditySignedWithHashButNotEnabled(t *testing.T) {
 	t.Parallel()
 
@@ -1049,6 +1137,7 @@ func TestInterceptedTransaction_CheckValiditySignedWithHashButNotEnabled(t *test
 		false,
 		&hashingMocks.HasherMock{},
 		versioning.NewTxVersionChecker(minTxVersion),
+		nil,
 	)
 
 	err := txi.CheckValidity()
@@ -1109,6 +1198,7 @@ func TestInterceptedTransaction_CheckValiditySignedWithHashShouldWork(t *testing
 		true,
 		&hashingMocks.HasherMock{},
 		versioning.NewTxVersionChecker(minTxVersion),
+		nil,
 	)
 
 	err := txi.CheckValidity()
@@ -1194,6 +1284,7 @@ func TestInterceptedTransaction_ScTxDeployRecvShardIdShouldBeSendersShardId(t *t
 		false,
 		&hashingMocks.HasherMock{},
 		versioning.NewTxVersionChecker(minTxVersion),
+		nil,
 	)
 
 	assert.Nil(t, err)
@@ -1333,6 +1424,7 @@ func TestInterceptedTransaction_CheckValiditySecondTimeDoesNotVerifySig(t *testi
 		false,
 		&hashingMocks.HasherMock{},
 		versioning.NewTxVersionChecker(minTxVersion),
+		nil,
 	)
 	require.Nil(t, err)
 
@@ -1626,6 +1718,7 @@ func TestInterceptedTransaction_Fee(t *testing.T) {
 		false,
 		&hashingMocks.HasherMock{},
 		versioning.NewTxVersionChecker(0),
+		nil,
 	)
 
 	assert.Equal(t, big.NewInt(0), txin.Fee())
@@ -1669,6 +1762,7 @@ func TestInterceptedTransaction_String(t *testing.T) {
 		false,
 		&hashingMocks.HasherMock{},
 		versioning.NewTxVersionChecker(0),
+		nil,
 	)
 
 	expectedFormat := fmt.Sprintf(
@@ -1865,3 +1959,19 @@ func TestInterceptedTransaction_VerifyGuardianSig(t *testing.T) {
 		require.Nil(t, err)
 	})
 }
+
+type txReplacementCheckerStub struct {
+	checkTxReplacementCalled func(txHash []byte, tx data.TransactionHandler, cacheID string) error
+}
+
+func (stub *txReplacementCheckerStub) CheckTxReplacement(txHash []byte, tx data.TransactionHandler, cacheID string) error {
+	if stub.checkTxReplacementCalled != nil {
+		return stub.checkTxReplacementCalled(txHash, tx, cacheID)
+	}
+
+	return nil
+}
+
+func (stub *txReplacementCheckerStub) IsInterfaceNil() bool {
+	return stub == nil
+}
@@ -157,6 +157,11 @@ func (r *simulationAccountsDB) GetTrie(_ []byte) (common.Trie, error) {
 	return nil, nil
 }
 
+// GetStatsForRootHash will call the original accounts' function with the same name
+func (r *simulationAccountsDB) GetStatsForRootHash(rootHash []byte, numLargestDataTries uint32) (common.TriesStatisticsCollector, error) {
+	return r.originalAccounts.GetStatsForRootHash(rootHash, numLargestDataTries)
+}
+
 // CommitInEpoch will do nothing for this implementation
 func (r *simulationAccountsDB) CommitInEpoch(_ uint32, _ uint32) ([]byte, error) {
 	return nil, nil
@@ -344,6 +344,29 @@ func (psm *PeerShardMapper) removePidAssociation(pid core.PeerID) []byte {
 	return oldPkBuff
 }
 
+// GetPeerIDs returns the peer IDs known for the provided public key, the most recently seen one being the first
+func (psm *PeerShardMapper) GetPeerIDs(pk []byte) []core.PeerID {
+	psm.mutUpdatePeerIdPublicKey.RLock()
+	defer psm.mutUpdatePeerIdPublicKey.RUnlock()
+
+	objPidsQueue, found := psm.pkPeerIdCache.Get(pk)
+	if !found {
+		return nil
+	}
+
+	pq, ok := objPidsQueue.(common.PidQueueHandler)
+	if !ok {
+		return nil
+	}
+
+	pids := make([]core.PeerID, 0, pq.Len())
+	for idx := pq.Len() - 1; idx >= 0; idx-- {
+		pids = append(pids, pq.Get(idx))
+	}
+
+	return pids
+}
+
 // PutPeerIdSubType puts the peerIdSubType search map containing peer IDs and peer subtypes
 func (psm *PeerShardMapper) PutPeerIdSubType(pid core.PeerID, peerSubType core.P2PPeerSubType) {
 	psm.peerIdSubTypeCache.Put([]byte(pid), peerSubType, uint32Size)
@@ -543,3 +543,35 @@ func TestPeerShardMapper_PutPeerIdShardId(t *testing.T) {
 	psm.PutPeerIdShardId(providedPid, providedShardID)
 	assert.True(t, wasCalled)
 }
+
+func TestPeerShardMapper_GetPeerIDs(t *testing.T) {
+	t.Parallel()
+
+	pid1 := core.PeerID("pid1")
+	pid2 := core.PeerID("pid2")
+	pid3 := core.PeerID("pid3")
+	pk1 := []byte("pk1")
+	pk2 := []byte("pk2")
+
+	t.Run("unknown public key should return nil", func(t *testing.T) {
+		t.Parallel()
+
+		psm := createPeerShardMapper()
+
+		assert.Nil(t, psm.GetPeerIDs(pk1))
+	})
+	t.Run("should return the most recently seen peer ID first", func(t *testing.T) {
+		t.Parallel()
+
+		psm := createPeerShardMapper()
+
+		psm.UpdatePeerIDPublicKeyPair(pid1, pk1)
+		psm.UpdatePeerIDPublicKeyPair(pid2, pk1)
+		psm.UpdatePeerIDPublicKeyPair(pid3, pk2)
+		assert.Equal(t, []core.PeerID{pid2, pid1}, psm.GetPeerIDs(pk1))
+		assert.Equal(t, []core.PeerID{pid3}, psm.GetPeerIDs(pk2))
+
+		psm.UpdatePeerIDPublicKeyPair(pid1, pk1)
+		assert.Equal(t, []core.PeerID{pid1, pid2}, psm.GetPeerIDs(pk1))
+	})
+}
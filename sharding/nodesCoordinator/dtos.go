@@ -22,3 +22,14 @@ type ResUpdateNodes struct {
 	StillRemaining []Validator
 	LowWaitingList bool
 }
+
+// ShuffledNodes holds the nodes lists of each shard, as resulted after the shuffling done at the start of an epoch
+type ShuffledNodes struct {
+	Eligible       map[uint32][]Validator
+	Waiting        map[uint32][]Validator
+	Leaving        map[uint32][]Validator
+	StillRemaining map[uint32][]Validator
+	ShuffledOut    map[uint32][]Validator
+	NbShards       uint32
+	LowWaitingList bool
+}
@@ -123,3 +123,6 @@ var ErrReceivedAuctionValidatorsBeforeStakingV4 = errors.New("should not have re
 
 // ErrNilEpochNotifier signals that a nil EpochNotifier has been provided
 var ErrNilEpochNotifier = errors.New("nil epoch notifier provided")
+
+// ErrNilEpochStartMetaBlock signals that a nil epoch start meta block has been provided
+var ErrNilEpochStartMetaBlock = errors.New("nil epoch start meta block")
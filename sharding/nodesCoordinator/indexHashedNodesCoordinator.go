@@ -83,6 +83,7 @@ type indexHashedNodesCoordinator struct {
 	marshalizer                     marshal.Marshalizer
 	hasher                          hashing.Hasher
 	shuffler                        NodesShuffler
+	mutShuffling                    sync.Mutex
 	epochStartRegistrationHandler   EpochStartEventNotifier
 	bootStorer                      storage.Storer
 	nodesConfig                     map[uint32]*epochNodesConfig
@@ -103,6 +104,8 @@ type indexHashedNodesCoordinator struct {
 	flagStakingV4Step2              atomicFlags.Flag
 	nodesCoordinatorRegistryFactory NodesCoordinatorRegistryFactory
 	flagStakingV4Started            atomicFlags.Flag
+	mutHistoricalNodesConfig        sync.Mutex
+	historicalNodesConfig           *historicalNodesConfig
 }
 
 // NewIndexHashedNodesCoordinator creates a new index hashed group selector
@@ -674,16 +677,70 @@ func (ihnc *indexHashedNodesCoordinator) EpochStartPrepare(metaHdr data.HeaderHa
 	}
 
 	// TODO: compare with previous nodesConfig if exists
-	newNodesConfig, err := ihnc.computeNodesConfigFromList(allValidatorInfo)
+	shuffledNodes, err := ihnc.computeShuffledNodes(allValidatorInfo, randomness, newEpoch)
 	if err != nil {
-		log.Error("could not compute nodes config from list - do nothing on nodesCoordinator epochStartPrepare")
+		log.Error("could not compute the shuffled nodes - do nothing on nodesCoordinator epochStartPrepare", "err", err.Error())
 		return
 	}
 
+	err = ihnc.setNodesPerShards(
+		shuffledNodes.Eligible,
+		shuffledNodes.Waiting,
+		shuffledNodes.Leaving,
+		shuffledNodes.ShuffledOut,
+		newEpoch,
+		shuffledNodes.LowWaitingList,
+	)
+	if err != nil {
+		log.Error("set nodes per shard failed", "error", err.Error())
+	}
+
+	ihnc.fillPublicKeyToValidatorMap()
+	err = ihnc.saveState(randomness, newEpoch)
+	ihnc.handleErrorLog(err, "saving nodes coordinator config failed")
+
+	displayNodesConfiguration(
+		shuffledNodes.Eligible,
+		shuffledNodes.Waiting,
+		shuffledNodes.Leaving,
+		shuffledNodes.StillRemaining,
+		shuffledNodes.ShuffledOut,
+		shuffledNodes.NbShards)
+
+	ihnc.mutSavedStateKey.Lock()
+	ihnc.savedStateKey = randomness
+	ihnc.mutSavedStateKey.Unlock()
+
+	ihnc.consensusGroupCacher.Clear()
+}
+
+// PreviewNodesShuffling runs the nodes shuffler on the provided validators info, as it would be run at the start of the
+// provided epoch, without altering the nodes configuration of any epoch
+func (ihnc *indexHashedNodesCoordinator) PreviewNodesShuffling(
+	validatorsInfo []*state.ShardValidatorInfo,
+	randomness []byte,
+	epoch uint32,
+) (*ShuffledNodes, error) {
+	if len(randomness) == 0 {
+		return nil, ErrNilRandomness
+	}
+
+	return ihnc.computeShuffledNodes(validatorsInfo, randomness, epoch)
+}
+
+func (ihnc *indexHashedNodesCoordinator) computeShuffledNodes(
+	allValidatorInfo []*state.ShardValidatorInfo,
+	randomness []byte,
+	epoch uint32,
+) (*ShuffledNodes, error) {
+	newNodesConfig, err := ihnc.computeNodesConfigFromList(allValidatorInfo)
+	if err != nil {
+		return nil, fmt.Errorf("%w while computing the nodes config from list", err)
+	}
+
 	additionalLeavingMap, err := ihnc.nodesCoordinatorHelper.ComputeAdditionalLeaving(allValidatorInfo)
 	if err != nil {
-		log.Error("could not compute additionalLeaving Nodes  - do nothing on nodesCoordinator epochStartPrepare")
-		return
+		return nil, fmt.Errorf("%w while computing the additional leaving nodes", err)
 	}
 
 	unStakeLeavingList := ihnc.createSortedListFromMap(newNodesConfig.leavingMap)
@@ -698,13 +755,15 @@ func (ihnc *indexHashedNodesCoordinator) EpochStartPrepare(metaHdr data.HeaderHa
 		AdditionalLeaving: additionalLeavingList,
 		Rand:              randomness,
 		NbShards:          newNodesConfig.nbShards,
-		Epoch:             newEpoch,
+		Epoch:             epoch,
 	}
 
+	// the shuffler configuration is updated on each call, so the previews should not interleave with the epoch start
+	ihnc.mutShuffling.Lock()
 	resUpdateNodes, err := ihnc.shuffler.UpdateNodeLists(shufflerArgs)
+	ihnc.mutShuffling.Unlock()
 	if err != nil {
-		log.Error("could not compute UpdateNodeLists - do nothing on nodesCoordinator epochStartPrepare", "err", err.Error())
-		return
+		return nil, fmt.Errorf("%w while updating the nodes lists", err)
 	}
 
 	leavingNodesMap, stillRemainingNodesMap := createActuallyLeavingPerShards(
@@ -713,28 +772,15 @@ func (ihnc *indexHashedNodesCoordinator) EpochStartPrepare(metaHdr data.HeaderHa
 		resUpdateNodes.Leaving,
 	)
 
-	err = ihnc.setNodesPerShards(resUpdateNodes.Eligible, resUpdateNodes.Waiting, leavingNodesMap, resUpdateNodes.ShuffledOut, newEpoch, resUpdateNodes.LowWaitingList)
-	if err != nil {
-		log.Error("set nodes per shard failed", "error", err.Error())
-	}
-
-	ihnc.fillPublicKeyToValidatorMap()
-	err = ihnc.saveState(randomness, newEpoch)
-	ihnc.handleErrorLog(err, "saving nodes coordinator config failed")
-
-	displayNodesConfiguration(
-		resUpdateNodes.Eligible,
-		resUpdateNodes.Waiting,
-		leavingNodesMap,
-		stillRemainingNodesMap,
-		resUpdateNodes.ShuffledOut,
-		newNodesConfig.nbShards)
-
-	ihnc.mutSavedStateKey.Lock()
-	ihnc.savedStateKey = randomness
-	ihnc.mutSavedStateKey.Unlock()
-
-	ihnc.consensusGroupCacher.Clear()
+	return &ShuffledNodes{
+		Eligible:       resUpdateNodes.Eligible,
+		Waiting:        resUpdateNodes.Waiting,
+		Leaving:        leavingNodesMap,
+		StillRemaining: stillRemainingNodesMap,
+		ShuffledOut:    resUpdateNodes.ShuffledOut,
+		NbShards:       newNodesConfig.nbShards,
+		LowWaitingList: resUpdateNodes.LowWaitingList,
+	}, nil
 }
 
 func (ihnc *indexHashedNodesCoordinator) fillPublicKeyToValidatorMap() {
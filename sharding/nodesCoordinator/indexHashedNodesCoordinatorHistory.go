@@ -0,0 +1,170 @@
+package nodesCoordinator
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-core-go/data"
+	"github.com/multiversx/mx-chain-go/common"
+)
+
+type historicalNodesConfig struct {
+	registryKey []byte
+	epoch       uint32
+	nodesConfig *epochNodesConfig
+}
+
+// GetHistoricalConsensusValidatorsPublicKeys computes the consensus group for a specific shard, randomness and round
+// number of an epoch that is no longer kept in memory, returning the members public keys. The nodes config of the epoch
+// is read from the registry saved in the boot storage when the provided epoch start meta block was processed
+func (ihnc *indexHashedNodesCoordinator) GetHistoricalConsensusValidatorsPublicKeys(
+	epochStartMetaBlock data.HeaderHandler,
+	randomness []byte,
+	round uint64,
+	shardID uint32,
+	epoch uint32,
+) ([]string, error) {
+	if len(randomness) == 0 {
+		return nil, ErrNilRandomness
+	}
+
+	nodesConfig, err := ihnc.getHistoricalNodesConfig(epochStartMetaBlock, epoch)
+	if err != nil {
+		return nil, err
+	}
+
+	if shardID >= nodesConfig.nbShards && shardID != core.MetachainShardId {
+		return nil, ErrInvalidShardId
+	}
+
+	consensusNodes, err := ihnc.baseComputeConsensusGroup(
+		randomness,
+		round,
+		shardID,
+		epoch,
+		nodesConfig.selectors[shardID],
+		nodesConfig.eligibleMap[shardID],
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	pubKeys := make([]string, 0, len(consensusNodes))
+	for _, v := range consensusNodes {
+		pubKeys = append(pubKeys, string(v.PubKey()))
+	}
+
+	return pubKeys, nil
+}
+
+// GetHistoricalValidatorsIndexes returns the indexes of the provided public keys in the eligible list of the shard, for
+// an epoch that is no longer kept in memory. The nodes config of the epoch is read from the registry saved in the boot
+// storage when the provided epoch start meta block was processed
+func (ihnc *indexHashedNodesCoordinator) GetHistoricalValidatorsIndexes(
+	epochStartMetaBlock data.HeaderHandler,
+	publicKeys []string,
+	shardID uint32,
+	epoch uint32,
+) ([]uint64, error) {
+	nodesConfig, err := ihnc.getHistoricalNodesConfig(epochStartMetaBlock, epoch)
+	if err != nil {
+		return nil, err
+	}
+
+	signersIndexes := make([]uint64, 0, len(publicKeys))
+	eligibleList := nodesConfig.eligibleMap[shardID]
+	for _, pubKey := range publicKeys {
+		for index, validator := range eligibleList {
+			if bytes.Equal([]byte(pubKey), validator.PubKey()) {
+				signersIndexes = append(signersIndexes, uint64(index))
+			}
+		}
+	}
+
+	if len(publicKeys) != len(signersIndexes) {
+		return nil, ErrInvalidNumberPubKeys
+	}
+
+	return signersIndexes, nil
+}
+
+func (ihnc *indexHashedNodesCoordinator) getHistoricalNodesConfig(
+	epochStartMetaBlock data.HeaderHandler,
+	epoch uint32,
+) (*epochNodesConfig, error) {
+	if check.IfNil(epochStartMetaBlock) {
+		return nil, ErrNilEpochStartMetaBlock
+	}
+
+	registryKey := epochStartMetaBlock.GetPrevRandSeed()
+
+	ihnc.mutHistoricalNodesConfig.Lock()
+	defer ihnc.mutHistoricalNodesConfig.Unlock()
+
+	cached := ihnc.historicalNodesConfig
+	if cached != nil && cached.epoch == epoch && bytes.Equal(cached.registryKey, registryKey) {
+		return cached.nodesConfig, nil
+	}
+
+	buff, err := ihnc.getHistoricalRegistryData(registryKey, epochStartMetaBlock.GetEpoch())
+	if err != nil {
+		return nil, err
+	}
+
+	registry, err := ihnc.nodesCoordinatorRegistryFactory.CreateNodesCoordinatorRegistry(buff)
+	if err != nil {
+		return nil, err
+	}
+
+	epochValidators, ok := registry.GetEpochsConfig()[fmt.Sprint(epoch)]
+	if !ok {
+		return nil, fmt.Errorf("%w epoch=%v in the saved registry", ErrEpochNodesConfigDoesNotExist, epoch)
+	}
+
+	nodesConfig, err := epochValidatorsToEpochNodesConfig(epochValidators)
+	if err != nil {
+		return nil, err
+	}
+
+	nbShards := uint32(len(nodesConfig.eligibleMap))
+	if nbShards < 2 {
+		return nil, ErrInvalidNumberOfShards
+	}
+
+	// shards without metachain shard
+	nodesConfig.nbShards = nbShards - 1
+	nodesConfig.selectors, err = ihnc.createSelectors(nodesConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	ihnc.historicalNodesConfig = &historicalNodesConfig{
+		registryKey: registryKey,
+		epoch:       epoch,
+		nodesConfig: nodesConfig,
+	}
+
+	return nodesConfig, nil
+}
+
+// getHistoricalRegistryData searches the registry saved at the start of the provided epoch. The registry is saved while
+// the epoch start block is processed, before the storers switch to the new epoch
+func (ihnc *indexHashedNodesCoordinator) getHistoricalRegistryData(registryKey []byte, epochStartEpoch uint32) ([]byte, error) {
+	ncInternalKey := append([]byte(common.NodesCoordinatorRegistryKeyPrefix), registryKey...)
+
+	buff, err := ihnc.bootStorer.SearchFirst(ncInternalKey)
+	if err == nil {
+		return buff, nil
+	}
+
+	if epochStartEpoch > 0 {
+		buff, err = ihnc.bootStorer.GetFromEpoch(ncInternalKey, epochStartEpoch-1)
+		if err == nil {
+			return buff, nil
+		}
+	}
+
+	return ihnc.bootStorer.GetFromEpoch(ncInternalKey, epochStartEpoch)
+}
@@ -0,0 +1,126 @@
+package nodesCoordinator
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/data/block"
+	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/testscommon/enableEpochsHandlerMock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createArgumentsWithStakingV4Epoch() ArgNodesCoordinator {
+	args := createArguments()
+	args.EnableEpochsHandler = &enableEpochsHandlerMock.EnableEpochsHandlerStub{
+		GetActivationEpochCalled: func(flag core.EnableEpochFlag) uint32 {
+			if flag == common.StakingV4Step2Flag {
+				return stakingV4Epoch
+			}
+			return 0
+		},
+	}
+
+	return args
+}
+
+func TestIndexHashedNodesCoordinator_GetHistoricalConsensusValidatorsPublicKeys(t *testing.T) {
+	t.Parallel()
+
+	randomness := []byte("randomness")
+	registryKey := []byte("registry key")
+	epochStartMetaBlock := &block.MetaBlock{
+		Epoch:        1,
+		PrevRandSeed: registryKey,
+	}
+
+	t.Run("nil epoch start meta block should error", func(t *testing.T) {
+		t.Parallel()
+
+		ihnc, _ := NewIndexHashedNodesCoordinator(createArguments())
+		pubKeys, err := ihnc.GetHistoricalConsensusValidatorsPublicKeys(nil, randomness, 1, 0, 0)
+		assert.Equal(t, ErrNilEpochStartMetaBlock, err)
+		assert.Nil(t, pubKeys)
+	})
+	t.Run("nil randomness should error", func(t *testing.T) {
+		t.Parallel()
+
+		ihnc, _ := NewIndexHashedNodesCoordinator(createArguments())
+		pubKeys, err := ihnc.GetHistoricalConsensusValidatorsPublicKeys(epochStartMetaBlock, nil, 1, 0, 0)
+		assert.Equal(t, ErrNilRandomness, err)
+		assert.Nil(t, pubKeys)
+	})
+	t.Run("missing registry should error", func(t *testing.T) {
+		t.Parallel()
+
+		ihnc, _ := NewIndexHashedNodesCoordinator(createArguments())
+		pubKeys, err := ihnc.GetHistoricalConsensusValidatorsPublicKeys(epochStartMetaBlock, randomness, 1, 0, 0)
+		assert.NotNil(t, err)
+		assert.Nil(t, pubKeys)
+	})
+	t.Run("epoch missing from the registry should error", func(t *testing.T) {
+		t.Parallel()
+
+		ihnc, _ := NewIndexHashedNodesCoordinator(createArgumentsWithStakingV4Epoch())
+		require.Nil(t, ihnc.saveState(registryKey, 0))
+
+		pubKeys, err := ihnc.GetHistoricalConsensusValidatorsPublicKeys(epochStartMetaBlock, randomness, 1, 0, 5)
+		assert.True(t, errors.Is(err, ErrEpochNodesConfigDoesNotExist))
+		assert.Nil(t, pubKeys)
+	})
+	t.Run("should compute the same consensus and indexes as for the epoch kept in memory", func(t *testing.T) {
+		t.Parallel()
+
+		args := createArgumentsWithStakingV4Epoch()
+		args.ShardConsensusGroupSize = 3
+		args.MetaConsensusGroupSize = 3
+		ihnc, _ := NewIndexHashedNodesCoordinator(args)
+
+		round := uint64(7)
+		for _, shardID := range []uint32{0, core.MetachainShardId} {
+			expectedPubKeys, err := ihnc.GetConsensusValidatorsPublicKeys(randomness, round, shardID, 0)
+			require.Nil(t, err)
+			require.Equal(t, 3, len(expectedPubKeys))
+
+			ihnc.mutNodesConfig.RLock()
+			eligibleList := ihnc.nodesConfig[0].eligibleMap[shardID]
+			ihnc.mutNodesConfig.RUnlock()
+			expectedIndexes := make([]uint64, 0, len(expectedPubKeys))
+			for _, pubKey := range expectedPubKeys {
+				for index, validator := range eligibleList {
+					if string(validator.PubKey()) == pubKey {
+						expectedIndexes = append(expectedIndexes, uint64(index))
+					}
+				}
+			}
+
+			require.Nil(t, ihnc.saveState(registryKey, 0))
+			ihnc.mutNodesConfig.Lock()
+			liveConfig := ihnc.nodesConfig[0]
+			delete(ihnc.nodesConfig, 0)
+			ihnc.mutNodesConfig.Unlock()
+			ihnc.consensusGroupCacher.Clear()
+
+			_, err = ihnc.GetConsensusValidatorsPublicKeys(randomness, round, shardID, 0)
+			require.True(t, errors.Is(err, ErrEpochNodesConfigDoesNotExist))
+
+			pubKeys, err := ihnc.GetHistoricalConsensusValidatorsPublicKeys(epochStartMetaBlock, randomness, round, shardID, 0)
+			assert.Nil(t, err)
+			assert.Equal(t, expectedPubKeys, pubKeys)
+
+			indexes, err := ihnc.GetHistoricalValidatorsIndexes(epochStartMetaBlock, pubKeys, shardID, 0)
+			assert.Nil(t, err)
+			assert.Equal(t, expectedIndexes, indexes)
+
+			indexes, err = ihnc.GetHistoricalValidatorsIndexes(epochStartMetaBlock, []string{"unknown"}, shardID, 0)
+			assert.Equal(t, ErrInvalidNumberPubKeys, err)
+			assert.Nil(t, indexes)
+
+			ihnc.mutNodesConfig.Lock()
+			ihnc.nodesConfig[0] = liveConfig
+			ihnc.mutNodesConfig.Unlock()
+		}
+	})
+}
@@ -1257,6 +1257,89 @@ func TestIndexHashedNodesCoordinator_EpochStart(t *testing.T) {
 	require.False(t, isValidator)
 }
 
+func TestIndexHashedNodesCoordinator_PreviewNodesShuffling(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil randomness should error", func(t *testing.T) {
+		t.Parallel()
+
+		ihnc, _ := NewIndexHashedNodesCoordinator(createArguments())
+		shuffledNodes, err := ihnc.PreviewNodesShuffling(nil, nil, 1)
+		require.Nil(t, shuffledNodes)
+		require.Equal(t, ErrNilRandomness, err)
+	})
+	t.Run("no validators should error", func(t *testing.T) {
+		t.Parallel()
+
+		ihnc, _ := NewIndexHashedNodesCoordinator(createArguments())
+		shuffledNodes, err := ihnc.PreviewNodesShuffling(nil, []byte("rand seed"), 1)
+		require.Nil(t, shuffledNodes)
+		require.True(t, errors.Is(err, ErrMapSizeZero))
+	})
+	t.Run("should return the same lists as the epoch start without altering the nodes config", func(t *testing.T) {
+		t.Parallel()
+
+		arguments := createArguments()
+		arguments.ValidatorInfoCacher = dataPool.NewCurrentEpochValidatorInfoPool()
+		ihnc, err := NewIndexHashedNodesCoordinator(arguments)
+		require.Nil(t, err)
+		epoch := uint32(1)
+		randomness := []byte("rand seed")
+
+		validatorsInfo := make([]*state.ShardValidatorInfo, 0)
+		addValidatorsInfo := func(nodesMap map[uint32][]Validator, list common.PeerType) {
+			for shardID, validators := range nodesMap {
+				for index, v := range validators {
+					validatorsInfo = append(validatorsInfo, &state.ShardValidatorInfo{
+						PublicKey:  v.PubKey(),
+						ShardId:    shardID,
+						List:       string(list),
+						Index:      uint32(index),
+						TempRating: 10,
+					})
+				}
+			}
+		}
+		addValidatorsInfo(ihnc.nodesConfig[0].eligibleMap, common.EligibleList)
+		addValidatorsInfo(ihnc.nodesConfig[0].waitingMap, common.WaitingList)
+
+		shuffledNodes, err := ihnc.PreviewNodesShuffling(validatorsInfo, randomness, epoch)
+		require.Nil(t, err)
+		_, exists := ihnc.nodesConfig[epoch]
+		require.False(t, exists)
+
+		header := &block.MetaBlock{
+			PrevRandSeed: randomness,
+			EpochStart:   block.EpochStart{LastFinalizedHeaders: []block.EpochStartShardData{{}}},
+			Epoch:        epoch,
+		}
+		ihnc.nodesConfig[epoch] = ihnc.nodesConfig[0]
+		body := createBlockBodyFromNodesCoordinator(ihnc, epoch, ihnc.validatorInfoCacher)
+		ihnc.EpochStartPrepare(header, body)
+
+		eligible, err := ihnc.GetAllEligibleValidatorsPublicKeys(epoch)
+		require.Nil(t, err)
+		waiting, err := ihnc.GetAllWaitingValidatorsPublicKeys(epoch)
+		require.Nil(t, err)
+		require.Equal(t, len(eligible), len(shuffledNodes.Eligible))
+		for shardID, validators := range shuffledNodes.Eligible {
+			require.Equal(t, eligible[shardID], pubKeysFromValidators(validators))
+		}
+		for shardID, validators := range shuffledNodes.Waiting {
+			require.Equal(t, waiting[shardID], pubKeysFromValidators(validators))
+		}
+	})
+}
+
+func pubKeysFromValidators(validators []Validator) [][]byte {
+	var pubKeys [][]byte
+	for _, v := range validators {
+		pubKeys = append(pubKeys, v.PubKey())
+	}
+
+	return pubKeys
+}
+
 func TestIndexHashedNodesCoordinator_setNodesPerShardsShouldTriggerWrongConfiguration(t *testing.T) {
 	t.Parallel()
 
@@ -33,6 +33,7 @@ type NodesCoordinator interface {
 	GetNumTotalEligible() uint64
 	GetWaitingEpochsLeftForPublicKey(publicKey []byte) (uint32, error)
 	EpochStartPrepare(metaHdr data.HeaderHandler, body data.BodyHandler)
+	PreviewNodesShuffling(validatorsInfo []*state.ShardValidatorInfo, randomness []byte, epoch uint32) (*ShuffledNodes, error)
 	NodesCoordinatorToRegistry(epoch uint32) NodesCoordinatorRegistryHandler
 	IsInterfaceNil() bool
 }
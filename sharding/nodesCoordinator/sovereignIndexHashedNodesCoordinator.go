@@ -5,6 +5,8 @@ import (
 
 	"github.com/multiversx/mx-chain-core-go/core"
 	"github.com/multiversx/mx-chain-core-go/data"
+
+	"github.com/multiversx/mx-chain-go/state"
 )
 
 type sovereignIndexHashedNodesCoordinator struct {
@@ -210,6 +212,11 @@ func (ihnc *sovereignIndexHashedNodesCoordinator) EpochStartPrepare(_ data.Heade
 	log.Error("sovereignIndexHashedNodesCoordinator.EpochStartPrepare was called, not implemented in sovereign")
 }
 
+// PreviewNodesShuffling is not implemented for sovereign
+func (ihnc *sovereignIndexHashedNodesCoordinator) PreviewNodesShuffling(_ []*state.ShardValidatorInfo, _ []byte, _ uint32) (*ShuffledNodes, error) {
+	return nil, ErrNotImplemented
+}
+
 func displaySovereignNodesConfiguration(
 	eligible map[uint32][]Validator,
 	waiting map[uint32][]Validator,
@@ -1124,9 +1124,9 @@ func (adb *AccountsDB) Close() error {
 	return adb.storagePruningManager.Close()
 }
 
-// GetStatsForRootHash will get trie statistics for the given rootHash
-func (adb *AccountsDB) GetStatsForRootHash(rootHash []byte) (common.TriesStatisticsCollector, error) {
-	stats := statistics.NewTrieStatisticsCollector()
+// GetStatsForRootHash will get trie statistics for the given rootHash, keeping the provided number of largest data tries
+func (adb *AccountsDB) GetStatsForRootHash(rootHash []byte, numLargestDataTries uint32) (common.TriesStatisticsCollector, error) {
+	stats := statistics.NewTrieStatisticsCollectorWithNumLargestDataTries(numLargestDataTries)
 	mainTrie := adb.getMainTrie()
 
 	tr, ok := mainTrie.(common.TrieStats)
@@ -1134,15 +1134,21 @@ func (adb *AccountsDB) GetStatsForRootHash(rootHash []byte) (common.TriesStatist
 		return nil, fmt.Errorf("invalid trie, type is %T", mainTrie)
 	}
 
-	collectStats(tr, stats, rootHash, "", common.MainTrie)
+	err := collectStats(tr, stats, rootHash, "", common.MainTrie)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
 
 	iteratorChannels := &common.TrieIteratorChannels{
 		LeavesChan: make(chan core.KeyValueHolder, leavesChannelSize),
 		ErrChan:    errChan.NewErrChanWrapper(),
 	}
-	err := mainTrie.GetAllLeavesOnChannel(
+	err = mainTrie.GetAllLeavesOnChannel(
 		iteratorChannels,
-		context.Background(),
+		ctx,
 		rootHash,
 		keyBuilder.NewKeyBuilder(),
 		parsers.NewMainTrieLeafParser(),
@@ -1151,33 +1157,58 @@ func (adb *AccountsDB) GetStatsForRootHash(rootHash []byte) (common.TriesStatist
 		return nil, err
 	}
 
-	for leaf := range iteratorChannels.LeavesChan {
+	err = adb.collectDataTriesStats(tr, stats, iteratorChannels.LeavesChan)
+	if err != nil {
+		cancel()
+		drainLeavesChannel(iteratorChannels.LeavesChan)
+		return nil, err
+	}
+
+	err = iteratorChannels.ErrChan.ReadFromChanNonBlocking()
+	if err != nil {
+		return nil, err
+	}
+
+	return stats, nil
+}
+
+func (adb *AccountsDB) collectDataTriesStats(
+	tr common.TrieStats,
+	stats common.TriesStatisticsCollector,
+	leavesChannel chan core.KeyValueHolder,
+) error {
+	for leaf := range leavesChannel {
 		userAccount, skipAccount, err := getUserAccountFromBytes(adb.accountFactory, adb.marshaller, leaf.Key(), leaf.Value())
 		if err != nil {
-			return nil, err
+			return err
 		}
 		if skipAccount {
 			continue
 		}
 
+		stats.IncrementNumAccounts()
 		if common.IsEmptyTrie(userAccount.GetRootHash()) {
 			continue
 		}
 
 		accountAddress, err := adb.addressConverter.Encode(userAccount.AddressBytes())
 		if err != nil {
-			return nil, err
+			return err
 		}
 
-		collectStats(tr, stats, userAccount.GetRootHash(), accountAddress, common.DataTrie)
+		err = collectStats(tr, stats, userAccount.GetRootHash(), accountAddress, common.DataTrie)
+		if err != nil {
+			return err
+		}
 	}
 
-	err = iteratorChannels.ErrChan.ReadFromChanNonBlocking()
-	if err != nil {
-		return nil, err
-	}
+	return nil
+}
 
-	return stats, nil
+// drainLeavesChannel consumes the remaining leaves so the trie iterator goroutine can finish
+func drainLeavesChannel(leavesChannel chan core.KeyValueHolder) {
+	for range leavesChannel {
+	}
 }
 
 func collectStats(
@@ -1186,15 +1217,16 @@ func collectStats(
 	rootHash []byte,
 	address string,
 	trieType common.TrieType,
-) {
+) error {
 	trieStats, err := tr.GetTrieStats(address, rootHash)
 	if err != nil {
-		log.Error(err.Error())
-		return
+		return fmt.Errorf("%w while collecting the statistics of the %s trie with root hash %x", err, trieType, rootHash)
 	}
 	stats.Add(trieStats, trieType)
 
 	log.Debug(strings.Join(trieStats.ToString(), " "))
+
+	return nil
 }
 
 // IsSnapshotInProgress returns true if there is a snapshot in progress
@@ -227,6 +227,11 @@ func (accountsDB *accountsDBApi) GetTrie(rootHash []byte) (common.Trie, error) {
 	return accountsDB.innerAccountsAdapter.GetTrie(rootHash)
 }
 
+// GetStatsForRootHash will call the inner accountsAdapter method
+func (accountsDB *accountsDBApi) GetStatsForRootHash(rootHash []byte, numLargestDataTries uint32) (common.TriesStatisticsCollector, error) {
+	return accountsDB.innerAccountsAdapter.GetStatsForRootHash(rootHash, numLargestDataTries)
+}
+
 // GetStackDebugFirstEntry will call the inner accountsAdapter method
 func (accountsDB *accountsDBApi) GetStackDebugFirstEntry() []byte {
 	return accountsDB.innerAccountsAdapter.GetStackDebugFirstEntry()
@@ -130,6 +130,11 @@ func (accountsDB *accountsDBApiWithHistory) GetTrie(_ []byte) (common.Trie, erro
 	return nil, ErrFunctionalityNotImplemented
 }
 
+// GetStatsForRootHash will call the inner accountsAdapter method
+func (accountsDB *accountsDBApiWithHistory) GetStatsForRootHash(rootHash []byte, numLargestDataTries uint32) (common.TriesStatisticsCollector, error) {
+	return accountsDB.innerAccountsAdapter.GetStatsForRootHash(rootHash, numLargestDataTries)
+}
+
 // GetStackDebugFirstEntry returns nil
 func (accountsDB *accountsDBApiWithHistory) GetStackDebugFirstEntry() []byte {
 	return nil
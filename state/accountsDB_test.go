@@ -2623,13 +2623,61 @@ func TestAccountsDB_PrintStatsForRootHash(t *testing.T) {
 	addDataTries(accountsAddresses, adb)
 	rootHash, _ := adb.Commit()
 
-	stats, err := adb.GetStatsForRootHash(rootHash)
+	numLargestDataTries := uint32(5)
+	stats, err := adb.GetStatsForRootHash(rootHash, numLargestDataTries)
 	assert.Nil(t, err)
 	assert.NotNil(t, stats)
+	assert.Equal(t, uint64(numAccounts), stats.GetNumAccounts())
+	assert.Equal(t, uint64(1), stats.GetNumTries(common.MainTrie))
+	assert.Equal(t, uint64(numAccounts), stats.GetTrieStatistics(common.MainTrie).GetNumLeafNodes())
+
+	largestDataTries := stats.GetLargestDataTries()
+	assert.Equal(t, int(numLargestDataTries), len(largestDataTries))
+	for i := 1; i < len(largestDataTries); i++ {
+		assert.True(t, largestDataTries[i-1].GetTotalNodesSize() >= largestDataTries[i].GetTotalNodesSize())
+		assert.NotEmpty(t, largestDataTries[i].GetAddress())
+	}
 
 	stats.Print()
 }
 
+func TestAccountsDB_GetStatsForRootHashShouldErrOnMissingTrieNodes(t *testing.T) {
+	t.Parallel()
+
+	t.Run("missing main trie root should error", func(t *testing.T) {
+		t.Parallel()
+
+		_, adb := getDefaultTrieAndAccountsDb()
+		_ = generateAccounts(t, 10, adb)
+		_, _ = adb.Commit()
+
+		stats, err := adb.GetStatsForRootHash([]byte("missing root hash"), 10)
+		assert.NotNil(t, err)
+		assert.Nil(t, stats)
+	})
+	t.Run("missing data trie root should error", func(t *testing.T) {
+		t.Parallel()
+
+		db := testscommon.NewSnapshotPruningStorerMock()
+		_, adb := getDefaultTrieAndAccountsDbWithCustomDB(db)
+		accountsAddresses := generateAccounts(t, 100, adb)
+		acc, _ := adb.LoadAccount(accountsAddresses[0])
+		userAcc := acc.(state.UserAccountHandler)
+		_ = userAcc.SaveKeyValue([]byte("key"), []byte("value"))
+		_ = adb.SaveAccount(acc)
+		rootHash, _ := adb.Commit()
+
+		acc, _ = adb.LoadAccount(accountsAddresses[0])
+		dataTrieRootHash := acc.(state.UserAccountHandler).GetRootHash()
+		require.Nil(t, db.Remove(dataTrieRootHash))
+
+		stats, err := adb.GetStatsForRootHash(rootHash, 10)
+		require.NotNil(t, err)
+		assert.Contains(t, err.Error(), string(common.DataTrie))
+		assert.Nil(t, stats)
+	})
+}
+
 func TestAccountsDB_SyncMissingSnapshotNodes(t *testing.T) {
 	t.Parallel()
 
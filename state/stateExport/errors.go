@@ -0,0 +1,31 @@
+package stateExport
+
+import "errors"
+
+// ErrNilAccountsTrie signals that a nil accounts trie was provided
+var ErrNilAccountsTrie = errors.New("nil accounts trie")
+
+// ErrNilPeerAccountsTrie signals that a nil peer accounts trie was provided
+var ErrNilPeerAccountsTrie = errors.New("nil peer accounts trie")
+
+// ErrNilMarshaller signals that a nil marshaller was provided
+var ErrNilMarshaller = errors.New("nil marshaller")
+
+// ErrNilEnableEpochsHandler signals that a nil enable epochs handler was provided
+var ErrNilEnableEpochsHandler = errors.New("nil enable epochs handler")
+
+// ErrNilAddressPubkeyConverter signals that a nil address public key converter was provided
+var ErrNilAddressPubkeyConverter = errors.New("nil address public key converter")
+
+// ErrNilValidatorPubkeyConverter signals that a nil validator public key converter was provided
+var ErrNilValidatorPubkeyConverter = errors.New("nil validator public key converter")
+
+// ErrMissingCode signals that the code of a smart contract was not found in the accounts trie
+var ErrMissingCode = errors.New("missing smart contract code")
+
+// ErrInvalidBalance signals that an exported account holds an invalid balance
+var ErrInvalidBalance = errors.New("invalid balance")
+
+// ErrValidatorsCanNotBeLoaded signals that the state export holds validators, which can not be loaded as the export
+// does not contain their keys
+var ErrValidatorsCanNotBeLoaded = errors.New("the exported validators can not be loaded")
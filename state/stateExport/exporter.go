@@ -0,0 +1,391 @@
+package stateExport
+
+import (
+	"bufio"
+	"context"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"io"
+	"math/big"
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-core-go/marshal"
+	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/common/errChan"
+	"github.com/multiversx/mx-chain-go/common/holders"
+	"github.com/multiversx/mx-chain-go/node/chainSimulator/dtos"
+	"github.com/multiversx/mx-chain-go/state"
+	"github.com/multiversx/mx-chain-go/state/accounts"
+	"github.com/multiversx/mx-chain-go/state/parsers"
+	"github.com/multiversx/mx-chain-go/trie/keyBuilder"
+	logger "github.com/multiversx/mx-chain-logger-go"
+)
+
+var log = logger.GetOrCreate("state/stateExport")
+
+const (
+	progressLogInterval = 100000
+	leavesChannelSize   = 100
+)
+
+// ArgsStateExporter holds the arguments needed for creating a state exporter
+type ArgsStateExporter struct {
+	AccountsTrie             common.Trie
+	PeerAccountsTrie         common.Trie
+	Marshaller               marshal.Marshalizer
+	EnableEpochsHandler      common.EnableEpochsHandler
+	AddressPubkeyConverter   core.PubkeyConverter
+	ValidatorPubkeyConverter core.PubkeyConverter
+}
+
+type stateExporter struct {
+	accountsTrie             common.Trie
+	peerAccountsTrie         common.Trie
+	marshaller               marshal.Marshalizer
+	enableEpochsHandler      common.EnableEpochsHandler
+	addressPubkeyConverter   core.PubkeyConverter
+	validatorPubkeyConverter core.PubkeyConverter
+}
+
+// NewStateExporter creates a component able to write the accounts, together with their data tries keys, and the
+// validators of a block in a human-readable JSON file. The peer accounts trie is needed only for metachain exports
+func NewStateExporter(args ArgsStateExporter) (*stateExporter, error) {
+	if check.IfNil(args.AccountsTrie) {
+		return nil, ErrNilAccountsTrie
+	}
+	if check.IfNil(args.Marshaller) {
+		return nil, ErrNilMarshaller
+	}
+	if check.IfNil(args.EnableEpochsHandler) {
+		return nil, ErrNilEnableEpochsHandler
+	}
+	if check.IfNil(args.AddressPubkeyConverter) {
+		return nil, ErrNilAddressPubkeyConverter
+	}
+	if check.IfNil(args.ValidatorPubkeyConverter) {
+		return nil, ErrNilValidatorPubkeyConverter
+	}
+
+	return &stateExporter{
+		accountsTrie:             args.AccountsTrie,
+		peerAccountsTrie:         args.PeerAccountsTrie,
+		marshaller:               args.Marshaller,
+		enableEpochsHandler:      args.EnableEpochsHandler,
+		addressPubkeyConverter:   args.AddressPubkeyConverter,
+		validatorPubkeyConverter: args.ValidatorPubkeyConverter,
+	}, nil
+}
+
+// Export writes the state with the root hashes from the header to the provided writer. The accounts are streamed one
+// by one, so the memory usage does not depend on the size of the state
+func (se *stateExporter) Export(writer io.Writer, header ExportHeader) (*ExportSummary, error) {
+	if len(header.ValidatorStatsRootHash) > 0 && check.IfNil(se.peerAccountsTrie) {
+		return nil, ErrNilPeerAccountsTrie
+	}
+
+	buffWriter := bufio.NewWriter(writer)
+	summary := &ExportSummary{
+		TotalBalance: big.NewInt(0),
+	}
+
+	headerBytes, err := json.Marshal(header)
+	if err != nil {
+		return nil, err
+	}
+
+	out := &jsonWriter{writer: buffWriter}
+	out.writeString("{\n\"header\": ")
+	out.write(headerBytes)
+
+	out.writeString(",\n\"accounts\": [")
+	err = se.exportAccounts(out, header.RootHash, summary)
+	if err != nil {
+		return nil, err
+	}
+
+	out.writeString("\n],\n\"validators\": [")
+	if len(header.ValidatorStatsRootHash) > 0 {
+		err = se.exportValidators(out, header.ValidatorStatsRootHash, summary)
+		if err != nil {
+			return nil, err
+		}
+	}
+	out.writeString("\n]\n}\n")
+	if out.err != nil {
+		return nil, out.err
+	}
+
+	err = buffWriter.Flush()
+	if err != nil {
+		return nil, err
+	}
+
+	return summary, nil
+}
+
+func (se *stateExporter) exportAccounts(out *jsonWriter, rootHash []byte, summary *ExportSummary) error {
+	startTime := time.Now()
+	mainTrie, err := se.accountsTrie.Recreate(holders.NewDefaultRootHashesHolder(rootHash))
+	if err != nil {
+		return err
+	}
+
+	return iterateLeaves(se.accountsTrie, rootHash, parsers.NewMainTrieLeafParser(), func(leaf core.KeyValueHolder) error {
+		account := &accounts.UserAccountData{}
+		errUnmarshal := se.marshaller.Unmarshal(account, leaf.Value())
+		if errUnmarshal != nil || len(account.Address) == 0 {
+			// the code of the smart contracts is also saved in the main trie
+			return nil
+		}
+
+		addressState, errConvert := se.createAddressState(mainTrie, account, summary)
+		if errConvert != nil {
+			return errConvert
+		}
+
+		errWrite := out.writeItem(addressState, summary.NumAccounts == 0)
+		if errWrite != nil {
+			return errWrite
+		}
+
+		summary.NumAccounts++
+		if account.Balance != nil {
+			summary.TotalBalance.Add(summary.TotalBalance, account.Balance)
+		}
+		if summary.NumAccounts%progressLogInterval == 0 {
+			log.Info("exporting accounts", "num accounts", summary.NumAccounts, "duration", time.Since(startTime))
+		}
+
+		return nil
+	})
+}
+
+func (se *stateExporter) createAddressState(
+	mainTrie common.Trie,
+	account *accounts.UserAccountData,
+	summary *ExportSummary,
+) (*dtos.AddressState, error) {
+	address, err := se.addressPubkeyConverter.Encode(account.Address)
+	if err != nil {
+		return nil, err
+	}
+
+	nonce := account.Nonce
+	addressState := &dtos.AddressState{
+		Address:      address,
+		Nonce:        &nonce,
+		Balance:      bigIntToString(account.Balance),
+		CodeHash:     encodeBase64(account.CodeHash),
+		CodeMetadata: encodeBase64(account.CodeMetadata),
+	}
+	if account.DeveloperReward != nil && account.DeveloperReward.Sign() != 0 {
+		addressState.DeveloperRewards = account.DeveloperReward.String()
+	}
+	if len(account.OwnerAddress) > 0 {
+		addressState.Owner, err = se.addressPubkeyConverter.Encode(account.OwnerAddress)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if len(account.CodeHash) > 0 {
+		addressState.Code, err = se.getCode(mainTrie, account.CodeHash)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if common.IsEmptyTrie(account.RootHash) {
+		return addressState, nil
+	}
+
+	addressState.Keys, err = se.getDataTrieKeys(account.Address, account.RootHash)
+	if err != nil {
+		return nil, err
+	}
+	summary.NumDataTrieKeys += uint64(len(addressState.Keys))
+
+	return addressState, nil
+}
+
+func (se *stateExporter) getCode(mainTrie common.Trie, codeHash []byte) (string, error) {
+	codeEntryBytes, _, err := mainTrie.Get(codeHash)
+	if err != nil {
+		return "", err
+	}
+	if len(codeEntryBytes) == 0 {
+		return "", ErrMissingCode
+	}
+
+	codeEntry := &state.CodeEntry{}
+	err = se.marshaller.Unmarshal(codeEntry, codeEntryBytes)
+	if err != nil {
+		return "", err
+	}
+
+	return hex.EncodeToString(codeEntry.Code), nil
+}
+
+func (se *stateExporter) getDataTrieKeys(address []byte, rootHash []byte) (map[string]string, error) {
+	leafParser, err := parsers.NewDataTrieLeafParser(address, se.marshaller, se.enableEpochsHandler)
+	if err != nil {
+		return nil, err
+	}
+
+	keys := make(map[string]string)
+	err = iterateLeaves(se.accountsTrie, rootHash, leafParser, func(leaf core.KeyValueHolder) error {
+		if len(leaf.Value()) == 0 {
+			return nil
+		}
+
+		keys[hex.EncodeToString(leaf.Key())] = hex.EncodeToString(leaf.Value())
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return keys, nil
+}
+
+func (se *stateExporter) exportValidators(out *jsonWriter, rootHash []byte, summary *ExportSummary) error {
+	return iterateLeaves(se.peerAccountsTrie, rootHash, parsers.NewMainTrieLeafParser(), func(leaf core.KeyValueHolder) error {
+		peerAccount := &accounts.PeerAccountData{}
+		err := se.marshaller.Unmarshal(peerAccount, leaf.Value())
+		if err != nil {
+			return err
+		}
+
+		validator, err := se.createExportedValidator(peerAccount)
+		if err != nil {
+			return err
+		}
+
+		err = out.writeItem(validator, summary.NumValidators == 0)
+		if err != nil {
+			return err
+		}
+
+		summary.NumValidators++
+		return nil
+	})
+}
+
+func (se *stateExporter) createExportedValidator(peerAccount *accounts.PeerAccountData) (*ExportedValidator, error) {
+	pubKey, err := se.validatorPubkeyConverter.Encode(peerAccount.BLSPublicKey)
+	if err != nil {
+		return nil, err
+	}
+
+	rewardAddress := ""
+	if len(peerAccount.RewardAddress) > 0 {
+		rewardAddress, err = se.addressPubkeyConverter.Encode(peerAccount.RewardAddress)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return &ExportedValidator{
+		PubKey:        pubKey,
+		Address:       rewardAddress,
+		InitialRating: peerAccount.Rating,
+		ShardID:       peerAccount.ShardId,
+		List:          peerAccount.List,
+		IndexInList:   peerAccount.IndexInList,
+		TempRating:    peerAccount.TempRating,
+	}, nil
+}
+
+// iterateLeaves calls the handler for every leaf of the trie with the provided root hash. Returning an error from the
+// handler stops the iteration
+func iterateLeaves(
+	tr common.Trie,
+	rootHash []byte,
+	leafParser common.TrieLeafParser,
+	handler func(leaf core.KeyValueHolder) error,
+) error {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	leavesChannels := &common.TrieIteratorChannels{
+		LeavesChan: make(chan core.KeyValueHolder, leavesChannelSize),
+		ErrChan:    errChan.NewErrChanWrapper(),
+	}
+	err := tr.GetAllLeavesOnChannel(leavesChannels, ctx, rootHash, keyBuilder.NewKeyBuilder(), leafParser)
+	if err != nil {
+		return err
+	}
+
+	for leaf := range leavesChannels.LeavesChan {
+		err = handler(leaf)
+		if err != nil {
+			cancel()
+			drainLeaves(leavesChannels.LeavesChan)
+			return err
+		}
+	}
+
+	return leavesChannels.ErrChan.ReadFromChanNonBlocking()
+}
+
+func drainLeaves(leavesChan chan core.KeyValueHolder) {
+	for range leavesChan {
+	}
+}
+
+func bigIntToString(value *big.Int) string {
+	if value == nil {
+		return "0"
+	}
+
+	return value.String()
+}
+
+func encodeBase64(value []byte) string {
+	if len(value) == 0 {
+		return ""
+	}
+
+	return base64.StdEncoding.EncodeToString(value)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (se *stateExporter) IsInterfaceNil() bool {
+	return se == nil
+}
+
+// jsonWriter keeps the first write error, so that the JSON document can be written without checking every call
+type jsonWriter struct {
+	writer io.Writer
+	err    error
+}
+
+func (jw *jsonWriter) write(data []byte) {
+	if jw.err != nil {
+		return
+	}
+
+	_, jw.err = jw.writer.Write(data)
+}
+
+func (jw *jsonWriter) writeString(data string) {
+	jw.write([]byte(data))
+}
+
+func (jw *jsonWriter) writeItem(item interface{}, isFirst bool) error {
+	itemBytes, err := json.Marshal(item)
+	if err != nil {
+		return err
+	}
+
+	if !isFirst {
+		jw.writeString(",")
+	}
+	jw.writeString("\n")
+	jw.write(itemBytes)
+
+	return jw.err
+}
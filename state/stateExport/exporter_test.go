@@ -0,0 +1,277 @@
+package stateExport_test
+
+import (
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-core-go/hashing/blake2b"
+	"github.com/multiversx/mx-chain-core-go/marshal"
+	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/common/holders"
+	"github.com/multiversx/mx-chain-go/state"
+	"github.com/multiversx/mx-chain-go/state/accounts"
+	"github.com/multiversx/mx-chain-go/state/stateExport"
+	"github.com/multiversx/mx-chain-go/testscommon"
+	"github.com/multiversx/mx-chain-go/testscommon/enableEpochsHandlerMock"
+	testStorage "github.com/multiversx/mx-chain-go/testscommon/storage"
+	"github.com/multiversx/mx-chain-go/trie"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	testMarshaller = &marshal.GogoProtoMarshalizer{}
+	testHasher     = blake2b.NewBlake2b()
+	userAddress    = bytes.Repeat([]byte("u"), 32)
+	scAddress      = append(make([]byte, 10), bytes.Repeat([]byte("s"), 22)...)
+)
+
+func createTrie(t *testing.T) common.Trie {
+	args := testStorage.GetStorageManagerArgs()
+	args.MainStorer = testscommon.NewSnapshotPruningStorerMock()
+	args.Marshalizer = testMarshaller
+	args.Hasher = testHasher
+	storageManager, err := trie.NewTrieStorageManager(args)
+	require.Nil(t, err)
+
+	tr, err := trie.NewTrie(storageManager, testMarshaller, testHasher, &enableEpochsHandlerMock.EnableEpochsHandlerStub{}, 5)
+	require.Nil(t, err)
+
+	return tr
+}
+
+func saveAccount(t *testing.T, tr common.Trie, account *accounts.UserAccountData) {
+	accountBytes, err := testMarshaller.Marshal(account)
+	require.Nil(t, err)
+	require.Nil(t, tr.Update(testHasher.Compute(string(account.Address)), accountBytes))
+}
+
+// createAccountsTrie saves a user account with a data trie and a smart contract together with its code
+func createAccountsTrie(t *testing.T, withCode bool) (common.Trie, []byte) {
+	tr := createTrie(t)
+
+	dataTrie, err := tr.Recreate(holders.NewRootHashHolderAsEmpty())
+	require.Nil(t, err)
+	for i := 0; i < 3; i++ {
+		key := []byte(fmt.Sprintf("key%d", i))
+		value := append([]byte(fmt.Sprintf("value%d", i)), append(key, userAddress...)...)
+		require.Nil(t, dataTrie.Update(key, value))
+	}
+	require.Nil(t, dataTrie.Commit())
+	dataTrieRootHash, _ := dataTrie.RootHash()
+
+	saveAccount(t, tr, &accounts.UserAccountData{
+		Nonce:    7,
+		Balance:  big.NewInt(1000),
+		Address:  userAddress,
+		RootHash: dataTrieRootHash,
+	})
+	saveAccount(t, tr, &accounts.UserAccountData{
+		Balance:         big.NewInt(50),
+		Address:         scAddress,
+		CodeHash:        testHasher.Compute("code"),
+		CodeMetadata:    []byte{5, 0},
+		OwnerAddress:    userAddress,
+		DeveloperReward: big.NewInt(3),
+	})
+
+	if withCode {
+		codeEntry, errMarshal := testMarshaller.Marshal(&state.CodeEntry{Code: []byte("code"), NumReferences: 1})
+		require.Nil(t, errMarshal)
+		require.Nil(t, tr.Update(testHasher.Compute("code"), codeEntry))
+	}
+
+	require.Nil(t, tr.Commit())
+	rootHash, _ := tr.RootHash()
+
+	return tr, rootHash
+}
+
+func createPeerAccountsTrie(t *testing.T) (common.Trie, []byte) {
+	tr := createTrie(t)
+	for i := 0; i < 2; i++ {
+		peerAccount := &accounts.PeerAccountData{
+			BLSPublicKey:  []byte(fmt.Sprintf("bls key %d", i)),
+			RewardAddress: userAddress,
+			ShardId:       uint32(i),
+			Rating:        50,
+			TempRating:    51,
+			List:          string(common.EligibleList),
+			IndexInList:   uint32(i),
+		}
+		peerAccountBytes, err := testMarshaller.Marshal(peerAccount)
+		require.Nil(t, err)
+		require.Nil(t, tr.Update(peerAccount.BLSPublicKey, peerAccountBytes))
+	}
+	require.Nil(t, tr.Commit())
+	rootHash, _ := tr.RootHash()
+
+	return tr, rootHash
+}
+
+func createMockArgsStateExporter(t *testing.T) stateExport.ArgsStateExporter {
+	return stateExport.ArgsStateExporter{
+		AccountsTrie:             createTrie(t),
+		PeerAccountsTrie:         createTrie(t),
+		Marshaller:               testMarshaller,
+		EnableEpochsHandler:      &enableEpochsHandlerMock.EnableEpochsHandlerStub{},
+		AddressPubkeyConverter:   testscommon.NewPubkeyConverterMock(32),
+		ValidatorPubkeyConverter: testscommon.NewPubkeyConverterMock(96),
+	}
+}
+
+func TestNewStateExporter(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil accounts trie should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsStateExporter(t)
+		args.AccountsTrie = nil
+		exporter, err := stateExport.NewStateExporter(args)
+		assert.Equal(t, stateExport.ErrNilAccountsTrie, err)
+		assert.True(t, check.IfNil(exporter))
+	})
+	t.Run("nil marshaller should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsStateExporter(t)
+		args.Marshaller = nil
+		exporter, err := stateExport.NewStateExporter(args)
+		assert.Equal(t, stateExport.ErrNilMarshaller, err)
+		assert.True(t, check.IfNil(exporter))
+	})
+	t.Run("nil enable epochs handler should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsStateExporter(t)
+		args.EnableEpochsHandler = nil
+		exporter, err := stateExport.NewStateExporter(args)
+		assert.Equal(t, stateExport.ErrNilEnableEpochsHandler, err)
+		assert.True(t, check.IfNil(exporter))
+	})
+	t.Run("nil address pubkey converter should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsStateExporter(t)
+		args.AddressPubkeyConverter = nil
+		exporter, err := stateExport.NewStateExporter(args)
+		assert.Equal(t, stateExport.ErrNilAddressPubkeyConverter, err)
+		assert.True(t, check.IfNil(exporter))
+	})
+	t.Run("nil validator pubkey converter should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsStateExporter(t)
+		args.ValidatorPubkeyConverter = nil
+		exporter, err := stateExport.NewStateExporter(args)
+		assert.Equal(t, stateExport.ErrNilValidatorPubkeyConverter, err)
+		assert.True(t, check.IfNil(exporter))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		exporter, err := stateExport.NewStateExporter(createMockArgsStateExporter(t))
+		assert.Nil(t, err)
+		assert.False(t, check.IfNil(exporter))
+	})
+}
+
+func TestStateExporter_Export(t *testing.T) {
+	t.Parallel()
+
+	t.Run("validator stats root hash without peer accounts trie should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsStateExporter(t)
+		args.PeerAccountsTrie = nil
+		exporter, _ := stateExport.NewStateExporter(args)
+
+		summary, err := exporter.Export(&bytes.Buffer{}, stateExport.ExportHeader{ValidatorStatsRootHash: []byte("root hash")})
+		assert.Equal(t, stateExport.ErrNilPeerAccountsTrie, err)
+		assert.Nil(t, summary)
+	})
+	t.Run("missing code should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsStateExporter(t)
+		var rootHash []byte
+		args.AccountsTrie, rootHash = createAccountsTrie(t, false)
+		exporter, _ := stateExport.NewStateExporter(args)
+
+		summary, err := exporter.Export(&bytes.Buffer{}, stateExport.ExportHeader{RootHash: rootHash})
+		assert.Equal(t, stateExport.ErrMissingCode, err)
+		assert.Nil(t, summary)
+	})
+	t.Run("should export the accounts and the validators", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsStateExporter(t)
+		header := stateExport.ExportHeader{
+			ShardID:    0,
+			Epoch:      3,
+			Nonce:      120,
+			HeaderHash: []byte("header hash"),
+		}
+		args.AccountsTrie, header.RootHash = createAccountsTrie(t, true)
+		args.PeerAccountsTrie, header.ValidatorStatsRootHash = createPeerAccountsTrie(t)
+		exporter, _ := stateExport.NewStateExporter(args)
+
+		buff := &bytes.Buffer{}
+		summary, err := exporter.Export(buff, header)
+		require.Nil(t, err)
+		assert.Equal(t, uint64(2), summary.NumAccounts)
+		assert.Equal(t, uint64(3), summary.NumDataTrieKeys)
+		assert.Equal(t, uint64(2), summary.NumValidators)
+		assert.Equal(t, big.NewInt(1050), summary.TotalBalance)
+
+		exported := &stateExport.ExportedState{}
+		require.Nil(t, json.Unmarshal(buff.Bytes(), exported))
+		assert.Equal(t, header, exported.Header)
+		require.Equal(t, 2, len(exported.Accounts))
+		require.Equal(t, 2, len(exported.Validators))
+
+		for _, account := range exported.Accounts {
+			switch account.Address {
+			case hex.EncodeToString(userAddress):
+				assert.Equal(t, uint64(7), *account.Nonce)
+				assert.Equal(t, "1000", account.Balance)
+				assert.Empty(t, account.Code)
+				assert.Equal(t, map[string]string{
+					hex.EncodeToString([]byte("key0")): hex.EncodeToString([]byte("value0")),
+					hex.EncodeToString([]byte("key1")): hex.EncodeToString([]byte("value1")),
+					hex.EncodeToString([]byte("key2")): hex.EncodeToString([]byte("value2")),
+				}, account.Keys)
+			case hex.EncodeToString(scAddress):
+				assert.Equal(t, "50", account.Balance)
+				assert.Equal(t, hex.EncodeToString([]byte("code")), account.Code)
+				assert.Equal(t, "BQA=", account.CodeMetadata)
+				assert.Equal(t, hex.EncodeToString(userAddress), account.Owner)
+				assert.Equal(t, "3", account.DeveloperRewards)
+				assert.Empty(t, account.Keys)
+			default:
+				assert.Fail(t, "unexpected account "+account.Address)
+			}
+		}
+
+		for _, validator := range exported.Validators {
+			assert.Equal(t, hex.EncodeToString(userAddress), validator.Address)
+			assert.Equal(t, uint32(50), validator.InitialRating)
+			assert.Equal(t, uint32(51), validator.TempRating)
+			assert.Equal(t, string(common.EligibleList), validator.List)
+		}
+
+		filePath := filepath.Join(t.TempDir(), "state.json")
+		require.Nil(t, os.WriteFile(filePath, buff.Bytes(), 0644))
+		loaded, err := stateExport.LoadExportedState(filePath)
+		require.Nil(t, err)
+		assert.Equal(t, exported, loaded)
+	})
+}
@@ -0,0 +1,56 @@
+package stateExport
+
+import (
+	"math/big"
+
+	"github.com/multiversx/mx-chain-go/genesis/data"
+	"github.com/multiversx/mx-chain-go/node/chainSimulator/dtos"
+)
+
+// ExportHeader identifies the block whose state was exported
+type ExportHeader struct {
+	ShardID                uint32 `json:"shardId"`
+	Epoch                  uint32 `json:"epoch"`
+	Round                  uint64 `json:"round"`
+	Nonce                  uint64 `json:"nonce"`
+	HeaderHash             []byte `json:"headerHash"`
+	RootHash               []byte `json:"rootHash"`
+	ValidatorStatsRootHash []byte `json:"validatorStatsRootHash,omitempty"`
+}
+
+// ExportedValidator holds the peer account of a validator. The first fields follow the nodesSetup.json schema
+type ExportedValidator struct {
+	PubKey        string `json:"pubkey"`
+	Address       string `json:"address"`
+	InitialRating uint32 `json:"initialRating"`
+	ShardID       uint32 `json:"shardId"`
+	List          string `json:"list"`
+	IndexInList   uint32 `json:"indexInList"`
+	TempRating    uint32 `json:"tempRating"`
+}
+
+// ExportedState is the content of a state export file. The accounts use the chain simulator's address state schema,
+// so they can be loaded as they are with SetStateMultiple
+type ExportedState struct {
+	Header     ExportHeader         `json:"header"`
+	Accounts   []*dtos.AddressState `json:"accounts"`
+	Validators []*ExportedValidator `json:"validators"`
+}
+
+// ExportSummary holds the totals of a finished export
+type ExportSummary struct {
+	NumAccounts     uint64
+	NumDataTrieKeys uint64
+	NumValidators   uint64
+	TotalBalance    *big.Int
+}
+
+// GenesisAccounts holds the genesis.json entries created from the exported accounts, together with the totals of the
+// exported data that the genesis file can not hold
+type GenesisAccounts struct {
+	InitialAccounts          []*data.InitialAccount
+	TotalSupply              *big.Int
+	NumSkippedSmartContracts uint64
+	NumSkippedEmptyAccounts  uint64
+	NumSkippedDataTrieKeys   uint64
+}
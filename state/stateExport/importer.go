@@ -0,0 +1,83 @@
+package stateExport
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"os"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-go/genesis/data"
+	"github.com/multiversx/mx-chain-go/node/chainSimulator/dtos"
+)
+
+// LoadExportedState reads a state export file
+func LoadExportedState(filePath string) (*ExportedState, error) {
+	file, err := os.Open(filePath)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		_ = file.Close()
+	}()
+
+	exportedState := &ExportedState{}
+	err = json.NewDecoder(file).Decode(exportedState)
+	if err != nil {
+		return nil, fmt.Errorf("%w while decoding the state export file %s", err, filePath)
+	}
+
+	return exportedState, nil
+}
+
+// CreateGenesisAccounts converts the exported accounts in genesis.json entries. A genesis file can only hold the balances
+// of user accounts, so the smart contracts, the empty accounts and the data tries keys (ESDT tokens included) are left
+// out and counted in the returned result. The returned total supply must be set as the genesis total supply in the
+// economics configuration
+func CreateGenesisAccounts(
+	accountsStates []*dtos.AddressState,
+	addressPubkeyConverter core.PubkeyConverter,
+) (*GenesisAccounts, error) {
+	if check.IfNil(addressPubkeyConverter) {
+		return nil, ErrNilAddressPubkeyConverter
+	}
+
+	genesisAccounts := &GenesisAccounts{
+		InitialAccounts: make([]*data.InitialAccount, 0, len(accountsStates)),
+		TotalSupply:     big.NewInt(0),
+	}
+	for _, accountState := range accountsStates {
+		addressBytes, err := addressPubkeyConverter.Decode(accountState.Address)
+		if err != nil {
+			return nil, err
+		}
+		if core.IsSmartContractAddress(addressBytes) {
+			genesisAccounts.NumSkippedSmartContracts++
+			continue
+		}
+
+		balance, ok := big.NewInt(0).SetString(accountState.Balance, 10)
+		if !ok {
+			return nil, fmt.Errorf("%w '%s' for address %s", ErrInvalidBalance, accountState.Balance, accountState.Address)
+		}
+		genesisAccounts.NumSkippedDataTrieKeys += uint64(len(accountState.Keys))
+		if balance.Sign() <= 0 {
+			genesisAccounts.NumSkippedEmptyAccounts++
+			continue
+		}
+
+		genesisAccounts.InitialAccounts = append(genesisAccounts.InitialAccounts, &data.InitialAccount{
+			Address:      accountState.Address,
+			Supply:       balance,
+			Balance:      big.NewInt(0).Set(balance),
+			StakingValue: big.NewInt(0),
+			Delegation: &data.DelegationData{
+				Value: big.NewInt(0),
+			},
+		})
+		genesisAccounts.TotalSupply.Add(genesisAccounts.TotalSupply, balance)
+	}
+
+	return genesisAccounts, nil
+}
@@ -0,0 +1,85 @@
+package stateExport_test
+
+import (
+	"bytes"
+	"encoding/hex"
+	"errors"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/multiversx/mx-chain-go/node/chainSimulator/dtos"
+	"github.com/multiversx/mx-chain-go/state/stateExport"
+	"github.com/multiversx/mx-chain-go/testscommon"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLoadExportedState(t *testing.T) {
+	t.Parallel()
+
+	t.Run("missing file should error", func(t *testing.T) {
+		t.Parallel()
+
+		exported, err := stateExport.LoadExportedState(filepath.Join(t.TempDir(), "missing.json"))
+		assert.True(t, errors.Is(err, os.ErrNotExist))
+		assert.Nil(t, exported)
+	})
+	t.Run("invalid file should error", func(t *testing.T) {
+		t.Parallel()
+
+		filePath := filepath.Join(t.TempDir(), "state.json")
+		require.Nil(t, os.WriteFile(filePath, []byte("{\"accounts\": ["), 0644))
+
+		exported, err := stateExport.LoadExportedState(filePath)
+		assert.NotNil(t, err)
+		assert.Nil(t, exported)
+	})
+}
+
+func TestCreateGenesisAccounts(t *testing.T) {
+	t.Parallel()
+
+	converter := testscommon.NewPubkeyConverterMock(32)
+
+	t.Run("nil converter should error", func(t *testing.T) {
+		t.Parallel()
+
+		genesisAccounts, err := stateExport.CreateGenesisAccounts(nil, nil)
+		assert.Equal(t, stateExport.ErrNilAddressPubkeyConverter, err)
+		assert.Nil(t, genesisAccounts)
+	})
+	t.Run("invalid balance should error", func(t *testing.T) {
+		t.Parallel()
+
+		accountsStates := []*dtos.AddressState{
+			{Address: hex.EncodeToString(userAddress), Balance: "not a number"},
+		}
+		genesisAccounts, err := stateExport.CreateGenesisAccounts(accountsStates, converter)
+		assert.True(t, errors.Is(err, stateExport.ErrInvalidBalance))
+		assert.Nil(t, genesisAccounts)
+	})
+	t.Run("should keep only the funded user accounts and count the skipped data", func(t *testing.T) {
+		t.Parallel()
+
+		emptyAddress := hex.EncodeToString(bytes.Repeat([]byte{2}, 32))
+		accountsStates := []*dtos.AddressState{
+			{Address: hex.EncodeToString(userAddress), Balance: "1000", Keys: map[string]string{"aa": "01", "bb": "02"}},
+			{Address: hex.EncodeToString(scAddress), Balance: "50", Keys: map[string]string{"cc": "03"}},
+			{Address: emptyAddress, Balance: "0", Keys: map[string]string{"dd": "04"}},
+		}
+		genesisAccounts, err := stateExport.CreateGenesisAccounts(accountsStates, converter)
+		require.Nil(t, err)
+		assert.Equal(t, big.NewInt(1000), genesisAccounts.TotalSupply)
+		assert.Equal(t, uint64(1), genesisAccounts.NumSkippedSmartContracts)
+		assert.Equal(t, uint64(1), genesisAccounts.NumSkippedEmptyAccounts)
+		assert.Equal(t, uint64(3), genesisAccounts.NumSkippedDataTrieKeys)
+		initialAccounts := genesisAccounts.InitialAccounts
+		require.Equal(t, 1, len(initialAccounts))
+		assert.Equal(t, hex.EncodeToString(userAddress), initialAccounts[0].Address)
+		assert.Equal(t, big.NewInt(1000), initialAccounts[0].Supply)
+		assert.Equal(t, big.NewInt(1000), initialAccounts[0].Balance)
+		assert.Equal(t, big.NewInt(0), initialAccounts[0].StakingValue)
+	})
+}
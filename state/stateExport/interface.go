@@ -0,0 +1,9 @@
+package stateExport
+
+import "io"
+
+// StateExporter defines the operations of a component able to write a state export file
+type StateExporter interface {
+	Export(writer io.Writer, header ExportHeader) (*ExportSummary, error)
+	IsInterfaceNil() bool
+}
@@ -0,0 +1,39 @@
+package stateSnapshot
+
+import "errors"
+
+// ErrNilTrieStorage signals that a nil trie storage was provided
+var ErrNilTrieStorage = errors.New("nil trie storage")
+
+// ErrNilMarshaller signals that a nil marshaller was provided
+var ErrNilMarshaller = errors.New("nil marshaller")
+
+// ErrNilHasher signals that a nil hasher was provided
+var ErrNilHasher = errors.New("nil hasher")
+
+// ErrNilStorageMarker signals that a nil storage marker was provided
+var ErrNilStorageMarker = errors.New("nil storage marker")
+
+// ErrInvalidSnapshotFile signals that the file is not a valid state snapshot file
+var ErrInvalidSnapshotFile = errors.New("invalid state snapshot file")
+
+// ErrUnsupportedSnapshotVersion signals that the snapshot file was written with an unsupported format version
+var ErrUnsupportedSnapshotVersion = errors.New("unsupported state snapshot version")
+
+// ErrChunkTooLarge signals that a chunk exceeds the maximum allowed size
+var ErrChunkTooLarge = errors.New("state snapshot chunk too large")
+
+// ErrChunkHashMismatch signals that the hash of a chunk does not match its payload
+var ErrChunkHashMismatch = errors.New("state snapshot chunk hash mismatch")
+
+// ErrInvalidNodesChunk signals that a nodes chunk could not be parsed
+var ErrInvalidNodesChunk = errors.New("invalid state snapshot nodes chunk")
+
+// ErrRootHashMismatch signals that the snapshot does not hold the expected root hash
+var ErrRootHashMismatch = errors.New("state snapshot root hash mismatch")
+
+// ErrTruncatedSnapshot signals that the end of the snapshot file was reached before its last chunk
+var ErrTruncatedSnapshot = errors.New("truncated state snapshot")
+
+// ErrUnexpectedChunk signals that a chunk was found in an unexpected position
+var ErrUnexpectedChunk = errors.New("unexpected state snapshot chunk")
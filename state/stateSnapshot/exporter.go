@@ -0,0 +1,158 @@
+package stateSnapshot
+
+import (
+	"io"
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-core-go/hashing"
+	"github.com/multiversx/mx-chain-core-go/marshal"
+	"github.com/multiversx/mx-chain-go/common"
+	logger "github.com/multiversx/mx-chain-logger-go"
+)
+
+var log = logger.GetOrCreate("state/stateSnapshot")
+
+const progressLogInterval = 1000000
+
+// ArgsSnapshotExporter holds the arguments needed for creating a state snapshot exporter
+type ArgsSnapshotExporter struct {
+	UserAccountsStorage common.TrieStorageInteractor
+	PeerAccountsStorage common.TrieStorageInteractor
+	Marshaller          marshal.Marshalizer
+	Hasher              hashing.Hasher
+	ChunkSize           int
+}
+
+type snapshotExporter struct {
+	userAccountsStorage common.TrieStorageInteractor
+	peerAccountsStorage common.TrieStorageInteractor
+	marshaller          marshal.Marshalizer
+	hasher              hashing.Hasher
+	chunkSize           int
+}
+
+// NewSnapshotExporter creates a component able to write the user accounts trie, together with all the data tries, and
+// the peer accounts trie in a single state snapshot file. The peer accounts storage is needed only for metachain
+// snapshots
+func NewSnapshotExporter(args ArgsSnapshotExporter) (*snapshotExporter, error) {
+	if check.IfNil(args.UserAccountsStorage) {
+		return nil, ErrNilTrieStorage
+	}
+	if check.IfNil(args.Marshaller) {
+		return nil, ErrNilMarshaller
+	}
+	if check.IfNil(args.Hasher) {
+		return nil, ErrNilHasher
+	}
+
+	chunkSize := args.ChunkSize
+	if chunkSize <= 0 {
+		chunkSize = defaultChunkSize
+	}
+	if chunkSize > maxChunkSize/2 {
+		return nil, ErrChunkTooLarge
+	}
+
+	return &snapshotExporter{
+		userAccountsStorage: args.UserAccountsStorage,
+		peerAccountsStorage: args.PeerAccountsStorage,
+		marshaller:          args.Marshaller,
+		hasher:              args.Hasher,
+		chunkSize:           chunkSize,
+	}, nil
+}
+
+// Export writes the tries with the root hashes from the header to the provided writer. All the nodes of the tries
+// must be present in the storage
+func (se *snapshotExporter) Export(writer io.Writer, header SnapshotHeader) error {
+	if len(header.PeerAccountsRootHash) > 0 && check.IfNil(se.peerAccountsStorage) {
+		return ErrNilTrieStorage
+	}
+
+	header.Version = FormatVersion
+	cw, err := newChunkWriter(writer, se.hasher)
+	if err != nil {
+		return err
+	}
+
+	err = cw.writeJSONChunk(headerChunk, header)
+	if err != nil {
+		return err
+	}
+
+	summary := &snapshotSummary{}
+	summary.NumUserAccountsNodes, err = se.exportTrie(cw, summary, UserAccountsTrie, se.userAccountsStorage, header.UserAccountsRootHash)
+	if err != nil {
+		return err
+	}
+
+	if len(header.PeerAccountsRootHash) > 0 {
+		summary.NumPeerAccountsNodes, err = se.exportTrie(cw, summary, PeerAccountsTrie, se.peerAccountsStorage, header.PeerAccountsRootHash)
+		if err != nil {
+			return err
+		}
+	}
+
+	err = cw.writeJSONChunk(endChunk, summary)
+	if err != nil {
+		return err
+	}
+
+	return cw.flush()
+}
+
+func (se *snapshotExporter) exportTrie(
+	cw *chunkWriter,
+	summary *snapshotSummary,
+	kind TrieKind,
+	db common.TrieStorageInteractor,
+	rootHash []byte,
+) (uint64, error) {
+	startTime := time.Now()
+	builder := newNodesChunkBuilder(kind, se.chunkSize)
+	numNodes := uint64(0)
+
+	flushChunk := func() error {
+		if builder.isEmpty() {
+			return nil
+		}
+
+		errWrite := cw.writeChunk(nodesChunk, builder.buffer)
+		builder.reset()
+		summary.NumNodesChunks++
+
+		return errWrite
+	}
+
+	err := walkTrie(kind, db, se.marshaller, se.hasher, rootHash, func(_ []byte, serializedNode []byte) error {
+		builder.add(serializedNode)
+		numNodes++
+		if numNodes%progressLogInterval == 0 {
+			log.Info("exporting state snapshot", "trie", kind.String(), "num nodes", numNodes, "duration", time.Since(startTime))
+		}
+
+		if builder.size() < se.chunkSize {
+			return nil
+		}
+
+		return flushChunk()
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	err = flushChunk()
+	if err != nil {
+		return 0, err
+	}
+
+	log.Info("exported trie", "trie", kind.String(), "root hash", rootHash, "num nodes", numNodes, "duration", time.Since(startTime))
+
+	return numNodes, nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (se *snapshotExporter) IsInterfaceNil() bool {
+	return se == nil
+}
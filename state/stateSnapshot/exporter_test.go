@@ -0,0 +1,113 @@
+package stateSnapshot_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/state/stateSnapshot"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createMockArgsSnapshotExporter(t *testing.T) stateSnapshot.ArgsSnapshotExporter {
+	return stateSnapshot.ArgsSnapshotExporter{
+		UserAccountsStorage: createStorageManager(t),
+		PeerAccountsStorage: createStorageManager(t),
+		Marshaller:          testMarshaller,
+		Hasher:              testHasher,
+	}
+}
+
+func TestNewSnapshotExporter(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil user accounts storage should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsSnapshotExporter(t)
+		args.UserAccountsStorage = nil
+		exporter, err := stateSnapshot.NewSnapshotExporter(args)
+		assert.Equal(t, stateSnapshot.ErrNilTrieStorage, err)
+		assert.True(t, check.IfNil(exporter))
+	})
+	t.Run("nil marshaller should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsSnapshotExporter(t)
+		args.Marshaller = nil
+		exporter, err := stateSnapshot.NewSnapshotExporter(args)
+		assert.Equal(t, stateSnapshot.ErrNilMarshaller, err)
+		assert.True(t, check.IfNil(exporter))
+	})
+	t.Run("nil hasher should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsSnapshotExporter(t)
+		args.Hasher = nil
+		exporter, err := stateSnapshot.NewSnapshotExporter(args)
+		assert.Equal(t, stateSnapshot.ErrNilHasher, err)
+		assert.True(t, check.IfNil(exporter))
+	})
+	t.Run("too large chunk size should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsSnapshotExporter(t)
+		args.ChunkSize = 1 << 30
+		exporter, err := stateSnapshot.NewSnapshotExporter(args)
+		assert.Equal(t, stateSnapshot.ErrChunkTooLarge, err)
+		assert.True(t, check.IfNil(exporter))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		exporter, err := stateSnapshot.NewSnapshotExporter(createMockArgsSnapshotExporter(t))
+		assert.Nil(t, err)
+		assert.False(t, check.IfNil(exporter))
+	})
+}
+
+func TestSnapshotExporter_Export(t *testing.T) {
+	t.Parallel()
+
+	t.Run("peer accounts root hash without storage should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsSnapshotExporter(t)
+		args.PeerAccountsStorage = nil
+		exporter, _ := stateSnapshot.NewSnapshotExporter(args)
+
+		err := exporter.Export(&bytes.Buffer{}, stateSnapshot.SnapshotHeader{
+			UserAccountsRootHash: []byte("user root hash"),
+			PeerAccountsRootHash: []byte("peer root hash"),
+		})
+		assert.Equal(t, stateSnapshot.ErrNilTrieStorage, err)
+	})
+	t.Run("missing trie node should error", func(t *testing.T) {
+		t.Parallel()
+
+		exporter, _ := stateSnapshot.NewSnapshotExporter(createMockArgsSnapshotExporter(t))
+
+		err := exporter.Export(&bytes.Buffer{}, stateSnapshot.SnapshotHeader{
+			UserAccountsRootHash: []byte("missing root hash"),
+		})
+		assert.NotNil(t, err)
+	})
+	t.Run("should export only the user accounts trie if no peer accounts root hash is provided", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsSnapshotExporter(t)
+		args.PeerAccountsStorage = nil
+		exporter, _ := stateSnapshot.NewSnapshotExporter(args)
+		header := stateSnapshot.SnapshotHeader{
+			ShardID:              1,
+			UserAccountsRootHash: createUserAccountsTrie(t, args.UserAccountsStorage.(common.StorageManager)),
+		}
+
+		buff := &bytes.Buffer{}
+		err := exporter.Export(buff, header)
+		require.Nil(t, err)
+		assert.True(t, buff.Len() > 0)
+	})
+}
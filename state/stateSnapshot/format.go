@@ -0,0 +1,253 @@
+package stateSnapshot
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"io"
+
+	"github.com/multiversx/mx-chain-core-go/hashing"
+)
+
+// TrieKind identifies the trie a node from the snapshot file belongs to
+type TrieKind byte
+
+const (
+	// UserAccountsTrie holds the nodes of the user accounts trie and of all the accounts data tries
+	UserAccountsTrie TrieKind = 1
+	// PeerAccountsTrie holds the nodes of the peer accounts trie
+	PeerAccountsTrie TrieKind = 2
+)
+
+// String returns the name of the trie kind
+func (tk TrieKind) String() string {
+	switch tk {
+	case UserAccountsTrie:
+		return "user accounts trie"
+	case PeerAccountsTrie:
+		return "peer accounts trie"
+	default:
+		return fmt.Sprintf("unknown trie kind %d", tk)
+	}
+}
+
+// FormatVersion is the version of the snapshot file format written by the exporter
+const FormatVersion = uint32(1)
+
+type chunkType byte
+
+const (
+	headerChunk chunkType = 1
+	nodesChunk  chunkType = 2
+	endChunk    chunkType = 3
+)
+
+const (
+	uint32Size       = 4
+	chunkPrefixSize  = 1 + uint32Size
+	maxChunkSize     = 64 * 1024 * 1024
+	defaultChunkSize = 4 * 1024 * 1024
+	ioBufferSize     = 1024 * 1024
+)
+
+// fileMagic is written at the beginning of every snapshot file
+var fileMagic = []byte("MXSTATE\x00")
+
+// SnapshotHeader is written in the first chunk of the snapshot file and describes the exported state
+type SnapshotHeader struct {
+	Version              uint32 `json:"version"`
+	ShardID              uint32 `json:"shardID"`
+	Epoch                uint32 `json:"epoch"`
+	UserAccountsRootHash []byte `json:"userAccountsRootHash"`
+	PeerAccountsRootHash []byte `json:"peerAccountsRootHash,omitempty"`
+}
+
+// RootHash returns the root hash of the provided trie kind
+func (sh *SnapshotHeader) RootHash(kind TrieKind) []byte {
+	if kind == PeerAccountsTrie {
+		return sh.PeerAccountsRootHash
+	}
+
+	return sh.UserAccountsRootHash
+}
+
+// snapshotSummary is written in the last chunk of the snapshot file. Its presence proves that the file was not
+// truncated
+type snapshotSummary struct {
+	NumNodesChunks       uint64 `json:"numNodesChunks"`
+	NumUserAccountsNodes uint64 `json:"numUserAccountsNodes"`
+	NumPeerAccountsNodes uint64 `json:"numPeerAccountsNodes"`
+}
+
+// The snapshot file is the magic followed by a sequence of chunks. Each chunk is made of its type (1 byte), the
+// payload length (4 bytes, big endian), the payload and the hash of the payload. A nodes chunk payload starts with the
+// trie kind (1 byte), followed by length prefixed serialized trie nodes. The nodes are content addressed, so each node
+// is verified against its hash when imported
+type chunkWriter struct {
+	writer *bufio.Writer
+	hasher hashing.Hasher
+}
+
+func newChunkWriter(writer io.Writer, hasher hashing.Hasher) (*chunkWriter, error) {
+	cw := &chunkWriter{
+		writer: bufio.NewWriterSize(writer, ioBufferSize),
+		hasher: hasher,
+	}
+
+	_, err := cw.writer.Write(fileMagic)
+	if err != nil {
+		return nil, err
+	}
+
+	return cw, nil
+}
+
+func (cw *chunkWriter) writeJSONChunk(chType chunkType, object interface{}) error {
+	payload, err := json.Marshal(object)
+	if err != nil {
+		return err
+	}
+
+	return cw.writeChunk(chType, payload)
+}
+
+func (cw *chunkWriter) writeChunk(chType chunkType, payload []byte) error {
+	if len(payload) > maxChunkSize {
+		return fmt.Errorf("%w: %d bytes", ErrChunkTooLarge, len(payload))
+	}
+
+	prefix := make([]byte, chunkPrefixSize)
+	prefix[0] = byte(chType)
+	binary.BigEndian.PutUint32(prefix[1:], uint32(len(payload)))
+
+	_, err := cw.writer.Write(prefix)
+	if err != nil {
+		return err
+	}
+	_, err = cw.writer.Write(payload)
+	if err != nil {
+		return err
+	}
+	_, err = cw.writer.Write(cw.hasher.Compute(string(payload)))
+
+	return err
+}
+
+func (cw *chunkWriter) flush() error {
+	return cw.writer.Flush()
+}
+
+type chunkReader struct {
+	reader *bufio.Reader
+	hasher hashing.Hasher
+}
+
+func newChunkReader(reader io.Reader, hasher hashing.Hasher) (*chunkReader, error) {
+	cr := &chunkReader{
+		reader: bufio.NewReaderSize(reader, ioBufferSize),
+		hasher: hasher,
+	}
+
+	magic := make([]byte, len(fileMagic))
+	_, err := io.ReadFull(cr.reader, magic)
+	if err != nil || !bytes.Equal(magic, fileMagic) {
+		return nil, ErrInvalidSnapshotFile
+	}
+
+	return cr, nil
+}
+
+// readChunk returns the type and the verified payload of the next chunk. It returns io.EOF if there are no more chunks
+func (cr *chunkReader) readChunk() (chunkType, []byte, error) {
+	prefix := make([]byte, chunkPrefixSize)
+	_, err := io.ReadFull(cr.reader, prefix)
+	if err == io.EOF {
+		return 0, nil, io.EOF
+	}
+	if err != nil {
+		return 0, nil, fmt.Errorf("%w: %s", ErrInvalidSnapshotFile, err.Error())
+	}
+
+	payloadSize := binary.BigEndian.Uint32(prefix[1:])
+	if payloadSize > maxChunkSize {
+		return 0, nil, fmt.Errorf("%w: %d bytes", ErrChunkTooLarge, payloadSize)
+	}
+
+	payload := make([]byte, payloadSize)
+	_, err = io.ReadFull(cr.reader, payload)
+	if err != nil {
+		return 0, nil, fmt.Errorf("%w: %s", ErrInvalidSnapshotFile, err.Error())
+	}
+
+	hash := make([]byte, cr.hasher.Size())
+	_, err = io.ReadFull(cr.reader, hash)
+	if err != nil {
+		return 0, nil, fmt.Errorf("%w: %s", ErrInvalidSnapshotFile, err.Error())
+	}
+	if !bytes.Equal(hash, cr.hasher.Compute(string(payload))) {
+		return 0, nil, ErrChunkHashMismatch
+	}
+
+	return chunkType(prefix[0]), payload, nil
+}
+
+// nodesChunkBuilder accumulates the serialized nodes of a trie kind until the chunk size is reached
+type nodesChunkBuilder struct {
+	buffer []byte
+}
+
+func newNodesChunkBuilder(kind TrieKind, chunkSize int) *nodesChunkBuilder {
+	buffer := make([]byte, 1, chunkSize)
+	buffer[0] = byte(kind)
+
+	return &nodesChunkBuilder{
+		buffer: buffer,
+	}
+}
+
+func (ncb *nodesChunkBuilder) add(serializedNode []byte) {
+	ncb.buffer = binary.BigEndian.AppendUint32(ncb.buffer, uint32(len(serializedNode)))
+	ncb.buffer = append(ncb.buffer, serializedNode...)
+}
+
+func (ncb *nodesChunkBuilder) size() int {
+	return len(ncb.buffer)
+}
+
+func (ncb *nodesChunkBuilder) isEmpty() bool {
+	return len(ncb.buffer) == 1
+}
+
+func (ncb *nodesChunkBuilder) reset() {
+	ncb.buffer = ncb.buffer[:1]
+}
+
+// parseNodesChunk calls the handler for every serialized node of a nodes chunk payload
+func parseNodesChunk(payload []byte, handler func(kind TrieKind, serializedNode []byte) error) error {
+	if len(payload) < 1 {
+		return ErrInvalidNodesChunk
+	}
+
+	kind := TrieKind(payload[0])
+	for index := 1; index < len(payload); {
+		if index+uint32Size > len(payload) {
+			return ErrInvalidNodesChunk
+		}
+
+		nodeSize := int(binary.BigEndian.Uint32(payload[index:]))
+		index += uint32Size
+		if nodeSize == 0 || index+nodeSize > len(payload) {
+			return ErrInvalidNodesChunk
+		}
+
+		err := handler(kind, payload[index:index+nodeSize])
+		if err != nil {
+			return err
+		}
+		index += nodeSize
+	}
+
+	return nil
+}
@@ -0,0 +1,227 @@
+package stateSnapshot
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-core-go/hashing"
+	"github.com/multiversx/mx-chain-core-go/marshal"
+	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/trie"
+)
+
+// ArgsSnapshotImporter holds the arguments needed for creating a state snapshot importer
+type ArgsSnapshotImporter struct {
+	Marshaller marshal.Marshalizer
+	Hasher     hashing.Hasher
+}
+
+type snapshotImporter struct {
+	marshaller marshal.Marshalizer
+	hasher     hashing.Hasher
+}
+
+// NewSnapshotImporter creates a component able to import a trie from a state snapshot file
+func NewSnapshotImporter(args ArgsSnapshotImporter) (*snapshotImporter, error) {
+	if check.IfNil(args.Marshaller) {
+		return nil, ErrNilMarshaller
+	}
+	if check.IfNil(args.Hasher) {
+		return nil, ErrNilHasher
+	}
+
+	return &snapshotImporter{
+		marshaller: args.Marshaller,
+		hasher:     args.Hasher,
+	}, nil
+}
+
+// ReadHeader returns the header of the provided state snapshot file
+func (si *snapshotImporter) ReadHeader(filePath string) (*SnapshotHeader, error) {
+	file, err := os.Open(filePath)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		_ = file.Close()
+	}()
+
+	cr, err := newChunkReader(file, si.hasher)
+	if err != nil {
+		return nil, err
+	}
+
+	return si.readHeader(cr)
+}
+
+func (si *snapshotImporter) readHeader(cr *chunkReader) (*SnapshotHeader, error) {
+	chType, payload, err := cr.readChunk()
+	if err == io.EOF {
+		return nil, ErrTruncatedSnapshot
+	}
+	if err != nil {
+		return nil, err
+	}
+	if chType != headerChunk {
+		return nil, fmt.Errorf("%w: expected the header, found chunk type %d", ErrUnexpectedChunk, chType)
+	}
+
+	header := &SnapshotHeader{}
+	err = json.Unmarshal(payload, header)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrInvalidSnapshotFile, err.Error())
+	}
+	if header.Version != FormatVersion {
+		return nil, fmt.Errorf("%w: %d", ErrUnsupportedSnapshotVersion, header.Version)
+	}
+
+	return header, nil
+}
+
+// ImportTrie imports the nodes of the provided trie kind from the state snapshot file into the storage manager. The
+// root hash from the snapshot header must match the provided root hash and every node is verified against its hash.
+// After the import, the trie is walked from the root hash, to check that it is complete, and the storage is marked as
+// synced
+func (si *snapshotImporter) ImportTrie(
+	filePath string,
+	kind TrieKind,
+	rootHash []byte,
+	storageManager common.StorageManager,
+	storageMarker common.StorageMarker,
+) error {
+	if check.IfNil(storageManager) {
+		return ErrNilTrieStorage
+	}
+	if check.IfNil(storageMarker) {
+		return ErrNilStorageMarker
+	}
+
+	startTime := time.Now()
+	numImportedNodes, err := si.importNodes(filePath, kind, rootHash, storageManager)
+	if err != nil {
+		return err
+	}
+
+	numWalkedNodes := uint64(0)
+	err = walkTrie(kind, storageManager, si.marshaller, si.hasher, rootHash, func(_ []byte, _ []byte) error {
+		numWalkedNodes++
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("%w while checking the imported %s", err, kind.String())
+	}
+
+	storageMarker.MarkStorerAsSyncedAndActive(storageManager)
+
+	log.Info("imported trie from state snapshot",
+		"trie", kind.String(),
+		"root hash", rootHash,
+		"num imported nodes", numImportedNodes,
+		"num trie nodes", numWalkedNodes,
+		"duration", time.Since(startTime),
+	)
+
+	return nil
+}
+
+func (si *snapshotImporter) importNodes(filePath string, kind TrieKind, rootHash []byte, storageManager common.StorageManager) (uint64, error) {
+	file, err := os.Open(filePath)
+	if err != nil {
+		return 0, err
+	}
+	defer func() {
+		_ = file.Close()
+	}()
+
+	cr, err := newChunkReader(file, si.hasher)
+	if err != nil {
+		return 0, err
+	}
+
+	header, err := si.readHeader(cr)
+	if err != nil {
+		return 0, err
+	}
+	if !bytes.Equal(header.RootHash(kind), rootHash) {
+		return 0, fmt.Errorf("%w for %s: expected %x, snapshot holds %x",
+			ErrRootHashMismatch, kind.String(), rootHash, header.RootHash(kind))
+	}
+
+	syncStorage, err := trie.NewSyncTrieStorageManager(storageManager)
+	if err != nil {
+		return 0, err
+	}
+
+	numNodesChunks := uint64(0)
+	numImportedNodes := uint64(0)
+	putNode := func(nodeKind TrieKind, serializedNode []byte) error {
+		if nodeKind != kind {
+			return nil
+		}
+
+		numImportedNodes++
+		return syncStorage.Put(si.hasher.Compute(string(serializedNode)), serializedNode)
+	}
+
+	for {
+		chType, payload, errRead := cr.readChunk()
+		if errRead == io.EOF {
+			return 0, ErrTruncatedSnapshot
+		}
+		if errRead != nil {
+			return 0, errRead
+		}
+
+		switch chType {
+		case nodesChunk:
+			numNodesChunks++
+			err = parseNodesChunk(payload, putNode)
+			if err != nil {
+				return 0, err
+			}
+		case endChunk:
+			err = checkSummary(payload, numNodesChunks, kind, numImportedNodes)
+			if err != nil {
+				return 0, err
+			}
+
+			_, _, errRead = cr.readChunk()
+			if errRead != io.EOF {
+				return 0, fmt.Errorf("%w: data found after the last chunk", ErrUnexpectedChunk)
+			}
+
+			return numImportedNodes, nil
+		default:
+			return 0, fmt.Errorf("%w: chunk type %d", ErrUnexpectedChunk, chType)
+		}
+	}
+}
+
+func checkSummary(payload []byte, numNodesChunks uint64, kind TrieKind, numImportedNodes uint64) error {
+	summary := &snapshotSummary{}
+	err := json.Unmarshal(payload, summary)
+	if err != nil {
+		return fmt.Errorf("%w: %s", ErrInvalidSnapshotFile, err.Error())
+	}
+
+	expectedNumNodes := summary.NumUserAccountsNodes
+	if kind == PeerAccountsTrie {
+		expectedNumNodes = summary.NumPeerAccountsNodes
+	}
+	if summary.NumNodesChunks != numNodesChunks || expectedNumNodes != numImportedNodes {
+		return fmt.Errorf("%w: read %d chunks and %d nodes, the summary declares %d chunks and %d nodes",
+			ErrTruncatedSnapshot, numNodesChunks, numImportedNodes, summary.NumNodesChunks, expectedNumNodes)
+	}
+
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (si *snapshotImporter) IsInterfaceNil() bool {
+	return si == nil
+}
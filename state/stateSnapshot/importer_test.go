@@ -0,0 +1,278 @@
+package stateSnapshot_test
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-core-go/hashing/blake2b"
+	"github.com/multiversx/mx-chain-core-go/marshal"
+	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/common/holders"
+	"github.com/multiversx/mx-chain-go/state"
+	"github.com/multiversx/mx-chain-go/state/accounts"
+	"github.com/multiversx/mx-chain-go/state/stateSnapshot"
+	"github.com/multiversx/mx-chain-go/testscommon"
+	"github.com/multiversx/mx-chain-go/testscommon/enableEpochsHandlerMock"
+	testStorage "github.com/multiversx/mx-chain-go/testscommon/storage"
+	"github.com/multiversx/mx-chain-go/trie"
+	"github.com/multiversx/mx-chain-go/trie/storageMarker"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	testMarshaller = &marshal.GogoProtoMarshalizer{}
+	testHasher     = blake2b.NewBlake2b()
+)
+
+func createStorageManager(t *testing.T) common.StorageManager {
+	args := testStorage.GetStorageManagerArgs()
+	args.MainStorer = testscommon.NewSnapshotPruningStorerMock()
+	args.Marshalizer = testMarshaller
+	args.Hasher = testHasher
+
+	storageManager, err := trie.NewTrieStorageManager(args)
+	require.Nil(t, err)
+
+	return storageManager
+}
+
+func createTrie(t *testing.T, storageManager common.StorageManager) common.Trie {
+	tr, err := trie.NewTrie(storageManager, testMarshaller, testHasher, &enableEpochsHandlerMock.EnableEpochsHandlerStub{}, 5)
+	require.Nil(t, err)
+
+	return tr
+}
+
+// createUserAccountsTrie saves a main trie holding accounts with and without data tries, two accounts sharing the
+// same data trie, and a code entry
+func createUserAccountsTrie(t *testing.T, storageManager common.StorageManager) []byte {
+	mainTrie := createTrie(t, storageManager)
+	for i := 0; i < 20; i++ {
+		account := &accounts.UserAccountData{
+			Nonce:   uint64(i),
+			Address: []byte(fmt.Sprintf("address%d", i)),
+		}
+
+		if i%2 == 0 {
+			dataTrie := createTrie(t, storageManager)
+			dataIndex := i
+			if i == 4 {
+				dataIndex = 2
+			}
+			for j := 0; j < 10; j++ {
+				require.Nil(t, dataTrie.Update([]byte(fmt.Sprintf("key%d", j)), []byte(fmt.Sprintf("value%d_%d", dataIndex, j))))
+			}
+			require.Nil(t, dataTrie.Commit())
+			account.RootHash, _ = dataTrie.RootHash()
+		}
+
+		accountBytes, err := testMarshaller.Marshal(account)
+		require.Nil(t, err)
+		require.Nil(t, mainTrie.Update(testHasher.Compute(string(account.Address)), accountBytes))
+	}
+
+	codeEntry, err := testMarshaller.Marshal(&state.CodeEntry{Code: []byte("code"), NumReferences: 1})
+	require.Nil(t, err)
+	require.Nil(t, mainTrie.Update(testHasher.Compute("code"), codeEntry))
+
+	require.Nil(t, mainTrie.Commit())
+	rootHash, _ := mainTrie.RootHash()
+
+	return rootHash
+}
+
+func createPeerAccountsTrie(t *testing.T, storageManager common.StorageManager) []byte {
+	peerTrie := createTrie(t, storageManager)
+	for i := 0; i < 10; i++ {
+		require.Nil(t, peerTrie.Update([]byte(fmt.Sprintf("validator%d", i)), []byte(fmt.Sprintf("peer account %d", i))))
+	}
+	require.Nil(t, peerTrie.Commit())
+	rootHash, _ := peerTrie.RootHash()
+
+	return rootHash
+}
+
+// exportSnapshot exports the user and peer accounts tries, using small chunks, and returns the snapshot file path
+func exportSnapshot(t *testing.T) (string, *stateSnapshot.SnapshotHeader) {
+	userStorage := createStorageManager(t)
+	peerStorage := createStorageManager(t)
+	header := stateSnapshot.SnapshotHeader{
+		ShardID:              0,
+		Epoch:                7,
+		UserAccountsRootHash: createUserAccountsTrie(t, userStorage),
+		PeerAccountsRootHash: createPeerAccountsTrie(t, peerStorage),
+	}
+
+	exporter, err := stateSnapshot.NewSnapshotExporter(stateSnapshot.ArgsSnapshotExporter{
+		UserAccountsStorage: userStorage,
+		PeerAccountsStorage: peerStorage,
+		Marshaller:          testMarshaller,
+		Hasher:              testHasher,
+		ChunkSize:           512,
+	})
+	require.Nil(t, err)
+
+	buff := &bytes.Buffer{}
+	require.Nil(t, exporter.Export(buff, header))
+
+	filePath := filepath.Join(t.TempDir(), "state.snapshot")
+	require.Nil(t, os.WriteFile(filePath, buff.Bytes(), 0644))
+
+	return filePath, &header
+}
+
+func createImporter(t *testing.T) stateSnapshot.SnapshotImporter {
+	importer, err := stateSnapshot.NewSnapshotImporter(stateSnapshot.ArgsSnapshotImporter{
+		Marshaller: testMarshaller,
+		Hasher:     testHasher,
+	})
+	require.Nil(t, err)
+
+	return importer
+}
+
+func TestNewSnapshotImporter(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil marshaller should error", func(t *testing.T) {
+		t.Parallel()
+
+		importer, err := stateSnapshot.NewSnapshotImporter(stateSnapshot.ArgsSnapshotImporter{
+			Hasher: testHasher,
+		})
+		assert.Equal(t, stateSnapshot.ErrNilMarshaller, err)
+		assert.True(t, check.IfNil(importer))
+	})
+	t.Run("nil hasher should error", func(t *testing.T) {
+		t.Parallel()
+
+		importer, err := stateSnapshot.NewSnapshotImporter(stateSnapshot.ArgsSnapshotImporter{
+			Marshaller: testMarshaller,
+		})
+		assert.Equal(t, stateSnapshot.ErrNilHasher, err)
+		assert.True(t, check.IfNil(importer))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		importer := createImporter(t)
+		assert.False(t, check.IfNil(importer))
+	})
+}
+
+func TestSnapshotImporter_ReadHeader(t *testing.T) {
+	t.Parallel()
+
+	filePath, header := exportSnapshot(t)
+	readHeader, err := createImporter(t).ReadHeader(filePath)
+	assert.Nil(t, err)
+	assert.Equal(t, stateSnapshot.FormatVersion, readHeader.Version)
+	assert.Equal(t, header.Epoch, readHeader.Epoch)
+	assert.Equal(t, header.UserAccountsRootHash, readHeader.UserAccountsRootHash)
+	assert.Equal(t, header.PeerAccountsRootHash, readHeader.PeerAccountsRootHash)
+}
+
+func TestSnapshotImporter_ImportTrie(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil storage manager should error", func(t *testing.T) {
+		t.Parallel()
+
+		err := createImporter(t).ImportTrie("file", stateSnapshot.UserAccountsTrie, []byte("root"), nil, storageMarker.NewTrieStorageMarker())
+		assert.Equal(t, stateSnapshot.ErrNilTrieStorage, err)
+	})
+	t.Run("nil storage marker should error", func(t *testing.T) {
+		t.Parallel()
+
+		err := createImporter(t).ImportTrie("file", stateSnapshot.UserAccountsTrie, []byte("root"), createStorageManager(t), nil)
+		assert.Equal(t, stateSnapshot.ErrNilStorageMarker, err)
+	})
+	t.Run("not a snapshot file should error", func(t *testing.T) {
+		t.Parallel()
+
+		filePath := filepath.Join(t.TempDir(), "state.snapshot")
+		require.Nil(t, os.WriteFile(filePath, []byte("not a snapshot"), 0644))
+
+		err := createImporter(t).ImportTrie(filePath, stateSnapshot.UserAccountsTrie, []byte("root"), createStorageManager(t), storageMarker.NewTrieStorageMarker())
+		assert.Equal(t, stateSnapshot.ErrInvalidSnapshotFile, err)
+	})
+	t.Run("root hash mismatch should error before importing", func(t *testing.T) {
+		t.Parallel()
+
+		filePath, _ := exportSnapshot(t)
+		storageManager := createStorageManager(t)
+
+		err := createImporter(t).ImportTrie(filePath, stateSnapshot.UserAccountsTrie, []byte("another root hash"), storageManager, storageMarker.NewTrieStorageMarker())
+		assert.True(t, errors.Is(err, stateSnapshot.ErrRootHashMismatch))
+
+		_, err = storageManager.Get([]byte(common.TrieSyncedKey))
+		assert.NotNil(t, err)
+	})
+	t.Run("corrupted chunk should error", func(t *testing.T) {
+		t.Parallel()
+
+		filePath, header := exportSnapshot(t)
+		content, err := os.ReadFile(filePath)
+		require.Nil(t, err)
+		content[len(content)/2]++
+		require.Nil(t, os.WriteFile(filePath, content, 0644))
+
+		err = createImporter(t).ImportTrie(filePath, stateSnapshot.UserAccountsTrie, header.UserAccountsRootHash, createStorageManager(t), storageMarker.NewTrieStorageMarker())
+		assert.NotNil(t, err)
+	})
+	t.Run("truncated file should error", func(t *testing.T) {
+		t.Parallel()
+
+		filePath, header := exportSnapshot(t)
+		content, err := os.ReadFile(filePath)
+		require.Nil(t, err)
+		require.Nil(t, os.WriteFile(filePath, content[:len(content)-100], 0644))
+
+		err = createImporter(t).ImportTrie(filePath, stateSnapshot.UserAccountsTrie, header.UserAccountsRootHash, createStorageManager(t), storageMarker.NewTrieStorageMarker())
+		assert.True(t, errors.Is(err, stateSnapshot.ErrInvalidSnapshotFile) || errors.Is(err, stateSnapshot.ErrTruncatedSnapshot))
+	})
+	t.Run("should import both tries", func(t *testing.T) {
+		t.Parallel()
+
+		filePath, header := exportSnapshot(t)
+		importer := createImporter(t)
+
+		userStorage := createStorageManager(t)
+		err := importer.ImportTrie(filePath, stateSnapshot.UserAccountsTrie, header.UserAccountsRootHash, userStorage, storageMarker.NewTrieStorageMarker())
+		require.Nil(t, err)
+
+		val, err := userStorage.Get([]byte(common.TrieSyncedKey))
+		assert.Nil(t, err)
+		assert.Equal(t, []byte(common.TrieSyncedVal), val)
+
+		tr, err := createTrie(t, userStorage).Recreate(holders.NewDefaultRootHashesHolder(header.UserAccountsRootHash))
+		require.Nil(t, err)
+		accountBytes, _, err := tr.Get(testHasher.Compute("address6"))
+		require.Nil(t, err)
+		account := &accounts.UserAccountData{}
+		require.Nil(t, testMarshaller.Unmarshal(account, accountBytes))
+		assert.Equal(t, uint64(6), account.Nonce)
+
+		dataTrie, err := createTrie(t, userStorage).Recreate(holders.NewDefaultRootHashesHolder(account.RootHash))
+		require.Nil(t, err)
+		value, _, err := dataTrie.Get([]byte("key3"))
+		assert.Nil(t, err)
+		assert.Equal(t, []byte("value6_3"), value)
+
+		peerStorage := createStorageManager(t)
+		err = importer.ImportTrie(filePath, stateSnapshot.PeerAccountsTrie, header.PeerAccountsRootHash, peerStorage, storageMarker.NewTrieStorageMarker())
+		require.Nil(t, err)
+
+		peerTrie, err := createTrie(t, peerStorage).Recreate(holders.NewDefaultRootHashesHolder(header.PeerAccountsRootHash))
+		require.Nil(t, err)
+		value, _, err = peerTrie.Get([]byte("validator3"))
+		assert.Nil(t, err)
+		assert.Equal(t, []byte("peer account 3"), value)
+	})
+}
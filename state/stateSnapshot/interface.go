@@ -0,0 +1,20 @@
+package stateSnapshot
+
+import (
+	"io"
+
+	"github.com/multiversx/mx-chain-go/common"
+)
+
+// SnapshotExporter defines the operations of a component able to write a state snapshot file
+type SnapshotExporter interface {
+	Export(writer io.Writer, header SnapshotHeader) error
+	IsInterfaceNil() bool
+}
+
+// SnapshotImporter defines the operations of a component able to import tries from a state snapshot file
+type SnapshotImporter interface {
+	ReadHeader(filePath string) (*SnapshotHeader, error)
+	ImportTrie(filePath string, kind TrieKind, rootHash []byte, storageManager common.StorageManager, storageMarker common.StorageMarker) error
+	IsInterfaceNil() bool
+}
@@ -0,0 +1,55 @@
+package stateSnapshot
+
+import (
+	"github.com/multiversx/mx-chain-core-go/hashing"
+	"github.com/multiversx/mx-chain-core-go/marshal"
+	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/state/accounts"
+	"github.com/multiversx/mx-chain-go/trie"
+)
+
+// walkTrie walks all the nodes of the trie with the provided root hash. For the user accounts trie, the data tries of
+// the accounts are walked as well, each distinct data trie being walked only once
+func walkTrie(
+	kind TrieKind,
+	db common.TrieStorageInteractor,
+	marshaller marshal.Marshalizer,
+	hasher hashing.Hasher,
+	rootHash []byte,
+	handler func(hash []byte, serializedNode []byte) error,
+) error {
+	nodesHandler := func(hash []byte, serializedNode []byte, _ []byte) error {
+		return handler(hash, serializedNode)
+	}
+	if kind != UserAccountsTrie {
+		return trie.WalkSerializedNodes(db, marshaller, hasher, rootHash, nodesHandler)
+	}
+
+	walkedDataTries := make(map[string]struct{})
+	mainTrieHandler := func(hash []byte, serializedNode []byte, leafValue []byte) error {
+		err := handler(hash, serializedNode)
+		if err != nil || len(leafValue) == 0 {
+			return err
+		}
+
+		account := &accounts.UserAccountData{}
+		err = marshaller.Unmarshal(account, leafValue)
+		if err != nil {
+			// the code of the smart contracts is also saved in the main trie
+			return nil
+		}
+		if common.IsEmptyTrie(account.RootHash) {
+			return nil
+		}
+
+		_, alreadyWalked := walkedDataTries[string(account.RootHash)]
+		if alreadyWalked {
+			return nil
+		}
+		walkedDataTries[string(account.RootHash)] = struct{}{}
+
+		return trie.WalkSerializedNodes(db, marshaller, hasher, account.RootHash, nodesHandler)
+	}
+
+	return trie.WalkSerializedNodes(db, marshaller, hasher, rootHash, mainTrieHandler)
+}
@@ -0,0 +1,32 @@
+package coldarchive
+
+import (
+	"sync"
+)
+
+// archivingEpoch serves the reads of an epoch while it is archived, from the databases of the epoch directory opened in
+// read only mode. The archiver takes its lock when it has to wait for the ongoing reads to end
+type archivingEpoch struct {
+	mut    sync.RWMutex
+	source *movedArchive
+}
+
+func newArchivingEpoch(source *movedArchive) *archivingEpoch {
+	return &archivingEpoch{
+		source: source,
+	}
+}
+
+func (ae *archivingEpoch) get(unitName string, key []byte) ([]byte, error) {
+	ae.mut.RLock()
+	defer ae.mut.RUnlock()
+
+	return ae.source.get(unitName, key)
+}
+
+func (ae *archivingEpoch) close() error {
+	ae.mut.Lock()
+	defer ae.mut.Unlock()
+
+	return ae.source.close()
+}
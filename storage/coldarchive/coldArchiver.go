@@ -0,0 +1,583 @@
+package coldarchive
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-go/config"
+	"github.com/multiversx/mx-chain-go/storage"
+	"github.com/multiversx/mx-chain-go/storage/cache"
+	logger "github.com/multiversx/mx-chain-logger-go"
+)
+
+const (
+	// PackMode compacts all the databases of an epoch into a single, compressed, read-only archive file
+	PackMode = "pack"
+	// MoveMode moves the directory of an epoch, as it is, in the archive path
+	MoveMode = "move"
+
+	defaultArchiveDirectory = "ColdArchive"
+	packedArchiveExtension  = ".archive"
+	maxGetAttempts          = 2
+)
+
+var log = logger.GetOrCreate("storage/coldarchive")
+
+// ArgsColdArchiver holds the arguments needed for creating a cold archiver
+type ArgsColdArchiver struct {
+	Config              config.ColdArchiveConfig
+	NumActivePersisters uint32
+	PathManager         storage.PathManagerHandler
+	ShardCoordinator    storage.ShardCoordinator
+	EnableArchiving     bool
+}
+
+type epochArchive interface {
+	get(unitName string, key []byte) ([]byte, error)
+	close() error
+}
+
+type registeredUnit struct {
+	persisterFactory    storage.PersisterFactory
+	releaseEpochHandler func(epoch uint32) error
+}
+
+type coldArchiver struct {
+	mode                      string
+	archivePath               string
+	shardIDStr                string
+	numEpochsToKeepUnarchived uint32
+	pathManager               storage.PathManagerHandler
+	isArchivingEnabled        bool
+
+	mutUnits sync.RWMutex
+	units    map[string]*registeredUnit
+
+	mutArchivedEpochs sync.RWMutex
+	archivedEpochs    map[uint32]struct{}
+	archivingEpochs   map[uint32]*archivingEpoch
+
+	mutOpenedArchives sync.Mutex
+	openedArchives    storage.Cacher
+
+	mutRequestedEpoch    sync.Mutex
+	requestedEpoch       uint32
+	chanArchivingRequest chan struct{}
+	cancelFunc           func()
+	wgArchiving          sync.WaitGroup
+}
+
+// NewColdArchiver creates a component able to archive the databases of the old epochs and to read from the archives.
+// The archives are opened on demand and an LRU of opened archives is kept. If enabled, the archiving is done on a
+// background go routine, when requested
+func NewColdArchiver(args ArgsColdArchiver) (*coldArchiver, error) {
+	err := checkArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	archivePath := args.Config.ArchivePath
+	if len(archivePath) == 0 {
+		archivePath = filepath.Join(args.PathManager.DatabasePath(), defaultArchiveDirectory)
+	}
+
+	ca := &coldArchiver{
+		mode:                      args.Config.Mode,
+		archivePath:               archivePath,
+		shardIDStr:                core.GetShardIDString(args.ShardCoordinator.SelfId()),
+		numEpochsToKeepUnarchived: args.Config.NumEpochsToKeepUnarchived,
+		pathManager:               args.PathManager,
+		isArchivingEnabled:        args.EnableArchiving,
+		units:                     make(map[string]*registeredUnit),
+		archivedEpochs:            make(map[uint32]struct{}),
+		archivingEpochs:           make(map[uint32]*archivingEpoch),
+		chanArchivingRequest:      make(chan struct{}, 1),
+		cancelFunc:                func() {},
+	}
+	ca.openedArchives, err = cache.NewLRUCacheWithEviction(int(args.Config.NumOpenedArchives), ca.onEvicted)
+	if err != nil {
+		return nil, err
+	}
+
+	if ca.isArchivingEnabled {
+		var ctx context.Context
+		ctx, ca.cancelFunc = context.WithCancel(context.Background())
+		ca.wgArchiving.Add(1)
+		go ca.processArchivingRequests(ctx)
+	}
+
+	return ca, nil
+}
+
+func checkArgs(args ArgsColdArchiver) error {
+	if check.IfNil(args.PathManager) {
+		return storage.ErrNilPathManager
+	}
+	if check.IfNil(args.ShardCoordinator) {
+		return storage.ErrNilShardCoordinator
+	}
+	if args.Config.Mode != PackMode && args.Config.Mode != MoveMode {
+		return fmt.Errorf("%w: %s", storage.ErrInvalidColdArchiveMode, args.Config.Mode)
+	}
+	if args.Config.NumOpenedArchives < 1 || args.Config.NumOpenedArchives > math.MaxInt32 {
+		return storage.ErrInvalidNumOpenedArchives
+	}
+	if args.Config.NumEpochsToKeepUnarchived < args.NumActivePersisters {
+		return fmt.Errorf("%w: %d is lower than the number of active persisters %d",
+			storage.ErrInvalidNumEpochsToKeepUnarchived, args.Config.NumEpochsToKeepUnarchived, args.NumActivePersisters)
+	}
+
+	return nil
+}
+
+// RegisterUnit registers a storage unit, identified by its directory name inside an epoch directory. Only the registered
+// units are archived. The provided persister factory should open the databases in read only mode, as the archived
+// epochs are never written. The release epoch handler is called before an epoch is archived and it should close the
+// databases of the unit opened for that epoch, or return an error if the epoch is still in use
+func (ca *coldArchiver) RegisterUnit(unitName string, persisterFactory storage.PersisterFactory, releaseEpochHandler func(epoch uint32) error) {
+	if check.IfNil(persisterFactory) {
+		log.Warn("coldArchiver.RegisterUnit: nil persister factory", "unit", unitName)
+		return
+	}
+	if releaseEpochHandler == nil {
+		log.Warn("coldArchiver.RegisterUnit: nil release epoch handler", "unit", unitName)
+		return
+	}
+
+	ca.mutUnits.Lock()
+	ca.units[unitName] = &registeredUnit{
+		persisterFactory:    persisterFactory,
+		releaseEpochHandler: releaseEpochHandler,
+	}
+	ca.mutUnits.Unlock()
+}
+
+// ArchiveOldEpochs requests the archiving of all the epochs older than (current epoch - NumEpochsToKeepUnarchived).
+// The archiving is done on a background go routine, so this call does not block. It does nothing if the archiving is
+// not enabled
+func (ca *coldArchiver) ArchiveOldEpochs(currentEpoch uint32) {
+	if !ca.isArchivingEnabled {
+		return
+	}
+
+	ca.mutRequestedEpoch.Lock()
+	if currentEpoch > ca.requestedEpoch {
+		ca.requestedEpoch = currentEpoch
+	}
+	ca.mutRequestedEpoch.Unlock()
+
+	// a pending request will archive up to the newest requested epoch, so the new request can be dropped
+	select {
+	case ca.chanArchivingRequest <- struct{}{}:
+	default:
+	}
+}
+
+func (ca *coldArchiver) processArchivingRequests(ctx context.Context) {
+	defer ca.wgArchiving.Done()
+
+	for {
+		select {
+		case <-ctx.Done():
+			log.Debug("coldArchiver: closing the archiving go routine")
+			return
+		case <-ca.chanArchivingRequest:
+		}
+
+		ca.mutRequestedEpoch.Lock()
+		currentEpoch := ca.requestedEpoch
+		ca.mutRequestedEpoch.Unlock()
+
+		err := ca.archiveOldEpochs(ctx, currentEpoch)
+		if ctx.Err() != nil {
+			log.Debug("coldArchiver: archiving interrupted", "current epoch", currentEpoch, "error", err)
+			return
+		}
+		if err != nil {
+			log.Warn("coldArchiver: error archiving the old epochs", "current epoch", currentEpoch, "error", err)
+		}
+	}
+}
+
+func (ca *coldArchiver) archiveOldEpochs(ctx context.Context, currentEpoch uint32) error {
+	if currentEpoch <= ca.numEpochsToKeepUnarchived {
+		return nil
+	}
+
+	lastEpochToArchive := currentEpoch - ca.numEpochsToKeepUnarchived - 1
+	for epoch := uint32(0); epoch <= lastEpochToArchive; epoch++ {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+
+		epochDir := ca.epochDirectory(epoch)
+		if !pathExists(epochDir) {
+			continue
+		}
+
+		if ca.IsEpochArchived(epoch) {
+			log.Warn("coldArchiver: epoch directory found next to an existing archive, skipping it",
+				"epoch", epoch, "directory", epochDir, "archive", ca.archiveLocation(epoch))
+			continue
+		}
+
+		err := ca.archiveEpoch(ctx, epoch, epochDir)
+		if err != nil {
+			return fmt.Errorf("%w while archiving epoch %d", err, epoch)
+		}
+	}
+
+	return nil
+}
+
+// archiveEpoch archives the provided epoch. While the epoch is archived, its data is read from the epoch directory
+// through an archiving epoch, with the read only persister factories, after the units released their databases
+func (ca *coldArchiver) archiveEpoch(ctx context.Context, epoch uint32, epochDir string) error {
+	startTime := time.Now()
+	archiveLocation := ca.archiveLocation(epoch)
+
+	archiving := ca.startArchiving(epoch, epochDir)
+	err := ca.releaseEpoch(epoch)
+	if err != nil {
+		ca.stopArchiving(epoch, archiving)
+		return err
+	}
+
+	switch ca.mode {
+	case PackMode:
+		err = ca.packEpoch(ctx, epoch, epochDir, archiveLocation, archiving)
+	default:
+		err = ca.moveEpoch(epoch, epochDir, archiveLocation, archiving)
+	}
+	if err != nil {
+		return err
+	}
+
+	// the epoch directory is removed only if no other shard directory remains in it
+	_ = os.Remove(filepath.Dir(epochDir))
+
+	log.Info("coldArchiver: archived epoch",
+		"epoch", epoch,
+		"mode", ca.mode,
+		"archive", archiveLocation,
+		"duration", time.Since(startTime),
+	)
+
+	return nil
+}
+
+func (ca *coldArchiver) startArchiving(epoch uint32, epochDir string) *archivingEpoch {
+	archiving := newArchivingEpoch(newMovedArchive(epochDir, ca.getPersisterFactory))
+
+	ca.mutArchivedEpochs.Lock()
+	ca.archivingEpochs[epoch] = archiving
+	ca.mutArchivedEpochs.Unlock()
+
+	return archiving
+}
+
+// stopArchiving abandons the archiving of the epoch, the next reads being served by the units' databases
+func (ca *coldArchiver) stopArchiving(epoch uint32, archiving *archivingEpoch) {
+	err := archiving.close()
+	if err != nil {
+		log.Warn("coldArchiver: error closing the archiving epoch", "epoch", epoch, "error", err)
+	}
+
+	ca.finishArchiving(epoch, false)
+}
+
+func (ca *coldArchiver) finishArchiving(epoch uint32, isArchived bool) {
+	ca.mutArchivedEpochs.Lock()
+	delete(ca.archivingEpochs, epoch)
+	if isArchived {
+		ca.archivedEpochs[epoch] = struct{}{}
+	}
+	ca.mutArchivedEpochs.Unlock()
+}
+
+// releaseEpoch asks all the registered units to close their databases of the provided epoch. It is called without
+// holding any lock of the archiver, as the units call the archiver while holding their own locks
+func (ca *coldArchiver) releaseEpoch(epoch uint32) error {
+	ca.mutUnits.RLock()
+	releaseEpochHandlers := make(map[string]func(epoch uint32) error, len(ca.units))
+	for name, unit := range ca.units {
+		releaseEpochHandlers[name] = unit.releaseEpochHandler
+	}
+	ca.mutUnits.RUnlock()
+
+	for name, releaseEpochHandler := range releaseEpochHandlers {
+		err := releaseEpochHandler(epoch)
+		if err != nil {
+			return fmt.Errorf("%w while releasing unit %s", err, name)
+		}
+	}
+
+	return nil
+}
+
+func (ca *coldArchiver) packEpoch(
+	ctx context.Context,
+	epoch uint32,
+	epochDir string,
+	archiveFile string,
+	archiving *archivingEpoch,
+) error {
+	units := ca.getUnitsInDirectory(epochDir)
+	if len(units) == 0 {
+		ca.stopArchiving(epoch, archiving)
+		return nil
+	}
+
+	err := os.MkdirAll(filepath.Dir(archiveFile), os.ModePerm)
+	if err != nil {
+		ca.stopArchiving(epoch, archiving)
+		return err
+	}
+
+	// the units are packed from the same databases the reads are served from while archiving
+	err = writePackedArchive(ctx, archiveFile, units, archiving.source.getOrOpenPersister)
+	if err != nil {
+		ca.stopArchiving(epoch, archiving)
+		return err
+	}
+
+	// waits for the ongoing reads, the next ones being served from the packed archive
+	err = archiving.close()
+	if err != nil {
+		log.Warn("coldArchiver: error closing the archiving epoch", "epoch", epoch, "error", err)
+	}
+	ca.finishArchiving(epoch, true)
+
+	for _, unitName := range units {
+		unitPath := filepath.Join(epochDir, unitName)
+		err = os.RemoveAll(unitPath)
+		if err != nil {
+			return err
+		}
+
+		removeEmptyParents(unitPath, epochDir)
+	}
+
+	entries, err := os.ReadDir(epochDir)
+	if err != nil {
+		return err
+	}
+	if len(entries) > 0 {
+		log.Warn("coldArchiver: unregistered databases were left in the epoch directory",
+			"directory", epochDir, "num entries", len(entries))
+		return nil
+	}
+
+	return os.Remove(epochDir)
+}
+
+func (ca *coldArchiver) moveEpoch(epoch uint32, epochDir string, archiveLocation string, archiving *archivingEpoch) error {
+	// the reads are blocked while the directory is moved, as its databases can not stay opened
+	archiving.mut.Lock()
+	defer archiving.mut.Unlock()
+
+	err := archiving.source.close()
+	if err != nil {
+		log.Warn("coldArchiver: error closing the archiving epoch", "epoch", epoch, "error", err)
+	}
+
+	err = moveDirectory(epochDir, archiveLocation)
+	ca.finishArchiving(epoch, err == nil)
+
+	return err
+}
+
+func (ca *coldArchiver) getUnitsInDirectory(epochDir string) []string {
+	ca.mutUnits.RLock()
+	defer ca.mutUnits.RUnlock()
+
+	units := make([]string, 0, len(ca.units))
+	for name := range ca.units {
+		if !pathExists(filepath.Join(epochDir, name)) {
+			continue
+		}
+
+		units = append(units, name)
+	}
+
+	// the packed archive requires the keys to be added in increasing order
+	sort.Strings(units)
+
+	return units
+}
+
+func (ca *coldArchiver) getPersisterFactory(unitName string) (storage.PersisterFactory, bool) {
+	ca.mutUnits.RLock()
+	defer ca.mutUnits.RUnlock()
+
+	unit, found := ca.units[unitName]
+	if !found {
+		return nil, false
+	}
+
+	return unit.persisterFactory, true
+}
+
+// IsEpochArchived returns true if an archive exists for the provided epoch or if the epoch is being archived
+func (ca *coldArchiver) IsEpochArchived(epoch uint32) bool {
+	ca.mutArchivedEpochs.RLock()
+	_, found := ca.archivedEpochs[epoch]
+	_, isArchiving := ca.archivingEpochs[epoch]
+	ca.mutArchivedEpochs.RUnlock()
+	if found || isArchiving {
+		return true
+	}
+
+	if !pathExists(ca.archiveLocation(epoch)) {
+		return false
+	}
+
+	ca.setEpochArchived(epoch)
+
+	return true
+}
+
+func (ca *coldArchiver) setEpochArchived(epoch uint32) {
+	ca.mutArchivedEpochs.Lock()
+	ca.archivedEpochs[epoch] = struct{}{}
+	ca.mutArchivedEpochs.Unlock()
+}
+
+// Get returns the value of the key from the provided unit of an archived epoch, opening the epoch's archive if needed
+func (ca *coldArchiver) Get(epoch uint32, unitName string, key []byte) ([]byte, error) {
+	for i := 0; i < maxGetAttempts; i++ {
+		archive, err := ca.getOrOpenArchive(epoch)
+		if err != nil {
+			return nil, err
+		}
+
+		value, err := archive.get(unitName, key)
+		if errors.Is(err, storage.ErrDBIsClosed) {
+			// the archive was evicted from the opened archives cache in the meantime
+			continue
+		}
+
+		return value, err
+	}
+
+	return nil, storage.ErrDBIsClosed
+}
+
+func (ca *coldArchiver) getOrOpenArchive(epoch uint32) (epochArchive, error) {
+	ca.mutOpenedArchives.Lock()
+	defer ca.mutOpenedArchives.Unlock()
+
+	cacheKey := []byte(fmt.Sprintf("%d", epoch))
+	value, found := ca.openedArchives.Get(cacheKey)
+	if found {
+		archive, ok := value.(epochArchive)
+		if ok {
+			return archive, nil
+		}
+	}
+
+	// the archiving epoch is not kept in the opened archives cache as it is closed only when the archiving ends
+	archiving, isArchiving := ca.getArchivingEpoch(epoch)
+	if isArchiving {
+		return archiving, nil
+	}
+
+	if !ca.IsEpochArchived(epoch) {
+		return nil, fmt.Errorf("%w: %d", storage.ErrEpochNotArchived, epoch)
+	}
+
+	archive, err := ca.openArchive(epoch)
+	if err != nil {
+		return nil, err
+	}
+
+	log.Debug("coldArchiver: opened archive", "epoch", epoch, "mode", ca.mode)
+	ca.openedArchives.Put(cacheKey, archive, 0)
+
+	return archive, nil
+}
+
+func (ca *coldArchiver) getArchivingEpoch(epoch uint32) (*archivingEpoch, bool) {
+	ca.mutArchivedEpochs.RLock()
+	defer ca.mutArchivedEpochs.RUnlock()
+
+	archiving, found := ca.archivingEpochs[epoch]
+	return archiving, found
+}
+
+func (ca *coldArchiver) openArchive(epoch uint32) (epochArchive, error) {
+	archiveLocation := ca.archiveLocation(epoch)
+	if ca.mode == PackMode {
+		return openPackedArchive(archiveLocation)
+	}
+
+	return newMovedArchive(archiveLocation, ca.getPersisterFactory), nil
+}
+
+func (ca *coldArchiver) onEvicted(key interface{}, value interface{}) {
+	archive, ok := value.(epochArchive)
+	if !ok {
+		return
+	}
+
+	err := archive.close()
+	if err != nil {
+		log.Warn("coldArchiver: error closing archive", "epoch", key, "error", err)
+	}
+}
+
+func (ca *coldArchiver) epochDirectory(epoch uint32) string {
+	return filepath.Clean(ca.pathManager.PathForEpoch(ca.shardIDStr, epoch, ""))
+}
+
+func (ca *coldArchiver) archiveLocation(epoch uint32) string {
+	epochString := fmt.Sprintf("%s_%d", storage.DefaultEpochString, epoch)
+	shardString := fmt.Sprintf("%s_%s", storage.DefaultShardString, ca.shardIDStr)
+	if ca.mode == PackMode {
+		return filepath.Join(ca.archivePath, epochString+"_"+shardString+packedArchiveExtension)
+	}
+
+	return filepath.Join(ca.archivePath, epochString, shardString)
+}
+
+// Close stops the archiving, waiting for the epoch being archived to be abandoned, and closes all the opened archives.
+// They will be opened again on the next request
+func (ca *coldArchiver) Close() error {
+	ca.cancelFunc()
+	ca.wgArchiving.Wait()
+
+	ca.mutOpenedArchives.Lock()
+	ca.openedArchives.Clear()
+	ca.mutOpenedArchives.Unlock()
+
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (ca *coldArchiver) IsInterfaceNil() bool {
+	return ca == nil
+}
+
+func pathExists(path string) bool {
+	_, err := os.Stat(path)
+	return err == nil
+}
+
+// removeEmptyParents removes the empty directories between the provided path and the stop directory
+func removeEmptyParents(path string, stopDir string) {
+	for dir := filepath.Dir(path); dir != stopDir && len(dir) > len(stopDir); dir = filepath.Dir(dir) {
+		err := os.Remove(dir)
+		if err != nil {
+			return
+		}
+	}
+}
@@ -0,0 +1,488 @@
+package coldarchive_test
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/core/atomic"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-go/config"
+	"github.com/multiversx/mx-chain-go/storage"
+	"github.com/multiversx/mx-chain-go/storage/coldarchive"
+	"github.com/multiversx/mx-chain-go/storage/factory"
+	"github.com/multiversx/mx-chain-go/storage/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var testUnits = []string{"Transactions", "DbLookupExtensions/MiniblocksMetadata", "DbLookupExtensions_EpochByHash"}
+
+func createMockArgs(t *testing.T, mode string) coldarchive.ArgsColdArchiver {
+	pathManager, err := factory.CreatePathManagerFromSinglePathString(t.TempDir())
+	require.Nil(t, err)
+
+	return coldarchive.ArgsColdArchiver{
+		Config: config.ColdArchiveConfig{
+			Enabled:                   true,
+			Mode:                      mode,
+			ArchivePath:               t.TempDir(),
+			NumEpochsToKeepUnarchived: 2,
+			NumOpenedArchives:         1,
+		},
+		NumActivePersisters: 2,
+		PathManager:         pathManager,
+		ShardCoordinator:    mock.NewShardCoordinatorMock(0, 2),
+		EnableArchiving:     true,
+	}
+}
+
+func createDBConfig() config.DBConfig {
+	return config.DBConfig{
+		Type:              "LvlDBSerial",
+		BatchDelaySeconds: 2,
+		MaxBatchSize:      100,
+		MaxOpenFiles:      10,
+	}
+}
+
+// createShardedDBConfig creates the config of sharded persisters, which iterate their shards one after the other, so
+// the keys are not globally sorted
+func createShardedDBConfig() config.DBConfig {
+	dbConfig := createDBConfig()
+	dbConfig.ShardIDProviderType = "BinarySplit"
+	dbConfig.NumShards = 4
+
+	return dbConfig
+}
+
+// createPersisterFactories creates the factory used by the node to write the epochs and the read only factory
+// registered in the cold archiver
+func createPersisterFactories(t *testing.T, dbConfig config.DBConfig) (storage.PersisterFactory, storage.PersisterFactory) {
+	persisterFactory, err := factory.NewPersisterFactory(dbConfig)
+	require.Nil(t, err)
+
+	readOnlyPersisterFactory, err := factory.NewReadOnlyPersisterFactory(dbConfig)
+	require.Nil(t, err)
+
+	return persisterFactory, readOnlyPersisterFactory
+}
+
+func testKey(unit string, epoch uint32, index int) []byte {
+	return []byte(fmt.Sprintf("%s_key_%d_%d", unit, epoch, index))
+}
+
+func testValue(unit string, epoch uint32, index int) []byte {
+	return []byte(fmt.Sprintf("%s_value_%d_%d", unit, epoch, index))
+}
+
+// createEpochs writes the test units, each holding a few keys, in all the provided epochs
+func createEpochs(t *testing.T, args coldarchive.ArgsColdArchiver, persisterFactory storage.PersisterFactory, numEpochs uint32) {
+	for epoch := uint32(0); epoch < numEpochs; epoch++ {
+		for _, unit := range testUnits {
+			persister, err := persisterFactory.Create(args.PathManager.PathForEpoch("0", epoch, unit))
+			require.Nil(t, err)
+
+			for i := 0; i < 10; i++ {
+				require.Nil(t, persister.Put(testKey(unit, epoch, i), testValue(unit, epoch, i)))
+			}
+			require.Nil(t, persister.Close())
+		}
+	}
+}
+
+func createColdArchiverWithUnits(t *testing.T, args coldarchive.ArgsColdArchiver, persisterFactory storage.PersisterFactory) storage.ColdArchiver {
+	archiver, err := coldarchive.NewColdArchiver(args)
+	require.Nil(t, err)
+
+	for _, unit := range testUnits {
+		archiver.RegisterUnit(unit, persisterFactory, releaseEpoch)
+	}
+
+	return archiver
+}
+
+func releaseEpoch(_ uint32) error {
+	return nil
+}
+
+// archiveOldEpochs requests the archiving and waits for the provided units of the expected epochs to be archived
+func archiveOldEpochs(
+	t *testing.T,
+	archiver storage.ColdArchiver,
+	args coldarchive.ArgsColdArchiver,
+	currentEpoch uint32,
+	units []string,
+	archivedEpochs ...uint32,
+) {
+	archiver.ArchiveOldEpochs(currentEpoch)
+
+	require.Eventually(t, func() bool {
+		for _, epoch := range archivedEpochs {
+			if !archiver.IsEpochArchived(epoch) {
+				return false
+			}
+
+			for _, unit := range units {
+				_, err := os.Stat(args.PathManager.PathForEpoch("0", epoch, unit))
+				if !os.IsNotExist(err) {
+					return false
+				}
+			}
+		}
+
+		return true
+	}, 10*time.Second, 10*time.Millisecond)
+}
+
+func TestNewColdArchiver(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil path manager should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgs(t, coldarchive.PackMode)
+		args.PathManager = nil
+		archiver, err := coldarchive.NewColdArchiver(args)
+		assert.Equal(t, storage.ErrNilPathManager, err)
+		assert.True(t, check.IfNil(archiver))
+	})
+	t.Run("nil shard coordinator should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgs(t, coldarchive.PackMode)
+		args.ShardCoordinator = nil
+		archiver, err := coldarchive.NewColdArchiver(args)
+		assert.Equal(t, storage.ErrNilShardCoordinator, err)
+		assert.True(t, check.IfNil(archiver))
+	})
+	t.Run("invalid mode should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgs(t, "copy")
+		archiver, err := coldarchive.NewColdArchiver(args)
+		assert.True(t, errors.Is(err, storage.ErrInvalidColdArchiveMode))
+		assert.True(t, check.IfNil(archiver))
+	})
+	t.Run("invalid number of opened archives should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgs(t, coldarchive.PackMode)
+		args.Config.NumOpenedArchives = 0
+		archiver, err := coldarchive.NewColdArchiver(args)
+		assert.Equal(t, storage.ErrInvalidNumOpenedArchives, err)
+		assert.True(t, check.IfNil(archiver))
+	})
+	t.Run("fewer epochs to keep unarchived than active persisters should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgs(t, coldarchive.PackMode)
+		args.NumActivePersisters = 3
+		archiver, err := coldarchive.NewColdArchiver(args)
+		assert.True(t, errors.Is(err, storage.ErrInvalidNumEpochsToKeepUnarchived))
+		assert.True(t, check.IfNil(archiver))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgs(t, coldarchive.MoveMode)
+		archiver, err := coldarchive.NewColdArchiver(args)
+		assert.Nil(t, err)
+		assert.False(t, check.IfNil(archiver))
+	})
+}
+
+func TestColdArchiver_ArchiveOldEpochs(t *testing.T) {
+	t.Parallel()
+
+	t.Run("pack mode", func(t *testing.T) {
+		t.Parallel()
+
+		testArchiveOldEpochs(t, coldarchive.PackMode, createDBConfig())
+	})
+	t.Run("pack mode with sharded persisters", func(t *testing.T) {
+		t.Parallel()
+
+		testArchiveOldEpochs(t, coldarchive.PackMode, createShardedDBConfig())
+	})
+	t.Run("move mode", func(t *testing.T) {
+		t.Parallel()
+
+		testArchiveOldEpochs(t, coldarchive.MoveMode, createDBConfig())
+	})
+}
+
+func testArchiveOldEpochs(t *testing.T, mode string, dbConfig config.DBConfig) {
+	args := createMockArgs(t, mode)
+	persisterFactory, readOnlyPersisterFactory := createPersisterFactories(t, dbConfig)
+	createEpochs(t, args, persisterFactory, 5)
+	archiver := createColdArchiverWithUnits(t, args, readOnlyPersisterFactory)
+	defer func() {
+		_ = archiver.Close()
+	}()
+
+	// nothing to archive yet
+	archiver.ArchiveOldEpochs(2)
+	time.Sleep(100 * time.Millisecond)
+	assert.False(t, archiver.IsEpochArchived(0))
+
+	archiveOldEpochs(t, archiver, args, 4, testUnits, 0, 1)
+
+	for epoch := uint32(0); epoch < 5; epoch++ {
+		epochDir := filepath.Join(args.PathManager.DatabasePath(), fmt.Sprintf("Epoch_%d", epoch))
+		_, errStat := os.Stat(epochDir)
+		isArchived := epoch < 2
+		assert.Equal(t, isArchived, archiver.IsEpochArchived(epoch), "epoch %d", epoch)
+		assert.Equal(t, isArchived, os.IsNotExist(errStat), "epoch %d", epoch)
+	}
+
+	// reading alternatively from the 2 archived epochs forces the eviction of the opened archives
+	for i := 0; i < 10; i++ {
+		for epoch := uint32(0); epoch < 2; epoch++ {
+			for _, unit := range testUnits {
+				value, errGet := archiver.Get(epoch, unit, testKey(unit, epoch, i))
+				require.Nil(t, errGet)
+				assert.Equal(t, testValue(unit, epoch, i), value)
+			}
+		}
+	}
+
+	value, err := archiver.Get(0, testUnits[0], testKey(testUnits[0], 1, 0))
+	assert.Nil(t, value)
+	assert.Equal(t, storage.ErrKeyNotFound, err)
+
+	value, err = archiver.Get(0, "unknown unit", testKey(testUnits[0], 0, 0))
+	assert.Nil(t, value)
+	assert.Equal(t, storage.ErrKeyNotFound, err)
+
+	value, err = archiver.Get(2, testUnits[0], testKey(testUnits[0], 2, 0))
+	assert.Nil(t, value)
+	assert.True(t, errors.Is(err, storage.ErrEpochNotArchived))
+
+	// archiving again should not alter the existing archives
+	archiveOldEpochs(t, archiver, args, 4, testUnits, 0, 1)
+	value, err = archiver.Get(0, testUnits[2], testKey(testUnits[2], 0, 5))
+	assert.Nil(t, err)
+	assert.Equal(t, testValue(testUnits[2], 0, 5), value)
+
+	// a new instance should find the archives on disk
+	_ = archiver.Close()
+	archiver = createColdArchiverWithUnits(t, args, readOnlyPersisterFactory)
+	value, err = archiver.Get(1, testUnits[1], testKey(testUnits[1], 1, 9))
+	assert.Nil(t, err)
+	assert.Equal(t, testValue(testUnits[1], 1, 9), value)
+}
+
+func TestColdArchiver_UnregisteredUnitsShouldNotBeRemoved(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgs(t, coldarchive.PackMode)
+	persisterFactory, readOnlyPersisterFactory := createPersisterFactories(t, createDBConfig())
+	createEpochs(t, args, persisterFactory, 4)
+
+	archiver, err := coldarchive.NewColdArchiver(args)
+	require.Nil(t, err)
+	archiver.RegisterUnit(testUnits[0], readOnlyPersisterFactory, releaseEpoch)
+
+	archiveOldEpochs(t, archiver, args, 3, testUnits[:1], 0)
+
+	_, err = os.Stat(args.PathManager.PathForEpoch("0", 0, testUnits[0]))
+	assert.True(t, os.IsNotExist(err))
+	for _, unit := range testUnits[1:] {
+		_, err = os.Stat(args.PathManager.PathForEpoch("0", 0, unit))
+		assert.Nil(t, err)
+	}
+
+	value, err := archiver.Get(0, testUnits[0], testKey(testUnits[0], 0, 3))
+	assert.Nil(t, err)
+	assert.Equal(t, testValue(testUnits[0], 0, 3), value)
+	assert.Nil(t, archiver.Close())
+}
+
+func TestColdArchiver_MovedArchiveShouldNotBeAltered(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgs(t, coldarchive.MoveMode)
+	persisterFactory, readOnlyPersisterFactory := createPersisterFactories(t, createDBConfig())
+	createEpochs(t, args, persisterFactory, 3)
+
+	archiver := createColdArchiverWithUnits(t, args, readOnlyPersisterFactory)
+	archiveOldEpochs(t, archiver, args, 3, testUnits, 0)
+
+	movedEpochDir := filepath.Join(args.Config.ArchivePath, "Epoch_0", "Shard_0")
+	filesBefore := listFiles(t, movedEpochDir)
+
+	for _, unit := range testUnits {
+		value, errGet := archiver.Get(0, unit, testKey(unit, 0, 1))
+		require.Nil(t, errGet)
+		assert.Equal(t, testValue(unit, 0, 1), value)
+	}
+	require.Nil(t, archiver.Close())
+
+	assert.Equal(t, filesBefore, listFiles(t, movedEpochDir))
+}
+
+func TestColdArchiver_ArchivingEpochShouldBeRead(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgs(t, coldarchive.PackMode)
+	persisterFactory, readOnlyPersisterFactory := createPersisterFactories(t, createDBConfig())
+	createEpochs(t, args, persisterFactory, 3)
+
+	chanReleased := make(chan struct{}, 1)
+	chanResume := make(chan struct{})
+	archiver := createColdArchiverWithUnits(t, args, readOnlyPersisterFactory)
+	archiver.RegisterUnit(testUnits[0], readOnlyPersisterFactory, func(epoch uint32) error {
+		assert.Equal(t, uint32(0), epoch)
+		chanReleased <- struct{}{}
+		<-chanResume
+
+		return nil
+	})
+	defer func() {
+		_ = archiver.Close()
+	}()
+
+	// the request should not wait for the archiving
+	archiver.ArchiveOldEpochs(3)
+	select {
+	case <-chanReleased:
+	case <-time.After(10 * time.Second):
+		require.Fail(t, "the epoch was not released")
+	}
+
+	// while archiving, the epoch is read from its directory
+	assert.True(t, archiver.IsEpochArchived(0))
+	value, err := archiver.Get(0, testUnits[1], testKey(testUnits[1], 0, 2))
+	assert.Nil(t, err)
+	assert.Equal(t, testValue(testUnits[1], 0, 2), value)
+
+	close(chanResume)
+	archiveOldEpochs(t, archiver, args, 3, testUnits, 0)
+	value, err = archiver.Get(0, testUnits[1], testKey(testUnits[1], 0, 2))
+	assert.Nil(t, err)
+	assert.Equal(t, testValue(testUnits[1], 0, 2), value)
+}
+
+func TestColdArchiver_ReleaseErrorShouldNotArchive(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgs(t, coldarchive.PackMode)
+	persisterFactory, readOnlyPersisterFactory := createPersisterFactories(t, createDBConfig())
+	createEpochs(t, args, persisterFactory, 3)
+
+	expectedErr := errors.New("expected error")
+	wasReleaseCalled := atomic.Flag{}
+	archiver := createColdArchiverWithUnits(t, args, readOnlyPersisterFactory)
+	archiver.RegisterUnit(testUnits[0], readOnlyPersisterFactory, func(epoch uint32) error {
+		wasReleaseCalled.SetValue(true)
+		return expectedErr
+	})
+
+	archiver.ArchiveOldEpochs(3)
+	require.Eventually(t, wasReleaseCalled.IsSet, 10*time.Second, 10*time.Millisecond)
+	require.Nil(t, archiver.Close())
+
+	assert.False(t, archiver.IsEpochArchived(0))
+	for _, unit := range testUnits {
+		_, err := os.Stat(args.PathManager.PathForEpoch("0", 0, unit))
+		assert.Nil(t, err)
+	}
+
+	value, err := archiver.Get(0, testUnits[0], testKey(testUnits[0], 0, 0))
+	assert.Nil(t, value)
+	assert.True(t, errors.Is(err, storage.ErrEpochNotArchived))
+}
+
+func TestColdArchiver_CloseShouldStopTheArchiving(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgs(t, coldarchive.PackMode)
+	persisterFactory, readOnlyPersisterFactory := createPersisterFactories(t, createDBConfig())
+	createEpochs(t, args, persisterFactory, 3)
+
+	chanReleased := make(chan struct{}, 1)
+	chanResume := make(chan struct{})
+	archiver := createColdArchiverWithUnits(t, args, readOnlyPersisterFactory)
+	archiver.RegisterUnit(testUnits[0], readOnlyPersisterFactory, func(epoch uint32) error {
+		chanReleased <- struct{}{}
+		<-chanResume
+
+		return nil
+	})
+
+	archiver.ArchiveOldEpochs(3)
+	select {
+	case <-chanReleased:
+	case <-time.After(10 * time.Second):
+		require.Fail(t, "the epoch was not released")
+	}
+
+	chanClosed := make(chan error)
+	go func() {
+		chanClosed <- archiver.Close()
+	}()
+	// lets the close cancel the archiving before resuming it
+	time.Sleep(100 * time.Millisecond)
+	close(chanResume)
+	select {
+	case err := <-chanClosed:
+		assert.Nil(t, err)
+	case <-time.After(10 * time.Second):
+		require.Fail(t, "the archiver was not closed")
+	}
+
+	assert.False(t, archiver.IsEpochArchived(0))
+	for _, unit := range testUnits {
+		_, err := os.Stat(args.PathManager.PathForEpoch("0", 0, unit))
+		assert.Nil(t, err)
+	}
+	entries, err := os.ReadDir(args.Config.ArchivePath)
+	require.Nil(t, err)
+	assert.Empty(t, entries)
+}
+
+func TestColdArchiver_DisabledArchivingShouldNotArchive(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgs(t, coldarchive.MoveMode)
+	args.EnableArchiving = false
+	persisterFactory, readOnlyPersisterFactory := createPersisterFactories(t, createDBConfig())
+	createEpochs(t, args, persisterFactory, 3)
+
+	archiver := createColdArchiverWithUnits(t, args, readOnlyPersisterFactory)
+	archiver.ArchiveOldEpochs(3)
+	time.Sleep(100 * time.Millisecond)
+	require.Nil(t, archiver.Close())
+
+	assert.False(t, archiver.IsEpochArchived(0))
+	_, err := os.Stat(args.PathManager.PathForEpoch("0", 0, testUnits[0]))
+	assert.Nil(t, err)
+}
+
+// listFiles returns the relative path, size and modification time of all the files of a directory
+func listFiles(t *testing.T, directory string) []string {
+	files := make([]string, 0)
+	err := filepath.Walk(directory, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if info.IsDir() {
+			return nil
+		}
+
+		relativePath, err := filepath.Rel(directory, path)
+		if err != nil {
+			return err
+		}
+		files = append(files, fmt.Sprintf("%s %d %d", relativePath, info.Size(), info.ModTime().UnixNano()))
+
+		return nil
+	})
+	require.Nil(t, err)
+
+	return files
+}
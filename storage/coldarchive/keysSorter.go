@@ -0,0 +1,315 @@
+package coldarchive
+
+import (
+	"bufio"
+	"bytes"
+	"container/heap"
+	"encoding/binary"
+	"errors"
+	"io"
+	"os"
+	"sort"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+)
+
+const (
+	// maxKeysRunSizeInBytes is the maximum size of the keys kept in memory before they are sorted and spilled on disk
+	maxKeysRunSizeInBytes = 64 * core.MegabyteSize
+	// maxNumMergedRuns is the maximum number of runs read at the same time, which bounds the number of opened files
+	maxNumMergedRuns = 64
+	// keyMemoryOverhead approximates the memory used by a kept key besides its bytes
+	keyMemoryOverhead  = 24
+	keysRunFilePattern = "keys-run-*" + tmpFileSuffix
+)
+
+// keysSorter sorts the keys of a unit using a bounded amount of memory. The keys are gathered in runs, each run being
+// sorted and spilled in a temporary file once it reaches the maximum size. The sorted runs are merged when the keys
+// are read, at most maxNumMergedRuns at a time
+type keysSorter struct {
+	tmpDir          string
+	maxRunSize      int
+	maxMergedRuns   int
+	run             [][]byte
+	runSize         int
+	runFiles        []string
+	createdRunFiles []string
+}
+
+func newKeysSorter(tmpDir string, maxRunSize int, maxMergedRuns int) *keysSorter {
+	return &keysSorter{
+		tmpDir:        tmpDir,
+		maxRunSize:    maxRunSize,
+		maxMergedRuns: maxMergedRuns,
+		run:           make([][]byte, 0),
+	}
+}
+
+// add keeps a copy of the provided key, spilling the current run on disk if it reached the maximum size
+func (ks *keysSorter) add(key []byte) error {
+	keyCopy := make([]byte, len(key))
+	copy(keyCopy, key)
+
+	ks.run = append(ks.run, keyCopy)
+	ks.runSize += len(keyCopy) + keyMemoryOverhead
+	if ks.runSize < ks.maxRunSize {
+		return nil
+	}
+
+	return ks.spillRun()
+}
+
+func (ks *keysSorter) spillRun() error {
+	sortKeys(ks.run)
+
+	runFile, err := ks.writeRunFile(func(writeKey func(key []byte) error) error {
+		for _, key := range ks.run {
+			err := writeKey(key)
+			if err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	ks.runFiles = append(ks.runFiles, runFile)
+	ks.run = make([][]byte, 0)
+	ks.runSize = 0
+
+	return nil
+}
+
+// writeRunFile creates a new run file and writes in it the keys provided by the keys source
+func (ks *keysSorter) writeRunFile(keysSource func(writeKey func(key []byte) error) error) (string, error) {
+	file, err := os.CreateTemp(ks.tmpDir, keysRunFilePattern)
+	if err != nil {
+		return "", err
+	}
+	ks.createdRunFiles = append(ks.createdRunFiles, file.Name())
+
+	writer := bufio.NewWriter(file)
+	lengthBuffer := make([]byte, binary.MaxVarintLen64)
+	err = keysSource(func(key []byte) error {
+		numBytes := binary.PutUvarint(lengthBuffer, uint64(len(key)))
+		_, errWrite := writer.Write(lengthBuffer[:numBytes])
+		if errWrite != nil {
+			return errWrite
+		}
+
+		_, errWrite = writer.Write(key)
+		return errWrite
+	})
+	if err == nil {
+		err = writer.Flush()
+	}
+	errClose := file.Close()
+	if err != nil {
+		return "", err
+	}
+	if errClose != nil {
+		return "", errClose
+	}
+
+	return file.Name(), nil
+}
+
+// forEachSorted calls the handler for all the added keys, in increasing order. A key added more than once is provided
+// only once
+func (ks *keysSorter) forEachSorted(handler func(key []byte) error) error {
+	if len(ks.runFiles) == 0 {
+		sortKeys(ks.run)
+		return forEachUniqueKey(ks.run, handler)
+	}
+
+	if len(ks.run) > 0 {
+		err := ks.spillRun()
+		if err != nil {
+			return err
+		}
+	}
+
+	// the runs are merged in intermediate runs until all of them can be read at the same time
+	for len(ks.runFiles) > ks.maxMergedRuns {
+		mergedRunFile, err := ks.writeRunFile(func(writeKey func(key []byte) error) error {
+			return mergeRuns(ks.runFiles[:ks.maxMergedRuns], writeKey)
+		})
+		if err != nil {
+			return err
+		}
+
+		ks.removeRunFiles(ks.runFiles[:ks.maxMergedRuns])
+		ks.runFiles = append(ks.runFiles[ks.maxMergedRuns:], mergedRunFile)
+	}
+
+	return mergeRuns(ks.runFiles, handler)
+}
+
+// close removes all the run files
+func (ks *keysSorter) close() {
+	ks.removeRunFiles(ks.createdRunFiles)
+
+	ks.run = nil
+	ks.runFiles = nil
+	ks.createdRunFiles = nil
+}
+
+func (ks *keysSorter) removeRunFiles(runFiles []string) {
+	for _, runFile := range runFiles {
+		err := os.Remove(runFile)
+		if err != nil && !os.IsNotExist(err) {
+			log.Warn("coldArchiver: error removing keys run file", "file", runFile, "error", err)
+		}
+	}
+}
+
+func sortKeys(keys [][]byte) {
+	sort.Slice(keys, func(i, j int) bool {
+		return bytes.Compare(keys[i], keys[j]) < 0
+	})
+}
+
+func forEachUniqueKey(sortedKeys [][]byte, handler func(key []byte) error) error {
+	for i, key := range sortedKeys {
+		if i > 0 && bytes.Equal(sortedKeys[i-1], key) {
+			continue
+		}
+
+		err := handler(key)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// mergeRuns calls the handler for all the keys of the provided sorted runs, in increasing order and without duplicates
+func mergeRuns(runFiles []string, handler func(key []byte) error) error {
+	readers := make(runReaders, 0, len(runFiles))
+	defer func() {
+		for _, reader := range readers {
+			_ = reader.file.Close()
+		}
+	}()
+
+	for _, runFile := range runFiles {
+		reader, err := openRunReader(runFile)
+		if err != nil {
+			return err
+		}
+
+		isExhausted, err := reader.next()
+		if err != nil {
+			_ = reader.file.Close()
+			return err
+		}
+		if isExhausted {
+			_ = reader.file.Close()
+			continue
+		}
+
+		readers = append(readers, reader)
+	}
+	heap.Init(&readers)
+
+	var lastKey []byte
+	for readers.Len() > 0 {
+		reader := readers[0]
+		if lastKey == nil || !bytes.Equal(lastKey, reader.key) {
+			err := handler(reader.key)
+			if err != nil {
+				return err
+			}
+			lastKey = reader.key
+		}
+
+		isExhausted, err := reader.next()
+		if err != nil {
+			return err
+		}
+		if isExhausted {
+			heap.Pop(&readers)
+			_ = reader.file.Close()
+			continue
+		}
+
+		heap.Fix(&readers, 0)
+	}
+
+	return nil
+}
+
+type runReader struct {
+	file   *os.File
+	reader *bufio.Reader
+	key    []byte
+}
+
+func openRunReader(runFile string) (*runReader, error) {
+	file, err := os.Open(runFile)
+	if err != nil {
+		return nil, err
+	}
+
+	return &runReader{
+		file:   file,
+		reader: bufio.NewReader(file),
+	}, nil
+}
+
+// next reads the next key of the run. It returns true if the run has no more keys
+func (rr *runReader) next() (bool, error) {
+	keyLen, err := binary.ReadUvarint(rr.reader)
+	if errors.Is(err, io.EOF) {
+		return true, nil
+	}
+	if err != nil {
+		return false, err
+	}
+
+	// a new buffer is used for each key as the previous one might still be referenced by the caller
+	rr.key = make([]byte, keyLen)
+	_, err = io.ReadFull(rr.reader, rr.key)
+	if err != nil {
+		return false, err
+	}
+
+	return false, nil
+}
+
+// runReaders is a min heap of run readers, ordered by their current key
+type runReaders []*runReader
+
+// Len returns the number of readers
+func (readers runReaders) Len() int {
+	return len(readers)
+}
+
+// Less returns true if the current key of the reader i is lower than the one of the reader j
+func (readers runReaders) Less(i, j int) bool {
+	return bytes.Compare(readers[i].key, readers[j].key) < 0
+}
+
+// Swap swaps the readers i and j
+func (readers runReaders) Swap(i, j int) {
+	readers[i], readers[j] = readers[j], readers[i]
+}
+
+// Push adds a reader
+func (readers *runReaders) Push(x interface{}) {
+	*readers = append(*readers, x.(*runReader))
+}
+
+// Pop removes the last reader
+func (readers *runReaders) Pop() interface{} {
+	old := *readers
+	lastIndex := len(old) - 1
+	reader := old[lastIndex]
+	*readers = old[:lastIndex]
+
+	return reader
+}
@@ -0,0 +1,133 @@
+package coldarchive
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"math/rand"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func generateKeys(numKeys int) [][]byte {
+	keys := make([][]byte, 0, numKeys)
+	for i := 0; i < numKeys; i++ {
+		keys = append(keys, []byte(fmt.Sprintf("key_%d", rand.Intn(numKeys))))
+	}
+
+	return keys
+}
+
+func getSortedUniqueKeys(keys [][]byte) [][]byte {
+	uniqueKeys := make(map[string]struct{})
+	for _, key := range keys {
+		uniqueKeys[string(key)] = struct{}{}
+	}
+
+	sortedKeys := make([][]byte, 0, len(uniqueKeys))
+	for key := range uniqueKeys {
+		sortedKeys = append(sortedKeys, []byte(key))
+	}
+	sortKeys(sortedKeys)
+
+	return sortedKeys
+}
+
+func testKeysSorter(t *testing.T, maxRunSize int, maxMergedRuns int, numKeys int) {
+	tmpDir := t.TempDir()
+	sorter := newKeysSorter(tmpDir, maxRunSize, maxMergedRuns)
+
+	keys := generateKeys(numKeys)
+	for _, key := range keys {
+		require.Nil(t, sorter.add(key))
+	}
+
+	providedKeys := make([][]byte, 0, numKeys)
+	err := sorter.forEachSorted(func(key []byte) error {
+		providedKeys = append(providedKeys, key)
+		return nil
+	})
+	require.Nil(t, err)
+	assert.Equal(t, getSortedUniqueKeys(keys), providedKeys)
+
+	sorter.close()
+	entries, err := os.ReadDir(tmpDir)
+	require.Nil(t, err)
+	assert.Empty(t, entries)
+}
+
+func TestKeysSorter_ForEachSorted(t *testing.T) {
+	t.Parallel()
+
+	t.Run("no keys", func(t *testing.T) {
+		t.Parallel()
+
+		testKeysSorter(t, maxKeysRunSizeInBytes, maxNumMergedRuns, 0)
+	})
+	t.Run("keys sorted in memory", func(t *testing.T) {
+		t.Parallel()
+
+		testKeysSorter(t, maxKeysRunSizeInBytes, maxNumMergedRuns, 1000)
+	})
+	t.Run("keys spilled in runs", func(t *testing.T) {
+		t.Parallel()
+
+		testKeysSorter(t, 1000, maxNumMergedRuns, 1000)
+	})
+	t.Run("keys spilled in runs merged in more passes", func(t *testing.T) {
+		t.Parallel()
+
+		testKeysSorter(t, 100, 3, 1000)
+	})
+	t.Run("should not keep more than a run in memory", func(t *testing.T) {
+		t.Parallel()
+
+		maxRunSize := 200
+		sorter := newKeysSorter(t.TempDir(), maxRunSize, maxNumMergedRuns)
+		defer sorter.close()
+
+		for _, key := range generateKeys(1000) {
+			require.Nil(t, sorter.add(key))
+			assert.Less(t, sorter.runSize, maxRunSize)
+		}
+		assert.NotEmpty(t, sorter.runFiles)
+	})
+	t.Run("handler error should stop the iteration", func(t *testing.T) {
+		t.Parallel()
+
+		sorter := newKeysSorter(t.TempDir(), 100, maxNumMergedRuns)
+		defer sorter.close()
+
+		for _, key := range generateKeys(100) {
+			require.Nil(t, sorter.add(key))
+		}
+
+		expectedErr := errors.New("expected error")
+		numCalls := 0
+		err := sorter.forEachSorted(func(key []byte) error {
+			numCalls++
+			return expectedErr
+		})
+		assert.Equal(t, expectedErr, err)
+		assert.Equal(t, 1, numCalls)
+	})
+	t.Run("added keys should be copied", func(t *testing.T) {
+		t.Parallel()
+
+		sorter := newKeysSorter(t.TempDir(), maxKeysRunSizeInBytes, maxNumMergedRuns)
+		defer sorter.close()
+
+		key := []byte("key")
+		require.Nil(t, sorter.add(key))
+		key[0] = 'K'
+
+		err := sorter.forEachSorted(func(providedKey []byte) error {
+			assert.True(t, bytes.Equal([]byte("key"), providedKey))
+			return nil
+		})
+		assert.Nil(t, err)
+	})
+}
@@ -0,0 +1,175 @@
+package coldarchive
+
+import (
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sync"
+
+	"github.com/multiversx/mx-chain-go/storage"
+)
+
+// movedArchive reads the data of an epoch directory moved in the archive path. The unit databases are opened on demand,
+// with the read only persister factories registered for the units
+type movedArchive struct {
+	mut                    sync.Mutex
+	path                   string
+	persisterFactoryGetter func(unitName string) (storage.PersisterFactory, bool)
+	persisters             map[string]storage.Persister
+	isClosed               bool
+}
+
+func newMovedArchive(path string, persisterFactoryGetter func(unitName string) (storage.PersisterFactory, bool)) *movedArchive {
+	return &movedArchive{
+		path:                   path,
+		persisterFactoryGetter: persisterFactoryGetter,
+		persisters:             make(map[string]storage.Persister),
+	}
+}
+
+func (ma *movedArchive) get(unitName string, key []byte) ([]byte, error) {
+	persister, err := ma.getOrOpenPersister(unitName)
+	if err != nil {
+		return nil, err
+	}
+
+	return persister.Get(key)
+}
+
+func (ma *movedArchive) getOrOpenPersister(unitName string) (storage.Persister, error) {
+	ma.mut.Lock()
+	defer ma.mut.Unlock()
+
+	if ma.isClosed {
+		return nil, storage.ErrDBIsClosed
+	}
+
+	persister, found := ma.persisters[unitName]
+	if found {
+		return persister, nil
+	}
+
+	unitPath := filepath.Join(ma.path, unitName)
+	if !pathExists(unitPath) {
+		// opening a missing database would create an empty one in the archive path
+		return nil, storage.ErrKeyNotFound
+	}
+
+	persisterFactory, found := ma.persisterFactoryGetter(unitName)
+	if !found {
+		return nil, fmt.Errorf("%w for unit %s", storage.ErrNilPersisterFactory, unitName)
+	}
+
+	persister, err := persisterFactory.Create(unitPath)
+	if err != nil {
+		return nil, err
+	}
+
+	ma.persisters[unitName] = persister
+
+	return persister, nil
+}
+
+func (ma *movedArchive) close() error {
+	ma.mut.Lock()
+	defer ma.mut.Unlock()
+
+	ma.isClosed = true
+
+	var lastErr error
+	for unitName, persister := range ma.persisters {
+		err := persister.Close()
+		if err != nil {
+			log.Warn("coldArchiver: error closing moved archive persister", "unit", unitName, "error", err)
+			lastErr = err
+		}
+	}
+	ma.persisters = make(map[string]storage.Persister)
+
+	return lastErr
+}
+
+// moveDirectory moves the source directory to the destination. If a rename is not possible, as it is the case for
+// different file systems, the directory is copied and then removed
+func moveDirectory(source string, destination string) error {
+	if pathExists(destination) {
+		return fmt.Errorf("%w: %s already exists", storage.ErrInvalidFilePath, destination)
+	}
+
+	err := os.MkdirAll(filepath.Dir(destination), os.ModePerm)
+	if err != nil {
+		return err
+	}
+
+	err = os.Rename(source, destination)
+	if err == nil {
+		return nil
+	}
+
+	log.Debug("coldArchiver: rename failed, copying the directory", "source", source, "destination", destination, "error", err)
+
+	tmpDestination := destination + tmpFileSuffix
+	err = copyDirectory(source, tmpDestination)
+	if err != nil {
+		_ = os.RemoveAll(tmpDestination)
+		return err
+	}
+
+	err = os.Rename(tmpDestination, destination)
+	if err != nil {
+		return err
+	}
+
+	return os.RemoveAll(source)
+}
+
+func copyDirectory(source string, destination string) error {
+	return filepath.WalkDir(source, func(path string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+
+		relativePath, err := filepath.Rel(source, path)
+		if err != nil {
+			return err
+		}
+		target := filepath.Join(destination, relativePath)
+
+		if entry.IsDir() {
+			return os.MkdirAll(target, os.ModePerm)
+		}
+
+		return copyFile(path, target)
+	})
+}
+
+func copyFile(source string, destination string) error {
+	sourceFile, err := os.Open(source)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = sourceFile.Close()
+	}()
+
+	destinationFile, err := os.Create(destination)
+	if err != nil {
+		return err
+	}
+
+	_, err = io.Copy(destinationFile, sourceFile)
+	if err != nil {
+		_ = destinationFile.Close()
+		return err
+	}
+
+	err = destinationFile.Sync()
+	if err != nil {
+		_ = destinationFile.Close()
+		return err
+	}
+
+	return destinationFile.Close()
+}
@@ -0,0 +1,208 @@
+package coldarchive
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+
+	"github.com/cockroachdb/pebble/objstorage/objstorageprovider"
+	"github.com/cockroachdb/pebble/sstable"
+	"github.com/cockroachdb/pebble/vfs"
+	"github.com/multiversx/mx-chain-go/storage"
+)
+
+const (
+	unitNameSeparator = byte(0)
+	tmpFileSuffix     = ".tmp"
+)
+
+// packedArchive is a read-only, compressed, sorted table holding the data of all the units of an epoch. Each key is
+// prefixed with the name of its unit
+type packedArchive struct {
+	mut      sync.RWMutex
+	reader   *sstable.Reader
+	isClosed bool
+}
+
+func openPackedArchive(path string) (*packedArchive, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+
+	readable, err := sstable.NewSimpleReadable(file)
+	if err != nil {
+		_ = file.Close()
+		return nil, err
+	}
+
+	reader, err := sstable.NewReader(readable, sstable.ReaderOptions{})
+	if err != nil {
+		_ = readable.Close()
+		return nil, err
+	}
+
+	return &packedArchive{
+		reader: reader,
+	}, nil
+}
+
+func (pa *packedArchive) get(unitName string, key []byte) ([]byte, error) {
+	pa.mut.RLock()
+	defer pa.mut.RUnlock()
+
+	if pa.isClosed {
+		return nil, storage.ErrDBIsClosed
+	}
+
+	iterator, err := pa.reader.NewIter(nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		_ = iterator.Close()
+	}()
+
+	archiveKey := createArchiveKey(unitName, key)
+	internalKey, lazyValue := iterator.SeekGE(archiveKey, sstable.SeekGEFlags(0))
+	if internalKey == nil || !bytes.Equal(internalKey.UserKey, archiveKey) {
+		return nil, storage.ErrKeyNotFound
+	}
+
+	value, _, err := lazyValue.Value(nil)
+	if err != nil {
+		return nil, err
+	}
+
+	// the value might point to a buffer owned by the iterator
+	valueCopy := make([]byte, len(value))
+	copy(valueCopy, value)
+
+	return valueCopy, nil
+}
+
+func (pa *packedArchive) close() error {
+	pa.mut.Lock()
+	defer pa.mut.Unlock()
+
+	if pa.isClosed {
+		return nil
+	}
+
+	pa.isClosed = true
+	return pa.reader.Close()
+}
+
+// writePackedArchive packs the provided units of an epoch in a single archive file. The file is written under a temporary
+// name and renamed only after all the units were packed. The persisters are provided, and closed, by the caller
+func writePackedArchive(
+	ctx context.Context,
+	archiveFile string,
+	units []string,
+	persisterGetter func(unitName string) (storage.Persister, error),
+) error {
+	tmpFile := archiveFile + tmpFileSuffix
+	file, err := vfs.Default.Create(tmpFile)
+	if err != nil {
+		return err
+	}
+
+	writer := sstable.NewWriter(objstorageprovider.NewFileWritable(file), sstable.WriterOptions{
+		Compression: sstable.ZstdCompression,
+	})
+
+	// the keys runs spilled while sorting the keys of a unit are written next to the archive
+	tmpDir := filepath.Dir(archiveFile)
+	for _, unitName := range units {
+		err = packUnit(ctx, writer, unitName, persisterGetter, tmpDir)
+		if err != nil {
+			_ = writer.Close()
+			_ = os.Remove(tmpFile)
+			return err
+		}
+	}
+
+	err = writer.Close()
+	if err != nil {
+		_ = os.Remove(tmpFile)
+		return err
+	}
+
+	return os.Rename(tmpFile, archiveFile)
+}
+
+// packUnit writes all the (key, value) pairs of a unit in the archive. The sorted table requires the keys to be added
+// in increasing order, while the persisters do not guarantee any iteration order (a sharded persister iterates its
+// shards one after the other), so the keys are sorted first, with a bounded memory usage, and the values are fetched
+// afterward
+func packUnit(
+	ctx context.Context,
+	writer *sstable.Writer,
+	unitName string,
+	persisterGetter func(unitName string) (storage.Persister, error),
+	tmpDir string,
+) error {
+	persister, err := persisterGetter(unitName)
+	if err != nil {
+		return err
+	}
+
+	sorter := newKeysSorter(tmpDir, maxKeysRunSizeInBytes, maxNumMergedRuns)
+	defer sorter.close()
+
+	return packPersister(ctx, writer, unitName, persister, sorter)
+}
+
+func packPersister(
+	ctx context.Context,
+	writer *sstable.Writer,
+	unitName string,
+	persister storage.Persister,
+	sorter *keysSorter,
+) error {
+	var errAdd error
+	persister.RangeKeys(func(key []byte, _ []byte) bool {
+		errAdd = ctx.Err()
+		if errAdd == nil {
+			errAdd = sorter.add(key)
+		}
+
+		return errAdd == nil
+	})
+	if errAdd != nil {
+		return fmt.Errorf("%w while sorting the keys of unit %s", errAdd, unitName)
+	}
+
+	numKeys := 0
+	err := sorter.forEachSorted(func(key []byte) error {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+
+		value, errGet := persister.Get(key)
+		if errGet != nil {
+			return fmt.Errorf("%w while reading key %x of unit %s", errGet, key, unitName)
+		}
+
+		numKeys++
+		return writer.Set(createArchiveKey(unitName, key), value)
+	})
+	if err != nil {
+		return err
+	}
+
+	log.Debug("coldArchiver: packed unit", "unit", unitName, "num keys", numKeys)
+
+	return nil
+}
+
+func createArchiveKey(unitName string, key []byte) []byte {
+	archiveKey := make([]byte, 0, len(unitName)+1+len(key))
+	archiveKey = append(archiveKey, unitName...)
+	archiveKey = append(archiveKey, unitNameSeparator)
+
+	return append(archiveKey, key...)
+}
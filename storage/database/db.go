@@ -2,6 +2,7 @@ package database
 
 import (
 	"github.com/multiversx/mx-chain-go/storage"
+	"github.com/multiversx/mx-chain-go/storage/pebble"
 	"github.com/multiversx/mx-chain-storage-go/leveldb"
 	"github.com/multiversx/mx-chain-storage-go/memorydb"
 	"github.com/multiversx/mx-chain-storage-go/sharded"
@@ -33,6 +34,18 @@ func NewSerialDB(path string, batchDelaySeconds int, maxBatchSize int, maxOpenFi
 	return leveldb.NewSerialDB(path, batchDelaySeconds, maxBatchSize, maxOpenFiles)
 }
 
+// NewPebbleDB is a constructor for the pebble persister
+// It creates the files in the location given as parameter
+func NewPebbleDB(path string, batchDelaySeconds int, maxBatchSize int, maxOpenFiles int) (s *pebble.DB, err error) {
+	return pebble.NewDB(path, batchDelaySeconds, maxBatchSize, maxOpenFiles)
+}
+
+// NewReadOnlyPebbleDB is a constructor for the pebble persister opened in read only mode
+// It does not create nor modify any file in the location given as parameter
+func NewReadOnlyPebbleDB(path string, maxOpenFiles int) (s *pebble.DB, err error) {
+	return pebble.NewReadOnlyDB(path, maxOpenFiles)
+}
+
 // NewShardIDProvider is a constructor for shard id provider
 func NewShardIDProvider(numShards int32) (storage.ShardIDProvider, error) {
 	return sharded.NewShardIDProvider(numShards)
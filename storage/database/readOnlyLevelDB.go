@@ -0,0 +1,157 @@
+package database
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/multiversx/mx-chain-go/storage"
+	"github.com/syndtr/goleveldb/leveldb"
+	"github.com/syndtr/goleveldb/leveldb/opt"
+)
+
+var _ storage.Persister = (*readOnlyLevelDB)(nil)
+
+// readOnlyLevelDB is a leveldb persister that never writes on disk. The database is opened in the leveldb read only
+// mode, so that neither the data files nor the manifest and the journal files are touched
+type readOnlyLevelDB struct {
+	path  string
+	mutDb sync.RWMutex
+	db    *leveldb.DB
+}
+
+// NewReadOnlyLevelDB opens the leveldb persister found in the location given as parameter in read only mode
+func NewReadOnlyLevelDB(path string, maxOpenFiles int) (*readOnlyLevelDB, error) {
+	if maxOpenFiles < 1 {
+		return nil, storage.ErrInvalidNumOpenFiles
+	}
+
+	options := &opt.Options{
+		// disable internal cache
+		BlockCacheCapacity:     -1,
+		OpenFilesCacheCapacity: maxOpenFiles,
+		ReadOnly:               true,
+		ErrorIfMissing:         true,
+	}
+	db, err := leveldb.OpenFile(path, options)
+	if err != nil {
+		return nil, fmt.Errorf("%w for path %s", err, path)
+	}
+
+	return &readOnlyLevelDB{
+		path: path,
+		db:   db,
+	}, nil
+}
+
+// Put returns ErrPersisterIsReadOnly
+func (s *readOnlyLevelDB) Put(_, _ []byte) error {
+	return storage.ErrPersisterIsReadOnly
+}
+
+// Get returns the value associated to the key
+func (s *readOnlyLevelDB) Get(key []byte) ([]byte, error) {
+	db := s.getDbPointer()
+	if db == nil {
+		return nil, storage.ErrDBIsClosed
+	}
+
+	data, err := db.Get(key, nil)
+	if err == leveldb.ErrNotFound {
+		return nil, storage.ErrKeyNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return data, nil
+}
+
+// Has returns nil if the given key is present in the persistence medium
+func (s *readOnlyLevelDB) Has(key []byte) error {
+	db := s.getDbPointer()
+	if db == nil {
+		return storage.ErrDBIsClosed
+	}
+
+	has, err := db.Has(key, nil)
+	if err != nil {
+		return err
+	}
+	if !has {
+		return storage.ErrKeyNotFound
+	}
+
+	return nil
+}
+
+// RangeKeys will call the handler function for each (key, value) pair
+// If the handler returns true, the iteration will continue, otherwise will stop
+func (s *readOnlyLevelDB) RangeKeys(handler func(key []byte, value []byte) bool) {
+	if handler == nil {
+		return
+	}
+
+	db := s.getDbPointer()
+	if db == nil {
+		return
+	}
+
+	iterator := db.NewIterator(nil, nil)
+	defer iterator.Release()
+
+	for iterator.Next() {
+		key := iterator.Key()
+		clonedKey := make([]byte, len(key))
+		copy(clonedKey, key)
+
+		val := iterator.Value()
+		clonedVal := make([]byte, len(val))
+		copy(clonedVal, val)
+
+		shouldContinue := handler(clonedKey, clonedVal)
+		if !shouldContinue {
+			return
+		}
+	}
+}
+
+// Close closes the files/resources associated to the storage medium
+func (s *readOnlyLevelDB) Close() error {
+	s.mutDb.Lock()
+	db := s.db
+	s.db = nil
+	s.mutDb.Unlock()
+
+	if db == nil {
+		return nil
+	}
+
+	return db.Close()
+}
+
+// Remove returns ErrPersisterIsReadOnly
+func (s *readOnlyLevelDB) Remove(_ []byte) error {
+	return storage.ErrPersisterIsReadOnly
+}
+
+// Destroy returns ErrPersisterIsReadOnly
+func (s *readOnlyLevelDB) Destroy() error {
+	return storage.ErrPersisterIsReadOnly
+}
+
+// DestroyClosed returns ErrPersisterIsReadOnly
+func (s *readOnlyLevelDB) DestroyClosed() error {
+	return storage.ErrPersisterIsReadOnly
+}
+
+func (s *readOnlyLevelDB) getDbPointer() *leveldb.DB {
+	s.mutDb.RLock()
+	defer s.mutDb.RUnlock()
+
+	return s.db
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (s *readOnlyLevelDB) IsInterfaceNil() bool {
+	return s == nil
+}
@@ -0,0 +1,113 @@
+package database
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/multiversx/mx-chain-go/storage"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createLevelDBWithData(t *testing.T, path string, data map[string]string) {
+	db, err := NewSerialDB(path, 1, 100, 10)
+	require.Nil(t, err)
+
+	for key, value := range data {
+		err = db.Put([]byte(key), []byte(value))
+		require.Nil(t, err)
+	}
+
+	err = db.Close()
+	require.Nil(t, err)
+}
+
+func getFilesModTimes(t *testing.T, path string) map[string]int64 {
+	entries, err := os.ReadDir(path)
+	require.Nil(t, err)
+
+	modTimes := make(map[string]int64)
+	for _, entry := range entries {
+		info, errInfo := entry.Info()
+		require.Nil(t, errInfo)
+		modTimes[entry.Name()] = info.ModTime().UnixNano()
+	}
+
+	return modTimes
+}
+
+func TestNewReadOnlyLevelDB(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid number of open files should error", func(t *testing.T) {
+		t.Parallel()
+
+		db, err := NewReadOnlyLevelDB(t.TempDir(), 0)
+		assert.Nil(t, db)
+		assert.Equal(t, storage.ErrInvalidNumOpenFiles, err)
+	})
+	t.Run("missing database should error and not create it", func(t *testing.T) {
+		t.Parallel()
+
+		path := filepath.Join(t.TempDir(), "missing")
+		db, err := NewReadOnlyLevelDB(path, 10)
+		assert.Nil(t, db)
+		assert.NotNil(t, err)
+
+		_, err = os.Stat(path)
+		assert.True(t, os.IsNotExist(err))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		path := t.TempDir()
+		createLevelDBWithData(t, path, map[string]string{"key": "value"})
+
+		db, err := NewReadOnlyLevelDB(path, 10)
+		assert.Nil(t, err)
+		assert.False(t, db.IsInterfaceNil())
+		assert.Nil(t, db.Close())
+	})
+}
+
+func TestReadOnlyLevelDB_ShouldReadWithoutWriting(t *testing.T) {
+	t.Parallel()
+
+	path := t.TempDir()
+	data := map[string]string{
+		"key1": "value1",
+		"key2": "value2",
+	}
+	createLevelDBWithData(t, path, data)
+	modTimesBefore := getFilesModTimes(t, path)
+
+	db, _ := NewReadOnlyLevelDB(path, 10)
+
+	value, err := db.Get([]byte("key1"))
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("value1"), value)
+	assert.Nil(t, db.Has([]byte("key2")))
+
+	_, err = db.Get([]byte("missing"))
+	assert.Equal(t, storage.ErrKeyNotFound, err)
+	assert.Equal(t, storage.ErrKeyNotFound, db.Has([]byte("missing")))
+
+	rangedData := make(map[string]string)
+	db.RangeKeys(func(key []byte, value []byte) bool {
+		rangedData[string(key)] = string(value)
+		return true
+	})
+	assert.Equal(t, data, rangedData)
+
+	assert.Equal(t, storage.ErrPersisterIsReadOnly, db.Put([]byte("key3"), []byte("value3")))
+	assert.Equal(t, storage.ErrPersisterIsReadOnly, db.Remove([]byte("key1")))
+	assert.Equal(t, storage.ErrPersisterIsReadOnly, db.Destroy())
+	assert.Equal(t, storage.ErrPersisterIsReadOnly, db.DestroyClosed())
+
+	assert.Nil(t, db.Close())
+	_, err = db.Get([]byte("key1"))
+	assert.Equal(t, storage.ErrDBIsClosed, err)
+
+	assert.Equal(t, modTimesBefore, getFilesModTimes(t, path))
+}
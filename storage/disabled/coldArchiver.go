@@ -0,0 +1,40 @@
+package disabled
+
+import (
+	"github.com/multiversx/mx-chain-go/storage"
+)
+
+type coldArchiver struct{}
+
+// NewColdArchiver returns a new instance of this disabled cold archiver
+func NewColdArchiver() *coldArchiver {
+	return &coldArchiver{}
+}
+
+// RegisterUnit does nothing
+func (ca *coldArchiver) RegisterUnit(_ string, _ storage.PersisterFactory, _ func(epoch uint32) error) {
+}
+
+// ArchiveOldEpochs does nothing
+func (ca *coldArchiver) ArchiveOldEpochs(_ uint32) {
+}
+
+// IsEpochArchived returns false
+func (ca *coldArchiver) IsEpochArchived(_ uint32) bool {
+	return false
+}
+
+// Get returns nil and ErrEpochNotArchived
+func (ca *coldArchiver) Get(_ uint32, _ string, _ []byte) ([]byte, error) {
+	return nil, storage.ErrEpochNotArchived
+}
+
+// Close returns nil
+func (ca *coldArchiver) Close() error {
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (ca *coldArchiver) IsInterfaceNil() bool {
+	return ca == nil
+}
@@ -0,0 +1,31 @@
+package disabled
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/multiversx/mx-chain-go/storage"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestColdArchiver_MethodsDoNotPanic(t *testing.T) {
+	t.Parallel()
+
+	defer func() {
+		r := recover()
+		if r != nil {
+			assert.Fail(t, fmt.Sprintf("should have not panicked: %v", r))
+		}
+	}()
+
+	ca := NewColdArchiver()
+	assert.False(t, ca.IsInterfaceNil())
+	ca.RegisterUnit("unit", nil, nil)
+	ca.ArchiveOldEpochs(10)
+	assert.False(t, ca.IsEpochArchived(1))
+	assert.Nil(t, ca.Close())
+
+	val, err := ca.Get(1, "unit", []byte("key"))
+	assert.Nil(t, val)
+	assert.Equal(t, storage.ErrEpochNotArchived, err)
+}
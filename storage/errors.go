@@ -82,6 +82,9 @@ var ErrNotSupportedCacheType = storageErrors.ErrNotSupportedCacheType
 // ErrDBIsClosed is raised when the DB is closed
 var ErrDBIsClosed = storageErrors.ErrDBIsClosed
 
+// ErrInvalidNumOpenFiles is raised when the max num of open files is less than 1
+var ErrInvalidNumOpenFiles = storageErrors.ErrInvalidNumOpenFiles
+
 // ErrEpochKeepIsLowerThanNumActive signals that num epochs to keep is lower than num active epochs
 var ErrEpochKeepIsLowerThanNumActive = errors.New("num epochs to keep is lower than num active epochs")
 
@@ -115,6 +118,33 @@ var ErrNilBootstrapDataProvider = errors.New("nil bootstrap data provider")
 // ErrNilDirectoryReader signals that a nil directory reader has been provided
 var ErrNilDirectoryReader = errors.New("nil directory reader")
 
+// ErrNilColdArchiver signals that a nil cold archiver has been provided
+var ErrNilColdArchiver = errors.New("nil cold archiver")
+
+// ErrNilArchivePersisterFactory signals that a nil persister factory for the archived epochs has been provided
+var ErrNilArchivePersisterFactory = errors.New("nil archive persister factory")
+
+// ErrInvalidColdArchiveMode signals that an invalid cold archive mode has been provided
+var ErrInvalidColdArchiveMode = errors.New("invalid cold archive mode")
+
+// ErrInvalidNumOpenedArchives signals that an invalid number of opened archives has been provided
+var ErrInvalidNumOpenedArchives = errors.New("invalid number of opened archives")
+
+// ErrInvalidNumEpochsToKeepUnarchived signals that an invalid number of epochs to keep unarchived has been provided
+var ErrInvalidNumEpochsToKeepUnarchived = errors.New("invalid number of epochs to keep unarchived")
+
+// ErrEpochNotArchived signals that the requested epoch is not archived
+var ErrEpochNotArchived = errors.New("epoch not archived")
+
+// ErrArchivedEpochStillActive signals that the epoch to be archived is still active
+var ErrArchivedEpochStillActive = errors.New("the epoch to be archived is still active")
+
+// ErrArchivedEpochIsReadOnly signals that a write operation was attempted on an archived epoch
+var ErrArchivedEpochIsReadOnly = errors.New("archived epoch is read only")
+
+// ErrPersisterIsReadOnly signals that a write operation was attempted on a persister opened in read only mode
+var ErrPersisterIsReadOnly = errors.New("persister is read only")
+
 // IsNotFoundInStorageErr returns whether an error is a "not found in storage" error.
 // Currently, "item not found" storage errors are untyped (thus not distinguishable from others). E.g. see "pruningStorer.go".
 // As a workaround, we test the error message for a match.
@@ -12,12 +12,14 @@ const minNumShards = 2
 
 // persisterCreator is the factory which will handle creating new persisters
 type persisterCreator struct {
-	conf config.DBConfig
+	conf     config.DBConfig
+	readOnly bool
 }
 
-func newPersisterCreator(config config.DBConfig) *persisterCreator {
+func newPersisterCreator(config config.DBConfig, readOnly bool) *persisterCreator {
 	return &persisterCreator{
-		conf: config,
+		conf:     config,
+		readOnly: readOnly,
 	}
 }
 
@@ -41,6 +43,12 @@ func (pc *persisterCreator) Create(path string) (storage.Persister, error) {
 // CreateBasePersister will create base the persister for the provided path
 func (pc *persisterCreator) CreateBasePersister(path string) (storage.Persister, error) {
 	var dbType = storageunit.DBType(pc.conf.Type)
+	if pc.readOnly {
+		return pc.createReadOnlyBasePersister(dbType, path)
+	}
+	if dbType == storageunit.PebbleDB {
+		return database.NewPebbleDB(path, pc.conf.BatchDelaySeconds, pc.conf.MaxBatchSize, pc.conf.MaxOpenFiles)
+	}
 
 	argsDB := factory.ArgDB{
 		DBType:            dbType,
@@ -53,6 +61,19 @@ func (pc *persisterCreator) CreateBasePersister(path string) (storage.Persister,
 	return storageunit.NewDB(argsDB)
 }
 
+func (pc *persisterCreator) createReadOnlyBasePersister(dbType storageunit.DBType, path string) (storage.Persister, error) {
+	switch dbType {
+	case storageunit.PebbleDB:
+		return database.NewReadOnlyPebbleDB(path, pc.conf.MaxOpenFiles)
+	case storageunit.LvlDB, storageunit.LvlDBSerial:
+		return database.NewReadOnlyLevelDB(path, pc.conf.MaxOpenFiles)
+	case storageunit.MemoryDB:
+		return database.NewMemDB(), nil
+	default:
+		return nil, storage.ErrNotSupportedDBType
+	}
+}
+
 func (pc *persisterCreator) createShardIDProvider() (storage.ShardIDProvider, error) {
 	switch storageunit.ShardIDProviderType(pc.conf.ShardIDProviderType) {
 	case storageunit.BinarySplit:
@@ -76,6 +76,31 @@ func TestPersisterCreator_Create(t *testing.T) {
 
 		assert.True(t, strings.Contains(fmt.Sprintf("%T", p), "*sharded.shardedPersister"))
 	})
+
+	t.Run("should create sharded pebble persister", func(t *testing.T) {
+		t.Parallel()
+
+		dbConfig := createDefaultDBConfig()
+		dbConfig.Type = string(storageunit.PebbleDB)
+		pc := factory.NewPersisterCreator(dbConfig)
+
+		dir := t.TempDir()
+		p, err := pc.Create(dir)
+		require.NotNil(t, p)
+		require.Nil(t, err)
+
+		err = p.Put([]byte("key"), []byte("value"))
+		require.Nil(t, err)
+		err = p.Close()
+		require.Nil(t, err)
+
+		p, err = pc.Create(dir)
+		require.Nil(t, err)
+		value, err := p.Get([]byte("key"))
+		require.Nil(t, err)
+		require.Equal(t, []byte("value"), value)
+		_ = p.Close()
+	})
 }
 
 func TestPersisterCreator_CreateBasePersister(t *testing.T) {
@@ -136,6 +161,24 @@ func TestPersisterCreator_CreateBasePersister(t *testing.T) {
 
 		assert.True(t, strings.Contains(fmt.Sprintf("%T", p), "*memorydb.DB"))
 	})
+
+	t.Run("pebble", func(t *testing.T) {
+		t.Parallel()
+
+		dbConfig := createDefaultBasePersisterConfig()
+		dbConfig.Type = string(storageunit.PebbleDB)
+		pc := factory.NewPersisterCreator(dbConfig)
+
+		dir := t.TempDir()
+		p, err := pc.CreateBasePersister(dir)
+		require.NotNil(t, p)
+		require.Nil(t, err)
+		defer func() {
+			_ = p.Close()
+		}()
+
+		assert.True(t, strings.Contains(fmt.Sprintf("%T", p), "*pebble.DB"))
+	})
 }
 
 func TestPersisterCreator_CreateShardIDProvider(t *testing.T) {
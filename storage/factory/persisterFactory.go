@@ -7,12 +7,14 @@ import (
 
 	"github.com/multiversx/mx-chain-go/config"
 	"github.com/multiversx/mx-chain-go/storage"
+	"github.com/multiversx/mx-chain-go/storage/database"
 	"github.com/multiversx/mx-chain-go/storage/disabled"
 )
 
 // persisterFactory is the factory which will handle creating new databases
 type persisterFactory struct {
 	dbConfigHandler storage.DBConfigHandler
+	readOnly        bool
 }
 
 // NewPersisterFactory will return a new instance of persister factory
@@ -24,6 +26,18 @@ func NewPersisterFactory(config config.DBConfig) (*persisterFactory, error) {
 	}, nil
 }
 
+// NewReadOnlyPersisterFactory will return a new instance of persister factory that opens the existing databases in
+// read only mode. Nothing is written on disk: the databases that do not exist are replaced by empty in memory
+// databases and the db config files are not saved
+func NewReadOnlyPersisterFactory(config config.DBConfig) (*persisterFactory, error) {
+	dbConfigHandler := NewDBConfigHandler(config)
+
+	return &persisterFactory{
+		dbConfigHandler: dbConfigHandler,
+		readOnly:        true,
+	}, nil
+}
+
 // CreateWithRetries will return a new instance of a DB with a given path
 // It will try to create db multiple times
 func (pf *persisterFactory) CreateWithRetries(path string) (storage.Persister, error) {
@@ -55,6 +69,10 @@ func (pf *persisterFactory) Create(path string) (storage.Persister, error) {
 		return nil, err
 	}
 
+	if pf.readOnly {
+		return pf.createReadOnly(path, dbConfig)
+	}
+
 	if dbConfig.UseTmpAsFilePath {
 		filePath, err := getTmpFilePath(path)
 		if err != nil {
@@ -64,7 +82,7 @@ func (pf *persisterFactory) Create(path string) (storage.Persister, error) {
 		path = filePath
 	}
 
-	pc := newPersisterCreator(*dbConfig)
+	pc := newPersisterCreator(*dbConfig, false)
 
 	persister, err := pc.Create(path)
 	if err != nil {
@@ -79,6 +97,15 @@ func (pf *persisterFactory) Create(path string) (storage.Persister, error) {
 	return persister, nil
 }
 
+func (pf *persisterFactory) createReadOnly(path string, dbConfig *config.DBConfig) (storage.Persister, error) {
+	if checkIfDirIsEmpty(path) || dbConfig.UseTmpAsFilePath {
+		log.Debug("persisterFactory.createReadOnly: no database on disk, using an empty in memory database", "path", path)
+		return database.NewMemDB(), nil
+	}
+
+	return newPersisterCreator(*dbConfig, true).Create(path)
+}
+
 // CreateDisabled will return a new disabled persister
 func (pf *persisterFactory) CreateDisabled() storage.Persister {
 	return disabled.NewErrorDisabledPersister()
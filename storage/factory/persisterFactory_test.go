@@ -76,6 +76,51 @@ func TestPersisterFactory_Create(t *testing.T) {
 	})
 }
 
+func TestReadOnlyPersisterFactory_Create(t *testing.T) {
+	t.Parallel()
+
+	t.Run("missing database should return an empty in memory database", func(t *testing.T) {
+		t.Parallel()
+
+		pf, _ := factory.NewReadOnlyPersisterFactory(createDefaultDBConfig())
+
+		dir := path.Join(t.TempDir(), "missing")
+		p, err := pf.Create(dir)
+		require.Nil(t, err)
+		require.Equal(t, storage.ErrKeyNotFound, p.Has([]byte("key")))
+
+		_, err = os.Stat(dir)
+		require.True(t, os.IsNotExist(err))
+	})
+	t.Run("existing database should be opened in read only mode", func(t *testing.T) {
+		t.Parallel()
+
+		dir := t.TempDir()
+		pf, _ := factory.NewPersisterFactory(createDefaultDBConfig())
+		p, err := pf.Create(dir)
+		require.Nil(t, err)
+		require.Nil(t, p.Put([]byte("key"), []byte("value")))
+		require.Nil(t, p.Close())
+		configFileInfo, err := os.Stat(dir + "/config.toml")
+		require.Nil(t, err)
+
+		readOnlyFactory, _ := factory.NewReadOnlyPersisterFactory(createDefaultDBConfig())
+		p, err = readOnlyFactory.Create(dir)
+		require.Nil(t, err)
+
+		value, err := p.Get([]byte("key"))
+		require.Nil(t, err)
+		require.Equal(t, []byte("value"), value)
+		require.Equal(t, storage.ErrPersisterIsReadOnly, p.Put([]byte("key2"), []byte("value2")))
+		require.Nil(t, p.Close())
+
+		// the db config file should not be saved again
+		newConfigFileInfo, err := os.Stat(dir + "/config.toml")
+		require.Nil(t, err)
+		require.Equal(t, configFileInfo.ModTime(), newConfigFileInfo.ModTime())
+	})
+}
+
 func TestPersisterFactory_CreateWithRetries(t *testing.T) {
 	t.Parallel()
 
@@ -16,6 +16,7 @@ import (
 	"github.com/multiversx/mx-chain-go/process"
 	"github.com/multiversx/mx-chain-go/storage"
 	"github.com/multiversx/mx-chain-go/storage/clean"
+	"github.com/multiversx/mx-chain-go/storage/coldarchive"
 	"github.com/multiversx/mx-chain-go/storage/databaseremover/disabled"
 	"github.com/multiversx/mx-chain-go/storage/databaseremover/factory"
 	storageDisabled "github.com/multiversx/mx-chain-go/storage/disabled"
@@ -44,6 +45,15 @@ const (
 
 	// ImportDBStorageService is used for the import-db storage service
 	ImportDBStorageService StorageServiceType = "import-db"
+
+	// DBCheckerStorageService is used by the offline database checker. The persisters are opened in read only mode
+	DBCheckerStorageService StorageServiceType = "db-checker"
+
+	// StateSnapshotStorageService is used by the state snapshot exporter. The persisters are opened in read only mode
+	StateSnapshotStorageService StorageServiceType = "state-snapshot"
+
+	// StateExportStorageService is used by the state exporter. The persisters are opened in read only mode
+	StateExportStorageService StorageServiceType = "state-export"
 )
 
 // StorageServiceFactory handles the creation of storage services for both meta and shards
@@ -62,6 +72,7 @@ type StorageServiceFactory struct {
 	repopulateTokensSupplies        bool
 	stateStatsHandler               common.StateStatisticsHandler
 	additionalStorageServiceCreator process.AdditionalStorageServiceCreator
+	coldArchiver                    storage.ColdArchiver
 }
 
 // StorageServiceFactoryArgs holds the arguments needed for creating a new storage service factory
@@ -102,6 +113,11 @@ func NewStorageServiceFactory(args StorageServiceFactoryArgs) (*StorageServiceFa
 		return nil, storage.ErrInvalidNumberOfEpochsToSave
 	}
 
+	coldArchiver, err := createColdArchiver(args)
+	if err != nil {
+		return nil, err
+	}
+
 	return &StorageServiceFactory{
 		generalConfig:                   args.Config,
 		prefsConfig:                     args.PrefsConfig,
@@ -117,9 +133,26 @@ func NewStorageServiceFactory(args StorageServiceFactoryArgs) (*StorageServiceFa
 		repopulateTokensSupplies:        args.RepopulateTokensSupplies,
 		stateStatsHandler:               args.StateStatsHandler,
 		additionalStorageServiceCreator: args.AdditionalStorageServiceCreator,
+		coldArchiver:                    coldArchiver,
 	}, nil
 }
 
+func createColdArchiver(args StorageServiceFactoryArgs) (storage.ColdArchiver, error) {
+	isColdArchiveEnabled := args.PrefsConfig.FullArchive && args.Config.StoragePruning.ColdArchive.Enabled
+	if !isColdArchiveEnabled {
+		return storageDisabled.NewColdArchiver(), nil
+	}
+
+	return coldarchive.NewColdArchiver(coldarchive.ArgsColdArchiver{
+		Config:              args.Config.StoragePruning.ColdArchive,
+		NumActivePersisters: args.Config.StoragePruning.FullArchiveNumActivePersisters,
+		PathManager:         args.PathManager,
+		ShardCoordinator:    args.ShardCoordinator,
+		// only the processing storage service archives, the other ones just read from the archives
+		EnableArchiving: args.StorageType == ProcessStorageService,
+	})
+}
+
 func checkArgs(args StorageServiceFactoryArgs) error {
 	if args.Config.StoragePruning.NumActivePersisters < minimumNumberOfActivePersisters {
 		return storage.ErrInvalidNumberOfActivePersisters
@@ -290,7 +323,7 @@ func (psf *StorageServiceFactory) createStaticStorageUnit(
 	dbPath := psf.pathManager.PathForStatic(shardID, storageConf.DB.FilePath) + dbPathSuffix
 	storageUnitDBConf.FilePath = dbPath
 
-	persisterCreator, err := NewPersisterFactory(storageConf.DB)
+	persisterCreator, err := psf.createPersisterFactory(storageConf.DB)
 	if err != nil {
 		return nil, err
 	}
@@ -324,7 +357,7 @@ func (psf *StorageServiceFactory) createAndAddStorageUnitsForSovereign(
 
 	extendedHeaderConfig := psf.generalConfig.SovereignConfig.ExtendedShardHeaderStorage
 	dbConfigExtendedHeader := NewDBConfigHandler(extendedHeaderConfig.DB)
-	extendedHeaderPersisterCreator, err := NewPersisterFactory(dbConfigExtendedHeader.conf)
+	extendedHeaderPersisterCreator, err := psf.createPersisterFactory(dbConfigExtendedHeader.conf)
 	if err != nil {
 		return err
 	}
@@ -410,6 +443,8 @@ func (psf *StorageServiceFactory) CreateForShard() (dataRetriever.StorageService
 		return nil, err
 	}
 
+	psf.archiveOldEpochsIfNeeded()
+
 	return store, err
 }
 
@@ -460,11 +495,18 @@ func (psf *StorageServiceFactory) CreateForMeta() (dataRetriever.StorageService,
 		return nil, err
 	}
 
+	err = psf.setUpValidatorHistoryStorers(store)
+	if err != nil {
+		return nil, err
+	}
+
 	err = psf.initOldDatabasesCleaningIfNeeded(store)
 	if err != nil {
 		return nil, err
 	}
 
+	psf.archiveOldEpochsIfNeeded()
+
 	return store, err
 }
 
@@ -524,6 +566,43 @@ func (psf *StorageServiceFactory) setUpLogsAndEventsStorer(chainStorer *dataRetr
 	return nil
 }
 
+// setUpValidatorHistoryStorers adds the validators rewards breakdown and ratings history storers, which are only
+// persisted by the metachain nodes that have the db lookup extensions enabled
+func (psf *StorageServiceFactory) setUpValidatorHistoryStorers(chainStorer *dataRetriever.ChainStorer) error {
+	dbLookupConfig := psf.generalConfig.DbLookupExtensions
+
+	err := psf.setUpValidatorHistoryStorer(chainStorer, dataRetriever.ValidatorRewardsUnit, dbLookupConfig.ValidatorRewardsStorageConfig, "ValidatorRewardsStorageConfig")
+	if err != nil {
+		return err
+	}
+
+	return psf.setUpValidatorHistoryStorer(chainStorer, dataRetriever.ValidatorRatingsUnit, dbLookupConfig.ValidatorRatingsStorageConfig, "ValidatorRatingsStorageConfig")
+}
+
+func (psf *StorageServiceFactory) setUpValidatorHistoryStorer(
+	chainStorer *dataRetriever.ChainStorer,
+	unitType dataRetriever.UnitType,
+	storageConfig config.StorageConfig,
+	configName string,
+) error {
+	var unit storage.Storer
+	unit = storageDisabled.NewStorer()
+
+	if psf.generalConfig.DbLookupExtensions.Enabled {
+		shardID := core.GetShardIDString(psf.shardCoordinator.SelfId())
+
+		var err error
+		unit, err = psf.createStaticStorageUnit(storageConfig, shardID, emptyDBPathSuffix)
+		if err != nil {
+			return fmt.Errorf("%w for DbLookupExtensions.%s", err, configName)
+		}
+	}
+
+	chainStorer.AddStorer(unitType, unit)
+
+	return nil
+}
+
 func (psf *StorageServiceFactory) setUpDbLookupExtensions(chainStorer *dataRetriever.ChainStorer) error {
 	if !psf.generalConfig.DbLookupExtensions.Enabled {
 		return nil
@@ -605,6 +684,25 @@ func (psf *StorageServiceFactory) setUpEsdtSuppliesStorer(chainStorer *dataRetri
 	return nil
 }
 
+// isReadOnlyStorageService returns true for the storage services used by the offline tools, which must never alter
+// the databases of the node they inspect
+func (psf *StorageServiceFactory) isReadOnlyStorageService() bool {
+	switch psf.storageType {
+	case DBCheckerStorageService, StateSnapshotStorageService, StateExportStorageService:
+		return true
+	default:
+		return false
+	}
+}
+
+func (psf *StorageServiceFactory) createPersisterFactory(dbConfig config.DBConfig) (*persisterFactory, error) {
+	if psf.isReadOnlyStorageService() {
+		return NewReadOnlyPersisterFactory(dbConfig)
+	}
+
+	return NewPersisterFactory(dbConfig)
+}
+
 func (psf *StorageServiceFactory) createPruningStorerArgs(
 	storageConfig config.StorageConfig,
 	customDatabaseRemover storage.CustomDatabaseRemoverHandler,
@@ -620,7 +718,12 @@ func (psf *StorageServiceFactory) createPruningStorerArgs(
 		NumOfActivePersisters: numOfActivePersisters,
 	}
 
-	persisterFactory, err := NewPersisterFactory(storageConfig.DB)
+	persisterFactory, err := psf.createPersisterFactory(storageConfig.DB)
+	if err != nil {
+		return pruning.StorerArgs{}, err
+	}
+
+	archivePersisterFactory, err := NewReadOnlyPersisterFactory(storageConfig.DB)
 	if err != nil {
 		return pruning.StorerArgs{}, err
 	}
@@ -635,6 +738,7 @@ func (psf *StorageServiceFactory) createPruningStorerArgs(
 		PathManager:               psf.pathManager,
 		DbPath:                    dbPath,
 		PersisterFactory:          persisterFactory,
+		ArchivePersisterFactory:   archivePersisterFactory,
 		Notifier:                  psf.epochStartNotifier,
 		MaxBatchSize:              storageConfig.DB.MaxBatchSize,
 		EnabledDbLookupExtensions: psf.generalConfig.DbLookupExtensions.Enabled,
@@ -678,6 +782,7 @@ func (psf *StorageServiceFactory) createTriePruningPersister(arg pruning.StorerA
 	historyArgs := pruning.FullHistoryStorerArgs{
 		StorerArgs:               arg,
 		NumOfOldActivePersisters: numOldActivePersisters,
+		ColdArchiver:             psf.coldArchiver,
 	}
 
 	return pruning.NewFullHistoryTriePruningStorer(historyArgs)
@@ -694,6 +799,7 @@ func (psf *StorageServiceFactory) createPruningPersister(arg pruning.StorerArgs)
 	historyArgs := pruning.FullHistoryStorerArgs{
 		StorerArgs:               arg,
 		NumOfOldActivePersisters: numOldActivePersisters,
+		ColdArchiver:             psf.coldArchiver,
 	}
 
 	return pruning.NewFullHistoryPruningStorer(historyArgs)
@@ -716,9 +822,20 @@ func (psf *StorageServiceFactory) getNumActivePersistersForFullHistoryStorer(isF
 	return psf.generalConfig.StoragePruning.FullArchiveNumActivePersisters
 }
 
+// archiveOldEpochsIfNeeded requests the archiving of the old epochs left since the node was stopped. It is done on a
+// background go routine, after all the storers were created, and only for the processing storage service. The next
+// epochs are archived on the epoch change
+func (psf *StorageServiceFactory) archiveOldEpochsIfNeeded() {
+	if psf.storageType != ProcessStorageService {
+		return
+	}
+
+	psf.coldArchiver.ArchiveOldEpochs(psf.currentEpoch)
+}
+
 func (psf *StorageServiceFactory) initOldDatabasesCleaningIfNeeded(store dataRetriever.StorageService) error {
 	isFullArchive := psf.prefsConfig.FullArchive
-	if isFullArchive {
+	if isFullArchive || psf.isReadOnlyStorageService() {
 		return nil
 	}
 	_, err := clean.NewOldDatabaseCleaner(clean.ArgsOldDatabaseCleaner{
@@ -1,8 +1,12 @@
 package factory
 
 import (
+	errorsGo "errors"
 	"fmt"
+	"os"
+	"path/filepath"
 	"testing"
+	"time"
 
 	"github.com/multiversx/mx-chain-core-go/core"
 	"github.com/multiversx/mx-chain-core-go/core/check"
@@ -63,6 +67,8 @@ func createMockArgument(t *testing.T) StorageServiceFactoryArgs {
 				ResultsHashesByTxHashStorageConfig: createMockStorageConfig("ResultsHashesByTxHashStorage"),
 				ESDTSuppliesStorageConfig:          createMockStorageConfig("ESDTSuppliesStorage"),
 				RoundHashStorageConfig:             createMockStorageConfig("RoundHashStorage"),
+				ValidatorRewardsStorageConfig:      createMockStorageConfig("ValidatorRewardsStorage"),
+				ValidatorRatingsStorageConfig:      createMockStorageConfig("ValidatorRatingsStorage"),
 			},
 			LogsAndEvents: config.LogsAndEventsConfig{
 				SaveInStorageEnabled: true,
@@ -171,6 +177,19 @@ func TestNewStorageServiceFactory(t *testing.T) {
 		assert.Equal(t, errors.ErrNilAdditionalStorageServiceCreator, err)
 		assert.Nil(t, storageServiceFactory)
 	})
+	t.Run("invalid cold archive config should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgument(t)
+		args.PrefsConfig.FullArchive = true
+		args.Config.StoragePruning.ColdArchive = config.ColdArchiveConfig{
+			Enabled: true,
+			Mode:    "invalid",
+		}
+		storageServiceFactory, err := NewStorageServiceFactory(args)
+		assert.True(t, errorsGo.Is(err, storage.ErrInvalidColdArchiveMode))
+		assert.Nil(t, storageServiceFactory)
+	})
 	t.Run("should work", func(t *testing.T) {
 		t.Parallel()
 
@@ -181,6 +200,108 @@ func TestNewStorageServiceFactory(t *testing.T) {
 	})
 }
 
+func TestStorageServiceFactory_CreateForShardWithColdArchive(t *testing.T) {
+	t.Parallel()
+
+	testKey, testValue := []byte("key"), []byte("value")
+	args := createMockArgument(t)
+	persisterFactory, err := NewPersisterFactory(args.Config.BlockHeaderStorage.DB)
+	require.Nil(t, err)
+	oldEpochPath := args.PathManager.PathForEpoch("0", 0, args.Config.BlockHeaderStorage.DB.FilePath)
+	persister, err := persisterFactory.Create(oldEpochPath)
+	require.Nil(t, err)
+	require.Nil(t, persister.Put(testKey, testValue))
+	require.Nil(t, persister.Close())
+
+	args.PrefsConfig.FullArchive = true
+	args.CurrentEpoch = 6
+	args.Config.StoragePruning.FullArchiveNumActivePersisters = 2
+	args.Config.StoragePruning.ColdArchive = config.ColdArchiveConfig{
+		Enabled:                   true,
+		Mode:                      "pack",
+		NumEpochsToKeepUnarchived: 4,
+		NumOpenedArchives:         2,
+	}
+	storageServiceFactory, err := NewStorageServiceFactory(args)
+	require.Nil(t, err)
+
+	storageService, err := storageServiceFactory.CreateForShard()
+	require.Nil(t, err)
+	defer func() {
+		_ = storageService.CloseAll()
+	}()
+
+	// the old epochs are archived on a background go routine
+	require.Eventually(t, func() bool {
+		_, errStat := os.Stat(oldEpochPath)
+		return os.IsNotExist(errStat)
+	}, 10*time.Second, 10*time.Millisecond)
+
+	headersUnit, err := storageService.GetStorer(dataRetriever.BlockHeaderUnit)
+	require.Nil(t, err)
+	value, err := headersUnit.GetFromEpoch(testKey, 0)
+	assert.Nil(t, err)
+	assert.Equal(t, testValue, value)
+
+	err = headersUnit.PutInEpoch(testKey, testValue, 0)
+	assert.Equal(t, storage.ErrArchivedEpochIsReadOnly, err)
+
+	_, err = os.Stat(oldEpochPath)
+	assert.True(t, os.IsNotExist(err))
+}
+
+func getFilesModTimes(t *testing.T, rootPath string) map[string]int64 {
+	modTimes := make(map[string]int64)
+	err := filepath.Walk(rootPath, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+
+		modTimes[path] = info.ModTime().UnixNano()
+		return nil
+	})
+	require.Nil(t, err)
+
+	return modTimes
+}
+
+func TestStorageServiceFactory_CreateForShardReadOnly(t *testing.T) {
+	t.Parallel()
+
+	testKey, testValue := []byte("key"), []byte("value")
+	args := createMockArgument(t)
+	storageServiceFactory, err := NewStorageServiceFactory(args)
+	require.Nil(t, err)
+	storageService, err := storageServiceFactory.CreateForShard()
+	require.Nil(t, err)
+	headersUnit, err := storageService.GetStorer(dataRetriever.BlockHeaderUnit)
+	require.Nil(t, err)
+	require.Nil(t, headersUnit.Put(testKey, testValue))
+	require.Nil(t, storageService.CloseAll())
+
+	modTimesBefore := getFilesModTimes(t, args.PathManager.DatabasePath())
+	require.Greater(t, len(modTimesBefore), numShardStoreres)
+
+	readOnlyTypes := []StorageServiceType{DBCheckerStorageService, StateSnapshotStorageService, StateExportStorageService}
+	for _, storageType := range readOnlyTypes {
+		args.StorageType = storageType
+		storageServiceFactory, err = NewStorageServiceFactory(args)
+		require.Nil(t, err)
+		storageService, err = storageServiceFactory.CreateForShard()
+		require.Nil(t, err)
+
+		headersUnit, err = storageService.GetStorer(dataRetriever.BlockHeaderUnit)
+		require.Nil(t, err)
+		value, errGet := headersUnit.Get(testKey)
+		assert.Nil(t, errGet)
+		assert.Equal(t, testValue, value)
+		assert.NotNil(t, headersUnit.Put([]byte("another key"), testValue))
+
+		require.Nil(t, storageService.CloseAll())
+		assert.Equal(t, modTimesBefore, getFilesModTimes(t, args.PathManager.DatabasePath()), string(storageType))
+	}
+}
+
 func TestStorageServiceFactory_CreateForShard(t *testing.T) {
 	t.Parallel()
 
@@ -587,6 +708,45 @@ func TestStorageServiceFactory_CreateForMeta(t *testing.T) {
 		assert.Equal(t, expectedErrForCacheString+" for LogsAndEvents.TxLogsStorage", err.Error())
 		assert.True(t, check.IfNil(storageService))
 	})
+	t.Run("wrong config for DbLookupExtensions.ValidatorRewardsStorageConfig should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgument(t)
+		args.Config.DbLookupExtensions.ValidatorRewardsStorageConfig.Cache.Type = ""
+		storageServiceFactory, _ := NewStorageServiceFactory(args)
+		storageService, err := storageServiceFactory.CreateForMeta()
+		assert.Equal(t, expectedErrForCacheString+" for DbLookupExtensions.ValidatorRewardsStorageConfig", err.Error())
+		assert.True(t, check.IfNil(storageService))
+	})
+	t.Run("wrong config for DbLookupExtensions.ValidatorRatingsStorageConfig should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgument(t)
+		args.Config.DbLookupExtensions.ValidatorRatingsStorageConfig.Cache.Type = ""
+		storageServiceFactory, _ := NewStorageServiceFactory(args)
+		storageService, err := storageServiceFactory.CreateForMeta()
+		assert.Equal(t, expectedErrForCacheString+" for DbLookupExtensions.ValidatorRatingsStorageConfig", err.Error())
+		assert.True(t, check.IfNil(storageService))
+	})
+	t.Run("disabled db lookup extensions should use disabled validator history storers", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgument(t)
+		args.Config.DbLookupExtensions.Enabled = false
+		storageServiceFactory, _ := NewStorageServiceFactory(args)
+		storageService, err := storageServiceFactory.CreateForMeta()
+		require.Nil(t, err)
+
+		storer, err := storageService.GetStorer(dataRetriever.ValidatorRewardsUnit)
+		require.Nil(t, err)
+		assert.Equal(t, "*disabled.storer", fmt.Sprintf("%T", storer))
+
+		storer, err = storageService.GetStorer(dataRetriever.ValidatorRatingsUnit)
+		require.Nil(t, err)
+		assert.Equal(t, "*disabled.storer", fmt.Sprintf("%T", storer))
+
+		_ = storageService.CloseAll()
+	})
 	t.Run("should work", func(t *testing.T) {
 		t.Parallel()
 
@@ -598,7 +758,8 @@ func TestStorageServiceFactory_CreateForMeta(t *testing.T) {
 		allStorers := storageService.GetAllStorers()
 		missingStorers := 2 // PeerChangesUnit and ShardHdrNonceHashDataUnit
 		numShardHdrStorage := 3
-		expectedStorers := numShardStoreres - missingStorers + numShardHdrStorage
+		numMetaOnlyStorers := 2 // ValidatorRewardsUnit and ValidatorRatingsUnit
+		expectedStorers := numShardStoreres - missingStorers + numShardHdrStorage + numMetaOnlyStorers
 		assert.Equal(t, expectedStorers, len(allStorers))
 
 		storer, _ := storageService.GetStorer(dataRetriever.UserAccountsUnit)
@@ -621,7 +782,8 @@ func TestStorageServiceFactory_CreateForMeta(t *testing.T) {
 		allStorers := storageService.GetAllStorers()
 		missingStorers := 2 // PeerChangesUnit and ShardHdrNonceHashDataUnit
 		numShardHdrStorage := 3
-		expectedStorers := 23 - missingStorers + numShardHdrStorage
+		numMetaOnlyStorers := 2 // ValidatorRewardsUnit and ValidatorRatingsUnit
+		expectedStorers := 23 - missingStorers + numShardHdrStorage + numMetaOnlyStorers
 		assert.Equal(t, expectedStorers, len(allStorers))
 
 		storer, _ := storageService.GetStorer(dataRetriever.UserAccountsUnit)
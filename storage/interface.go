@@ -105,6 +105,16 @@ type PersisterFactory interface {
 	IsInterfaceNil() bool
 }
 
+// ColdArchiver defines what a component able to archive the databases of old epochs and to read from the archives should do
+type ColdArchiver interface {
+	RegisterUnit(unitName string, persisterFactory PersisterFactory, releaseEpochHandler func(epoch uint32) error)
+	ArchiveOldEpochs(currentEpoch uint32)
+	IsEpochArchived(epoch uint32) bool
+	Get(epoch uint32, unitName string, key []byte) ([]byte, error)
+	Close() error
+	IsInterfaceNil() bool
+}
+
 // UnitOpenerHandler defines which actions should be done for opening storage units
 type UnitOpenerHandler interface {
 	OpenDB(dbConfig config.DBConfig, shardID uint32, epoch uint32) (Storer, error)
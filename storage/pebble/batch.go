@@ -0,0 +1,98 @@
+package pebble
+
+import (
+	"sync"
+
+	"github.com/cockroachdb/pebble"
+)
+
+type batch struct {
+	batch       *pebble.Batch
+	cachedData  map[string][]byte
+	removedData map[string]struct{}
+	mutBatch    sync.RWMutex
+}
+
+func newBatch(db *pebble.DB) *batch {
+	return &batch{
+		batch:       db.NewBatch(),
+		cachedData:  make(map[string][]byte),
+		removedData: make(map[string]struct{}),
+	}
+}
+
+// Put inserts one entry - key, value pair - into the batch
+func (b *batch) Put(key []byte, val []byte) error {
+	b.mutBatch.Lock()
+	defer b.mutBatch.Unlock()
+
+	err := b.batch.Set(key, val, nil)
+	if err != nil {
+		return err
+	}
+
+	b.cachedData[string(key)] = val
+	delete(b.removedData, string(key))
+
+	return nil
+}
+
+// Delete deletes the entry for the provided key from the batch
+func (b *batch) Delete(key []byte) error {
+	b.mutBatch.Lock()
+	defer b.mutBatch.Unlock()
+
+	err := b.batch.Delete(key, nil)
+	if err != nil {
+		return err
+	}
+
+	b.removedData[string(key)] = struct{}{}
+	delete(b.cachedData, string(key))
+
+	return nil
+}
+
+// Reset clears the contents of the batch
+func (b *batch) Reset() {
+	b.mutBatch.Lock()
+	b.batch.Reset()
+	b.cachedData = make(map[string][]byte)
+	b.removedData = make(map[string]struct{})
+	b.mutBatch.Unlock()
+}
+
+// Get returns the value
+func (b *batch) Get(key []byte) []byte {
+	b.mutBatch.RLock()
+	defer b.mutBatch.RUnlock()
+
+	return b.cachedData[string(key)]
+}
+
+// IsRemoved returns true if the key is marked for removal
+func (b *batch) IsRemoved(key []byte) bool {
+	b.mutBatch.RLock()
+	defer b.mutBatch.RUnlock()
+
+	_, found := b.removedData[string(key)]
+
+	return found
+}
+
+// commit writes the batch content in the provided database
+func (b *batch) commit(db *pebble.DB) error {
+	b.mutBatch.RLock()
+	defer b.mutBatch.RUnlock()
+
+	if b.batch.Empty() {
+		return nil
+	}
+
+	return db.Apply(b.batch, pebble.Sync)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (b *batch) IsInterfaceNil() bool {
+	return b == nil
+}
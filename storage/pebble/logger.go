@@ -0,0 +1,27 @@
+package pebble
+
+import (
+	"fmt"
+)
+
+// pebbleLogger redirects the messages of the pebble engine towards the node's logger
+type pebbleLogger struct {
+	path string
+}
+
+// Infof logs the pebble informative messages at the trace level as they are very verbose
+func (pl *pebbleLogger) Infof(format string, args ...interface{}) {
+	log.Trace("pebble", "path", pl.path, "message", fmt.Sprintf(format, args...))
+}
+
+// Errorf logs the pebble error messages
+func (pl *pebbleLogger) Errorf(format string, args ...interface{}) {
+	log.Error("pebble", "path", pl.path, "message", fmt.Sprintf(format, args...))
+}
+
+// Fatalf logs the pebble fatal messages and panics, as the pebble engine expects the call to not return
+func (pl *pebbleLogger) Fatalf(format string, args ...interface{}) {
+	message := fmt.Sprintf(format, args...)
+	log.Error("pebble fatal error", "path", pl.path, "message", message)
+	panic(message)
+}
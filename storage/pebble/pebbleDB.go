@@ -0,0 +1,350 @@
+package pebble
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"runtime"
+	"sync"
+	"time"
+
+	"github.com/cockroachdb/pebble"
+	"github.com/multiversx/mx-chain-go/storage"
+	logger "github.com/multiversx/mx-chain-logger-go"
+)
+
+var _ storage.Persister = (*DB)(nil)
+
+// read + write + execute for owner only
+const rwxOwner = 0700
+
+var log = logger.GetOrCreate("storage/pebble")
+
+// DB holds a pointer to the pebble database and the path to where it is stored. The writes are accumulated
+// in a batch that is committed either when it reaches the maximum size or periodically
+type DB struct {
+	path              string
+	readOnly          bool
+	mutDb             sync.RWMutex
+	db                *pebble.DB
+	maxBatchSize      int
+	batchDelaySeconds int
+	sizeBatch         int
+	batch             *batch
+	mutBatch          sync.RWMutex
+	cancel            context.CancelFunc
+}
+
+// NewDB is a constructor for the pebble persister
+// It creates the files in the location given as parameter
+func NewDB(path string, batchDelaySeconds int, maxBatchSize int, maxOpenFiles int) (*DB, error) {
+	if maxOpenFiles < 1 {
+		return nil, storage.ErrInvalidNumOpenFiles
+	}
+
+	err := os.MkdirAll(path, rwxOwner)
+	if err != nil {
+		return nil, err
+	}
+
+	options := &pebble.Options{
+		MaxOpenFiles: maxOpenFiles,
+		Logger:       &pebbleLogger{path: path},
+	}
+	db, err := pebble.Open(path, options)
+	if err != nil {
+		return nil, fmt.Errorf("%w for path %s", err, path)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	dbStore := &DB{
+		path:              path,
+		db:                db,
+		maxBatchSize:      maxBatchSize,
+		batchDelaySeconds: batchDelaySeconds,
+		batch:             newBatch(db),
+		cancel:            cancel,
+	}
+
+	go dbStore.batchTimeoutHandle(ctx)
+
+	runtime.SetFinalizer(dbStore, func(db *DB) {
+		_ = db.Close()
+	})
+
+	log.Debug("opened pebble db persister", "path", path)
+
+	return dbStore, nil
+}
+
+// NewReadOnlyDB opens the pebble persister found in the location given as parameter in read only mode. All the write
+// operations will return ErrPersisterIsReadOnly and nothing is written on disk
+func NewReadOnlyDB(path string, maxOpenFiles int) (*DB, error) {
+	if maxOpenFiles < 1 {
+		return nil, storage.ErrInvalidNumOpenFiles
+	}
+
+	options := &pebble.Options{
+		MaxOpenFiles:     maxOpenFiles,
+		Logger:           &pebbleLogger{path: path},
+		ReadOnly:         true,
+		ErrorIfNotExists: true,
+	}
+	db, err := pebble.Open(path, options)
+	if err != nil {
+		return nil, fmt.Errorf("%w for path %s", err, path)
+	}
+
+	dbStore := &DB{
+		path:     path,
+		readOnly: true,
+		db:       db,
+		batch:    newBatch(db),
+		cancel:   func() {},
+	}
+
+	runtime.SetFinalizer(dbStore, func(db *DB) {
+		_ = db.Close()
+	})
+
+	log.Debug("opened read only pebble db persister", "path", path)
+
+	return dbStore, nil
+}
+
+func (s *DB) batchTimeoutHandle(ctx context.Context) {
+	interval := time.Duration(s.batchDelaySeconds) * time.Second
+	timer := time.NewTimer(interval)
+	defer timer.Stop()
+
+	for {
+		timer.Reset(interval)
+
+		select {
+		case <-timer.C:
+			s.mutBatch.Lock()
+			err := s.commitBatch()
+			if err != nil {
+				log.Warn("pebble commitBatch", "path", s.path, "error", err.Error())
+			}
+			s.mutBatch.Unlock()
+		case <-ctx.Done():
+			log.Debug("closing the timed batch handler", "path", s.path)
+			return
+		}
+	}
+}
+
+func (s *DB) updateBatchWithIncrement() error {
+	s.mutBatch.Lock()
+	defer s.mutBatch.Unlock()
+
+	s.sizeBatch++
+	if s.sizeBatch < s.maxBatchSize {
+		return nil
+	}
+
+	err := s.commitBatch()
+	if err != nil {
+		log.Warn("pebble commitBatch", "path", s.path, "error", err.Error())
+		return err
+	}
+
+	return nil
+}
+
+// commitBatch writes the batch in the database and resets it. The caller should hold the batch mutex
+func (s *DB) commitBatch() error {
+	db := s.getDbPointer()
+	if db == nil {
+		return storage.ErrDBIsClosed
+	}
+
+	err := s.batch.commit(db)
+	if err != nil {
+		return err
+	}
+
+	s.batch.Reset()
+	s.sizeBatch = 0
+
+	return nil
+}
+
+// Put adds the value to the (key, val) storage medium
+func (s *DB) Put(key, val []byte) error {
+	if s.readOnly {
+		return storage.ErrPersisterIsReadOnly
+	}
+
+	s.mutBatch.RLock()
+	err := s.batch.Put(key, val)
+	s.mutBatch.RUnlock()
+	if err != nil {
+		return err
+	}
+
+	return s.updateBatchWithIncrement()
+}
+
+// Get returns the value associated to the key
+func (s *DB) Get(key []byte) ([]byte, error) {
+	db := s.getDbPointer()
+	if db == nil {
+		return nil, storage.ErrDBIsClosed
+	}
+
+	if s.batch.IsRemoved(key) {
+		return nil, storage.ErrKeyNotFound
+	}
+
+	data := s.batch.Get(key)
+	if data != nil {
+		return data, nil
+	}
+
+	value, closer, err := db.Get(key)
+	if errors.Is(err, pebble.ErrNotFound) {
+		return nil, storage.ErrKeyNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	// the returned value is valid only until the closer is called
+	data = make([]byte, len(value))
+	copy(data, value)
+
+	return data, closer.Close()
+}
+
+// Has returns nil if the given key is present in the persistence medium
+func (s *DB) Has(key []byte) error {
+	_, err := s.Get(key)
+
+	return err
+}
+
+// RangeKeys will call the handler function for each (key, value) pair
+// If the handler returns true, the iteration will continue, otherwise will stop
+func (s *DB) RangeKeys(handler func(key []byte, value []byte) bool) {
+	if handler == nil {
+		return
+	}
+
+	db := s.getDbPointer()
+	if db == nil {
+		return
+	}
+
+	iterator, err := db.NewIter(nil)
+	if err != nil {
+		log.Warn("pebble RangeKeys: cannot create iterator", "path", s.path, "error", err.Error())
+		return
+	}
+
+	for iterator.First(); iterator.Valid(); iterator.Next() {
+		key := iterator.Key()
+		clonedKey := make([]byte, len(key))
+		copy(clonedKey, key)
+
+		val := iterator.Value()
+		clonedVal := make([]byte, len(val))
+		copy(clonedVal, val)
+
+		shouldContinue := handler(clonedKey, clonedVal)
+		if !shouldContinue {
+			break
+		}
+	}
+
+	err = iterator.Close()
+	if err != nil {
+		log.Warn("pebble RangeKeys: cannot close iterator", "path", s.path, "error", err.Error())
+	}
+}
+
+// Close closes the files/resources associated to the storage medium
+func (s *DB) Close() error {
+	if !s.readOnly {
+		s.mutBatch.Lock()
+		_ = s.commitBatch()
+		s.mutBatch.Unlock()
+	}
+
+	s.cancel()
+	db := s.makeDbPointerNilReturningLast()
+	if db != nil {
+		return db.Close()
+	}
+
+	return nil
+}
+
+// Remove removes the data associated to the given key
+func (s *DB) Remove(key []byte) error {
+	if s.readOnly {
+		return storage.ErrPersisterIsReadOnly
+	}
+
+	s.mutBatch.Lock()
+	_ = s.batch.Delete(key)
+	s.mutBatch.Unlock()
+
+	return s.updateBatchWithIncrement()
+}
+
+// Destroy removes the storage medium stored data
+func (s *DB) Destroy() error {
+	if s.readOnly {
+		return storage.ErrPersisterIsReadOnly
+	}
+
+	s.mutBatch.Lock()
+	s.batch.Reset()
+	s.sizeBatch = 0
+	s.mutBatch.Unlock()
+
+	s.cancel()
+	db := s.makeDbPointerNilReturningLast()
+	if db != nil {
+		err := db.Close()
+		if err != nil {
+			return err
+		}
+	}
+
+	return os.RemoveAll(s.path)
+}
+
+// DestroyClosed removes the already closed storage medium stored data
+func (s *DB) DestroyClosed() error {
+	if s.readOnly {
+		return storage.ErrPersisterIsReadOnly
+	}
+
+	return os.RemoveAll(s.path)
+}
+
+func (s *DB) getDbPointer() *pebble.DB {
+	s.mutDb.RLock()
+	defer s.mutDb.RUnlock()
+
+	return s.db
+}
+
+func (s *DB) makeDbPointerNilReturningLast() *pebble.DB {
+	s.mutDb.Lock()
+	defer s.mutDb.Unlock()
+
+	db := s.db
+	s.db = nil
+
+	return db
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (s *DB) IsInterfaceNil() bool {
+	return s == nil
+}
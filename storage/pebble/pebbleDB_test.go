@@ -0,0 +1,273 @@
+package pebble_test
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/multiversx/mx-chain-go/storage"
+	"github.com/multiversx/mx-chain-go/storage/pebble"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createPebbleDb(t *testing.T, batchDelaySeconds int, maxBatchSize int) *pebble.DB {
+	db, err := pebble.NewDB(t.TempDir(), batchDelaySeconds, maxBatchSize, 10)
+	require.Nil(t, err)
+
+	t.Cleanup(func() {
+		_ = db.Close()
+	})
+
+	return db
+}
+
+func TestNewDB(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid max open files should error", func(t *testing.T) {
+		t.Parallel()
+
+		db, err := pebble.NewDB(t.TempDir(), 10, 1, 0)
+		assert.Nil(t, db)
+		assert.Equal(t, storage.ErrInvalidNumOpenFiles, err)
+	})
+	t.Run("double open should error", func(t *testing.T) {
+		t.Parallel()
+
+		dir := t.TempDir()
+		db, err := pebble.NewDB(dir, 10, 1, 10)
+		require.Nil(t, err)
+		defer func() {
+			_ = db.Close()
+		}()
+
+		_, err = pebble.NewDB(dir, 10, 1, 10)
+		assert.NotNil(t, err)
+	})
+	t.Run("reopen should keep the data", func(t *testing.T) {
+		t.Parallel()
+
+		dir := t.TempDir()
+		db, err := pebble.NewDB(dir, 10, 100, 10)
+		require.Nil(t, err)
+
+		err = db.Put([]byte("key"), []byte("value"))
+		require.Nil(t, err)
+		err = db.Close()
+		require.Nil(t, err)
+
+		db, err = pebble.NewDB(dir, 10, 100, 10)
+		require.Nil(t, err)
+		defer func() {
+			_ = db.Close()
+		}()
+
+		value, err := db.Get([]byte("key"))
+		assert.Nil(t, err)
+		assert.Equal(t, []byte("value"), value)
+	})
+}
+
+func TestNewReadOnlyDB(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid max open files should error", func(t *testing.T) {
+		t.Parallel()
+
+		db, err := pebble.NewReadOnlyDB(t.TempDir(), 0)
+		assert.Nil(t, db)
+		assert.Equal(t, storage.ErrInvalidNumOpenFiles, err)
+	})
+	t.Run("missing database should error and not create it", func(t *testing.T) {
+		t.Parallel()
+
+		dir := filepath.Join(t.TempDir(), "missing")
+		db, err := pebble.NewReadOnlyDB(dir, 10)
+		assert.Nil(t, db)
+		assert.NotNil(t, err)
+
+		_, err = os.Stat(dir)
+		assert.True(t, os.IsNotExist(err))
+	})
+	t.Run("should read the data and reject the writes", func(t *testing.T) {
+		t.Parallel()
+
+		dir := t.TempDir()
+		db, err := pebble.NewDB(dir, 10, 100, 10)
+		require.Nil(t, err)
+		err = db.Put([]byte("key"), []byte("value"))
+		require.Nil(t, err)
+		err = db.Close()
+		require.Nil(t, err)
+
+		readOnlyDB, err := pebble.NewReadOnlyDB(dir, 10)
+		require.Nil(t, err)
+
+		value, err := readOnlyDB.Get([]byte("key"))
+		assert.Nil(t, err)
+		assert.Equal(t, []byte("value"), value)
+		assert.Equal(t, storage.ErrPersisterIsReadOnly, readOnlyDB.Put([]byte("key2"), []byte("value2")))
+		assert.Equal(t, storage.ErrPersisterIsReadOnly, readOnlyDB.Remove([]byte("key")))
+		assert.Equal(t, storage.ErrPersisterIsReadOnly, readOnlyDB.Destroy())
+		assert.Equal(t, storage.ErrPersisterIsReadOnly, readOnlyDB.DestroyClosed())
+		assert.Nil(t, readOnlyDB.Close())
+	})
+}
+
+func TestDB_PutGetBeforeAndAfterBatchCommit(t *testing.T) {
+	t.Parallel()
+
+	db := createPebbleDb(t, 1, 100)
+	key, value := []byte("key"), []byte("value")
+
+	err := db.Put(key, value)
+	require.Nil(t, err)
+
+	retrieved, err := db.Get(key)
+	assert.Nil(t, err)
+	assert.Equal(t, value, retrieved)
+
+	time.Sleep(time.Second * 2)
+
+	retrieved, err = db.Get(key)
+	assert.Nil(t, err)
+	assert.Equal(t, value, retrieved)
+}
+
+func TestDB_GetNotPresent(t *testing.T) {
+	t.Parallel()
+
+	db := createPebbleDb(t, 10, 1)
+
+	value, err := db.Get([]byte("missing"))
+	assert.Nil(t, value)
+	assert.Equal(t, storage.ErrKeyNotFound, err)
+	assert.Equal(t, storage.ErrKeyNotFound, db.Has([]byte("missing")))
+}
+
+func TestDB_Remove(t *testing.T) {
+	t.Parallel()
+
+	t.Run("remove before the batch commit", func(t *testing.T) {
+		t.Parallel()
+
+		db := createPebbleDb(t, 10, 100)
+		key := []byte("key")
+
+		_ = db.Put(key, []byte("value"))
+		err := db.Remove(key)
+		assert.Nil(t, err)
+		assert.Equal(t, storage.ErrKeyNotFound, db.Has(key))
+	})
+	t.Run("remove after the batch commit", func(t *testing.T) {
+		t.Parallel()
+
+		db := createPebbleDb(t, 10, 1)
+		key := []byte("key")
+
+		_ = db.Put(key, []byte("value"))
+		assert.Nil(t, db.Has(key))
+
+		err := db.Remove(key)
+		assert.Nil(t, err)
+		assert.Equal(t, storage.ErrKeyNotFound, db.Has(key))
+	})
+}
+
+func TestDB_RangeKeys(t *testing.T) {
+	t.Parallel()
+
+	db := createPebbleDb(t, 10, 1)
+	keysVals := map[string][]byte{
+		"key1": []byte("value1"),
+		"key2": []byte("value2"),
+		"key3": []byte("value3"),
+	}
+	for key, val := range keysVals {
+		_ = db.Put([]byte(key), val)
+	}
+
+	recovered := make(map[string][]byte)
+	db.RangeKeys(func(key []byte, value []byte) bool {
+		recovered[string(key)] = value
+		return true
+	})
+	assert.Equal(t, keysVals, recovered)
+
+	numCalls := 0
+	db.RangeKeys(func(key []byte, value []byte) bool {
+		numCalls++
+		return false
+	})
+	assert.Equal(t, 1, numCalls)
+
+	assert.NotPanics(t, func() {
+		db.RangeKeys(nil)
+	})
+}
+
+func TestDB_MethodCallsAfterCloseOrDestroy(t *testing.T) {
+	t.Parallel()
+
+	t.Run("close", func(t *testing.T) {
+		t.Parallel()
+
+		db, _ := pebble.NewDB(t.TempDir(), 10, 1, 10)
+		err := db.Close()
+		require.Nil(t, err)
+
+		_, err = db.Get([]byte("key"))
+		assert.Equal(t, storage.ErrDBIsClosed, err)
+		assert.Equal(t, storage.ErrDBIsClosed, db.Has([]byte("key")))
+		assert.Nil(t, db.Close())
+		db.RangeKeys(func(key []byte, value []byte) bool {
+			assert.Fail(t, "should have not been called")
+			return true
+		})
+	})
+	t.Run("destroy", func(t *testing.T) {
+		t.Parallel()
+
+		db, _ := pebble.NewDB(t.TempDir(), 10, 1, 10)
+		err := db.Destroy()
+		require.Nil(t, err)
+
+		_, err = db.Get([]byte("key"))
+		assert.Equal(t, storage.ErrDBIsClosed, err)
+		assert.Nil(t, db.DestroyClosed())
+	})
+}
+
+func TestDB_ConcurrentOperations(t *testing.T) {
+	t.Parallel()
+
+	db := createPebbleDb(t, 1, 5)
+
+	numOperations := 1000
+	wg := sync.WaitGroup{}
+	wg.Add(numOperations)
+	for i := 0; i < numOperations; i++ {
+		go func(idx int) {
+			defer wg.Done()
+
+			key := []byte(fmt.Sprintf("key%d", idx%10))
+			switch idx % 4 {
+			case 0:
+				_ = db.Put(key, []byte("value"))
+			case 1:
+				_, _ = db.Get(key)
+			case 2:
+				_ = db.Remove(key)
+			case 3:
+				db.RangeKeys(func(key []byte, value []byte) bool {
+					return true
+				})
+			}
+		}(i)
+	}
+	wg.Wait()
+}
@@ -0,0 +1,65 @@
+package pruning
+
+import (
+	"github.com/multiversx/mx-chain-go/storage"
+)
+
+// archivedEpochPersister is a read-only persister which serves the data of an archived epoch through the cold archiver
+type archivedEpochPersister struct {
+	coldArchiver storage.ColdArchiver
+	epoch        uint32
+	unitName     string
+}
+
+func newArchivedEpochPersister(coldArchiver storage.ColdArchiver, epoch uint32, unitName string) *archivedEpochPersister {
+	return &archivedEpochPersister{
+		coldArchiver: coldArchiver,
+		epoch:        epoch,
+		unitName:     unitName,
+	}
+}
+
+// Put returns ErrArchivedEpochIsReadOnly
+func (aep *archivedEpochPersister) Put(_, _ []byte) error {
+	return storage.ErrArchivedEpochIsReadOnly
+}
+
+// Get returns the value of the key from the epoch's archive
+func (aep *archivedEpochPersister) Get(key []byte) ([]byte, error) {
+	return aep.coldArchiver.Get(aep.epoch, aep.unitName, key)
+}
+
+// Has returns nil if the key is present in the epoch's archive
+func (aep *archivedEpochPersister) Has(key []byte) error {
+	_, err := aep.Get(key)
+	return err
+}
+
+// Close returns nil as the archives are closed by the cold archiver
+func (aep *archivedEpochPersister) Close() error {
+	return nil
+}
+
+// Remove returns ErrArchivedEpochIsReadOnly
+func (aep *archivedEpochPersister) Remove(_ []byte) error {
+	return storage.ErrArchivedEpochIsReadOnly
+}
+
+// Destroy returns ErrArchivedEpochIsReadOnly
+func (aep *archivedEpochPersister) Destroy() error {
+	return storage.ErrArchivedEpochIsReadOnly
+}
+
+// DestroyClosed returns ErrArchivedEpochIsReadOnly
+func (aep *archivedEpochPersister) DestroyClosed() error {
+	return storage.ErrArchivedEpochIsReadOnly
+}
+
+// RangeKeys does nothing as the archives can not be iterated
+func (aep *archivedEpochPersister) RangeKeys(_ func(key []byte, val []byte) bool) {
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (aep *archivedEpochPersister) IsInterfaceNil() bool {
+	return aep == nil
+}
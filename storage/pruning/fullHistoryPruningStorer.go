@@ -5,18 +5,24 @@ import (
 	"fmt"
 	"math"
 
+	"github.com/multiversx/mx-chain-core-go/core/check"
 	"github.com/multiversx/mx-chain-core-go/data"
+	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/epochStart/notifier"
 	"github.com/multiversx/mx-chain-go/storage"
 	"github.com/multiversx/mx-chain-go/storage/cache"
 )
 
 // FullHistoryPruningStorer represents a storer for full history nodes
-// which creates a new persister for each epoch and removes older activePersisters
+// which creates a new persister for each epoch and removes older activePersisters.
+// The epochs moved in the cold archive are read through the cold archiver
 type FullHistoryPruningStorer struct {
 	*PruningStorer
 	args                           StorerArgs
 	shardId                        string
+	unitName                       string
 	oldEpochsActivePersistersCache storage.Cacher
+	coldArchiver                   storage.ColdArchiver
 }
 
 // NewFullHistoryPruningStorer will return a new instance of PruningStorer without sharded directories' naming scheme
@@ -38,6 +44,12 @@ func initFullHistoryPruningStorer(args FullHistoryStorerArgs, shardId string) (*
 	if err != nil {
 		return nil, err
 	}
+	if check.IfNil(args.ColdArchiver) {
+		return nil, storage.ErrNilColdArchiver
+	}
+	if check.IfNil(args.ArchivePersisterFactory) {
+		return nil, storage.ErrNilArchivePersisterFactory
+	}
 
 	activePersisters, persistersMapByEpoch, err := initPersistersInEpoch(args.StorerArgs, shardId)
 	if err != nil {
@@ -59,15 +71,61 @@ func initFullHistoryPruningStorer(args FullHistoryStorerArgs, shardId string) (*
 		PruningStorer: ps,
 		args:          args.StorerArgs,
 		shardId:       shardId,
+		unitName:      args.Identifier + shardId,
+		coldArchiver:  args.ColdArchiver,
 	}
 	fhps.oldEpochsActivePersistersCache, err = cache.NewLRUCacheWithEviction(int(args.NumOfOldActivePersisters), fhps.onEvicted)
 	if err != nil {
 		return nil, err
 	}
 
+	// the archived epochs are never written, so they are opened in read only mode
+	fhps.coldArchiver.RegisterUnit(fhps.unitName, args.ArchivePersisterFactory, fhps.releaseArchivedEpoch)
+	fhps.registerColdArchiverHandler(args.Notifier)
+
 	return fhps, nil
 }
 
+// registerColdArchiverHandler requests the archiving of the old epochs on each epoch change. The archiving is done on a
+// background go routine by the cold archiver
+func (fhps *FullHistoryPruningStorer) registerColdArchiverHandler(handler EpochStartNotifier) {
+	subscribeHandler := notifier.NewHandlerForEpochStart(
+		func(hdr data.HeaderHandler) {
+			fhps.coldArchiver.ArchiveOldEpochs(hdr.GetEpoch())
+		},
+		func(_ data.HeaderHandler) {},
+		common.StorerOrder)
+
+	handler.RegisterHandler(subscribeHandler)
+}
+
+// releaseArchivedEpoch closes the persister opened for an epoch which is about to be archived. The next reads of the
+// epoch are served by the cold archiver
+func (fhps *FullHistoryPruningStorer) releaseArchivedEpoch(epoch uint32) error {
+	fhps.lock.Lock()
+	defer fhps.lock.Unlock()
+
+	for _, pdata := range fhps.activePersisters {
+		if pdata.epoch == epoch {
+			return fmt.Errorf("%w: %d", storage.ErrArchivedEpochStillActive, epoch)
+		}
+	}
+
+	epochString := fmt.Sprintf("%d", epoch)
+	pdata, exists := fhps.getPersisterData(epochString, epoch)
+	if !exists {
+		return nil
+	}
+
+	fhps.oldEpochsActivePersistersCache.Remove([]byte(epochString))
+	delete(fhps.persistersMapByEpoch, epoch)
+	if pdata.getIsClosed() {
+		return nil
+	}
+
+	return pdata.Close()
+}
+
 // GetFromEpoch will search a key only in the persister for the given epoch
 func (fhps *FullHistoryPruningStorer) GetFromEpoch(key []byte, epoch uint32) ([]byte, error) {
 	value, err := fhps.searchInEpoch(key, epoch)
@@ -177,6 +235,10 @@ func (fhps *FullHistoryPruningStorer) getOrOpenPersister(epoch uint32) (storage.
 	defer fhps.lock.Unlock()
 
 	pdata, exists = fhps.getPersisterData(epochString, epoch)
+	if !exists && fhps.coldArchiver.IsEpochArchived(epoch) {
+		// opening a persister would create an empty database in place of the archived one
+		return newArchivedEpochPersister(fhps.coldArchiver, epoch, fhps.unitName), nil
+	}
 	if !exists {
 		newPdata, errPersisterData := createPersisterDataForEpoch(fhps.args, epoch, fhps.shardId)
 		if errPersisterData != nil {
@@ -216,10 +278,15 @@ func (fhps *FullHistoryPruningStorer) getPersisterData(epochString string, epoch
 	return nil, false
 }
 
-// Close will try to close all opened persisters, including the ones in the LRU cache
+// Close will try to close all opened persisters, including the ones in the LRU cache, and the opened archives
 func (fhps *FullHistoryPruningStorer) Close() error {
 	fhps.oldEpochsActivePersistersCache.Clear()
 
+	err := fhps.coldArchiver.Close()
+	if err != nil {
+		log.Warn("FullHistoryPruningStorer: error closing the cold archiver", "id", fhps.identifier, "error", err)
+	}
+
 	return fhps.PruningStorer.Close()
 }
 
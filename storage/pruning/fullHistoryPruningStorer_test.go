@@ -3,6 +3,7 @@ package pruning_test
 import (
 	"context"
 	"crypto/rand"
+	"errors"
 	"fmt"
 	"math"
 	"path/filepath"
@@ -12,12 +13,17 @@ import (
 
 	"github.com/multiversx/mx-chain-core-go/core/random"
 	"github.com/multiversx/mx-chain-core-go/data"
+	"github.com/multiversx/mx-chain-core-go/data/block"
 	"github.com/multiversx/mx-chain-go/config"
+	"github.com/multiversx/mx-chain-go/epochStart"
 	"github.com/multiversx/mx-chain-go/storage"
 	"github.com/multiversx/mx-chain-go/storage/database"
+	"github.com/multiversx/mx-chain-go/storage/disabled"
 	"github.com/multiversx/mx-chain-go/storage/factory"
+	"github.com/multiversx/mx-chain-go/storage/mock"
 	"github.com/multiversx/mx-chain-go/storage/pathmanager"
 	"github.com/multiversx/mx-chain-go/storage/pruning"
+	storageStubs "github.com/multiversx/mx-chain-go/testscommon/storage"
 	logger "github.com/multiversx/mx-chain-logger-go"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -30,6 +36,7 @@ func TestNewFullHistoryPruningStorer_OkValsShouldWork(t *testing.T) {
 	fhArgs := pruning.FullHistoryStorerArgs{
 		StorerArgs:               args,
 		NumOfOldActivePersisters: 10,
+		ColdArchiver:             disabled.NewColdArchiver(),
 	}
 	fhps, err := pruning.NewFullHistoryPruningStorer(fhArgs)
 
@@ -45,6 +52,7 @@ func TestNewFullHistoryPruningStorer_InvalidNumberOfActivePersistersShouldErr(t
 	fhArgs := pruning.FullHistoryStorerArgs{
 		StorerArgs:               args,
 		NumOfOldActivePersisters: 0,
+		ColdArchiver:             disabled.NewColdArchiver(),
 	}
 	fhps, err := pruning.NewFullHistoryPruningStorer(fhArgs)
 
@@ -54,6 +62,7 @@ func TestNewFullHistoryPruningStorer_InvalidNumberOfActivePersistersShouldErr(t
 	fhArgs = pruning.FullHistoryStorerArgs{
 		StorerArgs:               args,
 		NumOfOldActivePersisters: math.MaxInt32 + 1,
+		ColdArchiver:             disabled.NewColdArchiver(),
 	}
 	fhps, err = pruning.NewFullHistoryPruningStorer(fhArgs)
 
@@ -61,6 +70,169 @@ func TestNewFullHistoryPruningStorer_InvalidNumberOfActivePersistersShouldErr(t
 	assert.Equal(t, storage.ErrInvalidNumberOfOldPersisters, err)
 }
 
+func TestNewFullHistoryPruningStorer_NilColdArchiverShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := getDefaultArgs()
+	fhArgs := pruning.FullHistoryStorerArgs{
+		StorerArgs:               args,
+		NumOfOldActivePersisters: 10,
+		ColdArchiver:             nil,
+	}
+	fhps, err := pruning.NewFullHistoryPruningStorer(fhArgs)
+
+	assert.Nil(t, fhps)
+	assert.Equal(t, storage.ErrNilColdArchiver, err)
+}
+
+func TestNewFullHistoryPruningStorer_NilArchivePersisterFactoryShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := getDefaultArgs()
+	args.ArchivePersisterFactory = nil
+	fhArgs := pruning.FullHistoryStorerArgs{
+		StorerArgs:               args,
+		NumOfOldActivePersisters: 10,
+		ColdArchiver:             disabled.NewColdArchiver(),
+	}
+	fhps, err := pruning.NewFullHistoryPruningStorer(fhArgs)
+
+	assert.Nil(t, fhps)
+	assert.Equal(t, storage.ErrNilArchivePersisterFactory, err)
+}
+
+func TestFullHistoryPruningStorer_ArchivedEpochShouldBeReadFromColdArchive(t *testing.T) {
+	t.Parallel()
+
+	archivedEpoch := uint32(3)
+	testKey, testVal := []byte("key"), []byte("value")
+	registeredUnits := make([]string, 0)
+	archivedKeysRequested := 0
+	archivePersisterFactory := &mock.PersisterFactoryStub{}
+	coldArchiver := &storageStubs.ColdArchiverStub{
+		RegisterUnitCalled: func(unitName string, persisterFactory storage.PersisterFactory, releaseEpochHandler func(epoch uint32) error) {
+			assert.True(t, persisterFactory == archivePersisterFactory)
+			assert.NotNil(t, releaseEpochHandler)
+			registeredUnits = append(registeredUnits, unitName)
+		},
+		IsEpochArchivedCalled: func(epoch uint32) bool {
+			return epoch == archivedEpoch
+		},
+		GetCalled: func(epoch uint32, unitName string, key []byte) ([]byte, error) {
+			archivedKeysRequested++
+			assert.Equal(t, archivedEpoch, epoch)
+			assert.Equal(t, "id0", unitName)
+			if string(key) == string(testKey) {
+				return testVal, nil
+			}
+
+			return nil, storage.ErrKeyNotFound
+		},
+	}
+
+	args := getDefaultArgs()
+	args.ArchivePersisterFactory = archivePersisterFactory
+	createdPaths := make([]string, 0)
+	persisterFactory := args.PersisterFactory
+	args.PersisterFactory = &mock.PersisterFactoryStub{
+		CreateCalled: func(path string) (storage.Persister, error) {
+			createdPaths = append(createdPaths, path)
+			return persisterFactory.Create(path)
+		},
+	}
+	fhArgs := pruning.FullHistoryStorerArgs{
+		StorerArgs:               args,
+		NumOfOldActivePersisters: 2,
+		ColdArchiver:             coldArchiver,
+	}
+	fhps, err := pruning.NewShardedFullHistoryPruningStorer(fhArgs, 0)
+	require.Nil(t, err)
+	require.Equal(t, []string{"id0"}, registeredUnits)
+	numCreatedPersisters := len(createdPaths)
+
+	res, err := fhps.GetFromEpoch(testKey, archivedEpoch)
+	assert.Nil(t, err)
+	assert.Equal(t, testVal, res)
+
+	results, err := fhps.GetBulkFromEpoch([][]byte{testKey, []byte("missing key")}, archivedEpoch)
+	assert.Nil(t, err)
+	assert.Equal(t, []data.KeyValuePair{{Key: testKey, Value: testVal}}, results)
+
+	err = fhps.PutInEpoch([]byte("new key"), testVal, archivedEpoch)
+	assert.Equal(t, storage.ErrArchivedEpochIsReadOnly, err)
+
+	assert.Equal(t, 3, archivedKeysRequested)
+	// no persister should have been created for the archived epoch
+	assert.Equal(t, numCreatedPersisters, len(createdPaths))
+}
+
+func TestFullHistoryPruningStorer_ReleaseArchivedEpoch(t *testing.T) {
+	t.Parallel()
+
+	var releaseEpoch func(epoch uint32) error
+	coldArchiver := &storageStubs.ColdArchiverStub{
+		RegisterUnitCalled: func(unitName string, persisterFactory storage.PersisterFactory, releaseEpochHandler func(epoch uint32) error) {
+			releaseEpoch = releaseEpochHandler
+		},
+	}
+	fhArgs := pruning.FullHistoryStorerArgs{
+		StorerArgs:               getDefaultArgs(),
+		NumOfOldActivePersisters: 2,
+		ColdArchiver:             coldArchiver,
+	}
+	fhps, err := pruning.NewFullHistoryPruningStorer(fhArgs)
+	require.Nil(t, err)
+	require.NotNil(t, releaseEpoch)
+
+	t.Run("active epoch should error", func(t *testing.T) {
+		err = releaseEpoch(0)
+		assert.True(t, errors.Is(err, storage.ErrArchivedEpochStillActive))
+		assert.Contains(t, fhps.PersistersMapByEpochToSlice(), uint32(0))
+	})
+	t.Run("not opened epoch should work", func(t *testing.T) {
+		assert.Nil(t, releaseEpoch(20))
+	})
+	t.Run("opened epoch should be closed and forgotten", func(t *testing.T) {
+		// opens the persisters of the epochs 7 and 8
+		_, _ = fhps.GetFromEpoch([]byte("key"), 7)
+		require.True(t, fhps.GetOldEpochsActivePersisters().Has([]byte("7")))
+
+		assert.Nil(t, releaseEpoch(7))
+		assert.False(t, fhps.GetOldEpochsActivePersisters().Has([]byte("7")))
+		assert.NotContains(t, fhps.PersistersMapByEpochToSlice(), uint32(7))
+		assert.True(t, fhps.GetOldEpochsActivePersisters().Has([]byte("8")))
+	})
+}
+
+func TestFullHistoryPruningStorer_EpochChangeShouldRequestTheArchiving(t *testing.T) {
+	t.Parallel()
+
+	handlers := make([]epochStart.ActionHandler, 0)
+	args := getDefaultArgs()
+	args.Notifier = &mock.EpochStartNotifierStub{
+		RegisterHandlerCalled: func(handler epochStart.ActionHandler) {
+			handlers = append(handlers, handler)
+		},
+	}
+	requestedEpochs := make([]uint32, 0)
+	fhArgs := pruning.FullHistoryStorerArgs{
+		StorerArgs:               args,
+		NumOfOldActivePersisters: 2,
+		ColdArchiver: &storageStubs.ColdArchiverStub{
+			ArchiveOldEpochsCalled: func(currentEpoch uint32) {
+				requestedEpochs = append(requestedEpochs, currentEpoch)
+			},
+		},
+	}
+	_, err := pruning.NewFullHistoryPruningStorer(fhArgs)
+	require.Nil(t, err)
+
+	for _, handler := range handlers {
+		handler.EpochStartAction(&block.MetaBlock{Epoch: 5})
+	}
+	assert.Equal(t, []uint32{5}, requestedEpochs)
+}
+
 func TestNewFullHistoryPruningStorer_PutAndGetInEpochShouldWork(t *testing.T) {
 	t.Parallel()
 
@@ -68,6 +240,7 @@ func TestNewFullHistoryPruningStorer_PutAndGetInEpochShouldWork(t *testing.T) {
 	fhArgs := pruning.FullHistoryStorerArgs{
 		StorerArgs:               args,
 		NumOfOldActivePersisters: 2,
+		ColdArchiver:             disabled.NewColdArchiver(),
 	}
 	fhps, _ := pruning.NewFullHistoryPruningStorer(fhArgs)
 
@@ -90,6 +263,7 @@ func TestNewFullHistoryPruningStorer_GetMultipleDifferentEpochsShouldEvict(t *te
 	fhArgs := pruning.FullHistoryStorerArgs{
 		StorerArgs:               args,
 		NumOfOldActivePersisters: 3,
+		ColdArchiver:             disabled.NewColdArchiver(),
 	}
 	fhps, _ := pruning.NewFullHistoryPruningStorer(fhArgs)
 
@@ -123,6 +297,7 @@ func TestNewFullHistoryPruningStorer_GetAfterEvictShouldWork(t *testing.T) {
 	fhArgs := pruning.FullHistoryStorerArgs{
 		StorerArgs:               args,
 		NumOfOldActivePersisters: 3,
+		ColdArchiver:             disabled.NewColdArchiver(),
 	}
 	fhps, _ := pruning.NewFullHistoryPruningStorer(fhArgs)
 	testVal := []byte("value")
@@ -157,6 +332,7 @@ func TestNewFullHistoryPruningStorer_GetFromEpochShouldSearchAlsoInNext(t *testi
 	fhArgs := pruning.FullHistoryStorerArgs{
 		StorerArgs:               args,
 		NumOfOldActivePersisters: 5,
+		ColdArchiver:             disabled.NewColdArchiver(),
 	}
 	fhps, _ := pruning.NewFullHistoryPruningStorer(fhArgs)
 	testVal := []byte("value")
@@ -184,6 +360,7 @@ func TestNewFullHistoryPruningStorer_GetBulkFromEpoch(t *testing.T) {
 	fhArgs := pruning.FullHistoryStorerArgs{
 		StorerArgs:               args,
 		NumOfOldActivePersisters: 5,
+		ColdArchiver:             disabled.NewColdArchiver(),
 	}
 	fhps, _ := pruning.NewFullHistoryPruningStorer(fhArgs)
 	testVal0, testVal1 := []byte("value0"), []byte("value1")
@@ -210,6 +387,7 @@ func TestNewFullHistoryPruningStorer_GetBulkFromEpochShouldNotLoadFromCache(t *t
 	fhArgs := pruning.FullHistoryStorerArgs{
 		StorerArgs:               args,
 		NumOfOldActivePersisters: 5,
+		ColdArchiver:             disabled.NewColdArchiver(),
 	}
 	fhps, _ := pruning.NewFullHistoryPruningStorer(fhArgs)
 	testVal0, testVal1 := []byte("value0"), []byte("value1")
@@ -238,6 +416,7 @@ func TestFullHistoryPruningStorer_IsEpochActive(t *testing.T) {
 	fhArgs := pruning.FullHistoryStorerArgs{
 		StorerArgs:               args,
 		NumOfOldActivePersisters: 2,
+		ColdArchiver:             disabled.NewColdArchiver(),
 	}
 	fhps, _ := pruning.NewFullHistoryPruningStorer(fhArgs)
 	testEpoch := uint32(7)
@@ -257,6 +436,7 @@ func TestNewFullHistoryShardedPruningStorer_ShouldWork(t *testing.T) {
 	fhArgs := pruning.FullHistoryStorerArgs{
 		StorerArgs:               args,
 		NumOfOldActivePersisters: 5,
+		ColdArchiver:             disabled.NewColdArchiver(),
 	}
 	fhps, err := pruning.NewShardedFullHistoryPruningStorer(fhArgs, 2)
 
@@ -271,6 +451,7 @@ func TestFullHistoryPruningStorer_Close(t *testing.T) {
 	fhArgs := pruning.FullHistoryStorerArgs{
 		StorerArgs:               args,
 		NumOfOldActivePersisters: 5,
+		ColdArchiver:             disabled.NewColdArchiver(),
 	}
 	fhps, _ := pruning.NewShardedFullHistoryPruningStorer(fhArgs, 2)
 	for i := 0; i < 10; i++ {
@@ -309,6 +490,7 @@ func TestFullHistoryPruningStorer_ConcurrentOperations(t *testing.T) {
 	fhArgs := pruning.FullHistoryStorerArgs{
 		StorerArgs:               args,
 		NumOfOldActivePersisters: 2,
+		ColdArchiver:             disabled.NewColdArchiver(),
 	}
 
 	fhps, _ := pruning.NewFullHistoryPruningStorer(fhArgs)
@@ -395,6 +577,7 @@ func TestFullHistoryPruningStorer_IsInterfaceNil(t *testing.T) {
 	fhArgs := pruning.FullHistoryStorerArgs{
 		StorerArgs:               args,
 		NumOfOldActivePersisters: 10,
+		ColdArchiver:             disabled.NewColdArchiver(),
 	}
 	fhps, _ = pruning.NewFullHistoryPruningStorer(fhArgs)
 	require.False(t, fhps.IsInterfaceNil())
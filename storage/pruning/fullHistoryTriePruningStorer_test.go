@@ -4,6 +4,7 @@ import (
 	"testing"
 
 	"github.com/multiversx/mx-chain-core-go/data"
+	"github.com/multiversx/mx-chain-go/storage/disabled"
 	"github.com/multiversx/mx-chain-go/storage/pruning"
 	"github.com/multiversx/mx-chain-go/testscommon/storage"
 	"github.com/stretchr/testify/assert"
@@ -17,6 +18,7 @@ func TestNewFullHistoryTriePruningStorer(t *testing.T) {
 	fhArgs := pruning.FullHistoryStorerArgs{
 		StorerArgs:               args,
 		NumOfOldActivePersisters: 10,
+		ColdArchiver:             disabled.NewColdArchiver(),
 	}
 	fhps, err := pruning.NewFullHistoryTriePruningStorer(fhArgs)
 	assert.Nil(t, err)
@@ -33,6 +35,7 @@ func TestFullHistoryTriePruningStorer_CallsMethodsFromUndelyingFHPS(t *testing.T
 		fhArgs := pruning.FullHistoryStorerArgs{
 			StorerArgs:               args,
 			NumOfOldActivePersisters: 10,
+			ColdArchiver:             disabled.NewColdArchiver(),
 		}
 		fhps, _ := pruning.NewFullHistoryTriePruningStorer(fhArgs)
 
@@ -56,6 +59,7 @@ func TestFullHistoryTriePruningStorer_CallsMethodsFromUndelyingFHPS(t *testing.T
 		fhArgs := pruning.FullHistoryStorerArgs{
 			StorerArgs:               args,
 			NumOfOldActivePersisters: 10,
+			ColdArchiver:             disabled.NewColdArchiver(),
 		}
 		fhps, _ := pruning.NewFullHistoryTriePruningStorer(fhArgs)
 
@@ -79,6 +83,7 @@ func TestFullHistoryTriePruningStorer_CallsMethodsFromUndelyingFHPS(t *testing.T
 		fhArgs := pruning.FullHistoryStorerArgs{
 			StorerArgs:               args,
 			NumOfOldActivePersisters: 10,
+			ColdArchiver:             disabled.NewColdArchiver(),
 		}
 		fhps, _ := pruning.NewFullHistoryTriePruningStorer(fhArgs)
 
@@ -102,6 +107,7 @@ func TestFullHistoryTriePruningStorer_CallsMethodsFromUndelyingFHPS(t *testing.T
 		fhArgs := pruning.FullHistoryStorerArgs{
 			StorerArgs:               args,
 			NumOfOldActivePersisters: 10,
+			ColdArchiver:             disabled.NewColdArchiver(),
 		}
 		fhps, _ := pruning.NewFullHistoryTriePruningStorer(fhArgs)
 
@@ -129,6 +135,7 @@ func TestFullHistoryTriePruningStorer_IsInterfaceNil(t *testing.T) {
 	fhArgs := pruning.FullHistoryStorerArgs{
 		StorerArgs:               args,
 		NumOfOldActivePersisters: 10,
+		ColdArchiver:             disabled.NewColdArchiver(),
 	}
 	fhtps, _ = pruning.NewFullHistoryTriePruningStorer(fhArgs)
 	require.False(t, fhtps.IsInterfaceNil())
@@ -15,6 +15,7 @@ type StorerArgs struct {
 	PathManager               storage.PathManagerHandler
 	DbPath                    string
 	PersisterFactory          DbFactoryHandler
+	ArchivePersisterFactory   storage.PersisterFactory
 	Notifier                  EpochStartNotifier
 	OldDataCleanerProvider    clean.OldDataCleanerProvider
 	CustomDatabaseRemover     storage.CustomDatabaseRemoverHandler
@@ -37,4 +38,5 @@ type EpochArgs struct {
 type FullHistoryStorerArgs struct {
 	StorerArgs
 	NumOfOldActivePersisters uint32
+	ColdArchiver             storage.ColdArchiver
 }
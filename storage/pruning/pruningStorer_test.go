@@ -76,20 +76,21 @@ func getDefaultArgs() pruning.StorerArgs {
 		NumOfActivePersisters: 2,
 	}
 	return pruning.StorerArgs{
-		PruningEnabled:         true,
-		Identifier:             "id",
-		ShardCoordinator:       mock.NewShardCoordinatorMock(0, 2),
-		PathManager:            &testscommon.PathManagerStub{},
-		CacheConf:              cacheConf,
-		DbPath:                 dbConf.FilePath,
-		PersisterFactory:       persisterFactory,
-		EpochsData:             epochsData,
-		Notifier:               &mock.EpochStartNotifierStub{},
-		OldDataCleanerProvider: &testscommon.OldDataCleanerProviderStub{},
-		CustomDatabaseRemover:  &testscommon.CustomDatabaseRemoverStub{},
-		MaxBatchSize:           10,
-		PersistersTracker:      pruning.NewPersistersTracker(epochsData),
-		StateStatsHandler:      disabled.NewStateStatistics(),
+		PruningEnabled:          true,
+		Identifier:              "id",
+		ShardCoordinator:        mock.NewShardCoordinatorMock(0, 2),
+		PathManager:             &testscommon.PathManagerStub{},
+		CacheConf:               cacheConf,
+		DbPath:                  dbConf.FilePath,
+		PersisterFactory:        persisterFactory,
+		ArchivePersisterFactory: &mock.PersisterFactoryStub{},
+		EpochsData:              epochsData,
+		Notifier:                &mock.EpochStartNotifierStub{},
+		OldDataCleanerProvider:  &testscommon.OldDataCleanerProviderStub{},
+		CustomDatabaseRemover:   &testscommon.CustomDatabaseRemoverStub{},
+		MaxBatchSize:            10,
+		PersistersTracker:       pruning.NewPersistersTracker(epochsData),
+		StateStatsHandler:       disabled.NewStateStatistics(),
 	}
 }
 
@@ -109,20 +110,21 @@ func getDefaultArgsSerialDB() pruning.StorerArgs {
 		NumOfActivePersisters: 2,
 	}
 	return pruning.StorerArgs{
-		PruningEnabled:         true,
-		Identifier:             "id",
-		ShardCoordinator:       mock.NewShardCoordinatorMock(0, 2),
-		PathManager:            pathManager,
-		CacheConf:              cacheConf,
-		DbPath:                 dbConf.FilePath,
-		PersisterFactory:       persisterFactory,
-		EpochsData:             epochData,
-		Notifier:               &mock.EpochStartNotifierStub{},
-		OldDataCleanerProvider: &testscommon.OldDataCleanerProviderStub{},
-		CustomDatabaseRemover:  &testscommon.CustomDatabaseRemoverStub{},
-		MaxBatchSize:           20,
-		PersistersTracker:      pruning.NewPersistersTracker(epochData),
-		StateStatsHandler:      disabled.NewStateStatistics(),
+		PruningEnabled:          true,
+		Identifier:              "id",
+		ShardCoordinator:        mock.NewShardCoordinatorMock(0, 2),
+		PathManager:             pathManager,
+		CacheConf:               cacheConf,
+		DbPath:                  dbConf.FilePath,
+		PersisterFactory:        persisterFactory,
+		ArchivePersisterFactory: &mock.PersisterFactoryStub{},
+		EpochsData:              epochData,
+		Notifier:                &mock.EpochStartNotifierStub{},
+		OldDataCleanerProvider:  &testscommon.OldDataCleanerProviderStub{},
+		CustomDatabaseRemover:   &testscommon.CustomDatabaseRemoverStub{},
+		MaxBatchSize:            20,
+		PersistersTracker:       pruning.NewPersistersTracker(epochData),
+		StateStatsHandler:       disabled.NewStateStatistics(),
 	}
 }
 
@@ -109,6 +109,20 @@ func GetGeneralConfig() config.Config {
 			Type:     "LRU",
 			Shards:   1,
 		},
+		TxDataPool: config.CacheConfig{
+			Capacity:             10000,
+			SizePerSender:        1000,
+			SizeInBytes:          1000000000,
+			SizeInBytesPerSender: 10000000,
+			Shards:               1,
+		},
+		GasPriceSuggestion: config.GasPriceSuggestionConfig{
+			NumRecentBlocks: 10,
+		},
+		TrieStatistics: config.TrieStatisticsConfig{
+			NumCachedRootHashes: 5,
+			NumLargestDataTries: 10,
+		},
 		PeersRatingConfig: config.PeersRatingConfig{
 			TopRatedCacheCapacity: 1000,
 			BadRatedCacheCapacity: 1000,
@@ -174,7 +188,8 @@ func GetGeneralConfig() config.Config {
 			Type: TestHasher,
 		},
 		Consensus: config.ConsensusConfig{
-			Type: "bls",
+			Type:                    "bls",
+			NumRoundTimelinesToKeep: 100,
 		},
 		ValidatorStatistics: config.ValidatorStatisticsConfig{
 			CacheRefreshIntervalInSec: uint32(100),
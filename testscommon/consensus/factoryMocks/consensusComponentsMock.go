@@ -7,11 +7,12 @@ import (
 )
 
 type ConsensusComponentsStub struct {
-	ChronologyHandler         consensus.ChronologyHandler
-	ConsensusWorkerHandler    factory.ConsensusWorker
-	BroadcastMessengerHandler consensus.BroadcastMessenger
-	GroupSize                 int
-	BootstrapperHandler       process.Bootstrapper
+	ChronologyHandler          consensus.ChronologyHandler
+	ConsensusWorkerHandler     factory.ConsensusWorker
+	BroadcastMessengerHandler  consensus.BroadcastMessenger
+	GroupSize                  int
+	BootstrapperHandler        process.Bootstrapper
+	RoundTimelineRecorderField consensus.RoundTimelineRecorder
 }
 
 // Create -
@@ -54,6 +55,11 @@ func (ccs *ConsensusComponentsStub) Bootstrapper() process.Bootstrapper {
 	return ccs.BootstrapperHandler
 }
 
+// RoundTimelineRecorder -
+func (ccs *ConsensusComponentsStub) RoundTimelineRecorder() consensus.RoundTimelineRecorder {
+	return ccs.RoundTimelineRecorderField
+}
+
 // ConsensusGroupSize -
 func (ccs *ConsensusComponentsStub) ConsensusGroupSize() (int, error) {
 	return ccs.GroupSize, nil
@@ -0,0 +1,35 @@
+package consensus
+
+import (
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-go/p2p"
+)
+
+// ReceivedMessagesRecorderStub -
+type ReceivedMessagesRecorderStub struct {
+	RecordReceivedMessageCalled func(message p2p.MessageP2P, fromConnectedPeer core.PeerID, localRound int64, receivedTime time.Time)
+	CloseCalled                 func() error
+}
+
+// RecordReceivedMessage -
+func (stub *ReceivedMessagesRecorderStub) RecordReceivedMessage(message p2p.MessageP2P, fromConnectedPeer core.PeerID, localRound int64, receivedTime time.Time) {
+	if stub.RecordReceivedMessageCalled != nil {
+		stub.RecordReceivedMessageCalled(message, fromConnectedPeer, localRound, receivedTime)
+	}
+}
+
+// Close -
+func (stub *ReceivedMessagesRecorderStub) Close() error {
+	if stub.CloseCalled != nil {
+		return stub.CloseCalled()
+	}
+
+	return nil
+}
+
+// IsInterfaceNil -
+func (stub *ReceivedMessagesRecorderStub) IsInterfaceNil() bool {
+	return stub == nil
+}
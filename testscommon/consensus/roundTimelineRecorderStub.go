@@ -0,0 +1,100 @@
+package consensus
+
+import (
+	"time"
+
+	"github.com/multiversx/mx-chain-go/common"
+)
+
+// RoundTimelineRecorderStub -
+type RoundTimelineRecorderStub struct {
+	RoundStartedCalled      func(round int64, roundTimeStamp time.Time)
+	SubroundStartedCalled   func(round int64, subroundName string)
+	SubroundEndedCalled     func(round int64, subroundName string, finished bool)
+	HeaderReceivedCalled    func(round int64, pubKey []byte, headerHash []byte)
+	BodyReceivedCalled      func(round int64, pubKey []byte)
+	SignatureReceivedCalled func(round int64, pubKey []byte)
+	BlockProcessedCalled    func(round int64, duration time.Duration)
+	RoundEndedCalled        func(round int64, completed bool, failedSubround string)
+	GetLastRoundsCalled     func(numRounds uint32) []*common.ConsensusRoundTimeline
+	CloseCalled             func() error
+}
+
+// RoundStarted -
+func (stub *RoundTimelineRecorderStub) RoundStarted(round int64, roundTimeStamp time.Time) {
+	if stub.RoundStartedCalled != nil {
+		stub.RoundStartedCalled(round, roundTimeStamp)
+	}
+}
+
+// SubroundStarted -
+func (stub *RoundTimelineRecorderStub) SubroundStarted(round int64, subroundName string) {
+	if stub.SubroundStartedCalled != nil {
+		stub.SubroundStartedCalled(round, subroundName)
+	}
+}
+
+// SubroundEnded -
+func (stub *RoundTimelineRecorderStub) SubroundEnded(round int64, subroundName string, finished bool) {
+	if stub.SubroundEndedCalled != nil {
+		stub.SubroundEndedCalled(round, subroundName, finished)
+	}
+}
+
+// HeaderReceived -
+func (stub *RoundTimelineRecorderStub) HeaderReceived(round int64, pubKey []byte, headerHash []byte) {
+	if stub.HeaderReceivedCalled != nil {
+		stub.HeaderReceivedCalled(round, pubKey, headerHash)
+	}
+}
+
+// BodyReceived -
+func (stub *RoundTimelineRecorderStub) BodyReceived(round int64, pubKey []byte) {
+	if stub.BodyReceivedCalled != nil {
+		stub.BodyReceivedCalled(round, pubKey)
+	}
+}
+
+// SignatureReceived -
+func (stub *RoundTimelineRecorderStub) SignatureReceived(round int64, pubKey []byte) {
+	if stub.SignatureReceivedCalled != nil {
+		stub.SignatureReceivedCalled(round, pubKey)
+	}
+}
+
+// BlockProcessed -
+func (stub *RoundTimelineRecorderStub) BlockProcessed(round int64, duration time.Duration) {
+	if stub.BlockProcessedCalled != nil {
+		stub.BlockProcessedCalled(round, duration)
+	}
+}
+
+// RoundEnded -
+func (stub *RoundTimelineRecorderStub) RoundEnded(round int64, completed bool, failedSubround string) {
+	if stub.RoundEndedCalled != nil {
+		stub.RoundEndedCalled(round, completed, failedSubround)
+	}
+}
+
+// GetLastRounds -
+func (stub *RoundTimelineRecorderStub) GetLastRounds(numRounds uint32) []*common.ConsensusRoundTimeline {
+	if stub.GetLastRoundsCalled != nil {
+		return stub.GetLastRoundsCalled(numRounds)
+	}
+
+	return make([]*common.ConsensusRoundTimeline, 0)
+}
+
+// Close -
+func (stub *RoundTimelineRecorderStub) Close() error {
+	if stub.CloseCalled != nil {
+		return stub.CloseCalled()
+	}
+
+	return nil
+}
+
+// IsInterfaceNil -
+func (stub *RoundTimelineRecorderStub) IsInterfaceNil() bool {
+	return stub == nil
+}
@@ -38,6 +38,7 @@ func CreateTxPool(numShards uint32, selfShard uint32) (dataRetriever.ShardedData
 				SizeInBytesPerSender: 33_554_432,
 				Shards:               16,
 			},
+			Journal:        txpool.NewDisabledJournal(),
 			NumberOfShards: numShards,
 			SelfShardID:    selfShard,
 			TxGasHandler: &txcachemocks.TxGasHandlerMock{
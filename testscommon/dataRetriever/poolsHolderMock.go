@@ -54,6 +54,7 @@ func NewPoolsHolderMock() *PoolsHolderMock {
 				MinimumGasPrice:      200000000000,
 				GasProcessingDivisor: 100,
 			},
+			Journal:        txpool.NewDisabledJournal(),
 			NumberOfShards: 1,
 		},
 	)
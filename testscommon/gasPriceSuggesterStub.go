@@ -0,0 +1,22 @@
+package testscommon
+
+import "github.com/multiversx/mx-chain-go/common"
+
+// GasPriceSuggesterStub -
+type GasPriceSuggesterStub struct {
+	GetGasPriceSuggestionCalled func() (*common.GasPriceSuggestionAPIResponse, error)
+}
+
+// GetGasPriceSuggestion -
+func (stub *GasPriceSuggesterStub) GetGasPriceSuggestion() (*common.GasPriceSuggestionAPIResponse, error) {
+	if stub.GetGasPriceSuggestionCalled != nil {
+		return stub.GetGasPriceSuggestionCalled()
+	}
+
+	return &common.GasPriceSuggestionAPIResponse{}, nil
+}
+
+// IsInterfaceNil -
+func (stub *GasPriceSuggesterStub) IsInterfaceNil() bool {
+	return stub == nil
+}
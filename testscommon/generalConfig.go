@@ -44,7 +44,8 @@ func GetGeneralConfig() config.Config {
 			SignatureLength: 48,
 		},
 		Consensus: config.ConsensusConfig{
-			Type: "bls",
+			Type:                    "bls",
+			NumRoundTimelinesToKeep: 100,
 		},
 		ValidatorStatistics: config.ValidatorStatisticsConfig{
 			CacheRefreshIntervalInSec: uint32(100),
@@ -429,6 +430,13 @@ func GetGeneralConfig() config.Config {
 		ResourceStats: config.ResourceStatsConfig{
 			RefreshIntervalInSec: 1,
 		},
+		GasPriceSuggestion: config.GasPriceSuggestionConfig{
+			NumRecentBlocks: 10,
+		},
+		TrieStatistics: config.TrieStatisticsConfig{
+			NumCachedRootHashes: 5,
+			NumLargestDataTries: 10,
+		},
 		SovereignConfig: config.SovereignConfig{
 			NotifierConfig: config.NotifierConfig{
 				SubscribedEvents: []config.SubscribedEvent{
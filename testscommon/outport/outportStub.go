@@ -2,15 +2,17 @@ package outport
 
 import (
 	outportcore "github.com/multiversx/mx-chain-core-go/data/outport"
+	"github.com/multiversx/mx-chain-go/common"
 	"github.com/multiversx/mx-chain-go/outport"
 )
 
 // OutportStub is a mock implementation fot the OutportHandler interface
 type OutportStub struct {
-	SaveBlockCalled             func(args *outportcore.OutportBlockWithHeaderAndBody) error
-	SaveValidatorsRatingCalled  func(validatorsRating *outportcore.ValidatorsRating)
-	SaveValidatorsPubKeysCalled func(validatorsPubKeys *outportcore.ValidatorsPubKeys)
-	HasDriversCalled            func() bool
+	SaveBlockCalled                  func(args *outportcore.OutportBlockWithHeaderAndBody) error
+	SaveValidatorsRatingCalled       func(validatorsRating *outportcore.ValidatorsRating)
+	SaveValidatorsPubKeysCalled      func(validatorsPubKeys *outportcore.ValidatorsPubKeys)
+	HasDriversCalled                 func() bool
+	SaveConsensusRoundTimelineCalled func(timeline *common.ConsensusRoundTimeline)
 }
 
 // SaveBlock -
@@ -69,6 +71,13 @@ func (as *OutportStub) SaveRoundsInfo(_ *outportcore.RoundsInfo) {
 
 }
 
+// SaveConsensusRoundTimeline -
+func (as *OutportStub) SaveConsensusRoundTimeline(timeline *common.ConsensusRoundTimeline) {
+	if as.SaveConsensusRoundTimelineCalled != nil {
+		as.SaveConsensusRoundTimelineCalled(timeline)
+	}
+}
+
 // SubscribeDriver -
 func (as *OutportStub) SubscribeDriver(_ outport.Driver) error {
 	return nil
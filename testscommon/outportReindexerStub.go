@@ -0,0 +1,42 @@
+package testscommon
+
+import "github.com/multiversx/mx-chain-go/common"
+
+// OutportReindexerStub -
+type OutportReindexerStub struct {
+	StartReindexingCalled     func(startNonce uint64, endNonce uint64) error
+	GetReindexingStatusCalled func() common.OutportReindexStatus
+	CloseCalled               func() error
+}
+
+// StartReindexing -
+func (stub *OutportReindexerStub) StartReindexing(startNonce uint64, endNonce uint64) error {
+	if stub.StartReindexingCalled != nil {
+		return stub.StartReindexingCalled(startNonce, endNonce)
+	}
+
+	return nil
+}
+
+// GetReindexingStatus -
+func (stub *OutportReindexerStub) GetReindexingStatus() common.OutportReindexStatus {
+	if stub.GetReindexingStatusCalled != nil {
+		return stub.GetReindexingStatusCalled()
+	}
+
+	return common.OutportReindexStatus{}
+}
+
+// Close -
+func (stub *OutportReindexerStub) Close() error {
+	if stub.CloseCalled != nil {
+		return stub.CloseCalled()
+	}
+
+	return nil
+}
+
+// IsInterfaceNil -
+func (stub *OutportReindexerStub) IsInterfaceNil() bool {
+	return stub == nil
+}
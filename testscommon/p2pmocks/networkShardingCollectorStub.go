@@ -11,6 +11,7 @@ type NetworkShardingCollectorStub struct {
 	PutPeerIdShardIdCalled          func(pid core.PeerID, shardId uint32)
 	PutPeerIdSubTypeCalled          func(pid core.PeerID, peerSubType core.P2PPeerSubType)
 	GetLastKnownPeerIDCalled        func(pk []byte) (core.PeerID, bool)
+	GetPeerIDsCalled                func(pk []byte) []core.PeerID
 	GetPeerInfoCalled               func(pid core.PeerID) core.P2PPeerInfo
 }
 
@@ -51,6 +52,15 @@ func (nscs *NetworkShardingCollectorStub) GetLastKnownPeerID(pk []byte) (core.Pe
 	return "", false
 }
 
+// GetPeerIDs -
+func (nscs *NetworkShardingCollectorStub) GetPeerIDs(pk []byte) []core.PeerID {
+	if nscs.GetPeerIDsCalled != nil {
+		return nscs.GetPeerIDsCalled(pk)
+	}
+
+	return nil
+}
+
 // GetPeerInfo -
 func (nscs *NetworkShardingCollectorStub) GetPeerInfo(pid core.PeerID) core.P2PPeerInfo {
 	if nscs.GetPeerInfoCalled != nil {
@@ -30,6 +30,7 @@ type NodesCoordinatorMock struct {
 	GetAllShuffledOutValidatorsPublicKeysCalled       func(epoch uint32) (map[uint32][][]byte, error)
 	GetShuffledOutToAuctionValidatorsPublicKeysCalled func(epoch uint32) (map[uint32][][]byte, error)
 	GetNumTotalEligibleCalled                         func() uint64
+	PreviewNodesShufflingCalled                       func(validatorsInfo []*state.ShardValidatorInfo, randomness []byte, epoch uint32) (*nodesCoordinator.ShuffledNodes, error)
 }
 
 // NewNodesCoordinatorMock -
@@ -311,6 +312,14 @@ func (ncm *NodesCoordinatorMock) EpochStartPrepare(_ data.HeaderHandler, _ data.
 
 }
 
+// PreviewNodesShuffling -
+func (ncm *NodesCoordinatorMock) PreviewNodesShuffling(validatorsInfo []*state.ShardValidatorInfo, randomness []byte, epoch uint32) (*nodesCoordinator.ShuffledNodes, error) {
+	if ncm.PreviewNodesShufflingCalled != nil {
+		return ncm.PreviewNodesShufflingCalled(validatorsInfo, randomness, epoch)
+	}
+	return &nodesCoordinator.ShuffledNodes{}, nil
+}
+
 // NodesCoordinatorToRegistry -
 func (ncm *NodesCoordinatorMock) NodesCoordinatorToRegistry(_ uint32) nodesCoordinator.NodesCoordinatorRegistryHandler {
 	return nil
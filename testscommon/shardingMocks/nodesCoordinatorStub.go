@@ -22,6 +22,7 @@ type NodesCoordinatorStub struct {
 	GetOwnPublicKeyCalled                    func() []byte
 	GetWaitingEpochsLeftForPublicKeyCalled   func(publicKey []byte) (uint32, error)
 	GetNumTotalEligibleCalled                func() uint64
+	PreviewNodesShufflingCalled              func(validatorsInfo []*state.ShardValidatorInfo, randomness []byte, epoch uint32) (*nodesCoordinator.ShuffledNodes, error)
 }
 
 // NodesCoordinatorToRegistry -
@@ -36,6 +37,15 @@ func (ncm *NodesCoordinatorStub) EpochStartPrepare(metaHdr data.HeaderHandler, b
 	}
 }
 
+// PreviewNodesShuffling -
+func (ncm *NodesCoordinatorStub) PreviewNodesShuffling(validatorsInfo []*state.ShardValidatorInfo, randomness []byte, epoch uint32) (*nodesCoordinator.ShuffledNodes, error) {
+	if ncm.PreviewNodesShufflingCalled != nil {
+		return ncm.PreviewNodesShufflingCalled(validatorsInfo, randomness, epoch)
+	}
+
+	return &nodesCoordinator.ShuffledNodes{}, nil
+}
+
 // GetChance -
 func (ncm *NodesCoordinatorStub) GetChance(uint32) uint32 {
 	return 1
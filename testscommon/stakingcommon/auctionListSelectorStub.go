@@ -1,10 +1,14 @@
 package stakingcommon
 
-import "github.com/multiversx/mx-chain-go/state"
+import (
+	"github.com/multiversx/mx-chain-go/epochStart"
+	"github.com/multiversx/mx-chain-go/state"
+)
 
 // AuctionListSelectorStub -
 type AuctionListSelectorStub struct {
 	SelectNodesFromAuctionListCalled func(validatorsInfoMap state.ShardValidatorsInfoMapHandler, randomness []byte) error
+	SimulateNodesSelectionCalled     func(ownersData map[string]*epochStart.OwnerData, randomness []byte) (*epochStart.AuctionSelectionResult, error)
 }
 
 // SelectNodesFromAuctionList -
@@ -19,6 +23,18 @@ func (als *AuctionListSelectorStub) SelectNodesFromAuctionList(
 	return nil
 }
 
+// SimulateNodesSelection -
+func (als *AuctionListSelectorStub) SimulateNodesSelection(
+	ownersData map[string]*epochStart.OwnerData,
+	randomness []byte,
+) (*epochStart.AuctionSelectionResult, error) {
+	if als.SimulateNodesSelectionCalled != nil {
+		return als.SimulateNodesSelectionCalled(ownersData, randomness)
+	}
+
+	return &epochStart.AuctionSelectionResult{}, nil
+}
+
 // IsInterfaceNil -
 func (als *AuctionListSelectorStub) IsInterfaceNil() bool {
 	return als == nil
@@ -7,9 +7,11 @@ import (
 
 // ValidatorsProviderStub -
 type ValidatorsProviderStub struct {
-	GetLatestValidatorsCalled func() map[string]*validator.ValidatorStatistics
-	GetAuctionListCalled      func() ([]*common.AuctionListValidatorAPIResponse, error)
-	ForceUpdateCalled         func() error
+	GetLatestValidatorsCalled      func() map[string]*validator.ValidatorStatistics
+	GetAuctionListCalled           func() ([]*common.AuctionListValidatorAPIResponse, error)
+	SimulateAuctionCalled          func(changes []*common.AuctionSimulationOwnerChange, randomness []byte) (*common.AuctionSimulationAPIResponse, error)
+	GetNodesShufflingPreviewCalled func(randomness []byte, epoch uint32) (*common.NodesShufflingPreviewAPIResponse, error)
+	ForceUpdateCalled              func() error
 }
 
 // GetLatestValidators -
@@ -30,6 +32,24 @@ func (vp *ValidatorsProviderStub) GetAuctionList() ([]*common.AuctionListValidat
 	return nil, nil
 }
 
+// SimulateAuction -
+func (vp *ValidatorsProviderStub) SimulateAuction(changes []*common.AuctionSimulationOwnerChange, randomness []byte) (*common.AuctionSimulationAPIResponse, error) {
+	if vp.SimulateAuctionCalled != nil {
+		return vp.SimulateAuctionCalled(changes, randomness)
+	}
+
+	return nil, nil
+}
+
+// GetNodesShufflingPreview -
+func (vp *ValidatorsProviderStub) GetNodesShufflingPreview(randomness []byte, epoch uint32) (*common.NodesShufflingPreviewAPIResponse, error) {
+	if vp.GetNodesShufflingPreviewCalled != nil {
+		return vp.GetNodesShufflingPreviewCalled(randomness, epoch)
+	}
+
+	return nil, nil
+}
+
 // ForceUpdate -
 func (vp *ValidatorsProviderStub) ForceUpdate() error {
 	if vp.ForceUpdateCalled != nil {
@@ -32,6 +32,7 @@ type AccountsStub struct {
 	RecreateAllTriesCalled        func(rootHash []byte) (map[string]common.Trie, error)
 	GetCodeCalled                 func([]byte) []byte
 	GetTrieCalled                 func([]byte) (common.Trie, error)
+	GetStatsForRootHashCalled     func(rootHash []byte, numLargestDataTries uint32) (common.TriesStatisticsCollector, error)
 	GetStackDebugFirstEntryCalled func() []byte
 	GetAccountWithBlockInfoCalled func(address []byte, options common.RootHashHolder) (vmcommon.AccountHandler, common.BlockInfo, error)
 	GetCodeWithBlockInfoCalled    func(codeHash []byte, options common.RootHashHolder) ([]byte, common.BlockInfo, error)
@@ -71,6 +72,15 @@ func (as *AccountsStub) GetTrie(codeHash []byte) (common.Trie, error) {
 	return nil, nil
 }
 
+// GetStatsForRootHash -
+func (as *AccountsStub) GetStatsForRootHash(rootHash []byte, numLargestDataTries uint32) (common.TriesStatisticsCollector, error) {
+	if as.GetStatsForRootHashCalled != nil {
+		return as.GetStatsForRootHashCalled(rootHash, numLargestDataTries)
+	}
+
+	return nil, nil
+}
+
 // GetCode -
 func (as *AccountsStub) GetCode(codeHash []byte) []byte {
 	if as.GetCodeCalled != nil {
@@ -0,0 +1,60 @@
+package storage
+
+import (
+	"github.com/multiversx/mx-chain-go/storage"
+)
+
+// ColdArchiverStub -
+type ColdArchiverStub struct {
+	RegisterUnitCalled     func(unitName string, persisterFactory storage.PersisterFactory, releaseEpochHandler func(epoch uint32) error)
+	ArchiveOldEpochsCalled func(currentEpoch uint32)
+	IsEpochArchivedCalled  func(epoch uint32) bool
+	GetCalled              func(epoch uint32, unitName string, key []byte) ([]byte, error)
+	CloseCalled            func() error
+}
+
+// RegisterUnit -
+func (stub *ColdArchiverStub) RegisterUnit(unitName string, persisterFactory storage.PersisterFactory, releaseEpochHandler func(epoch uint32) error) {
+	if stub.RegisterUnitCalled != nil {
+		stub.RegisterUnitCalled(unitName, persisterFactory, releaseEpochHandler)
+	}
+}
+
+// ArchiveOldEpochs -
+func (stub *ColdArchiverStub) ArchiveOldEpochs(currentEpoch uint32) {
+	if stub.ArchiveOldEpochsCalled != nil {
+		stub.ArchiveOldEpochsCalled(currentEpoch)
+	}
+}
+
+// IsEpochArchived -
+func (stub *ColdArchiverStub) IsEpochArchived(epoch uint32) bool {
+	if stub.IsEpochArchivedCalled != nil {
+		return stub.IsEpochArchivedCalled(epoch)
+	}
+
+	return false
+}
+
+// Get -
+func (stub *ColdArchiverStub) Get(epoch uint32, unitName string, key []byte) ([]byte, error) {
+	if stub.GetCalled != nil {
+		return stub.GetCalled(epoch, unitName, key)
+	}
+
+	return nil, storage.ErrEpochNotArchived
+}
+
+// Close -
+func (stub *ColdArchiverStub) Close() error {
+	if stub.CloseCalled != nil {
+		return stub.CloseCalled()
+	}
+
+	return nil
+}
+
+// IsInterfaceNil -
+func (stub *ColdArchiverStub) IsInterfaceNil() bool {
+	return stub == nil
+}
@@ -33,6 +33,16 @@ type TrieStub struct {
 	CloseCalled                     func() error
 	CollectLeavesForMigrationCalled func(args vmcommon.ArgsMigrateDataTrieLeaves) error
 	IsMigratedToLatestVersionCalled func() (bool, error)
+	GetTrieStatsCalled              func(address string, rootHash []byte) (common.TrieStatisticsHandler, error)
+}
+
+// GetTrieStats -
+func (ts *TrieStub) GetTrieStats(address string, rootHash []byte) (common.TrieStatisticsHandler, error) {
+	if ts.GetTrieStatsCalled != nil {
+		return ts.GetTrieStatsCalled(address, rootHash)
+	}
+
+	return nil, errNotImplemented
 }
 
 // GetStorageManager -
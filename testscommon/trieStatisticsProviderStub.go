@@ -0,0 +1,22 @@
+package testscommon
+
+import "github.com/multiversx/mx-chain-go/common"
+
+// TrieStatisticsProviderStub -
+type TrieStatisticsProviderStub struct {
+	GetTrieStatisticsCalled func(rootHash string) (*common.TrieStatisticsAPIResponse, error)
+}
+
+// GetTrieStatistics -
+func (stub *TrieStatisticsProviderStub) GetTrieStatistics(rootHash string) (*common.TrieStatisticsAPIResponse, error) {
+	if stub.GetTrieStatisticsCalled != nil {
+		return stub.GetTrieStatisticsCalled(rootHash)
+	}
+
+	return &common.TrieStatisticsAPIResponse{}, nil
+}
+
+// IsInterfaceNil -
+func (stub *TrieStatisticsProviderStub) IsInterfaceNil() bool {
+	return stub == nil
+}
@@ -123,3 +123,6 @@ var ErrNilTrieLeafParser = errors.New("nil trie leaf parser")
 
 // ErrInvalidNodeVersion signals that an invalid node version has been provided
 var ErrInvalidNodeVersion = errors.New("invalid node version provided")
+
+// ErrNilSerializedNodeHandler signals that a nil serialized node handler has been provided
+var ErrNilSerializedNodeHandler = errors.New("nil serialized node handler")
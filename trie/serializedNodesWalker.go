@@ -0,0 +1,82 @@
+package trie
+
+import (
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-core-go/hashing"
+	"github.com/multiversx/mx-chain-core-go/marshal"
+	"github.com/multiversx/mx-chain-go/common"
+)
+
+// SerializedNodeHandler is called for every node reached while walking a trie. The leaf value is nil for the branch
+// and extension nodes. Returning an error stops the walk
+type SerializedNodeHandler func(hash []byte, serializedNode []byte, leafValue []byte) error
+
+// WalkSerializedNodes walks, depth first, all the nodes of the trie with the provided root hash, as they are found in
+// the storage. The nodes are loaded one by one and are not kept in memory after being handled, so the memory usage
+// does not depend on the size of the trie. A missing node stops the walk with an error
+func WalkSerializedNodes(
+	db common.TrieStorageInteractor,
+	marshaller marshal.Marshalizer,
+	hasher hashing.Hasher,
+	rootHash []byte,
+	handler SerializedNodeHandler,
+) error {
+	if check.IfNil(db) {
+		return ErrNilDatabase
+	}
+	if check.IfNil(marshaller) {
+		return ErrNilMarshalizer
+	}
+	if check.IfNil(hasher) {
+		return ErrNilHasher
+	}
+	if handler == nil {
+		return ErrNilSerializedNodeHandler
+	}
+	if common.IsEmptyTrie(rootHash) {
+		return nil
+	}
+
+	hashesToWalk := [][]byte{rootHash}
+	for len(hashesToWalk) > 0 {
+		lastIndex := len(hashesToWalk) - 1
+		hash := hashesToWalk[lastIndex]
+		hashesToWalk = hashesToWalk[:lastIndex]
+
+		serializedNode, err := db.Get(hash)
+		if err != nil {
+			return core.NewGetNodeFromDBErrWithKey(hash, err, db.GetIdentifier())
+		}
+
+		n, err := decodeNode(serializedNode, marshaller, hasher)
+		if err != nil {
+			return err
+		}
+
+		var leafValue []byte
+		switch typedNode := n.(type) {
+		case *branchNode:
+			// the children are added in reverse order so that they are walked in their natural order
+			for i := len(typedNode.EncodedChildren) - 1; i >= 0; i-- {
+				if len(typedNode.EncodedChildren[i]) > 0 {
+					hashesToWalk = append(hashesToWalk, typedNode.EncodedChildren[i])
+				}
+			}
+		case *extensionNode:
+			if len(typedNode.EncodedChild) == 0 {
+				return ErrEmptyExtensionNode
+			}
+			hashesToWalk = append(hashesToWalk, typedNode.EncodedChild)
+		case *leafNode:
+			leafValue = typedNode.Value
+		}
+
+		err = handler(hash, serializedNode, leafValue)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
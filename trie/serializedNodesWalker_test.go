@@ -0,0 +1,96 @@
+package trie_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/multiversx/mx-chain-go/trie"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWalkSerializedNodes(t *testing.T) {
+	t.Parallel()
+
+	noOpHandler := func(_ []byte, _ []byte, _ []byte) error {
+		return nil
+	}
+
+	t.Run("nil arguments should error", func(t *testing.T) {
+		t.Parallel()
+
+		db, marshaller, hasher, _, _ := getDefaultTrieParameters()
+		rootHash := []byte("root hash")
+
+		err := trie.WalkSerializedNodes(nil, marshaller, hasher, rootHash, noOpHandler)
+		assert.Equal(t, trie.ErrNilDatabase, err)
+
+		err = trie.WalkSerializedNodes(db, nil, hasher, rootHash, noOpHandler)
+		assert.Equal(t, trie.ErrNilMarshalizer, err)
+
+		err = trie.WalkSerializedNodes(db, marshaller, nil, rootHash, noOpHandler)
+		assert.Equal(t, trie.ErrNilHasher, err)
+
+		err = trie.WalkSerializedNodes(db, marshaller, hasher, rootHash, nil)
+		assert.Equal(t, trie.ErrNilSerializedNodeHandler, err)
+	})
+	t.Run("missing node should error", func(t *testing.T) {
+		t.Parallel()
+
+		db, marshaller, hasher, _, _ := getDefaultTrieParameters()
+
+		err := trie.WalkSerializedNodes(db, marshaller, hasher, []byte("missing root hash"), noOpHandler)
+		assert.NotNil(t, err)
+	})
+	t.Run("handler error should stop the walk", func(t *testing.T) {
+		t.Parallel()
+
+		tr, _ := initTrieMultipleValues(20)
+		require.Nil(t, tr.Commit())
+		rootHash, _ := tr.RootHash()
+		_, marshaller, hasher, _, _ := getDefaultTrieParameters()
+
+		expectedErr := errors.New("expected error")
+		numCalls := 0
+		err := trie.WalkSerializedNodes(tr.GetStorageManager(), marshaller, hasher, rootHash, func(_ []byte, _ []byte, _ []byte) error {
+			numCalls++
+			return expectedErr
+		})
+		assert.Equal(t, expectedErr, err)
+		assert.Equal(t, 1, numCalls)
+	})
+	t.Run("should walk all the nodes", func(t *testing.T) {
+		t.Parallel()
+
+		tr, values := initTrieMultipleValues(100)
+		require.Nil(t, tr.Commit())
+		rootHash, _ := tr.RootHash()
+		_, marshaller, hasher, _, _ := getDefaultTrieParameters()
+
+		expectedHashes, err := tr.GetAllHashes()
+		require.Nil(t, err)
+
+		walkedHashes := make(map[string]struct{})
+		leafValues := make(map[string]struct{})
+		err = trie.WalkSerializedNodes(tr.GetStorageManager(), marshaller, hasher, rootHash, func(hash []byte, serializedNode []byte, leafValue []byte) error {
+			assert.Equal(t, hash, hasher.Compute(string(serializedNode)))
+			walkedHashes[string(hash)] = struct{}{}
+			if leafValue != nil {
+				leafValues[string(leafValue)] = struct{}{}
+			}
+
+			return nil
+		})
+		assert.Nil(t, err)
+		assert.Equal(t, len(expectedHashes), len(walkedHashes))
+		for _, hash := range expectedHashes {
+			_, found := walkedHashes[string(hash)]
+			assert.True(t, found)
+		}
+		assert.Equal(t, len(values), len(leafValues))
+		for _, value := range values {
+			_, found := leafValues[string(value)]
+			assert.True(t, found)
+		}
+	})
+}
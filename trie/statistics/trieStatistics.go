@@ -19,6 +19,7 @@ type trieStatistics struct {
 	extensionNodes *nodesStatistics
 	leafNodes      *nodesStatistics
 	migrationStats map[core.TrieNodeVersion]uint64
+	nodesPerDepth  []*common.TrieNodesPerDepth
 
 	mutex sync.RWMutex
 }
@@ -47,6 +48,7 @@ func NewTrieStatistics() *trieStatistics {
 			numNodes:  0,
 		},
 		migrationStats: make(map[core.TrieNodeVersion]uint64),
+		nodesPerDepth:  make([]*common.TrieNodesPerDepth, 0),
 	}
 }
 
@@ -56,6 +58,7 @@ func (ts *trieStatistics) AddBranchNode(level int, size uint64) {
 	defer ts.mutex.Unlock()
 
 	ts.collectNodeStatistics(level, size, ts.branchNodes)
+	ts.getNodesAtDepth(level).NumBranchNodes++
 }
 
 // AddExtensionNode will add the given level and size to the extension nodes statistics
@@ -64,6 +67,7 @@ func (ts *trieStatistics) AddExtensionNode(level int, size uint64) {
 	defer ts.mutex.Unlock()
 
 	ts.collectNodeStatistics(level, size, ts.extensionNodes)
+	ts.getNodesAtDepth(level).NumExtensionNodes++
 }
 
 // AddLeafNode will add the given level and size to the leaf nodes statistics
@@ -72,6 +76,7 @@ func (ts *trieStatistics) AddLeafNode(level int, size uint64, version core.TrieN
 	defer ts.mutex.Unlock()
 
 	ts.collectNodeStatistics(level, size, ts.leafNodes)
+	ts.getNodesAtDepth(level).NumLeafNodes++
 	ts.migrationStats[version]++
 }
 
@@ -93,6 +98,32 @@ func (ts *trieStatistics) collectNodeStatistics(level int, size uint64, nodeStat
 	}
 }
 
+func (ts *trieStatistics) getNodesAtDepth(level int) *common.TrieNodesPerDepth {
+	for len(ts.nodesPerDepth) <= level {
+		ts.nodesPerDepth = append(ts.nodesPerDepth, &common.TrieNodesPerDepth{
+			Depth: uint32(len(ts.nodesPerDepth)),
+		})
+	}
+
+	return ts.nodesPerDepth[level]
+}
+
+// GetAddress will return the address of the account that holds the trie, if any
+func (ts *trieStatistics) GetAddress() string {
+	ts.mutex.RLock()
+	defer ts.mutex.RUnlock()
+
+	return ts.address
+}
+
+// GetRootHash will return the root hash of the trie
+func (ts *trieStatistics) GetRootHash() []byte {
+	ts.mutex.RLock()
+	defer ts.mutex.RUnlock()
+
+	return ts.rootHash
+}
+
 // GetTotalNodesSize will return the total size of all nodes
 func (ts *trieStatistics) GetTotalNodesSize() uint64 {
 	ts.mutex.RLock()
@@ -186,6 +217,20 @@ func (ts *trieStatistics) GetLeavesMigrationStats() map[core.TrieNodeVersion]uin
 	return migrationStatsMap
 }
 
+// GetNodesPerDepth will return the number of nodes of each type for every depth of the trie
+func (ts *trieStatistics) GetNodesPerDepth() []*common.TrieNodesPerDepth {
+	ts.mutex.RLock()
+	defer ts.mutex.RUnlock()
+
+	nodesPerDepth := make([]*common.TrieNodesPerDepth, 0, len(ts.nodesPerDepth))
+	for _, nodesAtDepth := range ts.nodesPerDepth {
+		nodesAtDepthCopy := *nodesAtDepth
+		nodesPerDepth = append(nodesPerDepth, &nodesAtDepthCopy)
+	}
+
+	return nodesPerDepth
+}
+
 // MergeTriesStatistics will merge the given statistics with the current statistics
 func (ts *trieStatistics) MergeTriesStatistics(statsToBeMerged common.TrieStatisticsHandler) {
 	ts.mutex.Lock()
@@ -207,6 +252,13 @@ func (ts *trieStatistics) MergeTriesStatistics(statsToBeMerged common.TrieStatis
 	for version, numLeaves := range statsToBeMerged.GetLeavesMigrationStats() {
 		ts.migrationStats[version] += numLeaves
 	}
+
+	for _, nodesAtDepth := range statsToBeMerged.GetNodesPerDepth() {
+		currentNodesAtDepth := ts.getNodesAtDepth(int(nodesAtDepth.Depth))
+		currentNodesAtDepth.NumBranchNodes += nodesAtDepth.NumBranchNodes
+		currentNodesAtDepth.NumExtensionNodes += nodesAtDepth.NumExtensionNodes
+		currentNodesAtDepth.NumLeafNodes += nodesAtDepth.NumLeafNodes
+	}
 }
 
 // IsInterfaceNil returns true if there is no value under the interface
@@ -18,21 +18,30 @@ var log = logger.GetOrCreate("trieStatistics")
 const numTriesToPrint = 10
 
 type trieStatisticsCollector struct {
-	trieStatsByType map[common.TrieType]common.TrieStatisticsHandler
-	triesBySize     []common.TrieStatisticsHandler
-	triesByDepth    []common.TrieStatisticsHandler
-	numTriesByType  map[common.TrieType]uint64
+	trieStatsByType  map[common.TrieType]common.TrieStatisticsHandler
+	triesBySize      []common.TrieStatisticsHandler
+	triesByDepth     []common.TrieStatisticsHandler
+	largestDataTries []common.TrieStatisticsHandler
+	numTriesByType   map[common.TrieType]uint64
+	numAccounts      uint64
 
 	mutex sync.RWMutex
 }
 
 // NewTrieStatisticsCollector creates a new instance of trieStatisticsCollector
 func NewTrieStatisticsCollector() *trieStatisticsCollector {
+	return NewTrieStatisticsCollectorWithNumLargestDataTries(numTriesToPrint)
+}
+
+// NewTrieStatisticsCollectorWithNumLargestDataTries creates a new instance of trieStatisticsCollector which keeps the
+// statistics of the provided number of largest data tries
+func NewTrieStatisticsCollectorWithNumLargestDataTries(numLargestDataTries uint32) *trieStatisticsCollector {
 	return &trieStatisticsCollector{
-		trieStatsByType: make(map[common.TrieType]common.TrieStatisticsHandler),
-		triesBySize:     make([]common.TrieStatisticsHandler, numTriesToPrint),
-		triesByDepth:    make([]common.TrieStatisticsHandler, numTriesToPrint),
-		numTriesByType:  make(map[common.TrieType]uint64),
+		trieStatsByType:  make(map[common.TrieType]common.TrieStatisticsHandler),
+		triesBySize:      make([]common.TrieStatisticsHandler, numTriesToPrint),
+		triesByDepth:     make([]common.TrieStatisticsHandler, numTriesToPrint),
+		largestDataTries: make([]common.TrieStatisticsHandler, numLargestDataTries),
+		numTriesByType:   make(map[common.TrieType]uint64),
 	}
 }
 
@@ -56,6 +65,61 @@ func (tsc *trieStatisticsCollector) Add(trieStats common.TrieStatisticsHandler,
 
 	insertInSortedArray(tsc.triesBySize, trieStats, isLessSize)
 	insertInSortedArray(tsc.triesByDepth, trieStats, isLessDeep)
+	if trieType == common.DataTrie {
+		insertInSortedArray(tsc.largestDataTries, trieStats, isLessSize)
+	}
+}
+
+// IncrementNumAccounts increments the number of accounts found in the main trie
+func (tsc *trieStatisticsCollector) IncrementNumAccounts() {
+	tsc.mutex.Lock()
+	tsc.numAccounts++
+	tsc.mutex.Unlock()
+}
+
+// GetNumAccounts returns the number of accounts found in the main trie
+func (tsc *trieStatisticsCollector) GetNumAccounts() uint64 {
+	tsc.mutex.RLock()
+	defer tsc.mutex.RUnlock()
+
+	return tsc.numAccounts
+}
+
+// GetNumTries returns the number of collected tries of the given type
+func (tsc *trieStatisticsCollector) GetNumTries(trieType common.TrieType) uint64 {
+	tsc.mutex.RLock()
+	defer tsc.mutex.RUnlock()
+
+	return tsc.numTriesByType[trieType]
+}
+
+// GetTrieStatistics returns the merged statistics of all the collected tries of the given type
+func (tsc *trieStatisticsCollector) GetTrieStatistics(trieType common.TrieType) common.TrieStatisticsHandler {
+	tsc.mutex.RLock()
+	defer tsc.mutex.RUnlock()
+
+	trieStats, ok := tsc.trieStatsByType[trieType]
+	if !ok {
+		return NewTrieStatistics()
+	}
+
+	return trieStats
+}
+
+// GetLargestDataTries returns the statistics of the largest data tries, ordered descending by size
+func (tsc *trieStatisticsCollector) GetLargestDataTries() []common.TrieStatisticsHandler {
+	tsc.mutex.RLock()
+	defer tsc.mutex.RUnlock()
+
+	largestDataTries := make([]common.TrieStatisticsHandler, 0, len(tsc.largestDataTries))
+	for _, trieStats := range tsc.largestDataTries {
+		if check.IfNil(trieStats) {
+			continue
+		}
+		largestDataTries = append(largestDataTries, trieStats)
+	}
+
+	return largestDataTries
 }
 
 // Print will print all the collected statistics
@@ -180,9 +244,10 @@ func insertInSortedArray(
 	ts common.TrieStatisticsHandler,
 	isLess func(common.TrieStatisticsHandler, common.TrieStatisticsHandler) bool,
 ) {
-	insertIndex := numTriesToPrint
-	lastNilIndex := numTriesToPrint
-	for i := numTriesToPrint - 1; i >= 0; i-- {
+	arrayLen := len(array)
+	insertIndex := arrayLen
+	lastNilIndex := arrayLen
+	for i := arrayLen - 1; i >= 0; i-- {
 		currentTrie := array[i]
 		if currentTrie == nil {
 			lastNilIndex = i
@@ -200,13 +265,13 @@ func insertInSortedArray(
 		break
 	}
 
-	if insertIndex < numTriesToPrint {
-		array = append(array[:insertIndex+1], array[insertIndex:numTriesToPrint-1]...)
+	if insertIndex < arrayLen {
+		array = append(array[:insertIndex+1], array[insertIndex:arrayLen-1]...)
 		array[insertIndex] = ts
 		return
 	}
 
-	if lastNilIndex < numTriesToPrint {
+	if lastNilIndex < arrayLen {
 		array[lastNilIndex] = ts
 	}
 }
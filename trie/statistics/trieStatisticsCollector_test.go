@@ -52,6 +52,57 @@ func TestSnapshotStatistics_Add(t *testing.T) {
 	}
 }
 
+func TestTrieStatisticsCollector_Getters(t *testing.T) {
+	t.Parallel()
+
+	tsc := NewTrieStatisticsCollector()
+	assert.Equal(t, uint64(0), tsc.GetNumAccounts())
+	assert.Equal(t, uint64(0), tsc.GetNumTries(common.DataTrie))
+	assert.Equal(t, uint64(0), tsc.GetTrieStatistics(common.MainTrie).GetTotalNumNodes())
+	assert.Equal(t, 0, len(tsc.GetLargestDataTries()))
+
+	tsc.Add(getTrieStats(5, 1000), common.MainTrie)
+	numDataTries := numTriesToPrint + 5
+	for i := 0; i < numDataTries; i++ {
+		tsc.Add(getTrieStats(2, uint64(i+1)), common.DataTrie)
+		tsc.IncrementNumAccounts()
+	}
+
+	assert.Equal(t, uint64(numDataTries), tsc.GetNumAccounts())
+	assert.Equal(t, uint64(1), tsc.GetNumTries(common.MainTrie))
+	assert.Equal(t, uint64(numDataTries), tsc.GetNumTries(common.DataTrie))
+	assert.Equal(t, uint64(1000), tsc.GetTrieStatistics(common.MainTrie).GetTotalNodesSize())
+	assert.Equal(t, uint64(numDataTries), tsc.GetTrieStatistics(common.DataTrie).GetNumBranchNodes())
+
+	largestDataTries := tsc.GetLargestDataTries()
+	assert.Equal(t, numTriesToPrint, len(largestDataTries))
+	for i, trieStats := range largestDataTries {
+		assert.Equal(t, uint64(numDataTries-i), trieStats.GetTotalNodesSize())
+	}
+}
+
+func TestNewTrieStatisticsCollectorWithNumLargestDataTries(t *testing.T) {
+	t.Parallel()
+
+	numLargestDataTries := uint32(3)
+	tsc := NewTrieStatisticsCollectorWithNumLargestDataTries(numLargestDataTries)
+	assert.Equal(t, int(numLargestDataTries), len(tsc.largestDataTries))
+	assert.Equal(t, numTriesToPrint, len(tsc.triesBySize))
+	assert.Equal(t, numTriesToPrint, len(tsc.triesByDepth))
+
+	numDataTries := numTriesToPrint + 5
+	for i := 0; i < numDataTries; i++ {
+		tsc.Add(getTrieStats(2, uint64(i+1)), common.DataTrie)
+	}
+
+	largestDataTries := tsc.GetLargestDataTries()
+	assert.Equal(t, int(numLargestDataTries), len(largestDataTries))
+	for i, trieStats := range largestDataTries {
+		assert.Equal(t, uint64(numDataTries-i), trieStats.GetTotalNodesSize())
+	}
+	assert.Equal(t, numTriesToPrint, len(tsc.triesBySize))
+}
+
 func getTrieStats(maxLevel int, size uint64) common.TrieStatisticsHandler {
 	ts := NewTrieStatistics()
 	ts.AddBranchNode(maxLevel, size)
@@ -6,6 +6,7 @@ import (
 	"testing"
 
 	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-go/common"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -68,8 +69,32 @@ func TestTrieStatistics_AddAccountInfo(t *testing.T) {
 	ts := NewTrieStatistics()
 	ts.AddAccountInfo(address, rootHash)
 
-	assert.Equal(t, address, ts.address)
-	assert.Equal(t, rootHash, ts.rootHash)
+	assert.Equal(t, address, ts.GetAddress())
+	assert.Equal(t, rootHash, ts.GetRootHash())
+}
+
+func TestTrieStatistics_GetNodesPerDepth(t *testing.T) {
+	t.Parallel()
+
+	ts := NewTrieStatistics()
+	assert.Equal(t, 0, len(ts.GetNodesPerDepth()))
+
+	ts.AddBranchNode(0, 10)
+	ts.AddBranchNode(1, 10)
+	ts.AddExtensionNode(1, 10)
+	ts.AddLeafNode(2, 10, 0)
+	ts.AddLeafNode(2, 10, 0)
+
+	expectedNodesPerDepth := []*common.TrieNodesPerDepth{
+		{Depth: 0, NumBranchNodes: 1},
+		{Depth: 1, NumBranchNodes: 1, NumExtensionNodes: 1},
+		{Depth: 2, NumLeafNodes: 2},
+	}
+	nodesPerDepth := ts.GetNodesPerDepth()
+	assert.Equal(t, expectedNodesPerDepth, nodesPerDepth)
+
+	nodesPerDepth[0].NumBranchNodes = 100
+	assert.Equal(t, uint64(1), ts.GetNodesPerDepth()[0].NumBranchNodes)
 }
 
 func TestTrieStatistics_GetTrieStats(t *testing.T) {
@@ -162,6 +187,15 @@ func TestTrieStatistics_MergeTriesStatistics(t *testing.T) {
 	assert.Equal(t, uint64(2), ts.GetLeavesMigrationStats()[0])
 	assert.Equal(t, uint64(2), ts.GetLeavesMigrationStats()[1])
 
+	expectedNodesPerDepth := []*common.TrieNodesPerDepth{
+		{Depth: 0},
+		{Depth: 1, NumBranchNodes: 2, NumLeafNodes: 1},
+		{Depth: 2, NumLeafNodes: 1},
+		{Depth: 3, NumExtensionNodes: 2, NumLeafNodes: 1},
+		{Depth: 4, NumLeafNodes: 1},
+	}
+	assert.Equal(t, expectedNodesPerDepth, ts.GetNodesPerDepth())
+
 	address := "address"
 	rootHash := []byte("rootHash")
 	ts.AddAccountInfo(address, rootHash)